@@ -0,0 +1,37 @@
+package proxy
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/lyonmu/quebec/cmd/core/internal/dto/request"
+	"github.com/lyonmu/quebec/cmd/core/internal/dto/response"
+	"github.com/lyonmu/quebec/pkg/code"
+)
+
+// ProxyBootstrap
+// @Tags      代理管理
+// @Summary   生成 Envoy bootstrap
+// @Description 为接入指定网关集群的新数据面节点生成 Envoy bootstrap，包含节点标识、指向 Gateway 的 ADS 集群、管理接口及可选的 TLS 与 xDS 令牌，集群需已由 Gateway 注册
+// @securityDefinitions.apikey ApiKeyAuth
+// @In        header
+// @Name      x-quebec-token
+// @Param     data  body      request.ProxyBootstrapReq  true  "bootstrap 参数"
+// @Produce   json
+// @Success   200  {object}  code.Response{code=number,data=response.ProxyBootstrapResp,message=string}  "50000,success"
+// @Router    /v1/proxy/bootstrap [post]
+func (b *ProxyV1ApiGroup) ProxyBootstrap(c *gin.Context) {
+
+	var req request.ProxyBootstrapReq
+	if err := c.ShouldBindJSON(&req); err != nil {
+		code.InvalidParams.Failed(c)
+		return
+	}
+
+	var _ response.ProxyBootstrapResp
+	resp, err := proxysvc.Bootstrap(c.Request.Context(), &req)
+	if err != nil {
+		err.(*code.Response).Failed(c)
+		return
+	}
+
+	code.Success.Success(resp, c)
+}
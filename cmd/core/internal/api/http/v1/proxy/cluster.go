@@ -0,0 +1,119 @@
+package proxy
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/lyonmu/quebec/cmd/core/internal/dto/request"
+	"github.com/lyonmu/quebec/cmd/core/internal/dto/response"
+	"github.com/lyonmu/quebec/pkg/code"
+)
+
+// ProxyGatewayClusterList
+// @Tags      代理管理
+// @Summary   网关集群列表
+// @Description 获取已注册的网关集群列表
+// @securityDefinitions.apikey ApiKeyAuth
+// @In        header
+// @Name      x-quebec-token
+// @Produce   json
+// @Success   200  {object}  code.Response{code=number,data=[]response.ProxyGatewayClusterResp,message=string}  "50000,success"
+// @Router    /v1/proxy/cluster/list [get]
+func (b *ProxyV1ApiGroup) ProxyGatewayClusterList(c *gin.Context) {
+
+	var _ response.ProxyGatewayClusterResp
+	resp, err := proxysvc.ListGatewayCluster(c.Request.Context())
+	if err != nil {
+		err.(*code.Response).Failed(c)
+		return
+	}
+
+	code.Success.Success(resp, c)
+}
+
+// ProxyGatewayClusterLabel
+// @Tags      代理管理
+// @Summary   网关集群标签
+// @Description 获取网关集群标签
+// @securityDefinitions.apikey ApiKeyAuth
+// @In        header
+// @Name      x-quebec-token
+// @Produce   json
+// @Success   200  {object}  code.Response{code=number,data=[]response.Options,message=string}  "50000,success"
+// @Router    /v1/proxy/cluster/label [get]
+func (b *ProxyV1ApiGroup) ProxyGatewayClusterLabel(c *gin.Context) {
+
+	resp, err := proxysvc.GatewayClusterLabel(c.Request.Context())
+	if err != nil {
+		err.(*code.Response).Failed(c)
+		return
+	}
+
+	code.Success.Success(resp, c)
+}
+
+// ProxyListenerBindCluster
+// @Tags      代理管理
+// @Summary   监听器绑定网关集群
+// @Description 将监听器绑定到指定网关集群，集群ID为空表示下发到所有集群
+// @securityDefinitions.apikey ApiKeyAuth
+// @In        header
+// @Name      x-quebec-token
+// @Param     id    path      string                       true  "监听器ID"
+// @Param     data  body      request.ProxyBindClusterReq  true  "网关集群信息"
+// @Produce   json
+// @Success   200  {object}  code.Response{code=number,message=string}  "50000,success"
+// @Router    /v1/proxy/listener/{id}/cluster [put]
+func (b *ProxyV1ApiGroup) ProxyListenerBindCluster(c *gin.Context) {
+
+	var uri request.IdReq
+	if err := c.ShouldBindUri(&uri); err != nil {
+		code.InvalidParams.Failed(c)
+		return
+	}
+
+	var req request.ProxyBindClusterReq
+	if err := c.ShouldBindJSON(&req); err != nil {
+		code.InvalidParams.Failed(c)
+		return
+	}
+
+	if err := proxysvc.ListenerBindCluster(uri.ID, &req, c.Request.Context()); err != nil {
+		err.(*code.Response).Failed(c)
+		return
+	}
+
+	code.Success.Success(nil, c)
+}
+
+// ProxyHttpRouteBindCluster
+// @Tags      代理管理
+// @Summary   路由绑定网关集群
+// @Description 将 HTTP 路由绑定到指定网关集群，集群ID为空表示下发到所有集群
+// @securityDefinitions.apikey ApiKeyAuth
+// @In        header
+// @Name      x-quebec-token
+// @Param     id    path      string                       true  "路由ID"
+// @Param     data  body      request.ProxyBindClusterReq  true  "网关集群信息"
+// @Produce   json
+// @Success   200  {object}  code.Response{code=number,message=string}  "50000,success"
+// @Router    /v1/proxy/route/{id}/cluster [put]
+func (b *ProxyV1ApiGroup) ProxyHttpRouteBindCluster(c *gin.Context) {
+
+	var uri request.IdReq
+	if err := c.ShouldBindUri(&uri); err != nil {
+		code.InvalidParams.Failed(c)
+		return
+	}
+
+	var req request.ProxyBindClusterReq
+	if err := c.ShouldBindJSON(&req); err != nil {
+		code.InvalidParams.Failed(c)
+		return
+	}
+
+	if err := proxysvc.HttpRouteBindCluster(uri.ID, &req, c.Request.Context()); err != nil {
+		err.(*code.Response).Failed(c)
+		return
+	}
+
+	code.Success.Success(nil, c)
+}
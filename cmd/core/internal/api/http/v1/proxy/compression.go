@@ -0,0 +1,131 @@
+package proxy
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/lyonmu/quebec/cmd/core/internal/dto/request"
+	"github.com/lyonmu/quebec/pkg/code"
+)
+
+// ProxyListenerCompression
+// @Tags      代理管理
+// @Summary   监听器响应压缩
+// @Description 获取 L7 监听器的响应压缩
+// @securityDefinitions.apikey ApiKeyAuth
+// @In        header
+// @Name      x-quebec-token
+// @Param     id  path      string  true  "监听器ID"
+// @Produce   json
+// @Success   200  {object}  code.Response{code=number,data=response.ProxyCompressionResp,message=string}  "50000,success"
+// @Router    /v1/proxy/listener/{id}/compression [get]
+func (b *ProxyV1ApiGroup) ProxyListenerCompression(c *gin.Context) {
+
+	var uri request.IdReq
+	if err := c.ShouldBindUri(&uri); err != nil {
+		code.InvalidParams.Failed(c)
+		return
+	}
+
+	resp, err := proxysvc.ListenerCompression(c.Request.Context(), uri.ID)
+	if err != nil {
+		err.(*code.Response).Failed(c)
+		return
+	}
+
+	code.Success.Success(resp, c)
+}
+
+// ProxyListenerSetCompression
+// @Tags      代理管理
+// @Summary   设置监听器响应压缩
+// @Description 设置 L7 监听器的响应压缩，支持 gzip、brotli 与 zstd，按客户端 Accept-Encoding 选择算法；开启后 Envoy 移除转发给上游服务的 Accept-Encoding，上游服务不再需要自行压缩。压缩算法为空时关闭
+// @securityDefinitions.apikey ApiKeyAuth
+// @In        header
+// @Name      x-quebec-token
+// @Param     id    path      string                       true  "监听器ID"
+// @Param     data  body      request.ProxyCompressionReq  true  "响应压缩"
+// @Produce   json
+// @Success   200  {object}  code.Response{code=number,message=string}  "50000,success"
+// @Router    /v1/proxy/listener/{id}/compression [put]
+func (b *ProxyV1ApiGroup) ProxyListenerSetCompression(c *gin.Context) {
+
+	var uri request.IdReq
+	if err := c.ShouldBindUri(&uri); err != nil {
+		code.InvalidParams.Failed(c)
+		return
+	}
+
+	var req request.ProxyCompressionReq
+	if err := c.ShouldBindJSON(&req); err != nil {
+		code.InvalidParams.Failed(c)
+		return
+	}
+
+	if err := proxysvc.ListenerSetCompression(c.Request.Context(), uri.ID, &req); err != nil {
+		err.(*code.Response).Failed(c)
+		return
+	}
+
+	code.Success.Success(nil, c)
+}
+
+// ProxyHttpRouteCompression
+// @Tags      代理管理
+// @Summary   路由响应压缩
+// @Description 获取 HTTP 路由是否关闭了响应压缩
+// @securityDefinitions.apikey ApiKeyAuth
+// @In        header
+// @Name      x-quebec-token
+// @Param     id  path      string  true  "路由ID"
+// @Produce   json
+// @Success   200  {object}  code.Response{code=number,data=response.ProxyRouteCompressionResp,message=string}  "50000,success"
+// @Router    /v1/proxy/route/{id}/compression [get]
+func (b *ProxyV1ApiGroup) ProxyHttpRouteCompression(c *gin.Context) {
+
+	var uri request.IdReq
+	if err := c.ShouldBindUri(&uri); err != nil {
+		code.InvalidParams.Failed(c)
+		return
+	}
+
+	resp, err := proxysvc.HttpRouteCompression(c.Request.Context(), uri.ID)
+	if err != nil {
+		err.(*code.Response).Failed(c)
+		return
+	}
+
+	code.Success.Success(resp, c)
+}
+
+// ProxyHttpRouteSetCompression
+// @Tags      代理管理
+// @Summary   设置路由响应压缩
+// @Description 设置是否关闭 HTTP 路由的响应压缩，关闭后该路由的响应不经监听器压缩
+// @securityDefinitions.apikey ApiKeyAuth
+// @In        header
+// @Name      x-quebec-token
+// @Param     id    path      string                            true  "路由ID"
+// @Param     data  body      request.ProxyRouteCompressionReq  true  "响应压缩"
+// @Produce   json
+// @Success   200  {object}  code.Response{code=number,message=string}  "50000,success"
+// @Router    /v1/proxy/route/{id}/compression [put]
+func (b *ProxyV1ApiGroup) ProxyHttpRouteSetCompression(c *gin.Context) {
+
+	var uri request.IdReq
+	if err := c.ShouldBindUri(&uri); err != nil {
+		code.InvalidParams.Failed(c)
+		return
+	}
+
+	var req request.ProxyRouteCompressionReq
+	if err := c.ShouldBindJSON(&req); err != nil {
+		code.InvalidParams.Failed(c)
+		return
+	}
+
+	if err := proxysvc.HttpRouteSetCompression(c.Request.Context(), uri.ID, &req); err != nil {
+		err.(*code.Response).Failed(c)
+		return
+	}
+
+	code.Success.Success(nil, c)
+}
@@ -0,0 +1,29 @@
+package proxy
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/lyonmu/quebec/cmd/core/internal/dto/response"
+	"github.com/lyonmu/quebec/pkg/code"
+)
+
+// ProxyGatewayList
+// @Tags      代理管理
+// @Summary   网关列表
+// @Description 获取网关实例列表，包含已应用与最近被拒绝的配置修订号及拒绝原因
+// @securityDefinitions.apikey ApiKeyAuth
+// @In        header
+// @Name      x-quebec-token
+// @Produce   json
+// @Success   200  {object}  code.Response{code=number,data=[]response.ProxyGatewayResp,message=string}  "50000,success"
+// @Router    /v1/proxy/gateway/list [get]
+func (b *ProxyV1ApiGroup) ProxyGatewayList(c *gin.Context) {
+
+	var _ response.ProxyGatewayResp
+	resp, err := proxysvc.ListGateway(c.Request.Context())
+	if err != nil {
+		err.(*code.Response).Failed(c)
+		return
+	}
+
+	code.Success.Success(resp, c)
+}
@@ -0,0 +1,131 @@
+package proxy
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/lyonmu/quebec/cmd/core/internal/dto/request"
+	"github.com/lyonmu/quebec/pkg/code"
+)
+
+// ProxyHttpRouteHashPolicies
+// @Tags      代理管理
+// @Summary   路由哈希策略
+// @Description 获取 HTTP 路由的一致性哈希策略
+// @securityDefinitions.apikey ApiKeyAuth
+// @In        header
+// @Name      x-quebec-token
+// @Param     id  path      string  true  "路由ID"
+// @Produce   json
+// @Success   200  {object}  code.Response{code=number,data=response.ProxyHashPoliciesResp,message=string}  "50000,success"
+// @Router    /v1/proxy/route/{id}/hash-policy [get]
+func (b *ProxyV1ApiGroup) ProxyHttpRouteHashPolicies(c *gin.Context) {
+
+	var uri request.IdReq
+	if err := c.ShouldBindUri(&uri); err != nil {
+		code.InvalidParams.Failed(c)
+		return
+	}
+
+	resp, err := proxysvc.HttpRouteHashPolicies(c.Request.Context(), uri.ID)
+	if err != nil {
+		err.(*code.Response).Failed(c)
+		return
+	}
+
+	code.Success.Success(resp, c)
+}
+
+// ProxyHttpRouteSetHashPolicies
+// @Tags      代理管理
+// @Summary   设置路由哈希策略
+// @Description 设置 HTTP 路由的一致性哈希策略，按请求头、Cookie、客户端地址或查询参数计算哈希值，使同一会话的请求转发到同一后端；Cookie 设置有效期后由 Envoy 在首次请求时生成。上游服务使用 RING_HASH 或 MAGLEV 时生效，策略为空时清除
+// @securityDefinitions.apikey ApiKeyAuth
+// @In        header
+// @Name      x-quebec-token
+// @Param     id    path      string                        true  "路由ID"
+// @Param     data  body      request.ProxyHashPoliciesReq  true  "哈希策略"
+// @Produce   json
+// @Success   200  {object}  code.Response{code=number,message=string}  "50000,success"
+// @Router    /v1/proxy/route/{id}/hash-policy [put]
+func (b *ProxyV1ApiGroup) ProxyHttpRouteSetHashPolicies(c *gin.Context) {
+
+	var uri request.IdReq
+	if err := c.ShouldBindUri(&uri); err != nil {
+		code.InvalidParams.Failed(c)
+		return
+	}
+
+	var req request.ProxyHashPoliciesReq
+	if err := c.ShouldBindJSON(&req); err != nil {
+		code.InvalidParams.Failed(c)
+		return
+	}
+
+	if err := proxysvc.HttpRouteSetHashPolicies(c.Request.Context(), uri.ID, &req); err != nil {
+		err.(*code.Response).Failed(c)
+		return
+	}
+
+	code.Success.Success(nil, c)
+}
+
+// ProxyUpstreamLb
+// @Tags      代理管理
+// @Summary   上游服务负载均衡设置
+// @Description 获取上游服务的负载均衡策略，以及环哈希的环大小与 Maglev 的查找表大小
+// @securityDefinitions.apikey ApiKeyAuth
+// @In        header
+// @Name      x-quebec-token
+// @Param     id  path      string  true  "上游服务ID"
+// @Produce   json
+// @Success   200  {object}  code.Response{code=number,data=response.ProxyUpstreamLbResp,message=string}  "50000,success"
+// @Router    /v1/proxy/upstream/{id}/lb [get]
+func (b *ProxyV1ApiGroup) ProxyUpstreamLb(c *gin.Context) {
+
+	var uri request.IdReq
+	if err := c.ShouldBindUri(&uri); err != nil {
+		code.InvalidParams.Failed(c)
+		return
+	}
+
+	resp, err := proxysvc.UpstreamLb(c.Request.Context(), uri.ID)
+	if err != nil {
+		err.(*code.Response).Failed(c)
+		return
+	}
+
+	code.Success.Success(resp, c)
+}
+
+// ProxyUpstreamSetLb
+// @Tags      代理管理
+// @Summary   设置上游服务负载均衡
+// @Description 设置上游服务的负载均衡策略；环大小只在 RING_HASH 时生效，Maglev 查找表大小只在 MAGLEV 时生效且必须为质数，0 表示使用 Envoy 默认值
+// @securityDefinitions.apikey ApiKeyAuth
+// @In        header
+// @Name      x-quebec-token
+// @Param     id    path      string                      true  "上游服务ID"
+// @Param     data  body      request.ProxyUpstreamLbReq  true  "负载均衡设置"
+// @Produce   json
+// @Success   200  {object}  code.Response{code=number,message=string}  "50000,success"
+// @Router    /v1/proxy/upstream/{id}/lb [put]
+func (b *ProxyV1ApiGroup) ProxyUpstreamSetLb(c *gin.Context) {
+
+	var uri request.IdReq
+	if err := c.ShouldBindUri(&uri); err != nil {
+		code.InvalidParams.Failed(c)
+		return
+	}
+
+	var req request.ProxyUpstreamLbReq
+	if err := c.ShouldBindJSON(&req); err != nil {
+		code.InvalidParams.Failed(c)
+		return
+	}
+
+	if err := proxysvc.UpstreamSetLb(c.Request.Context(), uri.ID, &req); err != nil {
+		err.(*code.Response).Failed(c)
+		return
+	}
+
+	code.Success.Success(nil, c)
+}
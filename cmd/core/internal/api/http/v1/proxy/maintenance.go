@@ -0,0 +1,193 @@
+package proxy
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/lyonmu/quebec/cmd/core/internal/dto/request"
+	"github.com/lyonmu/quebec/pkg/code"
+)
+
+// ProxyHttpRouteDirectResponse
+// @Tags      代理管理
+// @Summary   路由直接响应
+// @Description 获取 HTTP 路由的直接响应
+// @securityDefinitions.apikey ApiKeyAuth
+// @In        header
+// @Name      x-quebec-token
+// @Param     id  path      string  true  "路由ID"
+// @Produce   json
+// @Success   200  {object}  code.Response{code=number,data=response.ProxyDirectResponseResp,message=string}  "50000,success"
+// @Router    /v1/proxy/route/{id}/direct-response [get]
+func (b *ProxyV1ApiGroup) ProxyHttpRouteDirectResponse(c *gin.Context) {
+
+	var uri request.IdReq
+	if err := c.ShouldBindUri(&uri); err != nil {
+		code.InvalidParams.Failed(c)
+		return
+	}
+
+	resp, err := proxysvc.HttpRouteDirectResponse(c.Request.Context(), uri.ID)
+	if err != nil {
+		err.(*code.Response).Failed(c)
+		return
+	}
+
+	code.Success.Success(resp, c)
+}
+
+// ProxyHttpRouteSetDirectResponse
+// @Tags      代理管理
+// @Summary   设置路由直接响应
+// @Description 设置 HTTP 路由的直接响应，设置后 Envoy 直接返回该响应而不转发到上游服务，状态码为 0 时清除。不能与重定向同时开启
+// @securityDefinitions.apikey ApiKeyAuth
+// @In        header
+// @Name      x-quebec-token
+// @Param     id    path      string                          true  "路由ID"
+// @Param     data  body      request.ProxyDirectResponseReq  true  "直接响应"
+// @Produce   json
+// @Success   200  {object}  code.Response{code=number,message=string}  "50000,success"
+// @Router    /v1/proxy/route/{id}/direct-response [put]
+func (b *ProxyV1ApiGroup) ProxyHttpRouteSetDirectResponse(c *gin.Context) {
+
+	var uri request.IdReq
+	if err := c.ShouldBindUri(&uri); err != nil {
+		code.InvalidParams.Failed(c)
+		return
+	}
+
+	var req request.ProxyDirectResponseReq
+	if err := c.ShouldBindJSON(&req); err != nil {
+		code.InvalidParams.Failed(c)
+		return
+	}
+
+	if err := proxysvc.HttpRouteSetDirectResponse(c.Request.Context(), uri.ID, &req); err != nil {
+		err.(*code.Response).Failed(c)
+		return
+	}
+
+	code.Success.Success(nil, c)
+}
+
+// ProxyVirtualHostMaintenance
+// @Tags      代理管理
+// @Summary   虚拟主机维护模式
+// @Description 获取虚拟主机的维护模式
+// @securityDefinitions.apikey ApiKeyAuth
+// @In        header
+// @Name      x-quebec-token
+// @Param     id  path      string  true  "虚拟主机ID"
+// @Produce   json
+// @Success   200  {object}  code.Response{code=number,data=response.ProxyMaintenanceResp,message=string}  "50000,success"
+// @Router    /v1/proxy/virtual-host/{id}/maintenance [get]
+func (b *ProxyV1ApiGroup) ProxyVirtualHostMaintenance(c *gin.Context) {
+
+	var uri request.IdReq
+	if err := c.ShouldBindUri(&uri); err != nil {
+		code.InvalidParams.Failed(c)
+		return
+	}
+
+	resp, err := proxysvc.VirtualHostMaintenance(c.Request.Context(), uri.ID)
+	if err != nil {
+		err.(*code.Response).Failed(c)
+		return
+	}
+
+	code.Success.Success(resp, c)
+}
+
+// ProxyVirtualHostSetMaintenance
+// @Tags      代理管理
+// @Summary   设置虚拟主机维护模式
+// @Description 开启或关闭虚拟主机的维护模式，开启后除放行的路径前缀与客户端地址外，所有请求返回维护响应；优先于网关集群的维护模式
+// @securityDefinitions.apikey ApiKeyAuth
+// @In        header
+// @Name      x-quebec-token
+// @Param     id    path      string                       true  "虚拟主机ID"
+// @Param     data  body      request.ProxyMaintenanceReq  true  "维护模式"
+// @Produce   json
+// @Success   200  {object}  code.Response{code=number,message=string}  "50000,success"
+// @Router    /v1/proxy/virtual-host/{id}/maintenance [put]
+func (b *ProxyV1ApiGroup) ProxyVirtualHostSetMaintenance(c *gin.Context) {
+
+	var uri request.IdReq
+	if err := c.ShouldBindUri(&uri); err != nil {
+		code.InvalidParams.Failed(c)
+		return
+	}
+
+	var req request.ProxyMaintenanceReq
+	if err := c.ShouldBindJSON(&req); err != nil {
+		code.InvalidParams.Failed(c)
+		return
+	}
+
+	if err := proxysvc.VirtualHostSetMaintenance(c.Request.Context(), uri.ID, &req); err != nil {
+		err.(*code.Response).Failed(c)
+		return
+	}
+
+	code.Success.Success(nil, c)
+}
+
+// ProxyGatewayClusterMaintenance
+// @Tags      代理管理
+// @Summary   网关集群维护模式
+// @Description 获取网关集群的维护模式
+// @securityDefinitions.apikey ApiKeyAuth
+// @In        header
+// @Name      x-quebec-token
+// @Param     id  path      string  true  "网关集群ID"
+// @Produce   json
+// @Success   200  {object}  code.Response{code=number,data=response.ProxyMaintenanceResp,message=string}  "50000,success"
+// @Router    /v1/proxy/cluster/{id}/maintenance [get]
+func (b *ProxyV1ApiGroup) ProxyGatewayClusterMaintenance(c *gin.Context) {
+
+	var uri request.IdReq
+	if err := c.ShouldBindUri(&uri); err != nil {
+		code.InvalidParams.Failed(c)
+		return
+	}
+
+	resp, err := proxysvc.GatewayClusterMaintenance(c.Request.Context(), uri.ID)
+	if err != nil {
+		err.(*code.Response).Failed(c)
+		return
+	}
+
+	code.Success.Success(resp, c)
+}
+
+// ProxyGatewayClusterSetMaintenance
+// @Tags      代理管理
+// @Summary   设置网关集群维护模式
+// @Description 开启或关闭网关集群的维护模式，作用于集群内所有未单独开启维护模式的虚拟主机，除放行的路径前缀与客户端地址外，所有请求返回维护响应
+// @securityDefinitions.apikey ApiKeyAuth
+// @In        header
+// @Name      x-quebec-token
+// @Param     id    path      string                       true  "网关集群ID"
+// @Param     data  body      request.ProxyMaintenanceReq  true  "维护模式"
+// @Produce   json
+// @Success   200  {object}  code.Response{code=number,message=string}  "50000,success"
+// @Router    /v1/proxy/cluster/{id}/maintenance [put]
+func (b *ProxyV1ApiGroup) ProxyGatewayClusterSetMaintenance(c *gin.Context) {
+
+	var uri request.IdReq
+	if err := c.ShouldBindUri(&uri); err != nil {
+		code.InvalidParams.Failed(c)
+		return
+	}
+
+	var req request.ProxyMaintenanceReq
+	if err := c.ShouldBindJSON(&req); err != nil {
+		code.InvalidParams.Failed(c)
+		return
+	}
+
+	if err := proxysvc.GatewayClusterSetMaintenance(c.Request.Context(), uri.ID, &req); err != nil {
+		err.(*code.Response).Failed(c)
+		return
+	}
+
+	code.Success.Success(nil, c)
+}
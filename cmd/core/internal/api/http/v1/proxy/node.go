@@ -0,0 +1,37 @@
+package proxy
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/lyonmu/quebec/cmd/core/internal/dto/request"
+	"github.com/lyonmu/quebec/cmd/core/internal/dto/response"
+	"github.com/lyonmu/quebec/pkg/code"
+)
+
+// ProxyGatewayNodeList
+// @Tags      代理管理
+// @Summary   网关节点列表
+// @Description 获取在线 Envoy 节点列表，包含各节点已应用的配置修订号与最近的 NACK 错误
+// @securityDefinitions.apikey ApiKeyAuth
+// @In        header
+// @Name      x-quebec-token
+// @Param     data  query      request.ProxyGatewayNodeListReq      true  "网关节点查询信息"
+// @Produce   json
+// @Success   200  {object}  code.Response{code=number,data=[]response.ProxyGatewayNodeResp,message=string}  "50000,success"
+// @Router    /v1/proxy/node/list [get]
+func (b *ProxyV1ApiGroup) ProxyGatewayNodeList(c *gin.Context) {
+
+	var req request.ProxyGatewayNodeListReq
+	var _ response.ProxyGatewayNodeResp
+	if err := c.ShouldBindQuery(&req); err != nil {
+		code.InvalidParams.Failed(c)
+		return
+	}
+
+	resp, err := proxysvc.ListGatewayNode(&req, c.Request.Context())
+	if err != nil {
+		err.(*code.Response).Failed(c)
+		return
+	}
+
+	code.Success.Success(resp, c)
+}
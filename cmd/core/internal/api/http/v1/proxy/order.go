@@ -0,0 +1,69 @@
+package proxy
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/lyonmu/quebec/cmd/core/internal/dto/request"
+	"github.com/lyonmu/quebec/pkg/code"
+)
+
+// ProxyHttpRoutePriority
+// @Tags      代理管理
+// @Summary   路由匹配优先级
+// @Description 获取 HTTP 路由的匹配优先级，以及同一虚拟主机下与其相互覆盖、导致一方永远不会命中的路由
+// @securityDefinitions.apikey ApiKeyAuth
+// @In        header
+// @Name      x-quebec-token
+// @Param     id  path      string  true  "路由ID"
+// @Produce   json
+// @Success   200  {object}  code.Response{code=number,data=response.ProxyRoutePriorityResp,message=string}  "50000,success"
+// @Router    /v1/proxy/route/{id}/priority [get]
+func (b *ProxyV1ApiGroup) ProxyHttpRoutePriority(c *gin.Context) {
+
+	var uri request.IdReq
+	if err := c.ShouldBindUri(&uri); err != nil {
+		code.InvalidParams.Failed(c)
+		return
+	}
+
+	resp, err := proxysvc.HttpRoutePriority(c.Request.Context(), uri.ID)
+	if err != nil {
+		err.(*code.Response).Failed(c)
+		return
+	}
+
+	code.Success.Success(resp, c)
+}
+
+// ProxyHttpRouteSetPriority
+// @Tags      代理管理
+// @Summary   设置路由匹配优先级
+// @Description 设置 HTTP 路由的匹配优先级，数值越大越先匹配；相同优先级时精确匹配在前、正则其次、前缀最后，前缀越长越靠前。与同一虚拟主机下的其他路由相互覆盖时返回 52069，data 中列出冲突的路由
+// @securityDefinitions.apikey ApiKeyAuth
+// @In        header
+// @Name      x-quebec-token
+// @Param     id    path      string                         true  "路由ID"
+// @Param     data  body      request.ProxyRoutePriorityReq  true  "匹配优先级"
+// @Produce   json
+// @Success   200  {object}  code.Response{code=number,message=string}  "50000,success"
+// @Router    /v1/proxy/route/{id}/priority [put]
+func (b *ProxyV1ApiGroup) ProxyHttpRouteSetPriority(c *gin.Context) {
+
+	var uri request.IdReq
+	if err := c.ShouldBindUri(&uri); err != nil {
+		code.InvalidParams.Failed(c)
+		return
+	}
+
+	var req request.ProxyRoutePriorityReq
+	if err := c.ShouldBindJSON(&req); err != nil {
+		code.InvalidParams.Failed(c)
+		return
+	}
+
+	if err := proxysvc.HttpRouteSetPriority(c.Request.Context(), uri.ID, &req); err != nil {
+		err.(*code.Response).Failed(c)
+		return
+	}
+
+	code.Success.Success(nil, c)
+}
@@ -0,0 +1,9 @@
+package proxy
+
+import "github.com/lyonmu/quebec/cmd/core/internal/service/http/proxy"
+
+type ProxyV1ApiGroup struct{}
+
+var (
+	proxysvc = proxy.ProxySvc{}
+)
@@ -0,0 +1,304 @@
+package proxy
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/lyonmu/quebec/cmd/core/internal/dto/request"
+	"github.com/lyonmu/quebec/cmd/core/internal/dto/response"
+	"github.com/lyonmu/quebec/pkg/code"
+)
+
+// ProxyHttpRouteRateLimit
+// @Tags      代理管理
+// @Summary   路由本地限流
+// @Description 获取 HTTP 路由的令牌桶本地限流，未配置时沿用监听器的本地限流
+// @securityDefinitions.apikey ApiKeyAuth
+// @In        header
+// @Name      x-quebec-token
+// @Param     id  path      string  true  "路由ID"
+// @Produce   json
+// @Success   200  {object}  code.Response{code=number,data=response.ProxyLocalRateLimitResp,message=string}  "50000,success"
+// @Router    /v1/proxy/route/{id}/rate-limit [get]
+func (b *ProxyV1ApiGroup) ProxyHttpRouteRateLimit(c *gin.Context) {
+
+	var uri request.IdReq
+	if err := c.ShouldBindUri(&uri); err != nil {
+		code.InvalidParams.Failed(c)
+		return
+	}
+
+	resp, err := proxysvc.HttpRouteRateLimit(c.Request.Context(), uri.ID)
+	if err != nil {
+		err.(*code.Response).Failed(c)
+		return
+	}
+
+	code.Success.Success(resp, c)
+}
+
+// ProxyHttpRouteSetRateLimit
+// @Tags      代理管理
+// @Summary   设置路由本地限流
+// @Description 设置 HTTP 路由的令牌桶容量、补充令牌数与间隔、被限流时的状态码与响应头，由每个 Envoy 独立计数，令牌桶容量为 0 时清除
+// @securityDefinitions.apikey ApiKeyAuth
+// @In        header
+// @Name      x-quebec-token
+// @Param     id    path      string                          true  "路由ID"
+// @Param     data  body      request.ProxyLocalRateLimitReq  true  "本地限流"
+// @Produce   json
+// @Success   200  {object}  code.Response{code=number,message=string}  "50000,success"
+// @Router    /v1/proxy/route/{id}/rate-limit [put]
+func (b *ProxyV1ApiGroup) ProxyHttpRouteSetRateLimit(c *gin.Context) {
+
+	var uri request.IdReq
+	if err := c.ShouldBindUri(&uri); err != nil {
+		code.InvalidParams.Failed(c)
+		return
+	}
+
+	var req request.ProxyLocalRateLimitReq
+	if err := c.ShouldBindJSON(&req); err != nil {
+		code.InvalidParams.Failed(c)
+		return
+	}
+
+	if err := proxysvc.HttpRouteSetRateLimit(c.Request.Context(), uri.ID, &req); err != nil {
+		err.(*code.Response).Failed(c)
+		return
+	}
+
+	code.Success.Success(nil, c)
+}
+
+// ProxyListenerRateLimit
+// @Tags      代理管理
+// @Summary   监听器本地限流
+// @Description 获取 L7 监听器的令牌桶本地限流
+// @securityDefinitions.apikey ApiKeyAuth
+// @In        header
+// @Name      x-quebec-token
+// @Param     id  path      string  true  "监听器ID"
+// @Produce   json
+// @Success   200  {object}  code.Response{code=number,data=response.ProxyLocalRateLimitResp,message=string}  "50000,success"
+// @Router    /v1/proxy/listener/{id}/rate-limit [get]
+func (b *ProxyV1ApiGroup) ProxyListenerRateLimit(c *gin.Context) {
+
+	var uri request.IdReq
+	if err := c.ShouldBindUri(&uri); err != nil {
+		code.InvalidParams.Failed(c)
+		return
+	}
+
+	resp, err := proxysvc.ListenerRateLimit(c.Request.Context(), uri.ID)
+	if err != nil {
+		err.(*code.Response).Failed(c)
+		return
+	}
+
+	code.Success.Success(resp, c)
+}
+
+// ProxyListenerSetRateLimit
+// @Tags      代理管理
+// @Summary   设置监听器本地限流
+// @Description 设置 L7 监听器的令牌桶本地限流，监听器上未单独配置限流的路由共用该令牌桶，令牌桶容量为 0 时清除
+// @securityDefinitions.apikey ApiKeyAuth
+// @In        header
+// @Name      x-quebec-token
+// @Param     id    path      string                          true  "监听器ID"
+// @Param     data  body      request.ProxyLocalRateLimitReq  true  "本地限流"
+// @Produce   json
+// @Success   200  {object}  code.Response{code=number,message=string}  "50000,success"
+// @Router    /v1/proxy/listener/{id}/rate-limit [put]
+func (b *ProxyV1ApiGroup) ProxyListenerSetRateLimit(c *gin.Context) {
+
+	var uri request.IdReq
+	if err := c.ShouldBindUri(&uri); err != nil {
+		code.InvalidParams.Failed(c)
+		return
+	}
+
+	var req request.ProxyLocalRateLimitReq
+	if err := c.ShouldBindJSON(&req); err != nil {
+		code.InvalidParams.Failed(c)
+		return
+	}
+
+	if err := proxysvc.ListenerSetRateLimit(c.Request.Context(), uri.ID, &req); err != nil {
+		err.(*code.Response).Failed(c)
+		return
+	}
+
+	code.Success.Success(nil, c)
+}
+
+// ProxyRateLimitRuleList
+// @Tags      代理管理
+// @Summary   全局限流规则列表
+// @Description 获取由 Gateway 限流服务基于 Redis 计数的全局限流规则
+// @securityDefinitions.apikey ApiKeyAuth
+// @In        header
+// @Name      x-quebec-token
+// @Produce   json
+// @Success   200  {object}  code.Response{code=number,data=[]response.ProxyRateLimitRuleResp,message=string}  "50000,success"
+// @Router    /v1/proxy/rate-limit-rule/list [get]
+func (b *ProxyV1ApiGroup) ProxyRateLimitRuleList(c *gin.Context) {
+
+	var _ response.ProxyRateLimitRuleResp
+	resp, err := proxysvc.ListRateLimitRule(c.Request.Context())
+	if err != nil {
+		err.(*code.Response).Failed(c)
+		return
+	}
+
+	code.Success.Success(resp, c)
+}
+
+// ProxyRateLimitRuleAdd
+// @Tags      代理管理
+// @Summary   创建全局限流规则
+// @Description 创建全局限流规则，描述符条目与路由生成的描述符逐项匹配，值为空表示匹配任意值并按不同值分别计数
+// @securityDefinitions.apikey ApiKeyAuth
+// @In        header
+// @Name      x-quebec-token
+// @Param     data  body      request.ProxyRateLimitRuleAddReq  true  "全局限流规则"
+// @Produce   json
+// @Success   200  {object}  code.Response{code=number,message=string}  "50000,success"
+// @Router    /v1/proxy/rate-limit-rule [post]
+func (b *ProxyV1ApiGroup) ProxyRateLimitRuleAdd(c *gin.Context) {
+
+	var req request.ProxyRateLimitRuleAddReq
+	if err := c.ShouldBindJSON(&req); err != nil {
+		code.InvalidParams.Failed(c)
+		return
+	}
+
+	if err := proxysvc.RateLimitRuleAdd(c.Request.Context(), &req); err != nil {
+		err.(*code.Response).Failed(c)
+		return
+	}
+
+	code.Success.Success(nil, c)
+}
+
+// ProxyRateLimitRuleUpdate
+// @Tags      代理管理
+// @Summary   更新全局限流规则
+// @Description 更新全局限流规则的名称、描述、描述符条目、计数周期、请求数与启用状态
+// @securityDefinitions.apikey ApiKeyAuth
+// @In        header
+// @Name      x-quebec-token
+// @Param     id    path      string                               true  "全局限流规则ID"
+// @Param     data  body      request.ProxyRateLimitRuleUpdateReq  true  "全局限流规则"
+// @Produce   json
+// @Success   200  {object}  code.Response{code=number,message=string}  "50000,success"
+// @Router    /v1/proxy/rate-limit-rule/{id} [put]
+func (b *ProxyV1ApiGroup) ProxyRateLimitRuleUpdate(c *gin.Context) {
+
+	var uri request.IdReq
+	if err := c.ShouldBindUri(&uri); err != nil {
+		code.InvalidParams.Failed(c)
+		return
+	}
+
+	var req request.ProxyRateLimitRuleUpdateReq
+	if err := c.ShouldBindJSON(&req); err != nil {
+		code.InvalidParams.Failed(c)
+		return
+	}
+
+	if err := proxysvc.RateLimitRuleUpdate(c.Request.Context(), uri.ID, &req); err != nil {
+		err.(*code.Response).Failed(c)
+		return
+	}
+
+	code.Success.Success(nil, c)
+}
+
+// ProxyRateLimitRuleDelete
+// @Tags      代理管理
+// @Summary   删除全局限流规则
+// @Description 删除全局限流规则
+// @securityDefinitions.apikey ApiKeyAuth
+// @In        header
+// @Name      x-quebec-token
+// @Param     id  path      string  true  "全局限流规则ID"
+// @Produce   json
+// @Success   200  {object}  code.Response{code=number,message=string}  "50000,success"
+// @Router    /v1/proxy/rate-limit-rule/{id} [delete]
+func (b *ProxyV1ApiGroup) ProxyRateLimitRuleDelete(c *gin.Context) {
+
+	var uri request.IdReq
+	if err := c.ShouldBindUri(&uri); err != nil {
+		code.InvalidParams.Failed(c)
+		return
+	}
+
+	if err := proxysvc.RateLimitRuleDelete(c.Request.Context(), uri.ID); err != nil {
+		err.(*code.Response).Failed(c)
+		return
+	}
+
+	code.Success.Success(nil, c)
+}
+
+// ProxyHttpRouteRateLimitActions
+// @Tags      代理管理
+// @Summary   路由全局限流描述符
+// @Description 获取 HTTP 路由发往全局限流服务的描述符条目
+// @securityDefinitions.apikey ApiKeyAuth
+// @In        header
+// @Name      x-quebec-token
+// @Param     id  path      string  true  "路由ID"
+// @Produce   json
+// @Success   200  {object}  code.Response{code=number,data=response.ProxyRateLimitActionsResp,message=string}  "50000,success"
+// @Router    /v1/proxy/route/{id}/rate-limit-actions [get]
+func (b *ProxyV1ApiGroup) ProxyHttpRouteRateLimitActions(c *gin.Context) {
+
+	var uri request.IdReq
+	if err := c.ShouldBindUri(&uri); err != nil {
+		code.InvalidParams.Failed(c)
+		return
+	}
+
+	resp, err := proxysvc.HttpRouteRateLimitActions(c.Request.Context(), uri.ID)
+	if err != nil {
+		err.(*code.Response).Failed(c)
+		return
+	}
+
+	code.Success.Success(resp, c)
+}
+
+// ProxyHttpRouteSetRateLimitActions
+// @Tags      代理管理
+// @Summary   设置路由全局限流描述符
+// @Description 设置 HTTP 路由的描述符条目，按顺序组成一个描述符交由全局限流规则匹配，为空时清除
+// @securityDefinitions.apikey ApiKeyAuth
+// @In        header
+// @Name      x-quebec-token
+// @Param     id    path      string                            true  "路由ID"
+// @Param     data  body      request.ProxyRateLimitActionsReq  true  "全局限流描述符"
+// @Produce   json
+// @Success   200  {object}  code.Response{code=number,message=string}  "50000,success"
+// @Router    /v1/proxy/route/{id}/rate-limit-actions [put]
+func (b *ProxyV1ApiGroup) ProxyHttpRouteSetRateLimitActions(c *gin.Context) {
+
+	var uri request.IdReq
+	if err := c.ShouldBindUri(&uri); err != nil {
+		code.InvalidParams.Failed(c)
+		return
+	}
+
+	var req request.ProxyRateLimitActionsReq
+	if err := c.ShouldBindJSON(&req); err != nil {
+		code.InvalidParams.Failed(c)
+		return
+	}
+
+	if err := proxysvc.HttpRouteSetRateLimitActions(c.Request.Context(), uri.ID, &req); err != nil {
+		err.(*code.Response).Failed(c)
+		return
+	}
+
+	code.Success.Success(nil, c)
+}
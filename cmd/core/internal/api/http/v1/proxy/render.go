@@ -0,0 +1,37 @@
+package proxy
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/lyonmu/quebec/cmd/core/internal/dto/request"
+	"github.com/lyonmu/quebec/cmd/core/internal/dto/response"
+	"github.com/lyonmu/quebec/pkg/code"
+)
+
+// ProxyRender
+// @Tags      代理管理
+// @Summary   预览渲染代理配置
+// @Description 渲染指定节点或集群将收到的 Envoy 资源 (Listener/RouteConfiguration/Cluster/ClusterLoadAssignment)，执行与 Gateway 相同的校验并将错误对应到出错的路由、上游服务或监听器，不会下发给 Envoy
+// @securityDefinitions.apikey ApiKeyAuth
+// @In        header
+// @Name      x-quebec-token
+// @Param     data  query     request.ProxyRenderReq  true  "渲染参数"
+// @Produce   json
+// @Success   200  {object}  code.Response{code=number,data=response.ProxyRenderResp,message=string}  "50000,success"
+// @Router    /v1/proxy/render [get]
+func (b *ProxyV1ApiGroup) ProxyRender(c *gin.Context) {
+
+	var req request.ProxyRenderReq
+	if err := c.ShouldBindQuery(&req); err != nil {
+		code.InvalidParams.Failed(c)
+		return
+	}
+
+	var _ response.ProxyRenderResp
+	resp, err := proxysvc.Render(c.Request.Context(), &req)
+	if err != nil {
+		err.(*code.Response).Failed(c)
+		return
+	}
+
+	code.Success.Success(resp, c)
+}
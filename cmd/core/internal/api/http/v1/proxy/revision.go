@@ -0,0 +1,120 @@
+package proxy
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/lyonmu/quebec/cmd/core/internal/dto/request"
+	"github.com/lyonmu/quebec/cmd/core/internal/dto/response"
+	"github.com/lyonmu/quebec/pkg/code"
+)
+
+// ProxyRevisionPage
+// @Tags      代理管理
+// @Summary   配置修订分页列表
+// @Description 分页获取已发布的代理配置修订，按修订号倒序
+// @securityDefinitions.apikey ApiKeyAuth
+// @In        header
+// @Name      x-quebec-token
+// @Param     data  query     request.ProxyRevisionPageReq  true  "分页参数"
+// @Produce   json
+// @Success   200  {object}  code.Response{code=number,data=response.ProxyRevisionListResp,message=string}  "50000,success"
+// @Router    /v1/proxy/revision/page [get]
+func (b *ProxyV1ApiGroup) ProxyRevisionPage(c *gin.Context) {
+
+	var req request.ProxyRevisionPageReq
+	if err := c.ShouldBindQuery(&req); err != nil {
+		code.InvalidParams.Failed(c)
+		return
+	}
+
+	var _ response.ProxyRevisionListResp
+	resp, err := proxysvc.RevisionPage(c.Request.Context(), &req)
+	if err != nil {
+		err.(*code.Response).Failed(c)
+		return
+	}
+
+	code.Success.Success(resp, c)
+}
+
+// ProxyRevisionDetail
+// @Tags      代理管理
+// @Summary   配置修订详情
+// @Description 获取指定修订的完整代理配置
+// @securityDefinitions.apikey ApiKeyAuth
+// @In        header
+// @Name      x-quebec-token
+// @Param     revision  path      int  true  "配置修订号"
+// @Produce   json
+// @Success   200  {object}  code.Response{code=number,data=response.ProxyRevisionDetailResp,message=string}  "50000,success"
+// @Router    /v1/proxy/revision/{revision} [get]
+func (b *ProxyV1ApiGroup) ProxyRevisionDetail(c *gin.Context) {
+
+	var uri request.ProxyRevisionReq
+	if err := c.ShouldBindUri(&uri); err != nil {
+		code.InvalidParams.Failed(c)
+		return
+	}
+
+	resp, err := proxysvc.RevisionDetail(c.Request.Context(), uri.Revision)
+	if err != nil {
+		err.(*code.Response).Failed(c)
+		return
+	}
+
+	code.Success.Success(resp, c)
+}
+
+// ProxyRevisionDiff
+// @Tags      代理管理
+// @Summary   配置修订比较
+// @Description 比较两个修订之间上游服务、路由、监听器与证书的新增、删除与字段级修改
+// @securityDefinitions.apikey ApiKeyAuth
+// @In        header
+// @Name      x-quebec-token
+// @Param     data  query     request.ProxyRevisionDiffReq  true  "比较的修订号"
+// @Produce   json
+// @Success   200  {object}  code.Response{code=number,data=response.ProxyRevisionDiffResp,message=string}  "50000,success"
+// @Router    /v1/proxy/revision/diff [get]
+func (b *ProxyV1ApiGroup) ProxyRevisionDiff(c *gin.Context) {
+
+	var req request.ProxyRevisionDiffReq
+	if err := c.ShouldBindQuery(&req); err != nil {
+		code.InvalidParams.Failed(c)
+		return
+	}
+
+	resp, err := proxysvc.RevisionDiff(c.Request.Context(), &req)
+	if err != nil {
+		err.(*code.Response).Failed(c)
+		return
+	}
+
+	code.Success.Success(resp, c)
+}
+
+// ProxyRevisionRollback
+// @Tags      代理管理
+// @Summary   回滚配置修订
+// @Description 将代理实体恢复到指定修订的状态，恢复后的配置作为新修订发布
+// @securityDefinitions.apikey ApiKeyAuth
+// @In        header
+// @Name      x-quebec-token
+// @Param     revision  path      int  true  "配置修订号"
+// @Produce   json
+// @Success   200  {object}  code.Response{code=number,message=string}  "50000,success"
+// @Router    /v1/proxy/revision/rollback/{revision} [post]
+func (b *ProxyV1ApiGroup) ProxyRevisionRollback(c *gin.Context) {
+
+	var uri request.ProxyRevisionReq
+	if err := c.ShouldBindUri(&uri); err != nil {
+		code.InvalidParams.Failed(c)
+		return
+	}
+
+	if err := proxysvc.RevisionRollback(c.Request.Context(), uri.Revision); err != nil {
+		err.(*code.Response).Failed(c)
+		return
+	}
+
+	code.Success.Success(nil, c)
+}
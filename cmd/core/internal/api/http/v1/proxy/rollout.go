@@ -0,0 +1,194 @@
+package proxy
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/lyonmu/quebec/cmd/core/internal/dto/request"
+	"github.com/lyonmu/quebec/cmd/core/internal/dto/response"
+	"github.com/lyonmu/quebec/pkg/code"
+)
+
+// ProxyRolloutPolicyList
+// @Tags      代理管理
+// @Summary   灰度策略列表
+// @Description 获取各网关集群的灰度发布策略
+// @securityDefinitions.apikey ApiKeyAuth
+// @In        header
+// @Name      x-quebec-token
+// @Produce   json
+// @Success   200  {object}  code.Response{code=number,data=[]response.ProxyRolloutPolicyResp,message=string}  "50000,success"
+// @Router    /v1/proxy/rollout/policy/list [get]
+func (b *ProxyV1ApiGroup) ProxyRolloutPolicyList(c *gin.Context) {
+
+	var _ response.ProxyRolloutPolicyResp
+	resp, err := proxysvc.RolloutPolicyList(c.Request.Context())
+	if err != nil {
+		err.(*code.Response).Failed(c)
+		return
+	}
+
+	code.Success.Success(resp, c)
+}
+
+// ProxyRolloutPolicySave
+// @Tags      代理管理
+// @Summary   保存灰度策略
+// @Description 创建或更新网关集群的灰度发布策略，对之后发布的修订生效
+// @securityDefinitions.apikey ApiKeyAuth
+// @In        header
+// @Name      x-quebec-token
+// @Param     data  body      request.ProxyRolloutPolicyReq  true  "灰度策略"
+// @Produce   json
+// @Success   200  {object}  code.Response{code=number,message=string}  "50000,success"
+// @Router    /v1/proxy/rollout/policy [put]
+func (b *ProxyV1ApiGroup) ProxyRolloutPolicySave(c *gin.Context) {
+
+	var req request.ProxyRolloutPolicyReq
+	if err := c.ShouldBindJSON(&req); err != nil {
+		code.InvalidParams.Failed(c)
+		return
+	}
+
+	if err := proxysvc.RolloutPolicySave(c.Request.Context(), &req); err != nil {
+		err.(*code.Response).Failed(c)
+		return
+	}
+
+	code.Success.Success(nil, c)
+}
+
+// ProxyRolloutPolicyDelete
+// @Tags      代理管理
+// @Summary   删除灰度策略
+// @Description 删除网关集群的灰度发布策略，之后发布的修订直接下发到集群所有节点
+// @securityDefinitions.apikey ApiKeyAuth
+// @In        header
+// @Name      x-quebec-token
+// @Param     id  path      string  true  "灰度策略ID"
+// @Produce   json
+// @Success   200  {object}  code.Response{code=number,message=string}  "50000,success"
+// @Router    /v1/proxy/rollout/policy/{id} [delete]
+func (b *ProxyV1ApiGroup) ProxyRolloutPolicyDelete(c *gin.Context) {
+
+	var uri request.IdReq
+	if err := c.ShouldBindUri(&uri); err != nil {
+		code.InvalidParams.Failed(c)
+		return
+	}
+
+	if err := proxysvc.RolloutPolicyDelete(c.Request.Context(), uri.ID); err != nil {
+		err.(*code.Response).Failed(c)
+		return
+	}
+
+	code.Success.Success(nil, c)
+}
+
+// ProxyRolloutPage
+// @Tags      代理管理
+// @Summary   灰度发布分页列表
+// @Description 分页获取灰度发布记录及其进度
+// @securityDefinitions.apikey ApiKeyAuth
+// @In        header
+// @Name      x-quebec-token
+// @Param     data  query     request.ProxyRolloutPageReq  true  "分页参数"
+// @Produce   json
+// @Success   200  {object}  code.Response{code=number,data=response.ProxyRolloutListResp,message=string}  "50000,success"
+// @Router    /v1/proxy/rollout/page [get]
+func (b *ProxyV1ApiGroup) ProxyRolloutPage(c *gin.Context) {
+
+	var req request.ProxyRolloutPageReq
+	if err := c.ShouldBindQuery(&req); err != nil {
+		code.InvalidParams.Failed(c)
+		return
+	}
+
+	resp, err := proxysvc.RolloutPage(c.Request.Context(), &req)
+	if err != nil {
+		err.(*code.Response).Failed(c)
+		return
+	}
+
+	code.Success.Success(resp, c)
+}
+
+// ProxyRolloutDetail
+// @Tags      代理管理
+// @Summary   灰度发布详情
+// @Description 获取灰度发布进度，包含各灰度节点的回执与流量
+// @securityDefinitions.apikey ApiKeyAuth
+// @In        header
+// @Name      x-quebec-token
+// @Param     id  path      string  true  "灰度发布ID"
+// @Produce   json
+// @Success   200  {object}  code.Response{code=number,data=response.ProxyRolloutDetailResp,message=string}  "50000,success"
+// @Router    /v1/proxy/rollout/{id} [get]
+func (b *ProxyV1ApiGroup) ProxyRolloutDetail(c *gin.Context) {
+
+	var uri request.IdReq
+	if err := c.ShouldBindUri(&uri); err != nil {
+		code.InvalidParams.Failed(c)
+		return
+	}
+
+	resp, err := proxysvc.RolloutDetail(c.Request.Context(), uri.ID)
+	if err != nil {
+		err.(*code.Response).Failed(c)
+		return
+	}
+
+	code.Success.Success(resp, c)
+}
+
+// ProxyRolloutPromote
+// @Tags      代理管理
+// @Summary   灰度发布全量
+// @Description 手动将灰度中或已中止的发布全量到集群所有节点
+// @securityDefinitions.apikey ApiKeyAuth
+// @In        header
+// @Name      x-quebec-token
+// @Param     id  path      string  true  "灰度发布ID"
+// @Produce   json
+// @Success   200  {object}  code.Response{code=number,message=string}  "50000,success"
+// @Router    /v1/proxy/rollout/{id}/promote [post]
+func (b *ProxyV1ApiGroup) ProxyRolloutPromote(c *gin.Context) {
+
+	var uri request.IdReq
+	if err := c.ShouldBindUri(&uri); err != nil {
+		code.InvalidParams.Failed(c)
+		return
+	}
+
+	if err := proxysvc.RolloutPromote(c.Request.Context(), uri.ID); err != nil {
+		err.(*code.Response).Failed(c)
+		return
+	}
+
+	code.Success.Success(nil, c)
+}
+
+// ProxyRolloutAbort
+// @Tags      代理管理
+// @Summary   中止灰度发布
+// @Description 手动中止灰度中的发布，集群所有节点回到稳定修订
+// @securityDefinitions.apikey ApiKeyAuth
+// @In        header
+// @Name      x-quebec-token
+// @Param     id  path      string  true  "灰度发布ID"
+// @Produce   json
+// @Success   200  {object}  code.Response{code=number,message=string}  "50000,success"
+// @Router    /v1/proxy/rollout/{id}/abort [post]
+func (b *ProxyV1ApiGroup) ProxyRolloutAbort(c *gin.Context) {
+
+	var uri request.IdReq
+	if err := c.ShouldBindUri(&uri); err != nil {
+		code.InvalidParams.Failed(c)
+		return
+	}
+
+	if err := proxysvc.RolloutAbort(c.Request.Context(), uri.ID); err != nil {
+		err.(*code.Response).Failed(c)
+		return
+	}
+
+	code.Success.Success(nil, c)
+}
@@ -0,0 +1,530 @@
+package proxy
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/lyonmu/quebec/cmd/core/internal/dto/request"
+	"github.com/lyonmu/quebec/pkg/code"
+)
+
+// ProxyHttpRouteTargets
+// @Tags      代理管理
+// @Summary   路由加权分流目标
+// @Description 获取 HTTP 路由的加权分流目标，为空表示全部流量转发到路由的上游服务
+// @securityDefinitions.apikey ApiKeyAuth
+// @In        header
+// @Name      x-quebec-token
+// @Param     id  path      string  true  "路由ID"
+// @Produce   json
+// @Success   200  {object}  code.Response{code=number,data=[]response.ProxyRouteTargetResp,message=string}  "50000,success"
+// @Router    /v1/proxy/route/{id}/targets [get]
+func (b *ProxyV1ApiGroup) ProxyHttpRouteTargets(c *gin.Context) {
+
+	var uri request.IdReq
+	if err := c.ShouldBindUri(&uri); err != nil {
+		code.InvalidParams.Failed(c)
+		return
+	}
+
+	resp, err := proxysvc.HttpRouteTargets(c.Request.Context(), uri.ID)
+	if err != nil {
+		err.(*code.Response).Failed(c)
+		return
+	}
+
+	code.Success.Success(resp, c)
+}
+
+// ProxyHttpRouteSetTargets
+// @Tags      代理管理
+// @Summary   设置路由加权分流
+// @Description 按权重将路由流量分配到多个上游服务，权重之和必须为100，传入空列表取消分流
+// @securityDefinitions.apikey ApiKeyAuth
+// @In        header
+// @Name      x-quebec-token
+// @Param     id    path      string                        true  "路由ID"
+// @Param     data  body      request.ProxyRouteTargetsReq  true  "分流目标"
+// @Produce   json
+// @Success   200  {object}  code.Response{code=number,message=string}  "50000,success"
+// @Router    /v1/proxy/route/{id}/targets [put]
+func (b *ProxyV1ApiGroup) ProxyHttpRouteSetTargets(c *gin.Context) {
+
+	var uri request.IdReq
+	if err := c.ShouldBindUri(&uri); err != nil {
+		code.InvalidParams.Failed(c)
+		return
+	}
+
+	var req request.ProxyRouteTargetsReq
+	if err := c.ShouldBindJSON(&req); err != nil {
+		code.InvalidParams.Failed(c)
+		return
+	}
+
+	if err := proxysvc.HttpRouteSetTargets(c.Request.Context(), uri.ID, &req); err != nil {
+		err.(*code.Response).Failed(c)
+		return
+	}
+
+	code.Success.Success(nil, c)
+}
+
+// ProxyHttpRouteMatch
+// @Tags      代理管理
+// @Summary   路由匹配条件
+// @Description 获取 HTTP 路由的路径、HTTP 方法、请求头与查询参数匹配条件
+// @securityDefinitions.apikey ApiKeyAuth
+// @In        header
+// @Name      x-quebec-token
+// @Param     id  path      string  true  "路由ID"
+// @Produce   json
+// @Success   200  {object}  code.Response{code=number,data=response.ProxyRouteMatchResp,message=string}  "50000,success"
+// @Router    /v1/proxy/route/{id}/match [get]
+func (b *ProxyV1ApiGroup) ProxyHttpRouteMatch(c *gin.Context) {
+
+	var uri request.IdReq
+	if err := c.ShouldBindUri(&uri); err != nil {
+		code.InvalidParams.Failed(c)
+		return
+	}
+
+	resp, err := proxysvc.HttpRouteMatch(c.Request.Context(), uri.ID)
+	if err != nil {
+		err.(*code.Response).Failed(c)
+		return
+	}
+
+	code.Success.Success(resp, c)
+}
+
+// ProxyHttpRouteSetMatch
+// @Tags      代理管理
+// @Summary   设置路由匹配条件
+// @Description 设置 HTTP 路由的 HTTP 方法、请求头与查询参数匹配条件，所有条件与路径匹配同时满足时路由才匹配，与同一虚拟主机下的其他路由相互覆盖时返回 52069
+// @securityDefinitions.apikey ApiKeyAuth
+// @In        header
+// @Name      x-quebec-token
+// @Param     id    path      string                      true  "路由ID"
+// @Param     data  body      request.ProxyRouteMatchReq  true  "匹配条件"
+// @Produce   json
+// @Success   200  {object}  code.Response{code=number,message=string}  "50000,success"
+// @Router    /v1/proxy/route/{id}/match [put]
+func (b *ProxyV1ApiGroup) ProxyHttpRouteSetMatch(c *gin.Context) {
+
+	var uri request.IdReq
+	if err := c.ShouldBindUri(&uri); err != nil {
+		code.InvalidParams.Failed(c)
+		return
+	}
+
+	var req request.ProxyRouteMatchReq
+	if err := c.ShouldBindJSON(&req); err != nil {
+		code.InvalidParams.Failed(c)
+		return
+	}
+
+	if err := proxysvc.HttpRouteSetMatch(c.Request.Context(), uri.ID, &req); err != nil {
+		err.(*code.Response).Failed(c)
+		return
+	}
+
+	code.Success.Success(nil, c)
+}
+
+// ProxyHttpRoutePath
+// @Tags      代理管理
+// @Summary   路由路径设置
+// @Description 获取 HTTP 路由的路径匹配、路径重写与重定向设置
+// @securityDefinitions.apikey ApiKeyAuth
+// @In        header
+// @Name      x-quebec-token
+// @Param     id  path      string  true  "路由ID"
+// @Produce   json
+// @Success   200  {object}  code.Response{code=number,data=response.ProxyRoutePathResp,message=string}  "50000,success"
+// @Router    /v1/proxy/route/{id}/path [get]
+func (b *ProxyV1ApiGroup) ProxyHttpRoutePath(c *gin.Context) {
+
+	var uri request.IdReq
+	if err := c.ShouldBindUri(&uri); err != nil {
+		code.InvalidParams.Failed(c)
+		return
+	}
+
+	resp, err := proxysvc.HttpRoutePath(c.Request.Context(), uri.ID)
+	if err != nil {
+		err.(*code.Response).Failed(c)
+		return
+	}
+
+	code.Success.Success(resp, c)
+}
+
+// ProxyHttpRouteSetPath
+// @Tags      代理管理
+// @Summary   设置路由路径
+// @Description 设置 HTTP 路由的路径匹配(前缀/精确/正则)、路径重写与重定向，正则按 RE2 语法校验，与同一虚拟主机下的其他路由相互覆盖时返回 52069
+// @securityDefinitions.apikey ApiKeyAuth
+// @In        header
+// @Name      x-quebec-token
+// @Param     id    path      string                     true  "路由ID"
+// @Param     data  body      request.ProxyRoutePathReq  true  "路径设置"
+// @Produce   json
+// @Success   200  {object}  code.Response{code=number,message=string}  "50000,success"
+// @Router    /v1/proxy/route/{id}/path [put]
+func (b *ProxyV1ApiGroup) ProxyHttpRouteSetPath(c *gin.Context) {
+
+	var uri request.IdReq
+	if err := c.ShouldBindUri(&uri); err != nil {
+		code.InvalidParams.Failed(c)
+		return
+	}
+
+	var req request.ProxyRoutePathReq
+	if err := c.ShouldBindJSON(&req); err != nil {
+		code.InvalidParams.Failed(c)
+		return
+	}
+
+	if err := proxysvc.HttpRouteSetPath(c.Request.Context(), uri.ID, &req); err != nil {
+		err.(*code.Response).Failed(c)
+		return
+	}
+
+	code.Success.Success(nil, c)
+}
+
+// ProxyHttpRouteRetry
+// @Tags      代理管理
+// @Summary   路由重试策略
+// @Description 获取 HTTP 路由的重试策略，未启用时返回默认值
+// @securityDefinitions.apikey ApiKeyAuth
+// @In        header
+// @Name      x-quebec-token
+// @Param     id  path      string  true  "路由ID"
+// @Produce   json
+// @Success   200  {object}  code.Response{code=number,data=response.ProxyRouteRetryResp,message=string}  "50000,success"
+// @Router    /v1/proxy/route/{id}/retry [get]
+func (b *ProxyV1ApiGroup) ProxyHttpRouteRetry(c *gin.Context) {
+
+	var uri request.IdReq
+	if err := c.ShouldBindUri(&uri); err != nil {
+		code.InvalidParams.Failed(c)
+		return
+	}
+
+	resp, err := proxysvc.HttpRouteRetry(c.Request.Context(), uri.ID)
+	if err != nil {
+		err.(*code.Response).Failed(c)
+		return
+	}
+
+	code.Success.Success(resp, c)
+}
+
+// ProxyHttpRouteSetRetry
+// @Tags      代理管理
+// @Summary   设置路由重试策略
+// @Description 设置 HTTP 路由的重试条件、重试次数、单次超时、可重试状态码与退避间隔，重试条件为空时关闭重试
+// @securityDefinitions.apikey ApiKeyAuth
+// @In        header
+// @Name      x-quebec-token
+// @Param     id    path      string                      true  "路由ID"
+// @Param     data  body      request.ProxyRouteRetryReq  true  "重试策略"
+// @Produce   json
+// @Success   200  {object}  code.Response{code=number,message=string}  "50000,success"
+// @Router    /v1/proxy/route/{id}/retry [put]
+func (b *ProxyV1ApiGroup) ProxyHttpRouteSetRetry(c *gin.Context) {
+
+	var uri request.IdReq
+	if err := c.ShouldBindUri(&uri); err != nil {
+		code.InvalidParams.Failed(c)
+		return
+	}
+
+	var req request.ProxyRouteRetryReq
+	if err := c.ShouldBindJSON(&req); err != nil {
+		code.InvalidParams.Failed(c)
+		return
+	}
+
+	if err := proxysvc.HttpRouteSetRetry(c.Request.Context(), uri.ID, &req); err != nil {
+		err.(*code.Response).Failed(c)
+		return
+	}
+
+	code.Success.Success(nil, c)
+}
+
+// ProxyHttpRouteHeaders
+// @Tags      代理管理
+// @Summary   路由头改写规则
+// @Description 获取 HTTP 路由添加、覆盖与移除的请求头和响应头
+// @securityDefinitions.apikey ApiKeyAuth
+// @In        header
+// @Name      x-quebec-token
+// @Param     id  path      string  true  "路由ID"
+// @Produce   json
+// @Success   200  {object}  code.Response{code=number,data=response.ProxyHeaderMutationResp,message=string}  "50000,success"
+// @Router    /v1/proxy/route/{id}/headers [get]
+func (b *ProxyV1ApiGroup) ProxyHttpRouteHeaders(c *gin.Context) {
+
+	var uri request.IdReq
+	if err := c.ShouldBindUri(&uri); err != nil {
+		code.InvalidParams.Failed(c)
+		return
+	}
+
+	resp, err := proxysvc.HttpRouteHeaders(c.Request.Context(), uri.ID)
+	if err != nil {
+		err.(*code.Response).Failed(c)
+		return
+	}
+
+	code.Success.Success(resp, c)
+}
+
+// ProxyHttpRouteSetHeaders
+// @Tags      代理管理
+// @Summary   设置路由头改写规则
+// @Description 设置 HTTP 路由添加、覆盖与移除的请求头和响应头，值支持 Envoy 格式化变量如 %DOWNSTREAM_REMOTE_ADDRESS%，规则全部为空时清除
+// @securityDefinitions.apikey ApiKeyAuth
+// @In        header
+// @Name      x-quebec-token
+// @Param     id    path      string                          true  "路由ID"
+// @Param     data  body      request.ProxyHeaderMutationReq  true  "头改写规则"
+// @Produce   json
+// @Success   200  {object}  code.Response{code=number,message=string}  "50000,success"
+// @Router    /v1/proxy/route/{id}/headers [put]
+func (b *ProxyV1ApiGroup) ProxyHttpRouteSetHeaders(c *gin.Context) {
+
+	var uri request.IdReq
+	if err := c.ShouldBindUri(&uri); err != nil {
+		code.InvalidParams.Failed(c)
+		return
+	}
+
+	var req request.ProxyHeaderMutationReq
+	if err := c.ShouldBindJSON(&req); err != nil {
+		code.InvalidParams.Failed(c)
+		return
+	}
+
+	if err := proxysvc.HttpRouteSetHeaders(c.Request.Context(), uri.ID, &req); err != nil {
+		err.(*code.Response).Failed(c)
+		return
+	}
+
+	code.Success.Success(nil, c)
+}
+
+// ProxyHttpRouteMirror
+// @Tags      代理管理
+// @Summary   路由流量镜像
+// @Description 获取 HTTP 路由的流量镜像设置，未开启时影子上游为空
+// @securityDefinitions.apikey ApiKeyAuth
+// @In        header
+// @Name      x-quebec-token
+// @Param     id  path      string  true  "路由ID"
+// @Produce   json
+// @Success   200  {object}  code.Response{code=number,data=response.ProxyRouteMirrorResp,message=string}  "50000,success"
+// @Router    /v1/proxy/route/{id}/mirror [get]
+func (b *ProxyV1ApiGroup) ProxyHttpRouteMirror(c *gin.Context) {
+
+	var uri request.IdReq
+	if err := c.ShouldBindUri(&uri); err != nil {
+		code.InvalidParams.Failed(c)
+		return
+	}
+
+	resp, err := proxysvc.HttpRouteMirror(c.Request.Context(), uri.ID)
+	if err != nil {
+		err.(*code.Response).Failed(c)
+		return
+	}
+
+	code.Success.Success(resp, c)
+}
+
+// ProxyHttpRouteSetMirror
+// @Tags      代理管理
+// @Summary   设置路由流量镜像
+// @Description 按比例将路由的请求复制到影子上游服务，镜像请求的响应会被丢弃；影子上游不能是路由的转发目标，为空时关闭镜像
+// @securityDefinitions.apikey ApiKeyAuth
+// @In        header
+// @Name      x-quebec-token
+// @Param     id    path      string                       true  "路由ID"
+// @Param     data  body      request.ProxyRouteMirrorReq  true  "流量镜像"
+// @Produce   json
+// @Success   200  {object}  code.Response{code=number,message=string}  "50000,success"
+// @Router    /v1/proxy/route/{id}/mirror [put]
+func (b *ProxyV1ApiGroup) ProxyHttpRouteSetMirror(c *gin.Context) {
+
+	var uri request.IdReq
+	if err := c.ShouldBindUri(&uri); err != nil {
+		code.InvalidParams.Failed(c)
+		return
+	}
+
+	var req request.ProxyRouteMirrorReq
+	if err := c.ShouldBindJSON(&req); err != nil {
+		code.InvalidParams.Failed(c)
+		return
+	}
+
+	if err := proxysvc.HttpRouteSetMirror(c.Request.Context(), uri.ID, &req); err != nil {
+		err.(*code.Response).Failed(c)
+		return
+	}
+
+	code.Success.Success(nil, c)
+}
+
+// ProxyHttpRouteFault
+// @Tags      代理管理
+// @Summary   路由故障注入
+// @Description 获取 HTTP 路由的故障注入设置，到期的故障返回未生效
+// @securityDefinitions.apikey ApiKeyAuth
+// @In        header
+// @Name      x-quebec-token
+// @Param     id  path      string  true  "路由ID"
+// @Produce   json
+// @Success   200  {object}  code.Response{code=number,data=response.ProxyRouteFaultResp,message=string}  "50000,success"
+// @Router    /v1/proxy/route/{id}/fault [get]
+func (b *ProxyV1ApiGroup) ProxyHttpRouteFault(c *gin.Context) {
+
+	var uri request.IdReq
+	if err := c.ShouldBindUri(&uri); err != nil {
+		code.InvalidParams.Failed(c)
+		return
+	}
+
+	resp, err := proxysvc.HttpRouteFault(c.Request.Context(), uri.ID)
+	if err != nil {
+		err.(*code.Response).Failed(c)
+		return
+	}
+
+	code.Success.Success(resp, c)
+}
+
+// ProxyHttpRouteSetFault
+// @Tags      代理管理
+// @Summary   设置路由故障注入
+// @Description 为 HTTP 路由按比例注入固定延迟或以指定 HTTP/gRPC 状态码中断请求，可只对携带指定请求头的请求生效，到期后自动移除
+// @securityDefinitions.apikey ApiKeyAuth
+// @In        header
+// @Name      x-quebec-token
+// @Param     id    path      string                      true  "路由ID"
+// @Param     data  body      request.ProxyRouteFaultReq  true  "故障注入"
+// @Produce   json
+// @Success   200  {object}  code.Response{code=number,message=string}  "50000,success"
+// @Router    /v1/proxy/route/{id}/fault [put]
+func (b *ProxyV1ApiGroup) ProxyHttpRouteSetFault(c *gin.Context) {
+
+	var uri request.IdReq
+	if err := c.ShouldBindUri(&uri); err != nil {
+		code.InvalidParams.Failed(c)
+		return
+	}
+
+	var req request.ProxyRouteFaultReq
+	if err := c.ShouldBindJSON(&req); err != nil {
+		code.InvalidParams.Failed(c)
+		return
+	}
+
+	if err := proxysvc.HttpRouteSetFault(c.Request.Context(), uri.ID, &req); err != nil {
+		err.(*code.Response).Failed(c)
+		return
+	}
+
+	code.Success.Success(nil, c)
+}
+
+// ProxyHttpRouteClearFault
+// @Tags      代理管理
+// @Summary   清除路由故障注入
+// @Description 立即清除 HTTP 路由的故障注入，不等待到期
+// @securityDefinitions.apikey ApiKeyAuth
+// @In        header
+// @Name      x-quebec-token
+// @Param     id  path      string  true  "路由ID"
+// @Produce   json
+// @Success   200  {object}  code.Response{code=number,message=string}  "50000,success"
+// @Router    /v1/proxy/route/{id}/fault [delete]
+func (b *ProxyV1ApiGroup) ProxyHttpRouteClearFault(c *gin.Context) {
+
+	var uri request.IdReq
+	if err := c.ShouldBindUri(&uri); err != nil {
+		code.InvalidParams.Failed(c)
+		return
+	}
+
+	if err := proxysvc.HttpRouteClearFault(c.Request.Context(), uri.ID); err != nil {
+		err.(*code.Response).Failed(c)
+		return
+	}
+
+	code.Success.Success(nil, c)
+}
+
+// ProxyHttpRouteCors
+// @Tags      代理管理
+// @Summary   路由跨域策略
+// @Description 获取 HTTP 路由的跨域策略，未配置时沿用虚拟主机的跨域策略
+// @securityDefinitions.apikey ApiKeyAuth
+// @In        header
+// @Name      x-quebec-token
+// @Param     id  path      string  true  "路由ID"
+// @Produce   json
+// @Success   200  {object}  code.Response{code=number,data=response.ProxyCorsPolicyResp,message=string}  "50000,success"
+// @Router    /v1/proxy/route/{id}/cors [get]
+func (b *ProxyV1ApiGroup) ProxyHttpRouteCors(c *gin.Context) {
+
+	var uri request.IdReq
+	if err := c.ShouldBindUri(&uri); err != nil {
+		code.InvalidParams.Failed(c)
+		return
+	}
+
+	resp, err := proxysvc.HttpRouteCors(c.Request.Context(), uri.ID)
+	if err != nil {
+		err.(*code.Response).Failed(c)
+		return
+	}
+
+	code.Success.Success(resp, c)
+}
+
+// ProxyHttpRouteSetCors
+// @Tags      代理管理
+// @Summary   设置路由跨域策略
+// @Description 设置 HTTP 路由允许的来源(精确/前缀/正则)、方法、请求头、暴露的响应头、预检缓存时间与是否允许凭证，允许来源为空时清除
+// @securityDefinitions.apikey ApiKeyAuth
+// @In        header
+// @Name      x-quebec-token
+// @Param     id    path      string                      true  "路由ID"
+// @Param     data  body      request.ProxyCorsPolicyReq  true  "跨域策略"
+// @Produce   json
+// @Success   200  {object}  code.Response{code=number,message=string}  "50000,success"
+// @Router    /v1/proxy/route/{id}/cors [put]
+func (b *ProxyV1ApiGroup) ProxyHttpRouteSetCors(c *gin.Context) {
+
+	var uri request.IdReq
+	if err := c.ShouldBindUri(&uri); err != nil {
+		code.InvalidParams.Failed(c)
+		return
+	}
+
+	var req request.ProxyCorsPolicyReq
+	if err := c.ShouldBindJSON(&req); err != nil {
+		code.InvalidParams.Failed(c)
+		return
+	}
+
+	if err := proxysvc.HttpRouteSetCors(c.Request.Context(), uri.ID, &req); err != nil {
+		err.(*code.Response).Failed(c)
+		return
+	}
+
+	code.Success.Success(nil, c)
+}
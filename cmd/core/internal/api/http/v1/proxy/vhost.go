@@ -0,0 +1,283 @@
+package proxy
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/lyonmu/quebec/cmd/core/internal/dto/request"
+	"github.com/lyonmu/quebec/cmd/core/internal/dto/response"
+	"github.com/lyonmu/quebec/pkg/code"
+)
+
+// ProxyVirtualHostList
+// @Tags      代理管理
+// @Summary   虚拟主机列表
+// @Description 获取 L7 监听器下的虚拟主机，未绑定虚拟主机的路由挂在默认虚拟主机(*)下
+// @securityDefinitions.apikey ApiKeyAuth
+// @In        header
+// @Name      x-quebec-token
+// @Param     data  query     request.ProxyVirtualHostListReq  false  "过滤条件"
+// @Produce   json
+// @Success   200  {object}  code.Response{code=number,data=[]response.ProxyVirtualHostResp,message=string}  "50000,success"
+// @Router    /v1/proxy/virtual-host/list [get]
+func (b *ProxyV1ApiGroup) ProxyVirtualHostList(c *gin.Context) {
+
+	var _ response.ProxyVirtualHostResp
+	var req request.ProxyVirtualHostListReq
+	if err := c.ShouldBindQuery(&req); err != nil {
+		code.InvalidParams.Failed(c)
+		return
+	}
+
+	resp, err := proxysvc.ListVirtualHost(c.Request.Context(), &req)
+	if err != nil {
+		err.(*code.Response).Failed(c)
+		return
+	}
+
+	code.Success.Success(resp, c)
+}
+
+// ProxyVirtualHostAdd
+// @Tags      代理管理
+// @Summary   创建虚拟主机
+// @Description 在 L7 监听器下创建虚拟主机，域名不能与同一监听器下其他启用的虚拟主机重复
+// @securityDefinitions.apikey ApiKeyAuth
+// @In        header
+// @Name      x-quebec-token
+// @Param     data  body      request.ProxyVirtualHostAddReq  true  "虚拟主机"
+// @Produce   json
+// @Success   200  {object}  code.Response{code=number,message=string}  "50000,success"
+// @Router    /v1/proxy/virtual-host [post]
+func (b *ProxyV1ApiGroup) ProxyVirtualHostAdd(c *gin.Context) {
+
+	var req request.ProxyVirtualHostAddReq
+	if err := c.ShouldBindJSON(&req); err != nil {
+		code.InvalidParams.Failed(c)
+		return
+	}
+
+	if err := proxysvc.VirtualHostAdd(c.Request.Context(), &req); err != nil {
+		err.(*code.Response).Failed(c)
+		return
+	}
+
+	code.Success.Success(nil, c)
+}
+
+// ProxyVirtualHostUpdate
+// @Tags      代理管理
+// @Summary   更新虚拟主机
+// @Description 更新虚拟主机的名称、描述、域名与启用状态
+// @securityDefinitions.apikey ApiKeyAuth
+// @In        header
+// @Name      x-quebec-token
+// @Param     id    path      string                             true  "虚拟主机ID"
+// @Param     data  body      request.ProxyVirtualHostUpdateReq  true  "虚拟主机"
+// @Produce   json
+// @Success   200  {object}  code.Response{code=number,message=string}  "50000,success"
+// @Router    /v1/proxy/virtual-host/{id} [put]
+func (b *ProxyV1ApiGroup) ProxyVirtualHostUpdate(c *gin.Context) {
+
+	var uri request.IdReq
+	if err := c.ShouldBindUri(&uri); err != nil {
+		code.InvalidParams.Failed(c)
+		return
+	}
+
+	var req request.ProxyVirtualHostUpdateReq
+	if err := c.ShouldBindJSON(&req); err != nil {
+		code.InvalidParams.Failed(c)
+		return
+	}
+
+	if err := proxysvc.VirtualHostUpdate(c.Request.Context(), uri.ID, &req); err != nil {
+		err.(*code.Response).Failed(c)
+		return
+	}
+
+	code.Success.Success(nil, c)
+}
+
+// ProxyVirtualHostDelete
+// @Tags      代理管理
+// @Summary   删除虚拟主机
+// @Description 删除虚拟主机，仍有路由绑定时不允许删除
+// @securityDefinitions.apikey ApiKeyAuth
+// @In        header
+// @Name      x-quebec-token
+// @Param     id  path      string  true  "虚拟主机ID"
+// @Produce   json
+// @Success   200  {object}  code.Response{code=number,message=string}  "50000,success"
+// @Router    /v1/proxy/virtual-host/{id} [delete]
+func (b *ProxyV1ApiGroup) ProxyVirtualHostDelete(c *gin.Context) {
+
+	var uri request.IdReq
+	if err := c.ShouldBindUri(&uri); err != nil {
+		code.InvalidParams.Failed(c)
+		return
+	}
+
+	if err := proxysvc.VirtualHostDelete(c.Request.Context(), uri.ID); err != nil {
+		err.(*code.Response).Failed(c)
+		return
+	}
+
+	code.Success.Success(nil, c)
+}
+
+// ProxyHttpRouteBindVirtualHost
+// @Tags      代理管理
+// @Summary   路由绑定虚拟主机
+// @Description 将路由挂到指定虚拟主机下，虚拟主机ID为空时挂到所有监听器的默认虚拟主机(*)
+// @securityDefinitions.apikey ApiKeyAuth
+// @In        header
+// @Name      x-quebec-token
+// @Param     id    path      string                          true  "路由ID"
+// @Param     data  body      request.ProxyRouteBindVhostReq  true  "虚拟主机"
+// @Produce   json
+// @Success   200  {object}  code.Response{code=number,message=string}  "50000,success"
+// @Router    /v1/proxy/route/{id}/virtual-host [put]
+func (b *ProxyV1ApiGroup) ProxyHttpRouteBindVirtualHost(c *gin.Context) {
+
+	var uri request.IdReq
+	if err := c.ShouldBindUri(&uri); err != nil {
+		code.InvalidParams.Failed(c)
+		return
+	}
+
+	var req request.ProxyRouteBindVhostReq
+	if err := c.ShouldBindJSON(&req); err != nil {
+		code.InvalidParams.Failed(c)
+		return
+	}
+
+	if err := proxysvc.HttpRouteBindVirtualHost(c.Request.Context(), uri.ID, &req); err != nil {
+		err.(*code.Response).Failed(c)
+		return
+	}
+
+	code.Success.Success(nil, c)
+}
+
+// ProxyVirtualHostHeaders
+// @Tags      代理管理
+// @Summary   虚拟主机头改写规则
+// @Description 获取虚拟主机添加、覆盖与移除的请求头和响应头
+// @securityDefinitions.apikey ApiKeyAuth
+// @In        header
+// @Name      x-quebec-token
+// @Param     id  path      string  true  "虚拟主机ID"
+// @Produce   json
+// @Success   200  {object}  code.Response{code=number,data=response.ProxyHeaderMutationResp,message=string}  "50000,success"
+// @Router    /v1/proxy/virtual-host/{id}/headers [get]
+func (b *ProxyV1ApiGroup) ProxyVirtualHostHeaders(c *gin.Context) {
+
+	var uri request.IdReq
+	if err := c.ShouldBindUri(&uri); err != nil {
+		code.InvalidParams.Failed(c)
+		return
+	}
+
+	resp, err := proxysvc.VirtualHostHeaders(c.Request.Context(), uri.ID)
+	if err != nil {
+		err.(*code.Response).Failed(c)
+		return
+	}
+
+	code.Success.Success(resp, c)
+}
+
+// ProxyVirtualHostSetHeaders
+// @Tags      代理管理
+// @Summary   设置虚拟主机头改写规则
+// @Description 设置虚拟主机添加、覆盖与移除的请求头和响应头，作用于虚拟主机下所有路由，值支持 Envoy 格式化变量，规则全部为空时清除
+// @securityDefinitions.apikey ApiKeyAuth
+// @In        header
+// @Name      x-quebec-token
+// @Param     id    path      string                          true  "虚拟主机ID"
+// @Param     data  body      request.ProxyHeaderMutationReq  true  "头改写规则"
+// @Produce   json
+// @Success   200  {object}  code.Response{code=number,message=string}  "50000,success"
+// @Router    /v1/proxy/virtual-host/{id}/headers [put]
+func (b *ProxyV1ApiGroup) ProxyVirtualHostSetHeaders(c *gin.Context) {
+
+	var uri request.IdReq
+	if err := c.ShouldBindUri(&uri); err != nil {
+		code.InvalidParams.Failed(c)
+		return
+	}
+
+	var req request.ProxyHeaderMutationReq
+	if err := c.ShouldBindJSON(&req); err != nil {
+		code.InvalidParams.Failed(c)
+		return
+	}
+
+	if err := proxysvc.VirtualHostSetHeaders(c.Request.Context(), uri.ID, &req); err != nil {
+		err.(*code.Response).Failed(c)
+		return
+	}
+
+	code.Success.Success(nil, c)
+}
+
+// ProxyVirtualHostCors
+// @Tags      代理管理
+// @Summary   虚拟主机跨域策略
+// @Description 获取虚拟主机的跨域策略
+// @securityDefinitions.apikey ApiKeyAuth
+// @In        header
+// @Name      x-quebec-token
+// @Param     id  path      string  true  "虚拟主机ID"
+// @Produce   json
+// @Success   200  {object}  code.Response{code=number,data=response.ProxyCorsPolicyResp,message=string}  "50000,success"
+// @Router    /v1/proxy/virtual-host/{id}/cors [get]
+func (b *ProxyV1ApiGroup) ProxyVirtualHostCors(c *gin.Context) {
+
+	var uri request.IdReq
+	if err := c.ShouldBindUri(&uri); err != nil {
+		code.InvalidParams.Failed(c)
+		return
+	}
+
+	resp, err := proxysvc.VirtualHostCors(c.Request.Context(), uri.ID)
+	if err != nil {
+		err.(*code.Response).Failed(c)
+		return
+	}
+
+	code.Success.Success(resp, c)
+}
+
+// ProxyVirtualHostSetCors
+// @Tags      代理管理
+// @Summary   设置虚拟主机跨域策略
+// @Description 设置虚拟主机的跨域策略，作用于虚拟主机下未单独配置跨域策略的路由，允许来源为空时清除
+// @securityDefinitions.apikey ApiKeyAuth
+// @In        header
+// @Name      x-quebec-token
+// @Param     id    path      string                      true  "虚拟主机ID"
+// @Param     data  body      request.ProxyCorsPolicyReq  true  "跨域策略"
+// @Produce   json
+// @Success   200  {object}  code.Response{code=number,message=string}  "50000,success"
+// @Router    /v1/proxy/virtual-host/{id}/cors [put]
+func (b *ProxyV1ApiGroup) ProxyVirtualHostSetCors(c *gin.Context) {
+
+	var uri request.IdReq
+	if err := c.ShouldBindUri(&uri); err != nil {
+		code.InvalidParams.Failed(c)
+		return
+	}
+
+	var req request.ProxyCorsPolicyReq
+	if err := c.ShouldBindJSON(&req); err != nil {
+		code.InvalidParams.Failed(c)
+		return
+	}
+
+	if err := proxysvc.VirtualHostSetCors(c.Request.Context(), uri.ID, &req); err != nil {
+		err.(*code.Response).Failed(c)
+		return
+	}
+
+	code.Success.Success(nil, c)
+}
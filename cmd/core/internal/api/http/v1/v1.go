@@ -1,7 +1,11 @@
 package v1
 
-import "github.com/lyonmu/quebec/cmd/core/internal/api/http/v1/system"
+import (
+	"github.com/lyonmu/quebec/cmd/core/internal/api/http/v1/proxy"
+	"github.com/lyonmu/quebec/cmd/core/internal/api/http/v1/system"
+)
 
 type V1ApiGroup struct {
 	system.SystemV1ApiGroup
+	proxy.ProxyV1ApiGroup
 }
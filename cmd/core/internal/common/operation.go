@@ -23,4 +23,40 @@ const (
 	OperationRoleEnable          OperationType = 18 // 启用/禁用角色
 	OperationMenuEnable          OperationType = 19 // 启用/禁用菜单
 	OperationRoleBindMenus       OperationType = 20 // 角色绑定菜单
+	OperationListenerBindCluster OperationType = 21 // 监听器绑定网关集群
+	OperationRouteBindCluster    OperationType = 22 // 路由绑定网关集群
+	OperationProxyRollback       OperationType = 23 // 回滚代理配置修订
+	OperationRolloutPolicySave   OperationType = 24 // 保存灰度策略
+	OperationRolloutPolicyDelete OperationType = 25 // 删除灰度策略
+	OperationRolloutPromote      OperationType = 26 // 灰度发布全量
+	OperationRolloutAbort        OperationType = 27 // 中止灰度发布
+	OperationRouteSetTargets     OperationType = 28 // 设置路由加权分流
+	OperationRouteSetMatch       OperationType = 29 // 设置路由匹配条件
+	OperationRouteSetPath        OperationType = 30 // 设置路由路径匹配、重写与重定向
+	OperationRouteSetRetry       OperationType = 31 // 设置路由重试策略
+	OperationVirtualHostCreate   OperationType = 32 // 创建虚拟主机
+	OperationVirtualHostUpdate   OperationType = 33 // 更新虚拟主机
+	OperationVirtualHostDelete   OperationType = 34 // 删除虚拟主机
+	OperationRouteBindVhost      OperationType = 35 // 路由绑定虚拟主机
+	OperationRouteSetHeaders     OperationType = 36 // 设置路由头改写
+	OperationVhostSetHeaders     OperationType = 37 // 设置虚拟主机头改写
+	OperationRouteSetMirror      OperationType = 38 // 设置路由流量镜像
+	OperationRouteSetFault       OperationType = 39 // 设置路由故障注入
+	OperationRouteClearFault     OperationType = 40 // 清除路由故障注入
+	OperationRouteSetCors        OperationType = 41 // 设置路由跨域策略
+	OperationVhostSetCors        OperationType = 42 // 设置虚拟主机跨域策略
+	OperationRouteSetRateLimit   OperationType = 43 // 设置路由本地限流
+	OperationListenerRateLimit   OperationType = 44 // 设置监听器本地限流
+	OperationRateLimitRuleCreate OperationType = 45 // 创建全局限流规则
+	OperationRateLimitRuleUpdate OperationType = 46 // 更新全局限流规则
+	OperationRateLimitRuleDelete OperationType = 47 // 删除全局限流规则
+	OperationRouteSetRateActions OperationType = 48 // 设置路由全局限流描述符
+	OperationRouteSetPriority    OperationType = 49 // 设置路由匹配优先级
+	OperationRouteSetDirectResp  OperationType = 50 // 设置路由直接响应
+	OperationVhostMaintenance    OperationType = 51 // 设置虚拟主机维护模式
+	OperationClusterMaintenance  OperationType = 52 // 设置网关集群维护模式
+	OperationRouteSetHashPolicy  OperationType = 53 // 设置路由哈希策略
+	OperationUpstreamSetLb       OperationType = 54 // 设置上游服务负载均衡
+	OperationListenerCompression OperationType = 55 // 设置监听器响应压缩
+	OperationRouteCompression    OperationType = 56 // 设置路由响应压缩
 )
@@ -0,0 +1,121 @@
+package common
+
+import "github.com/lyonmu/quebec/pkg/constant"
+
+// HttpMatcher HTTP 路由的请求头或查询参数匹配条件
+type HttpMatcher struct {
+	Name       string                        `json:"name"`                  // 请求头或查询参数名称
+	Type       constant.ProxyHttpMatcherType `json:"type"`                  // 匹配方式 [1: 精确, 2: 正则, 3: 存在, 4: 不存在]
+	Value      string                        `json:"value,omitempty"`       // 匹配值，精确与正则匹配时必填
+	Invert     bool                          `json:"invert,omitempty"`      // 是否取反，仅请求头
+	IgnoreCase bool                          `json:"ignore_case,omitempty"` // 精确匹配时是否忽略大小写
+}
+
+// RetryPolicy HTTP 路由的重试策略
+type RetryPolicy struct {
+	RetryOn              []constant.ProxyRetry `json:"retry_on"`                         // 重试触发条件，如 5xx、gateway-error、reset
+	NumRetries           int                   `json:"num_retries"`                      // 最大重试次数
+	PerTryTimeoutMs      int                   `json:"per_try_timeout_ms,omitempty"`     // 单次重试超时(毫秒)，0 表示使用路由超时
+	RetriableStatusCodes []int                 `json:"retriable_status_codes,omitempty"` // 可重试的状态码，需同时配置 retriable-status-codes 条件
+	BackoffBaseMs        int                   `json:"backoff_base_ms,omitempty"`        // 退避基础间隔(毫秒)，0 表示使用 Envoy 默认值 25ms
+	BackoffMaxMs         int                   `json:"backoff_max_ms,omitempty"`         // 退避最大间隔(毫秒)，0 表示基础间隔的 10 倍
+}
+
+// HeaderMutation 路由或虚拟主机的请求头与响应头改写
+type HeaderMutation struct {
+	RequestHeadersToAdd     []HeaderValue `json:"request_headers_to_add,omitempty"`     // 添加的请求头
+	RequestHeadersToRemove  []string      `json:"request_headers_to_remove,omitempty"`  // 移除的请求头
+	ResponseHeadersToAdd    []HeaderValue `json:"response_headers_to_add,omitempty"`    // 添加的响应头
+	ResponseHeadersToRemove []string      `json:"response_headers_to_remove,omitempty"` // 移除的响应头
+}
+
+// HeaderValue 添加的请求头或响应头
+type HeaderValue struct {
+	Name   string                     `json:"name"`   // 头名称
+	Value  string                     `json:"value"`  // 头的值，支持 Envoy 格式化变量如 %DOWNSTREAM_REMOTE_ADDRESS%
+	Action constant.ProxyHeaderAction `json:"action"` // 添加方式 [1: 追加, 2: 覆盖, 3: 不存在时添加]
+}
+
+// FaultPolicy HTTP 路由的故障注入，到期后自动失效
+type FaultPolicy struct {
+	DelayMs         int     `json:"delay_ms,omitempty"`          // 注入的固定延迟(毫秒)，0 表示不注入延迟
+	DelayPercent    float64 `json:"delay_percent,omitempty"`     // 注入延迟的请求比例(%)
+	AbortHttpStatus int     `json:"abort_http_status,omitempty"` // 中断请求时返回的 HTTP 状态码，与 gRPC 状态码二选一
+	AbortGrpcStatus int     `json:"abort_grpc_status,omitempty"` // 中断请求时返回的 gRPC 状态码
+	AbortPercent    float64 `json:"abort_percent,omitempty"`     // 中断的请求比例(%)
+	HeaderName      string  `json:"header_name,omitempty"`       // 只对携带该请求头的请求注入故障，为空表示所有请求
+	HeaderValue     string  `json:"header_value,omitempty"`      // 请求头的值，为空表示只要求请求头存在
+	ExpiresAt       int64   `json:"expires_at"`                  // 失效时间(Unix 秒)
+}
+
+// CorsPolicy 路由或虚拟主机的跨域策略
+type CorsPolicy struct {
+	AllowOrigins     []CorsOrigin `json:"allow_origins"`               // 允许的来源
+	AllowMethods     []string     `json:"allow_methods,omitempty"`     // 允许的 HTTP 方法
+	AllowHeaders     []string     `json:"allow_headers,omitempty"`     // 允许的请求头
+	ExposeHeaders    []string     `json:"expose_headers,omitempty"`    // 允许浏览器读取的响应头
+	MaxAgeSeconds    int          `json:"max_age_seconds,omitempty"`   // 预检结果缓存时间(秒)，0 表示不设置
+	AllowCredentials bool         `json:"allow_credentials,omitempty"` // 是否允许携带凭证
+}
+
+// CorsOrigin 跨域允许的来源
+type CorsOrigin struct {
+	Type  constant.ProxyCorsOriginType `json:"type"`  // 匹配方式 [1: 精确, 2: 前缀, 3: 正则]
+	Value string                       `json:"value"` // 来源，如 https://app.example.com
+}
+
+// LocalRateLimit 路由或监听器的令牌桶本地限流，由每个 Envoy 独立计数
+type LocalRateLimit struct {
+	MaxTokens      int    `json:"max_tokens"`             // 令牌桶容量
+	TokensPerFill  int    `json:"tokens_per_fill"`        // 每次补充的令牌数
+	FillIntervalMs int    `json:"fill_interval_ms"`       // 补充间隔(毫秒)
+	StatusCode     int    `json:"status_code"`            // 被限流时返回的状态码
+	HeaderName     string `json:"header_name,omitempty"`  // 被限流时添加的响应头，为空表示不添加
+	HeaderValue    string `json:"header_value,omitempty"` // 响应头的值
+}
+
+// Compression 监听器的响应压缩，按客户端 Accept-Encoding 选择算法
+type Compression struct {
+	Algorithms       []constant.ProxyCompressionAlgorithm `json:"algorithms"`                   // 压缩算法，按优先级排列 [1: gzip, 2: brotli, 3: zstd]
+	ContentTypes     []string                             `json:"content_types,omitempty"`      // 压缩的响应类型，为空时使用 Envoy 默认的文本类型
+	MinContentLength int                                  `json:"min_content_length,omitempty"` // 触发压缩的最小响应长度(字节)，0 表示使用 Envoy 默认值 30
+	Level            int                                  `json:"level,omitempty"`              // 压缩级别 1-9，0 表示使用各算法的默认级别
+}
+
+// RateLimitAction 路由的全局限流描述符条目，按顺序生成一个描述符发送到 Gateway 的限流服务
+type RateLimitAction struct {
+	Type            constant.ProxyRateLimitActionType `json:"type"`                       // 生成方式 [1: 固定键值, 2: 请求头, 3: 客户端地址, 4: 查询参数]
+	DescriptorKey   string                            `json:"descriptor_key,omitempty"`   // 描述符键，固定键值默认为 generic_key，客户端地址固定为 remote_address
+	DescriptorValue string                            `json:"descriptor_value,omitempty"` // 固定键值的值
+	Name            string                            `json:"name,omitempty"`             // 请求头或查询参数名称
+}
+
+// RateLimitDescriptor 全局限流规则匹配的描述符条目，值为空表示按该键的每个不同值分别计数
+type RateLimitDescriptor struct {
+	Key   string `json:"key"`
+	Value string `json:"value,omitempty"`
+}
+
+// HashPolicy 一致性哈希的哈希策略，上游服务使用 RING_HASH 或 MAGLEV 时生效
+type HashPolicy struct {
+	Type             constant.ProxyHashPolicyType `json:"type"`                         // 哈希策略类型 [1: 请求头, 2: Cookie, 3: 客户端地址, 4: 查询参数]
+	Name             string                       `json:"name,omitempty"`               // 请求头、Cookie 或查询参数名称
+	CookieTtlSeconds int                          `json:"cookie_ttl_seconds,omitempty"` // 请求未携带 Cookie 时由 Envoy 生成的 Cookie 有效期(秒)，0 表示不生成
+	CookiePath       string                       `json:"cookie_path,omitempty"`        // 生成的 Cookie 的路径
+	Terminal         bool                         `json:"terminal,omitempty"`           // 得到哈希值后不再计算后续策略
+}
+
+// DirectResponse 由 Envoy 直接返回的固定响应，不转发到上游服务
+type DirectResponse struct {
+	StatusCode  int    `json:"status_code"`            // 状态码
+	Body        string `json:"body,omitempty"`         // 响应体
+	ContentType string `json:"content_type,omitempty"` // 响应体类型，为空时使用 text/plain; charset=utf-8
+}
+
+// Maintenance 虚拟主机或网关集群的维护模式，关闭时保留设置以便再次开启
+type Maintenance struct {
+	Enabled    bool           `json:"enabled"`               // 是否开启
+	Response   DirectResponse `json:"response"`              // 维护响应
+	AllowPaths []string       `json:"allow_paths,omitempty"` // 放行的路径前缀
+	AllowIPs   []string       `json:"allow_ips,omitempty"`   // 放行的客户端 IP 或 CIDR
+}
@@ -0,0 +1,18 @@
+package common
+
+// XdsStatus Envoy 节点对单个类型 xDS 资源的回执状态
+type XdsStatus struct {
+	VersionInfo      string `json:"version_info,omitempty"`      // 最近 ACK 的资源版本
+	Revision         int64  `json:"revision,omitempty"`          // 最近 ACK 的配置修订号
+	AckTime          int64  `json:"ack_time,omitempty"`          // 最近 ACK 时间
+	RejectedVersion  string `json:"rejected_version,omitempty"`  // 最近被 NACK 的资源版本
+	ErrorDetail      string `json:"error_detail,omitempty"`      // 最近 NACK 错误信息，再次 ACK 后清空
+	NackTime         int64  `json:"nack_time,omitempty"`         // 最近 NACK 时间
+	RejectedRevision int64  `json:"rejected_revision,omitempty"` // 最近被 NACK 的配置修订号
+}
+
+// TrafficCounter 节点累计处理的请求数与 5xx 响应数
+type TrafficCounter struct {
+	Requests int64 `json:"requests"` // 请求数
+	Errors   int64 `json:"errors"`   // 5xx 响应数
+}
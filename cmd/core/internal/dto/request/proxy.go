@@ -0,0 +1,271 @@
+package request
+
+import "github.com/lyonmu/quebec/pkg/constant"
+
+type ProxyGatewayNodeListReq struct {
+	ClusterID string `json:"cluster_id,omitempty" form:"cluster_id"` // 网关集群ID
+}
+
+type ProxyBindClusterReq struct {
+	ClusterID string `json:"cluster_id" form:"cluster_id"` // 网关集群ID (Envoy node.cluster)，为空表示所有集群
+}
+
+type ProxyRevisionPageReq struct {
+	AuthorID string `json:"author_id,omitempty" form:"author_id"`                                                                             // 发布人ID
+	Page     int    `json:"page,omitempty" binding:"required,min=1" form:"page" minimum:"1" default:"1"`                                      // 页码
+	PageSize int    `json:"page_size,omitempty" binding:"required,min=10,max=1000" form:"page_size" minimum:"10" maximum:"1000" default:"10"` // 每页条数
+}
+
+type ProxyRevisionReq struct {
+	Revision int64 `json:"revision" binding:"required,min=1" uri:"revision"` // 配置修订号
+}
+
+type ProxyRevisionDiffReq struct {
+	From int64 `json:"from" binding:"required,min=1" form:"from"` // 起始修订号
+	To   int64 `json:"to" binding:"required,min=1" form:"to"`     // 目标修订号
+}
+
+type ProxyRolloutPolicyReq struct {
+	ClusterID    string           `json:"cluster_id" binding:"required"`                                // 网关集群ID (Envoy node.cluster)
+	Percentage   int              `json:"percentage" binding:"min=0,max=100" minimum:"0" maximum:"100"` // 灰度节点比例(%)，指定灰度节点时忽略
+	NodeIDs      []string         `json:"node_ids,omitempty"`                                           // 指定的灰度节点 (Envoy node.id)
+	SoakSeconds  int              `json:"soak_seconds" binding:"min=0" minimum:"0" default:"300"`       // 观察时长(秒)
+	MaxErrorRate float64          `json:"max_error_rate" binding:"min=0,max=100" default:"1"`           // 最大错误率(%)
+	MinRequests  int64            `json:"min_requests" binding:"min=0" default:"100"`                   // 评估错误率所需的最少请求数
+	Status       constant.YesOrNo `json:"status" binding:"required,oneof=1 2" enums:"1,2"`              // 是否启用 [1: 启用, 2: 禁用]
+}
+
+type ProxyRolloutPageReq struct {
+	ClusterID string                      `json:"cluster_id,omitempty" form:"cluster_id"`                                                                           // 网关集群ID
+	Status    constant.ProxyRolloutStatus `json:"status,omitempty" form:"status"`                                                                                   // 状态 [1: 灰度中, 2: 已全量, 3: 已中止, 4: 已被取代]
+	Page      int                         `json:"page,omitempty" binding:"required,min=1" form:"page" minimum:"1" default:"1"`                                      // 页码
+	PageSize  int                         `json:"page_size,omitempty" binding:"required,min=10,max=1000" form:"page_size" minimum:"10" maximum:"1000" default:"10"` // 每页条数
+}
+
+type ProxyRenderReq struct {
+	ClusterID       string `json:"cluster_id,omitempty" form:"cluster_id"`                         // 网关集群ID (Envoy node.cluster)，为空时仅渲染未绑定集群的配置
+	NodeID          string `json:"node_id,omitempty" form:"node_id"`                               // 网关节点ID (Envoy node.id)，指定时按节点所属集群及灰度状态渲染，忽略 cluster_id
+	Revision        int64  `json:"revision,omitempty" form:"revision" binding:"min=0" minimum:"0"` // 配置修订号，为空表示数据库中即将发布的当前配置
+	AccessLog       bool   `json:"access_log,omitempty" form:"access_log"`                         // 是否为监听器附加 gRPC 访问日志 (Gateway 以 debug 日志级别运行时开启)
+	GlobalRateLimit bool   `json:"global_rate_limit,omitempty" form:"global_rate_limit"`           // 是否添加全局限流过滤器 (Gateway 以 --gateway.global_rate_limit 运行时开启)
+}
+
+type ProxyBootstrapReq struct {
+	ClusterID string                `json:"cluster_id" binding:"required"`                                                         // 网关集群ID (Envoy node.cluster)
+	NodeID    string                `json:"node_id" binding:"required"`                                                            // Envoy 节点ID (node.id)
+	XdsHost   string                `json:"xds_host" binding:"required"`                                                           // Gateway xDS 服务地址，支持域名
+	XdsPort   uint32                `json:"xds_port,omitempty" binding:"omitempty,min=1,max=65535" default:"59025"`                // Gateway xDS 服务端口，即 Gateway 的 --gateway.port，为空时使用 59025
+	AdminPort uint32                `json:"admin_port,omitempty" binding:"max=65535" default:"19901"`                              // Envoy 管理接口端口
+	Delta     bool                  `json:"delta,omitempty"`                                                                       // 是否使用 Delta xDS
+	XdsToken  string                `json:"xds_token,omitempty"`                                                                   // xDS 连接令牌，与 Gateway 的 --gateway.xds_token 一致
+	TLS       *ProxyBootstrapTLSReq `json:"tls,omitempty"`                                                                         // xDS 连接的 TLS 配置，为空不启用
+	Format    string                `json:"format,omitempty" binding:"omitempty,oneof=yaml json" enums:"yaml,json" default:"yaml"` // 输出格式
+}
+
+type ProxyBootstrapTLSReq struct {
+	CACert     string `json:"ca_cert,omitempty"`     // 校验 Gateway 证书的 CA 证书路径
+	ClientCert string `json:"client_cert,omitempty"` // 双向认证的客户端证书路径
+	ClientKey  string `json:"client_key,omitempty"`  // 双向认证的客户端私钥路径
+	SNI        string `json:"sni,omitempty"`         // TLS SNI
+}
+
+type ProxyRouteTargetsReq struct {
+	Targets []ProxyRouteTargetReq `json:"targets" binding:"dive"` // 加权分流目标，权重之和为100；为空表示取消分流，全部流量转发到路由的上游服务
+}
+
+type ProxyRouteTargetReq struct {
+	UpstreamID string `json:"upstream_id" binding:"required"`                           // 上游服务ID
+	Weight     int    `json:"weight" binding:"min=0,max=100" minimum:"0" maximum:"100"` // 流量权重(%)
+}
+
+type ProxyRouteMatchReq struct {
+	IgnoreCase      bool                  `json:"ignore_case,omitempty"`                                                                       // 路径匹配是否忽略大小写
+	Methods         []string              `json:"methods,omitempty" binding:"dive,oneof=GET HEAD POST PUT PATCH DELETE CONNECT OPTIONS TRACE"` // 匹配的 HTTP 方法，为空表示不限制
+	Headers         []ProxyHttpMatcherReq `json:"headers,omitempty" binding:"dive"`                                                            // 请求头匹配条件，全部满足时路由才匹配
+	QueryParameters []ProxyHttpMatcherReq `json:"query_parameters,omitempty" binding:"dive"`                                                   // 查询参数匹配条件，全部满足时路由才匹配
+}
+
+type ProxyHttpMatcherReq struct {
+	Name       string                        `json:"name" binding:"required"`                               // 请求头或查询参数名称
+	Type       constant.ProxyHttpMatcherType `json:"type" binding:"required,oneof=1 2 3 4" enums:"1,2,3,4"` // 匹配方式 [1: 精确, 2: 正则, 3: 存在, 4: 不存在(仅请求头)]
+	Value      string                        `json:"value,omitempty"`                                       // 匹配值，精确与正则匹配时使用
+	Invert     bool                          `json:"invert,omitempty"`                                      // 是否取反，仅请求头
+	IgnoreCase bool                          `json:"ignore_case,omitempty"`                                 // 精确匹配时是否忽略大小写
+}
+
+type ProxyRoutePathReq struct {
+	MatchType         constant.ProxyHttpRouteMatchType `json:"match_type" binding:"required,oneof=1 2 3" enums:"1,2,3"`                             // 路径匹配类型 [1: 前缀, 2: 精确, 3: 正则(RE2)]
+	MatchPattern      string                           `json:"match_pattern" binding:"required"`                                                    // 路径匹配规则，如 /api/v1/*、/healthz、^/v(\d+)/users$
+	EnablePathRewrite constant.YesOrNo                 `json:"enable_path_rewrite" binding:"required,oneof=1 2" enums:"1,2"`                        // 是否启用路径重写 [1: 启用, 2: 禁用]
+	PathRewrite       string                           `json:"path_rewrite,omitempty"`                                                              // 路径重写规则，如 /v1/ 或 /api/v1/* /v1/*；正则匹配时可用 \1 引用分组
+	EnableRedirect    constant.YesOrNo                 `json:"enable_redirect" binding:"required,oneof=1 2" enums:"1,2"`                            // 是否启用重定向 [1: 启用, 2: 禁用]，启用后不再转发到上游服务
+	RedirectURL       string                           `json:"redirect_url,omitempty"`                                                              // 重定向地址，完整 URL 或以 / 开头的路径
+	RedirectCode      int                              `json:"redirect_code,omitempty" binding:"omitempty,oneof=301 302 303 307 308" default:"301"` // 重定向状态码
+}
+
+type ProxyRouteRetryReq struct {
+	RetryOn              []constant.ProxyRetry `json:"retry_on" binding:"dive,oneof=5xx gateway-error connect-failure retriable-4xx refused-stream reset retriable-status-codes"` // 重试触发条件，为空表示关闭重试
+	NumRetries           int                   `json:"num_retries" binding:"min=0,max=10" minimum:"0" maximum:"10" default:"3"`                                                   // 最大重试次数
+	PerTryTimeoutMs      int                   `json:"per_try_timeout_ms,omitempty" binding:"min=0" minimum:"0" default:"0"`                                                      // 单次重试超时(毫秒)，0 表示使用路由超时
+	RetriableStatusCodes []int                 `json:"retriable_status_codes,omitempty" binding:"dive,min=100,max=599"`                                                           // 可重试的状态码，需同时配置 retriable-status-codes 条件
+	BackoffBaseMs        int                   `json:"backoff_base_ms,omitempty" binding:"min=0" minimum:"0"`                                                                     // 退避基础间隔(毫秒)，0 表示使用 Envoy 默认值 25ms
+	BackoffMaxMs         int                   `json:"backoff_max_ms,omitempty" binding:"min=0" minimum:"0"`                                                                      // 退避最大间隔(毫秒)，0 表示基础间隔的 10 倍
+}
+
+type ProxyVirtualHostListReq struct {
+	ListenerID string `json:"listener_id,omitempty" form:"listener_id"` // L7 监听器ID
+}
+
+type ProxyVirtualHostAddReq struct {
+	Name        string           `json:"name" binding:"required"`                                                // 虚拟主机名称
+	Description string           `json:"description,omitempty"`                                                  // 虚拟主机描述
+	ListenerID  string           `json:"listener_id" binding:"required"`                                         // 所属 L7 监听器ID
+	Domains     []string         `json:"domains" binding:"required,min=1"`                                       // 匹配的域名，支持 *.example.com、example.* 与 *
+	Status      constant.YesOrNo `json:"status,omitempty" binding:"omitempty,oneof=1 2" enums:"1,2" default:"1"` // 是否启用 [1: 启用, 2: 禁用]
+}
+
+type ProxyVirtualHostUpdateReq struct {
+	Name        *string          `json:"name,omitempty"`                                             // 虚拟主机名称
+	Description *string          `json:"description,omitempty"`                                      // 虚拟主机描述
+	Domains     []string         `json:"domains,omitempty" binding:"omitempty,min=1"`                // 匹配的域名
+	Status      constant.YesOrNo `json:"status,omitempty" binding:"omitempty,oneof=1 2" enums:"1,2"` // 是否启用 [1: 启用, 2: 禁用]
+}
+
+type ProxyRoutePriorityReq struct {
+	Priority int `json:"priority" binding:"min=-1000,max=1000" minimum:"-1000" maximum:"1000" default:"0"` // 匹配优先级，数值越大越先匹配
+}
+
+type ProxyRouteBindVhostReq struct {
+	VirtualHostID string `json:"virtual_host_id"` // 虚拟主机ID，为空表示所有监听器的默认虚拟主机(*)
+}
+
+type ProxyHeaderMutationReq struct {
+	RequestHeadersToAdd     []ProxyHeaderValueReq `json:"request_headers_to_add,omitempty" binding:"dive"`              // 添加的请求头
+	RequestHeadersToRemove  []string              `json:"request_headers_to_remove,omitempty" binding:"dive,required"`  // 移除的请求头
+	ResponseHeadersToAdd    []ProxyHeaderValueReq `json:"response_headers_to_add,omitempty" binding:"dive"`             // 添加的响应头
+	ResponseHeadersToRemove []string              `json:"response_headers_to_remove,omitempty" binding:"dive,required"` // 移除的响应头
+}
+
+type ProxyHeaderValueReq struct {
+	Name   string                     `json:"name" binding:"required"`                                         // 头名称
+	Value  string                     `json:"value"`                                                           // 头的值，支持 Envoy 格式化变量如 %DOWNSTREAM_REMOTE_ADDRESS%，%% 表示字面量 %
+	Action constant.ProxyHeaderAction `json:"action" binding:"required,oneof=1 2 3" enums:"1,2,3" default:"2"` // 添加方式 [1: 已存在时追加, 2: 已存在时覆盖, 3: 仅在不存在时添加]
+}
+
+type ProxyRouteMirrorReq struct {
+	UpstreamID string  `json:"upstream_id"`                                                             // 影子上游服务ID，为空表示关闭镜像
+	Percent    float64 `json:"percent" binding:"min=0,max=100" minimum:"0" maximum:"100" default:"100"` // 镜像的请求比例(%)，支持两位小数
+}
+
+type ProxyRouteFaultReq struct {
+	DelayMs         int     `json:"delay_ms,omitempty" binding:"min=0" minimum:"0"`                                  // 注入的固定延迟(毫秒)，0 表示不注入延迟
+	DelayPercent    float64 `json:"delay_percent,omitempty" binding:"min=0,max=100" minimum:"0" maximum:"100"`       // 注入延迟的请求比例(%)
+	AbortHttpStatus int     `json:"abort_http_status,omitempty" binding:"omitempty,min=200,max=599"`                 // 中断请求时返回的 HTTP 状态码，与 gRPC 状态码二选一
+	AbortGrpcStatus int     `json:"abort_grpc_status,omitempty" binding:"omitempty,min=1,max=16"`                    // 中断请求时返回的 gRPC 状态码
+	AbortPercent    float64 `json:"abort_percent,omitempty" binding:"min=0,max=100" minimum:"0" maximum:"100"`       // 中断的请求比例(%)
+	HeaderName      string  `json:"header_name,omitempty"`                                                           // 只对携带该请求头的请求注入故障，为空表示所有请求
+	HeaderValue     string  `json:"header_value,omitempty"`                                                          // 请求头的值，为空表示只要求请求头存在
+	DurationMinutes int     `json:"duration_minutes" binding:"required,min=1,max=10080" minimum:"1" maximum:"10080"` // 故障持续时间(分钟)，到期后自动移除，最长 7 天
+}
+
+type ProxyCorsPolicyReq struct {
+	AllowOrigins     []ProxyCorsOriginReq `json:"allow_origins" binding:"dive"`                                                        // 允许的来源，为空表示清除跨域策略
+	AllowMethods     []string             `json:"allow_methods,omitempty" binding:"dive,oneof=GET HEAD POST PUT PATCH DELETE OPTIONS"` // 允许的 HTTP 方法
+	AllowHeaders     []string             `json:"allow_headers,omitempty" binding:"dive,required"`                                     // 允许的请求头
+	ExposeHeaders    []string             `json:"expose_headers,omitempty" binding:"dive,required"`                                    // 允许浏览器读取的响应头
+	MaxAgeSeconds    int                  `json:"max_age_seconds,omitempty" binding:"min=0" minimum:"0"`                               // 预检结果缓存时间(秒)，0 表示不设置
+	AllowCredentials bool                 `json:"allow_credentials,omitempty"`                                                         // 是否允许携带凭证
+}
+
+type ProxyCorsOriginReq struct {
+	Type  constant.ProxyCorsOriginType `json:"type" binding:"required,oneof=1 2 3" enums:"1,2,3"` // 匹配方式 [1: 精确(* 表示所有来源), 2: 前缀, 3: 正则]
+	Value string                       `json:"value" binding:"required"`                          // 来源，如 https://app.example.com
+}
+
+type ProxyLocalRateLimitReq struct {
+	MaxTokens      int    `json:"max_tokens" binding:"min=0" minimum:"0"`                             // 令牌桶容量，0 表示清除本地限流
+	TokensPerFill  int    `json:"tokens_per_fill,omitempty" binding:"min=0" minimum:"0"`              // 每次补充的令牌数，0 表示补满令牌桶
+	FillIntervalMs int    `json:"fill_interval_ms,omitempty" binding:"omitempty,min=50" minimum:"50"` // 补充间隔(毫秒)
+	StatusCode     int    `json:"status_code,omitempty" binding:"omitempty,min=400,max=599"`          // 被限流时返回的状态码，默认 429
+	HeaderName     string `json:"header_name,omitempty"`                                              // 被限流时添加的响应头，为空表示不添加
+	HeaderValue    string `json:"header_value,omitempty"`                                             // 响应头的值
+}
+
+type ProxyRateLimitRuleAddReq struct {
+	Name            string                        `json:"name" binding:"required"`                                                // 限流规则名称
+	Description     string                        `json:"description,omitempty"`                                                  // 限流规则描述
+	Descriptors     []ProxyRateLimitDescriptorReq `json:"descriptors" binding:"required,min=1,dive"`                              // 匹配的描述符条目，需与路由的描述符条目顺序一致
+	Unit            constant.ProxyRateLimitUnit   `json:"unit" binding:"required,oneof=1 2 3 4" enums:"1,2,3,4"`                  // 计数周期 [1: 秒, 2: 分钟, 3: 小时, 4: 天]
+	RequestsPerUnit uint32                        `json:"requests_per_unit" binding:"required,min=1" minimum:"1"`                 // 每个计数周期允许的请求数
+	Status          constant.YesOrNo              `json:"status,omitempty" binding:"omitempty,oneof=1 2" enums:"1,2" default:"1"` // 是否启用 [1: 启用, 2: 禁用]
+}
+
+type ProxyRateLimitRuleUpdateReq struct {
+	Name            *string                       `json:"name,omitempty"`                                                   // 限流规则名称
+	Description     *string                       `json:"description,omitempty"`                                            // 限流规则描述
+	Descriptors     []ProxyRateLimitDescriptorReq `json:"descriptors,omitempty" binding:"omitempty,min=1,dive"`             // 匹配的描述符条目
+	Unit            constant.ProxyRateLimitUnit   `json:"unit,omitempty" binding:"omitempty,oneof=1 2 3 4" enums:"1,2,3,4"` // 计数周期 [1: 秒, 2: 分钟, 3: 小时, 4: 天]
+	RequestsPerUnit uint32                        `json:"requests_per_unit,omitempty" binding:"omitempty,min=1"`            // 每个计数周期允许的请求数
+	Status          constant.YesOrNo              `json:"status,omitempty" binding:"omitempty,oneof=1 2" enums:"1,2"`       // 是否启用 [1: 启用, 2: 禁用]
+}
+
+type ProxyRateLimitDescriptorReq struct {
+	Key   string `json:"key" binding:"required"` // 描述符键
+	Value string `json:"value,omitempty"`        // 描述符值，为空表示按该键的每个不同值分别计数
+}
+
+type ProxyRateLimitActionsReq struct {
+	Actions []ProxyRateLimitActionReq `json:"actions" binding:"dive"` // 描述符条目，按顺序组成一个描述符，为空表示不进行全局限流
+}
+
+type ProxyRateLimitActionReq struct {
+	Type            constant.ProxyRateLimitActionType `json:"type" binding:"required,oneof=1 2 3 4" enums:"1,2,3,4"` // 生成方式 [1: 固定键值, 2: 请求头, 3: 客户端地址, 4: 查询参数]
+	DescriptorKey   string                            `json:"descriptor_key,omitempty"`                              // 描述符键，固定键值默认为 generic_key，客户端地址固定为 remote_address
+	DescriptorValue string                            `json:"descriptor_value,omitempty"`                            // 固定键值的值
+	Name            string                            `json:"name,omitempty"`                                        // 请求头或查询参数名称
+}
+
+type ProxyDirectResponseReq struct {
+	StatusCode  int    `json:"status_code" binding:"omitempty,min=200,max=599" minimum:"200" maximum:"599"` // 直接返回的状态码，0 表示清除直接响应并恢复转发
+	Body        string `json:"body,omitempty" binding:"max=4096"`                                           // 响应体，不超过 4KB
+	ContentType string `json:"content_type,omitempty"`                                                      // 响应体类型，默认 text/plain; charset=utf-8
+}
+
+type ProxyMaintenanceReq struct {
+	Enabled     bool     `json:"enabled"`                                                                               // 是否开启维护模式，关闭时保留设置
+	StatusCode  int      `json:"status_code,omitempty" binding:"omitempty,min=200,max=599" minimum:"200" maximum:"599"` // 维护响应的状态码，默认 503
+	Body        string   `json:"body,omitempty" binding:"max=4096"`                                                     // 维护响应体，不超过 4KB
+	ContentType string   `json:"content_type,omitempty"`                                                                // 维护响应体类型，默认 text/plain; charset=utf-8
+	AllowPaths  []string `json:"allow_paths,omitempty" binding:"dive,startswith=/"`                                     // 放行的路径前缀，如 /healthz
+	AllowIPs    []string `json:"allow_ips,omitempty" binding:"dive,ip|cidr"`                                            // 放行的客户端 IP 或 CIDR
+}
+
+type ProxyHashPoliciesReq struct {
+	Policies []ProxyHashPolicyReq `json:"policies" binding:"dive"` // 哈希策略，按顺序计算哈希值，为空表示清除
+}
+
+type ProxyHashPolicyReq struct {
+	Type             constant.ProxyHashPolicyType `json:"type" binding:"required,oneof=1 2 3 4" enums:"1,2,3,4"`    // 哈希策略类型 [1: 请求头, 2: Cookie, 3: 客户端地址, 4: 查询参数]
+	Name             string                       `json:"name,omitempty"`                                           // 请求头、Cookie 或查询参数名称
+	CookieTtlSeconds int                          `json:"cookie_ttl_seconds,omitempty" binding:"min=0" minimum:"0"` // 请求未携带 Cookie 时由 Envoy 生成的 Cookie 有效期(秒)，0 表示不生成
+	CookiePath       string                       `json:"cookie_path,omitempty"`                                    // 生成的 Cookie 的路径，如 /
+	Terminal         bool                         `json:"terminal,omitempty"`                                       // 得到哈希值后不再计算后续策略
+}
+
+type ProxyUpstreamLbReq struct {
+	LbPolicy        constant.ProxyLbPolicy `json:"lb_policy" binding:"required,oneof=1 2 3 4 5" enums:"1,2,3,4,5"` // 负载均衡策略 [1: ROUND_ROBIN, 2: LEAST_REQUEST, 3: RANDOM, 4: RING_HASH, 5: MAGLEV]
+	RingMinSize     uint64                 `json:"ring_min_size,omitempty"`                                        // 环哈希的最小环大小，0 表示使用 Envoy 默认值 1024
+	RingMaxSize     uint64                 `json:"ring_max_size,omitempty"`                                        // 环哈希的最大环大小，0 表示使用 Envoy 默认值 8388608
+	MaglevTableSize uint64                 `json:"maglev_table_size,omitempty"`                                    // Maglev 查找表大小，必须为质数，0 表示使用 Envoy 默认值 65537
+}
+
+type ProxyCompressionReq struct {
+	Algorithms       []constant.ProxyCompressionAlgorithm `json:"algorithms" binding:"dive,oneof=1 2 3" enums:"1,2,3"`           // 压缩算法，按优先级排列 [1: gzip, 2: brotli, 3: zstd]，为空表示关闭响应压缩
+	ContentTypes     []string                             `json:"content_types,omitempty" binding:"dive,required"`               // 压缩的响应类型，如 application/json，为空时使用 Envoy 默认的文本类型
+	MinContentLength int                                  `json:"min_content_length,omitempty" binding:"min=0" minimum:"0"`      // 触发压缩的最小响应长度(字节)，0 表示使用 Envoy 默认值 30
+	Level            int                                  `json:"level,omitempty" binding:"min=0,max=9" minimum:"0" maximum:"9"` // 压缩级别 1-9，0 表示使用各算法的默认级别
+}
+
+type ProxyRouteCompressionReq struct {
+	DisableCompression constant.YesOrNo `json:"disable_compression" binding:"required,oneof=1 2" enums:"1,2"` // 是否关闭该路由的响应压缩 [1: 是, 2: 否]
+}
@@ -0,0 +1,407 @@
+package response
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/lyonmu/quebec/cmd/core/internal/common"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent"
+	v1 "github.com/lyonmu/quebec/idl/router/v1"
+	"github.com/lyonmu/quebec/pkg/constant"
+)
+
+type ProxyGatewayClusterResp struct {
+	ID                     string `json:"id"`                        // ID
+	ClusterID              string `json:"cluster_id"`                // 集群ID (Envoy node.cluster)
+	GatewayID              int64  `json:"gateway_id"`                // 网关ID
+	ClusterCreateTime      int64  `json:"cluster_create_time"`       // 创建时间
+	ClusterLastRequestTime int64  `json:"cluster_last_request_time"` // 最新请求时间
+	Maintenance            bool   `json:"maintenance"`               // 是否开启了维护模式
+}
+
+func (r *ProxyGatewayClusterResp) LoadDb(e *ent.CoreGatewayCluster) {
+	r.ID = e.ID
+	r.ClusterID = e.ClusterID
+	r.GatewayID = e.GatewayID
+	r.ClusterCreateTime = e.ClusterCreateTime
+	r.ClusterLastRequestTime = e.ClusterLastRequestTime
+	r.Maintenance = e.Maintenance != nil && e.Maintenance.Enabled
+}
+
+type ProxyGatewayNodeResp struct {
+	ID                  string                      `json:"id"`                     // ID
+	NodeID              string                      `json:"node_id"`                // 节点ID (Envoy node.id)
+	ClusterID           string                      `json:"cluster_id"`             // 集群ID (Envoy node.cluster)
+	GatewayID           int64                       `json:"gateway_id"`             // 网关ID
+	NodeRegisterTime    int64                       `json:"node_register_time"`     // 注册时间
+	NodeLastRequestTime int64                       `json:"node_last_request_time"` // 最新请求时间
+	AppliedRevision     int64                       `json:"applied_revision"`       // 最近 ACK 的配置修订号
+	LastError           string                      `json:"last_error,omitempty"`   // 最近一次 NACK 错误信息
+	XdsStatus           map[string]common.XdsStatus `json:"xds_status,omitempty"`   // 各类型 xDS 资源的回执状态
+}
+
+func (r *ProxyGatewayNodeResp) LoadDb(e *ent.CoreGatewayNode) {
+	r.ID = e.ID
+	r.NodeID = e.NodeID
+	r.ClusterID = e.ClusterID
+	r.GatewayID = e.GatewayID
+	r.NodeRegisterTime = e.NodeRegisterTime
+	r.NodeLastRequestTime = e.NodeLastRequestTime
+	r.AppliedRevision = e.AppliedRevision
+	r.LastError = e.LastError
+	r.XdsStatus = e.XdsStatus
+}
+
+type ProxyGatewayResp struct {
+	ID               string           `json:"id"`                   // ID
+	GatewayID        int64            `json:"gateway_id"`           // 网关ID
+	AppliedRevision  int64            `json:"applied_revision"`     // 网关已应用的配置修订号
+	RejectedRevision int64            `json:"rejected_revision"`    // 最近被网关拒绝的配置修订号
+	LastError        string           `json:"last_error,omitempty"` // 最近一次配置被拒绝的原因
+	LastSyncTime     int64            `json:"last_sync_time"`       // 最近一次配置回执时间
+	Status           constant.YesOrNo `json:"status"`               // 是否在线 [1: 在线, 2: 离线]
+	GlobalRateLimit  constant.YesOrNo `json:"global_rate_limit"`    // 是否提供全局限流服务 [1: 是, 2: 否]
+}
+
+func (r *ProxyGatewayResp) LoadDb(e *ent.CoreGateway) {
+	r.ID = e.ID
+	r.GatewayID = e.GatewayID
+	r.AppliedRevision = e.AppliedRevision
+	r.RejectedRevision = e.RejectedRevision
+	r.LastError = e.LastError
+	r.LastSyncTime = e.LastSyncTime
+	r.Status = e.Status
+	r.GlobalRateLimit = e.GlobalRateLimit
+}
+
+type ProxyRevisionResp struct {
+	ID           string                       `json:"id"`                      // ID
+	Revision     int64                        `json:"revision"`                // 配置修订号
+	Action       constant.ProxyRevisionAction `json:"action"`                  // 修订来源 [1: 发布, 2: 回滚]
+	RollbackFrom int64                        `json:"rollback_from,omitempty"` // 回滚的目标修订号
+	AuthorID     string                       `json:"author_id,omitempty"`     // 发布人ID
+	AuthorName   string                       `json:"author_name,omitempty"`   // 发布人名称
+	Summary      string                       `json:"summary,omitempty"`       // 变更摘要
+	CreatedAt    int64                        `json:"created_at"`              // 发布时间
+}
+
+func (r *ProxyRevisionResp) LoadDb(e *ent.CoreProxyRevision) {
+	r.ID = e.ID
+	r.Revision = e.Revision
+	r.Action = e.Action
+	r.RollbackFrom = e.RollbackFrom
+	r.AuthorID = e.AuthorID
+	r.AuthorName = e.AuthorName
+	r.Summary = e.Summary
+	r.CreatedAt = e.CreatedAt.UnixMilli()
+}
+
+type ProxyRevisionListResp struct {
+	Total    int                  `json:"total,omitempty"`     // 总条数
+	Items    []*ProxyRevisionResp `json:"items,omitempty"`     // 修订列表
+	Page     int                  `json:"page,omitempty"`      // 页码
+	PageSize int                  `json:"page_size,omitempty"` // 每页条数
+}
+
+type ProxyRevisionDetailResp struct {
+	ProxyRevisionResp
+	Config *v1.ProxyConfig `json:"config"` // 完整代理配置
+}
+
+type ProxyResourceRef struct {
+	ID   string `json:"id"`   // 资源ID
+	Name string `json:"name"` // 资源名称
+}
+
+type ProxyFieldChange struct {
+	Field string `json:"field"`          // 字段名
+	From  any    `json:"from,omitempty"` // 修改前的值
+	To    any    `json:"to,omitempty"`   // 修改后的值
+}
+
+type ProxyResourceChange struct {
+	ProxyResourceRef
+	Fields []ProxyFieldChange `json:"fields"` // 字段级修改
+}
+
+type ProxyResourceDiff struct {
+	Added    []ProxyResourceRef    `json:"added"`    // 新增的资源
+	Removed  []ProxyResourceRef    `json:"removed"`  // 删除的资源
+	Modified []ProxyResourceChange `json:"modified"` // 修改的资源
+}
+
+type ProxyRevisionDiffResp struct {
+	From                int64              `json:"from"`                 // 起始修订号
+	To                  int64              `json:"to"`                   // 目标修订号
+	Upstreams           *ProxyResourceDiff `json:"upstreams"`            // 上游服务
+	HttpRoutes          *ProxyResourceDiff `json:"http_routes"`          // HTTP 路由
+	L7Listeners         *ProxyResourceDiff `json:"l7_listeners"`         // L7 监听器
+	Certs               *ProxyResourceDiff `json:"certs"`                // 证书
+	VirtualHosts        *ProxyResourceDiff `json:"virtual_hosts"`        // 虚拟主机
+	RateLimitRules      *ProxyResourceDiff `json:"rate_limit_rules"`     // 全局限流规则
+	ClusterMaintenances *ProxyResourceDiff `json:"cluster_maintenances"` // 网关集群维护模式
+}
+
+type ProxyRolloutPolicyResp struct {
+	ID           string           `json:"id"`                 // ID
+	ClusterID    string           `json:"cluster_id"`         // 网关集群ID
+	Percentage   int              `json:"percentage"`         // 灰度节点比例(%)
+	NodeIDs      []string         `json:"node_ids,omitempty"` // 指定的灰度节点
+	SoakSeconds  int              `json:"soak_seconds"`       // 观察时长(秒)
+	MaxErrorRate float64          `json:"max_error_rate"`     // 最大错误率(%)
+	MinRequests  int64            `json:"min_requests"`       // 评估错误率所需的最少请求数
+	Status       constant.YesOrNo `json:"status"`             // 是否启用 [1: 启用, 2: 禁用]
+}
+
+func (r *ProxyRolloutPolicyResp) LoadDb(e *ent.CoreProxyRolloutPolicy) {
+	r.ID = e.ID
+	r.ClusterID = e.ClusterID
+	r.Percentage = e.Percentage
+	r.NodeIDs = e.NodeIds
+	r.SoakSeconds = e.SoakSeconds
+	r.MaxErrorRate = e.MaxErrorRate
+	r.MinRequests = e.MinRequests
+	r.Status = e.Status
+}
+
+type ProxyRolloutResp struct {
+	ID             string                      `json:"id"`                    // ID
+	ClusterID      string                      `json:"cluster_id"`            // 网关集群ID
+	Revision       int64                       `json:"revision"`              // 灰度中的配置修订号
+	StableRevision int64                       `json:"stable_revision"`       // 稳定修订号
+	CanaryNodeIDs  []string                    `json:"canary_node_ids"`       // 灰度节点
+	SoakSeconds    int                         `json:"soak_seconds"`          // 观察时长(秒)
+	MaxErrorRate   float64                     `json:"max_error_rate"`        // 最大错误率(%)
+	MinRequests    int64                       `json:"min_requests"`          // 评估错误率所需的最少请求数
+	Requests       int64                       `json:"requests"`              // 灰度期间灰度节点的请求数
+	Errors         int64                       `json:"errors"`                // 灰度期间灰度节点的 5xx 响应数
+	ErrorRate      float64                     `json:"error_rate"`            // 灰度期间的错误率(%)
+	Status         constant.ProxyRolloutStatus `json:"status"`                // 状态 [1: 灰度中, 2: 已全量, 3: 已中止, 4: 已被取代]
+	Reason         string                      `json:"reason,omitempty"`      // 结束原因
+	StartedAt      int64                       `json:"started_at"`            // 开始时间
+	FinishedAt     int64                       `json:"finished_at,omitempty"` // 结束时间
+	SoakRemaining  int64                       `json:"soak_remaining"`        // 剩余观察时长(秒)，仅灰度中有效
+}
+
+func (r *ProxyRolloutResp) LoadDb(e *ent.CoreProxyRollout) {
+	r.ID = e.ID
+	r.ClusterID = e.ClusterID
+	r.Revision = e.Revision
+	r.StableRevision = e.StableRevision
+	r.CanaryNodeIDs = e.CanaryNodeIds
+	r.SoakSeconds = e.SoakSeconds
+	r.MaxErrorRate = e.MaxErrorRate
+	r.MinRequests = e.MinRequests
+	r.Requests = e.Requests
+	r.Errors = e.Errors
+	if e.Requests > 0 {
+		r.ErrorRate = float64(e.Errors) * 100 / float64(e.Requests)
+	}
+	r.Status = e.Status
+	r.Reason = e.Reason
+	r.StartedAt = e.StartedAt
+	r.FinishedAt = e.FinishedAt
+	if e.Status == constant.RolloutStatusInProgress {
+		r.SoakRemaining = max(e.StartedAt+int64(e.SoakSeconds)-time.Now().Unix(), 0)
+	}
+}
+
+type ProxyRolloutListResp struct {
+	Total    int                 `json:"total,omitempty"`     // 总条数
+	Items    []*ProxyRolloutResp `json:"items,omitempty"`     // 灰度发布列表
+	Page     int                 `json:"page,omitempty"`      // 页码
+	PageSize int                 `json:"page_size,omitempty"` // 每页条数
+}
+
+type ProxyRolloutNodeResp struct {
+	NodeID          string `json:"node_id"`              // 节点ID (Envoy node.id)
+	Online          bool   `json:"online"`               // 是否在线
+	AppliedRevision int64  `json:"applied_revision"`     // 最近 ACK 的配置修订号
+	LastError       string `json:"last_error,omitempty"` // 最近一次 NACK 错误信息
+	Requests        int64  `json:"requests"`             // 灰度期间的请求数
+	Errors          int64  `json:"errors"`               // 灰度期间的 5xx 响应数
+}
+
+type ProxyRolloutDetailResp struct {
+	ProxyRolloutResp
+	Nodes []*ProxyRolloutNodeResp `json:"nodes"` // 灰度节点进度
+}
+
+type ProxyRenderError struct {
+	Entity  string `json:"entity"`         // 出错的实体类型 [upstream: 上游服务, http_route: HTTP 路由, l7_listener: L7 监听器, virtual_host: 虚拟主机, snapshot: 资源引用一致性]
+	ID      string `json:"id,omitempty"`   // 实体ID
+	Name    string `json:"name,omitempty"` // 实体名称
+	Message string `json:"message"`        // 错误信息
+}
+
+type ProxyRenderResp struct {
+	ClusterID string             `json:"cluster_id"`                           // 网关集群ID
+	NodeID    string             `json:"node_id,omitempty"`                    // 网关节点ID
+	Revision  int64              `json:"revision"`                             // 渲染的配置修订号，0 表示尚未发布的当前配置
+	Valid     bool               `json:"valid"`                                // 是否通过校验
+	Errors    []ProxyRenderError `json:"errors"`                               // 校验错误，按出错的实体列出
+	Listeners []json.RawMessage  `json:"listeners" swaggertype:"array,object"` // Envoy Listener (protojson)
+	Routes    []json.RawMessage  `json:"routes" swaggertype:"array,object"`    // Envoy RouteConfiguration (protojson)
+	Clusters  []json.RawMessage  `json:"clusters" swaggertype:"array,object"`  // Envoy Cluster (protojson)
+	Endpoints []json.RawMessage  `json:"endpoints" swaggertype:"array,object"` // Envoy ClusterLoadAssignment (protojson)
+}
+
+type ProxyBootstrapResp struct {
+	Format  string `json:"format"`  // 输出格式 [yaml, json]
+	Content string `json:"content"` // bootstrap 内容，可直接作为 envoy -c 的配置文件
+}
+
+type ProxyRouteTargetResp struct {
+	ID           string `json:"id"`            // 分流目标ID
+	UpstreamID   string `json:"upstream_id"`   // 上游服务ID
+	UpstreamName string `json:"upstream_name"` // 上游服务名称
+	Weight       int    `json:"weight"`        // 流量权重(%)
+}
+
+type ProxyRouteMatchResp struct {
+	MatchType       constant.ProxyHttpRouteMatchType `json:"match_type"`       // 路径匹配类型 [1: 前缀, 2: 精确, 3: 正则]
+	MatchPattern    string                           `json:"match_pattern"`    // 路径匹配规则
+	IgnoreCase      bool                             `json:"ignore_case"`      // 路径匹配是否忽略大小写
+	Methods         []string                         `json:"methods"`          // 匹配的 HTTP 方法，为空表示不限制
+	Headers         []common.HttpMatcher             `json:"headers"`          // 请求头匹配条件
+	QueryParameters []common.HttpMatcher             `json:"query_parameters"` // 查询参数匹配条件
+}
+
+type ProxyRoutePriorityResp struct {
+	Priority  int                      `json:"priority"`  // 匹配优先级，数值越大越先匹配
+	Conflicts []ProxyRouteConflictResp `json:"conflicts"` // 与该路由相互覆盖的路由
+}
+
+type ProxyRouteConflictResp struct {
+	ID           string                           `json:"id"`            // 路由ID
+	Name         string                           `json:"name"`          // 路由名称
+	Priority     int                              `json:"priority"`      // 匹配优先级
+	MatchType    constant.ProxyHttpRouteMatchType `json:"match_type"`    // 路径匹配类型 [1: 前缀, 2: 精确, 3: 正则]
+	MatchPattern string                           `json:"match_pattern"` // 路径匹配规则
+	Shadowed     bool                             `json:"shadowed"`      // 是否被当前路由覆盖而永远不会命中，为 false 时当前路由被其覆盖
+}
+
+type ProxyRoutePathResp struct {
+	MatchType         constant.ProxyHttpRouteMatchType `json:"match_type"`          // 路径匹配类型 [1: 前缀, 2: 精确, 3: 正则(RE2)]
+	MatchPattern      string                           `json:"match_pattern"`       // 路径匹配规则
+	EnablePathRewrite constant.YesOrNo                 `json:"enable_path_rewrite"` // 是否启用路径重写 [1: 启用, 2: 禁用]
+	PathRewrite       string                           `json:"path_rewrite"`        // 路径重写规则
+	EnableRedirect    constant.YesOrNo                 `json:"enable_redirect"`     // 是否启用重定向 [1: 启用, 2: 禁用]
+	RedirectURL       string                           `json:"redirect_url"`        // 重定向地址
+	RedirectCode      int                              `json:"redirect_code"`       // 重定向状态码
+}
+
+type ProxyRouteRetryResp struct {
+	Enabled bool `json:"enabled"` // 是否启用重试
+	common.RetryPolicy
+}
+
+type ProxyVirtualHostResp struct {
+	ID           string           `json:"id"`            // 虚拟主机ID
+	Name         string           `json:"name"`          // 虚拟主机名称
+	Description  string           `json:"description"`   // 虚拟主机描述
+	ListenerID   string           `json:"listener_id"`   // 所属 L7 监听器ID
+	ListenerName string           `json:"listener_name"` // 所属 L7 监听器名称
+	Domains      []string         `json:"domains"`       // 匹配的域名
+	Status       constant.YesOrNo `json:"status"`        // 是否启用 [1: 启用, 2: 禁用]
+	RouteCount   int              `json:"route_count"`   // 路由数量
+}
+
+func (r *ProxyVirtualHostResp) LoadDb(e *ent.CoreGatewayVirtualHost) {
+	r.ID = e.ID
+	r.Name = e.Name
+	r.Description = e.Description
+	r.ListenerID = e.ListenerID
+	r.Domains = e.Domains
+	r.Status = e.Status
+	if l := e.Edges.VhostFromListener; l != nil {
+		r.ListenerName = l.Name
+	}
+	if r.Domains == nil {
+		r.Domains = []string{}
+	}
+}
+
+type ProxyHeaderMutationResp struct {
+	common.HeaderMutation
+}
+
+type ProxyRouteMirrorResp struct {
+	UpstreamID   string  `json:"upstream_id"`   // 影子上游服务ID，为空表示未开启镜像
+	UpstreamName string  `json:"upstream_name"` // 影子上游服务名称
+	Percent      float64 `json:"percent"`       // 镜像的请求比例(%)
+	RuntimeKey   string  `json:"runtime_key"`   // 可在 Envoy runtime 中临时调整镜像比例的 key
+}
+
+type ProxyRouteFaultResp struct {
+	Enabled bool `json:"enabled"` // 故障注入是否生效中，到期后为 false
+	common.FaultPolicy
+}
+
+type ProxyCorsPolicyResp struct {
+	Enabled bool `json:"enabled"` // 是否配置了跨域策略
+	common.CorsPolicy
+}
+
+type ProxyLocalRateLimitResp struct {
+	Enabled bool `json:"enabled"` // 是否配置了本地限流
+	common.LocalRateLimit
+}
+
+type ProxyRateLimitRuleResp struct {
+	ID              string                       `json:"id"`                // 限流规则ID
+	Name            string                       `json:"name"`              // 限流规则名称
+	Description     string                       `json:"description"`       // 限流规则描述
+	Descriptors     []common.RateLimitDescriptor `json:"descriptors"`       // 匹配的描述符条目
+	Unit            constant.ProxyRateLimitUnit  `json:"unit"`              // 计数周期 [1: 秒, 2: 分钟, 3: 小时, 4: 天]
+	RequestsPerUnit uint32                       `json:"requests_per_unit"` // 每个计数周期允许的请求数
+	Status          constant.YesOrNo             `json:"status"`            // 是否启用 [1: 启用, 2: 禁用]
+}
+
+func (r *ProxyRateLimitRuleResp) LoadDb(e *ent.CoreGatewayRateLimitRule) {
+	r.ID = e.ID
+	r.Name = e.Name
+	r.Description = e.Description
+	r.Descriptors = e.Descriptors
+	r.Unit = e.Unit
+	r.RequestsPerUnit = e.RequestsPerUnit
+	r.Status = e.Status
+	if r.Descriptors == nil {
+		r.Descriptors = []common.RateLimitDescriptor{}
+	}
+}
+
+type ProxyRateLimitActionsResp struct {
+	Enabled bool                     `json:"enabled"` // 是否配置了全局限流
+	Actions []common.RateLimitAction `json:"actions"` // 描述符条目
+}
+
+type ProxyDirectResponseResp struct {
+	Enabled bool `json:"enabled"` // 是否配置了直接响应
+	common.DirectResponse
+}
+
+type ProxyMaintenanceResp struct {
+	common.Maintenance
+}
+
+type ProxyHashPoliciesResp struct {
+	Enabled  bool                `json:"enabled"`  // 是否配置了哈希策略
+	Policies []common.HashPolicy `json:"policies"` // 哈希策略
+}
+
+type ProxyUpstreamLbResp struct {
+	LbPolicy        constant.ProxyLbPolicy `json:"lb_policy"`         // 负载均衡策略 [1: ROUND_ROBIN, 2: LEAST_REQUEST, 3: RANDOM, 4: RING_HASH, 5: MAGLEV]
+	RingMinSize     uint64                 `json:"ring_min_size"`     // 环哈希的最小环大小，0 表示使用 Envoy 默认值
+	RingMaxSize     uint64                 `json:"ring_max_size"`     // 环哈希的最大环大小，0 表示使用 Envoy 默认值
+	MaglevTableSize uint64                 `json:"maglev_table_size"` // Maglev 查找表大小，0 表示使用 Envoy 默认值
+}
+
+type ProxyCompressionResp struct {
+	Enabled bool `json:"enabled"` // 是否开启了响应压缩
+	common.Compression
+}
+
+type ProxyRouteCompressionResp struct {
+	DisableCompression constant.YesOrNo `json:"disable_compression"` // 是否关闭该路由的响应压缩 [1: 是, 2: 否]
+}
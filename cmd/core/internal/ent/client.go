@@ -17,14 +17,21 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/lyonmu/quebec/cmd/core/internal/ent/corecert"
 	"github.com/lyonmu/quebec/cmd/core/internal/ent/coredatarelationship"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/coregateway"
 	"github.com/lyonmu/quebec/cmd/core/internal/ent/coregatewaycluster"
 	"github.com/lyonmu/quebec/cmd/core/internal/ent/coregatewayhttproute"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/coregatewayhttproutetarget"
 	"github.com/lyonmu/quebec/cmd/core/internal/ent/coregatewayl4listener"
 	"github.com/lyonmu/quebec/cmd/core/internal/ent/coregatewayl7listener"
 	"github.com/lyonmu/quebec/cmd/core/internal/ent/coregatewaynode"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/coregatewayratelimitrule"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/coregatewayvirtualhost"
 	"github.com/lyonmu/quebec/cmd/core/internal/ent/coremenu"
 	"github.com/lyonmu/quebec/cmd/core/internal/ent/coreonlineuser"
 	"github.com/lyonmu/quebec/cmd/core/internal/ent/coreoperationlog"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/coreproxyrevision"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/coreproxyrollout"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/coreproxyrolloutpolicy"
 	"github.com/lyonmu/quebec/cmd/core/internal/ent/corerole"
 	"github.com/lyonmu/quebec/cmd/core/internal/ent/coreupstream"
 	"github.com/lyonmu/quebec/cmd/core/internal/ent/coreupstreamhost"
@@ -42,22 +49,36 @@ type Client struct {
 	CoreCert *CoreCertClient
 	// CoreDataRelationship is the client for interacting with the CoreDataRelationship builders.
 	CoreDataRelationship *CoreDataRelationshipClient
+	// CoreGateway is the client for interacting with the CoreGateway builders.
+	CoreGateway *CoreGatewayClient
 	// CoreGatewayCluster is the client for interacting with the CoreGatewayCluster builders.
 	CoreGatewayCluster *CoreGatewayClusterClient
 	// CoreGatewayHttpRoute is the client for interacting with the CoreGatewayHttpRoute builders.
 	CoreGatewayHttpRoute *CoreGatewayHttpRouteClient
+	// CoreGatewayHttpRouteTarget is the client for interacting with the CoreGatewayHttpRouteTarget builders.
+	CoreGatewayHttpRouteTarget *CoreGatewayHttpRouteTargetClient
 	// CoreGatewayL4Listener is the client for interacting with the CoreGatewayL4Listener builders.
 	CoreGatewayL4Listener *CoreGatewayL4ListenerClient
 	// CoreGatewayL7Listener is the client for interacting with the CoreGatewayL7Listener builders.
 	CoreGatewayL7Listener *CoreGatewayL7ListenerClient
 	// CoreGatewayNode is the client for interacting with the CoreGatewayNode builders.
 	CoreGatewayNode *CoreGatewayNodeClient
+	// CoreGatewayRateLimitRule is the client for interacting with the CoreGatewayRateLimitRule builders.
+	CoreGatewayRateLimitRule *CoreGatewayRateLimitRuleClient
+	// CoreGatewayVirtualHost is the client for interacting with the CoreGatewayVirtualHost builders.
+	CoreGatewayVirtualHost *CoreGatewayVirtualHostClient
 	// CoreMenu is the client for interacting with the CoreMenu builders.
 	CoreMenu *CoreMenuClient
 	// CoreOnLineUser is the client for interacting with the CoreOnLineUser builders.
 	CoreOnLineUser *CoreOnLineUserClient
 	// CoreOperationLog is the client for interacting with the CoreOperationLog builders.
 	CoreOperationLog *CoreOperationLogClient
+	// CoreProxyRevision is the client for interacting with the CoreProxyRevision builders.
+	CoreProxyRevision *CoreProxyRevisionClient
+	// CoreProxyRollout is the client for interacting with the CoreProxyRollout builders.
+	CoreProxyRollout *CoreProxyRolloutClient
+	// CoreProxyRolloutPolicy is the client for interacting with the CoreProxyRolloutPolicy builders.
+	CoreProxyRolloutPolicy *CoreProxyRolloutPolicyClient
 	// CoreRole is the client for interacting with the CoreRole builders.
 	CoreRole *CoreRoleClient
 	// CoreUpstream is the client for interacting with the CoreUpstream builders.
@@ -79,14 +100,21 @@ func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
 	c.CoreCert = NewCoreCertClient(c.config)
 	c.CoreDataRelationship = NewCoreDataRelationshipClient(c.config)
+	c.CoreGateway = NewCoreGatewayClient(c.config)
 	c.CoreGatewayCluster = NewCoreGatewayClusterClient(c.config)
 	c.CoreGatewayHttpRoute = NewCoreGatewayHttpRouteClient(c.config)
+	c.CoreGatewayHttpRouteTarget = NewCoreGatewayHttpRouteTargetClient(c.config)
 	c.CoreGatewayL4Listener = NewCoreGatewayL4ListenerClient(c.config)
 	c.CoreGatewayL7Listener = NewCoreGatewayL7ListenerClient(c.config)
 	c.CoreGatewayNode = NewCoreGatewayNodeClient(c.config)
+	c.CoreGatewayRateLimitRule = NewCoreGatewayRateLimitRuleClient(c.config)
+	c.CoreGatewayVirtualHost = NewCoreGatewayVirtualHostClient(c.config)
 	c.CoreMenu = NewCoreMenuClient(c.config)
 	c.CoreOnLineUser = NewCoreOnLineUserClient(c.config)
 	c.CoreOperationLog = NewCoreOperationLogClient(c.config)
+	c.CoreProxyRevision = NewCoreProxyRevisionClient(c.config)
+	c.CoreProxyRollout = NewCoreProxyRolloutClient(c.config)
+	c.CoreProxyRolloutPolicy = NewCoreProxyRolloutPolicyClient(c.config)
 	c.CoreRole = NewCoreRoleClient(c.config)
 	c.CoreUpstream = NewCoreUpstreamClient(c.config)
 	c.CoreUpstreamHost = NewCoreUpstreamHostClient(c.config)
@@ -181,22 +209,29 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:                   ctx,
-		config:                cfg,
-		CoreCert:              NewCoreCertClient(cfg),
-		CoreDataRelationship:  NewCoreDataRelationshipClient(cfg),
-		CoreGatewayCluster:    NewCoreGatewayClusterClient(cfg),
-		CoreGatewayHttpRoute:  NewCoreGatewayHttpRouteClient(cfg),
-		CoreGatewayL4Listener: NewCoreGatewayL4ListenerClient(cfg),
-		CoreGatewayL7Listener: NewCoreGatewayL7ListenerClient(cfg),
-		CoreGatewayNode:       NewCoreGatewayNodeClient(cfg),
-		CoreMenu:              NewCoreMenuClient(cfg),
-		CoreOnLineUser:        NewCoreOnLineUserClient(cfg),
-		CoreOperationLog:      NewCoreOperationLogClient(cfg),
-		CoreRole:              NewCoreRoleClient(cfg),
-		CoreUpstream:          NewCoreUpstreamClient(cfg),
-		CoreUpstreamHost:      NewCoreUpstreamHostClient(cfg),
-		CoreUser:              NewCoreUserClient(cfg),
+		ctx:                        ctx,
+		config:                     cfg,
+		CoreCert:                   NewCoreCertClient(cfg),
+		CoreDataRelationship:       NewCoreDataRelationshipClient(cfg),
+		CoreGateway:                NewCoreGatewayClient(cfg),
+		CoreGatewayCluster:         NewCoreGatewayClusterClient(cfg),
+		CoreGatewayHttpRoute:       NewCoreGatewayHttpRouteClient(cfg),
+		CoreGatewayHttpRouteTarget: NewCoreGatewayHttpRouteTargetClient(cfg),
+		CoreGatewayL4Listener:      NewCoreGatewayL4ListenerClient(cfg),
+		CoreGatewayL7Listener:      NewCoreGatewayL7ListenerClient(cfg),
+		CoreGatewayNode:            NewCoreGatewayNodeClient(cfg),
+		CoreGatewayRateLimitRule:   NewCoreGatewayRateLimitRuleClient(cfg),
+		CoreGatewayVirtualHost:     NewCoreGatewayVirtualHostClient(cfg),
+		CoreMenu:                   NewCoreMenuClient(cfg),
+		CoreOnLineUser:             NewCoreOnLineUserClient(cfg),
+		CoreOperationLog:           NewCoreOperationLogClient(cfg),
+		CoreProxyRevision:          NewCoreProxyRevisionClient(cfg),
+		CoreProxyRollout:           NewCoreProxyRolloutClient(cfg),
+		CoreProxyRolloutPolicy:     NewCoreProxyRolloutPolicyClient(cfg),
+		CoreRole:                   NewCoreRoleClient(cfg),
+		CoreUpstream:               NewCoreUpstreamClient(cfg),
+		CoreUpstreamHost:           NewCoreUpstreamHostClient(cfg),
+		CoreUser:                   NewCoreUserClient(cfg),
 	}, nil
 }
 
@@ -214,22 +249,29 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:                   ctx,
-		config:                cfg,
-		CoreCert:              NewCoreCertClient(cfg),
-		CoreDataRelationship:  NewCoreDataRelationshipClient(cfg),
-		CoreGatewayCluster:    NewCoreGatewayClusterClient(cfg),
-		CoreGatewayHttpRoute:  NewCoreGatewayHttpRouteClient(cfg),
-		CoreGatewayL4Listener: NewCoreGatewayL4ListenerClient(cfg),
-		CoreGatewayL7Listener: NewCoreGatewayL7ListenerClient(cfg),
-		CoreGatewayNode:       NewCoreGatewayNodeClient(cfg),
-		CoreMenu:              NewCoreMenuClient(cfg),
-		CoreOnLineUser:        NewCoreOnLineUserClient(cfg),
-		CoreOperationLog:      NewCoreOperationLogClient(cfg),
-		CoreRole:              NewCoreRoleClient(cfg),
-		CoreUpstream:          NewCoreUpstreamClient(cfg),
-		CoreUpstreamHost:      NewCoreUpstreamHostClient(cfg),
-		CoreUser:              NewCoreUserClient(cfg),
+		ctx:                        ctx,
+		config:                     cfg,
+		CoreCert:                   NewCoreCertClient(cfg),
+		CoreDataRelationship:       NewCoreDataRelationshipClient(cfg),
+		CoreGateway:                NewCoreGatewayClient(cfg),
+		CoreGatewayCluster:         NewCoreGatewayClusterClient(cfg),
+		CoreGatewayHttpRoute:       NewCoreGatewayHttpRouteClient(cfg),
+		CoreGatewayHttpRouteTarget: NewCoreGatewayHttpRouteTargetClient(cfg),
+		CoreGatewayL4Listener:      NewCoreGatewayL4ListenerClient(cfg),
+		CoreGatewayL7Listener:      NewCoreGatewayL7ListenerClient(cfg),
+		CoreGatewayNode:            NewCoreGatewayNodeClient(cfg),
+		CoreGatewayRateLimitRule:   NewCoreGatewayRateLimitRuleClient(cfg),
+		CoreGatewayVirtualHost:     NewCoreGatewayVirtualHostClient(cfg),
+		CoreMenu:                   NewCoreMenuClient(cfg),
+		CoreOnLineUser:             NewCoreOnLineUserClient(cfg),
+		CoreOperationLog:           NewCoreOperationLogClient(cfg),
+		CoreProxyRevision:          NewCoreProxyRevisionClient(cfg),
+		CoreProxyRollout:           NewCoreProxyRolloutClient(cfg),
+		CoreProxyRolloutPolicy:     NewCoreProxyRolloutPolicyClient(cfg),
+		CoreRole:                   NewCoreRoleClient(cfg),
+		CoreUpstream:               NewCoreUpstreamClient(cfg),
+		CoreUpstreamHost:           NewCoreUpstreamHostClient(cfg),
+		CoreUser:                   NewCoreUserClient(cfg),
 	}, nil
 }
 
@@ -259,10 +301,12 @@ func (c *Client) Close() error {
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
 	for _, n := range []interface{ Use(...Hook) }{
-		c.CoreCert, c.CoreDataRelationship, c.CoreGatewayCluster,
-		c.CoreGatewayHttpRoute, c.CoreGatewayL4Listener, c.CoreGatewayL7Listener,
-		c.CoreGatewayNode, c.CoreMenu, c.CoreOnLineUser, c.CoreOperationLog,
-		c.CoreRole, c.CoreUpstream, c.CoreUpstreamHost, c.CoreUser,
+		c.CoreCert, c.CoreDataRelationship, c.CoreGateway, c.CoreGatewayCluster,
+		c.CoreGatewayHttpRoute, c.CoreGatewayHttpRouteTarget, c.CoreGatewayL4Listener,
+		c.CoreGatewayL7Listener, c.CoreGatewayNode, c.CoreGatewayRateLimitRule,
+		c.CoreGatewayVirtualHost, c.CoreMenu, c.CoreOnLineUser, c.CoreOperationLog,
+		c.CoreProxyRevision, c.CoreProxyRollout, c.CoreProxyRolloutPolicy, c.CoreRole,
+		c.CoreUpstream, c.CoreUpstreamHost, c.CoreUser,
 	} {
 		n.Use(hooks...)
 	}
@@ -272,10 +316,12 @@ func (c *Client) Use(hooks ...Hook) {
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
 	for _, n := range []interface{ Intercept(...Interceptor) }{
-		c.CoreCert, c.CoreDataRelationship, c.CoreGatewayCluster,
-		c.CoreGatewayHttpRoute, c.CoreGatewayL4Listener, c.CoreGatewayL7Listener,
-		c.CoreGatewayNode, c.CoreMenu, c.CoreOnLineUser, c.CoreOperationLog,
-		c.CoreRole, c.CoreUpstream, c.CoreUpstreamHost, c.CoreUser,
+		c.CoreCert, c.CoreDataRelationship, c.CoreGateway, c.CoreGatewayCluster,
+		c.CoreGatewayHttpRoute, c.CoreGatewayHttpRouteTarget, c.CoreGatewayL4Listener,
+		c.CoreGatewayL7Listener, c.CoreGatewayNode, c.CoreGatewayRateLimitRule,
+		c.CoreGatewayVirtualHost, c.CoreMenu, c.CoreOnLineUser, c.CoreOperationLog,
+		c.CoreProxyRevision, c.CoreProxyRollout, c.CoreProxyRolloutPolicy, c.CoreRole,
+		c.CoreUpstream, c.CoreUpstreamHost, c.CoreUser,
 	} {
 		n.Intercept(interceptors...)
 	}
@@ -288,22 +334,36 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.CoreCert.mutate(ctx, m)
 	case *CoreDataRelationshipMutation:
 		return c.CoreDataRelationship.mutate(ctx, m)
+	case *CoreGatewayMutation:
+		return c.CoreGateway.mutate(ctx, m)
 	case *CoreGatewayClusterMutation:
 		return c.CoreGatewayCluster.mutate(ctx, m)
 	case *CoreGatewayHttpRouteMutation:
 		return c.CoreGatewayHttpRoute.mutate(ctx, m)
+	case *CoreGatewayHttpRouteTargetMutation:
+		return c.CoreGatewayHttpRouteTarget.mutate(ctx, m)
 	case *CoreGatewayL4ListenerMutation:
 		return c.CoreGatewayL4Listener.mutate(ctx, m)
 	case *CoreGatewayL7ListenerMutation:
 		return c.CoreGatewayL7Listener.mutate(ctx, m)
 	case *CoreGatewayNodeMutation:
 		return c.CoreGatewayNode.mutate(ctx, m)
+	case *CoreGatewayRateLimitRuleMutation:
+		return c.CoreGatewayRateLimitRule.mutate(ctx, m)
+	case *CoreGatewayVirtualHostMutation:
+		return c.CoreGatewayVirtualHost.mutate(ctx, m)
 	case *CoreMenuMutation:
 		return c.CoreMenu.mutate(ctx, m)
 	case *CoreOnLineUserMutation:
 		return c.CoreOnLineUser.mutate(ctx, m)
 	case *CoreOperationLogMutation:
 		return c.CoreOperationLog.mutate(ctx, m)
+	case *CoreProxyRevisionMutation:
+		return c.CoreProxyRevision.mutate(ctx, m)
+	case *CoreProxyRolloutMutation:
+		return c.CoreProxyRollout.mutate(ctx, m)
+	case *CoreProxyRolloutPolicyMutation:
+		return c.CoreProxyRolloutPolicy.mutate(ctx, m)
 	case *CoreRoleMutation:
 		return c.CoreRole.mutate(ctx, m)
 	case *CoreUpstreamMutation:
@@ -617,6 +677,140 @@ func (c *CoreDataRelationshipClient) mutate(ctx context.Context, m *CoreDataRela
 	}
 }
 
+// CoreGatewayClient is a client for the CoreGateway schema.
+type CoreGatewayClient struct {
+	config
+}
+
+// NewCoreGatewayClient returns a client for the CoreGateway from the given config.
+func NewCoreGatewayClient(c config) *CoreGatewayClient {
+	return &CoreGatewayClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `coregateway.Hooks(f(g(h())))`.
+func (c *CoreGatewayClient) Use(hooks ...Hook) {
+	c.hooks.CoreGateway = append(c.hooks.CoreGateway, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `coregateway.Intercept(f(g(h())))`.
+func (c *CoreGatewayClient) Intercept(interceptors ...Interceptor) {
+	c.inters.CoreGateway = append(c.inters.CoreGateway, interceptors...)
+}
+
+// Create returns a builder for creating a CoreGateway entity.
+func (c *CoreGatewayClient) Create() *CoreGatewayCreate {
+	mutation := newCoreGatewayMutation(c.config, OpCreate)
+	return &CoreGatewayCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of CoreGateway entities.
+func (c *CoreGatewayClient) CreateBulk(builders ...*CoreGatewayCreate) *CoreGatewayCreateBulk {
+	return &CoreGatewayCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *CoreGatewayClient) MapCreateBulk(slice any, setFunc func(*CoreGatewayCreate, int)) *CoreGatewayCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &CoreGatewayCreateBulk{err: fmt.Errorf("calling to CoreGatewayClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*CoreGatewayCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &CoreGatewayCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for CoreGateway.
+func (c *CoreGatewayClient) Update() *CoreGatewayUpdate {
+	mutation := newCoreGatewayMutation(c.config, OpUpdate)
+	return &CoreGatewayUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *CoreGatewayClient) UpdateOne(_m *CoreGateway) *CoreGatewayUpdateOne {
+	mutation := newCoreGatewayMutation(c.config, OpUpdateOne, withCoreGateway(_m))
+	return &CoreGatewayUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *CoreGatewayClient) UpdateOneID(id string) *CoreGatewayUpdateOne {
+	mutation := newCoreGatewayMutation(c.config, OpUpdateOne, withCoreGatewayID(id))
+	return &CoreGatewayUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for CoreGateway.
+func (c *CoreGatewayClient) Delete() *CoreGatewayDelete {
+	mutation := newCoreGatewayMutation(c.config, OpDelete)
+	return &CoreGatewayDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *CoreGatewayClient) DeleteOne(_m *CoreGateway) *CoreGatewayDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *CoreGatewayClient) DeleteOneID(id string) *CoreGatewayDeleteOne {
+	builder := c.Delete().Where(coregateway.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &CoreGatewayDeleteOne{builder}
+}
+
+// Query returns a query builder for CoreGateway.
+func (c *CoreGatewayClient) Query() *CoreGatewayQuery {
+	return &CoreGatewayQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeCoreGateway},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a CoreGateway entity by its id.
+func (c *CoreGatewayClient) Get(ctx context.Context, id string) (*CoreGateway, error) {
+	return c.Query().Where(coregateway.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *CoreGatewayClient) GetX(ctx context.Context, id string) *CoreGateway {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *CoreGatewayClient) Hooks() []Hook {
+	hooks := c.hooks.CoreGateway
+	return append(hooks[:len(hooks):len(hooks)], coregateway.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *CoreGatewayClient) Interceptors() []Interceptor {
+	return c.inters.CoreGateway
+}
+
+func (c *CoreGatewayClient) mutate(ctx context.Context, m *CoreGatewayMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&CoreGatewayCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&CoreGatewayUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&CoreGatewayUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&CoreGatewayDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown CoreGateway mutation op: %q", m.Op())
+	}
+}
+
 // CoreGatewayClusterClient is a client for the CoreGatewayCluster schema.
 type CoreGatewayClusterClient struct {
 	config
@@ -875,6 +1069,54 @@ func (c *CoreGatewayHttpRouteClient) GetX(ctx context.Context, id string) *CoreG
 	return obj
 }
 
+// QueryRouteFromUpstream queries the route_from_upstream edge of a CoreGatewayHttpRoute.
+func (c *CoreGatewayHttpRouteClient) QueryRouteFromUpstream(_m *CoreGatewayHttpRoute) *CoreUpstreamQuery {
+	query := (&CoreUpstreamClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(coregatewayhttproute.Table, coregatewayhttproute.FieldID, id),
+			sqlgraph.To(coreupstream.Table, coreupstream.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, coregatewayhttproute.RouteFromUpstreamTable, coregatewayhttproute.RouteFromUpstreamColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRouteToTarget queries the route_to_target edge of a CoreGatewayHttpRoute.
+func (c *CoreGatewayHttpRouteClient) QueryRouteToTarget(_m *CoreGatewayHttpRoute) *CoreGatewayHttpRouteTargetQuery {
+	query := (&CoreGatewayHttpRouteTargetClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(coregatewayhttproute.Table, coregatewayhttproute.FieldID, id),
+			sqlgraph.To(coregatewayhttproutetarget.Table, coregatewayhttproutetarget.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, coregatewayhttproute.RouteToTargetTable, coregatewayhttproute.RouteToTargetColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRouteFromVhost queries the route_from_vhost edge of a CoreGatewayHttpRoute.
+func (c *CoreGatewayHttpRouteClient) QueryRouteFromVhost(_m *CoreGatewayHttpRoute) *CoreGatewayVirtualHostQuery {
+	query := (&CoreGatewayVirtualHostClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(coregatewayhttproute.Table, coregatewayhttproute.FieldID, id),
+			sqlgraph.To(coregatewayvirtualhost.Table, coregatewayvirtualhost.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, coregatewayhttproute.RouteFromVhostTable, coregatewayhttproute.RouteFromVhostColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *CoreGatewayHttpRouteClient) Hooks() []Hook {
 	hooks := c.hooks.CoreGatewayHttpRoute
@@ -901,6 +1143,172 @@ func (c *CoreGatewayHttpRouteClient) mutate(ctx context.Context, m *CoreGatewayH
 	}
 }
 
+// CoreGatewayHttpRouteTargetClient is a client for the CoreGatewayHttpRouteTarget schema.
+type CoreGatewayHttpRouteTargetClient struct {
+	config
+}
+
+// NewCoreGatewayHttpRouteTargetClient returns a client for the CoreGatewayHttpRouteTarget from the given config.
+func NewCoreGatewayHttpRouteTargetClient(c config) *CoreGatewayHttpRouteTargetClient {
+	return &CoreGatewayHttpRouteTargetClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `coregatewayhttproutetarget.Hooks(f(g(h())))`.
+func (c *CoreGatewayHttpRouteTargetClient) Use(hooks ...Hook) {
+	c.hooks.CoreGatewayHttpRouteTarget = append(c.hooks.CoreGatewayHttpRouteTarget, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `coregatewayhttproutetarget.Intercept(f(g(h())))`.
+func (c *CoreGatewayHttpRouteTargetClient) Intercept(interceptors ...Interceptor) {
+	c.inters.CoreGatewayHttpRouteTarget = append(c.inters.CoreGatewayHttpRouteTarget, interceptors...)
+}
+
+// Create returns a builder for creating a CoreGatewayHttpRouteTarget entity.
+func (c *CoreGatewayHttpRouteTargetClient) Create() *CoreGatewayHttpRouteTargetCreate {
+	mutation := newCoreGatewayHttpRouteTargetMutation(c.config, OpCreate)
+	return &CoreGatewayHttpRouteTargetCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of CoreGatewayHttpRouteTarget entities.
+func (c *CoreGatewayHttpRouteTargetClient) CreateBulk(builders ...*CoreGatewayHttpRouteTargetCreate) *CoreGatewayHttpRouteTargetCreateBulk {
+	return &CoreGatewayHttpRouteTargetCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *CoreGatewayHttpRouteTargetClient) MapCreateBulk(slice any, setFunc func(*CoreGatewayHttpRouteTargetCreate, int)) *CoreGatewayHttpRouteTargetCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &CoreGatewayHttpRouteTargetCreateBulk{err: fmt.Errorf("calling to CoreGatewayHttpRouteTargetClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*CoreGatewayHttpRouteTargetCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &CoreGatewayHttpRouteTargetCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for CoreGatewayHttpRouteTarget.
+func (c *CoreGatewayHttpRouteTargetClient) Update() *CoreGatewayHttpRouteTargetUpdate {
+	mutation := newCoreGatewayHttpRouteTargetMutation(c.config, OpUpdate)
+	return &CoreGatewayHttpRouteTargetUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *CoreGatewayHttpRouteTargetClient) UpdateOne(_m *CoreGatewayHttpRouteTarget) *CoreGatewayHttpRouteTargetUpdateOne {
+	mutation := newCoreGatewayHttpRouteTargetMutation(c.config, OpUpdateOne, withCoreGatewayHttpRouteTarget(_m))
+	return &CoreGatewayHttpRouteTargetUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *CoreGatewayHttpRouteTargetClient) UpdateOneID(id string) *CoreGatewayHttpRouteTargetUpdateOne {
+	mutation := newCoreGatewayHttpRouteTargetMutation(c.config, OpUpdateOne, withCoreGatewayHttpRouteTargetID(id))
+	return &CoreGatewayHttpRouteTargetUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for CoreGatewayHttpRouteTarget.
+func (c *CoreGatewayHttpRouteTargetClient) Delete() *CoreGatewayHttpRouteTargetDelete {
+	mutation := newCoreGatewayHttpRouteTargetMutation(c.config, OpDelete)
+	return &CoreGatewayHttpRouteTargetDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *CoreGatewayHttpRouteTargetClient) DeleteOne(_m *CoreGatewayHttpRouteTarget) *CoreGatewayHttpRouteTargetDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *CoreGatewayHttpRouteTargetClient) DeleteOneID(id string) *CoreGatewayHttpRouteTargetDeleteOne {
+	builder := c.Delete().Where(coregatewayhttproutetarget.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &CoreGatewayHttpRouteTargetDeleteOne{builder}
+}
+
+// Query returns a query builder for CoreGatewayHttpRouteTarget.
+func (c *CoreGatewayHttpRouteTargetClient) Query() *CoreGatewayHttpRouteTargetQuery {
+	return &CoreGatewayHttpRouteTargetQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeCoreGatewayHttpRouteTarget},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a CoreGatewayHttpRouteTarget entity by its id.
+func (c *CoreGatewayHttpRouteTargetClient) Get(ctx context.Context, id string) (*CoreGatewayHttpRouteTarget, error) {
+	return c.Query().Where(coregatewayhttproutetarget.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *CoreGatewayHttpRouteTargetClient) GetX(ctx context.Context, id string) *CoreGatewayHttpRouteTarget {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryTargetFromRoute queries the target_from_route edge of a CoreGatewayHttpRouteTarget.
+func (c *CoreGatewayHttpRouteTargetClient) QueryTargetFromRoute(_m *CoreGatewayHttpRouteTarget) *CoreGatewayHttpRouteQuery {
+	query := (&CoreGatewayHttpRouteClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(coregatewayhttproutetarget.Table, coregatewayhttproutetarget.FieldID, id),
+			sqlgraph.To(coregatewayhttproute.Table, coregatewayhttproute.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, coregatewayhttproutetarget.TargetFromRouteTable, coregatewayhttproutetarget.TargetFromRouteColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryTargetFromUpstream queries the target_from_upstream edge of a CoreGatewayHttpRouteTarget.
+func (c *CoreGatewayHttpRouteTargetClient) QueryTargetFromUpstream(_m *CoreGatewayHttpRouteTarget) *CoreUpstreamQuery {
+	query := (&CoreUpstreamClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(coregatewayhttproutetarget.Table, coregatewayhttproutetarget.FieldID, id),
+			sqlgraph.To(coreupstream.Table, coreupstream.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, coregatewayhttproutetarget.TargetFromUpstreamTable, coregatewayhttproutetarget.TargetFromUpstreamColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *CoreGatewayHttpRouteTargetClient) Hooks() []Hook {
+	hooks := c.hooks.CoreGatewayHttpRouteTarget
+	return append(hooks[:len(hooks):len(hooks)], coregatewayhttproutetarget.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *CoreGatewayHttpRouteTargetClient) Interceptors() []Interceptor {
+	return c.inters.CoreGatewayHttpRouteTarget
+}
+
+func (c *CoreGatewayHttpRouteTargetClient) mutate(ctx context.Context, m *CoreGatewayHttpRouteTargetMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&CoreGatewayHttpRouteTargetCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&CoreGatewayHttpRouteTargetUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&CoreGatewayHttpRouteTargetUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&CoreGatewayHttpRouteTargetDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown CoreGatewayHttpRouteTarget mutation op: %q", m.Op())
+	}
+}
+
 // CoreGatewayL4ListenerClient is a client for the CoreGatewayL4Listener schema.
 type CoreGatewayL4ListenerClient struct {
 	config
@@ -1143,6 +1551,22 @@ func (c *CoreGatewayL7ListenerClient) GetX(ctx context.Context, id string) *Core
 	return obj
 }
 
+// QueryListenerToVhost queries the listener_to_vhost edge of a CoreGatewayL7Listener.
+func (c *CoreGatewayL7ListenerClient) QueryListenerToVhost(_m *CoreGatewayL7Listener) *CoreGatewayVirtualHostQuery {
+	query := (&CoreGatewayVirtualHostClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(coregatewayl7listener.Table, coregatewayl7listener.FieldID, id),
+			sqlgraph.To(coregatewayvirtualhost.Table, coregatewayvirtualhost.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, coregatewayl7listener.ListenerToVhostTable, coregatewayl7listener.ListenerToVhostColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *CoreGatewayL7ListenerClient) Hooks() []Hook {
 	hooks := c.hooks.CoreGatewayL7Listener
@@ -1319,102 +1743,402 @@ func (c *CoreGatewayNodeClient) mutate(ctx context.Context, m *CoreGatewayNodeMu
 	}
 }
 
-// CoreMenuClient is a client for the CoreMenu schema.
-type CoreMenuClient struct {
+// CoreGatewayRateLimitRuleClient is a client for the CoreGatewayRateLimitRule schema.
+type CoreGatewayRateLimitRuleClient struct {
 	config
 }
 
-// NewCoreMenuClient returns a client for the CoreMenu from the given config.
-func NewCoreMenuClient(c config) *CoreMenuClient {
-	return &CoreMenuClient{config: c}
+// NewCoreGatewayRateLimitRuleClient returns a client for the CoreGatewayRateLimitRule from the given config.
+func NewCoreGatewayRateLimitRuleClient(c config) *CoreGatewayRateLimitRuleClient {
+	return &CoreGatewayRateLimitRuleClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `coremenu.Hooks(f(g(h())))`.
-func (c *CoreMenuClient) Use(hooks ...Hook) {
-	c.hooks.CoreMenu = append(c.hooks.CoreMenu, hooks...)
+// A call to `Use(f, g, h)` equals to `coregatewayratelimitrule.Hooks(f(g(h())))`.
+func (c *CoreGatewayRateLimitRuleClient) Use(hooks ...Hook) {
+	c.hooks.CoreGatewayRateLimitRule = append(c.hooks.CoreGatewayRateLimitRule, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `coremenu.Intercept(f(g(h())))`.
-func (c *CoreMenuClient) Intercept(interceptors ...Interceptor) {
-	c.inters.CoreMenu = append(c.inters.CoreMenu, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `coregatewayratelimitrule.Intercept(f(g(h())))`.
+func (c *CoreGatewayRateLimitRuleClient) Intercept(interceptors ...Interceptor) {
+	c.inters.CoreGatewayRateLimitRule = append(c.inters.CoreGatewayRateLimitRule, interceptors...)
 }
 
-// Create returns a builder for creating a CoreMenu entity.
-func (c *CoreMenuClient) Create() *CoreMenuCreate {
-	mutation := newCoreMenuMutation(c.config, OpCreate)
-	return &CoreMenuCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a CoreGatewayRateLimitRule entity.
+func (c *CoreGatewayRateLimitRuleClient) Create() *CoreGatewayRateLimitRuleCreate {
+	mutation := newCoreGatewayRateLimitRuleMutation(c.config, OpCreate)
+	return &CoreGatewayRateLimitRuleCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of CoreMenu entities.
-func (c *CoreMenuClient) CreateBulk(builders ...*CoreMenuCreate) *CoreMenuCreateBulk {
-	return &CoreMenuCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of CoreGatewayRateLimitRule entities.
+func (c *CoreGatewayRateLimitRuleClient) CreateBulk(builders ...*CoreGatewayRateLimitRuleCreate) *CoreGatewayRateLimitRuleCreateBulk {
+	return &CoreGatewayRateLimitRuleCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *CoreMenuClient) MapCreateBulk(slice any, setFunc func(*CoreMenuCreate, int)) *CoreMenuCreateBulk {
+func (c *CoreGatewayRateLimitRuleClient) MapCreateBulk(slice any, setFunc func(*CoreGatewayRateLimitRuleCreate, int)) *CoreGatewayRateLimitRuleCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &CoreMenuCreateBulk{err: fmt.Errorf("calling to CoreMenuClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &CoreGatewayRateLimitRuleCreateBulk{err: fmt.Errorf("calling to CoreGatewayRateLimitRuleClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*CoreMenuCreate, rv.Len())
+	builders := make([]*CoreGatewayRateLimitRuleCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &CoreMenuCreateBulk{config: c.config, builders: builders}
+	return &CoreGatewayRateLimitRuleCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for CoreMenu.
-func (c *CoreMenuClient) Update() *CoreMenuUpdate {
-	mutation := newCoreMenuMutation(c.config, OpUpdate)
-	return &CoreMenuUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for CoreGatewayRateLimitRule.
+func (c *CoreGatewayRateLimitRuleClient) Update() *CoreGatewayRateLimitRuleUpdate {
+	mutation := newCoreGatewayRateLimitRuleMutation(c.config, OpUpdate)
+	return &CoreGatewayRateLimitRuleUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *CoreMenuClient) UpdateOne(_m *CoreMenu) *CoreMenuUpdateOne {
-	mutation := newCoreMenuMutation(c.config, OpUpdateOne, withCoreMenu(_m))
-	return &CoreMenuUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *CoreGatewayRateLimitRuleClient) UpdateOne(_m *CoreGatewayRateLimitRule) *CoreGatewayRateLimitRuleUpdateOne {
+	mutation := newCoreGatewayRateLimitRuleMutation(c.config, OpUpdateOne, withCoreGatewayRateLimitRule(_m))
+	return &CoreGatewayRateLimitRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *CoreMenuClient) UpdateOneID(id string) *CoreMenuUpdateOne {
-	mutation := newCoreMenuMutation(c.config, OpUpdateOne, withCoreMenuID(id))
-	return &CoreMenuUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *CoreGatewayRateLimitRuleClient) UpdateOneID(id string) *CoreGatewayRateLimitRuleUpdateOne {
+	mutation := newCoreGatewayRateLimitRuleMutation(c.config, OpUpdateOne, withCoreGatewayRateLimitRuleID(id))
+	return &CoreGatewayRateLimitRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for CoreMenu.
-func (c *CoreMenuClient) Delete() *CoreMenuDelete {
-	mutation := newCoreMenuMutation(c.config, OpDelete)
-	return &CoreMenuDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for CoreGatewayRateLimitRule.
+func (c *CoreGatewayRateLimitRuleClient) Delete() *CoreGatewayRateLimitRuleDelete {
+	mutation := newCoreGatewayRateLimitRuleMutation(c.config, OpDelete)
+	return &CoreGatewayRateLimitRuleDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *CoreMenuClient) DeleteOne(_m *CoreMenu) *CoreMenuDeleteOne {
+func (c *CoreGatewayRateLimitRuleClient) DeleteOne(_m *CoreGatewayRateLimitRule) *CoreGatewayRateLimitRuleDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *CoreMenuClient) DeleteOneID(id string) *CoreMenuDeleteOne {
-	builder := c.Delete().Where(coremenu.ID(id))
+func (c *CoreGatewayRateLimitRuleClient) DeleteOneID(id string) *CoreGatewayRateLimitRuleDeleteOne {
+	builder := c.Delete().Where(coregatewayratelimitrule.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &CoreMenuDeleteOne{builder}
+	return &CoreGatewayRateLimitRuleDeleteOne{builder}
 }
 
-// Query returns a query builder for CoreMenu.
-func (c *CoreMenuClient) Query() *CoreMenuQuery {
-	return &CoreMenuQuery{
+// Query returns a query builder for CoreGatewayRateLimitRule.
+func (c *CoreGatewayRateLimitRuleClient) Query() *CoreGatewayRateLimitRuleQuery {
+	return &CoreGatewayRateLimitRuleQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeCoreMenu},
+		ctx:    &QueryContext{Type: TypeCoreGatewayRateLimitRule},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a CoreMenu entity by its id.
-func (c *CoreMenuClient) Get(ctx context.Context, id string) (*CoreMenu, error) {
+// Get returns a CoreGatewayRateLimitRule entity by its id.
+func (c *CoreGatewayRateLimitRuleClient) Get(ctx context.Context, id string) (*CoreGatewayRateLimitRule, error) {
+	return c.Query().Where(coregatewayratelimitrule.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *CoreGatewayRateLimitRuleClient) GetX(ctx context.Context, id string) *CoreGatewayRateLimitRule {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *CoreGatewayRateLimitRuleClient) Hooks() []Hook {
+	hooks := c.hooks.CoreGatewayRateLimitRule
+	return append(hooks[:len(hooks):len(hooks)], coregatewayratelimitrule.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *CoreGatewayRateLimitRuleClient) Interceptors() []Interceptor {
+	return c.inters.CoreGatewayRateLimitRule
+}
+
+func (c *CoreGatewayRateLimitRuleClient) mutate(ctx context.Context, m *CoreGatewayRateLimitRuleMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&CoreGatewayRateLimitRuleCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&CoreGatewayRateLimitRuleUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&CoreGatewayRateLimitRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&CoreGatewayRateLimitRuleDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown CoreGatewayRateLimitRule mutation op: %q", m.Op())
+	}
+}
+
+// CoreGatewayVirtualHostClient is a client for the CoreGatewayVirtualHost schema.
+type CoreGatewayVirtualHostClient struct {
+	config
+}
+
+// NewCoreGatewayVirtualHostClient returns a client for the CoreGatewayVirtualHost from the given config.
+func NewCoreGatewayVirtualHostClient(c config) *CoreGatewayVirtualHostClient {
+	return &CoreGatewayVirtualHostClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `coregatewayvirtualhost.Hooks(f(g(h())))`.
+func (c *CoreGatewayVirtualHostClient) Use(hooks ...Hook) {
+	c.hooks.CoreGatewayVirtualHost = append(c.hooks.CoreGatewayVirtualHost, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `coregatewayvirtualhost.Intercept(f(g(h())))`.
+func (c *CoreGatewayVirtualHostClient) Intercept(interceptors ...Interceptor) {
+	c.inters.CoreGatewayVirtualHost = append(c.inters.CoreGatewayVirtualHost, interceptors...)
+}
+
+// Create returns a builder for creating a CoreGatewayVirtualHost entity.
+func (c *CoreGatewayVirtualHostClient) Create() *CoreGatewayVirtualHostCreate {
+	mutation := newCoreGatewayVirtualHostMutation(c.config, OpCreate)
+	return &CoreGatewayVirtualHostCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of CoreGatewayVirtualHost entities.
+func (c *CoreGatewayVirtualHostClient) CreateBulk(builders ...*CoreGatewayVirtualHostCreate) *CoreGatewayVirtualHostCreateBulk {
+	return &CoreGatewayVirtualHostCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *CoreGatewayVirtualHostClient) MapCreateBulk(slice any, setFunc func(*CoreGatewayVirtualHostCreate, int)) *CoreGatewayVirtualHostCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &CoreGatewayVirtualHostCreateBulk{err: fmt.Errorf("calling to CoreGatewayVirtualHostClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*CoreGatewayVirtualHostCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &CoreGatewayVirtualHostCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for CoreGatewayVirtualHost.
+func (c *CoreGatewayVirtualHostClient) Update() *CoreGatewayVirtualHostUpdate {
+	mutation := newCoreGatewayVirtualHostMutation(c.config, OpUpdate)
+	return &CoreGatewayVirtualHostUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *CoreGatewayVirtualHostClient) UpdateOne(_m *CoreGatewayVirtualHost) *CoreGatewayVirtualHostUpdateOne {
+	mutation := newCoreGatewayVirtualHostMutation(c.config, OpUpdateOne, withCoreGatewayVirtualHost(_m))
+	return &CoreGatewayVirtualHostUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *CoreGatewayVirtualHostClient) UpdateOneID(id string) *CoreGatewayVirtualHostUpdateOne {
+	mutation := newCoreGatewayVirtualHostMutation(c.config, OpUpdateOne, withCoreGatewayVirtualHostID(id))
+	return &CoreGatewayVirtualHostUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for CoreGatewayVirtualHost.
+func (c *CoreGatewayVirtualHostClient) Delete() *CoreGatewayVirtualHostDelete {
+	mutation := newCoreGatewayVirtualHostMutation(c.config, OpDelete)
+	return &CoreGatewayVirtualHostDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *CoreGatewayVirtualHostClient) DeleteOne(_m *CoreGatewayVirtualHost) *CoreGatewayVirtualHostDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *CoreGatewayVirtualHostClient) DeleteOneID(id string) *CoreGatewayVirtualHostDeleteOne {
+	builder := c.Delete().Where(coregatewayvirtualhost.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &CoreGatewayVirtualHostDeleteOne{builder}
+}
+
+// Query returns a query builder for CoreGatewayVirtualHost.
+func (c *CoreGatewayVirtualHostClient) Query() *CoreGatewayVirtualHostQuery {
+	return &CoreGatewayVirtualHostQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeCoreGatewayVirtualHost},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a CoreGatewayVirtualHost entity by its id.
+func (c *CoreGatewayVirtualHostClient) Get(ctx context.Context, id string) (*CoreGatewayVirtualHost, error) {
+	return c.Query().Where(coregatewayvirtualhost.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *CoreGatewayVirtualHostClient) GetX(ctx context.Context, id string) *CoreGatewayVirtualHost {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryVhostFromListener queries the vhost_from_listener edge of a CoreGatewayVirtualHost.
+func (c *CoreGatewayVirtualHostClient) QueryVhostFromListener(_m *CoreGatewayVirtualHost) *CoreGatewayL7ListenerQuery {
+	query := (&CoreGatewayL7ListenerClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(coregatewayvirtualhost.Table, coregatewayvirtualhost.FieldID, id),
+			sqlgraph.To(coregatewayl7listener.Table, coregatewayl7listener.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, coregatewayvirtualhost.VhostFromListenerTable, coregatewayvirtualhost.VhostFromListenerColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryVhostToRoute queries the vhost_to_route edge of a CoreGatewayVirtualHost.
+func (c *CoreGatewayVirtualHostClient) QueryVhostToRoute(_m *CoreGatewayVirtualHost) *CoreGatewayHttpRouteQuery {
+	query := (&CoreGatewayHttpRouteClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(coregatewayvirtualhost.Table, coregatewayvirtualhost.FieldID, id),
+			sqlgraph.To(coregatewayhttproute.Table, coregatewayhttproute.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, coregatewayvirtualhost.VhostToRouteTable, coregatewayvirtualhost.VhostToRouteColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *CoreGatewayVirtualHostClient) Hooks() []Hook {
+	hooks := c.hooks.CoreGatewayVirtualHost
+	return append(hooks[:len(hooks):len(hooks)], coregatewayvirtualhost.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *CoreGatewayVirtualHostClient) Interceptors() []Interceptor {
+	return c.inters.CoreGatewayVirtualHost
+}
+
+func (c *CoreGatewayVirtualHostClient) mutate(ctx context.Context, m *CoreGatewayVirtualHostMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&CoreGatewayVirtualHostCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&CoreGatewayVirtualHostUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&CoreGatewayVirtualHostUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&CoreGatewayVirtualHostDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown CoreGatewayVirtualHost mutation op: %q", m.Op())
+	}
+}
+
+// CoreMenuClient is a client for the CoreMenu schema.
+type CoreMenuClient struct {
+	config
+}
+
+// NewCoreMenuClient returns a client for the CoreMenu from the given config.
+func NewCoreMenuClient(c config) *CoreMenuClient {
+	return &CoreMenuClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `coremenu.Hooks(f(g(h())))`.
+func (c *CoreMenuClient) Use(hooks ...Hook) {
+	c.hooks.CoreMenu = append(c.hooks.CoreMenu, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `coremenu.Intercept(f(g(h())))`.
+func (c *CoreMenuClient) Intercept(interceptors ...Interceptor) {
+	c.inters.CoreMenu = append(c.inters.CoreMenu, interceptors...)
+}
+
+// Create returns a builder for creating a CoreMenu entity.
+func (c *CoreMenuClient) Create() *CoreMenuCreate {
+	mutation := newCoreMenuMutation(c.config, OpCreate)
+	return &CoreMenuCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of CoreMenu entities.
+func (c *CoreMenuClient) CreateBulk(builders ...*CoreMenuCreate) *CoreMenuCreateBulk {
+	return &CoreMenuCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *CoreMenuClient) MapCreateBulk(slice any, setFunc func(*CoreMenuCreate, int)) *CoreMenuCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &CoreMenuCreateBulk{err: fmt.Errorf("calling to CoreMenuClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*CoreMenuCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &CoreMenuCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for CoreMenu.
+func (c *CoreMenuClient) Update() *CoreMenuUpdate {
+	mutation := newCoreMenuMutation(c.config, OpUpdate)
+	return &CoreMenuUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *CoreMenuClient) UpdateOne(_m *CoreMenu) *CoreMenuUpdateOne {
+	mutation := newCoreMenuMutation(c.config, OpUpdateOne, withCoreMenu(_m))
+	return &CoreMenuUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *CoreMenuClient) UpdateOneID(id string) *CoreMenuUpdateOne {
+	mutation := newCoreMenuMutation(c.config, OpUpdateOne, withCoreMenuID(id))
+	return &CoreMenuUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for CoreMenu.
+func (c *CoreMenuClient) Delete() *CoreMenuDelete {
+	mutation := newCoreMenuMutation(c.config, OpDelete)
+	return &CoreMenuDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *CoreMenuClient) DeleteOne(_m *CoreMenu) *CoreMenuDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *CoreMenuClient) DeleteOneID(id string) *CoreMenuDeleteOne {
+	builder := c.Delete().Where(coremenu.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &CoreMenuDeleteOne{builder}
+}
+
+// Query returns a query builder for CoreMenu.
+func (c *CoreMenuClient) Query() *CoreMenuQuery {
+	return &CoreMenuQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeCoreMenu},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a CoreMenu entity by its id.
+func (c *CoreMenuClient) Get(ctx context.Context, id string) (*CoreMenu, error) {
 	return c.Query().Where(coremenu.ID(id)).Only(ctx)
 }
 
@@ -1801,6 +2525,408 @@ func (c *CoreOperationLogClient) mutate(ctx context.Context, m *CoreOperationLog
 	}
 }
 
+// CoreProxyRevisionClient is a client for the CoreProxyRevision schema.
+type CoreProxyRevisionClient struct {
+	config
+}
+
+// NewCoreProxyRevisionClient returns a client for the CoreProxyRevision from the given config.
+func NewCoreProxyRevisionClient(c config) *CoreProxyRevisionClient {
+	return &CoreProxyRevisionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `coreproxyrevision.Hooks(f(g(h())))`.
+func (c *CoreProxyRevisionClient) Use(hooks ...Hook) {
+	c.hooks.CoreProxyRevision = append(c.hooks.CoreProxyRevision, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `coreproxyrevision.Intercept(f(g(h())))`.
+func (c *CoreProxyRevisionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.CoreProxyRevision = append(c.inters.CoreProxyRevision, interceptors...)
+}
+
+// Create returns a builder for creating a CoreProxyRevision entity.
+func (c *CoreProxyRevisionClient) Create() *CoreProxyRevisionCreate {
+	mutation := newCoreProxyRevisionMutation(c.config, OpCreate)
+	return &CoreProxyRevisionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of CoreProxyRevision entities.
+func (c *CoreProxyRevisionClient) CreateBulk(builders ...*CoreProxyRevisionCreate) *CoreProxyRevisionCreateBulk {
+	return &CoreProxyRevisionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *CoreProxyRevisionClient) MapCreateBulk(slice any, setFunc func(*CoreProxyRevisionCreate, int)) *CoreProxyRevisionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &CoreProxyRevisionCreateBulk{err: fmt.Errorf("calling to CoreProxyRevisionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*CoreProxyRevisionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &CoreProxyRevisionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for CoreProxyRevision.
+func (c *CoreProxyRevisionClient) Update() *CoreProxyRevisionUpdate {
+	mutation := newCoreProxyRevisionMutation(c.config, OpUpdate)
+	return &CoreProxyRevisionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *CoreProxyRevisionClient) UpdateOne(_m *CoreProxyRevision) *CoreProxyRevisionUpdateOne {
+	mutation := newCoreProxyRevisionMutation(c.config, OpUpdateOne, withCoreProxyRevision(_m))
+	return &CoreProxyRevisionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *CoreProxyRevisionClient) UpdateOneID(id string) *CoreProxyRevisionUpdateOne {
+	mutation := newCoreProxyRevisionMutation(c.config, OpUpdateOne, withCoreProxyRevisionID(id))
+	return &CoreProxyRevisionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for CoreProxyRevision.
+func (c *CoreProxyRevisionClient) Delete() *CoreProxyRevisionDelete {
+	mutation := newCoreProxyRevisionMutation(c.config, OpDelete)
+	return &CoreProxyRevisionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *CoreProxyRevisionClient) DeleteOne(_m *CoreProxyRevision) *CoreProxyRevisionDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *CoreProxyRevisionClient) DeleteOneID(id string) *CoreProxyRevisionDeleteOne {
+	builder := c.Delete().Where(coreproxyrevision.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &CoreProxyRevisionDeleteOne{builder}
+}
+
+// Query returns a query builder for CoreProxyRevision.
+func (c *CoreProxyRevisionClient) Query() *CoreProxyRevisionQuery {
+	return &CoreProxyRevisionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeCoreProxyRevision},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a CoreProxyRevision entity by its id.
+func (c *CoreProxyRevisionClient) Get(ctx context.Context, id string) (*CoreProxyRevision, error) {
+	return c.Query().Where(coreproxyrevision.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *CoreProxyRevisionClient) GetX(ctx context.Context, id string) *CoreProxyRevision {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *CoreProxyRevisionClient) Hooks() []Hook {
+	hooks := c.hooks.CoreProxyRevision
+	return append(hooks[:len(hooks):len(hooks)], coreproxyrevision.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *CoreProxyRevisionClient) Interceptors() []Interceptor {
+	return c.inters.CoreProxyRevision
+}
+
+func (c *CoreProxyRevisionClient) mutate(ctx context.Context, m *CoreProxyRevisionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&CoreProxyRevisionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&CoreProxyRevisionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&CoreProxyRevisionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&CoreProxyRevisionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown CoreProxyRevision mutation op: %q", m.Op())
+	}
+}
+
+// CoreProxyRolloutClient is a client for the CoreProxyRollout schema.
+type CoreProxyRolloutClient struct {
+	config
+}
+
+// NewCoreProxyRolloutClient returns a client for the CoreProxyRollout from the given config.
+func NewCoreProxyRolloutClient(c config) *CoreProxyRolloutClient {
+	return &CoreProxyRolloutClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `coreproxyrollout.Hooks(f(g(h())))`.
+func (c *CoreProxyRolloutClient) Use(hooks ...Hook) {
+	c.hooks.CoreProxyRollout = append(c.hooks.CoreProxyRollout, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `coreproxyrollout.Intercept(f(g(h())))`.
+func (c *CoreProxyRolloutClient) Intercept(interceptors ...Interceptor) {
+	c.inters.CoreProxyRollout = append(c.inters.CoreProxyRollout, interceptors...)
+}
+
+// Create returns a builder for creating a CoreProxyRollout entity.
+func (c *CoreProxyRolloutClient) Create() *CoreProxyRolloutCreate {
+	mutation := newCoreProxyRolloutMutation(c.config, OpCreate)
+	return &CoreProxyRolloutCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of CoreProxyRollout entities.
+func (c *CoreProxyRolloutClient) CreateBulk(builders ...*CoreProxyRolloutCreate) *CoreProxyRolloutCreateBulk {
+	return &CoreProxyRolloutCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *CoreProxyRolloutClient) MapCreateBulk(slice any, setFunc func(*CoreProxyRolloutCreate, int)) *CoreProxyRolloutCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &CoreProxyRolloutCreateBulk{err: fmt.Errorf("calling to CoreProxyRolloutClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*CoreProxyRolloutCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &CoreProxyRolloutCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for CoreProxyRollout.
+func (c *CoreProxyRolloutClient) Update() *CoreProxyRolloutUpdate {
+	mutation := newCoreProxyRolloutMutation(c.config, OpUpdate)
+	return &CoreProxyRolloutUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *CoreProxyRolloutClient) UpdateOne(_m *CoreProxyRollout) *CoreProxyRolloutUpdateOne {
+	mutation := newCoreProxyRolloutMutation(c.config, OpUpdateOne, withCoreProxyRollout(_m))
+	return &CoreProxyRolloutUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *CoreProxyRolloutClient) UpdateOneID(id string) *CoreProxyRolloutUpdateOne {
+	mutation := newCoreProxyRolloutMutation(c.config, OpUpdateOne, withCoreProxyRolloutID(id))
+	return &CoreProxyRolloutUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for CoreProxyRollout.
+func (c *CoreProxyRolloutClient) Delete() *CoreProxyRolloutDelete {
+	mutation := newCoreProxyRolloutMutation(c.config, OpDelete)
+	return &CoreProxyRolloutDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *CoreProxyRolloutClient) DeleteOne(_m *CoreProxyRollout) *CoreProxyRolloutDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *CoreProxyRolloutClient) DeleteOneID(id string) *CoreProxyRolloutDeleteOne {
+	builder := c.Delete().Where(coreproxyrollout.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &CoreProxyRolloutDeleteOne{builder}
+}
+
+// Query returns a query builder for CoreProxyRollout.
+func (c *CoreProxyRolloutClient) Query() *CoreProxyRolloutQuery {
+	return &CoreProxyRolloutQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeCoreProxyRollout},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a CoreProxyRollout entity by its id.
+func (c *CoreProxyRolloutClient) Get(ctx context.Context, id string) (*CoreProxyRollout, error) {
+	return c.Query().Where(coreproxyrollout.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *CoreProxyRolloutClient) GetX(ctx context.Context, id string) *CoreProxyRollout {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *CoreProxyRolloutClient) Hooks() []Hook {
+	hooks := c.hooks.CoreProxyRollout
+	return append(hooks[:len(hooks):len(hooks)], coreproxyrollout.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *CoreProxyRolloutClient) Interceptors() []Interceptor {
+	return c.inters.CoreProxyRollout
+}
+
+func (c *CoreProxyRolloutClient) mutate(ctx context.Context, m *CoreProxyRolloutMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&CoreProxyRolloutCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&CoreProxyRolloutUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&CoreProxyRolloutUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&CoreProxyRolloutDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown CoreProxyRollout mutation op: %q", m.Op())
+	}
+}
+
+// CoreProxyRolloutPolicyClient is a client for the CoreProxyRolloutPolicy schema.
+type CoreProxyRolloutPolicyClient struct {
+	config
+}
+
+// NewCoreProxyRolloutPolicyClient returns a client for the CoreProxyRolloutPolicy from the given config.
+func NewCoreProxyRolloutPolicyClient(c config) *CoreProxyRolloutPolicyClient {
+	return &CoreProxyRolloutPolicyClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `coreproxyrolloutpolicy.Hooks(f(g(h())))`.
+func (c *CoreProxyRolloutPolicyClient) Use(hooks ...Hook) {
+	c.hooks.CoreProxyRolloutPolicy = append(c.hooks.CoreProxyRolloutPolicy, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `coreproxyrolloutpolicy.Intercept(f(g(h())))`.
+func (c *CoreProxyRolloutPolicyClient) Intercept(interceptors ...Interceptor) {
+	c.inters.CoreProxyRolloutPolicy = append(c.inters.CoreProxyRolloutPolicy, interceptors...)
+}
+
+// Create returns a builder for creating a CoreProxyRolloutPolicy entity.
+func (c *CoreProxyRolloutPolicyClient) Create() *CoreProxyRolloutPolicyCreate {
+	mutation := newCoreProxyRolloutPolicyMutation(c.config, OpCreate)
+	return &CoreProxyRolloutPolicyCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of CoreProxyRolloutPolicy entities.
+func (c *CoreProxyRolloutPolicyClient) CreateBulk(builders ...*CoreProxyRolloutPolicyCreate) *CoreProxyRolloutPolicyCreateBulk {
+	return &CoreProxyRolloutPolicyCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *CoreProxyRolloutPolicyClient) MapCreateBulk(slice any, setFunc func(*CoreProxyRolloutPolicyCreate, int)) *CoreProxyRolloutPolicyCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &CoreProxyRolloutPolicyCreateBulk{err: fmt.Errorf("calling to CoreProxyRolloutPolicyClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*CoreProxyRolloutPolicyCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &CoreProxyRolloutPolicyCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for CoreProxyRolloutPolicy.
+func (c *CoreProxyRolloutPolicyClient) Update() *CoreProxyRolloutPolicyUpdate {
+	mutation := newCoreProxyRolloutPolicyMutation(c.config, OpUpdate)
+	return &CoreProxyRolloutPolicyUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *CoreProxyRolloutPolicyClient) UpdateOne(_m *CoreProxyRolloutPolicy) *CoreProxyRolloutPolicyUpdateOne {
+	mutation := newCoreProxyRolloutPolicyMutation(c.config, OpUpdateOne, withCoreProxyRolloutPolicy(_m))
+	return &CoreProxyRolloutPolicyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *CoreProxyRolloutPolicyClient) UpdateOneID(id string) *CoreProxyRolloutPolicyUpdateOne {
+	mutation := newCoreProxyRolloutPolicyMutation(c.config, OpUpdateOne, withCoreProxyRolloutPolicyID(id))
+	return &CoreProxyRolloutPolicyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for CoreProxyRolloutPolicy.
+func (c *CoreProxyRolloutPolicyClient) Delete() *CoreProxyRolloutPolicyDelete {
+	mutation := newCoreProxyRolloutPolicyMutation(c.config, OpDelete)
+	return &CoreProxyRolloutPolicyDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *CoreProxyRolloutPolicyClient) DeleteOne(_m *CoreProxyRolloutPolicy) *CoreProxyRolloutPolicyDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *CoreProxyRolloutPolicyClient) DeleteOneID(id string) *CoreProxyRolloutPolicyDeleteOne {
+	builder := c.Delete().Where(coreproxyrolloutpolicy.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &CoreProxyRolloutPolicyDeleteOne{builder}
+}
+
+// Query returns a query builder for CoreProxyRolloutPolicy.
+func (c *CoreProxyRolloutPolicyClient) Query() *CoreProxyRolloutPolicyQuery {
+	return &CoreProxyRolloutPolicyQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeCoreProxyRolloutPolicy},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a CoreProxyRolloutPolicy entity by its id.
+func (c *CoreProxyRolloutPolicyClient) Get(ctx context.Context, id string) (*CoreProxyRolloutPolicy, error) {
+	return c.Query().Where(coreproxyrolloutpolicy.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *CoreProxyRolloutPolicyClient) GetX(ctx context.Context, id string) *CoreProxyRolloutPolicy {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *CoreProxyRolloutPolicyClient) Hooks() []Hook {
+	hooks := c.hooks.CoreProxyRolloutPolicy
+	return append(hooks[:len(hooks):len(hooks)], coreproxyrolloutpolicy.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *CoreProxyRolloutPolicyClient) Interceptors() []Interceptor {
+	return c.inters.CoreProxyRolloutPolicy
+}
+
+func (c *CoreProxyRolloutPolicyClient) mutate(ctx context.Context, m *CoreProxyRolloutPolicyMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&CoreProxyRolloutPolicyCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&CoreProxyRolloutPolicyUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&CoreProxyRolloutPolicyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&CoreProxyRolloutPolicyDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown CoreProxyRolloutPolicy mutation op: %q", m.Op())
+	}
+}
+
 // CoreRoleClient is a client for the CoreRole schema.
 type CoreRoleClient struct {
 	config
@@ -2075,6 +3201,54 @@ func (c *CoreUpstreamClient) GetX(ctx context.Context, id string) *CoreUpstream
 	return obj
 }
 
+// QueryUpstreamToHost queries the upstream_to_host edge of a CoreUpstream.
+func (c *CoreUpstreamClient) QueryUpstreamToHost(_m *CoreUpstream) *CoreUpstreamHostQuery {
+	query := (&CoreUpstreamHostClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(coreupstream.Table, coreupstream.FieldID, id),
+			sqlgraph.To(coreupstreamhost.Table, coreupstreamhost.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, coreupstream.UpstreamToHostTable, coreupstream.UpstreamToHostColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryUpstreamToRoute queries the upstream_to_route edge of a CoreUpstream.
+func (c *CoreUpstreamClient) QueryUpstreamToRoute(_m *CoreUpstream) *CoreGatewayHttpRouteQuery {
+	query := (&CoreGatewayHttpRouteClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(coreupstream.Table, coreupstream.FieldID, id),
+			sqlgraph.To(coregatewayhttproute.Table, coregatewayhttproute.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, coreupstream.UpstreamToRouteTable, coreupstream.UpstreamToRouteColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryUpstreamToTarget queries the upstream_to_target edge of a CoreUpstream.
+func (c *CoreUpstreamClient) QueryUpstreamToTarget(_m *CoreUpstream) *CoreGatewayHttpRouteTargetQuery {
+	query := (&CoreGatewayHttpRouteTargetClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(coreupstream.Table, coreupstream.FieldID, id),
+			sqlgraph.To(coregatewayhttproutetarget.Table, coregatewayhttproutetarget.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, coreupstream.UpstreamToTargetTable, coreupstream.UpstreamToTargetColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *CoreUpstreamClient) Hooks() []Hook {
 	hooks := c.hooks.CoreUpstream
@@ -2209,6 +3383,22 @@ func (c *CoreUpstreamHostClient) GetX(ctx context.Context, id string) *CoreUpstr
 	return obj
 }
 
+// QueryHostFromUpstream queries the host_from_upstream edge of a CoreUpstreamHost.
+func (c *CoreUpstreamHostClient) QueryHostFromUpstream(_m *CoreUpstreamHost) *CoreUpstreamQuery {
+	query := (&CoreUpstreamClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(coreupstreamhost.Table, coreupstreamhost.FieldID, id),
+			sqlgraph.To(coreupstream.Table, coreupstream.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, coreupstreamhost.HostFromUpstreamTable, coreupstreamhost.HostFromUpstreamColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *CoreUpstreamHostClient) Hooks() []Hook {
 	hooks := c.hooks.CoreUpstreamHost
@@ -2420,16 +3610,20 @@ func (c *CoreUserClient) mutate(ctx context.Context, m *CoreUserMutation) (Value
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		CoreCert, CoreDataRelationship, CoreGatewayCluster, CoreGatewayHttpRoute,
-		CoreGatewayL4Listener, CoreGatewayL7Listener, CoreGatewayNode, CoreMenu,
-		CoreOnLineUser, CoreOperationLog, CoreRole, CoreUpstream, CoreUpstreamHost,
-		CoreUser []ent.Hook
+		CoreCert, CoreDataRelationship, CoreGateway, CoreGatewayCluster,
+		CoreGatewayHttpRoute, CoreGatewayHttpRouteTarget, CoreGatewayL4Listener,
+		CoreGatewayL7Listener, CoreGatewayNode, CoreGatewayRateLimitRule,
+		CoreGatewayVirtualHost, CoreMenu, CoreOnLineUser, CoreOperationLog,
+		CoreProxyRevision, CoreProxyRollout, CoreProxyRolloutPolicy, CoreRole,
+		CoreUpstream, CoreUpstreamHost, CoreUser []ent.Hook
 	}
 	inters struct {
-		CoreCert, CoreDataRelationship, CoreGatewayCluster, CoreGatewayHttpRoute,
-		CoreGatewayL4Listener, CoreGatewayL7Listener, CoreGatewayNode, CoreMenu,
-		CoreOnLineUser, CoreOperationLog, CoreRole, CoreUpstream, CoreUpstreamHost,
-		CoreUser []ent.Interceptor
+		CoreCert, CoreDataRelationship, CoreGateway, CoreGatewayCluster,
+		CoreGatewayHttpRoute, CoreGatewayHttpRouteTarget, CoreGatewayL4Listener,
+		CoreGatewayL7Listener, CoreGatewayNode, CoreGatewayRateLimitRule,
+		CoreGatewayVirtualHost, CoreMenu, CoreOnLineUser, CoreOperationLog,
+		CoreProxyRevision, CoreProxyRollout, CoreProxyRolloutPolicy, CoreRole,
+		CoreUpstream, CoreUpstreamHost, CoreUser []ent.Interceptor
 	}
 )
 
@@ -0,0 +1,210 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/coregateway"
+	"github.com/lyonmu/quebec/pkg/constant"
+)
+
+// 网关实例配置同步状态表
+type CoreGateway struct {
+	config `json:"-"`
+	// ID of the ent.
+	// 主键ID
+	ID string `json:"id,omitempty"`
+	// 创建时间
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// 更新时间
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// 删除时间
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// 网关ID
+	GatewayID int64 `json:"gateway_id,omitempty"`
+	// 网关已应用的配置修订号
+	AppliedRevision int64 `json:"applied_revision,omitempty"`
+	// 最近被网关拒绝的配置修订号
+	RejectedRevision int64 `json:"rejected_revision,omitempty"`
+	// 最近一次配置被拒绝的原因，为空表示最新配置已应用
+	LastError string `json:"last_error,omitempty"`
+	// 最近一次配置回执时间
+	LastSyncTime int64 `json:"last_sync_time,omitempty"`
+	// 是否在线 [1: 在线, 2: 离线]
+	Status constant.YesOrNo `json:"status,omitempty"`
+	// 是否提供全局限流服务 [1: 是, 2: 否]，未提供时路由的全局限流不生效
+	GlobalRateLimit constant.YesOrNo `json:"global_rate_limit,omitempty"`
+	selectValues    sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*CoreGateway) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case coregateway.FieldGatewayID, coregateway.FieldAppliedRevision, coregateway.FieldRejectedRevision, coregateway.FieldLastSyncTime, coregateway.FieldStatus, coregateway.FieldGlobalRateLimit:
+			values[i] = new(sql.NullInt64)
+		case coregateway.FieldID, coregateway.FieldLastError:
+			values[i] = new(sql.NullString)
+		case coregateway.FieldCreatedAt, coregateway.FieldUpdatedAt, coregateway.FieldDeletedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the CoreGateway fields.
+func (_m *CoreGateway) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case coregateway.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case coregateway.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case coregateway.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case coregateway.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				_m.DeletedAt = new(time.Time)
+				*_m.DeletedAt = value.Time
+			}
+		case coregateway.FieldGatewayID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field gateway_id", values[i])
+			} else if value.Valid {
+				_m.GatewayID = value.Int64
+			}
+		case coregateway.FieldAppliedRevision:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field applied_revision", values[i])
+			} else if value.Valid {
+				_m.AppliedRevision = value.Int64
+			}
+		case coregateway.FieldRejectedRevision:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field rejected_revision", values[i])
+			} else if value.Valid {
+				_m.RejectedRevision = value.Int64
+			}
+		case coregateway.FieldLastError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field last_error", values[i])
+			} else if value.Valid {
+				_m.LastError = value.String
+			}
+		case coregateway.FieldLastSyncTime:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field last_sync_time", values[i])
+			} else if value.Valid {
+				_m.LastSyncTime = value.Int64
+			}
+		case coregateway.FieldStatus:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = constant.YesOrNo(value.Int64)
+			}
+		case coregateway.FieldGlobalRateLimit:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field global_rate_limit", values[i])
+			} else if value.Valid {
+				_m.GlobalRateLimit = constant.YesOrNo(value.Int64)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the CoreGateway.
+// This includes values selected through modifiers, order, etc.
+func (_m *CoreGateway) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this CoreGateway.
+// Note that you need to call CoreGateway.Unwrap() before calling this method if this CoreGateway
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *CoreGateway) Update() *CoreGatewayUpdateOne {
+	return NewCoreGatewayClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the CoreGateway entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *CoreGateway) Unwrap() *CoreGateway {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: CoreGateway is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *CoreGateway) String() string {
+	var builder strings.Builder
+	builder.WriteString("CoreGateway(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("gateway_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.GatewayID))
+	builder.WriteString(", ")
+	builder.WriteString("applied_revision=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AppliedRevision))
+	builder.WriteString(", ")
+	builder.WriteString("rejected_revision=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RejectedRevision))
+	builder.WriteString(", ")
+	builder.WriteString("last_error=")
+	builder.WriteString(_m.LastError)
+	builder.WriteString(", ")
+	builder.WriteString("last_sync_time=")
+	builder.WriteString(fmt.Sprintf("%v", _m.LastSyncTime))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteString(", ")
+	builder.WriteString("global_rate_limit=")
+	builder.WriteString(fmt.Sprintf("%v", _m.GlobalRateLimit))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// CoreGateways is a parsable slice of CoreGateway.
+type CoreGateways []*CoreGateway
@@ -0,0 +1,148 @@
+// Code generated by ent, DO NOT EDIT.
+
+package coregateway
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/lyonmu/quebec/pkg/constant"
+)
+
+const (
+	// Label holds the string label denoting the coregateway type in the database.
+	Label = "core_gateway"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
+	FieldDeletedAt = "deleted_at"
+	// FieldGatewayID holds the string denoting the gateway_id field in the database.
+	FieldGatewayID = "gateway_id"
+	// FieldAppliedRevision holds the string denoting the applied_revision field in the database.
+	FieldAppliedRevision = "applied_revision"
+	// FieldRejectedRevision holds the string denoting the rejected_revision field in the database.
+	FieldRejectedRevision = "rejected_revision"
+	// FieldLastError holds the string denoting the last_error field in the database.
+	FieldLastError = "last_error"
+	// FieldLastSyncTime holds the string denoting the last_sync_time field in the database.
+	FieldLastSyncTime = "last_sync_time"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldGlobalRateLimit holds the string denoting the global_rate_limit field in the database.
+	FieldGlobalRateLimit = "global_rate_limit"
+	// Table holds the table name of the coregateway in the database.
+	Table = "quebec_core_gateway"
+)
+
+// Columns holds all SQL columns for coregateway fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldDeletedAt,
+	FieldGatewayID,
+	FieldAppliedRevision,
+	FieldRejectedRevision,
+	FieldLastError,
+	FieldLastSyncTime,
+	FieldStatus,
+	FieldGlobalRateLimit,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "github.com/lyonmu/quebec/cmd/core/internal/ent/runtime"
+var (
+	Hooks [2]ent.Hook
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultLastSyncTime holds the default value on creation for the "last_sync_time" field.
+	DefaultLastSyncTime func() int64
+	// DefaultStatus holds the default value on creation for the "status" field.
+	DefaultStatus constant.YesOrNo
+	// DefaultGlobalRateLimit holds the default value on creation for the "global_rate_limit" field.
+	DefaultGlobalRateLimit constant.YesOrNo
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() string
+	// IDValidator is a validator for the "id" field. It is called by the builders before save.
+	IDValidator func(string) error
+)
+
+// OrderOption defines the ordering options for the CoreGateway queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByDeletedAt orders the results by the deleted_at field.
+func ByDeletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletedAt, opts...).ToFunc()
+}
+
+// ByGatewayID orders the results by the gateway_id field.
+func ByGatewayID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGatewayID, opts...).ToFunc()
+}
+
+// ByAppliedRevision orders the results by the applied_revision field.
+func ByAppliedRevision(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAppliedRevision, opts...).ToFunc()
+}
+
+// ByRejectedRevision orders the results by the rejected_revision field.
+func ByRejectedRevision(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRejectedRevision, opts...).ToFunc()
+}
+
+// ByLastError orders the results by the last_error field.
+func ByLastError(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastError, opts...).ToFunc()
+}
+
+// ByLastSyncTime orders the results by the last_sync_time field.
+func ByLastSyncTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastSyncTime, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByGlobalRateLimit orders the results by the global_rate_limit field.
+func ByGlobalRateLimit(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGlobalRateLimit, opts...).ToFunc()
+}
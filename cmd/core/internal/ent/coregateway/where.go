@@ -0,0 +1,666 @@
+// Code generated by ent, DO NOT EDIT.
+
+package coregateway
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/predicate"
+	"github.com/lyonmu/quebec/pkg/constant"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.CoreGateway {
+	return predicate.CoreGateway(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.CoreGateway {
+	return predicate.CoreGateway(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.CoreGateway {
+	return predicate.CoreGateway(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.CoreGateway {
+	return predicate.CoreGateway(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.CoreGateway {
+	return predicate.CoreGateway(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.CoreGateway {
+	return predicate.CoreGateway(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.CoreGateway {
+	return predicate.CoreGateway(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.CoreGateway {
+	return predicate.CoreGateway(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.CoreGateway {
+	return predicate.CoreGateway(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.CoreGateway {
+	return predicate.CoreGateway(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.CoreGateway {
+	return predicate.CoreGateway(sql.FieldContainsFold(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.CoreGateway {
+	return predicate.CoreGateway(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.CoreGateway {
+	return predicate.CoreGateway(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.CoreGateway {
+	return predicate.CoreGateway(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// GatewayID applies equality check predicate on the "gateway_id" field. It's identical to GatewayIDEQ.
+func GatewayID(v int64) predicate.CoreGateway {
+	return predicate.CoreGateway(sql.FieldEQ(FieldGatewayID, v))
+}
+
+// AppliedRevision applies equality check predicate on the "applied_revision" field. It's identical to AppliedRevisionEQ.
+func AppliedRevision(v int64) predicate.CoreGateway {
+	return predicate.CoreGateway(sql.FieldEQ(FieldAppliedRevision, v))
+}
+
+// RejectedRevision applies equality check predicate on the "rejected_revision" field. It's identical to RejectedRevisionEQ.
+func RejectedRevision(v int64) predicate.CoreGateway {
+	return predicate.CoreGateway(sql.FieldEQ(FieldRejectedRevision, v))
+}
+
+// LastError applies equality check predicate on the "last_error" field. It's identical to LastErrorEQ.
+func LastError(v string) predicate.CoreGateway {
+	return predicate.CoreGateway(sql.FieldEQ(FieldLastError, v))
+}
+
+// LastSyncTime applies equality check predicate on the "last_sync_time" field. It's identical to LastSyncTimeEQ.
+func LastSyncTime(v int64) predicate.CoreGateway {
+	return predicate.CoreGateway(sql.FieldEQ(FieldLastSyncTime, v))
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v constant.YesOrNo) predicate.CoreGateway {
+	vc := int8(v)
+	return predicate.CoreGateway(sql.FieldEQ(FieldStatus, vc))
+}
+
+// GlobalRateLimit applies equality check predicate on the "global_rate_limit" field. It's identical to GlobalRateLimitEQ.
+func GlobalRateLimit(v constant.YesOrNo) predicate.CoreGateway {
+	vc := int8(v)
+	return predicate.CoreGateway(sql.FieldEQ(FieldGlobalRateLimit, vc))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.CoreGateway {
+	return predicate.CoreGateway(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.CoreGateway {
+	return predicate.CoreGateway(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.CoreGateway {
+	return predicate.CoreGateway(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.CoreGateway {
+	return predicate.CoreGateway(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.CoreGateway {
+	return predicate.CoreGateway(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.CoreGateway {
+	return predicate.CoreGateway(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.CoreGateway {
+	return predicate.CoreGateway(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.CoreGateway {
+	return predicate.CoreGateway(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.CoreGateway {
+	return predicate.CoreGateway(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.CoreGateway {
+	return predicate.CoreGateway(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.CoreGateway {
+	return predicate.CoreGateway(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.CoreGateway {
+	return predicate.CoreGateway(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.CoreGateway {
+	return predicate.CoreGateway(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.CoreGateway {
+	return predicate.CoreGateway(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.CoreGateway {
+	return predicate.CoreGateway(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.CoreGateway {
+	return predicate.CoreGateway(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.CoreGateway {
+	return predicate.CoreGateway(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.CoreGateway {
+	return predicate.CoreGateway(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.CoreGateway {
+	return predicate.CoreGateway(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.CoreGateway {
+	return predicate.CoreGateway(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.CoreGateway {
+	return predicate.CoreGateway(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.CoreGateway {
+	return predicate.CoreGateway(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.CoreGateway {
+	return predicate.CoreGateway(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.CoreGateway {
+	return predicate.CoreGateway(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.CoreGateway {
+	return predicate.CoreGateway(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.CoreGateway {
+	return predicate.CoreGateway(sql.FieldNotNull(FieldDeletedAt))
+}
+
+// GatewayIDEQ applies the EQ predicate on the "gateway_id" field.
+func GatewayIDEQ(v int64) predicate.CoreGateway {
+	return predicate.CoreGateway(sql.FieldEQ(FieldGatewayID, v))
+}
+
+// GatewayIDNEQ applies the NEQ predicate on the "gateway_id" field.
+func GatewayIDNEQ(v int64) predicate.CoreGateway {
+	return predicate.CoreGateway(sql.FieldNEQ(FieldGatewayID, v))
+}
+
+// GatewayIDIn applies the In predicate on the "gateway_id" field.
+func GatewayIDIn(vs ...int64) predicate.CoreGateway {
+	return predicate.CoreGateway(sql.FieldIn(FieldGatewayID, vs...))
+}
+
+// GatewayIDNotIn applies the NotIn predicate on the "gateway_id" field.
+func GatewayIDNotIn(vs ...int64) predicate.CoreGateway {
+	return predicate.CoreGateway(sql.FieldNotIn(FieldGatewayID, vs...))
+}
+
+// GatewayIDGT applies the GT predicate on the "gateway_id" field.
+func GatewayIDGT(v int64) predicate.CoreGateway {
+	return predicate.CoreGateway(sql.FieldGT(FieldGatewayID, v))
+}
+
+// GatewayIDGTE applies the GTE predicate on the "gateway_id" field.
+func GatewayIDGTE(v int64) predicate.CoreGateway {
+	return predicate.CoreGateway(sql.FieldGTE(FieldGatewayID, v))
+}
+
+// GatewayIDLT applies the LT predicate on the "gateway_id" field.
+func GatewayIDLT(v int64) predicate.CoreGateway {
+	return predicate.CoreGateway(sql.FieldLT(FieldGatewayID, v))
+}
+
+// GatewayIDLTE applies the LTE predicate on the "gateway_id" field.
+func GatewayIDLTE(v int64) predicate.CoreGateway {
+	return predicate.CoreGateway(sql.FieldLTE(FieldGatewayID, v))
+}
+
+// GatewayIDIsNil applies the IsNil predicate on the "gateway_id" field.
+func GatewayIDIsNil() predicate.CoreGateway {
+	return predicate.CoreGateway(sql.FieldIsNull(FieldGatewayID))
+}
+
+// GatewayIDNotNil applies the NotNil predicate on the "gateway_id" field.
+func GatewayIDNotNil() predicate.CoreGateway {
+	return predicate.CoreGateway(sql.FieldNotNull(FieldGatewayID))
+}
+
+// AppliedRevisionEQ applies the EQ predicate on the "applied_revision" field.
+func AppliedRevisionEQ(v int64) predicate.CoreGateway {
+	return predicate.CoreGateway(sql.FieldEQ(FieldAppliedRevision, v))
+}
+
+// AppliedRevisionNEQ applies the NEQ predicate on the "applied_revision" field.
+func AppliedRevisionNEQ(v int64) predicate.CoreGateway {
+	return predicate.CoreGateway(sql.FieldNEQ(FieldAppliedRevision, v))
+}
+
+// AppliedRevisionIn applies the In predicate on the "applied_revision" field.
+func AppliedRevisionIn(vs ...int64) predicate.CoreGateway {
+	return predicate.CoreGateway(sql.FieldIn(FieldAppliedRevision, vs...))
+}
+
+// AppliedRevisionNotIn applies the NotIn predicate on the "applied_revision" field.
+func AppliedRevisionNotIn(vs ...int64) predicate.CoreGateway {
+	return predicate.CoreGateway(sql.FieldNotIn(FieldAppliedRevision, vs...))
+}
+
+// AppliedRevisionGT applies the GT predicate on the "applied_revision" field.
+func AppliedRevisionGT(v int64) predicate.CoreGateway {
+	return predicate.CoreGateway(sql.FieldGT(FieldAppliedRevision, v))
+}
+
+// AppliedRevisionGTE applies the GTE predicate on the "applied_revision" field.
+func AppliedRevisionGTE(v int64) predicate.CoreGateway {
+	return predicate.CoreGateway(sql.FieldGTE(FieldAppliedRevision, v))
+}
+
+// AppliedRevisionLT applies the LT predicate on the "applied_revision" field.
+func AppliedRevisionLT(v int64) predicate.CoreGateway {
+	return predicate.CoreGateway(sql.FieldLT(FieldAppliedRevision, v))
+}
+
+// AppliedRevisionLTE applies the LTE predicate on the "applied_revision" field.
+func AppliedRevisionLTE(v int64) predicate.CoreGateway {
+	return predicate.CoreGateway(sql.FieldLTE(FieldAppliedRevision, v))
+}
+
+// AppliedRevisionIsNil applies the IsNil predicate on the "applied_revision" field.
+func AppliedRevisionIsNil() predicate.CoreGateway {
+	return predicate.CoreGateway(sql.FieldIsNull(FieldAppliedRevision))
+}
+
+// AppliedRevisionNotNil applies the NotNil predicate on the "applied_revision" field.
+func AppliedRevisionNotNil() predicate.CoreGateway {
+	return predicate.CoreGateway(sql.FieldNotNull(FieldAppliedRevision))
+}
+
+// RejectedRevisionEQ applies the EQ predicate on the "rejected_revision" field.
+func RejectedRevisionEQ(v int64) predicate.CoreGateway {
+	return predicate.CoreGateway(sql.FieldEQ(FieldRejectedRevision, v))
+}
+
+// RejectedRevisionNEQ applies the NEQ predicate on the "rejected_revision" field.
+func RejectedRevisionNEQ(v int64) predicate.CoreGateway {
+	return predicate.CoreGateway(sql.FieldNEQ(FieldRejectedRevision, v))
+}
+
+// RejectedRevisionIn applies the In predicate on the "rejected_revision" field.
+func RejectedRevisionIn(vs ...int64) predicate.CoreGateway {
+	return predicate.CoreGateway(sql.FieldIn(FieldRejectedRevision, vs...))
+}
+
+// RejectedRevisionNotIn applies the NotIn predicate on the "rejected_revision" field.
+func RejectedRevisionNotIn(vs ...int64) predicate.CoreGateway {
+	return predicate.CoreGateway(sql.FieldNotIn(FieldRejectedRevision, vs...))
+}
+
+// RejectedRevisionGT applies the GT predicate on the "rejected_revision" field.
+func RejectedRevisionGT(v int64) predicate.CoreGateway {
+	return predicate.CoreGateway(sql.FieldGT(FieldRejectedRevision, v))
+}
+
+// RejectedRevisionGTE applies the GTE predicate on the "rejected_revision" field.
+func RejectedRevisionGTE(v int64) predicate.CoreGateway {
+	return predicate.CoreGateway(sql.FieldGTE(FieldRejectedRevision, v))
+}
+
+// RejectedRevisionLT applies the LT predicate on the "rejected_revision" field.
+func RejectedRevisionLT(v int64) predicate.CoreGateway {
+	return predicate.CoreGateway(sql.FieldLT(FieldRejectedRevision, v))
+}
+
+// RejectedRevisionLTE applies the LTE predicate on the "rejected_revision" field.
+func RejectedRevisionLTE(v int64) predicate.CoreGateway {
+	return predicate.CoreGateway(sql.FieldLTE(FieldRejectedRevision, v))
+}
+
+// RejectedRevisionIsNil applies the IsNil predicate on the "rejected_revision" field.
+func RejectedRevisionIsNil() predicate.CoreGateway {
+	return predicate.CoreGateway(sql.FieldIsNull(FieldRejectedRevision))
+}
+
+// RejectedRevisionNotNil applies the NotNil predicate on the "rejected_revision" field.
+func RejectedRevisionNotNil() predicate.CoreGateway {
+	return predicate.CoreGateway(sql.FieldNotNull(FieldRejectedRevision))
+}
+
+// LastErrorEQ applies the EQ predicate on the "last_error" field.
+func LastErrorEQ(v string) predicate.CoreGateway {
+	return predicate.CoreGateway(sql.FieldEQ(FieldLastError, v))
+}
+
+// LastErrorNEQ applies the NEQ predicate on the "last_error" field.
+func LastErrorNEQ(v string) predicate.CoreGateway {
+	return predicate.CoreGateway(sql.FieldNEQ(FieldLastError, v))
+}
+
+// LastErrorIn applies the In predicate on the "last_error" field.
+func LastErrorIn(vs ...string) predicate.CoreGateway {
+	return predicate.CoreGateway(sql.FieldIn(FieldLastError, vs...))
+}
+
+// LastErrorNotIn applies the NotIn predicate on the "last_error" field.
+func LastErrorNotIn(vs ...string) predicate.CoreGateway {
+	return predicate.CoreGateway(sql.FieldNotIn(FieldLastError, vs...))
+}
+
+// LastErrorGT applies the GT predicate on the "last_error" field.
+func LastErrorGT(v string) predicate.CoreGateway {
+	return predicate.CoreGateway(sql.FieldGT(FieldLastError, v))
+}
+
+// LastErrorGTE applies the GTE predicate on the "last_error" field.
+func LastErrorGTE(v string) predicate.CoreGateway {
+	return predicate.CoreGateway(sql.FieldGTE(FieldLastError, v))
+}
+
+// LastErrorLT applies the LT predicate on the "last_error" field.
+func LastErrorLT(v string) predicate.CoreGateway {
+	return predicate.CoreGateway(sql.FieldLT(FieldLastError, v))
+}
+
+// LastErrorLTE applies the LTE predicate on the "last_error" field.
+func LastErrorLTE(v string) predicate.CoreGateway {
+	return predicate.CoreGateway(sql.FieldLTE(FieldLastError, v))
+}
+
+// LastErrorContains applies the Contains predicate on the "last_error" field.
+func LastErrorContains(v string) predicate.CoreGateway {
+	return predicate.CoreGateway(sql.FieldContains(FieldLastError, v))
+}
+
+// LastErrorHasPrefix applies the HasPrefix predicate on the "last_error" field.
+func LastErrorHasPrefix(v string) predicate.CoreGateway {
+	return predicate.CoreGateway(sql.FieldHasPrefix(FieldLastError, v))
+}
+
+// LastErrorHasSuffix applies the HasSuffix predicate on the "last_error" field.
+func LastErrorHasSuffix(v string) predicate.CoreGateway {
+	return predicate.CoreGateway(sql.FieldHasSuffix(FieldLastError, v))
+}
+
+// LastErrorIsNil applies the IsNil predicate on the "last_error" field.
+func LastErrorIsNil() predicate.CoreGateway {
+	return predicate.CoreGateway(sql.FieldIsNull(FieldLastError))
+}
+
+// LastErrorNotNil applies the NotNil predicate on the "last_error" field.
+func LastErrorNotNil() predicate.CoreGateway {
+	return predicate.CoreGateway(sql.FieldNotNull(FieldLastError))
+}
+
+// LastErrorEqualFold applies the EqualFold predicate on the "last_error" field.
+func LastErrorEqualFold(v string) predicate.CoreGateway {
+	return predicate.CoreGateway(sql.FieldEqualFold(FieldLastError, v))
+}
+
+// LastErrorContainsFold applies the ContainsFold predicate on the "last_error" field.
+func LastErrorContainsFold(v string) predicate.CoreGateway {
+	return predicate.CoreGateway(sql.FieldContainsFold(FieldLastError, v))
+}
+
+// LastSyncTimeEQ applies the EQ predicate on the "last_sync_time" field.
+func LastSyncTimeEQ(v int64) predicate.CoreGateway {
+	return predicate.CoreGateway(sql.FieldEQ(FieldLastSyncTime, v))
+}
+
+// LastSyncTimeNEQ applies the NEQ predicate on the "last_sync_time" field.
+func LastSyncTimeNEQ(v int64) predicate.CoreGateway {
+	return predicate.CoreGateway(sql.FieldNEQ(FieldLastSyncTime, v))
+}
+
+// LastSyncTimeIn applies the In predicate on the "last_sync_time" field.
+func LastSyncTimeIn(vs ...int64) predicate.CoreGateway {
+	return predicate.CoreGateway(sql.FieldIn(FieldLastSyncTime, vs...))
+}
+
+// LastSyncTimeNotIn applies the NotIn predicate on the "last_sync_time" field.
+func LastSyncTimeNotIn(vs ...int64) predicate.CoreGateway {
+	return predicate.CoreGateway(sql.FieldNotIn(FieldLastSyncTime, vs...))
+}
+
+// LastSyncTimeGT applies the GT predicate on the "last_sync_time" field.
+func LastSyncTimeGT(v int64) predicate.CoreGateway {
+	return predicate.CoreGateway(sql.FieldGT(FieldLastSyncTime, v))
+}
+
+// LastSyncTimeGTE applies the GTE predicate on the "last_sync_time" field.
+func LastSyncTimeGTE(v int64) predicate.CoreGateway {
+	return predicate.CoreGateway(sql.FieldGTE(FieldLastSyncTime, v))
+}
+
+// LastSyncTimeLT applies the LT predicate on the "last_sync_time" field.
+func LastSyncTimeLT(v int64) predicate.CoreGateway {
+	return predicate.CoreGateway(sql.FieldLT(FieldLastSyncTime, v))
+}
+
+// LastSyncTimeLTE applies the LTE predicate on the "last_sync_time" field.
+func LastSyncTimeLTE(v int64) predicate.CoreGateway {
+	return predicate.CoreGateway(sql.FieldLTE(FieldLastSyncTime, v))
+}
+
+// LastSyncTimeIsNil applies the IsNil predicate on the "last_sync_time" field.
+func LastSyncTimeIsNil() predicate.CoreGateway {
+	return predicate.CoreGateway(sql.FieldIsNull(FieldLastSyncTime))
+}
+
+// LastSyncTimeNotNil applies the NotNil predicate on the "last_sync_time" field.
+func LastSyncTimeNotNil() predicate.CoreGateway {
+	return predicate.CoreGateway(sql.FieldNotNull(FieldLastSyncTime))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v constant.YesOrNo) predicate.CoreGateway {
+	vc := int8(v)
+	return predicate.CoreGateway(sql.FieldEQ(FieldStatus, vc))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v constant.YesOrNo) predicate.CoreGateway {
+	vc := int8(v)
+	return predicate.CoreGateway(sql.FieldNEQ(FieldStatus, vc))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...constant.YesOrNo) predicate.CoreGateway {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = int8(vs[i])
+	}
+	return predicate.CoreGateway(sql.FieldIn(FieldStatus, v...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...constant.YesOrNo) predicate.CoreGateway {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = int8(vs[i])
+	}
+	return predicate.CoreGateway(sql.FieldNotIn(FieldStatus, v...))
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v constant.YesOrNo) predicate.CoreGateway {
+	vc := int8(v)
+	return predicate.CoreGateway(sql.FieldGT(FieldStatus, vc))
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v constant.YesOrNo) predicate.CoreGateway {
+	vc := int8(v)
+	return predicate.CoreGateway(sql.FieldGTE(FieldStatus, vc))
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v constant.YesOrNo) predicate.CoreGateway {
+	vc := int8(v)
+	return predicate.CoreGateway(sql.FieldLT(FieldStatus, vc))
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v constant.YesOrNo) predicate.CoreGateway {
+	vc := int8(v)
+	return predicate.CoreGateway(sql.FieldLTE(FieldStatus, vc))
+}
+
+// StatusIsNil applies the IsNil predicate on the "status" field.
+func StatusIsNil() predicate.CoreGateway {
+	return predicate.CoreGateway(sql.FieldIsNull(FieldStatus))
+}
+
+// StatusNotNil applies the NotNil predicate on the "status" field.
+func StatusNotNil() predicate.CoreGateway {
+	return predicate.CoreGateway(sql.FieldNotNull(FieldStatus))
+}
+
+// GlobalRateLimitEQ applies the EQ predicate on the "global_rate_limit" field.
+func GlobalRateLimitEQ(v constant.YesOrNo) predicate.CoreGateway {
+	vc := int8(v)
+	return predicate.CoreGateway(sql.FieldEQ(FieldGlobalRateLimit, vc))
+}
+
+// GlobalRateLimitNEQ applies the NEQ predicate on the "global_rate_limit" field.
+func GlobalRateLimitNEQ(v constant.YesOrNo) predicate.CoreGateway {
+	vc := int8(v)
+	return predicate.CoreGateway(sql.FieldNEQ(FieldGlobalRateLimit, vc))
+}
+
+// GlobalRateLimitIn applies the In predicate on the "global_rate_limit" field.
+func GlobalRateLimitIn(vs ...constant.YesOrNo) predicate.CoreGateway {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = int8(vs[i])
+	}
+	return predicate.CoreGateway(sql.FieldIn(FieldGlobalRateLimit, v...))
+}
+
+// GlobalRateLimitNotIn applies the NotIn predicate on the "global_rate_limit" field.
+func GlobalRateLimitNotIn(vs ...constant.YesOrNo) predicate.CoreGateway {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = int8(vs[i])
+	}
+	return predicate.CoreGateway(sql.FieldNotIn(FieldGlobalRateLimit, v...))
+}
+
+// GlobalRateLimitGT applies the GT predicate on the "global_rate_limit" field.
+func GlobalRateLimitGT(v constant.YesOrNo) predicate.CoreGateway {
+	vc := int8(v)
+	return predicate.CoreGateway(sql.FieldGT(FieldGlobalRateLimit, vc))
+}
+
+// GlobalRateLimitGTE applies the GTE predicate on the "global_rate_limit" field.
+func GlobalRateLimitGTE(v constant.YesOrNo) predicate.CoreGateway {
+	vc := int8(v)
+	return predicate.CoreGateway(sql.FieldGTE(FieldGlobalRateLimit, vc))
+}
+
+// GlobalRateLimitLT applies the LT predicate on the "global_rate_limit" field.
+func GlobalRateLimitLT(v constant.YesOrNo) predicate.CoreGateway {
+	vc := int8(v)
+	return predicate.CoreGateway(sql.FieldLT(FieldGlobalRateLimit, vc))
+}
+
+// GlobalRateLimitLTE applies the LTE predicate on the "global_rate_limit" field.
+func GlobalRateLimitLTE(v constant.YesOrNo) predicate.CoreGateway {
+	vc := int8(v)
+	return predicate.CoreGateway(sql.FieldLTE(FieldGlobalRateLimit, vc))
+}
+
+// GlobalRateLimitIsNil applies the IsNil predicate on the "global_rate_limit" field.
+func GlobalRateLimitIsNil() predicate.CoreGateway {
+	return predicate.CoreGateway(sql.FieldIsNull(FieldGlobalRateLimit))
+}
+
+// GlobalRateLimitNotNil applies the NotNil predicate on the "global_rate_limit" field.
+func GlobalRateLimitNotNil() predicate.CoreGateway {
+	return predicate.CoreGateway(sql.FieldNotNull(FieldGlobalRateLimit))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.CoreGateway) predicate.CoreGateway {
+	return predicate.CoreGateway(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.CoreGateway) predicate.CoreGateway {
+	return predicate.CoreGateway(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.CoreGateway) predicate.CoreGateway {
+	return predicate.CoreGateway(sql.NotPredicates(p))
+}
@@ -0,0 +1,1328 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/coregateway"
+	"github.com/lyonmu/quebec/pkg/constant"
+)
+
+// CoreGatewayCreate is the builder for creating a CoreGateway entity.
+type CoreGatewayCreate struct {
+	config
+	mutation *CoreGatewayMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *CoreGatewayCreate) SetCreatedAt(v time.Time) *CoreGatewayCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *CoreGatewayCreate) SetNillableCreatedAt(v *time.Time) *CoreGatewayCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *CoreGatewayCreate) SetUpdatedAt(v time.Time) *CoreGatewayCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *CoreGatewayCreate) SetNillableUpdatedAt(v *time.Time) *CoreGatewayCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_c *CoreGatewayCreate) SetDeletedAt(v time.Time) *CoreGatewayCreate {
+	_c.mutation.SetDeletedAt(v)
+	return _c
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_c *CoreGatewayCreate) SetNillableDeletedAt(v *time.Time) *CoreGatewayCreate {
+	if v != nil {
+		_c.SetDeletedAt(*v)
+	}
+	return _c
+}
+
+// SetGatewayID sets the "gateway_id" field.
+func (_c *CoreGatewayCreate) SetGatewayID(v int64) *CoreGatewayCreate {
+	_c.mutation.SetGatewayID(v)
+	return _c
+}
+
+// SetNillableGatewayID sets the "gateway_id" field if the given value is not nil.
+func (_c *CoreGatewayCreate) SetNillableGatewayID(v *int64) *CoreGatewayCreate {
+	if v != nil {
+		_c.SetGatewayID(*v)
+	}
+	return _c
+}
+
+// SetAppliedRevision sets the "applied_revision" field.
+func (_c *CoreGatewayCreate) SetAppliedRevision(v int64) *CoreGatewayCreate {
+	_c.mutation.SetAppliedRevision(v)
+	return _c
+}
+
+// SetNillableAppliedRevision sets the "applied_revision" field if the given value is not nil.
+func (_c *CoreGatewayCreate) SetNillableAppliedRevision(v *int64) *CoreGatewayCreate {
+	if v != nil {
+		_c.SetAppliedRevision(*v)
+	}
+	return _c
+}
+
+// SetRejectedRevision sets the "rejected_revision" field.
+func (_c *CoreGatewayCreate) SetRejectedRevision(v int64) *CoreGatewayCreate {
+	_c.mutation.SetRejectedRevision(v)
+	return _c
+}
+
+// SetNillableRejectedRevision sets the "rejected_revision" field if the given value is not nil.
+func (_c *CoreGatewayCreate) SetNillableRejectedRevision(v *int64) *CoreGatewayCreate {
+	if v != nil {
+		_c.SetRejectedRevision(*v)
+	}
+	return _c
+}
+
+// SetLastError sets the "last_error" field.
+func (_c *CoreGatewayCreate) SetLastError(v string) *CoreGatewayCreate {
+	_c.mutation.SetLastError(v)
+	return _c
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (_c *CoreGatewayCreate) SetNillableLastError(v *string) *CoreGatewayCreate {
+	if v != nil {
+		_c.SetLastError(*v)
+	}
+	return _c
+}
+
+// SetLastSyncTime sets the "last_sync_time" field.
+func (_c *CoreGatewayCreate) SetLastSyncTime(v int64) *CoreGatewayCreate {
+	_c.mutation.SetLastSyncTime(v)
+	return _c
+}
+
+// SetNillableLastSyncTime sets the "last_sync_time" field if the given value is not nil.
+func (_c *CoreGatewayCreate) SetNillableLastSyncTime(v *int64) *CoreGatewayCreate {
+	if v != nil {
+		_c.SetLastSyncTime(*v)
+	}
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *CoreGatewayCreate) SetStatus(v constant.YesOrNo) *CoreGatewayCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *CoreGatewayCreate) SetNillableStatus(v *constant.YesOrNo) *CoreGatewayCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetGlobalRateLimit sets the "global_rate_limit" field.
+func (_c *CoreGatewayCreate) SetGlobalRateLimit(v constant.YesOrNo) *CoreGatewayCreate {
+	_c.mutation.SetGlobalRateLimit(v)
+	return _c
+}
+
+// SetNillableGlobalRateLimit sets the "global_rate_limit" field if the given value is not nil.
+func (_c *CoreGatewayCreate) SetNillableGlobalRateLimit(v *constant.YesOrNo) *CoreGatewayCreate {
+	if v != nil {
+		_c.SetGlobalRateLimit(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *CoreGatewayCreate) SetID(v string) *CoreGatewayCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *CoreGatewayCreate) SetNillableID(v *string) *CoreGatewayCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the CoreGatewayMutation object of the builder.
+func (_c *CoreGatewayCreate) Mutation() *CoreGatewayMutation {
+	return _c.mutation
+}
+
+// Save creates the CoreGateway in the database.
+func (_c *CoreGatewayCreate) Save(ctx context.Context) (*CoreGateway, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *CoreGatewayCreate) SaveX(ctx context.Context) *CoreGateway {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *CoreGatewayCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *CoreGatewayCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *CoreGatewayCreate) defaults() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		if coregateway.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized coregateway.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := coregateway.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		if coregateway.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized coregateway.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := coregateway.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.LastSyncTime(); !ok {
+		if coregateway.DefaultLastSyncTime == nil {
+			return fmt.Errorf("ent: uninitialized coregateway.DefaultLastSyncTime (forgotten import ent/runtime?)")
+		}
+		v := coregateway.DefaultLastSyncTime()
+		_c.mutation.SetLastSyncTime(v)
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		v := coregateway.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.GlobalRateLimit(); !ok {
+		v := coregateway.DefaultGlobalRateLimit
+		_c.mutation.SetGlobalRateLimit(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		if coregateway.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized coregateway.DefaultID (forgotten import ent/runtime?)")
+		}
+		v := coregateway.DefaultID()
+		_c.mutation.SetID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *CoreGatewayCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "CoreGateway.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "CoreGateway.updated_at"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := coregateway.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "CoreGateway.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *CoreGatewayCreate) sqlSave(ctx context.Context) (*CoreGateway, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected CoreGateway.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *CoreGatewayCreate) createSpec() (*CoreGateway, *sqlgraph.CreateSpec) {
+	var (
+		_node = &CoreGateway{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(coregateway.Table, sqlgraph.NewFieldSpec(coregateway.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(coregateway.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(coregateway.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.DeletedAt(); ok {
+		_spec.SetField(coregateway.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if value, ok := _c.mutation.GatewayID(); ok {
+		_spec.SetField(coregateway.FieldGatewayID, field.TypeInt64, value)
+		_node.GatewayID = value
+	}
+	if value, ok := _c.mutation.AppliedRevision(); ok {
+		_spec.SetField(coregateway.FieldAppliedRevision, field.TypeInt64, value)
+		_node.AppliedRevision = value
+	}
+	if value, ok := _c.mutation.RejectedRevision(); ok {
+		_spec.SetField(coregateway.FieldRejectedRevision, field.TypeInt64, value)
+		_node.RejectedRevision = value
+	}
+	if value, ok := _c.mutation.LastError(); ok {
+		_spec.SetField(coregateway.FieldLastError, field.TypeString, value)
+		_node.LastError = value
+	}
+	if value, ok := _c.mutation.LastSyncTime(); ok {
+		_spec.SetField(coregateway.FieldLastSyncTime, field.TypeInt64, value)
+		_node.LastSyncTime = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(coregateway.FieldStatus, field.TypeInt8, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.GlobalRateLimit(); ok {
+		_spec.SetField(coregateway.FieldGlobalRateLimit, field.TypeInt8, value)
+		_node.GlobalRateLimit = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.CoreGateway.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.CoreGatewayUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *CoreGatewayCreate) OnConflict(opts ...sql.ConflictOption) *CoreGatewayUpsertOne {
+	_c.conflict = opts
+	return &CoreGatewayUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.CoreGateway.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *CoreGatewayCreate) OnConflictColumns(columns ...string) *CoreGatewayUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &CoreGatewayUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// CoreGatewayUpsertOne is the builder for "upsert"-ing
+	//  one CoreGateway node.
+	CoreGatewayUpsertOne struct {
+		create *CoreGatewayCreate
+	}
+
+	// CoreGatewayUpsert is the "OnConflict" setter.
+	CoreGatewayUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *CoreGatewayUpsert) SetUpdatedAt(v time.Time) *CoreGatewayUpsert {
+	u.Set(coregateway.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *CoreGatewayUpsert) UpdateUpdatedAt() *CoreGatewayUpsert {
+	u.SetExcluded(coregateway.FieldUpdatedAt)
+	return u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *CoreGatewayUpsert) SetDeletedAt(v time.Time) *CoreGatewayUpsert {
+	u.Set(coregateway.FieldDeletedAt, v)
+	return u
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *CoreGatewayUpsert) UpdateDeletedAt() *CoreGatewayUpsert {
+	u.SetExcluded(coregateway.FieldDeletedAt)
+	return u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *CoreGatewayUpsert) ClearDeletedAt() *CoreGatewayUpsert {
+	u.SetNull(coregateway.FieldDeletedAt)
+	return u
+}
+
+// SetGatewayID sets the "gateway_id" field.
+func (u *CoreGatewayUpsert) SetGatewayID(v int64) *CoreGatewayUpsert {
+	u.Set(coregateway.FieldGatewayID, v)
+	return u
+}
+
+// UpdateGatewayID sets the "gateway_id" field to the value that was provided on create.
+func (u *CoreGatewayUpsert) UpdateGatewayID() *CoreGatewayUpsert {
+	u.SetExcluded(coregateway.FieldGatewayID)
+	return u
+}
+
+// AddGatewayID adds v to the "gateway_id" field.
+func (u *CoreGatewayUpsert) AddGatewayID(v int64) *CoreGatewayUpsert {
+	u.Add(coregateway.FieldGatewayID, v)
+	return u
+}
+
+// ClearGatewayID clears the value of the "gateway_id" field.
+func (u *CoreGatewayUpsert) ClearGatewayID() *CoreGatewayUpsert {
+	u.SetNull(coregateway.FieldGatewayID)
+	return u
+}
+
+// SetAppliedRevision sets the "applied_revision" field.
+func (u *CoreGatewayUpsert) SetAppliedRevision(v int64) *CoreGatewayUpsert {
+	u.Set(coregateway.FieldAppliedRevision, v)
+	return u
+}
+
+// UpdateAppliedRevision sets the "applied_revision" field to the value that was provided on create.
+func (u *CoreGatewayUpsert) UpdateAppliedRevision() *CoreGatewayUpsert {
+	u.SetExcluded(coregateway.FieldAppliedRevision)
+	return u
+}
+
+// AddAppliedRevision adds v to the "applied_revision" field.
+func (u *CoreGatewayUpsert) AddAppliedRevision(v int64) *CoreGatewayUpsert {
+	u.Add(coregateway.FieldAppliedRevision, v)
+	return u
+}
+
+// ClearAppliedRevision clears the value of the "applied_revision" field.
+func (u *CoreGatewayUpsert) ClearAppliedRevision() *CoreGatewayUpsert {
+	u.SetNull(coregateway.FieldAppliedRevision)
+	return u
+}
+
+// SetRejectedRevision sets the "rejected_revision" field.
+func (u *CoreGatewayUpsert) SetRejectedRevision(v int64) *CoreGatewayUpsert {
+	u.Set(coregateway.FieldRejectedRevision, v)
+	return u
+}
+
+// UpdateRejectedRevision sets the "rejected_revision" field to the value that was provided on create.
+func (u *CoreGatewayUpsert) UpdateRejectedRevision() *CoreGatewayUpsert {
+	u.SetExcluded(coregateway.FieldRejectedRevision)
+	return u
+}
+
+// AddRejectedRevision adds v to the "rejected_revision" field.
+func (u *CoreGatewayUpsert) AddRejectedRevision(v int64) *CoreGatewayUpsert {
+	u.Add(coregateway.FieldRejectedRevision, v)
+	return u
+}
+
+// ClearRejectedRevision clears the value of the "rejected_revision" field.
+func (u *CoreGatewayUpsert) ClearRejectedRevision() *CoreGatewayUpsert {
+	u.SetNull(coregateway.FieldRejectedRevision)
+	return u
+}
+
+// SetLastError sets the "last_error" field.
+func (u *CoreGatewayUpsert) SetLastError(v string) *CoreGatewayUpsert {
+	u.Set(coregateway.FieldLastError, v)
+	return u
+}
+
+// UpdateLastError sets the "last_error" field to the value that was provided on create.
+func (u *CoreGatewayUpsert) UpdateLastError() *CoreGatewayUpsert {
+	u.SetExcluded(coregateway.FieldLastError)
+	return u
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (u *CoreGatewayUpsert) ClearLastError() *CoreGatewayUpsert {
+	u.SetNull(coregateway.FieldLastError)
+	return u
+}
+
+// SetLastSyncTime sets the "last_sync_time" field.
+func (u *CoreGatewayUpsert) SetLastSyncTime(v int64) *CoreGatewayUpsert {
+	u.Set(coregateway.FieldLastSyncTime, v)
+	return u
+}
+
+// UpdateLastSyncTime sets the "last_sync_time" field to the value that was provided on create.
+func (u *CoreGatewayUpsert) UpdateLastSyncTime() *CoreGatewayUpsert {
+	u.SetExcluded(coregateway.FieldLastSyncTime)
+	return u
+}
+
+// AddLastSyncTime adds v to the "last_sync_time" field.
+func (u *CoreGatewayUpsert) AddLastSyncTime(v int64) *CoreGatewayUpsert {
+	u.Add(coregateway.FieldLastSyncTime, v)
+	return u
+}
+
+// ClearLastSyncTime clears the value of the "last_sync_time" field.
+func (u *CoreGatewayUpsert) ClearLastSyncTime() *CoreGatewayUpsert {
+	u.SetNull(coregateway.FieldLastSyncTime)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *CoreGatewayUpsert) SetStatus(v constant.YesOrNo) *CoreGatewayUpsert {
+	u.Set(coregateway.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *CoreGatewayUpsert) UpdateStatus() *CoreGatewayUpsert {
+	u.SetExcluded(coregateway.FieldStatus)
+	return u
+}
+
+// AddStatus adds v to the "status" field.
+func (u *CoreGatewayUpsert) AddStatus(v constant.YesOrNo) *CoreGatewayUpsert {
+	u.Add(coregateway.FieldStatus, v)
+	return u
+}
+
+// ClearStatus clears the value of the "status" field.
+func (u *CoreGatewayUpsert) ClearStatus() *CoreGatewayUpsert {
+	u.SetNull(coregateway.FieldStatus)
+	return u
+}
+
+// SetGlobalRateLimit sets the "global_rate_limit" field.
+func (u *CoreGatewayUpsert) SetGlobalRateLimit(v constant.YesOrNo) *CoreGatewayUpsert {
+	u.Set(coregateway.FieldGlobalRateLimit, v)
+	return u
+}
+
+// UpdateGlobalRateLimit sets the "global_rate_limit" field to the value that was provided on create.
+func (u *CoreGatewayUpsert) UpdateGlobalRateLimit() *CoreGatewayUpsert {
+	u.SetExcluded(coregateway.FieldGlobalRateLimit)
+	return u
+}
+
+// AddGlobalRateLimit adds v to the "global_rate_limit" field.
+func (u *CoreGatewayUpsert) AddGlobalRateLimit(v constant.YesOrNo) *CoreGatewayUpsert {
+	u.Add(coregateway.FieldGlobalRateLimit, v)
+	return u
+}
+
+// ClearGlobalRateLimit clears the value of the "global_rate_limit" field.
+func (u *CoreGatewayUpsert) ClearGlobalRateLimit() *CoreGatewayUpsert {
+	u.SetNull(coregateway.FieldGlobalRateLimit)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.CoreGateway.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(coregateway.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *CoreGatewayUpsertOne) UpdateNewValues() *CoreGatewayUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(coregateway.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(coregateway.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.CoreGateway.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *CoreGatewayUpsertOne) Ignore() *CoreGatewayUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *CoreGatewayUpsertOne) DoNothing() *CoreGatewayUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the CoreGatewayCreate.OnConflict
+// documentation for more info.
+func (u *CoreGatewayUpsertOne) Update(set func(*CoreGatewayUpsert)) *CoreGatewayUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&CoreGatewayUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *CoreGatewayUpsertOne) SetUpdatedAt(v time.Time) *CoreGatewayUpsertOne {
+	return u.Update(func(s *CoreGatewayUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *CoreGatewayUpsertOne) UpdateUpdatedAt() *CoreGatewayUpsertOne {
+	return u.Update(func(s *CoreGatewayUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *CoreGatewayUpsertOne) SetDeletedAt(v time.Time) *CoreGatewayUpsertOne {
+	return u.Update(func(s *CoreGatewayUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *CoreGatewayUpsertOne) UpdateDeletedAt() *CoreGatewayUpsertOne {
+	return u.Update(func(s *CoreGatewayUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *CoreGatewayUpsertOne) ClearDeletedAt() *CoreGatewayUpsertOne {
+	return u.Update(func(s *CoreGatewayUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetGatewayID sets the "gateway_id" field.
+func (u *CoreGatewayUpsertOne) SetGatewayID(v int64) *CoreGatewayUpsertOne {
+	return u.Update(func(s *CoreGatewayUpsert) {
+		s.SetGatewayID(v)
+	})
+}
+
+// AddGatewayID adds v to the "gateway_id" field.
+func (u *CoreGatewayUpsertOne) AddGatewayID(v int64) *CoreGatewayUpsertOne {
+	return u.Update(func(s *CoreGatewayUpsert) {
+		s.AddGatewayID(v)
+	})
+}
+
+// UpdateGatewayID sets the "gateway_id" field to the value that was provided on create.
+func (u *CoreGatewayUpsertOne) UpdateGatewayID() *CoreGatewayUpsertOne {
+	return u.Update(func(s *CoreGatewayUpsert) {
+		s.UpdateGatewayID()
+	})
+}
+
+// ClearGatewayID clears the value of the "gateway_id" field.
+func (u *CoreGatewayUpsertOne) ClearGatewayID() *CoreGatewayUpsertOne {
+	return u.Update(func(s *CoreGatewayUpsert) {
+		s.ClearGatewayID()
+	})
+}
+
+// SetAppliedRevision sets the "applied_revision" field.
+func (u *CoreGatewayUpsertOne) SetAppliedRevision(v int64) *CoreGatewayUpsertOne {
+	return u.Update(func(s *CoreGatewayUpsert) {
+		s.SetAppliedRevision(v)
+	})
+}
+
+// AddAppliedRevision adds v to the "applied_revision" field.
+func (u *CoreGatewayUpsertOne) AddAppliedRevision(v int64) *CoreGatewayUpsertOne {
+	return u.Update(func(s *CoreGatewayUpsert) {
+		s.AddAppliedRevision(v)
+	})
+}
+
+// UpdateAppliedRevision sets the "applied_revision" field to the value that was provided on create.
+func (u *CoreGatewayUpsertOne) UpdateAppliedRevision() *CoreGatewayUpsertOne {
+	return u.Update(func(s *CoreGatewayUpsert) {
+		s.UpdateAppliedRevision()
+	})
+}
+
+// ClearAppliedRevision clears the value of the "applied_revision" field.
+func (u *CoreGatewayUpsertOne) ClearAppliedRevision() *CoreGatewayUpsertOne {
+	return u.Update(func(s *CoreGatewayUpsert) {
+		s.ClearAppliedRevision()
+	})
+}
+
+// SetRejectedRevision sets the "rejected_revision" field.
+func (u *CoreGatewayUpsertOne) SetRejectedRevision(v int64) *CoreGatewayUpsertOne {
+	return u.Update(func(s *CoreGatewayUpsert) {
+		s.SetRejectedRevision(v)
+	})
+}
+
+// AddRejectedRevision adds v to the "rejected_revision" field.
+func (u *CoreGatewayUpsertOne) AddRejectedRevision(v int64) *CoreGatewayUpsertOne {
+	return u.Update(func(s *CoreGatewayUpsert) {
+		s.AddRejectedRevision(v)
+	})
+}
+
+// UpdateRejectedRevision sets the "rejected_revision" field to the value that was provided on create.
+func (u *CoreGatewayUpsertOne) UpdateRejectedRevision() *CoreGatewayUpsertOne {
+	return u.Update(func(s *CoreGatewayUpsert) {
+		s.UpdateRejectedRevision()
+	})
+}
+
+// ClearRejectedRevision clears the value of the "rejected_revision" field.
+func (u *CoreGatewayUpsertOne) ClearRejectedRevision() *CoreGatewayUpsertOne {
+	return u.Update(func(s *CoreGatewayUpsert) {
+		s.ClearRejectedRevision()
+	})
+}
+
+// SetLastError sets the "last_error" field.
+func (u *CoreGatewayUpsertOne) SetLastError(v string) *CoreGatewayUpsertOne {
+	return u.Update(func(s *CoreGatewayUpsert) {
+		s.SetLastError(v)
+	})
+}
+
+// UpdateLastError sets the "last_error" field to the value that was provided on create.
+func (u *CoreGatewayUpsertOne) UpdateLastError() *CoreGatewayUpsertOne {
+	return u.Update(func(s *CoreGatewayUpsert) {
+		s.UpdateLastError()
+	})
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (u *CoreGatewayUpsertOne) ClearLastError() *CoreGatewayUpsertOne {
+	return u.Update(func(s *CoreGatewayUpsert) {
+		s.ClearLastError()
+	})
+}
+
+// SetLastSyncTime sets the "last_sync_time" field.
+func (u *CoreGatewayUpsertOne) SetLastSyncTime(v int64) *CoreGatewayUpsertOne {
+	return u.Update(func(s *CoreGatewayUpsert) {
+		s.SetLastSyncTime(v)
+	})
+}
+
+// AddLastSyncTime adds v to the "last_sync_time" field.
+func (u *CoreGatewayUpsertOne) AddLastSyncTime(v int64) *CoreGatewayUpsertOne {
+	return u.Update(func(s *CoreGatewayUpsert) {
+		s.AddLastSyncTime(v)
+	})
+}
+
+// UpdateLastSyncTime sets the "last_sync_time" field to the value that was provided on create.
+func (u *CoreGatewayUpsertOne) UpdateLastSyncTime() *CoreGatewayUpsertOne {
+	return u.Update(func(s *CoreGatewayUpsert) {
+		s.UpdateLastSyncTime()
+	})
+}
+
+// ClearLastSyncTime clears the value of the "last_sync_time" field.
+func (u *CoreGatewayUpsertOne) ClearLastSyncTime() *CoreGatewayUpsertOne {
+	return u.Update(func(s *CoreGatewayUpsert) {
+		s.ClearLastSyncTime()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *CoreGatewayUpsertOne) SetStatus(v constant.YesOrNo) *CoreGatewayUpsertOne {
+	return u.Update(func(s *CoreGatewayUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// AddStatus adds v to the "status" field.
+func (u *CoreGatewayUpsertOne) AddStatus(v constant.YesOrNo) *CoreGatewayUpsertOne {
+	return u.Update(func(s *CoreGatewayUpsert) {
+		s.AddStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *CoreGatewayUpsertOne) UpdateStatus() *CoreGatewayUpsertOne {
+	return u.Update(func(s *CoreGatewayUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// ClearStatus clears the value of the "status" field.
+func (u *CoreGatewayUpsertOne) ClearStatus() *CoreGatewayUpsertOne {
+	return u.Update(func(s *CoreGatewayUpsert) {
+		s.ClearStatus()
+	})
+}
+
+// SetGlobalRateLimit sets the "global_rate_limit" field.
+func (u *CoreGatewayUpsertOne) SetGlobalRateLimit(v constant.YesOrNo) *CoreGatewayUpsertOne {
+	return u.Update(func(s *CoreGatewayUpsert) {
+		s.SetGlobalRateLimit(v)
+	})
+}
+
+// AddGlobalRateLimit adds v to the "global_rate_limit" field.
+func (u *CoreGatewayUpsertOne) AddGlobalRateLimit(v constant.YesOrNo) *CoreGatewayUpsertOne {
+	return u.Update(func(s *CoreGatewayUpsert) {
+		s.AddGlobalRateLimit(v)
+	})
+}
+
+// UpdateGlobalRateLimit sets the "global_rate_limit" field to the value that was provided on create.
+func (u *CoreGatewayUpsertOne) UpdateGlobalRateLimit() *CoreGatewayUpsertOne {
+	return u.Update(func(s *CoreGatewayUpsert) {
+		s.UpdateGlobalRateLimit()
+	})
+}
+
+// ClearGlobalRateLimit clears the value of the "global_rate_limit" field.
+func (u *CoreGatewayUpsertOne) ClearGlobalRateLimit() *CoreGatewayUpsertOne {
+	return u.Update(func(s *CoreGatewayUpsert) {
+		s.ClearGlobalRateLimit()
+	})
+}
+
+// Exec executes the query.
+func (u *CoreGatewayUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for CoreGatewayCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *CoreGatewayUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *CoreGatewayUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: CoreGatewayUpsertOne.ID is not supported by MySQL driver. Use CoreGatewayUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *CoreGatewayUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// CoreGatewayCreateBulk is the builder for creating many CoreGateway entities in bulk.
+type CoreGatewayCreateBulk struct {
+	config
+	err      error
+	builders []*CoreGatewayCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the CoreGateway entities in the database.
+func (_c *CoreGatewayCreateBulk) Save(ctx context.Context) ([]*CoreGateway, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*CoreGateway, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*CoreGatewayMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *CoreGatewayCreateBulk) SaveX(ctx context.Context) []*CoreGateway {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *CoreGatewayCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *CoreGatewayCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.CoreGateway.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.CoreGatewayUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *CoreGatewayCreateBulk) OnConflict(opts ...sql.ConflictOption) *CoreGatewayUpsertBulk {
+	_c.conflict = opts
+	return &CoreGatewayUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.CoreGateway.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *CoreGatewayCreateBulk) OnConflictColumns(columns ...string) *CoreGatewayUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &CoreGatewayUpsertBulk{
+		create: _c,
+	}
+}
+
+// CoreGatewayUpsertBulk is the builder for "upsert"-ing
+// a bulk of CoreGateway nodes.
+type CoreGatewayUpsertBulk struct {
+	create *CoreGatewayCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.CoreGateway.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(coregateway.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *CoreGatewayUpsertBulk) UpdateNewValues() *CoreGatewayUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(coregateway.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(coregateway.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.CoreGateway.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *CoreGatewayUpsertBulk) Ignore() *CoreGatewayUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *CoreGatewayUpsertBulk) DoNothing() *CoreGatewayUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the CoreGatewayCreateBulk.OnConflict
+// documentation for more info.
+func (u *CoreGatewayUpsertBulk) Update(set func(*CoreGatewayUpsert)) *CoreGatewayUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&CoreGatewayUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *CoreGatewayUpsertBulk) SetUpdatedAt(v time.Time) *CoreGatewayUpsertBulk {
+	return u.Update(func(s *CoreGatewayUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *CoreGatewayUpsertBulk) UpdateUpdatedAt() *CoreGatewayUpsertBulk {
+	return u.Update(func(s *CoreGatewayUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *CoreGatewayUpsertBulk) SetDeletedAt(v time.Time) *CoreGatewayUpsertBulk {
+	return u.Update(func(s *CoreGatewayUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *CoreGatewayUpsertBulk) UpdateDeletedAt() *CoreGatewayUpsertBulk {
+	return u.Update(func(s *CoreGatewayUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *CoreGatewayUpsertBulk) ClearDeletedAt() *CoreGatewayUpsertBulk {
+	return u.Update(func(s *CoreGatewayUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetGatewayID sets the "gateway_id" field.
+func (u *CoreGatewayUpsertBulk) SetGatewayID(v int64) *CoreGatewayUpsertBulk {
+	return u.Update(func(s *CoreGatewayUpsert) {
+		s.SetGatewayID(v)
+	})
+}
+
+// AddGatewayID adds v to the "gateway_id" field.
+func (u *CoreGatewayUpsertBulk) AddGatewayID(v int64) *CoreGatewayUpsertBulk {
+	return u.Update(func(s *CoreGatewayUpsert) {
+		s.AddGatewayID(v)
+	})
+}
+
+// UpdateGatewayID sets the "gateway_id" field to the value that was provided on create.
+func (u *CoreGatewayUpsertBulk) UpdateGatewayID() *CoreGatewayUpsertBulk {
+	return u.Update(func(s *CoreGatewayUpsert) {
+		s.UpdateGatewayID()
+	})
+}
+
+// ClearGatewayID clears the value of the "gateway_id" field.
+func (u *CoreGatewayUpsertBulk) ClearGatewayID() *CoreGatewayUpsertBulk {
+	return u.Update(func(s *CoreGatewayUpsert) {
+		s.ClearGatewayID()
+	})
+}
+
+// SetAppliedRevision sets the "applied_revision" field.
+func (u *CoreGatewayUpsertBulk) SetAppliedRevision(v int64) *CoreGatewayUpsertBulk {
+	return u.Update(func(s *CoreGatewayUpsert) {
+		s.SetAppliedRevision(v)
+	})
+}
+
+// AddAppliedRevision adds v to the "applied_revision" field.
+func (u *CoreGatewayUpsertBulk) AddAppliedRevision(v int64) *CoreGatewayUpsertBulk {
+	return u.Update(func(s *CoreGatewayUpsert) {
+		s.AddAppliedRevision(v)
+	})
+}
+
+// UpdateAppliedRevision sets the "applied_revision" field to the value that was provided on create.
+func (u *CoreGatewayUpsertBulk) UpdateAppliedRevision() *CoreGatewayUpsertBulk {
+	return u.Update(func(s *CoreGatewayUpsert) {
+		s.UpdateAppliedRevision()
+	})
+}
+
+// ClearAppliedRevision clears the value of the "applied_revision" field.
+func (u *CoreGatewayUpsertBulk) ClearAppliedRevision() *CoreGatewayUpsertBulk {
+	return u.Update(func(s *CoreGatewayUpsert) {
+		s.ClearAppliedRevision()
+	})
+}
+
+// SetRejectedRevision sets the "rejected_revision" field.
+func (u *CoreGatewayUpsertBulk) SetRejectedRevision(v int64) *CoreGatewayUpsertBulk {
+	return u.Update(func(s *CoreGatewayUpsert) {
+		s.SetRejectedRevision(v)
+	})
+}
+
+// AddRejectedRevision adds v to the "rejected_revision" field.
+func (u *CoreGatewayUpsertBulk) AddRejectedRevision(v int64) *CoreGatewayUpsertBulk {
+	return u.Update(func(s *CoreGatewayUpsert) {
+		s.AddRejectedRevision(v)
+	})
+}
+
+// UpdateRejectedRevision sets the "rejected_revision" field to the value that was provided on create.
+func (u *CoreGatewayUpsertBulk) UpdateRejectedRevision() *CoreGatewayUpsertBulk {
+	return u.Update(func(s *CoreGatewayUpsert) {
+		s.UpdateRejectedRevision()
+	})
+}
+
+// ClearRejectedRevision clears the value of the "rejected_revision" field.
+func (u *CoreGatewayUpsertBulk) ClearRejectedRevision() *CoreGatewayUpsertBulk {
+	return u.Update(func(s *CoreGatewayUpsert) {
+		s.ClearRejectedRevision()
+	})
+}
+
+// SetLastError sets the "last_error" field.
+func (u *CoreGatewayUpsertBulk) SetLastError(v string) *CoreGatewayUpsertBulk {
+	return u.Update(func(s *CoreGatewayUpsert) {
+		s.SetLastError(v)
+	})
+}
+
+// UpdateLastError sets the "last_error" field to the value that was provided on create.
+func (u *CoreGatewayUpsertBulk) UpdateLastError() *CoreGatewayUpsertBulk {
+	return u.Update(func(s *CoreGatewayUpsert) {
+		s.UpdateLastError()
+	})
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (u *CoreGatewayUpsertBulk) ClearLastError() *CoreGatewayUpsertBulk {
+	return u.Update(func(s *CoreGatewayUpsert) {
+		s.ClearLastError()
+	})
+}
+
+// SetLastSyncTime sets the "last_sync_time" field.
+func (u *CoreGatewayUpsertBulk) SetLastSyncTime(v int64) *CoreGatewayUpsertBulk {
+	return u.Update(func(s *CoreGatewayUpsert) {
+		s.SetLastSyncTime(v)
+	})
+}
+
+// AddLastSyncTime adds v to the "last_sync_time" field.
+func (u *CoreGatewayUpsertBulk) AddLastSyncTime(v int64) *CoreGatewayUpsertBulk {
+	return u.Update(func(s *CoreGatewayUpsert) {
+		s.AddLastSyncTime(v)
+	})
+}
+
+// UpdateLastSyncTime sets the "last_sync_time" field to the value that was provided on create.
+func (u *CoreGatewayUpsertBulk) UpdateLastSyncTime() *CoreGatewayUpsertBulk {
+	return u.Update(func(s *CoreGatewayUpsert) {
+		s.UpdateLastSyncTime()
+	})
+}
+
+// ClearLastSyncTime clears the value of the "last_sync_time" field.
+func (u *CoreGatewayUpsertBulk) ClearLastSyncTime() *CoreGatewayUpsertBulk {
+	return u.Update(func(s *CoreGatewayUpsert) {
+		s.ClearLastSyncTime()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *CoreGatewayUpsertBulk) SetStatus(v constant.YesOrNo) *CoreGatewayUpsertBulk {
+	return u.Update(func(s *CoreGatewayUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// AddStatus adds v to the "status" field.
+func (u *CoreGatewayUpsertBulk) AddStatus(v constant.YesOrNo) *CoreGatewayUpsertBulk {
+	return u.Update(func(s *CoreGatewayUpsert) {
+		s.AddStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *CoreGatewayUpsertBulk) UpdateStatus() *CoreGatewayUpsertBulk {
+	return u.Update(func(s *CoreGatewayUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// ClearStatus clears the value of the "status" field.
+func (u *CoreGatewayUpsertBulk) ClearStatus() *CoreGatewayUpsertBulk {
+	return u.Update(func(s *CoreGatewayUpsert) {
+		s.ClearStatus()
+	})
+}
+
+// SetGlobalRateLimit sets the "global_rate_limit" field.
+func (u *CoreGatewayUpsertBulk) SetGlobalRateLimit(v constant.YesOrNo) *CoreGatewayUpsertBulk {
+	return u.Update(func(s *CoreGatewayUpsert) {
+		s.SetGlobalRateLimit(v)
+	})
+}
+
+// AddGlobalRateLimit adds v to the "global_rate_limit" field.
+func (u *CoreGatewayUpsertBulk) AddGlobalRateLimit(v constant.YesOrNo) *CoreGatewayUpsertBulk {
+	return u.Update(func(s *CoreGatewayUpsert) {
+		s.AddGlobalRateLimit(v)
+	})
+}
+
+// UpdateGlobalRateLimit sets the "global_rate_limit" field to the value that was provided on create.
+func (u *CoreGatewayUpsertBulk) UpdateGlobalRateLimit() *CoreGatewayUpsertBulk {
+	return u.Update(func(s *CoreGatewayUpsert) {
+		s.UpdateGlobalRateLimit()
+	})
+}
+
+// ClearGlobalRateLimit clears the value of the "global_rate_limit" field.
+func (u *CoreGatewayUpsertBulk) ClearGlobalRateLimit() *CoreGatewayUpsertBulk {
+	return u.Update(func(s *CoreGatewayUpsert) {
+		s.ClearGlobalRateLimit()
+	})
+}
+
+// Exec executes the query.
+func (u *CoreGatewayUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the CoreGatewayCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for CoreGatewayCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *CoreGatewayUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
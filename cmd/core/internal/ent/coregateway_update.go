@@ -0,0 +1,777 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/coregateway"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/predicate"
+	"github.com/lyonmu/quebec/pkg/constant"
+)
+
+// CoreGatewayUpdate is the builder for updating CoreGateway entities.
+type CoreGatewayUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *CoreGatewayMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the CoreGatewayUpdate builder.
+func (_u *CoreGatewayUpdate) Where(ps ...predicate.CoreGateway) *CoreGatewayUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *CoreGatewayUpdate) SetUpdatedAt(v time.Time) *CoreGatewayUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *CoreGatewayUpdate) SetDeletedAt(v time.Time) *CoreGatewayUpdate {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *CoreGatewayUpdate) SetNillableDeletedAt(v *time.Time) *CoreGatewayUpdate {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *CoreGatewayUpdate) ClearDeletedAt() *CoreGatewayUpdate {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetGatewayID sets the "gateway_id" field.
+func (_u *CoreGatewayUpdate) SetGatewayID(v int64) *CoreGatewayUpdate {
+	_u.mutation.ResetGatewayID()
+	_u.mutation.SetGatewayID(v)
+	return _u
+}
+
+// SetNillableGatewayID sets the "gateway_id" field if the given value is not nil.
+func (_u *CoreGatewayUpdate) SetNillableGatewayID(v *int64) *CoreGatewayUpdate {
+	if v != nil {
+		_u.SetGatewayID(*v)
+	}
+	return _u
+}
+
+// AddGatewayID adds value to the "gateway_id" field.
+func (_u *CoreGatewayUpdate) AddGatewayID(v int64) *CoreGatewayUpdate {
+	_u.mutation.AddGatewayID(v)
+	return _u
+}
+
+// ClearGatewayID clears the value of the "gateway_id" field.
+func (_u *CoreGatewayUpdate) ClearGatewayID() *CoreGatewayUpdate {
+	_u.mutation.ClearGatewayID()
+	return _u
+}
+
+// SetAppliedRevision sets the "applied_revision" field.
+func (_u *CoreGatewayUpdate) SetAppliedRevision(v int64) *CoreGatewayUpdate {
+	_u.mutation.ResetAppliedRevision()
+	_u.mutation.SetAppliedRevision(v)
+	return _u
+}
+
+// SetNillableAppliedRevision sets the "applied_revision" field if the given value is not nil.
+func (_u *CoreGatewayUpdate) SetNillableAppliedRevision(v *int64) *CoreGatewayUpdate {
+	if v != nil {
+		_u.SetAppliedRevision(*v)
+	}
+	return _u
+}
+
+// AddAppliedRevision adds value to the "applied_revision" field.
+func (_u *CoreGatewayUpdate) AddAppliedRevision(v int64) *CoreGatewayUpdate {
+	_u.mutation.AddAppliedRevision(v)
+	return _u
+}
+
+// ClearAppliedRevision clears the value of the "applied_revision" field.
+func (_u *CoreGatewayUpdate) ClearAppliedRevision() *CoreGatewayUpdate {
+	_u.mutation.ClearAppliedRevision()
+	return _u
+}
+
+// SetRejectedRevision sets the "rejected_revision" field.
+func (_u *CoreGatewayUpdate) SetRejectedRevision(v int64) *CoreGatewayUpdate {
+	_u.mutation.ResetRejectedRevision()
+	_u.mutation.SetRejectedRevision(v)
+	return _u
+}
+
+// SetNillableRejectedRevision sets the "rejected_revision" field if the given value is not nil.
+func (_u *CoreGatewayUpdate) SetNillableRejectedRevision(v *int64) *CoreGatewayUpdate {
+	if v != nil {
+		_u.SetRejectedRevision(*v)
+	}
+	return _u
+}
+
+// AddRejectedRevision adds value to the "rejected_revision" field.
+func (_u *CoreGatewayUpdate) AddRejectedRevision(v int64) *CoreGatewayUpdate {
+	_u.mutation.AddRejectedRevision(v)
+	return _u
+}
+
+// ClearRejectedRevision clears the value of the "rejected_revision" field.
+func (_u *CoreGatewayUpdate) ClearRejectedRevision() *CoreGatewayUpdate {
+	_u.mutation.ClearRejectedRevision()
+	return _u
+}
+
+// SetLastError sets the "last_error" field.
+func (_u *CoreGatewayUpdate) SetLastError(v string) *CoreGatewayUpdate {
+	_u.mutation.SetLastError(v)
+	return _u
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (_u *CoreGatewayUpdate) SetNillableLastError(v *string) *CoreGatewayUpdate {
+	if v != nil {
+		_u.SetLastError(*v)
+	}
+	return _u
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (_u *CoreGatewayUpdate) ClearLastError() *CoreGatewayUpdate {
+	_u.mutation.ClearLastError()
+	return _u
+}
+
+// SetLastSyncTime sets the "last_sync_time" field.
+func (_u *CoreGatewayUpdate) SetLastSyncTime(v int64) *CoreGatewayUpdate {
+	_u.mutation.ResetLastSyncTime()
+	_u.mutation.SetLastSyncTime(v)
+	return _u
+}
+
+// SetNillableLastSyncTime sets the "last_sync_time" field if the given value is not nil.
+func (_u *CoreGatewayUpdate) SetNillableLastSyncTime(v *int64) *CoreGatewayUpdate {
+	if v != nil {
+		_u.SetLastSyncTime(*v)
+	}
+	return _u
+}
+
+// AddLastSyncTime adds value to the "last_sync_time" field.
+func (_u *CoreGatewayUpdate) AddLastSyncTime(v int64) *CoreGatewayUpdate {
+	_u.mutation.AddLastSyncTime(v)
+	return _u
+}
+
+// ClearLastSyncTime clears the value of the "last_sync_time" field.
+func (_u *CoreGatewayUpdate) ClearLastSyncTime() *CoreGatewayUpdate {
+	_u.mutation.ClearLastSyncTime()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *CoreGatewayUpdate) SetStatus(v constant.YesOrNo) *CoreGatewayUpdate {
+	_u.mutation.ResetStatus()
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *CoreGatewayUpdate) SetNillableStatus(v *constant.YesOrNo) *CoreGatewayUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// AddStatus adds value to the "status" field.
+func (_u *CoreGatewayUpdate) AddStatus(v constant.YesOrNo) *CoreGatewayUpdate {
+	_u.mutation.AddStatus(v)
+	return _u
+}
+
+// ClearStatus clears the value of the "status" field.
+func (_u *CoreGatewayUpdate) ClearStatus() *CoreGatewayUpdate {
+	_u.mutation.ClearStatus()
+	return _u
+}
+
+// SetGlobalRateLimit sets the "global_rate_limit" field.
+func (_u *CoreGatewayUpdate) SetGlobalRateLimit(v constant.YesOrNo) *CoreGatewayUpdate {
+	_u.mutation.ResetGlobalRateLimit()
+	_u.mutation.SetGlobalRateLimit(v)
+	return _u
+}
+
+// SetNillableGlobalRateLimit sets the "global_rate_limit" field if the given value is not nil.
+func (_u *CoreGatewayUpdate) SetNillableGlobalRateLimit(v *constant.YesOrNo) *CoreGatewayUpdate {
+	if v != nil {
+		_u.SetGlobalRateLimit(*v)
+	}
+	return _u
+}
+
+// AddGlobalRateLimit adds value to the "global_rate_limit" field.
+func (_u *CoreGatewayUpdate) AddGlobalRateLimit(v constant.YesOrNo) *CoreGatewayUpdate {
+	_u.mutation.AddGlobalRateLimit(v)
+	return _u
+}
+
+// ClearGlobalRateLimit clears the value of the "global_rate_limit" field.
+func (_u *CoreGatewayUpdate) ClearGlobalRateLimit() *CoreGatewayUpdate {
+	_u.mutation.ClearGlobalRateLimit()
+	return _u
+}
+
+// Mutation returns the CoreGatewayMutation object of the builder.
+func (_u *CoreGatewayUpdate) Mutation() *CoreGatewayMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *CoreGatewayUpdate) Save(ctx context.Context) (int, error) {
+	if err := _u.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *CoreGatewayUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *CoreGatewayUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *CoreGatewayUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *CoreGatewayUpdate) defaults() error {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		if coregateway.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized coregateway.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := coregateway.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *CoreGatewayUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *CoreGatewayUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *CoreGatewayUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(coregateway.Table, coregateway.Columns, sqlgraph.NewFieldSpec(coregateway.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(coregateway.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(coregateway.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(coregateway.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.GatewayID(); ok {
+		_spec.SetField(coregateway.FieldGatewayID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedGatewayID(); ok {
+		_spec.AddField(coregateway.FieldGatewayID, field.TypeInt64, value)
+	}
+	if _u.mutation.GatewayIDCleared() {
+		_spec.ClearField(coregateway.FieldGatewayID, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.AppliedRevision(); ok {
+		_spec.SetField(coregateway.FieldAppliedRevision, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedAppliedRevision(); ok {
+		_spec.AddField(coregateway.FieldAppliedRevision, field.TypeInt64, value)
+	}
+	if _u.mutation.AppliedRevisionCleared() {
+		_spec.ClearField(coregateway.FieldAppliedRevision, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.RejectedRevision(); ok {
+		_spec.SetField(coregateway.FieldRejectedRevision, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedRejectedRevision(); ok {
+		_spec.AddField(coregateway.FieldRejectedRevision, field.TypeInt64, value)
+	}
+	if _u.mutation.RejectedRevisionCleared() {
+		_spec.ClearField(coregateway.FieldRejectedRevision, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.LastError(); ok {
+		_spec.SetField(coregateway.FieldLastError, field.TypeString, value)
+	}
+	if _u.mutation.LastErrorCleared() {
+		_spec.ClearField(coregateway.FieldLastError, field.TypeString)
+	}
+	if value, ok := _u.mutation.LastSyncTime(); ok {
+		_spec.SetField(coregateway.FieldLastSyncTime, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedLastSyncTime(); ok {
+		_spec.AddField(coregateway.FieldLastSyncTime, field.TypeInt64, value)
+	}
+	if _u.mutation.LastSyncTimeCleared() {
+		_spec.ClearField(coregateway.FieldLastSyncTime, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(coregateway.FieldStatus, field.TypeInt8, value)
+	}
+	if value, ok := _u.mutation.AddedStatus(); ok {
+		_spec.AddField(coregateway.FieldStatus, field.TypeInt8, value)
+	}
+	if _u.mutation.StatusCleared() {
+		_spec.ClearField(coregateway.FieldStatus, field.TypeInt8)
+	}
+	if value, ok := _u.mutation.GlobalRateLimit(); ok {
+		_spec.SetField(coregateway.FieldGlobalRateLimit, field.TypeInt8, value)
+	}
+	if value, ok := _u.mutation.AddedGlobalRateLimit(); ok {
+		_spec.AddField(coregateway.FieldGlobalRateLimit, field.TypeInt8, value)
+	}
+	if _u.mutation.GlobalRateLimitCleared() {
+		_spec.ClearField(coregateway.FieldGlobalRateLimit, field.TypeInt8)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{coregateway.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// CoreGatewayUpdateOne is the builder for updating a single CoreGateway entity.
+type CoreGatewayUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *CoreGatewayMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *CoreGatewayUpdateOne) SetUpdatedAt(v time.Time) *CoreGatewayUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *CoreGatewayUpdateOne) SetDeletedAt(v time.Time) *CoreGatewayUpdateOne {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *CoreGatewayUpdateOne) SetNillableDeletedAt(v *time.Time) *CoreGatewayUpdateOne {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *CoreGatewayUpdateOne) ClearDeletedAt() *CoreGatewayUpdateOne {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetGatewayID sets the "gateway_id" field.
+func (_u *CoreGatewayUpdateOne) SetGatewayID(v int64) *CoreGatewayUpdateOne {
+	_u.mutation.ResetGatewayID()
+	_u.mutation.SetGatewayID(v)
+	return _u
+}
+
+// SetNillableGatewayID sets the "gateway_id" field if the given value is not nil.
+func (_u *CoreGatewayUpdateOne) SetNillableGatewayID(v *int64) *CoreGatewayUpdateOne {
+	if v != nil {
+		_u.SetGatewayID(*v)
+	}
+	return _u
+}
+
+// AddGatewayID adds value to the "gateway_id" field.
+func (_u *CoreGatewayUpdateOne) AddGatewayID(v int64) *CoreGatewayUpdateOne {
+	_u.mutation.AddGatewayID(v)
+	return _u
+}
+
+// ClearGatewayID clears the value of the "gateway_id" field.
+func (_u *CoreGatewayUpdateOne) ClearGatewayID() *CoreGatewayUpdateOne {
+	_u.mutation.ClearGatewayID()
+	return _u
+}
+
+// SetAppliedRevision sets the "applied_revision" field.
+func (_u *CoreGatewayUpdateOne) SetAppliedRevision(v int64) *CoreGatewayUpdateOne {
+	_u.mutation.ResetAppliedRevision()
+	_u.mutation.SetAppliedRevision(v)
+	return _u
+}
+
+// SetNillableAppliedRevision sets the "applied_revision" field if the given value is not nil.
+func (_u *CoreGatewayUpdateOne) SetNillableAppliedRevision(v *int64) *CoreGatewayUpdateOne {
+	if v != nil {
+		_u.SetAppliedRevision(*v)
+	}
+	return _u
+}
+
+// AddAppliedRevision adds value to the "applied_revision" field.
+func (_u *CoreGatewayUpdateOne) AddAppliedRevision(v int64) *CoreGatewayUpdateOne {
+	_u.mutation.AddAppliedRevision(v)
+	return _u
+}
+
+// ClearAppliedRevision clears the value of the "applied_revision" field.
+func (_u *CoreGatewayUpdateOne) ClearAppliedRevision() *CoreGatewayUpdateOne {
+	_u.mutation.ClearAppliedRevision()
+	return _u
+}
+
+// SetRejectedRevision sets the "rejected_revision" field.
+func (_u *CoreGatewayUpdateOne) SetRejectedRevision(v int64) *CoreGatewayUpdateOne {
+	_u.mutation.ResetRejectedRevision()
+	_u.mutation.SetRejectedRevision(v)
+	return _u
+}
+
+// SetNillableRejectedRevision sets the "rejected_revision" field if the given value is not nil.
+func (_u *CoreGatewayUpdateOne) SetNillableRejectedRevision(v *int64) *CoreGatewayUpdateOne {
+	if v != nil {
+		_u.SetRejectedRevision(*v)
+	}
+	return _u
+}
+
+// AddRejectedRevision adds value to the "rejected_revision" field.
+func (_u *CoreGatewayUpdateOne) AddRejectedRevision(v int64) *CoreGatewayUpdateOne {
+	_u.mutation.AddRejectedRevision(v)
+	return _u
+}
+
+// ClearRejectedRevision clears the value of the "rejected_revision" field.
+func (_u *CoreGatewayUpdateOne) ClearRejectedRevision() *CoreGatewayUpdateOne {
+	_u.mutation.ClearRejectedRevision()
+	return _u
+}
+
+// SetLastError sets the "last_error" field.
+func (_u *CoreGatewayUpdateOne) SetLastError(v string) *CoreGatewayUpdateOne {
+	_u.mutation.SetLastError(v)
+	return _u
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (_u *CoreGatewayUpdateOne) SetNillableLastError(v *string) *CoreGatewayUpdateOne {
+	if v != nil {
+		_u.SetLastError(*v)
+	}
+	return _u
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (_u *CoreGatewayUpdateOne) ClearLastError() *CoreGatewayUpdateOne {
+	_u.mutation.ClearLastError()
+	return _u
+}
+
+// SetLastSyncTime sets the "last_sync_time" field.
+func (_u *CoreGatewayUpdateOne) SetLastSyncTime(v int64) *CoreGatewayUpdateOne {
+	_u.mutation.ResetLastSyncTime()
+	_u.mutation.SetLastSyncTime(v)
+	return _u
+}
+
+// SetNillableLastSyncTime sets the "last_sync_time" field if the given value is not nil.
+func (_u *CoreGatewayUpdateOne) SetNillableLastSyncTime(v *int64) *CoreGatewayUpdateOne {
+	if v != nil {
+		_u.SetLastSyncTime(*v)
+	}
+	return _u
+}
+
+// AddLastSyncTime adds value to the "last_sync_time" field.
+func (_u *CoreGatewayUpdateOne) AddLastSyncTime(v int64) *CoreGatewayUpdateOne {
+	_u.mutation.AddLastSyncTime(v)
+	return _u
+}
+
+// ClearLastSyncTime clears the value of the "last_sync_time" field.
+func (_u *CoreGatewayUpdateOne) ClearLastSyncTime() *CoreGatewayUpdateOne {
+	_u.mutation.ClearLastSyncTime()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *CoreGatewayUpdateOne) SetStatus(v constant.YesOrNo) *CoreGatewayUpdateOne {
+	_u.mutation.ResetStatus()
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *CoreGatewayUpdateOne) SetNillableStatus(v *constant.YesOrNo) *CoreGatewayUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// AddStatus adds value to the "status" field.
+func (_u *CoreGatewayUpdateOne) AddStatus(v constant.YesOrNo) *CoreGatewayUpdateOne {
+	_u.mutation.AddStatus(v)
+	return _u
+}
+
+// ClearStatus clears the value of the "status" field.
+func (_u *CoreGatewayUpdateOne) ClearStatus() *CoreGatewayUpdateOne {
+	_u.mutation.ClearStatus()
+	return _u
+}
+
+// SetGlobalRateLimit sets the "global_rate_limit" field.
+func (_u *CoreGatewayUpdateOne) SetGlobalRateLimit(v constant.YesOrNo) *CoreGatewayUpdateOne {
+	_u.mutation.ResetGlobalRateLimit()
+	_u.mutation.SetGlobalRateLimit(v)
+	return _u
+}
+
+// SetNillableGlobalRateLimit sets the "global_rate_limit" field if the given value is not nil.
+func (_u *CoreGatewayUpdateOne) SetNillableGlobalRateLimit(v *constant.YesOrNo) *CoreGatewayUpdateOne {
+	if v != nil {
+		_u.SetGlobalRateLimit(*v)
+	}
+	return _u
+}
+
+// AddGlobalRateLimit adds value to the "global_rate_limit" field.
+func (_u *CoreGatewayUpdateOne) AddGlobalRateLimit(v constant.YesOrNo) *CoreGatewayUpdateOne {
+	_u.mutation.AddGlobalRateLimit(v)
+	return _u
+}
+
+// ClearGlobalRateLimit clears the value of the "global_rate_limit" field.
+func (_u *CoreGatewayUpdateOne) ClearGlobalRateLimit() *CoreGatewayUpdateOne {
+	_u.mutation.ClearGlobalRateLimit()
+	return _u
+}
+
+// Mutation returns the CoreGatewayMutation object of the builder.
+func (_u *CoreGatewayUpdateOne) Mutation() *CoreGatewayMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the CoreGatewayUpdate builder.
+func (_u *CoreGatewayUpdateOne) Where(ps ...predicate.CoreGateway) *CoreGatewayUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *CoreGatewayUpdateOne) Select(field string, fields ...string) *CoreGatewayUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated CoreGateway entity.
+func (_u *CoreGatewayUpdateOne) Save(ctx context.Context) (*CoreGateway, error) {
+	if err := _u.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *CoreGatewayUpdateOne) SaveX(ctx context.Context) *CoreGateway {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *CoreGatewayUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *CoreGatewayUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *CoreGatewayUpdateOne) defaults() error {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		if coregateway.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized coregateway.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := coregateway.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *CoreGatewayUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *CoreGatewayUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *CoreGatewayUpdateOne) sqlSave(ctx context.Context) (_node *CoreGateway, err error) {
+	_spec := sqlgraph.NewUpdateSpec(coregateway.Table, coregateway.Columns, sqlgraph.NewFieldSpec(coregateway.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "CoreGateway.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, coregateway.FieldID)
+		for _, f := range fields {
+			if !coregateway.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != coregateway.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(coregateway.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(coregateway.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(coregateway.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.GatewayID(); ok {
+		_spec.SetField(coregateway.FieldGatewayID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedGatewayID(); ok {
+		_spec.AddField(coregateway.FieldGatewayID, field.TypeInt64, value)
+	}
+	if _u.mutation.GatewayIDCleared() {
+		_spec.ClearField(coregateway.FieldGatewayID, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.AppliedRevision(); ok {
+		_spec.SetField(coregateway.FieldAppliedRevision, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedAppliedRevision(); ok {
+		_spec.AddField(coregateway.FieldAppliedRevision, field.TypeInt64, value)
+	}
+	if _u.mutation.AppliedRevisionCleared() {
+		_spec.ClearField(coregateway.FieldAppliedRevision, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.RejectedRevision(); ok {
+		_spec.SetField(coregateway.FieldRejectedRevision, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedRejectedRevision(); ok {
+		_spec.AddField(coregateway.FieldRejectedRevision, field.TypeInt64, value)
+	}
+	if _u.mutation.RejectedRevisionCleared() {
+		_spec.ClearField(coregateway.FieldRejectedRevision, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.LastError(); ok {
+		_spec.SetField(coregateway.FieldLastError, field.TypeString, value)
+	}
+	if _u.mutation.LastErrorCleared() {
+		_spec.ClearField(coregateway.FieldLastError, field.TypeString)
+	}
+	if value, ok := _u.mutation.LastSyncTime(); ok {
+		_spec.SetField(coregateway.FieldLastSyncTime, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedLastSyncTime(); ok {
+		_spec.AddField(coregateway.FieldLastSyncTime, field.TypeInt64, value)
+	}
+	if _u.mutation.LastSyncTimeCleared() {
+		_spec.ClearField(coregateway.FieldLastSyncTime, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(coregateway.FieldStatus, field.TypeInt8, value)
+	}
+	if value, ok := _u.mutation.AddedStatus(); ok {
+		_spec.AddField(coregateway.FieldStatus, field.TypeInt8, value)
+	}
+	if _u.mutation.StatusCleared() {
+		_spec.ClearField(coregateway.FieldStatus, field.TypeInt8)
+	}
+	if value, ok := _u.mutation.GlobalRateLimit(); ok {
+		_spec.SetField(coregateway.FieldGlobalRateLimit, field.TypeInt8, value)
+	}
+	if value, ok := _u.mutation.AddedGlobalRateLimit(); ok {
+		_spec.AddField(coregateway.FieldGlobalRateLimit, field.TypeInt8, value)
+	}
+	if _u.mutation.GlobalRateLimitCleared() {
+		_spec.ClearField(coregateway.FieldGlobalRateLimit, field.TypeInt8)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &CoreGateway{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{coregateway.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -3,12 +3,14 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
+	"github.com/lyonmu/quebec/cmd/core/internal/common"
 	"github.com/lyonmu/quebec/cmd/core/internal/ent/coregatewaycluster"
 )
 
@@ -32,6 +34,8 @@ type CoreGatewayCluster struct {
 	ClusterCreateTime int64 `json:"cluster_create_time,omitempty"`
 	// 最新请求时间
 	ClusterLastRequestTime int64 `json:"cluster_last_request_time,omitempty"`
+	// 维护模式，作用于集群内未单独开启维护模式的虚拟主机
+	Maintenance *common.Maintenance `json:"maintenance,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the CoreGatewayClusterQuery when eager-loading is set.
 	Edges        CoreGatewayClusterEdges `json:"-" gorm:"-"`
@@ -61,6 +65,8 @@ func (*CoreGatewayCluster) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case coregatewaycluster.FieldMaintenance:
+			values[i] = new([]byte)
 		case coregatewaycluster.FieldGatewayID, coregatewaycluster.FieldClusterCreateTime, coregatewaycluster.FieldClusterLastRequestTime:
 			values[i] = new(sql.NullInt64)
 		case coregatewaycluster.FieldID, coregatewaycluster.FieldClusterID:
@@ -131,6 +137,14 @@ func (_m *CoreGatewayCluster) assignValues(columns []string, values []any) error
 			} else if value.Valid {
 				_m.ClusterLastRequestTime = value.Int64
 			}
+		case coregatewaycluster.FieldMaintenance:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field maintenance", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Maintenance); err != nil {
+					return fmt.Errorf("unmarshal field maintenance: %w", err)
+				}
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -194,6 +208,9 @@ func (_m *CoreGatewayCluster) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("cluster_last_request_time=")
 	builder.WriteString(fmt.Sprintf("%v", _m.ClusterLastRequestTime))
+	builder.WriteString(", ")
+	builder.WriteString("maintenance=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Maintenance))
 	builder.WriteByte(')')
 	return builder.String()
 }
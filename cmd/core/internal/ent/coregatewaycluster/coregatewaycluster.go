@@ -29,6 +29,8 @@ const (
 	FieldClusterCreateTime = "cluster_create_time"
 	// FieldClusterLastRequestTime holds the string denoting the cluster_last_request_time field in the database.
 	FieldClusterLastRequestTime = "cluster_last_request_time"
+	// FieldMaintenance holds the string denoting the maintenance field in the database.
+	FieldMaintenance = "maintenance"
 	// EdgeClusterToNode holds the string denoting the cluster_to_node edge name in mutations.
 	EdgeClusterToNode = "cluster_to_node"
 	// Table holds the table name of the coregatewaycluster in the database.
@@ -52,6 +54,7 @@ var Columns = []string{
 	FieldGatewayID,
 	FieldClusterCreateTime,
 	FieldClusterLastRequestTime,
+	FieldMaintenance,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
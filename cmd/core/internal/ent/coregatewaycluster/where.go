@@ -455,6 +455,16 @@ func ClusterLastRequestTimeNotNil() predicate.CoreGatewayCluster {
 	return predicate.CoreGatewayCluster(sql.FieldNotNull(FieldClusterLastRequestTime))
 }
 
+// MaintenanceIsNil applies the IsNil predicate on the "maintenance" field.
+func MaintenanceIsNil() predicate.CoreGatewayCluster {
+	return predicate.CoreGatewayCluster(sql.FieldIsNull(FieldMaintenance))
+}
+
+// MaintenanceNotNil applies the NotNil predicate on the "maintenance" field.
+func MaintenanceNotNil() predicate.CoreGatewayCluster {
+	return predicate.CoreGatewayCluster(sql.FieldNotNull(FieldMaintenance))
+}
+
 // HasClusterToNode applies the HasEdge predicate on the "cluster_to_node" edge.
 func HasClusterToNode() predicate.CoreGatewayCluster {
 	return predicate.CoreGatewayCluster(func(s *sql.Selector) {
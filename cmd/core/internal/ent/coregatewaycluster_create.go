@@ -12,6 +12,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/lyonmu/quebec/cmd/core/internal/common"
 	"github.com/lyonmu/quebec/cmd/core/internal/ent/coregatewaycluster"
 	"github.com/lyonmu/quebec/cmd/core/internal/ent/coregatewaynode"
 )
@@ -122,6 +123,12 @@ func (_c *CoreGatewayClusterCreate) SetNillableClusterLastRequestTime(v *int64)
 	return _c
 }
 
+// SetMaintenance sets the "maintenance" field.
+func (_c *CoreGatewayClusterCreate) SetMaintenance(v *common.Maintenance) *CoreGatewayClusterCreate {
+	_c.mutation.SetMaintenance(v)
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *CoreGatewayClusterCreate) SetID(v string) *CoreGatewayClusterCreate {
 	_c.mutation.SetID(v)
@@ -303,6 +310,10 @@ func (_c *CoreGatewayClusterCreate) createSpec() (*CoreGatewayCluster, *sqlgraph
 		_spec.SetField(coregatewaycluster.FieldClusterLastRequestTime, field.TypeInt64, value)
 		_node.ClusterLastRequestTime = value
 	}
+	if value, ok := _c.mutation.Maintenance(); ok {
+		_spec.SetField(coregatewaycluster.FieldMaintenance, field.TypeJSON, value)
+		_node.Maintenance = value
+	}
 	if nodes := _c.mutation.ClusterToNodeIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -491,6 +502,24 @@ func (u *CoreGatewayClusterUpsert) ClearClusterLastRequestTime() *CoreGatewayClu
 	return u
 }
 
+// SetMaintenance sets the "maintenance" field.
+func (u *CoreGatewayClusterUpsert) SetMaintenance(v *common.Maintenance) *CoreGatewayClusterUpsert {
+	u.Set(coregatewaycluster.FieldMaintenance, v)
+	return u
+}
+
+// UpdateMaintenance sets the "maintenance" field to the value that was provided on create.
+func (u *CoreGatewayClusterUpsert) UpdateMaintenance() *CoreGatewayClusterUpsert {
+	u.SetExcluded(coregatewaycluster.FieldMaintenance)
+	return u
+}
+
+// ClearMaintenance clears the value of the "maintenance" field.
+func (u *CoreGatewayClusterUpsert) ClearMaintenance() *CoreGatewayClusterUpsert {
+	u.SetNull(coregatewaycluster.FieldMaintenance)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
 // Using this option is equivalent to using:
 //
@@ -682,6 +711,27 @@ func (u *CoreGatewayClusterUpsertOne) ClearClusterLastRequestTime() *CoreGateway
 	})
 }
 
+// SetMaintenance sets the "maintenance" field.
+func (u *CoreGatewayClusterUpsertOne) SetMaintenance(v *common.Maintenance) *CoreGatewayClusterUpsertOne {
+	return u.Update(func(s *CoreGatewayClusterUpsert) {
+		s.SetMaintenance(v)
+	})
+}
+
+// UpdateMaintenance sets the "maintenance" field to the value that was provided on create.
+func (u *CoreGatewayClusterUpsertOne) UpdateMaintenance() *CoreGatewayClusterUpsertOne {
+	return u.Update(func(s *CoreGatewayClusterUpsert) {
+		s.UpdateMaintenance()
+	})
+}
+
+// ClearMaintenance clears the value of the "maintenance" field.
+func (u *CoreGatewayClusterUpsertOne) ClearMaintenance() *CoreGatewayClusterUpsertOne {
+	return u.Update(func(s *CoreGatewayClusterUpsert) {
+		s.ClearMaintenance()
+	})
+}
+
 // Exec executes the query.
 func (u *CoreGatewayClusterUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -1040,6 +1090,27 @@ func (u *CoreGatewayClusterUpsertBulk) ClearClusterLastRequestTime() *CoreGatewa
 	})
 }
 
+// SetMaintenance sets the "maintenance" field.
+func (u *CoreGatewayClusterUpsertBulk) SetMaintenance(v *common.Maintenance) *CoreGatewayClusterUpsertBulk {
+	return u.Update(func(s *CoreGatewayClusterUpsert) {
+		s.SetMaintenance(v)
+	})
+}
+
+// UpdateMaintenance sets the "maintenance" field to the value that was provided on create.
+func (u *CoreGatewayClusterUpsertBulk) UpdateMaintenance() *CoreGatewayClusterUpsertBulk {
+	return u.Update(func(s *CoreGatewayClusterUpsert) {
+		s.UpdateMaintenance()
+	})
+}
+
+// ClearMaintenance clears the value of the "maintenance" field.
+func (u *CoreGatewayClusterUpsertBulk) ClearMaintenance() *CoreGatewayClusterUpsertBulk {
+	return u.Update(func(s *CoreGatewayClusterUpsert) {
+		s.ClearMaintenance()
+	})
+}
+
 // Exec executes the query.
 func (u *CoreGatewayClusterUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
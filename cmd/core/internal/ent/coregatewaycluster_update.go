@@ -11,6 +11,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/lyonmu/quebec/cmd/core/internal/common"
 	"github.com/lyonmu/quebec/cmd/core/internal/ent/coregatewaycluster"
 	"github.com/lyonmu/quebec/cmd/core/internal/ent/coregatewaynode"
 	"github.com/lyonmu/quebec/cmd/core/internal/ent/predicate"
@@ -157,6 +158,18 @@ func (_u *CoreGatewayClusterUpdate) ClearClusterLastRequestTime() *CoreGatewayCl
 	return _u
 }
 
+// SetMaintenance sets the "maintenance" field.
+func (_u *CoreGatewayClusterUpdate) SetMaintenance(v *common.Maintenance) *CoreGatewayClusterUpdate {
+	_u.mutation.SetMaintenance(v)
+	return _u
+}
+
+// ClearMaintenance clears the value of the "maintenance" field.
+func (_u *CoreGatewayClusterUpdate) ClearMaintenance() *CoreGatewayClusterUpdate {
+	_u.mutation.ClearMaintenance()
+	return _u
+}
+
 // AddClusterToNodeIDs adds the "cluster_to_node" edge to the CoreGatewayNode entity by IDs.
 func (_u *CoreGatewayClusterUpdate) AddClusterToNodeIDs(ids ...string) *CoreGatewayClusterUpdate {
 	_u.mutation.AddClusterToNodeIDs(ids...)
@@ -297,6 +310,12 @@ func (_u *CoreGatewayClusterUpdate) sqlSave(ctx context.Context) (_node int, err
 	if _u.mutation.ClusterLastRequestTimeCleared() {
 		_spec.ClearField(coregatewaycluster.FieldClusterLastRequestTime, field.TypeInt64)
 	}
+	if value, ok := _u.mutation.Maintenance(); ok {
+		_spec.SetField(coregatewaycluster.FieldMaintenance, field.TypeJSON, value)
+	}
+	if _u.mutation.MaintenanceCleared() {
+		_spec.ClearField(coregatewaycluster.FieldMaintenance, field.TypeJSON)
+	}
 	if _u.mutation.ClusterToNodeCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -491,6 +510,18 @@ func (_u *CoreGatewayClusterUpdateOne) ClearClusterLastRequestTime() *CoreGatewa
 	return _u
 }
 
+// SetMaintenance sets the "maintenance" field.
+func (_u *CoreGatewayClusterUpdateOne) SetMaintenance(v *common.Maintenance) *CoreGatewayClusterUpdateOne {
+	_u.mutation.SetMaintenance(v)
+	return _u
+}
+
+// ClearMaintenance clears the value of the "maintenance" field.
+func (_u *CoreGatewayClusterUpdateOne) ClearMaintenance() *CoreGatewayClusterUpdateOne {
+	_u.mutation.ClearMaintenance()
+	return _u
+}
+
 // AddClusterToNodeIDs adds the "cluster_to_node" edge to the CoreGatewayNode entity by IDs.
 func (_u *CoreGatewayClusterUpdateOne) AddClusterToNodeIDs(ids ...string) *CoreGatewayClusterUpdateOne {
 	_u.mutation.AddClusterToNodeIDs(ids...)
@@ -661,6 +692,12 @@ func (_u *CoreGatewayClusterUpdateOne) sqlSave(ctx context.Context) (_node *Core
 	if _u.mutation.ClusterLastRequestTimeCleared() {
 		_spec.ClearField(coregatewaycluster.FieldClusterLastRequestTime, field.TypeInt64)
 	}
+	if value, ok := _u.mutation.Maintenance(); ok {
+		_spec.SetField(coregatewaycluster.FieldMaintenance, field.TypeJSON, value)
+	}
+	if _u.mutation.MaintenanceCleared() {
+		_spec.ClearField(coregatewaycluster.FieldMaintenance, field.TypeJSON)
+	}
 	if _u.mutation.ClusterToNodeCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
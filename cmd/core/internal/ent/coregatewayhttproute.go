@@ -3,13 +3,17 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
+	"github.com/lyonmu/quebec/cmd/core/internal/common"
 	"github.com/lyonmu/quebec/cmd/core/internal/ent/coregatewayhttproute"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/coregatewayvirtualhost"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/coreupstream"
 	"github.com/lyonmu/quebec/pkg/constant"
 )
 
@@ -29,12 +33,50 @@ type CoreGatewayHttpRoute struct {
 	Name string `json:"name,omitempty"`
 	// 路由描述
 	Description string `json:"description,omitempty"`
+	// 目标上游服务ID，配置加权分流时为权重最大的目标
+	UpstreamID string `json:"upstream_id,omitempty"`
+	// 所属网关集群ID(Envoy node.cluster)，为空表示所有集群
+	ClusterID string `json:"cluster_id,omitempty"`
+	// 所属虚拟主机ID，为空表示所有监听器的默认虚拟主机(*)
+	VirtualHostID string `json:"virtual_host_id,omitempty"`
 	// 匹配类型: 1-前缀 2-精确 3-正则
 	MatchType constant.ProxyHttpRouteMatchType `json:"match_type,omitempty"`
 	// 匹配规则，如 /api/v1/*
 	MatchPattern string `json:"match_pattern,omitempty"`
+	// 匹配优先级，数值越大越先匹配；相同优先级时按匹配的精确程度排序
+	Priority int `json:"priority,omitempty"`
+	// 路径匹配是否忽略大小写 [1-是 2-否]
+	IgnoreCase constant.YesOrNo `json:"ignore_case,omitempty"`
+	// 匹配的 HTTP 方法，为空表示不限制
+	Methods []string `json:"methods,omitempty"`
+	// 请求头匹配条件，全部满足时路由才匹配
+	HeaderMatchers []common.HttpMatcher `json:"header_matchers,omitempty"`
+	// 查询参数匹配条件，全部满足时路由才匹配
+	QueryMatchers []common.HttpMatcher `json:"query_matchers,omitempty"`
 	// 路由超时(毫秒，默认15000=15秒)，包括所有重试
 	TimeoutMs int `json:"timeout_ms,omitempty"`
+	// 重试策略，为空表示不重试
+	RetryPolicy *common.RetryPolicy `json:"retry_policy,omitempty"`
+	// 请求头与响应头改写
+	HeaderMutation *common.HeaderMutation `json:"header_mutation,omitempty"`
+	// 流量镜像的影子上游服务ID，为空表示不镜像
+	MirrorUpstreamID string `json:"mirror_upstream_id,omitempty"`
+	// 镜像的请求比例(%)，支持两位小数
+	MirrorPercent float64 `json:"mirror_percent,omitempty"`
+	// 故障注入，到期后自动失效
+	FaultPolicy *common.FaultPolicy `json:"fault_policy,omitempty"`
+	// 跨域策略，为空时沿用虚拟主机的跨域策略
+	CorsPolicy *common.CorsPolicy `json:"cors_policy,omitempty"`
+	// 本地限流，为空时沿用监听器的本地限流
+	LocalRateLimit *common.LocalRateLimit `json:"local_rate_limit,omitempty"`
+	// 全局限流描述符条目，为空表示不进行全局限流
+	RateLimitActions []common.RateLimitAction `json:"rate_limit_actions,omitempty"`
+	// 直接响应，设置后不转发到上游服务
+	DirectResponse *common.DirectResponse `json:"direct_response,omitempty"`
+	// 一致性哈希的哈希策略，上游服务使用 RING_HASH 或 MAGLEV 时生效
+	HashPolicies []common.HashPolicy `json:"hash_policies,omitempty"`
+	// 是否关闭响应压缩 [1-是 2-否]
+	DisableCompression constant.YesOrNo `json:"disable_compression,omitempty"`
 	// 是否启用路径重写 [1-启用 2-禁用]
 	EnablePathRewrite constant.YesOrNo `json:"enable_path_rewrite,omitempty"`
 	// 路径重写规则，如 /api/v1/* /v1/*
@@ -46,18 +88,69 @@ type CoreGatewayHttpRoute struct {
 	// 重定向状态码
 	RedirectCode int `json:"redirect_code,omitempty"`
 	// 状态  [1-启用 2-禁用]
-	Status       constant.YesOrNo `json:"status,omitempty"`
+	Status constant.YesOrNo `json:"status,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the CoreGatewayHttpRouteQuery when eager-loading is set.
+	Edges        CoreGatewayHttpRouteEdges `json:"-" gorm:"-"`
 	selectValues sql.SelectValues
 }
 
+// CoreGatewayHttpRouteEdges holds the relations/edges for other nodes in the graph.
+type CoreGatewayHttpRouteEdges struct {
+	// RouteFromUpstream holds the value of the route_from_upstream edge.
+	RouteFromUpstream *CoreUpstream `json:"route_from_upstream,omitempty"`
+	// RouteToTarget holds the value of the route_to_target edge.
+	RouteToTarget []*CoreGatewayHttpRouteTarget `json:"route_to_target,omitempty"`
+	// RouteFromVhost holds the value of the route_from_vhost edge.
+	RouteFromVhost *CoreGatewayVirtualHost `json:"route_from_vhost,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [3]bool
+}
+
+// RouteFromUpstreamOrErr returns the RouteFromUpstream value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e CoreGatewayHttpRouteEdges) RouteFromUpstreamOrErr() (*CoreUpstream, error) {
+	if e.RouteFromUpstream != nil {
+		return e.RouteFromUpstream, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: coreupstream.Label}
+	}
+	return nil, &NotLoadedError{edge: "route_from_upstream"}
+}
+
+// RouteToTargetOrErr returns the RouteToTarget value or an error if the edge
+// was not loaded in eager-loading.
+func (e CoreGatewayHttpRouteEdges) RouteToTargetOrErr() ([]*CoreGatewayHttpRouteTarget, error) {
+	if e.loadedTypes[1] {
+		return e.RouteToTarget, nil
+	}
+	return nil, &NotLoadedError{edge: "route_to_target"}
+}
+
+// RouteFromVhostOrErr returns the RouteFromVhost value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e CoreGatewayHttpRouteEdges) RouteFromVhostOrErr() (*CoreGatewayVirtualHost, error) {
+	if e.RouteFromVhost != nil {
+		return e.RouteFromVhost, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: coregatewayvirtualhost.Label}
+	}
+	return nil, &NotLoadedError{edge: "route_from_vhost"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*CoreGatewayHttpRoute) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case coregatewayhttproute.FieldMatchType, coregatewayhttproute.FieldTimeoutMs, coregatewayhttproute.FieldEnablePathRewrite, coregatewayhttproute.FieldEnableRedirect, coregatewayhttproute.FieldRedirectCode, coregatewayhttproute.FieldStatus:
+		case coregatewayhttproute.FieldMethods, coregatewayhttproute.FieldHeaderMatchers, coregatewayhttproute.FieldQueryMatchers, coregatewayhttproute.FieldRetryPolicy, coregatewayhttproute.FieldHeaderMutation, coregatewayhttproute.FieldFaultPolicy, coregatewayhttproute.FieldCorsPolicy, coregatewayhttproute.FieldLocalRateLimit, coregatewayhttproute.FieldRateLimitActions, coregatewayhttproute.FieldDirectResponse, coregatewayhttproute.FieldHashPolicies:
+			values[i] = new([]byte)
+		case coregatewayhttproute.FieldMirrorPercent:
+			values[i] = new(sql.NullFloat64)
+		case coregatewayhttproute.FieldMatchType, coregatewayhttproute.FieldPriority, coregatewayhttproute.FieldIgnoreCase, coregatewayhttproute.FieldTimeoutMs, coregatewayhttproute.FieldDisableCompression, coregatewayhttproute.FieldEnablePathRewrite, coregatewayhttproute.FieldEnableRedirect, coregatewayhttproute.FieldRedirectCode, coregatewayhttproute.FieldStatus:
 			values[i] = new(sql.NullInt64)
-		case coregatewayhttproute.FieldID, coregatewayhttproute.FieldName, coregatewayhttproute.FieldDescription, coregatewayhttproute.FieldMatchPattern, coregatewayhttproute.FieldPathRewrite, coregatewayhttproute.FieldRedirectURL:
+		case coregatewayhttproute.FieldID, coregatewayhttproute.FieldName, coregatewayhttproute.FieldDescription, coregatewayhttproute.FieldUpstreamID, coregatewayhttproute.FieldClusterID, coregatewayhttproute.FieldVirtualHostID, coregatewayhttproute.FieldMatchPattern, coregatewayhttproute.FieldMirrorUpstreamID, coregatewayhttproute.FieldPathRewrite, coregatewayhttproute.FieldRedirectURL:
 			values[i] = new(sql.NullString)
 		case coregatewayhttproute.FieldCreatedAt, coregatewayhttproute.FieldUpdatedAt, coregatewayhttproute.FieldDeletedAt:
 			values[i] = new(sql.NullTime)
@@ -113,6 +206,24 @@ func (_m *CoreGatewayHttpRoute) assignValues(columns []string, values []any) err
 			} else if value.Valid {
 				_m.Description = value.String
 			}
+		case coregatewayhttproute.FieldUpstreamID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field upstream_id", values[i])
+			} else if value.Valid {
+				_m.UpstreamID = value.String
+			}
+		case coregatewayhttproute.FieldClusterID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field cluster_id", values[i])
+			} else if value.Valid {
+				_m.ClusterID = value.String
+			}
+		case coregatewayhttproute.FieldVirtualHostID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field virtual_host_id", values[i])
+			} else if value.Valid {
+				_m.VirtualHostID = value.String
+			}
 		case coregatewayhttproute.FieldMatchType:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field match_type", values[i])
@@ -125,12 +236,130 @@ func (_m *CoreGatewayHttpRoute) assignValues(columns []string, values []any) err
 			} else if value.Valid {
 				_m.MatchPattern = value.String
 			}
+		case coregatewayhttproute.FieldPriority:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field priority", values[i])
+			} else if value.Valid {
+				_m.Priority = int(value.Int64)
+			}
+		case coregatewayhttproute.FieldIgnoreCase:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field ignore_case", values[i])
+			} else if value.Valid {
+				_m.IgnoreCase = constant.YesOrNo(value.Int64)
+			}
+		case coregatewayhttproute.FieldMethods:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field methods", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Methods); err != nil {
+					return fmt.Errorf("unmarshal field methods: %w", err)
+				}
+			}
+		case coregatewayhttproute.FieldHeaderMatchers:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field header_matchers", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.HeaderMatchers); err != nil {
+					return fmt.Errorf("unmarshal field header_matchers: %w", err)
+				}
+			}
+		case coregatewayhttproute.FieldQueryMatchers:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field query_matchers", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.QueryMatchers); err != nil {
+					return fmt.Errorf("unmarshal field query_matchers: %w", err)
+				}
+			}
 		case coregatewayhttproute.FieldTimeoutMs:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field timeout_ms", values[i])
 			} else if value.Valid {
 				_m.TimeoutMs = int(value.Int64)
 			}
+		case coregatewayhttproute.FieldRetryPolicy:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field retry_policy", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.RetryPolicy); err != nil {
+					return fmt.Errorf("unmarshal field retry_policy: %w", err)
+				}
+			}
+		case coregatewayhttproute.FieldHeaderMutation:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field header_mutation", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.HeaderMutation); err != nil {
+					return fmt.Errorf("unmarshal field header_mutation: %w", err)
+				}
+			}
+		case coregatewayhttproute.FieldMirrorUpstreamID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field mirror_upstream_id", values[i])
+			} else if value.Valid {
+				_m.MirrorUpstreamID = value.String
+			}
+		case coregatewayhttproute.FieldMirrorPercent:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field mirror_percent", values[i])
+			} else if value.Valid {
+				_m.MirrorPercent = value.Float64
+			}
+		case coregatewayhttproute.FieldFaultPolicy:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field fault_policy", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.FaultPolicy); err != nil {
+					return fmt.Errorf("unmarshal field fault_policy: %w", err)
+				}
+			}
+		case coregatewayhttproute.FieldCorsPolicy:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field cors_policy", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.CorsPolicy); err != nil {
+					return fmt.Errorf("unmarshal field cors_policy: %w", err)
+				}
+			}
+		case coregatewayhttproute.FieldLocalRateLimit:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field local_rate_limit", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.LocalRateLimit); err != nil {
+					return fmt.Errorf("unmarshal field local_rate_limit: %w", err)
+				}
+			}
+		case coregatewayhttproute.FieldRateLimitActions:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field rate_limit_actions", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.RateLimitActions); err != nil {
+					return fmt.Errorf("unmarshal field rate_limit_actions: %w", err)
+				}
+			}
+		case coregatewayhttproute.FieldDirectResponse:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field direct_response", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.DirectResponse); err != nil {
+					return fmt.Errorf("unmarshal field direct_response: %w", err)
+				}
+			}
+		case coregatewayhttproute.FieldHashPolicies:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field hash_policies", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.HashPolicies); err != nil {
+					return fmt.Errorf("unmarshal field hash_policies: %w", err)
+				}
+			}
+		case coregatewayhttproute.FieldDisableCompression:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field disable_compression", values[i])
+			} else if value.Valid {
+				_m.DisableCompression = constant.YesOrNo(value.Int64)
+			}
 		case coregatewayhttproute.FieldEnablePathRewrite:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field enable_path_rewrite", values[i])
@@ -180,6 +409,21 @@ func (_m *CoreGatewayHttpRoute) Value(name string) (ent.Value, error) {
 	return _m.selectValues.Get(name)
 }
 
+// QueryRouteFromUpstream queries the "route_from_upstream" edge of the CoreGatewayHttpRoute entity.
+func (_m *CoreGatewayHttpRoute) QueryRouteFromUpstream() *CoreUpstreamQuery {
+	return NewCoreGatewayHttpRouteClient(_m.config).QueryRouteFromUpstream(_m)
+}
+
+// QueryRouteToTarget queries the "route_to_target" edge of the CoreGatewayHttpRoute entity.
+func (_m *CoreGatewayHttpRoute) QueryRouteToTarget() *CoreGatewayHttpRouteTargetQuery {
+	return NewCoreGatewayHttpRouteClient(_m.config).QueryRouteToTarget(_m)
+}
+
+// QueryRouteFromVhost queries the "route_from_vhost" edge of the CoreGatewayHttpRoute entity.
+func (_m *CoreGatewayHttpRoute) QueryRouteFromVhost() *CoreGatewayVirtualHostQuery {
+	return NewCoreGatewayHttpRouteClient(_m.config).QueryRouteFromVhost(_m)
+}
+
 // Update returns a builder for updating this CoreGatewayHttpRoute.
 // Note that you need to call CoreGatewayHttpRoute.Unwrap() before calling this method if this CoreGatewayHttpRoute
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -220,15 +464,72 @@ func (_m *CoreGatewayHttpRoute) String() string {
 	builder.WriteString("description=")
 	builder.WriteString(_m.Description)
 	builder.WriteString(", ")
+	builder.WriteString("upstream_id=")
+	builder.WriteString(_m.UpstreamID)
+	builder.WriteString(", ")
+	builder.WriteString("cluster_id=")
+	builder.WriteString(_m.ClusterID)
+	builder.WriteString(", ")
+	builder.WriteString("virtual_host_id=")
+	builder.WriteString(_m.VirtualHostID)
+	builder.WriteString(", ")
 	builder.WriteString("match_type=")
 	builder.WriteString(fmt.Sprintf("%v", _m.MatchType))
 	builder.WriteString(", ")
 	builder.WriteString("match_pattern=")
 	builder.WriteString(_m.MatchPattern)
 	builder.WriteString(", ")
+	builder.WriteString("priority=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Priority))
+	builder.WriteString(", ")
+	builder.WriteString("ignore_case=")
+	builder.WriteString(fmt.Sprintf("%v", _m.IgnoreCase))
+	builder.WriteString(", ")
+	builder.WriteString("methods=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Methods))
+	builder.WriteString(", ")
+	builder.WriteString("header_matchers=")
+	builder.WriteString(fmt.Sprintf("%v", _m.HeaderMatchers))
+	builder.WriteString(", ")
+	builder.WriteString("query_matchers=")
+	builder.WriteString(fmt.Sprintf("%v", _m.QueryMatchers))
+	builder.WriteString(", ")
 	builder.WriteString("timeout_ms=")
 	builder.WriteString(fmt.Sprintf("%v", _m.TimeoutMs))
 	builder.WriteString(", ")
+	builder.WriteString("retry_policy=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RetryPolicy))
+	builder.WriteString(", ")
+	builder.WriteString("header_mutation=")
+	builder.WriteString(fmt.Sprintf("%v", _m.HeaderMutation))
+	builder.WriteString(", ")
+	builder.WriteString("mirror_upstream_id=")
+	builder.WriteString(_m.MirrorUpstreamID)
+	builder.WriteString(", ")
+	builder.WriteString("mirror_percent=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MirrorPercent))
+	builder.WriteString(", ")
+	builder.WriteString("fault_policy=")
+	builder.WriteString(fmt.Sprintf("%v", _m.FaultPolicy))
+	builder.WriteString(", ")
+	builder.WriteString("cors_policy=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CorsPolicy))
+	builder.WriteString(", ")
+	builder.WriteString("local_rate_limit=")
+	builder.WriteString(fmt.Sprintf("%v", _m.LocalRateLimit))
+	builder.WriteString(", ")
+	builder.WriteString("rate_limit_actions=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RateLimitActions))
+	builder.WriteString(", ")
+	builder.WriteString("direct_response=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DirectResponse))
+	builder.WriteString(", ")
+	builder.WriteString("hash_policies=")
+	builder.WriteString(fmt.Sprintf("%v", _m.HashPolicies))
+	builder.WriteString(", ")
+	builder.WriteString("disable_compression=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DisableCompression))
+	builder.WriteString(", ")
 	builder.WriteString("enable_path_rewrite=")
 	builder.WriteString(fmt.Sprintf("%v", _m.EnablePathRewrite))
 	builder.WriteString(", ")
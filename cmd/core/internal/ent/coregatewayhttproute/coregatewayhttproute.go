@@ -7,6 +7,7 @@ import (
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/lyonmu/quebec/pkg/constant"
 )
 
@@ -25,12 +26,50 @@ const (
 	FieldName = "name"
 	// FieldDescription holds the string denoting the description field in the database.
 	FieldDescription = "description"
+	// FieldUpstreamID holds the string denoting the upstream_id field in the database.
+	FieldUpstreamID = "upstream_id"
+	// FieldClusterID holds the string denoting the cluster_id field in the database.
+	FieldClusterID = "cluster_id"
+	// FieldVirtualHostID holds the string denoting the virtual_host_id field in the database.
+	FieldVirtualHostID = "virtual_host_id"
 	// FieldMatchType holds the string denoting the match_type field in the database.
 	FieldMatchType = "match_type"
 	// FieldMatchPattern holds the string denoting the match_pattern field in the database.
 	FieldMatchPattern = "match_pattern"
+	// FieldPriority holds the string denoting the priority field in the database.
+	FieldPriority = "priority"
+	// FieldIgnoreCase holds the string denoting the ignore_case field in the database.
+	FieldIgnoreCase = "ignore_case"
+	// FieldMethods holds the string denoting the methods field in the database.
+	FieldMethods = "methods"
+	// FieldHeaderMatchers holds the string denoting the header_matchers field in the database.
+	FieldHeaderMatchers = "header_matchers"
+	// FieldQueryMatchers holds the string denoting the query_matchers field in the database.
+	FieldQueryMatchers = "query_matchers"
 	// FieldTimeoutMs holds the string denoting the timeout_ms field in the database.
 	FieldTimeoutMs = "timeout_ms"
+	// FieldRetryPolicy holds the string denoting the retry_policy field in the database.
+	FieldRetryPolicy = "retry_policy"
+	// FieldHeaderMutation holds the string denoting the header_mutation field in the database.
+	FieldHeaderMutation = "header_mutation"
+	// FieldMirrorUpstreamID holds the string denoting the mirror_upstream_id field in the database.
+	FieldMirrorUpstreamID = "mirror_upstream_id"
+	// FieldMirrorPercent holds the string denoting the mirror_percent field in the database.
+	FieldMirrorPercent = "mirror_percent"
+	// FieldFaultPolicy holds the string denoting the fault_policy field in the database.
+	FieldFaultPolicy = "fault_policy"
+	// FieldCorsPolicy holds the string denoting the cors_policy field in the database.
+	FieldCorsPolicy = "cors_policy"
+	// FieldLocalRateLimit holds the string denoting the local_rate_limit field in the database.
+	FieldLocalRateLimit = "local_rate_limit"
+	// FieldRateLimitActions holds the string denoting the rate_limit_actions field in the database.
+	FieldRateLimitActions = "rate_limit_actions"
+	// FieldDirectResponse holds the string denoting the direct_response field in the database.
+	FieldDirectResponse = "direct_response"
+	// FieldHashPolicies holds the string denoting the hash_policies field in the database.
+	FieldHashPolicies = "hash_policies"
+	// FieldDisableCompression holds the string denoting the disable_compression field in the database.
+	FieldDisableCompression = "disable_compression"
 	// FieldEnablePathRewrite holds the string denoting the enable_path_rewrite field in the database.
 	FieldEnablePathRewrite = "enable_path_rewrite"
 	// FieldPathRewrite holds the string denoting the path_rewrite field in the database.
@@ -43,8 +82,35 @@ const (
 	FieldRedirectCode = "redirect_code"
 	// FieldStatus holds the string denoting the status field in the database.
 	FieldStatus = "status"
+	// EdgeRouteFromUpstream holds the string denoting the route_from_upstream edge name in mutations.
+	EdgeRouteFromUpstream = "route_from_upstream"
+	// EdgeRouteToTarget holds the string denoting the route_to_target edge name in mutations.
+	EdgeRouteToTarget = "route_to_target"
+	// EdgeRouteFromVhost holds the string denoting the route_from_vhost edge name in mutations.
+	EdgeRouteFromVhost = "route_from_vhost"
 	// Table holds the table name of the coregatewayhttproute in the database.
 	Table = "quebec_core_gateway_http_route"
+	// RouteFromUpstreamTable is the table that holds the route_from_upstream relation/edge.
+	RouteFromUpstreamTable = "quebec_core_gateway_http_route"
+	// RouteFromUpstreamInverseTable is the table name for the CoreUpstream entity.
+	// It exists in this package in order to avoid circular dependency with the "coreupstream" package.
+	RouteFromUpstreamInverseTable = "quebec_core_upstream"
+	// RouteFromUpstreamColumn is the table column denoting the route_from_upstream relation/edge.
+	RouteFromUpstreamColumn = "upstream_id"
+	// RouteToTargetTable is the table that holds the route_to_target relation/edge.
+	RouteToTargetTable = "quebec_core_gateway_http_route_target"
+	// RouteToTargetInverseTable is the table name for the CoreGatewayHttpRouteTarget entity.
+	// It exists in this package in order to avoid circular dependency with the "coregatewayhttproutetarget" package.
+	RouteToTargetInverseTable = "quebec_core_gateway_http_route_target"
+	// RouteToTargetColumn is the table column denoting the route_to_target relation/edge.
+	RouteToTargetColumn = "route_id"
+	// RouteFromVhostTable is the table that holds the route_from_vhost relation/edge.
+	RouteFromVhostTable = "quebec_core_gateway_http_route"
+	// RouteFromVhostInverseTable is the table name for the CoreGatewayVirtualHost entity.
+	// It exists in this package in order to avoid circular dependency with the "coregatewayvirtualhost" package.
+	RouteFromVhostInverseTable = "quebec_core_gateway_virtual_host"
+	// RouteFromVhostColumn is the table column denoting the route_from_vhost relation/edge.
+	RouteFromVhostColumn = "virtual_host_id"
 )
 
 // Columns holds all SQL columns for coregatewayhttproute fields.
@@ -55,9 +121,28 @@ var Columns = []string{
 	FieldDeletedAt,
 	FieldName,
 	FieldDescription,
+	FieldUpstreamID,
+	FieldClusterID,
+	FieldVirtualHostID,
 	FieldMatchType,
 	FieldMatchPattern,
+	FieldPriority,
+	FieldIgnoreCase,
+	FieldMethods,
+	FieldHeaderMatchers,
+	FieldQueryMatchers,
 	FieldTimeoutMs,
+	FieldRetryPolicy,
+	FieldHeaderMutation,
+	FieldMirrorUpstreamID,
+	FieldMirrorPercent,
+	FieldFaultPolicy,
+	FieldCorsPolicy,
+	FieldLocalRateLimit,
+	FieldRateLimitActions,
+	FieldDirectResponse,
+	FieldHashPolicies,
+	FieldDisableCompression,
 	FieldEnablePathRewrite,
 	FieldPathRewrite,
 	FieldEnableRedirect,
@@ -91,8 +176,14 @@ var (
 	UpdateDefaultUpdatedAt func() time.Time
 	// DefaultMatchType holds the default value on creation for the "match_type" field.
 	DefaultMatchType constant.ProxyHttpRouteMatchType
+	// DefaultPriority holds the default value on creation for the "priority" field.
+	DefaultPriority int
+	// DefaultIgnoreCase holds the default value on creation for the "ignore_case" field.
+	DefaultIgnoreCase constant.YesOrNo
 	// DefaultTimeoutMs holds the default value on creation for the "timeout_ms" field.
 	DefaultTimeoutMs int
+	// DefaultDisableCompression holds the default value on creation for the "disable_compression" field.
+	DefaultDisableCompression constant.YesOrNo
 	// DefaultEnablePathRewrite holds the default value on creation for the "enable_path_rewrite" field.
 	DefaultEnablePathRewrite constant.YesOrNo
 	// DefaultEnableRedirect holds the default value on creation for the "enable_redirect" field.
@@ -140,6 +231,21 @@ func ByDescription(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldDescription, opts...).ToFunc()
 }
 
+// ByUpstreamID orders the results by the upstream_id field.
+func ByUpstreamID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpstreamID, opts...).ToFunc()
+}
+
+// ByClusterID orders the results by the cluster_id field.
+func ByClusterID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldClusterID, opts...).ToFunc()
+}
+
+// ByVirtualHostID orders the results by the virtual_host_id field.
+func ByVirtualHostID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVirtualHostID, opts...).ToFunc()
+}
+
 // ByMatchType orders the results by the match_type field.
 func ByMatchType(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldMatchType, opts...).ToFunc()
@@ -150,11 +256,36 @@ func ByMatchPattern(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldMatchPattern, opts...).ToFunc()
 }
 
+// ByPriority orders the results by the priority field.
+func ByPriority(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPriority, opts...).ToFunc()
+}
+
+// ByIgnoreCase orders the results by the ignore_case field.
+func ByIgnoreCase(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIgnoreCase, opts...).ToFunc()
+}
+
 // ByTimeoutMs orders the results by the timeout_ms field.
 func ByTimeoutMs(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldTimeoutMs, opts...).ToFunc()
 }
 
+// ByMirrorUpstreamID orders the results by the mirror_upstream_id field.
+func ByMirrorUpstreamID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMirrorUpstreamID, opts...).ToFunc()
+}
+
+// ByMirrorPercent orders the results by the mirror_percent field.
+func ByMirrorPercent(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMirrorPercent, opts...).ToFunc()
+}
+
+// ByDisableCompression orders the results by the disable_compression field.
+func ByDisableCompression(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDisableCompression, opts...).ToFunc()
+}
+
 // ByEnablePathRewrite orders the results by the enable_path_rewrite field.
 func ByEnablePathRewrite(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldEnablePathRewrite, opts...).ToFunc()
@@ -184,3 +315,52 @@ func ByRedirectCode(opts ...sql.OrderTermOption) OrderOption {
 func ByStatus(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldStatus, opts...).ToFunc()
 }
+
+// ByRouteFromUpstreamField orders the results by route_from_upstream field.
+func ByRouteFromUpstreamField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRouteFromUpstreamStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByRouteToTargetCount orders the results by route_to_target count.
+func ByRouteToTargetCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newRouteToTargetStep(), opts...)
+	}
+}
+
+// ByRouteToTarget orders the results by route_to_target terms.
+func ByRouteToTarget(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRouteToTargetStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByRouteFromVhostField orders the results by route_from_vhost field.
+func ByRouteFromVhostField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRouteFromVhostStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newRouteFromUpstreamStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RouteFromUpstreamInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, RouteFromUpstreamTable, RouteFromUpstreamColumn),
+	)
+}
+func newRouteToTargetStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RouteToTargetInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, RouteToTargetTable, RouteToTargetColumn),
+	)
+}
+func newRouteFromVhostStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RouteFromVhostInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, RouteFromVhostTable, RouteFromVhostColumn),
+	)
+}
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/lyonmu/quebec/cmd/core/internal/ent/predicate"
 	"github.com/lyonmu/quebec/pkg/constant"
 )
@@ -90,6 +91,21 @@ func Description(v string) predicate.CoreGatewayHttpRoute {
 	return predicate.CoreGatewayHttpRoute(sql.FieldEQ(FieldDescription, v))
 }
 
+// UpstreamID applies equality check predicate on the "upstream_id" field. It's identical to UpstreamIDEQ.
+func UpstreamID(v string) predicate.CoreGatewayHttpRoute {
+	return predicate.CoreGatewayHttpRoute(sql.FieldEQ(FieldUpstreamID, v))
+}
+
+// ClusterID applies equality check predicate on the "cluster_id" field. It's identical to ClusterIDEQ.
+func ClusterID(v string) predicate.CoreGatewayHttpRoute {
+	return predicate.CoreGatewayHttpRoute(sql.FieldEQ(FieldClusterID, v))
+}
+
+// VirtualHostID applies equality check predicate on the "virtual_host_id" field. It's identical to VirtualHostIDEQ.
+func VirtualHostID(v string) predicate.CoreGatewayHttpRoute {
+	return predicate.CoreGatewayHttpRoute(sql.FieldEQ(FieldVirtualHostID, v))
+}
+
 // MatchType applies equality check predicate on the "match_type" field. It's identical to MatchTypeEQ.
 func MatchType(v constant.ProxyHttpRouteMatchType) predicate.CoreGatewayHttpRoute {
 	vc := int8(v)
@@ -101,11 +117,38 @@ func MatchPattern(v string) predicate.CoreGatewayHttpRoute {
 	return predicate.CoreGatewayHttpRoute(sql.FieldEQ(FieldMatchPattern, v))
 }
 
+// Priority applies equality check predicate on the "priority" field. It's identical to PriorityEQ.
+func Priority(v int) predicate.CoreGatewayHttpRoute {
+	return predicate.CoreGatewayHttpRoute(sql.FieldEQ(FieldPriority, v))
+}
+
+// IgnoreCase applies equality check predicate on the "ignore_case" field. It's identical to IgnoreCaseEQ.
+func IgnoreCase(v constant.YesOrNo) predicate.CoreGatewayHttpRoute {
+	vc := int8(v)
+	return predicate.CoreGatewayHttpRoute(sql.FieldEQ(FieldIgnoreCase, vc))
+}
+
 // TimeoutMs applies equality check predicate on the "timeout_ms" field. It's identical to TimeoutMsEQ.
 func TimeoutMs(v int) predicate.CoreGatewayHttpRoute {
 	return predicate.CoreGatewayHttpRoute(sql.FieldEQ(FieldTimeoutMs, v))
 }
 
+// MirrorUpstreamID applies equality check predicate on the "mirror_upstream_id" field. It's identical to MirrorUpstreamIDEQ.
+func MirrorUpstreamID(v string) predicate.CoreGatewayHttpRoute {
+	return predicate.CoreGatewayHttpRoute(sql.FieldEQ(FieldMirrorUpstreamID, v))
+}
+
+// MirrorPercent applies equality check predicate on the "mirror_percent" field. It's identical to MirrorPercentEQ.
+func MirrorPercent(v float64) predicate.CoreGatewayHttpRoute {
+	return predicate.CoreGatewayHttpRoute(sql.FieldEQ(FieldMirrorPercent, v))
+}
+
+// DisableCompression applies equality check predicate on the "disable_compression" field. It's identical to DisableCompressionEQ.
+func DisableCompression(v constant.YesOrNo) predicate.CoreGatewayHttpRoute {
+	vc := int8(v)
+	return predicate.CoreGatewayHttpRoute(sql.FieldEQ(FieldDisableCompression, vc))
+}
+
 // EnablePathRewrite applies equality check predicate on the "enable_path_rewrite" field. It's identical to EnablePathRewriteEQ.
 func EnablePathRewrite(v constant.YesOrNo) predicate.CoreGatewayHttpRoute {
 	vc := int8(v)
@@ -419,6 +462,231 @@ func DescriptionContainsFold(v string) predicate.CoreGatewayHttpRoute {
 	return predicate.CoreGatewayHttpRoute(sql.FieldContainsFold(FieldDescription, v))
 }
 
+// UpstreamIDEQ applies the EQ predicate on the "upstream_id" field.
+func UpstreamIDEQ(v string) predicate.CoreGatewayHttpRoute {
+	return predicate.CoreGatewayHttpRoute(sql.FieldEQ(FieldUpstreamID, v))
+}
+
+// UpstreamIDNEQ applies the NEQ predicate on the "upstream_id" field.
+func UpstreamIDNEQ(v string) predicate.CoreGatewayHttpRoute {
+	return predicate.CoreGatewayHttpRoute(sql.FieldNEQ(FieldUpstreamID, v))
+}
+
+// UpstreamIDIn applies the In predicate on the "upstream_id" field.
+func UpstreamIDIn(vs ...string) predicate.CoreGatewayHttpRoute {
+	return predicate.CoreGatewayHttpRoute(sql.FieldIn(FieldUpstreamID, vs...))
+}
+
+// UpstreamIDNotIn applies the NotIn predicate on the "upstream_id" field.
+func UpstreamIDNotIn(vs ...string) predicate.CoreGatewayHttpRoute {
+	return predicate.CoreGatewayHttpRoute(sql.FieldNotIn(FieldUpstreamID, vs...))
+}
+
+// UpstreamIDGT applies the GT predicate on the "upstream_id" field.
+func UpstreamIDGT(v string) predicate.CoreGatewayHttpRoute {
+	return predicate.CoreGatewayHttpRoute(sql.FieldGT(FieldUpstreamID, v))
+}
+
+// UpstreamIDGTE applies the GTE predicate on the "upstream_id" field.
+func UpstreamIDGTE(v string) predicate.CoreGatewayHttpRoute {
+	return predicate.CoreGatewayHttpRoute(sql.FieldGTE(FieldUpstreamID, v))
+}
+
+// UpstreamIDLT applies the LT predicate on the "upstream_id" field.
+func UpstreamIDLT(v string) predicate.CoreGatewayHttpRoute {
+	return predicate.CoreGatewayHttpRoute(sql.FieldLT(FieldUpstreamID, v))
+}
+
+// UpstreamIDLTE applies the LTE predicate on the "upstream_id" field.
+func UpstreamIDLTE(v string) predicate.CoreGatewayHttpRoute {
+	return predicate.CoreGatewayHttpRoute(sql.FieldLTE(FieldUpstreamID, v))
+}
+
+// UpstreamIDContains applies the Contains predicate on the "upstream_id" field.
+func UpstreamIDContains(v string) predicate.CoreGatewayHttpRoute {
+	return predicate.CoreGatewayHttpRoute(sql.FieldContains(FieldUpstreamID, v))
+}
+
+// UpstreamIDHasPrefix applies the HasPrefix predicate on the "upstream_id" field.
+func UpstreamIDHasPrefix(v string) predicate.CoreGatewayHttpRoute {
+	return predicate.CoreGatewayHttpRoute(sql.FieldHasPrefix(FieldUpstreamID, v))
+}
+
+// UpstreamIDHasSuffix applies the HasSuffix predicate on the "upstream_id" field.
+func UpstreamIDHasSuffix(v string) predicate.CoreGatewayHttpRoute {
+	return predicate.CoreGatewayHttpRoute(sql.FieldHasSuffix(FieldUpstreamID, v))
+}
+
+// UpstreamIDIsNil applies the IsNil predicate on the "upstream_id" field.
+func UpstreamIDIsNil() predicate.CoreGatewayHttpRoute {
+	return predicate.CoreGatewayHttpRoute(sql.FieldIsNull(FieldUpstreamID))
+}
+
+// UpstreamIDNotNil applies the NotNil predicate on the "upstream_id" field.
+func UpstreamIDNotNil() predicate.CoreGatewayHttpRoute {
+	return predicate.CoreGatewayHttpRoute(sql.FieldNotNull(FieldUpstreamID))
+}
+
+// UpstreamIDEqualFold applies the EqualFold predicate on the "upstream_id" field.
+func UpstreamIDEqualFold(v string) predicate.CoreGatewayHttpRoute {
+	return predicate.CoreGatewayHttpRoute(sql.FieldEqualFold(FieldUpstreamID, v))
+}
+
+// UpstreamIDContainsFold applies the ContainsFold predicate on the "upstream_id" field.
+func UpstreamIDContainsFold(v string) predicate.CoreGatewayHttpRoute {
+	return predicate.CoreGatewayHttpRoute(sql.FieldContainsFold(FieldUpstreamID, v))
+}
+
+// ClusterIDEQ applies the EQ predicate on the "cluster_id" field.
+func ClusterIDEQ(v string) predicate.CoreGatewayHttpRoute {
+	return predicate.CoreGatewayHttpRoute(sql.FieldEQ(FieldClusterID, v))
+}
+
+// ClusterIDNEQ applies the NEQ predicate on the "cluster_id" field.
+func ClusterIDNEQ(v string) predicate.CoreGatewayHttpRoute {
+	return predicate.CoreGatewayHttpRoute(sql.FieldNEQ(FieldClusterID, v))
+}
+
+// ClusterIDIn applies the In predicate on the "cluster_id" field.
+func ClusterIDIn(vs ...string) predicate.CoreGatewayHttpRoute {
+	return predicate.CoreGatewayHttpRoute(sql.FieldIn(FieldClusterID, vs...))
+}
+
+// ClusterIDNotIn applies the NotIn predicate on the "cluster_id" field.
+func ClusterIDNotIn(vs ...string) predicate.CoreGatewayHttpRoute {
+	return predicate.CoreGatewayHttpRoute(sql.FieldNotIn(FieldClusterID, vs...))
+}
+
+// ClusterIDGT applies the GT predicate on the "cluster_id" field.
+func ClusterIDGT(v string) predicate.CoreGatewayHttpRoute {
+	return predicate.CoreGatewayHttpRoute(sql.FieldGT(FieldClusterID, v))
+}
+
+// ClusterIDGTE applies the GTE predicate on the "cluster_id" field.
+func ClusterIDGTE(v string) predicate.CoreGatewayHttpRoute {
+	return predicate.CoreGatewayHttpRoute(sql.FieldGTE(FieldClusterID, v))
+}
+
+// ClusterIDLT applies the LT predicate on the "cluster_id" field.
+func ClusterIDLT(v string) predicate.CoreGatewayHttpRoute {
+	return predicate.CoreGatewayHttpRoute(sql.FieldLT(FieldClusterID, v))
+}
+
+// ClusterIDLTE applies the LTE predicate on the "cluster_id" field.
+func ClusterIDLTE(v string) predicate.CoreGatewayHttpRoute {
+	return predicate.CoreGatewayHttpRoute(sql.FieldLTE(FieldClusterID, v))
+}
+
+// ClusterIDContains applies the Contains predicate on the "cluster_id" field.
+func ClusterIDContains(v string) predicate.CoreGatewayHttpRoute {
+	return predicate.CoreGatewayHttpRoute(sql.FieldContains(FieldClusterID, v))
+}
+
+// ClusterIDHasPrefix applies the HasPrefix predicate on the "cluster_id" field.
+func ClusterIDHasPrefix(v string) predicate.CoreGatewayHttpRoute {
+	return predicate.CoreGatewayHttpRoute(sql.FieldHasPrefix(FieldClusterID, v))
+}
+
+// ClusterIDHasSuffix applies the HasSuffix predicate on the "cluster_id" field.
+func ClusterIDHasSuffix(v string) predicate.CoreGatewayHttpRoute {
+	return predicate.CoreGatewayHttpRoute(sql.FieldHasSuffix(FieldClusterID, v))
+}
+
+// ClusterIDIsNil applies the IsNil predicate on the "cluster_id" field.
+func ClusterIDIsNil() predicate.CoreGatewayHttpRoute {
+	return predicate.CoreGatewayHttpRoute(sql.FieldIsNull(FieldClusterID))
+}
+
+// ClusterIDNotNil applies the NotNil predicate on the "cluster_id" field.
+func ClusterIDNotNil() predicate.CoreGatewayHttpRoute {
+	return predicate.CoreGatewayHttpRoute(sql.FieldNotNull(FieldClusterID))
+}
+
+// ClusterIDEqualFold applies the EqualFold predicate on the "cluster_id" field.
+func ClusterIDEqualFold(v string) predicate.CoreGatewayHttpRoute {
+	return predicate.CoreGatewayHttpRoute(sql.FieldEqualFold(FieldClusterID, v))
+}
+
+// ClusterIDContainsFold applies the ContainsFold predicate on the "cluster_id" field.
+func ClusterIDContainsFold(v string) predicate.CoreGatewayHttpRoute {
+	return predicate.CoreGatewayHttpRoute(sql.FieldContainsFold(FieldClusterID, v))
+}
+
+// VirtualHostIDEQ applies the EQ predicate on the "virtual_host_id" field.
+func VirtualHostIDEQ(v string) predicate.CoreGatewayHttpRoute {
+	return predicate.CoreGatewayHttpRoute(sql.FieldEQ(FieldVirtualHostID, v))
+}
+
+// VirtualHostIDNEQ applies the NEQ predicate on the "virtual_host_id" field.
+func VirtualHostIDNEQ(v string) predicate.CoreGatewayHttpRoute {
+	return predicate.CoreGatewayHttpRoute(sql.FieldNEQ(FieldVirtualHostID, v))
+}
+
+// VirtualHostIDIn applies the In predicate on the "virtual_host_id" field.
+func VirtualHostIDIn(vs ...string) predicate.CoreGatewayHttpRoute {
+	return predicate.CoreGatewayHttpRoute(sql.FieldIn(FieldVirtualHostID, vs...))
+}
+
+// VirtualHostIDNotIn applies the NotIn predicate on the "virtual_host_id" field.
+func VirtualHostIDNotIn(vs ...string) predicate.CoreGatewayHttpRoute {
+	return predicate.CoreGatewayHttpRoute(sql.FieldNotIn(FieldVirtualHostID, vs...))
+}
+
+// VirtualHostIDGT applies the GT predicate on the "virtual_host_id" field.
+func VirtualHostIDGT(v string) predicate.CoreGatewayHttpRoute {
+	return predicate.CoreGatewayHttpRoute(sql.FieldGT(FieldVirtualHostID, v))
+}
+
+// VirtualHostIDGTE applies the GTE predicate on the "virtual_host_id" field.
+func VirtualHostIDGTE(v string) predicate.CoreGatewayHttpRoute {
+	return predicate.CoreGatewayHttpRoute(sql.FieldGTE(FieldVirtualHostID, v))
+}
+
+// VirtualHostIDLT applies the LT predicate on the "virtual_host_id" field.
+func VirtualHostIDLT(v string) predicate.CoreGatewayHttpRoute {
+	return predicate.CoreGatewayHttpRoute(sql.FieldLT(FieldVirtualHostID, v))
+}
+
+// VirtualHostIDLTE applies the LTE predicate on the "virtual_host_id" field.
+func VirtualHostIDLTE(v string) predicate.CoreGatewayHttpRoute {
+	return predicate.CoreGatewayHttpRoute(sql.FieldLTE(FieldVirtualHostID, v))
+}
+
+// VirtualHostIDContains applies the Contains predicate on the "virtual_host_id" field.
+func VirtualHostIDContains(v string) predicate.CoreGatewayHttpRoute {
+	return predicate.CoreGatewayHttpRoute(sql.FieldContains(FieldVirtualHostID, v))
+}
+
+// VirtualHostIDHasPrefix applies the HasPrefix predicate on the "virtual_host_id" field.
+func VirtualHostIDHasPrefix(v string) predicate.CoreGatewayHttpRoute {
+	return predicate.CoreGatewayHttpRoute(sql.FieldHasPrefix(FieldVirtualHostID, v))
+}
+
+// VirtualHostIDHasSuffix applies the HasSuffix predicate on the "virtual_host_id" field.
+func VirtualHostIDHasSuffix(v string) predicate.CoreGatewayHttpRoute {
+	return predicate.CoreGatewayHttpRoute(sql.FieldHasSuffix(FieldVirtualHostID, v))
+}
+
+// VirtualHostIDIsNil applies the IsNil predicate on the "virtual_host_id" field.
+func VirtualHostIDIsNil() predicate.CoreGatewayHttpRoute {
+	return predicate.CoreGatewayHttpRoute(sql.FieldIsNull(FieldVirtualHostID))
+}
+
+// VirtualHostIDNotNil applies the NotNil predicate on the "virtual_host_id" field.
+func VirtualHostIDNotNil() predicate.CoreGatewayHttpRoute {
+	return predicate.CoreGatewayHttpRoute(sql.FieldNotNull(FieldVirtualHostID))
+}
+
+// VirtualHostIDEqualFold applies the EqualFold predicate on the "virtual_host_id" field.
+func VirtualHostIDEqualFold(v string) predicate.CoreGatewayHttpRoute {
+	return predicate.CoreGatewayHttpRoute(sql.FieldEqualFold(FieldVirtualHostID, v))
+}
+
+// VirtualHostIDContainsFold applies the ContainsFold predicate on the "virtual_host_id" field.
+func VirtualHostIDContainsFold(v string) predicate.CoreGatewayHttpRoute {
+	return predicate.CoreGatewayHttpRoute(sql.FieldContainsFold(FieldVirtualHostID, v))
+}
+
 // MatchTypeEQ applies the EQ predicate on the "match_type" field.
 func MatchTypeEQ(v constant.ProxyHttpRouteMatchType) predicate.CoreGatewayHttpRoute {
 	vc := int8(v)
@@ -558,6 +826,150 @@ func MatchPatternContainsFold(v string) predicate.CoreGatewayHttpRoute {
 	return predicate.CoreGatewayHttpRoute(sql.FieldContainsFold(FieldMatchPattern, v))
 }
 
+// PriorityEQ applies the EQ predicate on the "priority" field.
+func PriorityEQ(v int) predicate.CoreGatewayHttpRoute {
+	return predicate.CoreGatewayHttpRoute(sql.FieldEQ(FieldPriority, v))
+}
+
+// PriorityNEQ applies the NEQ predicate on the "priority" field.
+func PriorityNEQ(v int) predicate.CoreGatewayHttpRoute {
+	return predicate.CoreGatewayHttpRoute(sql.FieldNEQ(FieldPriority, v))
+}
+
+// PriorityIn applies the In predicate on the "priority" field.
+func PriorityIn(vs ...int) predicate.CoreGatewayHttpRoute {
+	return predicate.CoreGatewayHttpRoute(sql.FieldIn(FieldPriority, vs...))
+}
+
+// PriorityNotIn applies the NotIn predicate on the "priority" field.
+func PriorityNotIn(vs ...int) predicate.CoreGatewayHttpRoute {
+	return predicate.CoreGatewayHttpRoute(sql.FieldNotIn(FieldPriority, vs...))
+}
+
+// PriorityGT applies the GT predicate on the "priority" field.
+func PriorityGT(v int) predicate.CoreGatewayHttpRoute {
+	return predicate.CoreGatewayHttpRoute(sql.FieldGT(FieldPriority, v))
+}
+
+// PriorityGTE applies the GTE predicate on the "priority" field.
+func PriorityGTE(v int) predicate.CoreGatewayHttpRoute {
+	return predicate.CoreGatewayHttpRoute(sql.FieldGTE(FieldPriority, v))
+}
+
+// PriorityLT applies the LT predicate on the "priority" field.
+func PriorityLT(v int) predicate.CoreGatewayHttpRoute {
+	return predicate.CoreGatewayHttpRoute(sql.FieldLT(FieldPriority, v))
+}
+
+// PriorityLTE applies the LTE predicate on the "priority" field.
+func PriorityLTE(v int) predicate.CoreGatewayHttpRoute {
+	return predicate.CoreGatewayHttpRoute(sql.FieldLTE(FieldPriority, v))
+}
+
+// PriorityIsNil applies the IsNil predicate on the "priority" field.
+func PriorityIsNil() predicate.CoreGatewayHttpRoute {
+	return predicate.CoreGatewayHttpRoute(sql.FieldIsNull(FieldPriority))
+}
+
+// PriorityNotNil applies the NotNil predicate on the "priority" field.
+func PriorityNotNil() predicate.CoreGatewayHttpRoute {
+	return predicate.CoreGatewayHttpRoute(sql.FieldNotNull(FieldPriority))
+}
+
+// IgnoreCaseEQ applies the EQ predicate on the "ignore_case" field.
+func IgnoreCaseEQ(v constant.YesOrNo) predicate.CoreGatewayHttpRoute {
+	vc := int8(v)
+	return predicate.CoreGatewayHttpRoute(sql.FieldEQ(FieldIgnoreCase, vc))
+}
+
+// IgnoreCaseNEQ applies the NEQ predicate on the "ignore_case" field.
+func IgnoreCaseNEQ(v constant.YesOrNo) predicate.CoreGatewayHttpRoute {
+	vc := int8(v)
+	return predicate.CoreGatewayHttpRoute(sql.FieldNEQ(FieldIgnoreCase, vc))
+}
+
+// IgnoreCaseIn applies the In predicate on the "ignore_case" field.
+func IgnoreCaseIn(vs ...constant.YesOrNo) predicate.CoreGatewayHttpRoute {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = int8(vs[i])
+	}
+	return predicate.CoreGatewayHttpRoute(sql.FieldIn(FieldIgnoreCase, v...))
+}
+
+// IgnoreCaseNotIn applies the NotIn predicate on the "ignore_case" field.
+func IgnoreCaseNotIn(vs ...constant.YesOrNo) predicate.CoreGatewayHttpRoute {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = int8(vs[i])
+	}
+	return predicate.CoreGatewayHttpRoute(sql.FieldNotIn(FieldIgnoreCase, v...))
+}
+
+// IgnoreCaseGT applies the GT predicate on the "ignore_case" field.
+func IgnoreCaseGT(v constant.YesOrNo) predicate.CoreGatewayHttpRoute {
+	vc := int8(v)
+	return predicate.CoreGatewayHttpRoute(sql.FieldGT(FieldIgnoreCase, vc))
+}
+
+// IgnoreCaseGTE applies the GTE predicate on the "ignore_case" field.
+func IgnoreCaseGTE(v constant.YesOrNo) predicate.CoreGatewayHttpRoute {
+	vc := int8(v)
+	return predicate.CoreGatewayHttpRoute(sql.FieldGTE(FieldIgnoreCase, vc))
+}
+
+// IgnoreCaseLT applies the LT predicate on the "ignore_case" field.
+func IgnoreCaseLT(v constant.YesOrNo) predicate.CoreGatewayHttpRoute {
+	vc := int8(v)
+	return predicate.CoreGatewayHttpRoute(sql.FieldLT(FieldIgnoreCase, vc))
+}
+
+// IgnoreCaseLTE applies the LTE predicate on the "ignore_case" field.
+func IgnoreCaseLTE(v constant.YesOrNo) predicate.CoreGatewayHttpRoute {
+	vc := int8(v)
+	return predicate.CoreGatewayHttpRoute(sql.FieldLTE(FieldIgnoreCase, vc))
+}
+
+// IgnoreCaseIsNil applies the IsNil predicate on the "ignore_case" field.
+func IgnoreCaseIsNil() predicate.CoreGatewayHttpRoute {
+	return predicate.CoreGatewayHttpRoute(sql.FieldIsNull(FieldIgnoreCase))
+}
+
+// IgnoreCaseNotNil applies the NotNil predicate on the "ignore_case" field.
+func IgnoreCaseNotNil() predicate.CoreGatewayHttpRoute {
+	return predicate.CoreGatewayHttpRoute(sql.FieldNotNull(FieldIgnoreCase))
+}
+
+// MethodsIsNil applies the IsNil predicate on the "methods" field.
+func MethodsIsNil() predicate.CoreGatewayHttpRoute {
+	return predicate.CoreGatewayHttpRoute(sql.FieldIsNull(FieldMethods))
+}
+
+// MethodsNotNil applies the NotNil predicate on the "methods" field.
+func MethodsNotNil() predicate.CoreGatewayHttpRoute {
+	return predicate.CoreGatewayHttpRoute(sql.FieldNotNull(FieldMethods))
+}
+
+// HeaderMatchersIsNil applies the IsNil predicate on the "header_matchers" field.
+func HeaderMatchersIsNil() predicate.CoreGatewayHttpRoute {
+	return predicate.CoreGatewayHttpRoute(sql.FieldIsNull(FieldHeaderMatchers))
+}
+
+// HeaderMatchersNotNil applies the NotNil predicate on the "header_matchers" field.
+func HeaderMatchersNotNil() predicate.CoreGatewayHttpRoute {
+	return predicate.CoreGatewayHttpRoute(sql.FieldNotNull(FieldHeaderMatchers))
+}
+
+// QueryMatchersIsNil applies the IsNil predicate on the "query_matchers" field.
+func QueryMatchersIsNil() predicate.CoreGatewayHttpRoute {
+	return predicate.CoreGatewayHttpRoute(sql.FieldIsNull(FieldQueryMatchers))
+}
+
+// QueryMatchersNotNil applies the NotNil predicate on the "query_matchers" field.
+func QueryMatchersNotNil() predicate.CoreGatewayHttpRoute {
+	return predicate.CoreGatewayHttpRoute(sql.FieldNotNull(FieldQueryMatchers))
+}
+
 // TimeoutMsEQ applies the EQ predicate on the "timeout_ms" field.
 func TimeoutMsEQ(v int) predicate.CoreGatewayHttpRoute {
 	return predicate.CoreGatewayHttpRoute(sql.FieldEQ(FieldTimeoutMs, v))
@@ -608,6 +1020,275 @@ func TimeoutMsNotNil() predicate.CoreGatewayHttpRoute {
 	return predicate.CoreGatewayHttpRoute(sql.FieldNotNull(FieldTimeoutMs))
 }
 
+// RetryPolicyIsNil applies the IsNil predicate on the "retry_policy" field.
+func RetryPolicyIsNil() predicate.CoreGatewayHttpRoute {
+	return predicate.CoreGatewayHttpRoute(sql.FieldIsNull(FieldRetryPolicy))
+}
+
+// RetryPolicyNotNil applies the NotNil predicate on the "retry_policy" field.
+func RetryPolicyNotNil() predicate.CoreGatewayHttpRoute {
+	return predicate.CoreGatewayHttpRoute(sql.FieldNotNull(FieldRetryPolicy))
+}
+
+// HeaderMutationIsNil applies the IsNil predicate on the "header_mutation" field.
+func HeaderMutationIsNil() predicate.CoreGatewayHttpRoute {
+	return predicate.CoreGatewayHttpRoute(sql.FieldIsNull(FieldHeaderMutation))
+}
+
+// HeaderMutationNotNil applies the NotNil predicate on the "header_mutation" field.
+func HeaderMutationNotNil() predicate.CoreGatewayHttpRoute {
+	return predicate.CoreGatewayHttpRoute(sql.FieldNotNull(FieldHeaderMutation))
+}
+
+// MirrorUpstreamIDEQ applies the EQ predicate on the "mirror_upstream_id" field.
+func MirrorUpstreamIDEQ(v string) predicate.CoreGatewayHttpRoute {
+	return predicate.CoreGatewayHttpRoute(sql.FieldEQ(FieldMirrorUpstreamID, v))
+}
+
+// MirrorUpstreamIDNEQ applies the NEQ predicate on the "mirror_upstream_id" field.
+func MirrorUpstreamIDNEQ(v string) predicate.CoreGatewayHttpRoute {
+	return predicate.CoreGatewayHttpRoute(sql.FieldNEQ(FieldMirrorUpstreamID, v))
+}
+
+// MirrorUpstreamIDIn applies the In predicate on the "mirror_upstream_id" field.
+func MirrorUpstreamIDIn(vs ...string) predicate.CoreGatewayHttpRoute {
+	return predicate.CoreGatewayHttpRoute(sql.FieldIn(FieldMirrorUpstreamID, vs...))
+}
+
+// MirrorUpstreamIDNotIn applies the NotIn predicate on the "mirror_upstream_id" field.
+func MirrorUpstreamIDNotIn(vs ...string) predicate.CoreGatewayHttpRoute {
+	return predicate.CoreGatewayHttpRoute(sql.FieldNotIn(FieldMirrorUpstreamID, vs...))
+}
+
+// MirrorUpstreamIDGT applies the GT predicate on the "mirror_upstream_id" field.
+func MirrorUpstreamIDGT(v string) predicate.CoreGatewayHttpRoute {
+	return predicate.CoreGatewayHttpRoute(sql.FieldGT(FieldMirrorUpstreamID, v))
+}
+
+// MirrorUpstreamIDGTE applies the GTE predicate on the "mirror_upstream_id" field.
+func MirrorUpstreamIDGTE(v string) predicate.CoreGatewayHttpRoute {
+	return predicate.CoreGatewayHttpRoute(sql.FieldGTE(FieldMirrorUpstreamID, v))
+}
+
+// MirrorUpstreamIDLT applies the LT predicate on the "mirror_upstream_id" field.
+func MirrorUpstreamIDLT(v string) predicate.CoreGatewayHttpRoute {
+	return predicate.CoreGatewayHttpRoute(sql.FieldLT(FieldMirrorUpstreamID, v))
+}
+
+// MirrorUpstreamIDLTE applies the LTE predicate on the "mirror_upstream_id" field.
+func MirrorUpstreamIDLTE(v string) predicate.CoreGatewayHttpRoute {
+	return predicate.CoreGatewayHttpRoute(sql.FieldLTE(FieldMirrorUpstreamID, v))
+}
+
+// MirrorUpstreamIDContains applies the Contains predicate on the "mirror_upstream_id" field.
+func MirrorUpstreamIDContains(v string) predicate.CoreGatewayHttpRoute {
+	return predicate.CoreGatewayHttpRoute(sql.FieldContains(FieldMirrorUpstreamID, v))
+}
+
+// MirrorUpstreamIDHasPrefix applies the HasPrefix predicate on the "mirror_upstream_id" field.
+func MirrorUpstreamIDHasPrefix(v string) predicate.CoreGatewayHttpRoute {
+	return predicate.CoreGatewayHttpRoute(sql.FieldHasPrefix(FieldMirrorUpstreamID, v))
+}
+
+// MirrorUpstreamIDHasSuffix applies the HasSuffix predicate on the "mirror_upstream_id" field.
+func MirrorUpstreamIDHasSuffix(v string) predicate.CoreGatewayHttpRoute {
+	return predicate.CoreGatewayHttpRoute(sql.FieldHasSuffix(FieldMirrorUpstreamID, v))
+}
+
+// MirrorUpstreamIDIsNil applies the IsNil predicate on the "mirror_upstream_id" field.
+func MirrorUpstreamIDIsNil() predicate.CoreGatewayHttpRoute {
+	return predicate.CoreGatewayHttpRoute(sql.FieldIsNull(FieldMirrorUpstreamID))
+}
+
+// MirrorUpstreamIDNotNil applies the NotNil predicate on the "mirror_upstream_id" field.
+func MirrorUpstreamIDNotNil() predicate.CoreGatewayHttpRoute {
+	return predicate.CoreGatewayHttpRoute(sql.FieldNotNull(FieldMirrorUpstreamID))
+}
+
+// MirrorUpstreamIDEqualFold applies the EqualFold predicate on the "mirror_upstream_id" field.
+func MirrorUpstreamIDEqualFold(v string) predicate.CoreGatewayHttpRoute {
+	return predicate.CoreGatewayHttpRoute(sql.FieldEqualFold(FieldMirrorUpstreamID, v))
+}
+
+// MirrorUpstreamIDContainsFold applies the ContainsFold predicate on the "mirror_upstream_id" field.
+func MirrorUpstreamIDContainsFold(v string) predicate.CoreGatewayHttpRoute {
+	return predicate.CoreGatewayHttpRoute(sql.FieldContainsFold(FieldMirrorUpstreamID, v))
+}
+
+// MirrorPercentEQ applies the EQ predicate on the "mirror_percent" field.
+func MirrorPercentEQ(v float64) predicate.CoreGatewayHttpRoute {
+	return predicate.CoreGatewayHttpRoute(sql.FieldEQ(FieldMirrorPercent, v))
+}
+
+// MirrorPercentNEQ applies the NEQ predicate on the "mirror_percent" field.
+func MirrorPercentNEQ(v float64) predicate.CoreGatewayHttpRoute {
+	return predicate.CoreGatewayHttpRoute(sql.FieldNEQ(FieldMirrorPercent, v))
+}
+
+// MirrorPercentIn applies the In predicate on the "mirror_percent" field.
+func MirrorPercentIn(vs ...float64) predicate.CoreGatewayHttpRoute {
+	return predicate.CoreGatewayHttpRoute(sql.FieldIn(FieldMirrorPercent, vs...))
+}
+
+// MirrorPercentNotIn applies the NotIn predicate on the "mirror_percent" field.
+func MirrorPercentNotIn(vs ...float64) predicate.CoreGatewayHttpRoute {
+	return predicate.CoreGatewayHttpRoute(sql.FieldNotIn(FieldMirrorPercent, vs...))
+}
+
+// MirrorPercentGT applies the GT predicate on the "mirror_percent" field.
+func MirrorPercentGT(v float64) predicate.CoreGatewayHttpRoute {
+	return predicate.CoreGatewayHttpRoute(sql.FieldGT(FieldMirrorPercent, v))
+}
+
+// MirrorPercentGTE applies the GTE predicate on the "mirror_percent" field.
+func MirrorPercentGTE(v float64) predicate.CoreGatewayHttpRoute {
+	return predicate.CoreGatewayHttpRoute(sql.FieldGTE(FieldMirrorPercent, v))
+}
+
+// MirrorPercentLT applies the LT predicate on the "mirror_percent" field.
+func MirrorPercentLT(v float64) predicate.CoreGatewayHttpRoute {
+	return predicate.CoreGatewayHttpRoute(sql.FieldLT(FieldMirrorPercent, v))
+}
+
+// MirrorPercentLTE applies the LTE predicate on the "mirror_percent" field.
+func MirrorPercentLTE(v float64) predicate.CoreGatewayHttpRoute {
+	return predicate.CoreGatewayHttpRoute(sql.FieldLTE(FieldMirrorPercent, v))
+}
+
+// MirrorPercentIsNil applies the IsNil predicate on the "mirror_percent" field.
+func MirrorPercentIsNil() predicate.CoreGatewayHttpRoute {
+	return predicate.CoreGatewayHttpRoute(sql.FieldIsNull(FieldMirrorPercent))
+}
+
+// MirrorPercentNotNil applies the NotNil predicate on the "mirror_percent" field.
+func MirrorPercentNotNil() predicate.CoreGatewayHttpRoute {
+	return predicate.CoreGatewayHttpRoute(sql.FieldNotNull(FieldMirrorPercent))
+}
+
+// FaultPolicyIsNil applies the IsNil predicate on the "fault_policy" field.
+func FaultPolicyIsNil() predicate.CoreGatewayHttpRoute {
+	return predicate.CoreGatewayHttpRoute(sql.FieldIsNull(FieldFaultPolicy))
+}
+
+// FaultPolicyNotNil applies the NotNil predicate on the "fault_policy" field.
+func FaultPolicyNotNil() predicate.CoreGatewayHttpRoute {
+	return predicate.CoreGatewayHttpRoute(sql.FieldNotNull(FieldFaultPolicy))
+}
+
+// CorsPolicyIsNil applies the IsNil predicate on the "cors_policy" field.
+func CorsPolicyIsNil() predicate.CoreGatewayHttpRoute {
+	return predicate.CoreGatewayHttpRoute(sql.FieldIsNull(FieldCorsPolicy))
+}
+
+// CorsPolicyNotNil applies the NotNil predicate on the "cors_policy" field.
+func CorsPolicyNotNil() predicate.CoreGatewayHttpRoute {
+	return predicate.CoreGatewayHttpRoute(sql.FieldNotNull(FieldCorsPolicy))
+}
+
+// LocalRateLimitIsNil applies the IsNil predicate on the "local_rate_limit" field.
+func LocalRateLimitIsNil() predicate.CoreGatewayHttpRoute {
+	return predicate.CoreGatewayHttpRoute(sql.FieldIsNull(FieldLocalRateLimit))
+}
+
+// LocalRateLimitNotNil applies the NotNil predicate on the "local_rate_limit" field.
+func LocalRateLimitNotNil() predicate.CoreGatewayHttpRoute {
+	return predicate.CoreGatewayHttpRoute(sql.FieldNotNull(FieldLocalRateLimit))
+}
+
+// RateLimitActionsIsNil applies the IsNil predicate on the "rate_limit_actions" field.
+func RateLimitActionsIsNil() predicate.CoreGatewayHttpRoute {
+	return predicate.CoreGatewayHttpRoute(sql.FieldIsNull(FieldRateLimitActions))
+}
+
+// RateLimitActionsNotNil applies the NotNil predicate on the "rate_limit_actions" field.
+func RateLimitActionsNotNil() predicate.CoreGatewayHttpRoute {
+	return predicate.CoreGatewayHttpRoute(sql.FieldNotNull(FieldRateLimitActions))
+}
+
+// DirectResponseIsNil applies the IsNil predicate on the "direct_response" field.
+func DirectResponseIsNil() predicate.CoreGatewayHttpRoute {
+	return predicate.CoreGatewayHttpRoute(sql.FieldIsNull(FieldDirectResponse))
+}
+
+// DirectResponseNotNil applies the NotNil predicate on the "direct_response" field.
+func DirectResponseNotNil() predicate.CoreGatewayHttpRoute {
+	return predicate.CoreGatewayHttpRoute(sql.FieldNotNull(FieldDirectResponse))
+}
+
+// HashPoliciesIsNil applies the IsNil predicate on the "hash_policies" field.
+func HashPoliciesIsNil() predicate.CoreGatewayHttpRoute {
+	return predicate.CoreGatewayHttpRoute(sql.FieldIsNull(FieldHashPolicies))
+}
+
+// HashPoliciesNotNil applies the NotNil predicate on the "hash_policies" field.
+func HashPoliciesNotNil() predicate.CoreGatewayHttpRoute {
+	return predicate.CoreGatewayHttpRoute(sql.FieldNotNull(FieldHashPolicies))
+}
+
+// DisableCompressionEQ applies the EQ predicate on the "disable_compression" field.
+func DisableCompressionEQ(v constant.YesOrNo) predicate.CoreGatewayHttpRoute {
+	vc := int8(v)
+	return predicate.CoreGatewayHttpRoute(sql.FieldEQ(FieldDisableCompression, vc))
+}
+
+// DisableCompressionNEQ applies the NEQ predicate on the "disable_compression" field.
+func DisableCompressionNEQ(v constant.YesOrNo) predicate.CoreGatewayHttpRoute {
+	vc := int8(v)
+	return predicate.CoreGatewayHttpRoute(sql.FieldNEQ(FieldDisableCompression, vc))
+}
+
+// DisableCompressionIn applies the In predicate on the "disable_compression" field.
+func DisableCompressionIn(vs ...constant.YesOrNo) predicate.CoreGatewayHttpRoute {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = int8(vs[i])
+	}
+	return predicate.CoreGatewayHttpRoute(sql.FieldIn(FieldDisableCompression, v...))
+}
+
+// DisableCompressionNotIn applies the NotIn predicate on the "disable_compression" field.
+func DisableCompressionNotIn(vs ...constant.YesOrNo) predicate.CoreGatewayHttpRoute {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = int8(vs[i])
+	}
+	return predicate.CoreGatewayHttpRoute(sql.FieldNotIn(FieldDisableCompression, v...))
+}
+
+// DisableCompressionGT applies the GT predicate on the "disable_compression" field.
+func DisableCompressionGT(v constant.YesOrNo) predicate.CoreGatewayHttpRoute {
+	vc := int8(v)
+	return predicate.CoreGatewayHttpRoute(sql.FieldGT(FieldDisableCompression, vc))
+}
+
+// DisableCompressionGTE applies the GTE predicate on the "disable_compression" field.
+func DisableCompressionGTE(v constant.YesOrNo) predicate.CoreGatewayHttpRoute {
+	vc := int8(v)
+	return predicate.CoreGatewayHttpRoute(sql.FieldGTE(FieldDisableCompression, vc))
+}
+
+// DisableCompressionLT applies the LT predicate on the "disable_compression" field.
+func DisableCompressionLT(v constant.YesOrNo) predicate.CoreGatewayHttpRoute {
+	vc := int8(v)
+	return predicate.CoreGatewayHttpRoute(sql.FieldLT(FieldDisableCompression, vc))
+}
+
+// DisableCompressionLTE applies the LTE predicate on the "disable_compression" field.
+func DisableCompressionLTE(v constant.YesOrNo) predicate.CoreGatewayHttpRoute {
+	vc := int8(v)
+	return predicate.CoreGatewayHttpRoute(sql.FieldLTE(FieldDisableCompression, vc))
+}
+
+// DisableCompressionIsNil applies the IsNil predicate on the "disable_compression" field.
+func DisableCompressionIsNil() predicate.CoreGatewayHttpRoute {
+	return predicate.CoreGatewayHttpRoute(sql.FieldIsNull(FieldDisableCompression))
+}
+
+// DisableCompressionNotNil applies the NotNil predicate on the "disable_compression" field.
+func DisableCompressionNotNil() predicate.CoreGatewayHttpRoute {
+	return predicate.CoreGatewayHttpRoute(sql.FieldNotNull(FieldDisableCompression))
+}
+
 // EnablePathRewriteEQ applies the EQ predicate on the "enable_path_rewrite" field.
 func EnablePathRewriteEQ(v constant.YesOrNo) predicate.CoreGatewayHttpRoute {
 	vc := int8(v)
@@ -1000,6 +1681,75 @@ func StatusNotNil() predicate.CoreGatewayHttpRoute {
 	return predicate.CoreGatewayHttpRoute(sql.FieldNotNull(FieldStatus))
 }
 
+// HasRouteFromUpstream applies the HasEdge predicate on the "route_from_upstream" edge.
+func HasRouteFromUpstream() predicate.CoreGatewayHttpRoute {
+	return predicate.CoreGatewayHttpRoute(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, RouteFromUpstreamTable, RouteFromUpstreamColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRouteFromUpstreamWith applies the HasEdge predicate on the "route_from_upstream" edge with a given conditions (other predicates).
+func HasRouteFromUpstreamWith(preds ...predicate.CoreUpstream) predicate.CoreGatewayHttpRoute {
+	return predicate.CoreGatewayHttpRoute(func(s *sql.Selector) {
+		step := newRouteFromUpstreamStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasRouteToTarget applies the HasEdge predicate on the "route_to_target" edge.
+func HasRouteToTarget() predicate.CoreGatewayHttpRoute {
+	return predicate.CoreGatewayHttpRoute(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, RouteToTargetTable, RouteToTargetColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRouteToTargetWith applies the HasEdge predicate on the "route_to_target" edge with a given conditions (other predicates).
+func HasRouteToTargetWith(preds ...predicate.CoreGatewayHttpRouteTarget) predicate.CoreGatewayHttpRoute {
+	return predicate.CoreGatewayHttpRoute(func(s *sql.Selector) {
+		step := newRouteToTargetStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasRouteFromVhost applies the HasEdge predicate on the "route_from_vhost" edge.
+func HasRouteFromVhost() predicate.CoreGatewayHttpRoute {
+	return predicate.CoreGatewayHttpRoute(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, RouteFromVhostTable, RouteFromVhostColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRouteFromVhostWith applies the HasEdge predicate on the "route_from_vhost" edge with a given conditions (other predicates).
+func HasRouteFromVhostWith(preds ...predicate.CoreGatewayVirtualHost) predicate.CoreGatewayHttpRoute {
+	return predicate.CoreGatewayHttpRoute(func(s *sql.Selector) {
+		step := newRouteFromVhostStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.CoreGatewayHttpRoute) predicate.CoreGatewayHttpRoute {
 	return predicate.CoreGatewayHttpRoute(sql.AndPredicates(predicates...))
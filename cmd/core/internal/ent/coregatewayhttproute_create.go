@@ -12,7 +12,11 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/lyonmu/quebec/cmd/core/internal/common"
 	"github.com/lyonmu/quebec/cmd/core/internal/ent/coregatewayhttproute"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/coregatewayhttproutetarget"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/coregatewayvirtualhost"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/coreupstream"
 	"github.com/lyonmu/quebec/pkg/constant"
 )
 
@@ -94,6 +98,48 @@ func (_c *CoreGatewayHttpRouteCreate) SetNillableDescription(v *string) *CoreGat
 	return _c
 }
 
+// SetUpstreamID sets the "upstream_id" field.
+func (_c *CoreGatewayHttpRouteCreate) SetUpstreamID(v string) *CoreGatewayHttpRouteCreate {
+	_c.mutation.SetUpstreamID(v)
+	return _c
+}
+
+// SetNillableUpstreamID sets the "upstream_id" field if the given value is not nil.
+func (_c *CoreGatewayHttpRouteCreate) SetNillableUpstreamID(v *string) *CoreGatewayHttpRouteCreate {
+	if v != nil {
+		_c.SetUpstreamID(*v)
+	}
+	return _c
+}
+
+// SetClusterID sets the "cluster_id" field.
+func (_c *CoreGatewayHttpRouteCreate) SetClusterID(v string) *CoreGatewayHttpRouteCreate {
+	_c.mutation.SetClusterID(v)
+	return _c
+}
+
+// SetNillableClusterID sets the "cluster_id" field if the given value is not nil.
+func (_c *CoreGatewayHttpRouteCreate) SetNillableClusterID(v *string) *CoreGatewayHttpRouteCreate {
+	if v != nil {
+		_c.SetClusterID(*v)
+	}
+	return _c
+}
+
+// SetVirtualHostID sets the "virtual_host_id" field.
+func (_c *CoreGatewayHttpRouteCreate) SetVirtualHostID(v string) *CoreGatewayHttpRouteCreate {
+	_c.mutation.SetVirtualHostID(v)
+	return _c
+}
+
+// SetNillableVirtualHostID sets the "virtual_host_id" field if the given value is not nil.
+func (_c *CoreGatewayHttpRouteCreate) SetNillableVirtualHostID(v *string) *CoreGatewayHttpRouteCreate {
+	if v != nil {
+		_c.SetVirtualHostID(*v)
+	}
+	return _c
+}
+
 // SetMatchType sets the "match_type" field.
 func (_c *CoreGatewayHttpRouteCreate) SetMatchType(v constant.ProxyHttpRouteMatchType) *CoreGatewayHttpRouteCreate {
 	_c.mutation.SetMatchType(v)
@@ -122,6 +168,52 @@ func (_c *CoreGatewayHttpRouteCreate) SetNillableMatchPattern(v *string) *CoreGa
 	return _c
 }
 
+// SetPriority sets the "priority" field.
+func (_c *CoreGatewayHttpRouteCreate) SetPriority(v int) *CoreGatewayHttpRouteCreate {
+	_c.mutation.SetPriority(v)
+	return _c
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (_c *CoreGatewayHttpRouteCreate) SetNillablePriority(v *int) *CoreGatewayHttpRouteCreate {
+	if v != nil {
+		_c.SetPriority(*v)
+	}
+	return _c
+}
+
+// SetIgnoreCase sets the "ignore_case" field.
+func (_c *CoreGatewayHttpRouteCreate) SetIgnoreCase(v constant.YesOrNo) *CoreGatewayHttpRouteCreate {
+	_c.mutation.SetIgnoreCase(v)
+	return _c
+}
+
+// SetNillableIgnoreCase sets the "ignore_case" field if the given value is not nil.
+func (_c *CoreGatewayHttpRouteCreate) SetNillableIgnoreCase(v *constant.YesOrNo) *CoreGatewayHttpRouteCreate {
+	if v != nil {
+		_c.SetIgnoreCase(*v)
+	}
+	return _c
+}
+
+// SetMethods sets the "methods" field.
+func (_c *CoreGatewayHttpRouteCreate) SetMethods(v []string) *CoreGatewayHttpRouteCreate {
+	_c.mutation.SetMethods(v)
+	return _c
+}
+
+// SetHeaderMatchers sets the "header_matchers" field.
+func (_c *CoreGatewayHttpRouteCreate) SetHeaderMatchers(v []common.HttpMatcher) *CoreGatewayHttpRouteCreate {
+	_c.mutation.SetHeaderMatchers(v)
+	return _c
+}
+
+// SetQueryMatchers sets the "query_matchers" field.
+func (_c *CoreGatewayHttpRouteCreate) SetQueryMatchers(v []common.HttpMatcher) *CoreGatewayHttpRouteCreate {
+	_c.mutation.SetQueryMatchers(v)
+	return _c
+}
+
 // SetTimeoutMs sets the "timeout_ms" field.
 func (_c *CoreGatewayHttpRouteCreate) SetTimeoutMs(v int) *CoreGatewayHttpRouteCreate {
 	_c.mutation.SetTimeoutMs(v)
@@ -136,6 +228,96 @@ func (_c *CoreGatewayHttpRouteCreate) SetNillableTimeoutMs(v *int) *CoreGatewayH
 	return _c
 }
 
+// SetRetryPolicy sets the "retry_policy" field.
+func (_c *CoreGatewayHttpRouteCreate) SetRetryPolicy(v *common.RetryPolicy) *CoreGatewayHttpRouteCreate {
+	_c.mutation.SetRetryPolicy(v)
+	return _c
+}
+
+// SetHeaderMutation sets the "header_mutation" field.
+func (_c *CoreGatewayHttpRouteCreate) SetHeaderMutation(v *common.HeaderMutation) *CoreGatewayHttpRouteCreate {
+	_c.mutation.SetHeaderMutation(v)
+	return _c
+}
+
+// SetMirrorUpstreamID sets the "mirror_upstream_id" field.
+func (_c *CoreGatewayHttpRouteCreate) SetMirrorUpstreamID(v string) *CoreGatewayHttpRouteCreate {
+	_c.mutation.SetMirrorUpstreamID(v)
+	return _c
+}
+
+// SetNillableMirrorUpstreamID sets the "mirror_upstream_id" field if the given value is not nil.
+func (_c *CoreGatewayHttpRouteCreate) SetNillableMirrorUpstreamID(v *string) *CoreGatewayHttpRouteCreate {
+	if v != nil {
+		_c.SetMirrorUpstreamID(*v)
+	}
+	return _c
+}
+
+// SetMirrorPercent sets the "mirror_percent" field.
+func (_c *CoreGatewayHttpRouteCreate) SetMirrorPercent(v float64) *CoreGatewayHttpRouteCreate {
+	_c.mutation.SetMirrorPercent(v)
+	return _c
+}
+
+// SetNillableMirrorPercent sets the "mirror_percent" field if the given value is not nil.
+func (_c *CoreGatewayHttpRouteCreate) SetNillableMirrorPercent(v *float64) *CoreGatewayHttpRouteCreate {
+	if v != nil {
+		_c.SetMirrorPercent(*v)
+	}
+	return _c
+}
+
+// SetFaultPolicy sets the "fault_policy" field.
+func (_c *CoreGatewayHttpRouteCreate) SetFaultPolicy(v *common.FaultPolicy) *CoreGatewayHttpRouteCreate {
+	_c.mutation.SetFaultPolicy(v)
+	return _c
+}
+
+// SetCorsPolicy sets the "cors_policy" field.
+func (_c *CoreGatewayHttpRouteCreate) SetCorsPolicy(v *common.CorsPolicy) *CoreGatewayHttpRouteCreate {
+	_c.mutation.SetCorsPolicy(v)
+	return _c
+}
+
+// SetLocalRateLimit sets the "local_rate_limit" field.
+func (_c *CoreGatewayHttpRouteCreate) SetLocalRateLimit(v *common.LocalRateLimit) *CoreGatewayHttpRouteCreate {
+	_c.mutation.SetLocalRateLimit(v)
+	return _c
+}
+
+// SetRateLimitActions sets the "rate_limit_actions" field.
+func (_c *CoreGatewayHttpRouteCreate) SetRateLimitActions(v []common.RateLimitAction) *CoreGatewayHttpRouteCreate {
+	_c.mutation.SetRateLimitActions(v)
+	return _c
+}
+
+// SetDirectResponse sets the "direct_response" field.
+func (_c *CoreGatewayHttpRouteCreate) SetDirectResponse(v *common.DirectResponse) *CoreGatewayHttpRouteCreate {
+	_c.mutation.SetDirectResponse(v)
+	return _c
+}
+
+// SetHashPolicies sets the "hash_policies" field.
+func (_c *CoreGatewayHttpRouteCreate) SetHashPolicies(v []common.HashPolicy) *CoreGatewayHttpRouteCreate {
+	_c.mutation.SetHashPolicies(v)
+	return _c
+}
+
+// SetDisableCompression sets the "disable_compression" field.
+func (_c *CoreGatewayHttpRouteCreate) SetDisableCompression(v constant.YesOrNo) *CoreGatewayHttpRouteCreate {
+	_c.mutation.SetDisableCompression(v)
+	return _c
+}
+
+// SetNillableDisableCompression sets the "disable_compression" field if the given value is not nil.
+func (_c *CoreGatewayHttpRouteCreate) SetNillableDisableCompression(v *constant.YesOrNo) *CoreGatewayHttpRouteCreate {
+	if v != nil {
+		_c.SetDisableCompression(*v)
+	}
+	return _c
+}
+
 // SetEnablePathRewrite sets the "enable_path_rewrite" field.
 func (_c *CoreGatewayHttpRouteCreate) SetEnablePathRewrite(v constant.YesOrNo) *CoreGatewayHttpRouteCreate {
 	_c.mutation.SetEnablePathRewrite(v)
@@ -234,6 +416,59 @@ func (_c *CoreGatewayHttpRouteCreate) SetNillableID(v *string) *CoreGatewayHttpR
 	return _c
 }
 
+// SetRouteFromUpstreamID sets the "route_from_upstream" edge to the CoreUpstream entity by ID.
+func (_c *CoreGatewayHttpRouteCreate) SetRouteFromUpstreamID(id string) *CoreGatewayHttpRouteCreate {
+	_c.mutation.SetRouteFromUpstreamID(id)
+	return _c
+}
+
+// SetNillableRouteFromUpstreamID sets the "route_from_upstream" edge to the CoreUpstream entity by ID if the given value is not nil.
+func (_c *CoreGatewayHttpRouteCreate) SetNillableRouteFromUpstreamID(id *string) *CoreGatewayHttpRouteCreate {
+	if id != nil {
+		_c = _c.SetRouteFromUpstreamID(*id)
+	}
+	return _c
+}
+
+// SetRouteFromUpstream sets the "route_from_upstream" edge to the CoreUpstream entity.
+func (_c *CoreGatewayHttpRouteCreate) SetRouteFromUpstream(v *CoreUpstream) *CoreGatewayHttpRouteCreate {
+	return _c.SetRouteFromUpstreamID(v.ID)
+}
+
+// AddRouteToTargetIDs adds the "route_to_target" edge to the CoreGatewayHttpRouteTarget entity by IDs.
+func (_c *CoreGatewayHttpRouteCreate) AddRouteToTargetIDs(ids ...string) *CoreGatewayHttpRouteCreate {
+	_c.mutation.AddRouteToTargetIDs(ids...)
+	return _c
+}
+
+// AddRouteToTarget adds the "route_to_target" edges to the CoreGatewayHttpRouteTarget entity.
+func (_c *CoreGatewayHttpRouteCreate) AddRouteToTarget(v ...*CoreGatewayHttpRouteTarget) *CoreGatewayHttpRouteCreate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddRouteToTargetIDs(ids...)
+}
+
+// SetRouteFromVhostID sets the "route_from_vhost" edge to the CoreGatewayVirtualHost entity by ID.
+func (_c *CoreGatewayHttpRouteCreate) SetRouteFromVhostID(id string) *CoreGatewayHttpRouteCreate {
+	_c.mutation.SetRouteFromVhostID(id)
+	return _c
+}
+
+// SetNillableRouteFromVhostID sets the "route_from_vhost" edge to the CoreGatewayVirtualHost entity by ID if the given value is not nil.
+func (_c *CoreGatewayHttpRouteCreate) SetNillableRouteFromVhostID(id *string) *CoreGatewayHttpRouteCreate {
+	if id != nil {
+		_c = _c.SetRouteFromVhostID(*id)
+	}
+	return _c
+}
+
+// SetRouteFromVhost sets the "route_from_vhost" edge to the CoreGatewayVirtualHost entity.
+func (_c *CoreGatewayHttpRouteCreate) SetRouteFromVhost(v *CoreGatewayVirtualHost) *CoreGatewayHttpRouteCreate {
+	return _c.SetRouteFromVhostID(v.ID)
+}
+
 // Mutation returns the CoreGatewayHttpRouteMutation object of the builder.
 func (_c *CoreGatewayHttpRouteCreate) Mutation() *CoreGatewayHttpRouteMutation {
 	return _c.mutation
@@ -289,10 +524,22 @@ func (_c *CoreGatewayHttpRouteCreate) defaults() error {
 		v := coregatewayhttproute.DefaultMatchType
 		_c.mutation.SetMatchType(v)
 	}
+	if _, ok := _c.mutation.Priority(); !ok {
+		v := coregatewayhttproute.DefaultPriority
+		_c.mutation.SetPriority(v)
+	}
+	if _, ok := _c.mutation.IgnoreCase(); !ok {
+		v := coregatewayhttproute.DefaultIgnoreCase
+		_c.mutation.SetIgnoreCase(v)
+	}
 	if _, ok := _c.mutation.TimeoutMs(); !ok {
 		v := coregatewayhttproute.DefaultTimeoutMs
 		_c.mutation.SetTimeoutMs(v)
 	}
+	if _, ok := _c.mutation.DisableCompression(); !ok {
+		v := coregatewayhttproute.DefaultDisableCompression
+		_c.mutation.SetDisableCompression(v)
+	}
 	if _, ok := _c.mutation.EnablePathRewrite(); !ok {
 		v := coregatewayhttproute.DefaultEnablePathRewrite
 		_c.mutation.SetEnablePathRewrite(v)
@@ -388,6 +635,10 @@ func (_c *CoreGatewayHttpRouteCreate) createSpec() (*CoreGatewayHttpRoute, *sqlg
 		_spec.SetField(coregatewayhttproute.FieldDescription, field.TypeString, value)
 		_node.Description = value
 	}
+	if value, ok := _c.mutation.ClusterID(); ok {
+		_spec.SetField(coregatewayhttproute.FieldClusterID, field.TypeString, value)
+		_node.ClusterID = value
+	}
 	if value, ok := _c.mutation.MatchType(); ok {
 		_spec.SetField(coregatewayhttproute.FieldMatchType, field.TypeInt8, value)
 		_node.MatchType = value
@@ -396,10 +647,74 @@ func (_c *CoreGatewayHttpRouteCreate) createSpec() (*CoreGatewayHttpRoute, *sqlg
 		_spec.SetField(coregatewayhttproute.FieldMatchPattern, field.TypeString, value)
 		_node.MatchPattern = value
 	}
+	if value, ok := _c.mutation.Priority(); ok {
+		_spec.SetField(coregatewayhttproute.FieldPriority, field.TypeInt, value)
+		_node.Priority = value
+	}
+	if value, ok := _c.mutation.IgnoreCase(); ok {
+		_spec.SetField(coregatewayhttproute.FieldIgnoreCase, field.TypeInt8, value)
+		_node.IgnoreCase = value
+	}
+	if value, ok := _c.mutation.Methods(); ok {
+		_spec.SetField(coregatewayhttproute.FieldMethods, field.TypeJSON, value)
+		_node.Methods = value
+	}
+	if value, ok := _c.mutation.HeaderMatchers(); ok {
+		_spec.SetField(coregatewayhttproute.FieldHeaderMatchers, field.TypeJSON, value)
+		_node.HeaderMatchers = value
+	}
+	if value, ok := _c.mutation.QueryMatchers(); ok {
+		_spec.SetField(coregatewayhttproute.FieldQueryMatchers, field.TypeJSON, value)
+		_node.QueryMatchers = value
+	}
 	if value, ok := _c.mutation.TimeoutMs(); ok {
 		_spec.SetField(coregatewayhttproute.FieldTimeoutMs, field.TypeInt, value)
 		_node.TimeoutMs = value
 	}
+	if value, ok := _c.mutation.RetryPolicy(); ok {
+		_spec.SetField(coregatewayhttproute.FieldRetryPolicy, field.TypeJSON, value)
+		_node.RetryPolicy = value
+	}
+	if value, ok := _c.mutation.HeaderMutation(); ok {
+		_spec.SetField(coregatewayhttproute.FieldHeaderMutation, field.TypeJSON, value)
+		_node.HeaderMutation = value
+	}
+	if value, ok := _c.mutation.MirrorUpstreamID(); ok {
+		_spec.SetField(coregatewayhttproute.FieldMirrorUpstreamID, field.TypeString, value)
+		_node.MirrorUpstreamID = value
+	}
+	if value, ok := _c.mutation.MirrorPercent(); ok {
+		_spec.SetField(coregatewayhttproute.FieldMirrorPercent, field.TypeFloat64, value)
+		_node.MirrorPercent = value
+	}
+	if value, ok := _c.mutation.FaultPolicy(); ok {
+		_spec.SetField(coregatewayhttproute.FieldFaultPolicy, field.TypeJSON, value)
+		_node.FaultPolicy = value
+	}
+	if value, ok := _c.mutation.CorsPolicy(); ok {
+		_spec.SetField(coregatewayhttproute.FieldCorsPolicy, field.TypeJSON, value)
+		_node.CorsPolicy = value
+	}
+	if value, ok := _c.mutation.LocalRateLimit(); ok {
+		_spec.SetField(coregatewayhttproute.FieldLocalRateLimit, field.TypeJSON, value)
+		_node.LocalRateLimit = value
+	}
+	if value, ok := _c.mutation.RateLimitActions(); ok {
+		_spec.SetField(coregatewayhttproute.FieldRateLimitActions, field.TypeJSON, value)
+		_node.RateLimitActions = value
+	}
+	if value, ok := _c.mutation.DirectResponse(); ok {
+		_spec.SetField(coregatewayhttproute.FieldDirectResponse, field.TypeJSON, value)
+		_node.DirectResponse = value
+	}
+	if value, ok := _c.mutation.HashPolicies(); ok {
+		_spec.SetField(coregatewayhttproute.FieldHashPolicies, field.TypeJSON, value)
+		_node.HashPolicies = value
+	}
+	if value, ok := _c.mutation.DisableCompression(); ok {
+		_spec.SetField(coregatewayhttproute.FieldDisableCompression, field.TypeInt8, value)
+		_node.DisableCompression = value
+	}
 	if value, ok := _c.mutation.EnablePathRewrite(); ok {
 		_spec.SetField(coregatewayhttproute.FieldEnablePathRewrite, field.TypeInt8, value)
 		_node.EnablePathRewrite = value
@@ -424,6 +739,56 @@ func (_c *CoreGatewayHttpRouteCreate) createSpec() (*CoreGatewayHttpRoute, *sqlg
 		_spec.SetField(coregatewayhttproute.FieldStatus, field.TypeInt8, value)
 		_node.Status = value
 	}
+	if nodes := _c.mutation.RouteFromUpstreamIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   coregatewayhttproute.RouteFromUpstreamTable,
+			Columns: []string{coregatewayhttproute.RouteFromUpstreamColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(coreupstream.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UpstreamID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.RouteToTargetIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   coregatewayhttproute.RouteToTargetTable,
+			Columns: []string{coregatewayhttproute.RouteToTargetColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(coregatewayhttproutetarget.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.RouteFromVhostIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   coregatewayhttproute.RouteFromVhostTable,
+			Columns: []string{coregatewayhttproute.RouteFromVhostColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(coregatewayvirtualhost.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.VirtualHostID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -542,6 +907,60 @@ func (u *CoreGatewayHttpRouteUpsert) ClearDescription() *CoreGatewayHttpRouteUps
 	return u
 }
 
+// SetUpstreamID sets the "upstream_id" field.
+func (u *CoreGatewayHttpRouteUpsert) SetUpstreamID(v string) *CoreGatewayHttpRouteUpsert {
+	u.Set(coregatewayhttproute.FieldUpstreamID, v)
+	return u
+}
+
+// UpdateUpstreamID sets the "upstream_id" field to the value that was provided on create.
+func (u *CoreGatewayHttpRouteUpsert) UpdateUpstreamID() *CoreGatewayHttpRouteUpsert {
+	u.SetExcluded(coregatewayhttproute.FieldUpstreamID)
+	return u
+}
+
+// ClearUpstreamID clears the value of the "upstream_id" field.
+func (u *CoreGatewayHttpRouteUpsert) ClearUpstreamID() *CoreGatewayHttpRouteUpsert {
+	u.SetNull(coregatewayhttproute.FieldUpstreamID)
+	return u
+}
+
+// SetClusterID sets the "cluster_id" field.
+func (u *CoreGatewayHttpRouteUpsert) SetClusterID(v string) *CoreGatewayHttpRouteUpsert {
+	u.Set(coregatewayhttproute.FieldClusterID, v)
+	return u
+}
+
+// UpdateClusterID sets the "cluster_id" field to the value that was provided on create.
+func (u *CoreGatewayHttpRouteUpsert) UpdateClusterID() *CoreGatewayHttpRouteUpsert {
+	u.SetExcluded(coregatewayhttproute.FieldClusterID)
+	return u
+}
+
+// ClearClusterID clears the value of the "cluster_id" field.
+func (u *CoreGatewayHttpRouteUpsert) ClearClusterID() *CoreGatewayHttpRouteUpsert {
+	u.SetNull(coregatewayhttproute.FieldClusterID)
+	return u
+}
+
+// SetVirtualHostID sets the "virtual_host_id" field.
+func (u *CoreGatewayHttpRouteUpsert) SetVirtualHostID(v string) *CoreGatewayHttpRouteUpsert {
+	u.Set(coregatewayhttproute.FieldVirtualHostID, v)
+	return u
+}
+
+// UpdateVirtualHostID sets the "virtual_host_id" field to the value that was provided on create.
+func (u *CoreGatewayHttpRouteUpsert) UpdateVirtualHostID() *CoreGatewayHttpRouteUpsert {
+	u.SetExcluded(coregatewayhttproute.FieldVirtualHostID)
+	return u
+}
+
+// ClearVirtualHostID clears the value of the "virtual_host_id" field.
+func (u *CoreGatewayHttpRouteUpsert) ClearVirtualHostID() *CoreGatewayHttpRouteUpsert {
+	u.SetNull(coregatewayhttproute.FieldVirtualHostID)
+	return u
+}
+
 // SetMatchType sets the "match_type" field.
 func (u *CoreGatewayHttpRouteUpsert) SetMatchType(v constant.ProxyHttpRouteMatchType) *CoreGatewayHttpRouteUpsert {
 	u.Set(coregatewayhttproute.FieldMatchType, v)
@@ -584,141 +1003,453 @@ func (u *CoreGatewayHttpRouteUpsert) ClearMatchPattern() *CoreGatewayHttpRouteUp
 	return u
 }
 
-// SetTimeoutMs sets the "timeout_ms" field.
-func (u *CoreGatewayHttpRouteUpsert) SetTimeoutMs(v int) *CoreGatewayHttpRouteUpsert {
-	u.Set(coregatewayhttproute.FieldTimeoutMs, v)
+// SetPriority sets the "priority" field.
+func (u *CoreGatewayHttpRouteUpsert) SetPriority(v int) *CoreGatewayHttpRouteUpsert {
+	u.Set(coregatewayhttproute.FieldPriority, v)
 	return u
 }
 
-// UpdateTimeoutMs sets the "timeout_ms" field to the value that was provided on create.
-func (u *CoreGatewayHttpRouteUpsert) UpdateTimeoutMs() *CoreGatewayHttpRouteUpsert {
-	u.SetExcluded(coregatewayhttproute.FieldTimeoutMs)
+// UpdatePriority sets the "priority" field to the value that was provided on create.
+func (u *CoreGatewayHttpRouteUpsert) UpdatePriority() *CoreGatewayHttpRouteUpsert {
+	u.SetExcluded(coregatewayhttproute.FieldPriority)
 	return u
 }
 
-// AddTimeoutMs adds v to the "timeout_ms" field.
-func (u *CoreGatewayHttpRouteUpsert) AddTimeoutMs(v int) *CoreGatewayHttpRouteUpsert {
-	u.Add(coregatewayhttproute.FieldTimeoutMs, v)
+// AddPriority adds v to the "priority" field.
+func (u *CoreGatewayHttpRouteUpsert) AddPriority(v int) *CoreGatewayHttpRouteUpsert {
+	u.Add(coregatewayhttproute.FieldPriority, v)
 	return u
 }
 
-// ClearTimeoutMs clears the value of the "timeout_ms" field.
-func (u *CoreGatewayHttpRouteUpsert) ClearTimeoutMs() *CoreGatewayHttpRouteUpsert {
-	u.SetNull(coregatewayhttproute.FieldTimeoutMs)
+// ClearPriority clears the value of the "priority" field.
+func (u *CoreGatewayHttpRouteUpsert) ClearPriority() *CoreGatewayHttpRouteUpsert {
+	u.SetNull(coregatewayhttproute.FieldPriority)
 	return u
 }
 
-// SetEnablePathRewrite sets the "enable_path_rewrite" field.
-func (u *CoreGatewayHttpRouteUpsert) SetEnablePathRewrite(v constant.YesOrNo) *CoreGatewayHttpRouteUpsert {
-	u.Set(coregatewayhttproute.FieldEnablePathRewrite, v)
+// SetIgnoreCase sets the "ignore_case" field.
+func (u *CoreGatewayHttpRouteUpsert) SetIgnoreCase(v constant.YesOrNo) *CoreGatewayHttpRouteUpsert {
+	u.Set(coregatewayhttproute.FieldIgnoreCase, v)
 	return u
 }
 
-// UpdateEnablePathRewrite sets the "enable_path_rewrite" field to the value that was provided on create.
-func (u *CoreGatewayHttpRouteUpsert) UpdateEnablePathRewrite() *CoreGatewayHttpRouteUpsert {
-	u.SetExcluded(coregatewayhttproute.FieldEnablePathRewrite)
+// UpdateIgnoreCase sets the "ignore_case" field to the value that was provided on create.
+func (u *CoreGatewayHttpRouteUpsert) UpdateIgnoreCase() *CoreGatewayHttpRouteUpsert {
+	u.SetExcluded(coregatewayhttproute.FieldIgnoreCase)
 	return u
 }
 
-// AddEnablePathRewrite adds v to the "enable_path_rewrite" field.
-func (u *CoreGatewayHttpRouteUpsert) AddEnablePathRewrite(v constant.YesOrNo) *CoreGatewayHttpRouteUpsert {
-	u.Add(coregatewayhttproute.FieldEnablePathRewrite, v)
+// AddIgnoreCase adds v to the "ignore_case" field.
+func (u *CoreGatewayHttpRouteUpsert) AddIgnoreCase(v constant.YesOrNo) *CoreGatewayHttpRouteUpsert {
+	u.Add(coregatewayhttproute.FieldIgnoreCase, v)
 	return u
 }
 
-// ClearEnablePathRewrite clears the value of the "enable_path_rewrite" field.
-func (u *CoreGatewayHttpRouteUpsert) ClearEnablePathRewrite() *CoreGatewayHttpRouteUpsert {
-	u.SetNull(coregatewayhttproute.FieldEnablePathRewrite)
+// ClearIgnoreCase clears the value of the "ignore_case" field.
+func (u *CoreGatewayHttpRouteUpsert) ClearIgnoreCase() *CoreGatewayHttpRouteUpsert {
+	u.SetNull(coregatewayhttproute.FieldIgnoreCase)
 	return u
 }
 
-// SetPathRewrite sets the "path_rewrite" field.
-func (u *CoreGatewayHttpRouteUpsert) SetPathRewrite(v string) *CoreGatewayHttpRouteUpsert {
-	u.Set(coregatewayhttproute.FieldPathRewrite, v)
+// SetMethods sets the "methods" field.
+func (u *CoreGatewayHttpRouteUpsert) SetMethods(v []string) *CoreGatewayHttpRouteUpsert {
+	u.Set(coregatewayhttproute.FieldMethods, v)
 	return u
 }
 
-// UpdatePathRewrite sets the "path_rewrite" field to the value that was provided on create.
-func (u *CoreGatewayHttpRouteUpsert) UpdatePathRewrite() *CoreGatewayHttpRouteUpsert {
-	u.SetExcluded(coregatewayhttproute.FieldPathRewrite)
+// UpdateMethods sets the "methods" field to the value that was provided on create.
+func (u *CoreGatewayHttpRouteUpsert) UpdateMethods() *CoreGatewayHttpRouteUpsert {
+	u.SetExcluded(coregatewayhttproute.FieldMethods)
 	return u
 }
 
-// ClearPathRewrite clears the value of the "path_rewrite" field.
-func (u *CoreGatewayHttpRouteUpsert) ClearPathRewrite() *CoreGatewayHttpRouteUpsert {
-	u.SetNull(coregatewayhttproute.FieldPathRewrite)
+// ClearMethods clears the value of the "methods" field.
+func (u *CoreGatewayHttpRouteUpsert) ClearMethods() *CoreGatewayHttpRouteUpsert {
+	u.SetNull(coregatewayhttproute.FieldMethods)
 	return u
 }
 
-// SetEnableRedirect sets the "enable_redirect" field.
-func (u *CoreGatewayHttpRouteUpsert) SetEnableRedirect(v constant.YesOrNo) *CoreGatewayHttpRouteUpsert {
-	u.Set(coregatewayhttproute.FieldEnableRedirect, v)
+// SetHeaderMatchers sets the "header_matchers" field.
+func (u *CoreGatewayHttpRouteUpsert) SetHeaderMatchers(v []common.HttpMatcher) *CoreGatewayHttpRouteUpsert {
+	u.Set(coregatewayhttproute.FieldHeaderMatchers, v)
 	return u
 }
 
-// UpdateEnableRedirect sets the "enable_redirect" field to the value that was provided on create.
-func (u *CoreGatewayHttpRouteUpsert) UpdateEnableRedirect() *CoreGatewayHttpRouteUpsert {
-	u.SetExcluded(coregatewayhttproute.FieldEnableRedirect)
+// UpdateHeaderMatchers sets the "header_matchers" field to the value that was provided on create.
+func (u *CoreGatewayHttpRouteUpsert) UpdateHeaderMatchers() *CoreGatewayHttpRouteUpsert {
+	u.SetExcluded(coregatewayhttproute.FieldHeaderMatchers)
 	return u
 }
 
-// AddEnableRedirect adds v to the "enable_redirect" field.
-func (u *CoreGatewayHttpRouteUpsert) AddEnableRedirect(v constant.YesOrNo) *CoreGatewayHttpRouteUpsert {
-	u.Add(coregatewayhttproute.FieldEnableRedirect, v)
+// ClearHeaderMatchers clears the value of the "header_matchers" field.
+func (u *CoreGatewayHttpRouteUpsert) ClearHeaderMatchers() *CoreGatewayHttpRouteUpsert {
+	u.SetNull(coregatewayhttproute.FieldHeaderMatchers)
 	return u
 }
 
-// ClearEnableRedirect clears the value of the "enable_redirect" field.
-func (u *CoreGatewayHttpRouteUpsert) ClearEnableRedirect() *CoreGatewayHttpRouteUpsert {
-	u.SetNull(coregatewayhttproute.FieldEnableRedirect)
+// SetQueryMatchers sets the "query_matchers" field.
+func (u *CoreGatewayHttpRouteUpsert) SetQueryMatchers(v []common.HttpMatcher) *CoreGatewayHttpRouteUpsert {
+	u.Set(coregatewayhttproute.FieldQueryMatchers, v)
 	return u
 }
 
-// SetRedirectURL sets the "redirect_url" field.
-func (u *CoreGatewayHttpRouteUpsert) SetRedirectURL(v string) *CoreGatewayHttpRouteUpsert {
-	u.Set(coregatewayhttproute.FieldRedirectURL, v)
+// UpdateQueryMatchers sets the "query_matchers" field to the value that was provided on create.
+func (u *CoreGatewayHttpRouteUpsert) UpdateQueryMatchers() *CoreGatewayHttpRouteUpsert {
+	u.SetExcluded(coregatewayhttproute.FieldQueryMatchers)
 	return u
 }
 
-// UpdateRedirectURL sets the "redirect_url" field to the value that was provided on create.
-func (u *CoreGatewayHttpRouteUpsert) UpdateRedirectURL() *CoreGatewayHttpRouteUpsert {
-	u.SetExcluded(coregatewayhttproute.FieldRedirectURL)
+// ClearQueryMatchers clears the value of the "query_matchers" field.
+func (u *CoreGatewayHttpRouteUpsert) ClearQueryMatchers() *CoreGatewayHttpRouteUpsert {
+	u.SetNull(coregatewayhttproute.FieldQueryMatchers)
 	return u
 }
 
-// ClearRedirectURL clears the value of the "redirect_url" field.
-func (u *CoreGatewayHttpRouteUpsert) ClearRedirectURL() *CoreGatewayHttpRouteUpsert {
-	u.SetNull(coregatewayhttproute.FieldRedirectURL)
+// SetTimeoutMs sets the "timeout_ms" field.
+func (u *CoreGatewayHttpRouteUpsert) SetTimeoutMs(v int) *CoreGatewayHttpRouteUpsert {
+	u.Set(coregatewayhttproute.FieldTimeoutMs, v)
 	return u
 }
 
-// SetRedirectCode sets the "redirect_code" field.
-func (u *CoreGatewayHttpRouteUpsert) SetRedirectCode(v int) *CoreGatewayHttpRouteUpsert {
-	u.Set(coregatewayhttproute.FieldRedirectCode, v)
+// UpdateTimeoutMs sets the "timeout_ms" field to the value that was provided on create.
+func (u *CoreGatewayHttpRouteUpsert) UpdateTimeoutMs() *CoreGatewayHttpRouteUpsert {
+	u.SetExcluded(coregatewayhttproute.FieldTimeoutMs)
 	return u
 }
 
-// UpdateRedirectCode sets the "redirect_code" field to the value that was provided on create.
-func (u *CoreGatewayHttpRouteUpsert) UpdateRedirectCode() *CoreGatewayHttpRouteUpsert {
-	u.SetExcluded(coregatewayhttproute.FieldRedirectCode)
+// AddTimeoutMs adds v to the "timeout_ms" field.
+func (u *CoreGatewayHttpRouteUpsert) AddTimeoutMs(v int) *CoreGatewayHttpRouteUpsert {
+	u.Add(coregatewayhttproute.FieldTimeoutMs, v)
 	return u
 }
 
-// AddRedirectCode adds v to the "redirect_code" field.
-func (u *CoreGatewayHttpRouteUpsert) AddRedirectCode(v int) *CoreGatewayHttpRouteUpsert {
-	u.Add(coregatewayhttproute.FieldRedirectCode, v)
+// ClearTimeoutMs clears the value of the "timeout_ms" field.
+func (u *CoreGatewayHttpRouteUpsert) ClearTimeoutMs() *CoreGatewayHttpRouteUpsert {
+	u.SetNull(coregatewayhttproute.FieldTimeoutMs)
 	return u
 }
 
-// ClearRedirectCode clears the value of the "redirect_code" field.
-func (u *CoreGatewayHttpRouteUpsert) ClearRedirectCode() *CoreGatewayHttpRouteUpsert {
-	u.SetNull(coregatewayhttproute.FieldRedirectCode)
+// SetRetryPolicy sets the "retry_policy" field.
+func (u *CoreGatewayHttpRouteUpsert) SetRetryPolicy(v *common.RetryPolicy) *CoreGatewayHttpRouteUpsert {
+	u.Set(coregatewayhttproute.FieldRetryPolicy, v)
 	return u
 }
 
-// SetStatus sets the "status" field.
-func (u *CoreGatewayHttpRouteUpsert) SetStatus(v constant.YesOrNo) *CoreGatewayHttpRouteUpsert {
-	u.Set(coregatewayhttproute.FieldStatus, v)
+// UpdateRetryPolicy sets the "retry_policy" field to the value that was provided on create.
+func (u *CoreGatewayHttpRouteUpsert) UpdateRetryPolicy() *CoreGatewayHttpRouteUpsert {
+	u.SetExcluded(coregatewayhttproute.FieldRetryPolicy)
+	return u
+}
+
+// ClearRetryPolicy clears the value of the "retry_policy" field.
+func (u *CoreGatewayHttpRouteUpsert) ClearRetryPolicy() *CoreGatewayHttpRouteUpsert {
+	u.SetNull(coregatewayhttproute.FieldRetryPolicy)
+	return u
+}
+
+// SetHeaderMutation sets the "header_mutation" field.
+func (u *CoreGatewayHttpRouteUpsert) SetHeaderMutation(v *common.HeaderMutation) *CoreGatewayHttpRouteUpsert {
+	u.Set(coregatewayhttproute.FieldHeaderMutation, v)
+	return u
+}
+
+// UpdateHeaderMutation sets the "header_mutation" field to the value that was provided on create.
+func (u *CoreGatewayHttpRouteUpsert) UpdateHeaderMutation() *CoreGatewayHttpRouteUpsert {
+	u.SetExcluded(coregatewayhttproute.FieldHeaderMutation)
+	return u
+}
+
+// ClearHeaderMutation clears the value of the "header_mutation" field.
+func (u *CoreGatewayHttpRouteUpsert) ClearHeaderMutation() *CoreGatewayHttpRouteUpsert {
+	u.SetNull(coregatewayhttproute.FieldHeaderMutation)
+	return u
+}
+
+// SetMirrorUpstreamID sets the "mirror_upstream_id" field.
+func (u *CoreGatewayHttpRouteUpsert) SetMirrorUpstreamID(v string) *CoreGatewayHttpRouteUpsert {
+	u.Set(coregatewayhttproute.FieldMirrorUpstreamID, v)
+	return u
+}
+
+// UpdateMirrorUpstreamID sets the "mirror_upstream_id" field to the value that was provided on create.
+func (u *CoreGatewayHttpRouteUpsert) UpdateMirrorUpstreamID() *CoreGatewayHttpRouteUpsert {
+	u.SetExcluded(coregatewayhttproute.FieldMirrorUpstreamID)
+	return u
+}
+
+// ClearMirrorUpstreamID clears the value of the "mirror_upstream_id" field.
+func (u *CoreGatewayHttpRouteUpsert) ClearMirrorUpstreamID() *CoreGatewayHttpRouteUpsert {
+	u.SetNull(coregatewayhttproute.FieldMirrorUpstreamID)
+	return u
+}
+
+// SetMirrorPercent sets the "mirror_percent" field.
+func (u *CoreGatewayHttpRouteUpsert) SetMirrorPercent(v float64) *CoreGatewayHttpRouteUpsert {
+	u.Set(coregatewayhttproute.FieldMirrorPercent, v)
+	return u
+}
+
+// UpdateMirrorPercent sets the "mirror_percent" field to the value that was provided on create.
+func (u *CoreGatewayHttpRouteUpsert) UpdateMirrorPercent() *CoreGatewayHttpRouteUpsert {
+	u.SetExcluded(coregatewayhttproute.FieldMirrorPercent)
+	return u
+}
+
+// AddMirrorPercent adds v to the "mirror_percent" field.
+func (u *CoreGatewayHttpRouteUpsert) AddMirrorPercent(v float64) *CoreGatewayHttpRouteUpsert {
+	u.Add(coregatewayhttproute.FieldMirrorPercent, v)
+	return u
+}
+
+// ClearMirrorPercent clears the value of the "mirror_percent" field.
+func (u *CoreGatewayHttpRouteUpsert) ClearMirrorPercent() *CoreGatewayHttpRouteUpsert {
+	u.SetNull(coregatewayhttproute.FieldMirrorPercent)
+	return u
+}
+
+// SetFaultPolicy sets the "fault_policy" field.
+func (u *CoreGatewayHttpRouteUpsert) SetFaultPolicy(v *common.FaultPolicy) *CoreGatewayHttpRouteUpsert {
+	u.Set(coregatewayhttproute.FieldFaultPolicy, v)
+	return u
+}
+
+// UpdateFaultPolicy sets the "fault_policy" field to the value that was provided on create.
+func (u *CoreGatewayHttpRouteUpsert) UpdateFaultPolicy() *CoreGatewayHttpRouteUpsert {
+	u.SetExcluded(coregatewayhttproute.FieldFaultPolicy)
+	return u
+}
+
+// ClearFaultPolicy clears the value of the "fault_policy" field.
+func (u *CoreGatewayHttpRouteUpsert) ClearFaultPolicy() *CoreGatewayHttpRouteUpsert {
+	u.SetNull(coregatewayhttproute.FieldFaultPolicy)
+	return u
+}
+
+// SetCorsPolicy sets the "cors_policy" field.
+func (u *CoreGatewayHttpRouteUpsert) SetCorsPolicy(v *common.CorsPolicy) *CoreGatewayHttpRouteUpsert {
+	u.Set(coregatewayhttproute.FieldCorsPolicy, v)
+	return u
+}
+
+// UpdateCorsPolicy sets the "cors_policy" field to the value that was provided on create.
+func (u *CoreGatewayHttpRouteUpsert) UpdateCorsPolicy() *CoreGatewayHttpRouteUpsert {
+	u.SetExcluded(coregatewayhttproute.FieldCorsPolicy)
+	return u
+}
+
+// ClearCorsPolicy clears the value of the "cors_policy" field.
+func (u *CoreGatewayHttpRouteUpsert) ClearCorsPolicy() *CoreGatewayHttpRouteUpsert {
+	u.SetNull(coregatewayhttproute.FieldCorsPolicy)
+	return u
+}
+
+// SetLocalRateLimit sets the "local_rate_limit" field.
+func (u *CoreGatewayHttpRouteUpsert) SetLocalRateLimit(v *common.LocalRateLimit) *CoreGatewayHttpRouteUpsert {
+	u.Set(coregatewayhttproute.FieldLocalRateLimit, v)
+	return u
+}
+
+// UpdateLocalRateLimit sets the "local_rate_limit" field to the value that was provided on create.
+func (u *CoreGatewayHttpRouteUpsert) UpdateLocalRateLimit() *CoreGatewayHttpRouteUpsert {
+	u.SetExcluded(coregatewayhttproute.FieldLocalRateLimit)
+	return u
+}
+
+// ClearLocalRateLimit clears the value of the "local_rate_limit" field.
+func (u *CoreGatewayHttpRouteUpsert) ClearLocalRateLimit() *CoreGatewayHttpRouteUpsert {
+	u.SetNull(coregatewayhttproute.FieldLocalRateLimit)
+	return u
+}
+
+// SetRateLimitActions sets the "rate_limit_actions" field.
+func (u *CoreGatewayHttpRouteUpsert) SetRateLimitActions(v []common.RateLimitAction) *CoreGatewayHttpRouteUpsert {
+	u.Set(coregatewayhttproute.FieldRateLimitActions, v)
+	return u
+}
+
+// UpdateRateLimitActions sets the "rate_limit_actions" field to the value that was provided on create.
+func (u *CoreGatewayHttpRouteUpsert) UpdateRateLimitActions() *CoreGatewayHttpRouteUpsert {
+	u.SetExcluded(coregatewayhttproute.FieldRateLimitActions)
+	return u
+}
+
+// ClearRateLimitActions clears the value of the "rate_limit_actions" field.
+func (u *CoreGatewayHttpRouteUpsert) ClearRateLimitActions() *CoreGatewayHttpRouteUpsert {
+	u.SetNull(coregatewayhttproute.FieldRateLimitActions)
+	return u
+}
+
+// SetDirectResponse sets the "direct_response" field.
+func (u *CoreGatewayHttpRouteUpsert) SetDirectResponse(v *common.DirectResponse) *CoreGatewayHttpRouteUpsert {
+	u.Set(coregatewayhttproute.FieldDirectResponse, v)
+	return u
+}
+
+// UpdateDirectResponse sets the "direct_response" field to the value that was provided on create.
+func (u *CoreGatewayHttpRouteUpsert) UpdateDirectResponse() *CoreGatewayHttpRouteUpsert {
+	u.SetExcluded(coregatewayhttproute.FieldDirectResponse)
+	return u
+}
+
+// ClearDirectResponse clears the value of the "direct_response" field.
+func (u *CoreGatewayHttpRouteUpsert) ClearDirectResponse() *CoreGatewayHttpRouteUpsert {
+	u.SetNull(coregatewayhttproute.FieldDirectResponse)
+	return u
+}
+
+// SetHashPolicies sets the "hash_policies" field.
+func (u *CoreGatewayHttpRouteUpsert) SetHashPolicies(v []common.HashPolicy) *CoreGatewayHttpRouteUpsert {
+	u.Set(coregatewayhttproute.FieldHashPolicies, v)
+	return u
+}
+
+// UpdateHashPolicies sets the "hash_policies" field to the value that was provided on create.
+func (u *CoreGatewayHttpRouteUpsert) UpdateHashPolicies() *CoreGatewayHttpRouteUpsert {
+	u.SetExcluded(coregatewayhttproute.FieldHashPolicies)
+	return u
+}
+
+// ClearHashPolicies clears the value of the "hash_policies" field.
+func (u *CoreGatewayHttpRouteUpsert) ClearHashPolicies() *CoreGatewayHttpRouteUpsert {
+	u.SetNull(coregatewayhttproute.FieldHashPolicies)
+	return u
+}
+
+// SetDisableCompression sets the "disable_compression" field.
+func (u *CoreGatewayHttpRouteUpsert) SetDisableCompression(v constant.YesOrNo) *CoreGatewayHttpRouteUpsert {
+	u.Set(coregatewayhttproute.FieldDisableCompression, v)
+	return u
+}
+
+// UpdateDisableCompression sets the "disable_compression" field to the value that was provided on create.
+func (u *CoreGatewayHttpRouteUpsert) UpdateDisableCompression() *CoreGatewayHttpRouteUpsert {
+	u.SetExcluded(coregatewayhttproute.FieldDisableCompression)
+	return u
+}
+
+// AddDisableCompression adds v to the "disable_compression" field.
+func (u *CoreGatewayHttpRouteUpsert) AddDisableCompression(v constant.YesOrNo) *CoreGatewayHttpRouteUpsert {
+	u.Add(coregatewayhttproute.FieldDisableCompression, v)
+	return u
+}
+
+// ClearDisableCompression clears the value of the "disable_compression" field.
+func (u *CoreGatewayHttpRouteUpsert) ClearDisableCompression() *CoreGatewayHttpRouteUpsert {
+	u.SetNull(coregatewayhttproute.FieldDisableCompression)
+	return u
+}
+
+// SetEnablePathRewrite sets the "enable_path_rewrite" field.
+func (u *CoreGatewayHttpRouteUpsert) SetEnablePathRewrite(v constant.YesOrNo) *CoreGatewayHttpRouteUpsert {
+	u.Set(coregatewayhttproute.FieldEnablePathRewrite, v)
+	return u
+}
+
+// UpdateEnablePathRewrite sets the "enable_path_rewrite" field to the value that was provided on create.
+func (u *CoreGatewayHttpRouteUpsert) UpdateEnablePathRewrite() *CoreGatewayHttpRouteUpsert {
+	u.SetExcluded(coregatewayhttproute.FieldEnablePathRewrite)
+	return u
+}
+
+// AddEnablePathRewrite adds v to the "enable_path_rewrite" field.
+func (u *CoreGatewayHttpRouteUpsert) AddEnablePathRewrite(v constant.YesOrNo) *CoreGatewayHttpRouteUpsert {
+	u.Add(coregatewayhttproute.FieldEnablePathRewrite, v)
+	return u
+}
+
+// ClearEnablePathRewrite clears the value of the "enable_path_rewrite" field.
+func (u *CoreGatewayHttpRouteUpsert) ClearEnablePathRewrite() *CoreGatewayHttpRouteUpsert {
+	u.SetNull(coregatewayhttproute.FieldEnablePathRewrite)
+	return u
+}
+
+// SetPathRewrite sets the "path_rewrite" field.
+func (u *CoreGatewayHttpRouteUpsert) SetPathRewrite(v string) *CoreGatewayHttpRouteUpsert {
+	u.Set(coregatewayhttproute.FieldPathRewrite, v)
+	return u
+}
+
+// UpdatePathRewrite sets the "path_rewrite" field to the value that was provided on create.
+func (u *CoreGatewayHttpRouteUpsert) UpdatePathRewrite() *CoreGatewayHttpRouteUpsert {
+	u.SetExcluded(coregatewayhttproute.FieldPathRewrite)
+	return u
+}
+
+// ClearPathRewrite clears the value of the "path_rewrite" field.
+func (u *CoreGatewayHttpRouteUpsert) ClearPathRewrite() *CoreGatewayHttpRouteUpsert {
+	u.SetNull(coregatewayhttproute.FieldPathRewrite)
+	return u
+}
+
+// SetEnableRedirect sets the "enable_redirect" field.
+func (u *CoreGatewayHttpRouteUpsert) SetEnableRedirect(v constant.YesOrNo) *CoreGatewayHttpRouteUpsert {
+	u.Set(coregatewayhttproute.FieldEnableRedirect, v)
+	return u
+}
+
+// UpdateEnableRedirect sets the "enable_redirect" field to the value that was provided on create.
+func (u *CoreGatewayHttpRouteUpsert) UpdateEnableRedirect() *CoreGatewayHttpRouteUpsert {
+	u.SetExcluded(coregatewayhttproute.FieldEnableRedirect)
+	return u
+}
+
+// AddEnableRedirect adds v to the "enable_redirect" field.
+func (u *CoreGatewayHttpRouteUpsert) AddEnableRedirect(v constant.YesOrNo) *CoreGatewayHttpRouteUpsert {
+	u.Add(coregatewayhttproute.FieldEnableRedirect, v)
+	return u
+}
+
+// ClearEnableRedirect clears the value of the "enable_redirect" field.
+func (u *CoreGatewayHttpRouteUpsert) ClearEnableRedirect() *CoreGatewayHttpRouteUpsert {
+	u.SetNull(coregatewayhttproute.FieldEnableRedirect)
+	return u
+}
+
+// SetRedirectURL sets the "redirect_url" field.
+func (u *CoreGatewayHttpRouteUpsert) SetRedirectURL(v string) *CoreGatewayHttpRouteUpsert {
+	u.Set(coregatewayhttproute.FieldRedirectURL, v)
+	return u
+}
+
+// UpdateRedirectURL sets the "redirect_url" field to the value that was provided on create.
+func (u *CoreGatewayHttpRouteUpsert) UpdateRedirectURL() *CoreGatewayHttpRouteUpsert {
+	u.SetExcluded(coregatewayhttproute.FieldRedirectURL)
+	return u
+}
+
+// ClearRedirectURL clears the value of the "redirect_url" field.
+func (u *CoreGatewayHttpRouteUpsert) ClearRedirectURL() *CoreGatewayHttpRouteUpsert {
+	u.SetNull(coregatewayhttproute.FieldRedirectURL)
+	return u
+}
+
+// SetRedirectCode sets the "redirect_code" field.
+func (u *CoreGatewayHttpRouteUpsert) SetRedirectCode(v int) *CoreGatewayHttpRouteUpsert {
+	u.Set(coregatewayhttproute.FieldRedirectCode, v)
+	return u
+}
+
+// UpdateRedirectCode sets the "redirect_code" field to the value that was provided on create.
+func (u *CoreGatewayHttpRouteUpsert) UpdateRedirectCode() *CoreGatewayHttpRouteUpsert {
+	u.SetExcluded(coregatewayhttproute.FieldRedirectCode)
+	return u
+}
+
+// AddRedirectCode adds v to the "redirect_code" field.
+func (u *CoreGatewayHttpRouteUpsert) AddRedirectCode(v int) *CoreGatewayHttpRouteUpsert {
+	u.Add(coregatewayhttproute.FieldRedirectCode, v)
+	return u
+}
+
+// ClearRedirectCode clears the value of the "redirect_code" field.
+func (u *CoreGatewayHttpRouteUpsert) ClearRedirectCode() *CoreGatewayHttpRouteUpsert {
+	u.SetNull(coregatewayhttproute.FieldRedirectCode)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *CoreGatewayHttpRouteUpsert) SetStatus(v constant.YesOrNo) *CoreGatewayHttpRouteUpsert {
+	u.Set(coregatewayhttproute.FieldStatus, v)
 	return u
 }
 
@@ -868,6 +1599,69 @@ func (u *CoreGatewayHttpRouteUpsertOne) ClearDescription() *CoreGatewayHttpRoute
 	})
 }
 
+// SetUpstreamID sets the "upstream_id" field.
+func (u *CoreGatewayHttpRouteUpsertOne) SetUpstreamID(v string) *CoreGatewayHttpRouteUpsertOne {
+	return u.Update(func(s *CoreGatewayHttpRouteUpsert) {
+		s.SetUpstreamID(v)
+	})
+}
+
+// UpdateUpstreamID sets the "upstream_id" field to the value that was provided on create.
+func (u *CoreGatewayHttpRouteUpsertOne) UpdateUpstreamID() *CoreGatewayHttpRouteUpsertOne {
+	return u.Update(func(s *CoreGatewayHttpRouteUpsert) {
+		s.UpdateUpstreamID()
+	})
+}
+
+// ClearUpstreamID clears the value of the "upstream_id" field.
+func (u *CoreGatewayHttpRouteUpsertOne) ClearUpstreamID() *CoreGatewayHttpRouteUpsertOne {
+	return u.Update(func(s *CoreGatewayHttpRouteUpsert) {
+		s.ClearUpstreamID()
+	})
+}
+
+// SetClusterID sets the "cluster_id" field.
+func (u *CoreGatewayHttpRouteUpsertOne) SetClusterID(v string) *CoreGatewayHttpRouteUpsertOne {
+	return u.Update(func(s *CoreGatewayHttpRouteUpsert) {
+		s.SetClusterID(v)
+	})
+}
+
+// UpdateClusterID sets the "cluster_id" field to the value that was provided on create.
+func (u *CoreGatewayHttpRouteUpsertOne) UpdateClusterID() *CoreGatewayHttpRouteUpsertOne {
+	return u.Update(func(s *CoreGatewayHttpRouteUpsert) {
+		s.UpdateClusterID()
+	})
+}
+
+// ClearClusterID clears the value of the "cluster_id" field.
+func (u *CoreGatewayHttpRouteUpsertOne) ClearClusterID() *CoreGatewayHttpRouteUpsertOne {
+	return u.Update(func(s *CoreGatewayHttpRouteUpsert) {
+		s.ClearClusterID()
+	})
+}
+
+// SetVirtualHostID sets the "virtual_host_id" field.
+func (u *CoreGatewayHttpRouteUpsertOne) SetVirtualHostID(v string) *CoreGatewayHttpRouteUpsertOne {
+	return u.Update(func(s *CoreGatewayHttpRouteUpsert) {
+		s.SetVirtualHostID(v)
+	})
+}
+
+// UpdateVirtualHostID sets the "virtual_host_id" field to the value that was provided on create.
+func (u *CoreGatewayHttpRouteUpsertOne) UpdateVirtualHostID() *CoreGatewayHttpRouteUpsertOne {
+	return u.Update(func(s *CoreGatewayHttpRouteUpsert) {
+		s.UpdateVirtualHostID()
+	})
+}
+
+// ClearVirtualHostID clears the value of the "virtual_host_id" field.
+func (u *CoreGatewayHttpRouteUpsertOne) ClearVirtualHostID() *CoreGatewayHttpRouteUpsertOne {
+	return u.Update(func(s *CoreGatewayHttpRouteUpsert) {
+		s.ClearVirtualHostID()
+	})
+}
+
 // SetMatchType sets the "match_type" field.
 func (u *CoreGatewayHttpRouteUpsertOne) SetMatchType(v constant.ProxyHttpRouteMatchType) *CoreGatewayHttpRouteUpsertOne {
 	return u.Update(func(s *CoreGatewayHttpRouteUpsert) {
@@ -917,31 +1711,395 @@ func (u *CoreGatewayHttpRouteUpsertOne) ClearMatchPattern() *CoreGatewayHttpRout
 	})
 }
 
-// SetTimeoutMs sets the "timeout_ms" field.
-func (u *CoreGatewayHttpRouteUpsertOne) SetTimeoutMs(v int) *CoreGatewayHttpRouteUpsertOne {
+// SetPriority sets the "priority" field.
+func (u *CoreGatewayHttpRouteUpsertOne) SetPriority(v int) *CoreGatewayHttpRouteUpsertOne {
+	return u.Update(func(s *CoreGatewayHttpRouteUpsert) {
+		s.SetPriority(v)
+	})
+}
+
+// AddPriority adds v to the "priority" field.
+func (u *CoreGatewayHttpRouteUpsertOne) AddPriority(v int) *CoreGatewayHttpRouteUpsertOne {
+	return u.Update(func(s *CoreGatewayHttpRouteUpsert) {
+		s.AddPriority(v)
+	})
+}
+
+// UpdatePriority sets the "priority" field to the value that was provided on create.
+func (u *CoreGatewayHttpRouteUpsertOne) UpdatePriority() *CoreGatewayHttpRouteUpsertOne {
+	return u.Update(func(s *CoreGatewayHttpRouteUpsert) {
+		s.UpdatePriority()
+	})
+}
+
+// ClearPriority clears the value of the "priority" field.
+func (u *CoreGatewayHttpRouteUpsertOne) ClearPriority() *CoreGatewayHttpRouteUpsertOne {
+	return u.Update(func(s *CoreGatewayHttpRouteUpsert) {
+		s.ClearPriority()
+	})
+}
+
+// SetIgnoreCase sets the "ignore_case" field.
+func (u *CoreGatewayHttpRouteUpsertOne) SetIgnoreCase(v constant.YesOrNo) *CoreGatewayHttpRouteUpsertOne {
+	return u.Update(func(s *CoreGatewayHttpRouteUpsert) {
+		s.SetIgnoreCase(v)
+	})
+}
+
+// AddIgnoreCase adds v to the "ignore_case" field.
+func (u *CoreGatewayHttpRouteUpsertOne) AddIgnoreCase(v constant.YesOrNo) *CoreGatewayHttpRouteUpsertOne {
+	return u.Update(func(s *CoreGatewayHttpRouteUpsert) {
+		s.AddIgnoreCase(v)
+	})
+}
+
+// UpdateIgnoreCase sets the "ignore_case" field to the value that was provided on create.
+func (u *CoreGatewayHttpRouteUpsertOne) UpdateIgnoreCase() *CoreGatewayHttpRouteUpsertOne {
+	return u.Update(func(s *CoreGatewayHttpRouteUpsert) {
+		s.UpdateIgnoreCase()
+	})
+}
+
+// ClearIgnoreCase clears the value of the "ignore_case" field.
+func (u *CoreGatewayHttpRouteUpsertOne) ClearIgnoreCase() *CoreGatewayHttpRouteUpsertOne {
+	return u.Update(func(s *CoreGatewayHttpRouteUpsert) {
+		s.ClearIgnoreCase()
+	})
+}
+
+// SetMethods sets the "methods" field.
+func (u *CoreGatewayHttpRouteUpsertOne) SetMethods(v []string) *CoreGatewayHttpRouteUpsertOne {
+	return u.Update(func(s *CoreGatewayHttpRouteUpsert) {
+		s.SetMethods(v)
+	})
+}
+
+// UpdateMethods sets the "methods" field to the value that was provided on create.
+func (u *CoreGatewayHttpRouteUpsertOne) UpdateMethods() *CoreGatewayHttpRouteUpsertOne {
+	return u.Update(func(s *CoreGatewayHttpRouteUpsert) {
+		s.UpdateMethods()
+	})
+}
+
+// ClearMethods clears the value of the "methods" field.
+func (u *CoreGatewayHttpRouteUpsertOne) ClearMethods() *CoreGatewayHttpRouteUpsertOne {
+	return u.Update(func(s *CoreGatewayHttpRouteUpsert) {
+		s.ClearMethods()
+	})
+}
+
+// SetHeaderMatchers sets the "header_matchers" field.
+func (u *CoreGatewayHttpRouteUpsertOne) SetHeaderMatchers(v []common.HttpMatcher) *CoreGatewayHttpRouteUpsertOne {
+	return u.Update(func(s *CoreGatewayHttpRouteUpsert) {
+		s.SetHeaderMatchers(v)
+	})
+}
+
+// UpdateHeaderMatchers sets the "header_matchers" field to the value that was provided on create.
+func (u *CoreGatewayHttpRouteUpsertOne) UpdateHeaderMatchers() *CoreGatewayHttpRouteUpsertOne {
+	return u.Update(func(s *CoreGatewayHttpRouteUpsert) {
+		s.UpdateHeaderMatchers()
+	})
+}
+
+// ClearHeaderMatchers clears the value of the "header_matchers" field.
+func (u *CoreGatewayHttpRouteUpsertOne) ClearHeaderMatchers() *CoreGatewayHttpRouteUpsertOne {
+	return u.Update(func(s *CoreGatewayHttpRouteUpsert) {
+		s.ClearHeaderMatchers()
+	})
+}
+
+// SetQueryMatchers sets the "query_matchers" field.
+func (u *CoreGatewayHttpRouteUpsertOne) SetQueryMatchers(v []common.HttpMatcher) *CoreGatewayHttpRouteUpsertOne {
+	return u.Update(func(s *CoreGatewayHttpRouteUpsert) {
+		s.SetQueryMatchers(v)
+	})
+}
+
+// UpdateQueryMatchers sets the "query_matchers" field to the value that was provided on create.
+func (u *CoreGatewayHttpRouteUpsertOne) UpdateQueryMatchers() *CoreGatewayHttpRouteUpsertOne {
+	return u.Update(func(s *CoreGatewayHttpRouteUpsert) {
+		s.UpdateQueryMatchers()
+	})
+}
+
+// ClearQueryMatchers clears the value of the "query_matchers" field.
+func (u *CoreGatewayHttpRouteUpsertOne) ClearQueryMatchers() *CoreGatewayHttpRouteUpsertOne {
+	return u.Update(func(s *CoreGatewayHttpRouteUpsert) {
+		s.ClearQueryMatchers()
+	})
+}
+
+// SetTimeoutMs sets the "timeout_ms" field.
+func (u *CoreGatewayHttpRouteUpsertOne) SetTimeoutMs(v int) *CoreGatewayHttpRouteUpsertOne {
+	return u.Update(func(s *CoreGatewayHttpRouteUpsert) {
+		s.SetTimeoutMs(v)
+	})
+}
+
+// AddTimeoutMs adds v to the "timeout_ms" field.
+func (u *CoreGatewayHttpRouteUpsertOne) AddTimeoutMs(v int) *CoreGatewayHttpRouteUpsertOne {
+	return u.Update(func(s *CoreGatewayHttpRouteUpsert) {
+		s.AddTimeoutMs(v)
+	})
+}
+
+// UpdateTimeoutMs sets the "timeout_ms" field to the value that was provided on create.
+func (u *CoreGatewayHttpRouteUpsertOne) UpdateTimeoutMs() *CoreGatewayHttpRouteUpsertOne {
+	return u.Update(func(s *CoreGatewayHttpRouteUpsert) {
+		s.UpdateTimeoutMs()
+	})
+}
+
+// ClearTimeoutMs clears the value of the "timeout_ms" field.
+func (u *CoreGatewayHttpRouteUpsertOne) ClearTimeoutMs() *CoreGatewayHttpRouteUpsertOne {
+	return u.Update(func(s *CoreGatewayHttpRouteUpsert) {
+		s.ClearTimeoutMs()
+	})
+}
+
+// SetRetryPolicy sets the "retry_policy" field.
+func (u *CoreGatewayHttpRouteUpsertOne) SetRetryPolicy(v *common.RetryPolicy) *CoreGatewayHttpRouteUpsertOne {
+	return u.Update(func(s *CoreGatewayHttpRouteUpsert) {
+		s.SetRetryPolicy(v)
+	})
+}
+
+// UpdateRetryPolicy sets the "retry_policy" field to the value that was provided on create.
+func (u *CoreGatewayHttpRouteUpsertOne) UpdateRetryPolicy() *CoreGatewayHttpRouteUpsertOne {
+	return u.Update(func(s *CoreGatewayHttpRouteUpsert) {
+		s.UpdateRetryPolicy()
+	})
+}
+
+// ClearRetryPolicy clears the value of the "retry_policy" field.
+func (u *CoreGatewayHttpRouteUpsertOne) ClearRetryPolicy() *CoreGatewayHttpRouteUpsertOne {
+	return u.Update(func(s *CoreGatewayHttpRouteUpsert) {
+		s.ClearRetryPolicy()
+	})
+}
+
+// SetHeaderMutation sets the "header_mutation" field.
+func (u *CoreGatewayHttpRouteUpsertOne) SetHeaderMutation(v *common.HeaderMutation) *CoreGatewayHttpRouteUpsertOne {
+	return u.Update(func(s *CoreGatewayHttpRouteUpsert) {
+		s.SetHeaderMutation(v)
+	})
+}
+
+// UpdateHeaderMutation sets the "header_mutation" field to the value that was provided on create.
+func (u *CoreGatewayHttpRouteUpsertOne) UpdateHeaderMutation() *CoreGatewayHttpRouteUpsertOne {
+	return u.Update(func(s *CoreGatewayHttpRouteUpsert) {
+		s.UpdateHeaderMutation()
+	})
+}
+
+// ClearHeaderMutation clears the value of the "header_mutation" field.
+func (u *CoreGatewayHttpRouteUpsertOne) ClearHeaderMutation() *CoreGatewayHttpRouteUpsertOne {
+	return u.Update(func(s *CoreGatewayHttpRouteUpsert) {
+		s.ClearHeaderMutation()
+	})
+}
+
+// SetMirrorUpstreamID sets the "mirror_upstream_id" field.
+func (u *CoreGatewayHttpRouteUpsertOne) SetMirrorUpstreamID(v string) *CoreGatewayHttpRouteUpsertOne {
+	return u.Update(func(s *CoreGatewayHttpRouteUpsert) {
+		s.SetMirrorUpstreamID(v)
+	})
+}
+
+// UpdateMirrorUpstreamID sets the "mirror_upstream_id" field to the value that was provided on create.
+func (u *CoreGatewayHttpRouteUpsertOne) UpdateMirrorUpstreamID() *CoreGatewayHttpRouteUpsertOne {
+	return u.Update(func(s *CoreGatewayHttpRouteUpsert) {
+		s.UpdateMirrorUpstreamID()
+	})
+}
+
+// ClearMirrorUpstreamID clears the value of the "mirror_upstream_id" field.
+func (u *CoreGatewayHttpRouteUpsertOne) ClearMirrorUpstreamID() *CoreGatewayHttpRouteUpsertOne {
+	return u.Update(func(s *CoreGatewayHttpRouteUpsert) {
+		s.ClearMirrorUpstreamID()
+	})
+}
+
+// SetMirrorPercent sets the "mirror_percent" field.
+func (u *CoreGatewayHttpRouteUpsertOne) SetMirrorPercent(v float64) *CoreGatewayHttpRouteUpsertOne {
+	return u.Update(func(s *CoreGatewayHttpRouteUpsert) {
+		s.SetMirrorPercent(v)
+	})
+}
+
+// AddMirrorPercent adds v to the "mirror_percent" field.
+func (u *CoreGatewayHttpRouteUpsertOne) AddMirrorPercent(v float64) *CoreGatewayHttpRouteUpsertOne {
+	return u.Update(func(s *CoreGatewayHttpRouteUpsert) {
+		s.AddMirrorPercent(v)
+	})
+}
+
+// UpdateMirrorPercent sets the "mirror_percent" field to the value that was provided on create.
+func (u *CoreGatewayHttpRouteUpsertOne) UpdateMirrorPercent() *CoreGatewayHttpRouteUpsertOne {
+	return u.Update(func(s *CoreGatewayHttpRouteUpsert) {
+		s.UpdateMirrorPercent()
+	})
+}
+
+// ClearMirrorPercent clears the value of the "mirror_percent" field.
+func (u *CoreGatewayHttpRouteUpsertOne) ClearMirrorPercent() *CoreGatewayHttpRouteUpsertOne {
+	return u.Update(func(s *CoreGatewayHttpRouteUpsert) {
+		s.ClearMirrorPercent()
+	})
+}
+
+// SetFaultPolicy sets the "fault_policy" field.
+func (u *CoreGatewayHttpRouteUpsertOne) SetFaultPolicy(v *common.FaultPolicy) *CoreGatewayHttpRouteUpsertOne {
+	return u.Update(func(s *CoreGatewayHttpRouteUpsert) {
+		s.SetFaultPolicy(v)
+	})
+}
+
+// UpdateFaultPolicy sets the "fault_policy" field to the value that was provided on create.
+func (u *CoreGatewayHttpRouteUpsertOne) UpdateFaultPolicy() *CoreGatewayHttpRouteUpsertOne {
+	return u.Update(func(s *CoreGatewayHttpRouteUpsert) {
+		s.UpdateFaultPolicy()
+	})
+}
+
+// ClearFaultPolicy clears the value of the "fault_policy" field.
+func (u *CoreGatewayHttpRouteUpsertOne) ClearFaultPolicy() *CoreGatewayHttpRouteUpsertOne {
+	return u.Update(func(s *CoreGatewayHttpRouteUpsert) {
+		s.ClearFaultPolicy()
+	})
+}
+
+// SetCorsPolicy sets the "cors_policy" field.
+func (u *CoreGatewayHttpRouteUpsertOne) SetCorsPolicy(v *common.CorsPolicy) *CoreGatewayHttpRouteUpsertOne {
+	return u.Update(func(s *CoreGatewayHttpRouteUpsert) {
+		s.SetCorsPolicy(v)
+	})
+}
+
+// UpdateCorsPolicy sets the "cors_policy" field to the value that was provided on create.
+func (u *CoreGatewayHttpRouteUpsertOne) UpdateCorsPolicy() *CoreGatewayHttpRouteUpsertOne {
+	return u.Update(func(s *CoreGatewayHttpRouteUpsert) {
+		s.UpdateCorsPolicy()
+	})
+}
+
+// ClearCorsPolicy clears the value of the "cors_policy" field.
+func (u *CoreGatewayHttpRouteUpsertOne) ClearCorsPolicy() *CoreGatewayHttpRouteUpsertOne {
+	return u.Update(func(s *CoreGatewayHttpRouteUpsert) {
+		s.ClearCorsPolicy()
+	})
+}
+
+// SetLocalRateLimit sets the "local_rate_limit" field.
+func (u *CoreGatewayHttpRouteUpsertOne) SetLocalRateLimit(v *common.LocalRateLimit) *CoreGatewayHttpRouteUpsertOne {
+	return u.Update(func(s *CoreGatewayHttpRouteUpsert) {
+		s.SetLocalRateLimit(v)
+	})
+}
+
+// UpdateLocalRateLimit sets the "local_rate_limit" field to the value that was provided on create.
+func (u *CoreGatewayHttpRouteUpsertOne) UpdateLocalRateLimit() *CoreGatewayHttpRouteUpsertOne {
+	return u.Update(func(s *CoreGatewayHttpRouteUpsert) {
+		s.UpdateLocalRateLimit()
+	})
+}
+
+// ClearLocalRateLimit clears the value of the "local_rate_limit" field.
+func (u *CoreGatewayHttpRouteUpsertOne) ClearLocalRateLimit() *CoreGatewayHttpRouteUpsertOne {
+	return u.Update(func(s *CoreGatewayHttpRouteUpsert) {
+		s.ClearLocalRateLimit()
+	})
+}
+
+// SetRateLimitActions sets the "rate_limit_actions" field.
+func (u *CoreGatewayHttpRouteUpsertOne) SetRateLimitActions(v []common.RateLimitAction) *CoreGatewayHttpRouteUpsertOne {
+	return u.Update(func(s *CoreGatewayHttpRouteUpsert) {
+		s.SetRateLimitActions(v)
+	})
+}
+
+// UpdateRateLimitActions sets the "rate_limit_actions" field to the value that was provided on create.
+func (u *CoreGatewayHttpRouteUpsertOne) UpdateRateLimitActions() *CoreGatewayHttpRouteUpsertOne {
+	return u.Update(func(s *CoreGatewayHttpRouteUpsert) {
+		s.UpdateRateLimitActions()
+	})
+}
+
+// ClearRateLimitActions clears the value of the "rate_limit_actions" field.
+func (u *CoreGatewayHttpRouteUpsertOne) ClearRateLimitActions() *CoreGatewayHttpRouteUpsertOne {
+	return u.Update(func(s *CoreGatewayHttpRouteUpsert) {
+		s.ClearRateLimitActions()
+	})
+}
+
+// SetDirectResponse sets the "direct_response" field.
+func (u *CoreGatewayHttpRouteUpsertOne) SetDirectResponse(v *common.DirectResponse) *CoreGatewayHttpRouteUpsertOne {
+	return u.Update(func(s *CoreGatewayHttpRouteUpsert) {
+		s.SetDirectResponse(v)
+	})
+}
+
+// UpdateDirectResponse sets the "direct_response" field to the value that was provided on create.
+func (u *CoreGatewayHttpRouteUpsertOne) UpdateDirectResponse() *CoreGatewayHttpRouteUpsertOne {
+	return u.Update(func(s *CoreGatewayHttpRouteUpsert) {
+		s.UpdateDirectResponse()
+	})
+}
+
+// ClearDirectResponse clears the value of the "direct_response" field.
+func (u *CoreGatewayHttpRouteUpsertOne) ClearDirectResponse() *CoreGatewayHttpRouteUpsertOne {
+	return u.Update(func(s *CoreGatewayHttpRouteUpsert) {
+		s.ClearDirectResponse()
+	})
+}
+
+// SetHashPolicies sets the "hash_policies" field.
+func (u *CoreGatewayHttpRouteUpsertOne) SetHashPolicies(v []common.HashPolicy) *CoreGatewayHttpRouteUpsertOne {
+	return u.Update(func(s *CoreGatewayHttpRouteUpsert) {
+		s.SetHashPolicies(v)
+	})
+}
+
+// UpdateHashPolicies sets the "hash_policies" field to the value that was provided on create.
+func (u *CoreGatewayHttpRouteUpsertOne) UpdateHashPolicies() *CoreGatewayHttpRouteUpsertOne {
+	return u.Update(func(s *CoreGatewayHttpRouteUpsert) {
+		s.UpdateHashPolicies()
+	})
+}
+
+// ClearHashPolicies clears the value of the "hash_policies" field.
+func (u *CoreGatewayHttpRouteUpsertOne) ClearHashPolicies() *CoreGatewayHttpRouteUpsertOne {
+	return u.Update(func(s *CoreGatewayHttpRouteUpsert) {
+		s.ClearHashPolicies()
+	})
+}
+
+// SetDisableCompression sets the "disable_compression" field.
+func (u *CoreGatewayHttpRouteUpsertOne) SetDisableCompression(v constant.YesOrNo) *CoreGatewayHttpRouteUpsertOne {
 	return u.Update(func(s *CoreGatewayHttpRouteUpsert) {
-		s.SetTimeoutMs(v)
+		s.SetDisableCompression(v)
 	})
 }
 
-// AddTimeoutMs adds v to the "timeout_ms" field.
-func (u *CoreGatewayHttpRouteUpsertOne) AddTimeoutMs(v int) *CoreGatewayHttpRouteUpsertOne {
+// AddDisableCompression adds v to the "disable_compression" field.
+func (u *CoreGatewayHttpRouteUpsertOne) AddDisableCompression(v constant.YesOrNo) *CoreGatewayHttpRouteUpsertOne {
 	return u.Update(func(s *CoreGatewayHttpRouteUpsert) {
-		s.AddTimeoutMs(v)
+		s.AddDisableCompression(v)
 	})
 }
 
-// UpdateTimeoutMs sets the "timeout_ms" field to the value that was provided on create.
-func (u *CoreGatewayHttpRouteUpsertOne) UpdateTimeoutMs() *CoreGatewayHttpRouteUpsertOne {
+// UpdateDisableCompression sets the "disable_compression" field to the value that was provided on create.
+func (u *CoreGatewayHttpRouteUpsertOne) UpdateDisableCompression() *CoreGatewayHttpRouteUpsertOne {
 	return u.Update(func(s *CoreGatewayHttpRouteUpsert) {
-		s.UpdateTimeoutMs()
+		s.UpdateDisableCompression()
 	})
 }
 
-// ClearTimeoutMs clears the value of the "timeout_ms" field.
-func (u *CoreGatewayHttpRouteUpsertOne) ClearTimeoutMs() *CoreGatewayHttpRouteUpsertOne {
+// ClearDisableCompression clears the value of the "disable_compression" field.
+func (u *CoreGatewayHttpRouteUpsertOne) ClearDisableCompression() *CoreGatewayHttpRouteUpsertOne {
 	return u.Update(func(s *CoreGatewayHttpRouteUpsert) {
-		s.ClearTimeoutMs()
+		s.ClearDisableCompression()
 	})
 }
 
@@ -1394,6 +2552,69 @@ func (u *CoreGatewayHttpRouteUpsertBulk) ClearDescription() *CoreGatewayHttpRout
 	})
 }
 
+// SetUpstreamID sets the "upstream_id" field.
+func (u *CoreGatewayHttpRouteUpsertBulk) SetUpstreamID(v string) *CoreGatewayHttpRouteUpsertBulk {
+	return u.Update(func(s *CoreGatewayHttpRouteUpsert) {
+		s.SetUpstreamID(v)
+	})
+}
+
+// UpdateUpstreamID sets the "upstream_id" field to the value that was provided on create.
+func (u *CoreGatewayHttpRouteUpsertBulk) UpdateUpstreamID() *CoreGatewayHttpRouteUpsertBulk {
+	return u.Update(func(s *CoreGatewayHttpRouteUpsert) {
+		s.UpdateUpstreamID()
+	})
+}
+
+// ClearUpstreamID clears the value of the "upstream_id" field.
+func (u *CoreGatewayHttpRouteUpsertBulk) ClearUpstreamID() *CoreGatewayHttpRouteUpsertBulk {
+	return u.Update(func(s *CoreGatewayHttpRouteUpsert) {
+		s.ClearUpstreamID()
+	})
+}
+
+// SetClusterID sets the "cluster_id" field.
+func (u *CoreGatewayHttpRouteUpsertBulk) SetClusterID(v string) *CoreGatewayHttpRouteUpsertBulk {
+	return u.Update(func(s *CoreGatewayHttpRouteUpsert) {
+		s.SetClusterID(v)
+	})
+}
+
+// UpdateClusterID sets the "cluster_id" field to the value that was provided on create.
+func (u *CoreGatewayHttpRouteUpsertBulk) UpdateClusterID() *CoreGatewayHttpRouteUpsertBulk {
+	return u.Update(func(s *CoreGatewayHttpRouteUpsert) {
+		s.UpdateClusterID()
+	})
+}
+
+// ClearClusterID clears the value of the "cluster_id" field.
+func (u *CoreGatewayHttpRouteUpsertBulk) ClearClusterID() *CoreGatewayHttpRouteUpsertBulk {
+	return u.Update(func(s *CoreGatewayHttpRouteUpsert) {
+		s.ClearClusterID()
+	})
+}
+
+// SetVirtualHostID sets the "virtual_host_id" field.
+func (u *CoreGatewayHttpRouteUpsertBulk) SetVirtualHostID(v string) *CoreGatewayHttpRouteUpsertBulk {
+	return u.Update(func(s *CoreGatewayHttpRouteUpsert) {
+		s.SetVirtualHostID(v)
+	})
+}
+
+// UpdateVirtualHostID sets the "virtual_host_id" field to the value that was provided on create.
+func (u *CoreGatewayHttpRouteUpsertBulk) UpdateVirtualHostID() *CoreGatewayHttpRouteUpsertBulk {
+	return u.Update(func(s *CoreGatewayHttpRouteUpsert) {
+		s.UpdateVirtualHostID()
+	})
+}
+
+// ClearVirtualHostID clears the value of the "virtual_host_id" field.
+func (u *CoreGatewayHttpRouteUpsertBulk) ClearVirtualHostID() *CoreGatewayHttpRouteUpsertBulk {
+	return u.Update(func(s *CoreGatewayHttpRouteUpsert) {
+		s.ClearVirtualHostID()
+	})
+}
+
 // SetMatchType sets the "match_type" field.
 func (u *CoreGatewayHttpRouteUpsertBulk) SetMatchType(v constant.ProxyHttpRouteMatchType) *CoreGatewayHttpRouteUpsertBulk {
 	return u.Update(func(s *CoreGatewayHttpRouteUpsert) {
@@ -1443,6 +2664,125 @@ func (u *CoreGatewayHttpRouteUpsertBulk) ClearMatchPattern() *CoreGatewayHttpRou
 	})
 }
 
+// SetPriority sets the "priority" field.
+func (u *CoreGatewayHttpRouteUpsertBulk) SetPriority(v int) *CoreGatewayHttpRouteUpsertBulk {
+	return u.Update(func(s *CoreGatewayHttpRouteUpsert) {
+		s.SetPriority(v)
+	})
+}
+
+// AddPriority adds v to the "priority" field.
+func (u *CoreGatewayHttpRouteUpsertBulk) AddPriority(v int) *CoreGatewayHttpRouteUpsertBulk {
+	return u.Update(func(s *CoreGatewayHttpRouteUpsert) {
+		s.AddPriority(v)
+	})
+}
+
+// UpdatePriority sets the "priority" field to the value that was provided on create.
+func (u *CoreGatewayHttpRouteUpsertBulk) UpdatePriority() *CoreGatewayHttpRouteUpsertBulk {
+	return u.Update(func(s *CoreGatewayHttpRouteUpsert) {
+		s.UpdatePriority()
+	})
+}
+
+// ClearPriority clears the value of the "priority" field.
+func (u *CoreGatewayHttpRouteUpsertBulk) ClearPriority() *CoreGatewayHttpRouteUpsertBulk {
+	return u.Update(func(s *CoreGatewayHttpRouteUpsert) {
+		s.ClearPriority()
+	})
+}
+
+// SetIgnoreCase sets the "ignore_case" field.
+func (u *CoreGatewayHttpRouteUpsertBulk) SetIgnoreCase(v constant.YesOrNo) *CoreGatewayHttpRouteUpsertBulk {
+	return u.Update(func(s *CoreGatewayHttpRouteUpsert) {
+		s.SetIgnoreCase(v)
+	})
+}
+
+// AddIgnoreCase adds v to the "ignore_case" field.
+func (u *CoreGatewayHttpRouteUpsertBulk) AddIgnoreCase(v constant.YesOrNo) *CoreGatewayHttpRouteUpsertBulk {
+	return u.Update(func(s *CoreGatewayHttpRouteUpsert) {
+		s.AddIgnoreCase(v)
+	})
+}
+
+// UpdateIgnoreCase sets the "ignore_case" field to the value that was provided on create.
+func (u *CoreGatewayHttpRouteUpsertBulk) UpdateIgnoreCase() *CoreGatewayHttpRouteUpsertBulk {
+	return u.Update(func(s *CoreGatewayHttpRouteUpsert) {
+		s.UpdateIgnoreCase()
+	})
+}
+
+// ClearIgnoreCase clears the value of the "ignore_case" field.
+func (u *CoreGatewayHttpRouteUpsertBulk) ClearIgnoreCase() *CoreGatewayHttpRouteUpsertBulk {
+	return u.Update(func(s *CoreGatewayHttpRouteUpsert) {
+		s.ClearIgnoreCase()
+	})
+}
+
+// SetMethods sets the "methods" field.
+func (u *CoreGatewayHttpRouteUpsertBulk) SetMethods(v []string) *CoreGatewayHttpRouteUpsertBulk {
+	return u.Update(func(s *CoreGatewayHttpRouteUpsert) {
+		s.SetMethods(v)
+	})
+}
+
+// UpdateMethods sets the "methods" field to the value that was provided on create.
+func (u *CoreGatewayHttpRouteUpsertBulk) UpdateMethods() *CoreGatewayHttpRouteUpsertBulk {
+	return u.Update(func(s *CoreGatewayHttpRouteUpsert) {
+		s.UpdateMethods()
+	})
+}
+
+// ClearMethods clears the value of the "methods" field.
+func (u *CoreGatewayHttpRouteUpsertBulk) ClearMethods() *CoreGatewayHttpRouteUpsertBulk {
+	return u.Update(func(s *CoreGatewayHttpRouteUpsert) {
+		s.ClearMethods()
+	})
+}
+
+// SetHeaderMatchers sets the "header_matchers" field.
+func (u *CoreGatewayHttpRouteUpsertBulk) SetHeaderMatchers(v []common.HttpMatcher) *CoreGatewayHttpRouteUpsertBulk {
+	return u.Update(func(s *CoreGatewayHttpRouteUpsert) {
+		s.SetHeaderMatchers(v)
+	})
+}
+
+// UpdateHeaderMatchers sets the "header_matchers" field to the value that was provided on create.
+func (u *CoreGatewayHttpRouteUpsertBulk) UpdateHeaderMatchers() *CoreGatewayHttpRouteUpsertBulk {
+	return u.Update(func(s *CoreGatewayHttpRouteUpsert) {
+		s.UpdateHeaderMatchers()
+	})
+}
+
+// ClearHeaderMatchers clears the value of the "header_matchers" field.
+func (u *CoreGatewayHttpRouteUpsertBulk) ClearHeaderMatchers() *CoreGatewayHttpRouteUpsertBulk {
+	return u.Update(func(s *CoreGatewayHttpRouteUpsert) {
+		s.ClearHeaderMatchers()
+	})
+}
+
+// SetQueryMatchers sets the "query_matchers" field.
+func (u *CoreGatewayHttpRouteUpsertBulk) SetQueryMatchers(v []common.HttpMatcher) *CoreGatewayHttpRouteUpsertBulk {
+	return u.Update(func(s *CoreGatewayHttpRouteUpsert) {
+		s.SetQueryMatchers(v)
+	})
+}
+
+// UpdateQueryMatchers sets the "query_matchers" field to the value that was provided on create.
+func (u *CoreGatewayHttpRouteUpsertBulk) UpdateQueryMatchers() *CoreGatewayHttpRouteUpsertBulk {
+	return u.Update(func(s *CoreGatewayHttpRouteUpsert) {
+		s.UpdateQueryMatchers()
+	})
+}
+
+// ClearQueryMatchers clears the value of the "query_matchers" field.
+func (u *CoreGatewayHttpRouteUpsertBulk) ClearQueryMatchers() *CoreGatewayHttpRouteUpsertBulk {
+	return u.Update(func(s *CoreGatewayHttpRouteUpsert) {
+		s.ClearQueryMatchers()
+	})
+}
+
 // SetTimeoutMs sets the "timeout_ms" field.
 func (u *CoreGatewayHttpRouteUpsertBulk) SetTimeoutMs(v int) *CoreGatewayHttpRouteUpsertBulk {
 	return u.Update(func(s *CoreGatewayHttpRouteUpsert) {
@@ -1471,6 +2811,251 @@ func (u *CoreGatewayHttpRouteUpsertBulk) ClearTimeoutMs() *CoreGatewayHttpRouteU
 	})
 }
 
+// SetRetryPolicy sets the "retry_policy" field.
+func (u *CoreGatewayHttpRouteUpsertBulk) SetRetryPolicy(v *common.RetryPolicy) *CoreGatewayHttpRouteUpsertBulk {
+	return u.Update(func(s *CoreGatewayHttpRouteUpsert) {
+		s.SetRetryPolicy(v)
+	})
+}
+
+// UpdateRetryPolicy sets the "retry_policy" field to the value that was provided on create.
+func (u *CoreGatewayHttpRouteUpsertBulk) UpdateRetryPolicy() *CoreGatewayHttpRouteUpsertBulk {
+	return u.Update(func(s *CoreGatewayHttpRouteUpsert) {
+		s.UpdateRetryPolicy()
+	})
+}
+
+// ClearRetryPolicy clears the value of the "retry_policy" field.
+func (u *CoreGatewayHttpRouteUpsertBulk) ClearRetryPolicy() *CoreGatewayHttpRouteUpsertBulk {
+	return u.Update(func(s *CoreGatewayHttpRouteUpsert) {
+		s.ClearRetryPolicy()
+	})
+}
+
+// SetHeaderMutation sets the "header_mutation" field.
+func (u *CoreGatewayHttpRouteUpsertBulk) SetHeaderMutation(v *common.HeaderMutation) *CoreGatewayHttpRouteUpsertBulk {
+	return u.Update(func(s *CoreGatewayHttpRouteUpsert) {
+		s.SetHeaderMutation(v)
+	})
+}
+
+// UpdateHeaderMutation sets the "header_mutation" field to the value that was provided on create.
+func (u *CoreGatewayHttpRouteUpsertBulk) UpdateHeaderMutation() *CoreGatewayHttpRouteUpsertBulk {
+	return u.Update(func(s *CoreGatewayHttpRouteUpsert) {
+		s.UpdateHeaderMutation()
+	})
+}
+
+// ClearHeaderMutation clears the value of the "header_mutation" field.
+func (u *CoreGatewayHttpRouteUpsertBulk) ClearHeaderMutation() *CoreGatewayHttpRouteUpsertBulk {
+	return u.Update(func(s *CoreGatewayHttpRouteUpsert) {
+		s.ClearHeaderMutation()
+	})
+}
+
+// SetMirrorUpstreamID sets the "mirror_upstream_id" field.
+func (u *CoreGatewayHttpRouteUpsertBulk) SetMirrorUpstreamID(v string) *CoreGatewayHttpRouteUpsertBulk {
+	return u.Update(func(s *CoreGatewayHttpRouteUpsert) {
+		s.SetMirrorUpstreamID(v)
+	})
+}
+
+// UpdateMirrorUpstreamID sets the "mirror_upstream_id" field to the value that was provided on create.
+func (u *CoreGatewayHttpRouteUpsertBulk) UpdateMirrorUpstreamID() *CoreGatewayHttpRouteUpsertBulk {
+	return u.Update(func(s *CoreGatewayHttpRouteUpsert) {
+		s.UpdateMirrorUpstreamID()
+	})
+}
+
+// ClearMirrorUpstreamID clears the value of the "mirror_upstream_id" field.
+func (u *CoreGatewayHttpRouteUpsertBulk) ClearMirrorUpstreamID() *CoreGatewayHttpRouteUpsertBulk {
+	return u.Update(func(s *CoreGatewayHttpRouteUpsert) {
+		s.ClearMirrorUpstreamID()
+	})
+}
+
+// SetMirrorPercent sets the "mirror_percent" field.
+func (u *CoreGatewayHttpRouteUpsertBulk) SetMirrorPercent(v float64) *CoreGatewayHttpRouteUpsertBulk {
+	return u.Update(func(s *CoreGatewayHttpRouteUpsert) {
+		s.SetMirrorPercent(v)
+	})
+}
+
+// AddMirrorPercent adds v to the "mirror_percent" field.
+func (u *CoreGatewayHttpRouteUpsertBulk) AddMirrorPercent(v float64) *CoreGatewayHttpRouteUpsertBulk {
+	return u.Update(func(s *CoreGatewayHttpRouteUpsert) {
+		s.AddMirrorPercent(v)
+	})
+}
+
+// UpdateMirrorPercent sets the "mirror_percent" field to the value that was provided on create.
+func (u *CoreGatewayHttpRouteUpsertBulk) UpdateMirrorPercent() *CoreGatewayHttpRouteUpsertBulk {
+	return u.Update(func(s *CoreGatewayHttpRouteUpsert) {
+		s.UpdateMirrorPercent()
+	})
+}
+
+// ClearMirrorPercent clears the value of the "mirror_percent" field.
+func (u *CoreGatewayHttpRouteUpsertBulk) ClearMirrorPercent() *CoreGatewayHttpRouteUpsertBulk {
+	return u.Update(func(s *CoreGatewayHttpRouteUpsert) {
+		s.ClearMirrorPercent()
+	})
+}
+
+// SetFaultPolicy sets the "fault_policy" field.
+func (u *CoreGatewayHttpRouteUpsertBulk) SetFaultPolicy(v *common.FaultPolicy) *CoreGatewayHttpRouteUpsertBulk {
+	return u.Update(func(s *CoreGatewayHttpRouteUpsert) {
+		s.SetFaultPolicy(v)
+	})
+}
+
+// UpdateFaultPolicy sets the "fault_policy" field to the value that was provided on create.
+func (u *CoreGatewayHttpRouteUpsertBulk) UpdateFaultPolicy() *CoreGatewayHttpRouteUpsertBulk {
+	return u.Update(func(s *CoreGatewayHttpRouteUpsert) {
+		s.UpdateFaultPolicy()
+	})
+}
+
+// ClearFaultPolicy clears the value of the "fault_policy" field.
+func (u *CoreGatewayHttpRouteUpsertBulk) ClearFaultPolicy() *CoreGatewayHttpRouteUpsertBulk {
+	return u.Update(func(s *CoreGatewayHttpRouteUpsert) {
+		s.ClearFaultPolicy()
+	})
+}
+
+// SetCorsPolicy sets the "cors_policy" field.
+func (u *CoreGatewayHttpRouteUpsertBulk) SetCorsPolicy(v *common.CorsPolicy) *CoreGatewayHttpRouteUpsertBulk {
+	return u.Update(func(s *CoreGatewayHttpRouteUpsert) {
+		s.SetCorsPolicy(v)
+	})
+}
+
+// UpdateCorsPolicy sets the "cors_policy" field to the value that was provided on create.
+func (u *CoreGatewayHttpRouteUpsertBulk) UpdateCorsPolicy() *CoreGatewayHttpRouteUpsertBulk {
+	return u.Update(func(s *CoreGatewayHttpRouteUpsert) {
+		s.UpdateCorsPolicy()
+	})
+}
+
+// ClearCorsPolicy clears the value of the "cors_policy" field.
+func (u *CoreGatewayHttpRouteUpsertBulk) ClearCorsPolicy() *CoreGatewayHttpRouteUpsertBulk {
+	return u.Update(func(s *CoreGatewayHttpRouteUpsert) {
+		s.ClearCorsPolicy()
+	})
+}
+
+// SetLocalRateLimit sets the "local_rate_limit" field.
+func (u *CoreGatewayHttpRouteUpsertBulk) SetLocalRateLimit(v *common.LocalRateLimit) *CoreGatewayHttpRouteUpsertBulk {
+	return u.Update(func(s *CoreGatewayHttpRouteUpsert) {
+		s.SetLocalRateLimit(v)
+	})
+}
+
+// UpdateLocalRateLimit sets the "local_rate_limit" field to the value that was provided on create.
+func (u *CoreGatewayHttpRouteUpsertBulk) UpdateLocalRateLimit() *CoreGatewayHttpRouteUpsertBulk {
+	return u.Update(func(s *CoreGatewayHttpRouteUpsert) {
+		s.UpdateLocalRateLimit()
+	})
+}
+
+// ClearLocalRateLimit clears the value of the "local_rate_limit" field.
+func (u *CoreGatewayHttpRouteUpsertBulk) ClearLocalRateLimit() *CoreGatewayHttpRouteUpsertBulk {
+	return u.Update(func(s *CoreGatewayHttpRouteUpsert) {
+		s.ClearLocalRateLimit()
+	})
+}
+
+// SetRateLimitActions sets the "rate_limit_actions" field.
+func (u *CoreGatewayHttpRouteUpsertBulk) SetRateLimitActions(v []common.RateLimitAction) *CoreGatewayHttpRouteUpsertBulk {
+	return u.Update(func(s *CoreGatewayHttpRouteUpsert) {
+		s.SetRateLimitActions(v)
+	})
+}
+
+// UpdateRateLimitActions sets the "rate_limit_actions" field to the value that was provided on create.
+func (u *CoreGatewayHttpRouteUpsertBulk) UpdateRateLimitActions() *CoreGatewayHttpRouteUpsertBulk {
+	return u.Update(func(s *CoreGatewayHttpRouteUpsert) {
+		s.UpdateRateLimitActions()
+	})
+}
+
+// ClearRateLimitActions clears the value of the "rate_limit_actions" field.
+func (u *CoreGatewayHttpRouteUpsertBulk) ClearRateLimitActions() *CoreGatewayHttpRouteUpsertBulk {
+	return u.Update(func(s *CoreGatewayHttpRouteUpsert) {
+		s.ClearRateLimitActions()
+	})
+}
+
+// SetDirectResponse sets the "direct_response" field.
+func (u *CoreGatewayHttpRouteUpsertBulk) SetDirectResponse(v *common.DirectResponse) *CoreGatewayHttpRouteUpsertBulk {
+	return u.Update(func(s *CoreGatewayHttpRouteUpsert) {
+		s.SetDirectResponse(v)
+	})
+}
+
+// UpdateDirectResponse sets the "direct_response" field to the value that was provided on create.
+func (u *CoreGatewayHttpRouteUpsertBulk) UpdateDirectResponse() *CoreGatewayHttpRouteUpsertBulk {
+	return u.Update(func(s *CoreGatewayHttpRouteUpsert) {
+		s.UpdateDirectResponse()
+	})
+}
+
+// ClearDirectResponse clears the value of the "direct_response" field.
+func (u *CoreGatewayHttpRouteUpsertBulk) ClearDirectResponse() *CoreGatewayHttpRouteUpsertBulk {
+	return u.Update(func(s *CoreGatewayHttpRouteUpsert) {
+		s.ClearDirectResponse()
+	})
+}
+
+// SetHashPolicies sets the "hash_policies" field.
+func (u *CoreGatewayHttpRouteUpsertBulk) SetHashPolicies(v []common.HashPolicy) *CoreGatewayHttpRouteUpsertBulk {
+	return u.Update(func(s *CoreGatewayHttpRouteUpsert) {
+		s.SetHashPolicies(v)
+	})
+}
+
+// UpdateHashPolicies sets the "hash_policies" field to the value that was provided on create.
+func (u *CoreGatewayHttpRouteUpsertBulk) UpdateHashPolicies() *CoreGatewayHttpRouteUpsertBulk {
+	return u.Update(func(s *CoreGatewayHttpRouteUpsert) {
+		s.UpdateHashPolicies()
+	})
+}
+
+// ClearHashPolicies clears the value of the "hash_policies" field.
+func (u *CoreGatewayHttpRouteUpsertBulk) ClearHashPolicies() *CoreGatewayHttpRouteUpsertBulk {
+	return u.Update(func(s *CoreGatewayHttpRouteUpsert) {
+		s.ClearHashPolicies()
+	})
+}
+
+// SetDisableCompression sets the "disable_compression" field.
+func (u *CoreGatewayHttpRouteUpsertBulk) SetDisableCompression(v constant.YesOrNo) *CoreGatewayHttpRouteUpsertBulk {
+	return u.Update(func(s *CoreGatewayHttpRouteUpsert) {
+		s.SetDisableCompression(v)
+	})
+}
+
+// AddDisableCompression adds v to the "disable_compression" field.
+func (u *CoreGatewayHttpRouteUpsertBulk) AddDisableCompression(v constant.YesOrNo) *CoreGatewayHttpRouteUpsertBulk {
+	return u.Update(func(s *CoreGatewayHttpRouteUpsert) {
+		s.AddDisableCompression(v)
+	})
+}
+
+// UpdateDisableCompression sets the "disable_compression" field to the value that was provided on create.
+func (u *CoreGatewayHttpRouteUpsertBulk) UpdateDisableCompression() *CoreGatewayHttpRouteUpsertBulk {
+	return u.Update(func(s *CoreGatewayHttpRouteUpsert) {
+		s.UpdateDisableCompression()
+	})
+}
+
+// ClearDisableCompression clears the value of the "disable_compression" field.
+func (u *CoreGatewayHttpRouteUpsertBulk) ClearDisableCompression() *CoreGatewayHttpRouteUpsertBulk {
+	return u.Update(func(s *CoreGatewayHttpRouteUpsert) {
+		s.ClearDisableCompression()
+	})
+}
+
 // SetEnablePathRewrite sets the "enable_path_rewrite" field.
 func (u *CoreGatewayHttpRouteUpsertBulk) SetEnablePathRewrite(v constant.YesOrNo) *CoreGatewayHttpRouteUpsertBulk {
 	return u.Update(func(s *CoreGatewayHttpRouteUpsert) {
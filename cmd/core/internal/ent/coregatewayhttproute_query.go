@@ -4,6 +4,7 @@ package ent
 
 import (
 	"context"
+	"database/sql/driver"
 	"fmt"
 	"math"
 
@@ -12,17 +13,23 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/lyonmu/quebec/cmd/core/internal/ent/coregatewayhttproute"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/coregatewayhttproutetarget"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/coregatewayvirtualhost"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/coreupstream"
 	"github.com/lyonmu/quebec/cmd/core/internal/ent/predicate"
 )
 
 // CoreGatewayHttpRouteQuery is the builder for querying CoreGatewayHttpRoute entities.
 type CoreGatewayHttpRouteQuery struct {
 	config
-	ctx        *QueryContext
-	order      []coregatewayhttproute.OrderOption
-	inters     []Interceptor
-	predicates []predicate.CoreGatewayHttpRoute
-	modifiers  []func(*sql.Selector)
+	ctx                   *QueryContext
+	order                 []coregatewayhttproute.OrderOption
+	inters                []Interceptor
+	predicates            []predicate.CoreGatewayHttpRoute
+	withRouteFromUpstream *CoreUpstreamQuery
+	withRouteToTarget     *CoreGatewayHttpRouteTargetQuery
+	withRouteFromVhost    *CoreGatewayVirtualHostQuery
+	modifiers             []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -59,6 +66,72 @@ func (_q *CoreGatewayHttpRouteQuery) Order(o ...coregatewayhttproute.OrderOption
 	return _q
 }
 
+// QueryRouteFromUpstream chains the current query on the "route_from_upstream" edge.
+func (_q *CoreGatewayHttpRouteQuery) QueryRouteFromUpstream() *CoreUpstreamQuery {
+	query := (&CoreUpstreamClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(coregatewayhttproute.Table, coregatewayhttproute.FieldID, selector),
+			sqlgraph.To(coreupstream.Table, coreupstream.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, coregatewayhttproute.RouteFromUpstreamTable, coregatewayhttproute.RouteFromUpstreamColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryRouteToTarget chains the current query on the "route_to_target" edge.
+func (_q *CoreGatewayHttpRouteQuery) QueryRouteToTarget() *CoreGatewayHttpRouteTargetQuery {
+	query := (&CoreGatewayHttpRouteTargetClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(coregatewayhttproute.Table, coregatewayhttproute.FieldID, selector),
+			sqlgraph.To(coregatewayhttproutetarget.Table, coregatewayhttproutetarget.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, coregatewayhttproute.RouteToTargetTable, coregatewayhttproute.RouteToTargetColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryRouteFromVhost chains the current query on the "route_from_vhost" edge.
+func (_q *CoreGatewayHttpRouteQuery) QueryRouteFromVhost() *CoreGatewayVirtualHostQuery {
+	query := (&CoreGatewayVirtualHostClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(coregatewayhttproute.Table, coregatewayhttproute.FieldID, selector),
+			sqlgraph.To(coregatewayvirtualhost.Table, coregatewayvirtualhost.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, coregatewayhttproute.RouteFromVhostTable, coregatewayhttproute.RouteFromVhostColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first CoreGatewayHttpRoute entity from the query.
 // Returns a *NotFoundError when no CoreGatewayHttpRoute was found.
 func (_q *CoreGatewayHttpRouteQuery) First(ctx context.Context) (*CoreGatewayHttpRoute, error) {
@@ -246,11 +319,14 @@ func (_q *CoreGatewayHttpRouteQuery) Clone() *CoreGatewayHttpRouteQuery {
 		return nil
 	}
 	return &CoreGatewayHttpRouteQuery{
-		config:     _q.config,
-		ctx:        _q.ctx.Clone(),
-		order:      append([]coregatewayhttproute.OrderOption{}, _q.order...),
-		inters:     append([]Interceptor{}, _q.inters...),
-		predicates: append([]predicate.CoreGatewayHttpRoute{}, _q.predicates...),
+		config:                _q.config,
+		ctx:                   _q.ctx.Clone(),
+		order:                 append([]coregatewayhttproute.OrderOption{}, _q.order...),
+		inters:                append([]Interceptor{}, _q.inters...),
+		predicates:            append([]predicate.CoreGatewayHttpRoute{}, _q.predicates...),
+		withRouteFromUpstream: _q.withRouteFromUpstream.Clone(),
+		withRouteToTarget:     _q.withRouteToTarget.Clone(),
+		withRouteFromVhost:    _q.withRouteFromVhost.Clone(),
 		// clone intermediate query.
 		sql:       _q.sql.Clone(),
 		path:      _q.path,
@@ -258,6 +334,39 @@ func (_q *CoreGatewayHttpRouteQuery) Clone() *CoreGatewayHttpRouteQuery {
 	}
 }
 
+// WithRouteFromUpstream tells the query-builder to eager-load the nodes that are connected to
+// the "route_from_upstream" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *CoreGatewayHttpRouteQuery) WithRouteFromUpstream(opts ...func(*CoreUpstreamQuery)) *CoreGatewayHttpRouteQuery {
+	query := (&CoreUpstreamClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withRouteFromUpstream = query
+	return _q
+}
+
+// WithRouteToTarget tells the query-builder to eager-load the nodes that are connected to
+// the "route_to_target" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *CoreGatewayHttpRouteQuery) WithRouteToTarget(opts ...func(*CoreGatewayHttpRouteTargetQuery)) *CoreGatewayHttpRouteQuery {
+	query := (&CoreGatewayHttpRouteTargetClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withRouteToTarget = query
+	return _q
+}
+
+// WithRouteFromVhost tells the query-builder to eager-load the nodes that are connected to
+// the "route_from_vhost" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *CoreGatewayHttpRouteQuery) WithRouteFromVhost(opts ...func(*CoreGatewayVirtualHostQuery)) *CoreGatewayHttpRouteQuery {
+	query := (&CoreGatewayVirtualHostClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withRouteFromVhost = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -334,8 +443,13 @@ func (_q *CoreGatewayHttpRouteQuery) prepareQuery(ctx context.Context) error {
 
 func (_q *CoreGatewayHttpRouteQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*CoreGatewayHttpRoute, error) {
 	var (
-		nodes = []*CoreGatewayHttpRoute{}
-		_spec = _q.querySpec()
+		nodes       = []*CoreGatewayHttpRoute{}
+		_spec       = _q.querySpec()
+		loadedTypes = [3]bool{
+			_q.withRouteFromUpstream != nil,
+			_q.withRouteToTarget != nil,
+			_q.withRouteFromVhost != nil,
+		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
 		return (*CoreGatewayHttpRoute).scanValues(nil, columns)
@@ -343,6 +457,7 @@ func (_q *CoreGatewayHttpRouteQuery) sqlAll(ctx context.Context, hooks ...queryH
 	_spec.Assign = func(columns []string, values []any) error {
 		node := &CoreGatewayHttpRoute{config: _q.config}
 		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
 	if len(_q.modifiers) > 0 {
@@ -357,9 +472,119 @@ func (_q *CoreGatewayHttpRouteQuery) sqlAll(ctx context.Context, hooks ...queryH
 	if len(nodes) == 0 {
 		return nodes, nil
 	}
+	if query := _q.withRouteFromUpstream; query != nil {
+		if err := _q.loadRouteFromUpstream(ctx, query, nodes, nil,
+			func(n *CoreGatewayHttpRoute, e *CoreUpstream) { n.Edges.RouteFromUpstream = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withRouteToTarget; query != nil {
+		if err := _q.loadRouteToTarget(ctx, query, nodes,
+			func(n *CoreGatewayHttpRoute) { n.Edges.RouteToTarget = []*CoreGatewayHttpRouteTarget{} },
+			func(n *CoreGatewayHttpRoute, e *CoreGatewayHttpRouteTarget) {
+				n.Edges.RouteToTarget = append(n.Edges.RouteToTarget, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withRouteFromVhost; query != nil {
+		if err := _q.loadRouteFromVhost(ctx, query, nodes, nil,
+			func(n *CoreGatewayHttpRoute, e *CoreGatewayVirtualHost) { n.Edges.RouteFromVhost = e }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
+func (_q *CoreGatewayHttpRouteQuery) loadRouteFromUpstream(ctx context.Context, query *CoreUpstreamQuery, nodes []*CoreGatewayHttpRoute, init func(*CoreGatewayHttpRoute), assign func(*CoreGatewayHttpRoute, *CoreUpstream)) error {
+	ids := make([]string, 0, len(nodes))
+	nodeids := make(map[string][]*CoreGatewayHttpRoute)
+	for i := range nodes {
+		fk := nodes[i].UpstreamID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(coreupstream.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "upstream_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (_q *CoreGatewayHttpRouteQuery) loadRouteToTarget(ctx context.Context, query *CoreGatewayHttpRouteTargetQuery, nodes []*CoreGatewayHttpRoute, init func(*CoreGatewayHttpRoute), assign func(*CoreGatewayHttpRoute, *CoreGatewayHttpRouteTarget)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*CoreGatewayHttpRoute)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(coregatewayhttproutetarget.FieldRouteID)
+	}
+	query.Where(predicate.CoreGatewayHttpRouteTarget(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(coregatewayhttproute.RouteToTargetColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.RouteID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "route_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *CoreGatewayHttpRouteQuery) loadRouteFromVhost(ctx context.Context, query *CoreGatewayVirtualHostQuery, nodes []*CoreGatewayHttpRoute, init func(*CoreGatewayHttpRoute), assign func(*CoreGatewayHttpRoute, *CoreGatewayVirtualHost)) error {
+	ids := make([]string, 0, len(nodes))
+	nodeids := make(map[string][]*CoreGatewayHttpRoute)
+	for i := range nodes {
+		fk := nodes[i].VirtualHostID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(coregatewayvirtualhost.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "virtual_host_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
 func (_q *CoreGatewayHttpRouteQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
 	if len(_q.modifiers) > 0 {
@@ -388,6 +613,12 @@ func (_q *CoreGatewayHttpRouteQuery) querySpec() *sqlgraph.QuerySpec {
 				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
 			}
 		}
+		if _q.withRouteFromUpstream != nil {
+			_spec.Node.AddColumnOnce(coregatewayhttproute.FieldUpstreamID)
+		}
+		if _q.withRouteFromVhost != nil {
+			_spec.Node.AddColumnOnce(coregatewayhttproute.FieldVirtualHostID)
+		}
 	}
 	if ps := _q.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
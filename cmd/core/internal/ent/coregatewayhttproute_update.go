@@ -10,8 +10,13 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
+	"github.com/lyonmu/quebec/cmd/core/internal/common"
 	"github.com/lyonmu/quebec/cmd/core/internal/ent/coregatewayhttproute"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/coregatewayhttproutetarget"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/coregatewayvirtualhost"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/coreupstream"
 	"github.com/lyonmu/quebec/cmd/core/internal/ent/predicate"
 	"github.com/lyonmu/quebec/pkg/constant"
 )
@@ -96,6 +101,66 @@ func (_u *CoreGatewayHttpRouteUpdate) ClearDescription() *CoreGatewayHttpRouteUp
 	return _u
 }
 
+// SetUpstreamID sets the "upstream_id" field.
+func (_u *CoreGatewayHttpRouteUpdate) SetUpstreamID(v string) *CoreGatewayHttpRouteUpdate {
+	_u.mutation.SetUpstreamID(v)
+	return _u
+}
+
+// SetNillableUpstreamID sets the "upstream_id" field if the given value is not nil.
+func (_u *CoreGatewayHttpRouteUpdate) SetNillableUpstreamID(v *string) *CoreGatewayHttpRouteUpdate {
+	if v != nil {
+		_u.SetUpstreamID(*v)
+	}
+	return _u
+}
+
+// ClearUpstreamID clears the value of the "upstream_id" field.
+func (_u *CoreGatewayHttpRouteUpdate) ClearUpstreamID() *CoreGatewayHttpRouteUpdate {
+	_u.mutation.ClearUpstreamID()
+	return _u
+}
+
+// SetClusterID sets the "cluster_id" field.
+func (_u *CoreGatewayHttpRouteUpdate) SetClusterID(v string) *CoreGatewayHttpRouteUpdate {
+	_u.mutation.SetClusterID(v)
+	return _u
+}
+
+// SetNillableClusterID sets the "cluster_id" field if the given value is not nil.
+func (_u *CoreGatewayHttpRouteUpdate) SetNillableClusterID(v *string) *CoreGatewayHttpRouteUpdate {
+	if v != nil {
+		_u.SetClusterID(*v)
+	}
+	return _u
+}
+
+// ClearClusterID clears the value of the "cluster_id" field.
+func (_u *CoreGatewayHttpRouteUpdate) ClearClusterID() *CoreGatewayHttpRouteUpdate {
+	_u.mutation.ClearClusterID()
+	return _u
+}
+
+// SetVirtualHostID sets the "virtual_host_id" field.
+func (_u *CoreGatewayHttpRouteUpdate) SetVirtualHostID(v string) *CoreGatewayHttpRouteUpdate {
+	_u.mutation.SetVirtualHostID(v)
+	return _u
+}
+
+// SetNillableVirtualHostID sets the "virtual_host_id" field if the given value is not nil.
+func (_u *CoreGatewayHttpRouteUpdate) SetNillableVirtualHostID(v *string) *CoreGatewayHttpRouteUpdate {
+	if v != nil {
+		_u.SetVirtualHostID(*v)
+	}
+	return _u
+}
+
+// ClearVirtualHostID clears the value of the "virtual_host_id" field.
+func (_u *CoreGatewayHttpRouteUpdate) ClearVirtualHostID() *CoreGatewayHttpRouteUpdate {
+	_u.mutation.ClearVirtualHostID()
+	return _u
+}
+
 // SetMatchType sets the "match_type" field.
 func (_u *CoreGatewayHttpRouteUpdate) SetMatchType(v constant.ProxyHttpRouteMatchType) *CoreGatewayHttpRouteUpdate {
 	_u.mutation.ResetMatchType()
@@ -143,6 +208,114 @@ func (_u *CoreGatewayHttpRouteUpdate) ClearMatchPattern() *CoreGatewayHttpRouteU
 	return _u
 }
 
+// SetPriority sets the "priority" field.
+func (_u *CoreGatewayHttpRouteUpdate) SetPriority(v int) *CoreGatewayHttpRouteUpdate {
+	_u.mutation.ResetPriority()
+	_u.mutation.SetPriority(v)
+	return _u
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (_u *CoreGatewayHttpRouteUpdate) SetNillablePriority(v *int) *CoreGatewayHttpRouteUpdate {
+	if v != nil {
+		_u.SetPriority(*v)
+	}
+	return _u
+}
+
+// AddPriority adds value to the "priority" field.
+func (_u *CoreGatewayHttpRouteUpdate) AddPriority(v int) *CoreGatewayHttpRouteUpdate {
+	_u.mutation.AddPriority(v)
+	return _u
+}
+
+// ClearPriority clears the value of the "priority" field.
+func (_u *CoreGatewayHttpRouteUpdate) ClearPriority() *CoreGatewayHttpRouteUpdate {
+	_u.mutation.ClearPriority()
+	return _u
+}
+
+// SetIgnoreCase sets the "ignore_case" field.
+func (_u *CoreGatewayHttpRouteUpdate) SetIgnoreCase(v constant.YesOrNo) *CoreGatewayHttpRouteUpdate {
+	_u.mutation.ResetIgnoreCase()
+	_u.mutation.SetIgnoreCase(v)
+	return _u
+}
+
+// SetNillableIgnoreCase sets the "ignore_case" field if the given value is not nil.
+func (_u *CoreGatewayHttpRouteUpdate) SetNillableIgnoreCase(v *constant.YesOrNo) *CoreGatewayHttpRouteUpdate {
+	if v != nil {
+		_u.SetIgnoreCase(*v)
+	}
+	return _u
+}
+
+// AddIgnoreCase adds value to the "ignore_case" field.
+func (_u *CoreGatewayHttpRouteUpdate) AddIgnoreCase(v constant.YesOrNo) *CoreGatewayHttpRouteUpdate {
+	_u.mutation.AddIgnoreCase(v)
+	return _u
+}
+
+// ClearIgnoreCase clears the value of the "ignore_case" field.
+func (_u *CoreGatewayHttpRouteUpdate) ClearIgnoreCase() *CoreGatewayHttpRouteUpdate {
+	_u.mutation.ClearIgnoreCase()
+	return _u
+}
+
+// SetMethods sets the "methods" field.
+func (_u *CoreGatewayHttpRouteUpdate) SetMethods(v []string) *CoreGatewayHttpRouteUpdate {
+	_u.mutation.SetMethods(v)
+	return _u
+}
+
+// AppendMethods appends value to the "methods" field.
+func (_u *CoreGatewayHttpRouteUpdate) AppendMethods(v []string) *CoreGatewayHttpRouteUpdate {
+	_u.mutation.AppendMethods(v)
+	return _u
+}
+
+// ClearMethods clears the value of the "methods" field.
+func (_u *CoreGatewayHttpRouteUpdate) ClearMethods() *CoreGatewayHttpRouteUpdate {
+	_u.mutation.ClearMethods()
+	return _u
+}
+
+// SetHeaderMatchers sets the "header_matchers" field.
+func (_u *CoreGatewayHttpRouteUpdate) SetHeaderMatchers(v []common.HttpMatcher) *CoreGatewayHttpRouteUpdate {
+	_u.mutation.SetHeaderMatchers(v)
+	return _u
+}
+
+// AppendHeaderMatchers appends value to the "header_matchers" field.
+func (_u *CoreGatewayHttpRouteUpdate) AppendHeaderMatchers(v []common.HttpMatcher) *CoreGatewayHttpRouteUpdate {
+	_u.mutation.AppendHeaderMatchers(v)
+	return _u
+}
+
+// ClearHeaderMatchers clears the value of the "header_matchers" field.
+func (_u *CoreGatewayHttpRouteUpdate) ClearHeaderMatchers() *CoreGatewayHttpRouteUpdate {
+	_u.mutation.ClearHeaderMatchers()
+	return _u
+}
+
+// SetQueryMatchers sets the "query_matchers" field.
+func (_u *CoreGatewayHttpRouteUpdate) SetQueryMatchers(v []common.HttpMatcher) *CoreGatewayHttpRouteUpdate {
+	_u.mutation.SetQueryMatchers(v)
+	return _u
+}
+
+// AppendQueryMatchers appends value to the "query_matchers" field.
+func (_u *CoreGatewayHttpRouteUpdate) AppendQueryMatchers(v []common.HttpMatcher) *CoreGatewayHttpRouteUpdate {
+	_u.mutation.AppendQueryMatchers(v)
+	return _u
+}
+
+// ClearQueryMatchers clears the value of the "query_matchers" field.
+func (_u *CoreGatewayHttpRouteUpdate) ClearQueryMatchers() *CoreGatewayHttpRouteUpdate {
+	_u.mutation.ClearQueryMatchers()
+	return _u
+}
+
 // SetTimeoutMs sets the "timeout_ms" field.
 func (_u *CoreGatewayHttpRouteUpdate) SetTimeoutMs(v int) *CoreGatewayHttpRouteUpdate {
 	_u.mutation.ResetTimeoutMs()
@@ -170,6 +343,188 @@ func (_u *CoreGatewayHttpRouteUpdate) ClearTimeoutMs() *CoreGatewayHttpRouteUpda
 	return _u
 }
 
+// SetRetryPolicy sets the "retry_policy" field.
+func (_u *CoreGatewayHttpRouteUpdate) SetRetryPolicy(v *common.RetryPolicy) *CoreGatewayHttpRouteUpdate {
+	_u.mutation.SetRetryPolicy(v)
+	return _u
+}
+
+// ClearRetryPolicy clears the value of the "retry_policy" field.
+func (_u *CoreGatewayHttpRouteUpdate) ClearRetryPolicy() *CoreGatewayHttpRouteUpdate {
+	_u.mutation.ClearRetryPolicy()
+	return _u
+}
+
+// SetHeaderMutation sets the "header_mutation" field.
+func (_u *CoreGatewayHttpRouteUpdate) SetHeaderMutation(v *common.HeaderMutation) *CoreGatewayHttpRouteUpdate {
+	_u.mutation.SetHeaderMutation(v)
+	return _u
+}
+
+// ClearHeaderMutation clears the value of the "header_mutation" field.
+func (_u *CoreGatewayHttpRouteUpdate) ClearHeaderMutation() *CoreGatewayHttpRouteUpdate {
+	_u.mutation.ClearHeaderMutation()
+	return _u
+}
+
+// SetMirrorUpstreamID sets the "mirror_upstream_id" field.
+func (_u *CoreGatewayHttpRouteUpdate) SetMirrorUpstreamID(v string) *CoreGatewayHttpRouteUpdate {
+	_u.mutation.SetMirrorUpstreamID(v)
+	return _u
+}
+
+// SetNillableMirrorUpstreamID sets the "mirror_upstream_id" field if the given value is not nil.
+func (_u *CoreGatewayHttpRouteUpdate) SetNillableMirrorUpstreamID(v *string) *CoreGatewayHttpRouteUpdate {
+	if v != nil {
+		_u.SetMirrorUpstreamID(*v)
+	}
+	return _u
+}
+
+// ClearMirrorUpstreamID clears the value of the "mirror_upstream_id" field.
+func (_u *CoreGatewayHttpRouteUpdate) ClearMirrorUpstreamID() *CoreGatewayHttpRouteUpdate {
+	_u.mutation.ClearMirrorUpstreamID()
+	return _u
+}
+
+// SetMirrorPercent sets the "mirror_percent" field.
+func (_u *CoreGatewayHttpRouteUpdate) SetMirrorPercent(v float64) *CoreGatewayHttpRouteUpdate {
+	_u.mutation.ResetMirrorPercent()
+	_u.mutation.SetMirrorPercent(v)
+	return _u
+}
+
+// SetNillableMirrorPercent sets the "mirror_percent" field if the given value is not nil.
+func (_u *CoreGatewayHttpRouteUpdate) SetNillableMirrorPercent(v *float64) *CoreGatewayHttpRouteUpdate {
+	if v != nil {
+		_u.SetMirrorPercent(*v)
+	}
+	return _u
+}
+
+// AddMirrorPercent adds value to the "mirror_percent" field.
+func (_u *CoreGatewayHttpRouteUpdate) AddMirrorPercent(v float64) *CoreGatewayHttpRouteUpdate {
+	_u.mutation.AddMirrorPercent(v)
+	return _u
+}
+
+// ClearMirrorPercent clears the value of the "mirror_percent" field.
+func (_u *CoreGatewayHttpRouteUpdate) ClearMirrorPercent() *CoreGatewayHttpRouteUpdate {
+	_u.mutation.ClearMirrorPercent()
+	return _u
+}
+
+// SetFaultPolicy sets the "fault_policy" field.
+func (_u *CoreGatewayHttpRouteUpdate) SetFaultPolicy(v *common.FaultPolicy) *CoreGatewayHttpRouteUpdate {
+	_u.mutation.SetFaultPolicy(v)
+	return _u
+}
+
+// ClearFaultPolicy clears the value of the "fault_policy" field.
+func (_u *CoreGatewayHttpRouteUpdate) ClearFaultPolicy() *CoreGatewayHttpRouteUpdate {
+	_u.mutation.ClearFaultPolicy()
+	return _u
+}
+
+// SetCorsPolicy sets the "cors_policy" field.
+func (_u *CoreGatewayHttpRouteUpdate) SetCorsPolicy(v *common.CorsPolicy) *CoreGatewayHttpRouteUpdate {
+	_u.mutation.SetCorsPolicy(v)
+	return _u
+}
+
+// ClearCorsPolicy clears the value of the "cors_policy" field.
+func (_u *CoreGatewayHttpRouteUpdate) ClearCorsPolicy() *CoreGatewayHttpRouteUpdate {
+	_u.mutation.ClearCorsPolicy()
+	return _u
+}
+
+// SetLocalRateLimit sets the "local_rate_limit" field.
+func (_u *CoreGatewayHttpRouteUpdate) SetLocalRateLimit(v *common.LocalRateLimit) *CoreGatewayHttpRouteUpdate {
+	_u.mutation.SetLocalRateLimit(v)
+	return _u
+}
+
+// ClearLocalRateLimit clears the value of the "local_rate_limit" field.
+func (_u *CoreGatewayHttpRouteUpdate) ClearLocalRateLimit() *CoreGatewayHttpRouteUpdate {
+	_u.mutation.ClearLocalRateLimit()
+	return _u
+}
+
+// SetRateLimitActions sets the "rate_limit_actions" field.
+func (_u *CoreGatewayHttpRouteUpdate) SetRateLimitActions(v []common.RateLimitAction) *CoreGatewayHttpRouteUpdate {
+	_u.mutation.SetRateLimitActions(v)
+	return _u
+}
+
+// AppendRateLimitActions appends value to the "rate_limit_actions" field.
+func (_u *CoreGatewayHttpRouteUpdate) AppendRateLimitActions(v []common.RateLimitAction) *CoreGatewayHttpRouteUpdate {
+	_u.mutation.AppendRateLimitActions(v)
+	return _u
+}
+
+// ClearRateLimitActions clears the value of the "rate_limit_actions" field.
+func (_u *CoreGatewayHttpRouteUpdate) ClearRateLimitActions() *CoreGatewayHttpRouteUpdate {
+	_u.mutation.ClearRateLimitActions()
+	return _u
+}
+
+// SetDirectResponse sets the "direct_response" field.
+func (_u *CoreGatewayHttpRouteUpdate) SetDirectResponse(v *common.DirectResponse) *CoreGatewayHttpRouteUpdate {
+	_u.mutation.SetDirectResponse(v)
+	return _u
+}
+
+// ClearDirectResponse clears the value of the "direct_response" field.
+func (_u *CoreGatewayHttpRouteUpdate) ClearDirectResponse() *CoreGatewayHttpRouteUpdate {
+	_u.mutation.ClearDirectResponse()
+	return _u
+}
+
+// SetHashPolicies sets the "hash_policies" field.
+func (_u *CoreGatewayHttpRouteUpdate) SetHashPolicies(v []common.HashPolicy) *CoreGatewayHttpRouteUpdate {
+	_u.mutation.SetHashPolicies(v)
+	return _u
+}
+
+// AppendHashPolicies appends value to the "hash_policies" field.
+func (_u *CoreGatewayHttpRouteUpdate) AppendHashPolicies(v []common.HashPolicy) *CoreGatewayHttpRouteUpdate {
+	_u.mutation.AppendHashPolicies(v)
+	return _u
+}
+
+// ClearHashPolicies clears the value of the "hash_policies" field.
+func (_u *CoreGatewayHttpRouteUpdate) ClearHashPolicies() *CoreGatewayHttpRouteUpdate {
+	_u.mutation.ClearHashPolicies()
+	return _u
+}
+
+// SetDisableCompression sets the "disable_compression" field.
+func (_u *CoreGatewayHttpRouteUpdate) SetDisableCompression(v constant.YesOrNo) *CoreGatewayHttpRouteUpdate {
+	_u.mutation.ResetDisableCompression()
+	_u.mutation.SetDisableCompression(v)
+	return _u
+}
+
+// SetNillableDisableCompression sets the "disable_compression" field if the given value is not nil.
+func (_u *CoreGatewayHttpRouteUpdate) SetNillableDisableCompression(v *constant.YesOrNo) *CoreGatewayHttpRouteUpdate {
+	if v != nil {
+		_u.SetDisableCompression(*v)
+	}
+	return _u
+}
+
+// AddDisableCompression adds value to the "disable_compression" field.
+func (_u *CoreGatewayHttpRouteUpdate) AddDisableCompression(v constant.YesOrNo) *CoreGatewayHttpRouteUpdate {
+	_u.mutation.AddDisableCompression(v)
+	return _u
+}
+
+// ClearDisableCompression clears the value of the "disable_compression" field.
+func (_u *CoreGatewayHttpRouteUpdate) ClearDisableCompression() *CoreGatewayHttpRouteUpdate {
+	_u.mutation.ClearDisableCompression()
+	return _u
+}
+
 // SetEnablePathRewrite sets the "enable_path_rewrite" field.
 func (_u *CoreGatewayHttpRouteUpdate) SetEnablePathRewrite(v constant.YesOrNo) *CoreGatewayHttpRouteUpdate {
 	_u.mutation.ResetEnablePathRewrite()
@@ -318,11 +673,97 @@ func (_u *CoreGatewayHttpRouteUpdate) ClearStatus() *CoreGatewayHttpRouteUpdate
 	return _u
 }
 
+// SetRouteFromUpstreamID sets the "route_from_upstream" edge to the CoreUpstream entity by ID.
+func (_u *CoreGatewayHttpRouteUpdate) SetRouteFromUpstreamID(id string) *CoreGatewayHttpRouteUpdate {
+	_u.mutation.SetRouteFromUpstreamID(id)
+	return _u
+}
+
+// SetNillableRouteFromUpstreamID sets the "route_from_upstream" edge to the CoreUpstream entity by ID if the given value is not nil.
+func (_u *CoreGatewayHttpRouteUpdate) SetNillableRouteFromUpstreamID(id *string) *CoreGatewayHttpRouteUpdate {
+	if id != nil {
+		_u = _u.SetRouteFromUpstreamID(*id)
+	}
+	return _u
+}
+
+// SetRouteFromUpstream sets the "route_from_upstream" edge to the CoreUpstream entity.
+func (_u *CoreGatewayHttpRouteUpdate) SetRouteFromUpstream(v *CoreUpstream) *CoreGatewayHttpRouteUpdate {
+	return _u.SetRouteFromUpstreamID(v.ID)
+}
+
+// AddRouteToTargetIDs adds the "route_to_target" edge to the CoreGatewayHttpRouteTarget entity by IDs.
+func (_u *CoreGatewayHttpRouteUpdate) AddRouteToTargetIDs(ids ...string) *CoreGatewayHttpRouteUpdate {
+	_u.mutation.AddRouteToTargetIDs(ids...)
+	return _u
+}
+
+// AddRouteToTarget adds the "route_to_target" edges to the CoreGatewayHttpRouteTarget entity.
+func (_u *CoreGatewayHttpRouteUpdate) AddRouteToTarget(v ...*CoreGatewayHttpRouteTarget) *CoreGatewayHttpRouteUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddRouteToTargetIDs(ids...)
+}
+
+// SetRouteFromVhostID sets the "route_from_vhost" edge to the CoreGatewayVirtualHost entity by ID.
+func (_u *CoreGatewayHttpRouteUpdate) SetRouteFromVhostID(id string) *CoreGatewayHttpRouteUpdate {
+	_u.mutation.SetRouteFromVhostID(id)
+	return _u
+}
+
+// SetNillableRouteFromVhostID sets the "route_from_vhost" edge to the CoreGatewayVirtualHost entity by ID if the given value is not nil.
+func (_u *CoreGatewayHttpRouteUpdate) SetNillableRouteFromVhostID(id *string) *CoreGatewayHttpRouteUpdate {
+	if id != nil {
+		_u = _u.SetRouteFromVhostID(*id)
+	}
+	return _u
+}
+
+// SetRouteFromVhost sets the "route_from_vhost" edge to the CoreGatewayVirtualHost entity.
+func (_u *CoreGatewayHttpRouteUpdate) SetRouteFromVhost(v *CoreGatewayVirtualHost) *CoreGatewayHttpRouteUpdate {
+	return _u.SetRouteFromVhostID(v.ID)
+}
+
 // Mutation returns the CoreGatewayHttpRouteMutation object of the builder.
 func (_u *CoreGatewayHttpRouteUpdate) Mutation() *CoreGatewayHttpRouteMutation {
 	return _u.mutation
 }
 
+// ClearRouteFromUpstream clears the "route_from_upstream" edge to the CoreUpstream entity.
+func (_u *CoreGatewayHttpRouteUpdate) ClearRouteFromUpstream() *CoreGatewayHttpRouteUpdate {
+	_u.mutation.ClearRouteFromUpstream()
+	return _u
+}
+
+// ClearRouteToTarget clears all "route_to_target" edges to the CoreGatewayHttpRouteTarget entity.
+func (_u *CoreGatewayHttpRouteUpdate) ClearRouteToTarget() *CoreGatewayHttpRouteUpdate {
+	_u.mutation.ClearRouteToTarget()
+	return _u
+}
+
+// RemoveRouteToTargetIDs removes the "route_to_target" edge to CoreGatewayHttpRouteTarget entities by IDs.
+func (_u *CoreGatewayHttpRouteUpdate) RemoveRouteToTargetIDs(ids ...string) *CoreGatewayHttpRouteUpdate {
+	_u.mutation.RemoveRouteToTargetIDs(ids...)
+	return _u
+}
+
+// RemoveRouteToTarget removes "route_to_target" edges to CoreGatewayHttpRouteTarget entities.
+func (_u *CoreGatewayHttpRouteUpdate) RemoveRouteToTarget(v ...*CoreGatewayHttpRouteTarget) *CoreGatewayHttpRouteUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveRouteToTargetIDs(ids...)
+}
+
+// ClearRouteFromVhost clears the "route_from_vhost" edge to the CoreGatewayVirtualHost entity.
+func (_u *CoreGatewayHttpRouteUpdate) ClearRouteFromVhost() *CoreGatewayHttpRouteUpdate {
+	_u.mutation.ClearRouteFromVhost()
+	return _u
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *CoreGatewayHttpRouteUpdate) Save(ctx context.Context) (int, error) {
 	if err := _u.defaults(); err != nil {
@@ -401,6 +842,12 @@ func (_u *CoreGatewayHttpRouteUpdate) sqlSave(ctx context.Context) (_node int, e
 	if _u.mutation.DescriptionCleared() {
 		_spec.ClearField(coregatewayhttproute.FieldDescription, field.TypeString)
 	}
+	if value, ok := _u.mutation.ClusterID(); ok {
+		_spec.SetField(coregatewayhttproute.FieldClusterID, field.TypeString, value)
+	}
+	if _u.mutation.ClusterIDCleared() {
+		_spec.ClearField(coregatewayhttproute.FieldClusterID, field.TypeString)
+	}
 	if value, ok := _u.mutation.MatchType(); ok {
 		_spec.SetField(coregatewayhttproute.FieldMatchType, field.TypeInt8, value)
 	}
@@ -416,6 +863,57 @@ func (_u *CoreGatewayHttpRouteUpdate) sqlSave(ctx context.Context) (_node int, e
 	if _u.mutation.MatchPatternCleared() {
 		_spec.ClearField(coregatewayhttproute.FieldMatchPattern, field.TypeString)
 	}
+	if value, ok := _u.mutation.Priority(); ok {
+		_spec.SetField(coregatewayhttproute.FieldPriority, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPriority(); ok {
+		_spec.AddField(coregatewayhttproute.FieldPriority, field.TypeInt, value)
+	}
+	if _u.mutation.PriorityCleared() {
+		_spec.ClearField(coregatewayhttproute.FieldPriority, field.TypeInt)
+	}
+	if value, ok := _u.mutation.IgnoreCase(); ok {
+		_spec.SetField(coregatewayhttproute.FieldIgnoreCase, field.TypeInt8, value)
+	}
+	if value, ok := _u.mutation.AddedIgnoreCase(); ok {
+		_spec.AddField(coregatewayhttproute.FieldIgnoreCase, field.TypeInt8, value)
+	}
+	if _u.mutation.IgnoreCaseCleared() {
+		_spec.ClearField(coregatewayhttproute.FieldIgnoreCase, field.TypeInt8)
+	}
+	if value, ok := _u.mutation.Methods(); ok {
+		_spec.SetField(coregatewayhttproute.FieldMethods, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedMethods(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, coregatewayhttproute.FieldMethods, value)
+		})
+	}
+	if _u.mutation.MethodsCleared() {
+		_spec.ClearField(coregatewayhttproute.FieldMethods, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.HeaderMatchers(); ok {
+		_spec.SetField(coregatewayhttproute.FieldHeaderMatchers, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedHeaderMatchers(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, coregatewayhttproute.FieldHeaderMatchers, value)
+		})
+	}
+	if _u.mutation.HeaderMatchersCleared() {
+		_spec.ClearField(coregatewayhttproute.FieldHeaderMatchers, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.QueryMatchers(); ok {
+		_spec.SetField(coregatewayhttproute.FieldQueryMatchers, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedQueryMatchers(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, coregatewayhttproute.FieldQueryMatchers, value)
+		})
+	}
+	if _u.mutation.QueryMatchersCleared() {
+		_spec.ClearField(coregatewayhttproute.FieldQueryMatchers, field.TypeJSON)
+	}
 	if value, ok := _u.mutation.TimeoutMs(); ok {
 		_spec.SetField(coregatewayhttproute.FieldTimeoutMs, field.TypeInt, value)
 	}
@@ -425,6 +923,88 @@ func (_u *CoreGatewayHttpRouteUpdate) sqlSave(ctx context.Context) (_node int, e
 	if _u.mutation.TimeoutMsCleared() {
 		_spec.ClearField(coregatewayhttproute.FieldTimeoutMs, field.TypeInt)
 	}
+	if value, ok := _u.mutation.RetryPolicy(); ok {
+		_spec.SetField(coregatewayhttproute.FieldRetryPolicy, field.TypeJSON, value)
+	}
+	if _u.mutation.RetryPolicyCleared() {
+		_spec.ClearField(coregatewayhttproute.FieldRetryPolicy, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.HeaderMutation(); ok {
+		_spec.SetField(coregatewayhttproute.FieldHeaderMutation, field.TypeJSON, value)
+	}
+	if _u.mutation.HeaderMutationCleared() {
+		_spec.ClearField(coregatewayhttproute.FieldHeaderMutation, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.MirrorUpstreamID(); ok {
+		_spec.SetField(coregatewayhttproute.FieldMirrorUpstreamID, field.TypeString, value)
+	}
+	if _u.mutation.MirrorUpstreamIDCleared() {
+		_spec.ClearField(coregatewayhttproute.FieldMirrorUpstreamID, field.TypeString)
+	}
+	if value, ok := _u.mutation.MirrorPercent(); ok {
+		_spec.SetField(coregatewayhttproute.FieldMirrorPercent, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedMirrorPercent(); ok {
+		_spec.AddField(coregatewayhttproute.FieldMirrorPercent, field.TypeFloat64, value)
+	}
+	if _u.mutation.MirrorPercentCleared() {
+		_spec.ClearField(coregatewayhttproute.FieldMirrorPercent, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.FaultPolicy(); ok {
+		_spec.SetField(coregatewayhttproute.FieldFaultPolicy, field.TypeJSON, value)
+	}
+	if _u.mutation.FaultPolicyCleared() {
+		_spec.ClearField(coregatewayhttproute.FieldFaultPolicy, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.CorsPolicy(); ok {
+		_spec.SetField(coregatewayhttproute.FieldCorsPolicy, field.TypeJSON, value)
+	}
+	if _u.mutation.CorsPolicyCleared() {
+		_spec.ClearField(coregatewayhttproute.FieldCorsPolicy, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.LocalRateLimit(); ok {
+		_spec.SetField(coregatewayhttproute.FieldLocalRateLimit, field.TypeJSON, value)
+	}
+	if _u.mutation.LocalRateLimitCleared() {
+		_spec.ClearField(coregatewayhttproute.FieldLocalRateLimit, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.RateLimitActions(); ok {
+		_spec.SetField(coregatewayhttproute.FieldRateLimitActions, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedRateLimitActions(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, coregatewayhttproute.FieldRateLimitActions, value)
+		})
+	}
+	if _u.mutation.RateLimitActionsCleared() {
+		_spec.ClearField(coregatewayhttproute.FieldRateLimitActions, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.DirectResponse(); ok {
+		_spec.SetField(coregatewayhttproute.FieldDirectResponse, field.TypeJSON, value)
+	}
+	if _u.mutation.DirectResponseCleared() {
+		_spec.ClearField(coregatewayhttproute.FieldDirectResponse, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.HashPolicies(); ok {
+		_spec.SetField(coregatewayhttproute.FieldHashPolicies, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedHashPolicies(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, coregatewayhttproute.FieldHashPolicies, value)
+		})
+	}
+	if _u.mutation.HashPoliciesCleared() {
+		_spec.ClearField(coregatewayhttproute.FieldHashPolicies, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.DisableCompression(); ok {
+		_spec.SetField(coregatewayhttproute.FieldDisableCompression, field.TypeInt8, value)
+	}
+	if value, ok := _u.mutation.AddedDisableCompression(); ok {
+		_spec.AddField(coregatewayhttproute.FieldDisableCompression, field.TypeInt8, value)
+	}
+	if _u.mutation.DisableCompressionCleared() {
+		_spec.ClearField(coregatewayhttproute.FieldDisableCompression, field.TypeInt8)
+	}
 	if value, ok := _u.mutation.EnablePathRewrite(); ok {
 		_spec.SetField(coregatewayhttproute.FieldEnablePathRewrite, field.TypeInt8, value)
 	}
@@ -473,6 +1053,109 @@ func (_u *CoreGatewayHttpRouteUpdate) sqlSave(ctx context.Context) (_node int, e
 	if _u.mutation.StatusCleared() {
 		_spec.ClearField(coregatewayhttproute.FieldStatus, field.TypeInt8)
 	}
+	if _u.mutation.RouteFromUpstreamCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   coregatewayhttproute.RouteFromUpstreamTable,
+			Columns: []string{coregatewayhttproute.RouteFromUpstreamColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(coreupstream.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RouteFromUpstreamIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   coregatewayhttproute.RouteFromUpstreamTable,
+			Columns: []string{coregatewayhttproute.RouteFromUpstreamColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(coreupstream.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.RouteToTargetCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   coregatewayhttproute.RouteToTargetTable,
+			Columns: []string{coregatewayhttproute.RouteToTargetColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(coregatewayhttproutetarget.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedRouteToTargetIDs(); len(nodes) > 0 && !_u.mutation.RouteToTargetCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   coregatewayhttproute.RouteToTargetTable,
+			Columns: []string{coregatewayhttproute.RouteToTargetColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(coregatewayhttproutetarget.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RouteToTargetIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   coregatewayhttproute.RouteToTargetTable,
+			Columns: []string{coregatewayhttproute.RouteToTargetColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(coregatewayhttproutetarget.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.RouteFromVhostCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   coregatewayhttproute.RouteFromVhostTable,
+			Columns: []string{coregatewayhttproute.RouteFromVhostColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(coregatewayvirtualhost.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RouteFromVhostIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   coregatewayhttproute.RouteFromVhostTable,
+			Columns: []string{coregatewayhttproute.RouteFromVhostColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(coregatewayvirtualhost.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
@@ -530,34 +1213,94 @@ func (_u *CoreGatewayHttpRouteUpdateOne) SetName(v string) *CoreGatewayHttpRoute
 // SetNillableName sets the "name" field if the given value is not nil.
 func (_u *CoreGatewayHttpRouteUpdateOne) SetNillableName(v *string) *CoreGatewayHttpRouteUpdateOne {
 	if v != nil {
-		_u.SetName(*v)
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// ClearName clears the value of the "name" field.
+func (_u *CoreGatewayHttpRouteUpdateOne) ClearName() *CoreGatewayHttpRouteUpdateOne {
+	_u.mutation.ClearName()
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *CoreGatewayHttpRouteUpdateOne) SetDescription(v string) *CoreGatewayHttpRouteUpdateOne {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *CoreGatewayHttpRouteUpdateOne) SetNillableDescription(v *string) *CoreGatewayHttpRouteUpdateOne {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *CoreGatewayHttpRouteUpdateOne) ClearDescription() *CoreGatewayHttpRouteUpdateOne {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetUpstreamID sets the "upstream_id" field.
+func (_u *CoreGatewayHttpRouteUpdateOne) SetUpstreamID(v string) *CoreGatewayHttpRouteUpdateOne {
+	_u.mutation.SetUpstreamID(v)
+	return _u
+}
+
+// SetNillableUpstreamID sets the "upstream_id" field if the given value is not nil.
+func (_u *CoreGatewayHttpRouteUpdateOne) SetNillableUpstreamID(v *string) *CoreGatewayHttpRouteUpdateOne {
+	if v != nil {
+		_u.SetUpstreamID(*v)
+	}
+	return _u
+}
+
+// ClearUpstreamID clears the value of the "upstream_id" field.
+func (_u *CoreGatewayHttpRouteUpdateOne) ClearUpstreamID() *CoreGatewayHttpRouteUpdateOne {
+	_u.mutation.ClearUpstreamID()
+	return _u
+}
+
+// SetClusterID sets the "cluster_id" field.
+func (_u *CoreGatewayHttpRouteUpdateOne) SetClusterID(v string) *CoreGatewayHttpRouteUpdateOne {
+	_u.mutation.SetClusterID(v)
+	return _u
+}
+
+// SetNillableClusterID sets the "cluster_id" field if the given value is not nil.
+func (_u *CoreGatewayHttpRouteUpdateOne) SetNillableClusterID(v *string) *CoreGatewayHttpRouteUpdateOne {
+	if v != nil {
+		_u.SetClusterID(*v)
 	}
 	return _u
 }
 
-// ClearName clears the value of the "name" field.
-func (_u *CoreGatewayHttpRouteUpdateOne) ClearName() *CoreGatewayHttpRouteUpdateOne {
-	_u.mutation.ClearName()
+// ClearClusterID clears the value of the "cluster_id" field.
+func (_u *CoreGatewayHttpRouteUpdateOne) ClearClusterID() *CoreGatewayHttpRouteUpdateOne {
+	_u.mutation.ClearClusterID()
 	return _u
 }
 
-// SetDescription sets the "description" field.
-func (_u *CoreGatewayHttpRouteUpdateOne) SetDescription(v string) *CoreGatewayHttpRouteUpdateOne {
-	_u.mutation.SetDescription(v)
+// SetVirtualHostID sets the "virtual_host_id" field.
+func (_u *CoreGatewayHttpRouteUpdateOne) SetVirtualHostID(v string) *CoreGatewayHttpRouteUpdateOne {
+	_u.mutation.SetVirtualHostID(v)
 	return _u
 }
 
-// SetNillableDescription sets the "description" field if the given value is not nil.
-func (_u *CoreGatewayHttpRouteUpdateOne) SetNillableDescription(v *string) *CoreGatewayHttpRouteUpdateOne {
+// SetNillableVirtualHostID sets the "virtual_host_id" field if the given value is not nil.
+func (_u *CoreGatewayHttpRouteUpdateOne) SetNillableVirtualHostID(v *string) *CoreGatewayHttpRouteUpdateOne {
 	if v != nil {
-		_u.SetDescription(*v)
+		_u.SetVirtualHostID(*v)
 	}
 	return _u
 }
 
-// ClearDescription clears the value of the "description" field.
-func (_u *CoreGatewayHttpRouteUpdateOne) ClearDescription() *CoreGatewayHttpRouteUpdateOne {
-	_u.mutation.ClearDescription()
+// ClearVirtualHostID clears the value of the "virtual_host_id" field.
+func (_u *CoreGatewayHttpRouteUpdateOne) ClearVirtualHostID() *CoreGatewayHttpRouteUpdateOne {
+	_u.mutation.ClearVirtualHostID()
 	return _u
 }
 
@@ -608,6 +1351,114 @@ func (_u *CoreGatewayHttpRouteUpdateOne) ClearMatchPattern() *CoreGatewayHttpRou
 	return _u
 }
 
+// SetPriority sets the "priority" field.
+func (_u *CoreGatewayHttpRouteUpdateOne) SetPriority(v int) *CoreGatewayHttpRouteUpdateOne {
+	_u.mutation.ResetPriority()
+	_u.mutation.SetPriority(v)
+	return _u
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (_u *CoreGatewayHttpRouteUpdateOne) SetNillablePriority(v *int) *CoreGatewayHttpRouteUpdateOne {
+	if v != nil {
+		_u.SetPriority(*v)
+	}
+	return _u
+}
+
+// AddPriority adds value to the "priority" field.
+func (_u *CoreGatewayHttpRouteUpdateOne) AddPriority(v int) *CoreGatewayHttpRouteUpdateOne {
+	_u.mutation.AddPriority(v)
+	return _u
+}
+
+// ClearPriority clears the value of the "priority" field.
+func (_u *CoreGatewayHttpRouteUpdateOne) ClearPriority() *CoreGatewayHttpRouteUpdateOne {
+	_u.mutation.ClearPriority()
+	return _u
+}
+
+// SetIgnoreCase sets the "ignore_case" field.
+func (_u *CoreGatewayHttpRouteUpdateOne) SetIgnoreCase(v constant.YesOrNo) *CoreGatewayHttpRouteUpdateOne {
+	_u.mutation.ResetIgnoreCase()
+	_u.mutation.SetIgnoreCase(v)
+	return _u
+}
+
+// SetNillableIgnoreCase sets the "ignore_case" field if the given value is not nil.
+func (_u *CoreGatewayHttpRouteUpdateOne) SetNillableIgnoreCase(v *constant.YesOrNo) *CoreGatewayHttpRouteUpdateOne {
+	if v != nil {
+		_u.SetIgnoreCase(*v)
+	}
+	return _u
+}
+
+// AddIgnoreCase adds value to the "ignore_case" field.
+func (_u *CoreGatewayHttpRouteUpdateOne) AddIgnoreCase(v constant.YesOrNo) *CoreGatewayHttpRouteUpdateOne {
+	_u.mutation.AddIgnoreCase(v)
+	return _u
+}
+
+// ClearIgnoreCase clears the value of the "ignore_case" field.
+func (_u *CoreGatewayHttpRouteUpdateOne) ClearIgnoreCase() *CoreGatewayHttpRouteUpdateOne {
+	_u.mutation.ClearIgnoreCase()
+	return _u
+}
+
+// SetMethods sets the "methods" field.
+func (_u *CoreGatewayHttpRouteUpdateOne) SetMethods(v []string) *CoreGatewayHttpRouteUpdateOne {
+	_u.mutation.SetMethods(v)
+	return _u
+}
+
+// AppendMethods appends value to the "methods" field.
+func (_u *CoreGatewayHttpRouteUpdateOne) AppendMethods(v []string) *CoreGatewayHttpRouteUpdateOne {
+	_u.mutation.AppendMethods(v)
+	return _u
+}
+
+// ClearMethods clears the value of the "methods" field.
+func (_u *CoreGatewayHttpRouteUpdateOne) ClearMethods() *CoreGatewayHttpRouteUpdateOne {
+	_u.mutation.ClearMethods()
+	return _u
+}
+
+// SetHeaderMatchers sets the "header_matchers" field.
+func (_u *CoreGatewayHttpRouteUpdateOne) SetHeaderMatchers(v []common.HttpMatcher) *CoreGatewayHttpRouteUpdateOne {
+	_u.mutation.SetHeaderMatchers(v)
+	return _u
+}
+
+// AppendHeaderMatchers appends value to the "header_matchers" field.
+func (_u *CoreGatewayHttpRouteUpdateOne) AppendHeaderMatchers(v []common.HttpMatcher) *CoreGatewayHttpRouteUpdateOne {
+	_u.mutation.AppendHeaderMatchers(v)
+	return _u
+}
+
+// ClearHeaderMatchers clears the value of the "header_matchers" field.
+func (_u *CoreGatewayHttpRouteUpdateOne) ClearHeaderMatchers() *CoreGatewayHttpRouteUpdateOne {
+	_u.mutation.ClearHeaderMatchers()
+	return _u
+}
+
+// SetQueryMatchers sets the "query_matchers" field.
+func (_u *CoreGatewayHttpRouteUpdateOne) SetQueryMatchers(v []common.HttpMatcher) *CoreGatewayHttpRouteUpdateOne {
+	_u.mutation.SetQueryMatchers(v)
+	return _u
+}
+
+// AppendQueryMatchers appends value to the "query_matchers" field.
+func (_u *CoreGatewayHttpRouteUpdateOne) AppendQueryMatchers(v []common.HttpMatcher) *CoreGatewayHttpRouteUpdateOne {
+	_u.mutation.AppendQueryMatchers(v)
+	return _u
+}
+
+// ClearQueryMatchers clears the value of the "query_matchers" field.
+func (_u *CoreGatewayHttpRouteUpdateOne) ClearQueryMatchers() *CoreGatewayHttpRouteUpdateOne {
+	_u.mutation.ClearQueryMatchers()
+	return _u
+}
+
 // SetTimeoutMs sets the "timeout_ms" field.
 func (_u *CoreGatewayHttpRouteUpdateOne) SetTimeoutMs(v int) *CoreGatewayHttpRouteUpdateOne {
 	_u.mutation.ResetTimeoutMs()
@@ -635,6 +1486,188 @@ func (_u *CoreGatewayHttpRouteUpdateOne) ClearTimeoutMs() *CoreGatewayHttpRouteU
 	return _u
 }
 
+// SetRetryPolicy sets the "retry_policy" field.
+func (_u *CoreGatewayHttpRouteUpdateOne) SetRetryPolicy(v *common.RetryPolicy) *CoreGatewayHttpRouteUpdateOne {
+	_u.mutation.SetRetryPolicy(v)
+	return _u
+}
+
+// ClearRetryPolicy clears the value of the "retry_policy" field.
+func (_u *CoreGatewayHttpRouteUpdateOne) ClearRetryPolicy() *CoreGatewayHttpRouteUpdateOne {
+	_u.mutation.ClearRetryPolicy()
+	return _u
+}
+
+// SetHeaderMutation sets the "header_mutation" field.
+func (_u *CoreGatewayHttpRouteUpdateOne) SetHeaderMutation(v *common.HeaderMutation) *CoreGatewayHttpRouteUpdateOne {
+	_u.mutation.SetHeaderMutation(v)
+	return _u
+}
+
+// ClearHeaderMutation clears the value of the "header_mutation" field.
+func (_u *CoreGatewayHttpRouteUpdateOne) ClearHeaderMutation() *CoreGatewayHttpRouteUpdateOne {
+	_u.mutation.ClearHeaderMutation()
+	return _u
+}
+
+// SetMirrorUpstreamID sets the "mirror_upstream_id" field.
+func (_u *CoreGatewayHttpRouteUpdateOne) SetMirrorUpstreamID(v string) *CoreGatewayHttpRouteUpdateOne {
+	_u.mutation.SetMirrorUpstreamID(v)
+	return _u
+}
+
+// SetNillableMirrorUpstreamID sets the "mirror_upstream_id" field if the given value is not nil.
+func (_u *CoreGatewayHttpRouteUpdateOne) SetNillableMirrorUpstreamID(v *string) *CoreGatewayHttpRouteUpdateOne {
+	if v != nil {
+		_u.SetMirrorUpstreamID(*v)
+	}
+	return _u
+}
+
+// ClearMirrorUpstreamID clears the value of the "mirror_upstream_id" field.
+func (_u *CoreGatewayHttpRouteUpdateOne) ClearMirrorUpstreamID() *CoreGatewayHttpRouteUpdateOne {
+	_u.mutation.ClearMirrorUpstreamID()
+	return _u
+}
+
+// SetMirrorPercent sets the "mirror_percent" field.
+func (_u *CoreGatewayHttpRouteUpdateOne) SetMirrorPercent(v float64) *CoreGatewayHttpRouteUpdateOne {
+	_u.mutation.ResetMirrorPercent()
+	_u.mutation.SetMirrorPercent(v)
+	return _u
+}
+
+// SetNillableMirrorPercent sets the "mirror_percent" field if the given value is not nil.
+func (_u *CoreGatewayHttpRouteUpdateOne) SetNillableMirrorPercent(v *float64) *CoreGatewayHttpRouteUpdateOne {
+	if v != nil {
+		_u.SetMirrorPercent(*v)
+	}
+	return _u
+}
+
+// AddMirrorPercent adds value to the "mirror_percent" field.
+func (_u *CoreGatewayHttpRouteUpdateOne) AddMirrorPercent(v float64) *CoreGatewayHttpRouteUpdateOne {
+	_u.mutation.AddMirrorPercent(v)
+	return _u
+}
+
+// ClearMirrorPercent clears the value of the "mirror_percent" field.
+func (_u *CoreGatewayHttpRouteUpdateOne) ClearMirrorPercent() *CoreGatewayHttpRouteUpdateOne {
+	_u.mutation.ClearMirrorPercent()
+	return _u
+}
+
+// SetFaultPolicy sets the "fault_policy" field.
+func (_u *CoreGatewayHttpRouteUpdateOne) SetFaultPolicy(v *common.FaultPolicy) *CoreGatewayHttpRouteUpdateOne {
+	_u.mutation.SetFaultPolicy(v)
+	return _u
+}
+
+// ClearFaultPolicy clears the value of the "fault_policy" field.
+func (_u *CoreGatewayHttpRouteUpdateOne) ClearFaultPolicy() *CoreGatewayHttpRouteUpdateOne {
+	_u.mutation.ClearFaultPolicy()
+	return _u
+}
+
+// SetCorsPolicy sets the "cors_policy" field.
+func (_u *CoreGatewayHttpRouteUpdateOne) SetCorsPolicy(v *common.CorsPolicy) *CoreGatewayHttpRouteUpdateOne {
+	_u.mutation.SetCorsPolicy(v)
+	return _u
+}
+
+// ClearCorsPolicy clears the value of the "cors_policy" field.
+func (_u *CoreGatewayHttpRouteUpdateOne) ClearCorsPolicy() *CoreGatewayHttpRouteUpdateOne {
+	_u.mutation.ClearCorsPolicy()
+	return _u
+}
+
+// SetLocalRateLimit sets the "local_rate_limit" field.
+func (_u *CoreGatewayHttpRouteUpdateOne) SetLocalRateLimit(v *common.LocalRateLimit) *CoreGatewayHttpRouteUpdateOne {
+	_u.mutation.SetLocalRateLimit(v)
+	return _u
+}
+
+// ClearLocalRateLimit clears the value of the "local_rate_limit" field.
+func (_u *CoreGatewayHttpRouteUpdateOne) ClearLocalRateLimit() *CoreGatewayHttpRouteUpdateOne {
+	_u.mutation.ClearLocalRateLimit()
+	return _u
+}
+
+// SetRateLimitActions sets the "rate_limit_actions" field.
+func (_u *CoreGatewayHttpRouteUpdateOne) SetRateLimitActions(v []common.RateLimitAction) *CoreGatewayHttpRouteUpdateOne {
+	_u.mutation.SetRateLimitActions(v)
+	return _u
+}
+
+// AppendRateLimitActions appends value to the "rate_limit_actions" field.
+func (_u *CoreGatewayHttpRouteUpdateOne) AppendRateLimitActions(v []common.RateLimitAction) *CoreGatewayHttpRouteUpdateOne {
+	_u.mutation.AppendRateLimitActions(v)
+	return _u
+}
+
+// ClearRateLimitActions clears the value of the "rate_limit_actions" field.
+func (_u *CoreGatewayHttpRouteUpdateOne) ClearRateLimitActions() *CoreGatewayHttpRouteUpdateOne {
+	_u.mutation.ClearRateLimitActions()
+	return _u
+}
+
+// SetDirectResponse sets the "direct_response" field.
+func (_u *CoreGatewayHttpRouteUpdateOne) SetDirectResponse(v *common.DirectResponse) *CoreGatewayHttpRouteUpdateOne {
+	_u.mutation.SetDirectResponse(v)
+	return _u
+}
+
+// ClearDirectResponse clears the value of the "direct_response" field.
+func (_u *CoreGatewayHttpRouteUpdateOne) ClearDirectResponse() *CoreGatewayHttpRouteUpdateOne {
+	_u.mutation.ClearDirectResponse()
+	return _u
+}
+
+// SetHashPolicies sets the "hash_policies" field.
+func (_u *CoreGatewayHttpRouteUpdateOne) SetHashPolicies(v []common.HashPolicy) *CoreGatewayHttpRouteUpdateOne {
+	_u.mutation.SetHashPolicies(v)
+	return _u
+}
+
+// AppendHashPolicies appends value to the "hash_policies" field.
+func (_u *CoreGatewayHttpRouteUpdateOne) AppendHashPolicies(v []common.HashPolicy) *CoreGatewayHttpRouteUpdateOne {
+	_u.mutation.AppendHashPolicies(v)
+	return _u
+}
+
+// ClearHashPolicies clears the value of the "hash_policies" field.
+func (_u *CoreGatewayHttpRouteUpdateOne) ClearHashPolicies() *CoreGatewayHttpRouteUpdateOne {
+	_u.mutation.ClearHashPolicies()
+	return _u
+}
+
+// SetDisableCompression sets the "disable_compression" field.
+func (_u *CoreGatewayHttpRouteUpdateOne) SetDisableCompression(v constant.YesOrNo) *CoreGatewayHttpRouteUpdateOne {
+	_u.mutation.ResetDisableCompression()
+	_u.mutation.SetDisableCompression(v)
+	return _u
+}
+
+// SetNillableDisableCompression sets the "disable_compression" field if the given value is not nil.
+func (_u *CoreGatewayHttpRouteUpdateOne) SetNillableDisableCompression(v *constant.YesOrNo) *CoreGatewayHttpRouteUpdateOne {
+	if v != nil {
+		_u.SetDisableCompression(*v)
+	}
+	return _u
+}
+
+// AddDisableCompression adds value to the "disable_compression" field.
+func (_u *CoreGatewayHttpRouteUpdateOne) AddDisableCompression(v constant.YesOrNo) *CoreGatewayHttpRouteUpdateOne {
+	_u.mutation.AddDisableCompression(v)
+	return _u
+}
+
+// ClearDisableCompression clears the value of the "disable_compression" field.
+func (_u *CoreGatewayHttpRouteUpdateOne) ClearDisableCompression() *CoreGatewayHttpRouteUpdateOne {
+	_u.mutation.ClearDisableCompression()
+	return _u
+}
+
 // SetEnablePathRewrite sets the "enable_path_rewrite" field.
 func (_u *CoreGatewayHttpRouteUpdateOne) SetEnablePathRewrite(v constant.YesOrNo) *CoreGatewayHttpRouteUpdateOne {
 	_u.mutation.ResetEnablePathRewrite()
@@ -783,11 +1816,97 @@ func (_u *CoreGatewayHttpRouteUpdateOne) ClearStatus() *CoreGatewayHttpRouteUpda
 	return _u
 }
 
+// SetRouteFromUpstreamID sets the "route_from_upstream" edge to the CoreUpstream entity by ID.
+func (_u *CoreGatewayHttpRouteUpdateOne) SetRouteFromUpstreamID(id string) *CoreGatewayHttpRouteUpdateOne {
+	_u.mutation.SetRouteFromUpstreamID(id)
+	return _u
+}
+
+// SetNillableRouteFromUpstreamID sets the "route_from_upstream" edge to the CoreUpstream entity by ID if the given value is not nil.
+func (_u *CoreGatewayHttpRouteUpdateOne) SetNillableRouteFromUpstreamID(id *string) *CoreGatewayHttpRouteUpdateOne {
+	if id != nil {
+		_u = _u.SetRouteFromUpstreamID(*id)
+	}
+	return _u
+}
+
+// SetRouteFromUpstream sets the "route_from_upstream" edge to the CoreUpstream entity.
+func (_u *CoreGatewayHttpRouteUpdateOne) SetRouteFromUpstream(v *CoreUpstream) *CoreGatewayHttpRouteUpdateOne {
+	return _u.SetRouteFromUpstreamID(v.ID)
+}
+
+// AddRouteToTargetIDs adds the "route_to_target" edge to the CoreGatewayHttpRouteTarget entity by IDs.
+func (_u *CoreGatewayHttpRouteUpdateOne) AddRouteToTargetIDs(ids ...string) *CoreGatewayHttpRouteUpdateOne {
+	_u.mutation.AddRouteToTargetIDs(ids...)
+	return _u
+}
+
+// AddRouteToTarget adds the "route_to_target" edges to the CoreGatewayHttpRouteTarget entity.
+func (_u *CoreGatewayHttpRouteUpdateOne) AddRouteToTarget(v ...*CoreGatewayHttpRouteTarget) *CoreGatewayHttpRouteUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddRouteToTargetIDs(ids...)
+}
+
+// SetRouteFromVhostID sets the "route_from_vhost" edge to the CoreGatewayVirtualHost entity by ID.
+func (_u *CoreGatewayHttpRouteUpdateOne) SetRouteFromVhostID(id string) *CoreGatewayHttpRouteUpdateOne {
+	_u.mutation.SetRouteFromVhostID(id)
+	return _u
+}
+
+// SetNillableRouteFromVhostID sets the "route_from_vhost" edge to the CoreGatewayVirtualHost entity by ID if the given value is not nil.
+func (_u *CoreGatewayHttpRouteUpdateOne) SetNillableRouteFromVhostID(id *string) *CoreGatewayHttpRouteUpdateOne {
+	if id != nil {
+		_u = _u.SetRouteFromVhostID(*id)
+	}
+	return _u
+}
+
+// SetRouteFromVhost sets the "route_from_vhost" edge to the CoreGatewayVirtualHost entity.
+func (_u *CoreGatewayHttpRouteUpdateOne) SetRouteFromVhost(v *CoreGatewayVirtualHost) *CoreGatewayHttpRouteUpdateOne {
+	return _u.SetRouteFromVhostID(v.ID)
+}
+
 // Mutation returns the CoreGatewayHttpRouteMutation object of the builder.
 func (_u *CoreGatewayHttpRouteUpdateOne) Mutation() *CoreGatewayHttpRouteMutation {
 	return _u.mutation
 }
 
+// ClearRouteFromUpstream clears the "route_from_upstream" edge to the CoreUpstream entity.
+func (_u *CoreGatewayHttpRouteUpdateOne) ClearRouteFromUpstream() *CoreGatewayHttpRouteUpdateOne {
+	_u.mutation.ClearRouteFromUpstream()
+	return _u
+}
+
+// ClearRouteToTarget clears all "route_to_target" edges to the CoreGatewayHttpRouteTarget entity.
+func (_u *CoreGatewayHttpRouteUpdateOne) ClearRouteToTarget() *CoreGatewayHttpRouteUpdateOne {
+	_u.mutation.ClearRouteToTarget()
+	return _u
+}
+
+// RemoveRouteToTargetIDs removes the "route_to_target" edge to CoreGatewayHttpRouteTarget entities by IDs.
+func (_u *CoreGatewayHttpRouteUpdateOne) RemoveRouteToTargetIDs(ids ...string) *CoreGatewayHttpRouteUpdateOne {
+	_u.mutation.RemoveRouteToTargetIDs(ids...)
+	return _u
+}
+
+// RemoveRouteToTarget removes "route_to_target" edges to CoreGatewayHttpRouteTarget entities.
+func (_u *CoreGatewayHttpRouteUpdateOne) RemoveRouteToTarget(v ...*CoreGatewayHttpRouteTarget) *CoreGatewayHttpRouteUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveRouteToTargetIDs(ids...)
+}
+
+// ClearRouteFromVhost clears the "route_from_vhost" edge to the CoreGatewayVirtualHost entity.
+func (_u *CoreGatewayHttpRouteUpdateOne) ClearRouteFromVhost() *CoreGatewayHttpRouteUpdateOne {
+	_u.mutation.ClearRouteFromVhost()
+	return _u
+}
+
 // Where appends a list predicates to the CoreGatewayHttpRouteUpdate builder.
 func (_u *CoreGatewayHttpRouteUpdateOne) Where(ps ...predicate.CoreGatewayHttpRoute) *CoreGatewayHttpRouteUpdateOne {
 	_u.mutation.Where(ps...)
@@ -896,6 +2015,12 @@ func (_u *CoreGatewayHttpRouteUpdateOne) sqlSave(ctx context.Context) (_node *Co
 	if _u.mutation.DescriptionCleared() {
 		_spec.ClearField(coregatewayhttproute.FieldDescription, field.TypeString)
 	}
+	if value, ok := _u.mutation.ClusterID(); ok {
+		_spec.SetField(coregatewayhttproute.FieldClusterID, field.TypeString, value)
+	}
+	if _u.mutation.ClusterIDCleared() {
+		_spec.ClearField(coregatewayhttproute.FieldClusterID, field.TypeString)
+	}
 	if value, ok := _u.mutation.MatchType(); ok {
 		_spec.SetField(coregatewayhttproute.FieldMatchType, field.TypeInt8, value)
 	}
@@ -911,6 +2036,57 @@ func (_u *CoreGatewayHttpRouteUpdateOne) sqlSave(ctx context.Context) (_node *Co
 	if _u.mutation.MatchPatternCleared() {
 		_spec.ClearField(coregatewayhttproute.FieldMatchPattern, field.TypeString)
 	}
+	if value, ok := _u.mutation.Priority(); ok {
+		_spec.SetField(coregatewayhttproute.FieldPriority, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPriority(); ok {
+		_spec.AddField(coregatewayhttproute.FieldPriority, field.TypeInt, value)
+	}
+	if _u.mutation.PriorityCleared() {
+		_spec.ClearField(coregatewayhttproute.FieldPriority, field.TypeInt)
+	}
+	if value, ok := _u.mutation.IgnoreCase(); ok {
+		_spec.SetField(coregatewayhttproute.FieldIgnoreCase, field.TypeInt8, value)
+	}
+	if value, ok := _u.mutation.AddedIgnoreCase(); ok {
+		_spec.AddField(coregatewayhttproute.FieldIgnoreCase, field.TypeInt8, value)
+	}
+	if _u.mutation.IgnoreCaseCleared() {
+		_spec.ClearField(coregatewayhttproute.FieldIgnoreCase, field.TypeInt8)
+	}
+	if value, ok := _u.mutation.Methods(); ok {
+		_spec.SetField(coregatewayhttproute.FieldMethods, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedMethods(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, coregatewayhttproute.FieldMethods, value)
+		})
+	}
+	if _u.mutation.MethodsCleared() {
+		_spec.ClearField(coregatewayhttproute.FieldMethods, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.HeaderMatchers(); ok {
+		_spec.SetField(coregatewayhttproute.FieldHeaderMatchers, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedHeaderMatchers(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, coregatewayhttproute.FieldHeaderMatchers, value)
+		})
+	}
+	if _u.mutation.HeaderMatchersCleared() {
+		_spec.ClearField(coregatewayhttproute.FieldHeaderMatchers, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.QueryMatchers(); ok {
+		_spec.SetField(coregatewayhttproute.FieldQueryMatchers, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedQueryMatchers(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, coregatewayhttproute.FieldQueryMatchers, value)
+		})
+	}
+	if _u.mutation.QueryMatchersCleared() {
+		_spec.ClearField(coregatewayhttproute.FieldQueryMatchers, field.TypeJSON)
+	}
 	if value, ok := _u.mutation.TimeoutMs(); ok {
 		_spec.SetField(coregatewayhttproute.FieldTimeoutMs, field.TypeInt, value)
 	}
@@ -920,6 +2096,88 @@ func (_u *CoreGatewayHttpRouteUpdateOne) sqlSave(ctx context.Context) (_node *Co
 	if _u.mutation.TimeoutMsCleared() {
 		_spec.ClearField(coregatewayhttproute.FieldTimeoutMs, field.TypeInt)
 	}
+	if value, ok := _u.mutation.RetryPolicy(); ok {
+		_spec.SetField(coregatewayhttproute.FieldRetryPolicy, field.TypeJSON, value)
+	}
+	if _u.mutation.RetryPolicyCleared() {
+		_spec.ClearField(coregatewayhttproute.FieldRetryPolicy, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.HeaderMutation(); ok {
+		_spec.SetField(coregatewayhttproute.FieldHeaderMutation, field.TypeJSON, value)
+	}
+	if _u.mutation.HeaderMutationCleared() {
+		_spec.ClearField(coregatewayhttproute.FieldHeaderMutation, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.MirrorUpstreamID(); ok {
+		_spec.SetField(coregatewayhttproute.FieldMirrorUpstreamID, field.TypeString, value)
+	}
+	if _u.mutation.MirrorUpstreamIDCleared() {
+		_spec.ClearField(coregatewayhttproute.FieldMirrorUpstreamID, field.TypeString)
+	}
+	if value, ok := _u.mutation.MirrorPercent(); ok {
+		_spec.SetField(coregatewayhttproute.FieldMirrorPercent, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedMirrorPercent(); ok {
+		_spec.AddField(coregatewayhttproute.FieldMirrorPercent, field.TypeFloat64, value)
+	}
+	if _u.mutation.MirrorPercentCleared() {
+		_spec.ClearField(coregatewayhttproute.FieldMirrorPercent, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.FaultPolicy(); ok {
+		_spec.SetField(coregatewayhttproute.FieldFaultPolicy, field.TypeJSON, value)
+	}
+	if _u.mutation.FaultPolicyCleared() {
+		_spec.ClearField(coregatewayhttproute.FieldFaultPolicy, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.CorsPolicy(); ok {
+		_spec.SetField(coregatewayhttproute.FieldCorsPolicy, field.TypeJSON, value)
+	}
+	if _u.mutation.CorsPolicyCleared() {
+		_spec.ClearField(coregatewayhttproute.FieldCorsPolicy, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.LocalRateLimit(); ok {
+		_spec.SetField(coregatewayhttproute.FieldLocalRateLimit, field.TypeJSON, value)
+	}
+	if _u.mutation.LocalRateLimitCleared() {
+		_spec.ClearField(coregatewayhttproute.FieldLocalRateLimit, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.RateLimitActions(); ok {
+		_spec.SetField(coregatewayhttproute.FieldRateLimitActions, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedRateLimitActions(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, coregatewayhttproute.FieldRateLimitActions, value)
+		})
+	}
+	if _u.mutation.RateLimitActionsCleared() {
+		_spec.ClearField(coregatewayhttproute.FieldRateLimitActions, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.DirectResponse(); ok {
+		_spec.SetField(coregatewayhttproute.FieldDirectResponse, field.TypeJSON, value)
+	}
+	if _u.mutation.DirectResponseCleared() {
+		_spec.ClearField(coregatewayhttproute.FieldDirectResponse, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.HashPolicies(); ok {
+		_spec.SetField(coregatewayhttproute.FieldHashPolicies, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedHashPolicies(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, coregatewayhttproute.FieldHashPolicies, value)
+		})
+	}
+	if _u.mutation.HashPoliciesCleared() {
+		_spec.ClearField(coregatewayhttproute.FieldHashPolicies, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.DisableCompression(); ok {
+		_spec.SetField(coregatewayhttproute.FieldDisableCompression, field.TypeInt8, value)
+	}
+	if value, ok := _u.mutation.AddedDisableCompression(); ok {
+		_spec.AddField(coregatewayhttproute.FieldDisableCompression, field.TypeInt8, value)
+	}
+	if _u.mutation.DisableCompressionCleared() {
+		_spec.ClearField(coregatewayhttproute.FieldDisableCompression, field.TypeInt8)
+	}
 	if value, ok := _u.mutation.EnablePathRewrite(); ok {
 		_spec.SetField(coregatewayhttproute.FieldEnablePathRewrite, field.TypeInt8, value)
 	}
@@ -968,6 +2226,109 @@ func (_u *CoreGatewayHttpRouteUpdateOne) sqlSave(ctx context.Context) (_node *Co
 	if _u.mutation.StatusCleared() {
 		_spec.ClearField(coregatewayhttproute.FieldStatus, field.TypeInt8)
 	}
+	if _u.mutation.RouteFromUpstreamCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   coregatewayhttproute.RouteFromUpstreamTable,
+			Columns: []string{coregatewayhttproute.RouteFromUpstreamColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(coreupstream.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RouteFromUpstreamIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   coregatewayhttproute.RouteFromUpstreamTable,
+			Columns: []string{coregatewayhttproute.RouteFromUpstreamColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(coreupstream.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.RouteToTargetCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   coregatewayhttproute.RouteToTargetTable,
+			Columns: []string{coregatewayhttproute.RouteToTargetColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(coregatewayhttproutetarget.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedRouteToTargetIDs(); len(nodes) > 0 && !_u.mutation.RouteToTargetCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   coregatewayhttproute.RouteToTargetTable,
+			Columns: []string{coregatewayhttproute.RouteToTargetColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(coregatewayhttproutetarget.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RouteToTargetIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   coregatewayhttproute.RouteToTargetTable,
+			Columns: []string{coregatewayhttproute.RouteToTargetColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(coregatewayhttproutetarget.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.RouteFromVhostCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   coregatewayhttproute.RouteFromVhostTable,
+			Columns: []string{coregatewayhttproute.RouteFromVhostColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(coregatewayvirtualhost.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RouteFromVhostIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   coregatewayhttproute.RouteFromVhostTable,
+			Columns: []string{coregatewayhttproute.RouteFromVhostColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(coregatewayvirtualhost.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.AddModifiers(_u.modifiers...)
 	_node = &CoreGatewayHttpRoute{config: _u.config}
 	_spec.Assign = _node.assignValues
@@ -0,0 +1,213 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/coregatewayhttproute"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/coregatewayhttproutetarget"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/coreupstream"
+)
+
+// HTTP路由加权分流目标表
+type CoreGatewayHttpRouteTarget struct {
+	config `json:"-"`
+	// ID of the ent.
+	// 主键ID
+	ID string `json:"id,omitempty"`
+	// 创建时间
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// 更新时间
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// 删除时间
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// HTTP路由ID
+	RouteID string `json:"route_id,omitempty"`
+	// 目标上游服务ID
+	UpstreamID string `json:"upstream_id,omitempty"`
+	// 流量权重(%)，同一路由的权重之和为100
+	Weight int `json:"weight,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the CoreGatewayHttpRouteTargetQuery when eager-loading is set.
+	Edges        CoreGatewayHttpRouteTargetEdges `json:"-" gorm:"-"`
+	selectValues sql.SelectValues
+}
+
+// CoreGatewayHttpRouteTargetEdges holds the relations/edges for other nodes in the graph.
+type CoreGatewayHttpRouteTargetEdges struct {
+	// TargetFromRoute holds the value of the target_from_route edge.
+	TargetFromRoute *CoreGatewayHttpRoute `json:"target_from_route,omitempty"`
+	// TargetFromUpstream holds the value of the target_from_upstream edge.
+	TargetFromUpstream *CoreUpstream `json:"target_from_upstream,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// TargetFromRouteOrErr returns the TargetFromRoute value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e CoreGatewayHttpRouteTargetEdges) TargetFromRouteOrErr() (*CoreGatewayHttpRoute, error) {
+	if e.TargetFromRoute != nil {
+		return e.TargetFromRoute, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: coregatewayhttproute.Label}
+	}
+	return nil, &NotLoadedError{edge: "target_from_route"}
+}
+
+// TargetFromUpstreamOrErr returns the TargetFromUpstream value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e CoreGatewayHttpRouteTargetEdges) TargetFromUpstreamOrErr() (*CoreUpstream, error) {
+	if e.TargetFromUpstream != nil {
+		return e.TargetFromUpstream, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: coreupstream.Label}
+	}
+	return nil, &NotLoadedError{edge: "target_from_upstream"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*CoreGatewayHttpRouteTarget) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case coregatewayhttproutetarget.FieldWeight:
+			values[i] = new(sql.NullInt64)
+		case coregatewayhttproutetarget.FieldID, coregatewayhttproutetarget.FieldRouteID, coregatewayhttproutetarget.FieldUpstreamID:
+			values[i] = new(sql.NullString)
+		case coregatewayhttproutetarget.FieldCreatedAt, coregatewayhttproutetarget.FieldUpdatedAt, coregatewayhttproutetarget.FieldDeletedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the CoreGatewayHttpRouteTarget fields.
+func (_m *CoreGatewayHttpRouteTarget) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case coregatewayhttproutetarget.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case coregatewayhttproutetarget.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case coregatewayhttproutetarget.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case coregatewayhttproutetarget.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				_m.DeletedAt = new(time.Time)
+				*_m.DeletedAt = value.Time
+			}
+		case coregatewayhttproutetarget.FieldRouteID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field route_id", values[i])
+			} else if value.Valid {
+				_m.RouteID = value.String
+			}
+		case coregatewayhttproutetarget.FieldUpstreamID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field upstream_id", values[i])
+			} else if value.Valid {
+				_m.UpstreamID = value.String
+			}
+		case coregatewayhttproutetarget.FieldWeight:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field weight", values[i])
+			} else if value.Valid {
+				_m.Weight = int(value.Int64)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the CoreGatewayHttpRouteTarget.
+// This includes values selected through modifiers, order, etc.
+func (_m *CoreGatewayHttpRouteTarget) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryTargetFromRoute queries the "target_from_route" edge of the CoreGatewayHttpRouteTarget entity.
+func (_m *CoreGatewayHttpRouteTarget) QueryTargetFromRoute() *CoreGatewayHttpRouteQuery {
+	return NewCoreGatewayHttpRouteTargetClient(_m.config).QueryTargetFromRoute(_m)
+}
+
+// QueryTargetFromUpstream queries the "target_from_upstream" edge of the CoreGatewayHttpRouteTarget entity.
+func (_m *CoreGatewayHttpRouteTarget) QueryTargetFromUpstream() *CoreUpstreamQuery {
+	return NewCoreGatewayHttpRouteTargetClient(_m.config).QueryTargetFromUpstream(_m)
+}
+
+// Update returns a builder for updating this CoreGatewayHttpRouteTarget.
+// Note that you need to call CoreGatewayHttpRouteTarget.Unwrap() before calling this method if this CoreGatewayHttpRouteTarget
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *CoreGatewayHttpRouteTarget) Update() *CoreGatewayHttpRouteTargetUpdateOne {
+	return NewCoreGatewayHttpRouteTargetClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the CoreGatewayHttpRouteTarget entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *CoreGatewayHttpRouteTarget) Unwrap() *CoreGatewayHttpRouteTarget {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: CoreGatewayHttpRouteTarget is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *CoreGatewayHttpRouteTarget) String() string {
+	var builder strings.Builder
+	builder.WriteString("CoreGatewayHttpRouteTarget(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("route_id=")
+	builder.WriteString(_m.RouteID)
+	builder.WriteString(", ")
+	builder.WriteString("upstream_id=")
+	builder.WriteString(_m.UpstreamID)
+	builder.WriteString(", ")
+	builder.WriteString("weight=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Weight))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// CoreGatewayHttpRouteTargets is a parsable slice of CoreGatewayHttpRouteTarget.
+type CoreGatewayHttpRouteTargets []*CoreGatewayHttpRouteTarget
@@ -0,0 +1,158 @@
+// Code generated by ent, DO NOT EDIT.
+
+package coregatewayhttproutetarget
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the coregatewayhttproutetarget type in the database.
+	Label = "core_gateway_http_route_target"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
+	FieldDeletedAt = "deleted_at"
+	// FieldRouteID holds the string denoting the route_id field in the database.
+	FieldRouteID = "route_id"
+	// FieldUpstreamID holds the string denoting the upstream_id field in the database.
+	FieldUpstreamID = "upstream_id"
+	// FieldWeight holds the string denoting the weight field in the database.
+	FieldWeight = "weight"
+	// EdgeTargetFromRoute holds the string denoting the target_from_route edge name in mutations.
+	EdgeTargetFromRoute = "target_from_route"
+	// EdgeTargetFromUpstream holds the string denoting the target_from_upstream edge name in mutations.
+	EdgeTargetFromUpstream = "target_from_upstream"
+	// Table holds the table name of the coregatewayhttproutetarget in the database.
+	Table = "quebec_core_gateway_http_route_target"
+	// TargetFromRouteTable is the table that holds the target_from_route relation/edge.
+	TargetFromRouteTable = "quebec_core_gateway_http_route_target"
+	// TargetFromRouteInverseTable is the table name for the CoreGatewayHttpRoute entity.
+	// It exists in this package in order to avoid circular dependency with the "coregatewayhttproute" package.
+	TargetFromRouteInverseTable = "quebec_core_gateway_http_route"
+	// TargetFromRouteColumn is the table column denoting the target_from_route relation/edge.
+	TargetFromRouteColumn = "route_id"
+	// TargetFromUpstreamTable is the table that holds the target_from_upstream relation/edge.
+	TargetFromUpstreamTable = "quebec_core_gateway_http_route_target"
+	// TargetFromUpstreamInverseTable is the table name for the CoreUpstream entity.
+	// It exists in this package in order to avoid circular dependency with the "coreupstream" package.
+	TargetFromUpstreamInverseTable = "quebec_core_upstream"
+	// TargetFromUpstreamColumn is the table column denoting the target_from_upstream relation/edge.
+	TargetFromUpstreamColumn = "upstream_id"
+)
+
+// Columns holds all SQL columns for coregatewayhttproutetarget fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldDeletedAt,
+	FieldRouteID,
+	FieldUpstreamID,
+	FieldWeight,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "github.com/lyonmu/quebec/cmd/core/internal/ent/runtime"
+var (
+	Hooks [2]ent.Hook
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultWeight holds the default value on creation for the "weight" field.
+	DefaultWeight int
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() string
+	// IDValidator is a validator for the "id" field. It is called by the builders before save.
+	IDValidator func(string) error
+)
+
+// OrderOption defines the ordering options for the CoreGatewayHttpRouteTarget queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByDeletedAt orders the results by the deleted_at field.
+func ByDeletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletedAt, opts...).ToFunc()
+}
+
+// ByRouteID orders the results by the route_id field.
+func ByRouteID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRouteID, opts...).ToFunc()
+}
+
+// ByUpstreamID orders the results by the upstream_id field.
+func ByUpstreamID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpstreamID, opts...).ToFunc()
+}
+
+// ByWeight orders the results by the weight field.
+func ByWeight(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWeight, opts...).ToFunc()
+}
+
+// ByTargetFromRouteField orders the results by target_from_route field.
+func ByTargetFromRouteField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTargetFromRouteStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByTargetFromUpstreamField orders the results by target_from_upstream field.
+func ByTargetFromUpstreamField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTargetFromUpstreamStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newTargetFromRouteStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TargetFromRouteInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, TargetFromRouteTable, TargetFromRouteColumn),
+	)
+}
+func newTargetFromUpstreamStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TargetFromUpstreamInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, TargetFromUpstreamTable, TargetFromUpstreamColumn),
+	)
+}
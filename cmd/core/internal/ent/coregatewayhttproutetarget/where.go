@@ -0,0 +1,487 @@
+// Code generated by ent, DO NOT EDIT.
+
+package coregatewayhttproutetarget
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.CoreGatewayHttpRouteTarget {
+	return predicate.CoreGatewayHttpRouteTarget(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.CoreGatewayHttpRouteTarget {
+	return predicate.CoreGatewayHttpRouteTarget(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.CoreGatewayHttpRouteTarget {
+	return predicate.CoreGatewayHttpRouteTarget(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.CoreGatewayHttpRouteTarget {
+	return predicate.CoreGatewayHttpRouteTarget(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.CoreGatewayHttpRouteTarget {
+	return predicate.CoreGatewayHttpRouteTarget(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.CoreGatewayHttpRouteTarget {
+	return predicate.CoreGatewayHttpRouteTarget(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.CoreGatewayHttpRouteTarget {
+	return predicate.CoreGatewayHttpRouteTarget(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.CoreGatewayHttpRouteTarget {
+	return predicate.CoreGatewayHttpRouteTarget(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.CoreGatewayHttpRouteTarget {
+	return predicate.CoreGatewayHttpRouteTarget(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.CoreGatewayHttpRouteTarget {
+	return predicate.CoreGatewayHttpRouteTarget(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.CoreGatewayHttpRouteTarget {
+	return predicate.CoreGatewayHttpRouteTarget(sql.FieldContainsFold(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.CoreGatewayHttpRouteTarget {
+	return predicate.CoreGatewayHttpRouteTarget(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.CoreGatewayHttpRouteTarget {
+	return predicate.CoreGatewayHttpRouteTarget(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.CoreGatewayHttpRouteTarget {
+	return predicate.CoreGatewayHttpRouteTarget(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// RouteID applies equality check predicate on the "route_id" field. It's identical to RouteIDEQ.
+func RouteID(v string) predicate.CoreGatewayHttpRouteTarget {
+	return predicate.CoreGatewayHttpRouteTarget(sql.FieldEQ(FieldRouteID, v))
+}
+
+// UpstreamID applies equality check predicate on the "upstream_id" field. It's identical to UpstreamIDEQ.
+func UpstreamID(v string) predicate.CoreGatewayHttpRouteTarget {
+	return predicate.CoreGatewayHttpRouteTarget(sql.FieldEQ(FieldUpstreamID, v))
+}
+
+// Weight applies equality check predicate on the "weight" field. It's identical to WeightEQ.
+func Weight(v int) predicate.CoreGatewayHttpRouteTarget {
+	return predicate.CoreGatewayHttpRouteTarget(sql.FieldEQ(FieldWeight, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.CoreGatewayHttpRouteTarget {
+	return predicate.CoreGatewayHttpRouteTarget(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.CoreGatewayHttpRouteTarget {
+	return predicate.CoreGatewayHttpRouteTarget(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.CoreGatewayHttpRouteTarget {
+	return predicate.CoreGatewayHttpRouteTarget(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.CoreGatewayHttpRouteTarget {
+	return predicate.CoreGatewayHttpRouteTarget(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.CoreGatewayHttpRouteTarget {
+	return predicate.CoreGatewayHttpRouteTarget(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.CoreGatewayHttpRouteTarget {
+	return predicate.CoreGatewayHttpRouteTarget(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.CoreGatewayHttpRouteTarget {
+	return predicate.CoreGatewayHttpRouteTarget(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.CoreGatewayHttpRouteTarget {
+	return predicate.CoreGatewayHttpRouteTarget(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.CoreGatewayHttpRouteTarget {
+	return predicate.CoreGatewayHttpRouteTarget(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.CoreGatewayHttpRouteTarget {
+	return predicate.CoreGatewayHttpRouteTarget(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.CoreGatewayHttpRouteTarget {
+	return predicate.CoreGatewayHttpRouteTarget(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.CoreGatewayHttpRouteTarget {
+	return predicate.CoreGatewayHttpRouteTarget(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.CoreGatewayHttpRouteTarget {
+	return predicate.CoreGatewayHttpRouteTarget(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.CoreGatewayHttpRouteTarget {
+	return predicate.CoreGatewayHttpRouteTarget(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.CoreGatewayHttpRouteTarget {
+	return predicate.CoreGatewayHttpRouteTarget(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.CoreGatewayHttpRouteTarget {
+	return predicate.CoreGatewayHttpRouteTarget(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.CoreGatewayHttpRouteTarget {
+	return predicate.CoreGatewayHttpRouteTarget(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.CoreGatewayHttpRouteTarget {
+	return predicate.CoreGatewayHttpRouteTarget(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.CoreGatewayHttpRouteTarget {
+	return predicate.CoreGatewayHttpRouteTarget(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.CoreGatewayHttpRouteTarget {
+	return predicate.CoreGatewayHttpRouteTarget(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.CoreGatewayHttpRouteTarget {
+	return predicate.CoreGatewayHttpRouteTarget(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.CoreGatewayHttpRouteTarget {
+	return predicate.CoreGatewayHttpRouteTarget(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.CoreGatewayHttpRouteTarget {
+	return predicate.CoreGatewayHttpRouteTarget(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.CoreGatewayHttpRouteTarget {
+	return predicate.CoreGatewayHttpRouteTarget(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.CoreGatewayHttpRouteTarget {
+	return predicate.CoreGatewayHttpRouteTarget(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.CoreGatewayHttpRouteTarget {
+	return predicate.CoreGatewayHttpRouteTarget(sql.FieldNotNull(FieldDeletedAt))
+}
+
+// RouteIDEQ applies the EQ predicate on the "route_id" field.
+func RouteIDEQ(v string) predicate.CoreGatewayHttpRouteTarget {
+	return predicate.CoreGatewayHttpRouteTarget(sql.FieldEQ(FieldRouteID, v))
+}
+
+// RouteIDNEQ applies the NEQ predicate on the "route_id" field.
+func RouteIDNEQ(v string) predicate.CoreGatewayHttpRouteTarget {
+	return predicate.CoreGatewayHttpRouteTarget(sql.FieldNEQ(FieldRouteID, v))
+}
+
+// RouteIDIn applies the In predicate on the "route_id" field.
+func RouteIDIn(vs ...string) predicate.CoreGatewayHttpRouteTarget {
+	return predicate.CoreGatewayHttpRouteTarget(sql.FieldIn(FieldRouteID, vs...))
+}
+
+// RouteIDNotIn applies the NotIn predicate on the "route_id" field.
+func RouteIDNotIn(vs ...string) predicate.CoreGatewayHttpRouteTarget {
+	return predicate.CoreGatewayHttpRouteTarget(sql.FieldNotIn(FieldRouteID, vs...))
+}
+
+// RouteIDGT applies the GT predicate on the "route_id" field.
+func RouteIDGT(v string) predicate.CoreGatewayHttpRouteTarget {
+	return predicate.CoreGatewayHttpRouteTarget(sql.FieldGT(FieldRouteID, v))
+}
+
+// RouteIDGTE applies the GTE predicate on the "route_id" field.
+func RouteIDGTE(v string) predicate.CoreGatewayHttpRouteTarget {
+	return predicate.CoreGatewayHttpRouteTarget(sql.FieldGTE(FieldRouteID, v))
+}
+
+// RouteIDLT applies the LT predicate on the "route_id" field.
+func RouteIDLT(v string) predicate.CoreGatewayHttpRouteTarget {
+	return predicate.CoreGatewayHttpRouteTarget(sql.FieldLT(FieldRouteID, v))
+}
+
+// RouteIDLTE applies the LTE predicate on the "route_id" field.
+func RouteIDLTE(v string) predicate.CoreGatewayHttpRouteTarget {
+	return predicate.CoreGatewayHttpRouteTarget(sql.FieldLTE(FieldRouteID, v))
+}
+
+// RouteIDContains applies the Contains predicate on the "route_id" field.
+func RouteIDContains(v string) predicate.CoreGatewayHttpRouteTarget {
+	return predicate.CoreGatewayHttpRouteTarget(sql.FieldContains(FieldRouteID, v))
+}
+
+// RouteIDHasPrefix applies the HasPrefix predicate on the "route_id" field.
+func RouteIDHasPrefix(v string) predicate.CoreGatewayHttpRouteTarget {
+	return predicate.CoreGatewayHttpRouteTarget(sql.FieldHasPrefix(FieldRouteID, v))
+}
+
+// RouteIDHasSuffix applies the HasSuffix predicate on the "route_id" field.
+func RouteIDHasSuffix(v string) predicate.CoreGatewayHttpRouteTarget {
+	return predicate.CoreGatewayHttpRouteTarget(sql.FieldHasSuffix(FieldRouteID, v))
+}
+
+// RouteIDIsNil applies the IsNil predicate on the "route_id" field.
+func RouteIDIsNil() predicate.CoreGatewayHttpRouteTarget {
+	return predicate.CoreGatewayHttpRouteTarget(sql.FieldIsNull(FieldRouteID))
+}
+
+// RouteIDNotNil applies the NotNil predicate on the "route_id" field.
+func RouteIDNotNil() predicate.CoreGatewayHttpRouteTarget {
+	return predicate.CoreGatewayHttpRouteTarget(sql.FieldNotNull(FieldRouteID))
+}
+
+// RouteIDEqualFold applies the EqualFold predicate on the "route_id" field.
+func RouteIDEqualFold(v string) predicate.CoreGatewayHttpRouteTarget {
+	return predicate.CoreGatewayHttpRouteTarget(sql.FieldEqualFold(FieldRouteID, v))
+}
+
+// RouteIDContainsFold applies the ContainsFold predicate on the "route_id" field.
+func RouteIDContainsFold(v string) predicate.CoreGatewayHttpRouteTarget {
+	return predicate.CoreGatewayHttpRouteTarget(sql.FieldContainsFold(FieldRouteID, v))
+}
+
+// UpstreamIDEQ applies the EQ predicate on the "upstream_id" field.
+func UpstreamIDEQ(v string) predicate.CoreGatewayHttpRouteTarget {
+	return predicate.CoreGatewayHttpRouteTarget(sql.FieldEQ(FieldUpstreamID, v))
+}
+
+// UpstreamIDNEQ applies the NEQ predicate on the "upstream_id" field.
+func UpstreamIDNEQ(v string) predicate.CoreGatewayHttpRouteTarget {
+	return predicate.CoreGatewayHttpRouteTarget(sql.FieldNEQ(FieldUpstreamID, v))
+}
+
+// UpstreamIDIn applies the In predicate on the "upstream_id" field.
+func UpstreamIDIn(vs ...string) predicate.CoreGatewayHttpRouteTarget {
+	return predicate.CoreGatewayHttpRouteTarget(sql.FieldIn(FieldUpstreamID, vs...))
+}
+
+// UpstreamIDNotIn applies the NotIn predicate on the "upstream_id" field.
+func UpstreamIDNotIn(vs ...string) predicate.CoreGatewayHttpRouteTarget {
+	return predicate.CoreGatewayHttpRouteTarget(sql.FieldNotIn(FieldUpstreamID, vs...))
+}
+
+// UpstreamIDGT applies the GT predicate on the "upstream_id" field.
+func UpstreamIDGT(v string) predicate.CoreGatewayHttpRouteTarget {
+	return predicate.CoreGatewayHttpRouteTarget(sql.FieldGT(FieldUpstreamID, v))
+}
+
+// UpstreamIDGTE applies the GTE predicate on the "upstream_id" field.
+func UpstreamIDGTE(v string) predicate.CoreGatewayHttpRouteTarget {
+	return predicate.CoreGatewayHttpRouteTarget(sql.FieldGTE(FieldUpstreamID, v))
+}
+
+// UpstreamIDLT applies the LT predicate on the "upstream_id" field.
+func UpstreamIDLT(v string) predicate.CoreGatewayHttpRouteTarget {
+	return predicate.CoreGatewayHttpRouteTarget(sql.FieldLT(FieldUpstreamID, v))
+}
+
+// UpstreamIDLTE applies the LTE predicate on the "upstream_id" field.
+func UpstreamIDLTE(v string) predicate.CoreGatewayHttpRouteTarget {
+	return predicate.CoreGatewayHttpRouteTarget(sql.FieldLTE(FieldUpstreamID, v))
+}
+
+// UpstreamIDContains applies the Contains predicate on the "upstream_id" field.
+func UpstreamIDContains(v string) predicate.CoreGatewayHttpRouteTarget {
+	return predicate.CoreGatewayHttpRouteTarget(sql.FieldContains(FieldUpstreamID, v))
+}
+
+// UpstreamIDHasPrefix applies the HasPrefix predicate on the "upstream_id" field.
+func UpstreamIDHasPrefix(v string) predicate.CoreGatewayHttpRouteTarget {
+	return predicate.CoreGatewayHttpRouteTarget(sql.FieldHasPrefix(FieldUpstreamID, v))
+}
+
+// UpstreamIDHasSuffix applies the HasSuffix predicate on the "upstream_id" field.
+func UpstreamIDHasSuffix(v string) predicate.CoreGatewayHttpRouteTarget {
+	return predicate.CoreGatewayHttpRouteTarget(sql.FieldHasSuffix(FieldUpstreamID, v))
+}
+
+// UpstreamIDIsNil applies the IsNil predicate on the "upstream_id" field.
+func UpstreamIDIsNil() predicate.CoreGatewayHttpRouteTarget {
+	return predicate.CoreGatewayHttpRouteTarget(sql.FieldIsNull(FieldUpstreamID))
+}
+
+// UpstreamIDNotNil applies the NotNil predicate on the "upstream_id" field.
+func UpstreamIDNotNil() predicate.CoreGatewayHttpRouteTarget {
+	return predicate.CoreGatewayHttpRouteTarget(sql.FieldNotNull(FieldUpstreamID))
+}
+
+// UpstreamIDEqualFold applies the EqualFold predicate on the "upstream_id" field.
+func UpstreamIDEqualFold(v string) predicate.CoreGatewayHttpRouteTarget {
+	return predicate.CoreGatewayHttpRouteTarget(sql.FieldEqualFold(FieldUpstreamID, v))
+}
+
+// UpstreamIDContainsFold applies the ContainsFold predicate on the "upstream_id" field.
+func UpstreamIDContainsFold(v string) predicate.CoreGatewayHttpRouteTarget {
+	return predicate.CoreGatewayHttpRouteTarget(sql.FieldContainsFold(FieldUpstreamID, v))
+}
+
+// WeightEQ applies the EQ predicate on the "weight" field.
+func WeightEQ(v int) predicate.CoreGatewayHttpRouteTarget {
+	return predicate.CoreGatewayHttpRouteTarget(sql.FieldEQ(FieldWeight, v))
+}
+
+// WeightNEQ applies the NEQ predicate on the "weight" field.
+func WeightNEQ(v int) predicate.CoreGatewayHttpRouteTarget {
+	return predicate.CoreGatewayHttpRouteTarget(sql.FieldNEQ(FieldWeight, v))
+}
+
+// WeightIn applies the In predicate on the "weight" field.
+func WeightIn(vs ...int) predicate.CoreGatewayHttpRouteTarget {
+	return predicate.CoreGatewayHttpRouteTarget(sql.FieldIn(FieldWeight, vs...))
+}
+
+// WeightNotIn applies the NotIn predicate on the "weight" field.
+func WeightNotIn(vs ...int) predicate.CoreGatewayHttpRouteTarget {
+	return predicate.CoreGatewayHttpRouteTarget(sql.FieldNotIn(FieldWeight, vs...))
+}
+
+// WeightGT applies the GT predicate on the "weight" field.
+func WeightGT(v int) predicate.CoreGatewayHttpRouteTarget {
+	return predicate.CoreGatewayHttpRouteTarget(sql.FieldGT(FieldWeight, v))
+}
+
+// WeightGTE applies the GTE predicate on the "weight" field.
+func WeightGTE(v int) predicate.CoreGatewayHttpRouteTarget {
+	return predicate.CoreGatewayHttpRouteTarget(sql.FieldGTE(FieldWeight, v))
+}
+
+// WeightLT applies the LT predicate on the "weight" field.
+func WeightLT(v int) predicate.CoreGatewayHttpRouteTarget {
+	return predicate.CoreGatewayHttpRouteTarget(sql.FieldLT(FieldWeight, v))
+}
+
+// WeightLTE applies the LTE predicate on the "weight" field.
+func WeightLTE(v int) predicate.CoreGatewayHttpRouteTarget {
+	return predicate.CoreGatewayHttpRouteTarget(sql.FieldLTE(FieldWeight, v))
+}
+
+// WeightIsNil applies the IsNil predicate on the "weight" field.
+func WeightIsNil() predicate.CoreGatewayHttpRouteTarget {
+	return predicate.CoreGatewayHttpRouteTarget(sql.FieldIsNull(FieldWeight))
+}
+
+// WeightNotNil applies the NotNil predicate on the "weight" field.
+func WeightNotNil() predicate.CoreGatewayHttpRouteTarget {
+	return predicate.CoreGatewayHttpRouteTarget(sql.FieldNotNull(FieldWeight))
+}
+
+// HasTargetFromRoute applies the HasEdge predicate on the "target_from_route" edge.
+func HasTargetFromRoute() predicate.CoreGatewayHttpRouteTarget {
+	return predicate.CoreGatewayHttpRouteTarget(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, TargetFromRouteTable, TargetFromRouteColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTargetFromRouteWith applies the HasEdge predicate on the "target_from_route" edge with a given conditions (other predicates).
+func HasTargetFromRouteWith(preds ...predicate.CoreGatewayHttpRoute) predicate.CoreGatewayHttpRouteTarget {
+	return predicate.CoreGatewayHttpRouteTarget(func(s *sql.Selector) {
+		step := newTargetFromRouteStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasTargetFromUpstream applies the HasEdge predicate on the "target_from_upstream" edge.
+func HasTargetFromUpstream() predicate.CoreGatewayHttpRouteTarget {
+	return predicate.CoreGatewayHttpRouteTarget(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, TargetFromUpstreamTable, TargetFromUpstreamColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTargetFromUpstreamWith applies the HasEdge predicate on the "target_from_upstream" edge with a given conditions (other predicates).
+func HasTargetFromUpstreamWith(preds ...predicate.CoreUpstream) predicate.CoreGatewayHttpRouteTarget {
+	return predicate.CoreGatewayHttpRouteTarget(func(s *sql.Selector) {
+		step := newTargetFromUpstreamStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.CoreGatewayHttpRouteTarget) predicate.CoreGatewayHttpRouteTarget {
+	return predicate.CoreGatewayHttpRouteTarget(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.CoreGatewayHttpRouteTarget) predicate.CoreGatewayHttpRouteTarget {
+	return predicate.CoreGatewayHttpRouteTarget(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.CoreGatewayHttpRouteTarget) predicate.CoreGatewayHttpRouteTarget {
+	return predicate.CoreGatewayHttpRouteTarget(sql.NotPredicates(p))
+}
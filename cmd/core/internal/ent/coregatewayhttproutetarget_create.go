@@ -0,0 +1,970 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/coregatewayhttproute"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/coregatewayhttproutetarget"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/coreupstream"
+)
+
+// CoreGatewayHttpRouteTargetCreate is the builder for creating a CoreGatewayHttpRouteTarget entity.
+type CoreGatewayHttpRouteTargetCreate struct {
+	config
+	mutation *CoreGatewayHttpRouteTargetMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *CoreGatewayHttpRouteTargetCreate) SetCreatedAt(v time.Time) *CoreGatewayHttpRouteTargetCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *CoreGatewayHttpRouteTargetCreate) SetNillableCreatedAt(v *time.Time) *CoreGatewayHttpRouteTargetCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *CoreGatewayHttpRouteTargetCreate) SetUpdatedAt(v time.Time) *CoreGatewayHttpRouteTargetCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *CoreGatewayHttpRouteTargetCreate) SetNillableUpdatedAt(v *time.Time) *CoreGatewayHttpRouteTargetCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_c *CoreGatewayHttpRouteTargetCreate) SetDeletedAt(v time.Time) *CoreGatewayHttpRouteTargetCreate {
+	_c.mutation.SetDeletedAt(v)
+	return _c
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_c *CoreGatewayHttpRouteTargetCreate) SetNillableDeletedAt(v *time.Time) *CoreGatewayHttpRouteTargetCreate {
+	if v != nil {
+		_c.SetDeletedAt(*v)
+	}
+	return _c
+}
+
+// SetRouteID sets the "route_id" field.
+func (_c *CoreGatewayHttpRouteTargetCreate) SetRouteID(v string) *CoreGatewayHttpRouteTargetCreate {
+	_c.mutation.SetRouteID(v)
+	return _c
+}
+
+// SetNillableRouteID sets the "route_id" field if the given value is not nil.
+func (_c *CoreGatewayHttpRouteTargetCreate) SetNillableRouteID(v *string) *CoreGatewayHttpRouteTargetCreate {
+	if v != nil {
+		_c.SetRouteID(*v)
+	}
+	return _c
+}
+
+// SetUpstreamID sets the "upstream_id" field.
+func (_c *CoreGatewayHttpRouteTargetCreate) SetUpstreamID(v string) *CoreGatewayHttpRouteTargetCreate {
+	_c.mutation.SetUpstreamID(v)
+	return _c
+}
+
+// SetNillableUpstreamID sets the "upstream_id" field if the given value is not nil.
+func (_c *CoreGatewayHttpRouteTargetCreate) SetNillableUpstreamID(v *string) *CoreGatewayHttpRouteTargetCreate {
+	if v != nil {
+		_c.SetUpstreamID(*v)
+	}
+	return _c
+}
+
+// SetWeight sets the "weight" field.
+func (_c *CoreGatewayHttpRouteTargetCreate) SetWeight(v int) *CoreGatewayHttpRouteTargetCreate {
+	_c.mutation.SetWeight(v)
+	return _c
+}
+
+// SetNillableWeight sets the "weight" field if the given value is not nil.
+func (_c *CoreGatewayHttpRouteTargetCreate) SetNillableWeight(v *int) *CoreGatewayHttpRouteTargetCreate {
+	if v != nil {
+		_c.SetWeight(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *CoreGatewayHttpRouteTargetCreate) SetID(v string) *CoreGatewayHttpRouteTargetCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *CoreGatewayHttpRouteTargetCreate) SetNillableID(v *string) *CoreGatewayHttpRouteTargetCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetTargetFromRouteID sets the "target_from_route" edge to the CoreGatewayHttpRoute entity by ID.
+func (_c *CoreGatewayHttpRouteTargetCreate) SetTargetFromRouteID(id string) *CoreGatewayHttpRouteTargetCreate {
+	_c.mutation.SetTargetFromRouteID(id)
+	return _c
+}
+
+// SetNillableTargetFromRouteID sets the "target_from_route" edge to the CoreGatewayHttpRoute entity by ID if the given value is not nil.
+func (_c *CoreGatewayHttpRouteTargetCreate) SetNillableTargetFromRouteID(id *string) *CoreGatewayHttpRouteTargetCreate {
+	if id != nil {
+		_c = _c.SetTargetFromRouteID(*id)
+	}
+	return _c
+}
+
+// SetTargetFromRoute sets the "target_from_route" edge to the CoreGatewayHttpRoute entity.
+func (_c *CoreGatewayHttpRouteTargetCreate) SetTargetFromRoute(v *CoreGatewayHttpRoute) *CoreGatewayHttpRouteTargetCreate {
+	return _c.SetTargetFromRouteID(v.ID)
+}
+
+// SetTargetFromUpstreamID sets the "target_from_upstream" edge to the CoreUpstream entity by ID.
+func (_c *CoreGatewayHttpRouteTargetCreate) SetTargetFromUpstreamID(id string) *CoreGatewayHttpRouteTargetCreate {
+	_c.mutation.SetTargetFromUpstreamID(id)
+	return _c
+}
+
+// SetNillableTargetFromUpstreamID sets the "target_from_upstream" edge to the CoreUpstream entity by ID if the given value is not nil.
+func (_c *CoreGatewayHttpRouteTargetCreate) SetNillableTargetFromUpstreamID(id *string) *CoreGatewayHttpRouteTargetCreate {
+	if id != nil {
+		_c = _c.SetTargetFromUpstreamID(*id)
+	}
+	return _c
+}
+
+// SetTargetFromUpstream sets the "target_from_upstream" edge to the CoreUpstream entity.
+func (_c *CoreGatewayHttpRouteTargetCreate) SetTargetFromUpstream(v *CoreUpstream) *CoreGatewayHttpRouteTargetCreate {
+	return _c.SetTargetFromUpstreamID(v.ID)
+}
+
+// Mutation returns the CoreGatewayHttpRouteTargetMutation object of the builder.
+func (_c *CoreGatewayHttpRouteTargetCreate) Mutation() *CoreGatewayHttpRouteTargetMutation {
+	return _c.mutation
+}
+
+// Save creates the CoreGatewayHttpRouteTarget in the database.
+func (_c *CoreGatewayHttpRouteTargetCreate) Save(ctx context.Context) (*CoreGatewayHttpRouteTarget, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *CoreGatewayHttpRouteTargetCreate) SaveX(ctx context.Context) *CoreGatewayHttpRouteTarget {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *CoreGatewayHttpRouteTargetCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *CoreGatewayHttpRouteTargetCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *CoreGatewayHttpRouteTargetCreate) defaults() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		if coregatewayhttproutetarget.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized coregatewayhttproutetarget.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := coregatewayhttproutetarget.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		if coregatewayhttproutetarget.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized coregatewayhttproutetarget.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := coregatewayhttproutetarget.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.Weight(); !ok {
+		v := coregatewayhttproutetarget.DefaultWeight
+		_c.mutation.SetWeight(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		if coregatewayhttproutetarget.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized coregatewayhttproutetarget.DefaultID (forgotten import ent/runtime?)")
+		}
+		v := coregatewayhttproutetarget.DefaultID()
+		_c.mutation.SetID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *CoreGatewayHttpRouteTargetCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "CoreGatewayHttpRouteTarget.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "CoreGatewayHttpRouteTarget.updated_at"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := coregatewayhttproutetarget.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "CoreGatewayHttpRouteTarget.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *CoreGatewayHttpRouteTargetCreate) sqlSave(ctx context.Context) (*CoreGatewayHttpRouteTarget, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected CoreGatewayHttpRouteTarget.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *CoreGatewayHttpRouteTargetCreate) createSpec() (*CoreGatewayHttpRouteTarget, *sqlgraph.CreateSpec) {
+	var (
+		_node = &CoreGatewayHttpRouteTarget{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(coregatewayhttproutetarget.Table, sqlgraph.NewFieldSpec(coregatewayhttproutetarget.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(coregatewayhttproutetarget.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(coregatewayhttproutetarget.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.DeletedAt(); ok {
+		_spec.SetField(coregatewayhttproutetarget.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if value, ok := _c.mutation.Weight(); ok {
+		_spec.SetField(coregatewayhttproutetarget.FieldWeight, field.TypeInt, value)
+		_node.Weight = value
+	}
+	if nodes := _c.mutation.TargetFromRouteIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   coregatewayhttproutetarget.TargetFromRouteTable,
+			Columns: []string{coregatewayhttproutetarget.TargetFromRouteColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(coregatewayhttproute.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.RouteID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.TargetFromUpstreamIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   coregatewayhttproutetarget.TargetFromUpstreamTable,
+			Columns: []string{coregatewayhttproutetarget.TargetFromUpstreamColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(coreupstream.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UpstreamID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.CoreGatewayHttpRouteTarget.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.CoreGatewayHttpRouteTargetUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *CoreGatewayHttpRouteTargetCreate) OnConflict(opts ...sql.ConflictOption) *CoreGatewayHttpRouteTargetUpsertOne {
+	_c.conflict = opts
+	return &CoreGatewayHttpRouteTargetUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.CoreGatewayHttpRouteTarget.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *CoreGatewayHttpRouteTargetCreate) OnConflictColumns(columns ...string) *CoreGatewayHttpRouteTargetUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &CoreGatewayHttpRouteTargetUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// CoreGatewayHttpRouteTargetUpsertOne is the builder for "upsert"-ing
+	//  one CoreGatewayHttpRouteTarget node.
+	CoreGatewayHttpRouteTargetUpsertOne struct {
+		create *CoreGatewayHttpRouteTargetCreate
+	}
+
+	// CoreGatewayHttpRouteTargetUpsert is the "OnConflict" setter.
+	CoreGatewayHttpRouteTargetUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *CoreGatewayHttpRouteTargetUpsert) SetUpdatedAt(v time.Time) *CoreGatewayHttpRouteTargetUpsert {
+	u.Set(coregatewayhttproutetarget.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *CoreGatewayHttpRouteTargetUpsert) UpdateUpdatedAt() *CoreGatewayHttpRouteTargetUpsert {
+	u.SetExcluded(coregatewayhttproutetarget.FieldUpdatedAt)
+	return u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *CoreGatewayHttpRouteTargetUpsert) SetDeletedAt(v time.Time) *CoreGatewayHttpRouteTargetUpsert {
+	u.Set(coregatewayhttproutetarget.FieldDeletedAt, v)
+	return u
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *CoreGatewayHttpRouteTargetUpsert) UpdateDeletedAt() *CoreGatewayHttpRouteTargetUpsert {
+	u.SetExcluded(coregatewayhttproutetarget.FieldDeletedAt)
+	return u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *CoreGatewayHttpRouteTargetUpsert) ClearDeletedAt() *CoreGatewayHttpRouteTargetUpsert {
+	u.SetNull(coregatewayhttproutetarget.FieldDeletedAt)
+	return u
+}
+
+// SetRouteID sets the "route_id" field.
+func (u *CoreGatewayHttpRouteTargetUpsert) SetRouteID(v string) *CoreGatewayHttpRouteTargetUpsert {
+	u.Set(coregatewayhttproutetarget.FieldRouteID, v)
+	return u
+}
+
+// UpdateRouteID sets the "route_id" field to the value that was provided on create.
+func (u *CoreGatewayHttpRouteTargetUpsert) UpdateRouteID() *CoreGatewayHttpRouteTargetUpsert {
+	u.SetExcluded(coregatewayhttproutetarget.FieldRouteID)
+	return u
+}
+
+// ClearRouteID clears the value of the "route_id" field.
+func (u *CoreGatewayHttpRouteTargetUpsert) ClearRouteID() *CoreGatewayHttpRouteTargetUpsert {
+	u.SetNull(coregatewayhttproutetarget.FieldRouteID)
+	return u
+}
+
+// SetUpstreamID sets the "upstream_id" field.
+func (u *CoreGatewayHttpRouteTargetUpsert) SetUpstreamID(v string) *CoreGatewayHttpRouteTargetUpsert {
+	u.Set(coregatewayhttproutetarget.FieldUpstreamID, v)
+	return u
+}
+
+// UpdateUpstreamID sets the "upstream_id" field to the value that was provided on create.
+func (u *CoreGatewayHttpRouteTargetUpsert) UpdateUpstreamID() *CoreGatewayHttpRouteTargetUpsert {
+	u.SetExcluded(coregatewayhttproutetarget.FieldUpstreamID)
+	return u
+}
+
+// ClearUpstreamID clears the value of the "upstream_id" field.
+func (u *CoreGatewayHttpRouteTargetUpsert) ClearUpstreamID() *CoreGatewayHttpRouteTargetUpsert {
+	u.SetNull(coregatewayhttproutetarget.FieldUpstreamID)
+	return u
+}
+
+// SetWeight sets the "weight" field.
+func (u *CoreGatewayHttpRouteTargetUpsert) SetWeight(v int) *CoreGatewayHttpRouteTargetUpsert {
+	u.Set(coregatewayhttproutetarget.FieldWeight, v)
+	return u
+}
+
+// UpdateWeight sets the "weight" field to the value that was provided on create.
+func (u *CoreGatewayHttpRouteTargetUpsert) UpdateWeight() *CoreGatewayHttpRouteTargetUpsert {
+	u.SetExcluded(coregatewayhttproutetarget.FieldWeight)
+	return u
+}
+
+// AddWeight adds v to the "weight" field.
+func (u *CoreGatewayHttpRouteTargetUpsert) AddWeight(v int) *CoreGatewayHttpRouteTargetUpsert {
+	u.Add(coregatewayhttproutetarget.FieldWeight, v)
+	return u
+}
+
+// ClearWeight clears the value of the "weight" field.
+func (u *CoreGatewayHttpRouteTargetUpsert) ClearWeight() *CoreGatewayHttpRouteTargetUpsert {
+	u.SetNull(coregatewayhttproutetarget.FieldWeight)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.CoreGatewayHttpRouteTarget.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(coregatewayhttproutetarget.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *CoreGatewayHttpRouteTargetUpsertOne) UpdateNewValues() *CoreGatewayHttpRouteTargetUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(coregatewayhttproutetarget.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(coregatewayhttproutetarget.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.CoreGatewayHttpRouteTarget.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *CoreGatewayHttpRouteTargetUpsertOne) Ignore() *CoreGatewayHttpRouteTargetUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *CoreGatewayHttpRouteTargetUpsertOne) DoNothing() *CoreGatewayHttpRouteTargetUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the CoreGatewayHttpRouteTargetCreate.OnConflict
+// documentation for more info.
+func (u *CoreGatewayHttpRouteTargetUpsertOne) Update(set func(*CoreGatewayHttpRouteTargetUpsert)) *CoreGatewayHttpRouteTargetUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&CoreGatewayHttpRouteTargetUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *CoreGatewayHttpRouteTargetUpsertOne) SetUpdatedAt(v time.Time) *CoreGatewayHttpRouteTargetUpsertOne {
+	return u.Update(func(s *CoreGatewayHttpRouteTargetUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *CoreGatewayHttpRouteTargetUpsertOne) UpdateUpdatedAt() *CoreGatewayHttpRouteTargetUpsertOne {
+	return u.Update(func(s *CoreGatewayHttpRouteTargetUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *CoreGatewayHttpRouteTargetUpsertOne) SetDeletedAt(v time.Time) *CoreGatewayHttpRouteTargetUpsertOne {
+	return u.Update(func(s *CoreGatewayHttpRouteTargetUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *CoreGatewayHttpRouteTargetUpsertOne) UpdateDeletedAt() *CoreGatewayHttpRouteTargetUpsertOne {
+	return u.Update(func(s *CoreGatewayHttpRouteTargetUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *CoreGatewayHttpRouteTargetUpsertOne) ClearDeletedAt() *CoreGatewayHttpRouteTargetUpsertOne {
+	return u.Update(func(s *CoreGatewayHttpRouteTargetUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetRouteID sets the "route_id" field.
+func (u *CoreGatewayHttpRouteTargetUpsertOne) SetRouteID(v string) *CoreGatewayHttpRouteTargetUpsertOne {
+	return u.Update(func(s *CoreGatewayHttpRouteTargetUpsert) {
+		s.SetRouteID(v)
+	})
+}
+
+// UpdateRouteID sets the "route_id" field to the value that was provided on create.
+func (u *CoreGatewayHttpRouteTargetUpsertOne) UpdateRouteID() *CoreGatewayHttpRouteTargetUpsertOne {
+	return u.Update(func(s *CoreGatewayHttpRouteTargetUpsert) {
+		s.UpdateRouteID()
+	})
+}
+
+// ClearRouteID clears the value of the "route_id" field.
+func (u *CoreGatewayHttpRouteTargetUpsertOne) ClearRouteID() *CoreGatewayHttpRouteTargetUpsertOne {
+	return u.Update(func(s *CoreGatewayHttpRouteTargetUpsert) {
+		s.ClearRouteID()
+	})
+}
+
+// SetUpstreamID sets the "upstream_id" field.
+func (u *CoreGatewayHttpRouteTargetUpsertOne) SetUpstreamID(v string) *CoreGatewayHttpRouteTargetUpsertOne {
+	return u.Update(func(s *CoreGatewayHttpRouteTargetUpsert) {
+		s.SetUpstreamID(v)
+	})
+}
+
+// UpdateUpstreamID sets the "upstream_id" field to the value that was provided on create.
+func (u *CoreGatewayHttpRouteTargetUpsertOne) UpdateUpstreamID() *CoreGatewayHttpRouteTargetUpsertOne {
+	return u.Update(func(s *CoreGatewayHttpRouteTargetUpsert) {
+		s.UpdateUpstreamID()
+	})
+}
+
+// ClearUpstreamID clears the value of the "upstream_id" field.
+func (u *CoreGatewayHttpRouteTargetUpsertOne) ClearUpstreamID() *CoreGatewayHttpRouteTargetUpsertOne {
+	return u.Update(func(s *CoreGatewayHttpRouteTargetUpsert) {
+		s.ClearUpstreamID()
+	})
+}
+
+// SetWeight sets the "weight" field.
+func (u *CoreGatewayHttpRouteTargetUpsertOne) SetWeight(v int) *CoreGatewayHttpRouteTargetUpsertOne {
+	return u.Update(func(s *CoreGatewayHttpRouteTargetUpsert) {
+		s.SetWeight(v)
+	})
+}
+
+// AddWeight adds v to the "weight" field.
+func (u *CoreGatewayHttpRouteTargetUpsertOne) AddWeight(v int) *CoreGatewayHttpRouteTargetUpsertOne {
+	return u.Update(func(s *CoreGatewayHttpRouteTargetUpsert) {
+		s.AddWeight(v)
+	})
+}
+
+// UpdateWeight sets the "weight" field to the value that was provided on create.
+func (u *CoreGatewayHttpRouteTargetUpsertOne) UpdateWeight() *CoreGatewayHttpRouteTargetUpsertOne {
+	return u.Update(func(s *CoreGatewayHttpRouteTargetUpsert) {
+		s.UpdateWeight()
+	})
+}
+
+// ClearWeight clears the value of the "weight" field.
+func (u *CoreGatewayHttpRouteTargetUpsertOne) ClearWeight() *CoreGatewayHttpRouteTargetUpsertOne {
+	return u.Update(func(s *CoreGatewayHttpRouteTargetUpsert) {
+		s.ClearWeight()
+	})
+}
+
+// Exec executes the query.
+func (u *CoreGatewayHttpRouteTargetUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for CoreGatewayHttpRouteTargetCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *CoreGatewayHttpRouteTargetUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *CoreGatewayHttpRouteTargetUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: CoreGatewayHttpRouteTargetUpsertOne.ID is not supported by MySQL driver. Use CoreGatewayHttpRouteTargetUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *CoreGatewayHttpRouteTargetUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// CoreGatewayHttpRouteTargetCreateBulk is the builder for creating many CoreGatewayHttpRouteTarget entities in bulk.
+type CoreGatewayHttpRouteTargetCreateBulk struct {
+	config
+	err      error
+	builders []*CoreGatewayHttpRouteTargetCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the CoreGatewayHttpRouteTarget entities in the database.
+func (_c *CoreGatewayHttpRouteTargetCreateBulk) Save(ctx context.Context) ([]*CoreGatewayHttpRouteTarget, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*CoreGatewayHttpRouteTarget, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*CoreGatewayHttpRouteTargetMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *CoreGatewayHttpRouteTargetCreateBulk) SaveX(ctx context.Context) []*CoreGatewayHttpRouteTarget {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *CoreGatewayHttpRouteTargetCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *CoreGatewayHttpRouteTargetCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.CoreGatewayHttpRouteTarget.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.CoreGatewayHttpRouteTargetUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *CoreGatewayHttpRouteTargetCreateBulk) OnConflict(opts ...sql.ConflictOption) *CoreGatewayHttpRouteTargetUpsertBulk {
+	_c.conflict = opts
+	return &CoreGatewayHttpRouteTargetUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.CoreGatewayHttpRouteTarget.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *CoreGatewayHttpRouteTargetCreateBulk) OnConflictColumns(columns ...string) *CoreGatewayHttpRouteTargetUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &CoreGatewayHttpRouteTargetUpsertBulk{
+		create: _c,
+	}
+}
+
+// CoreGatewayHttpRouteTargetUpsertBulk is the builder for "upsert"-ing
+// a bulk of CoreGatewayHttpRouteTarget nodes.
+type CoreGatewayHttpRouteTargetUpsertBulk struct {
+	create *CoreGatewayHttpRouteTargetCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.CoreGatewayHttpRouteTarget.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(coregatewayhttproutetarget.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *CoreGatewayHttpRouteTargetUpsertBulk) UpdateNewValues() *CoreGatewayHttpRouteTargetUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(coregatewayhttproutetarget.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(coregatewayhttproutetarget.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.CoreGatewayHttpRouteTarget.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *CoreGatewayHttpRouteTargetUpsertBulk) Ignore() *CoreGatewayHttpRouteTargetUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *CoreGatewayHttpRouteTargetUpsertBulk) DoNothing() *CoreGatewayHttpRouteTargetUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the CoreGatewayHttpRouteTargetCreateBulk.OnConflict
+// documentation for more info.
+func (u *CoreGatewayHttpRouteTargetUpsertBulk) Update(set func(*CoreGatewayHttpRouteTargetUpsert)) *CoreGatewayHttpRouteTargetUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&CoreGatewayHttpRouteTargetUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *CoreGatewayHttpRouteTargetUpsertBulk) SetUpdatedAt(v time.Time) *CoreGatewayHttpRouteTargetUpsertBulk {
+	return u.Update(func(s *CoreGatewayHttpRouteTargetUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *CoreGatewayHttpRouteTargetUpsertBulk) UpdateUpdatedAt() *CoreGatewayHttpRouteTargetUpsertBulk {
+	return u.Update(func(s *CoreGatewayHttpRouteTargetUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *CoreGatewayHttpRouteTargetUpsertBulk) SetDeletedAt(v time.Time) *CoreGatewayHttpRouteTargetUpsertBulk {
+	return u.Update(func(s *CoreGatewayHttpRouteTargetUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *CoreGatewayHttpRouteTargetUpsertBulk) UpdateDeletedAt() *CoreGatewayHttpRouteTargetUpsertBulk {
+	return u.Update(func(s *CoreGatewayHttpRouteTargetUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *CoreGatewayHttpRouteTargetUpsertBulk) ClearDeletedAt() *CoreGatewayHttpRouteTargetUpsertBulk {
+	return u.Update(func(s *CoreGatewayHttpRouteTargetUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetRouteID sets the "route_id" field.
+func (u *CoreGatewayHttpRouteTargetUpsertBulk) SetRouteID(v string) *CoreGatewayHttpRouteTargetUpsertBulk {
+	return u.Update(func(s *CoreGatewayHttpRouteTargetUpsert) {
+		s.SetRouteID(v)
+	})
+}
+
+// UpdateRouteID sets the "route_id" field to the value that was provided on create.
+func (u *CoreGatewayHttpRouteTargetUpsertBulk) UpdateRouteID() *CoreGatewayHttpRouteTargetUpsertBulk {
+	return u.Update(func(s *CoreGatewayHttpRouteTargetUpsert) {
+		s.UpdateRouteID()
+	})
+}
+
+// ClearRouteID clears the value of the "route_id" field.
+func (u *CoreGatewayHttpRouteTargetUpsertBulk) ClearRouteID() *CoreGatewayHttpRouteTargetUpsertBulk {
+	return u.Update(func(s *CoreGatewayHttpRouteTargetUpsert) {
+		s.ClearRouteID()
+	})
+}
+
+// SetUpstreamID sets the "upstream_id" field.
+func (u *CoreGatewayHttpRouteTargetUpsertBulk) SetUpstreamID(v string) *CoreGatewayHttpRouteTargetUpsertBulk {
+	return u.Update(func(s *CoreGatewayHttpRouteTargetUpsert) {
+		s.SetUpstreamID(v)
+	})
+}
+
+// UpdateUpstreamID sets the "upstream_id" field to the value that was provided on create.
+func (u *CoreGatewayHttpRouteTargetUpsertBulk) UpdateUpstreamID() *CoreGatewayHttpRouteTargetUpsertBulk {
+	return u.Update(func(s *CoreGatewayHttpRouteTargetUpsert) {
+		s.UpdateUpstreamID()
+	})
+}
+
+// ClearUpstreamID clears the value of the "upstream_id" field.
+func (u *CoreGatewayHttpRouteTargetUpsertBulk) ClearUpstreamID() *CoreGatewayHttpRouteTargetUpsertBulk {
+	return u.Update(func(s *CoreGatewayHttpRouteTargetUpsert) {
+		s.ClearUpstreamID()
+	})
+}
+
+// SetWeight sets the "weight" field.
+func (u *CoreGatewayHttpRouteTargetUpsertBulk) SetWeight(v int) *CoreGatewayHttpRouteTargetUpsertBulk {
+	return u.Update(func(s *CoreGatewayHttpRouteTargetUpsert) {
+		s.SetWeight(v)
+	})
+}
+
+// AddWeight adds v to the "weight" field.
+func (u *CoreGatewayHttpRouteTargetUpsertBulk) AddWeight(v int) *CoreGatewayHttpRouteTargetUpsertBulk {
+	return u.Update(func(s *CoreGatewayHttpRouteTargetUpsert) {
+		s.AddWeight(v)
+	})
+}
+
+// UpdateWeight sets the "weight" field to the value that was provided on create.
+func (u *CoreGatewayHttpRouteTargetUpsertBulk) UpdateWeight() *CoreGatewayHttpRouteTargetUpsertBulk {
+	return u.Update(func(s *CoreGatewayHttpRouteTargetUpsert) {
+		s.UpdateWeight()
+	})
+}
+
+// ClearWeight clears the value of the "weight" field.
+func (u *CoreGatewayHttpRouteTargetUpsertBulk) ClearWeight() *CoreGatewayHttpRouteTargetUpsertBulk {
+	return u.Update(func(s *CoreGatewayHttpRouteTargetUpsert) {
+		s.ClearWeight()
+	})
+}
+
+// Exec executes the query.
+func (u *CoreGatewayHttpRouteTargetUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the CoreGatewayHttpRouteTargetCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for CoreGatewayHttpRouteTargetCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *CoreGatewayHttpRouteTargetUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/coregatewayhttproutetarget"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/predicate"
+)
+
+// CoreGatewayHttpRouteTargetDelete is the builder for deleting a CoreGatewayHttpRouteTarget entity.
+type CoreGatewayHttpRouteTargetDelete struct {
+	config
+	hooks    []Hook
+	mutation *CoreGatewayHttpRouteTargetMutation
+}
+
+// Where appends a list predicates to the CoreGatewayHttpRouteTargetDelete builder.
+func (_d *CoreGatewayHttpRouteTargetDelete) Where(ps ...predicate.CoreGatewayHttpRouteTarget) *CoreGatewayHttpRouteTargetDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *CoreGatewayHttpRouteTargetDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *CoreGatewayHttpRouteTargetDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *CoreGatewayHttpRouteTargetDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(coregatewayhttproutetarget.Table, sqlgraph.NewFieldSpec(coregatewayhttproutetarget.FieldID, field.TypeString))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// CoreGatewayHttpRouteTargetDeleteOne is the builder for deleting a single CoreGatewayHttpRouteTarget entity.
+type CoreGatewayHttpRouteTargetDeleteOne struct {
+	_d *CoreGatewayHttpRouteTargetDelete
+}
+
+// Where appends a list predicates to the CoreGatewayHttpRouteTargetDelete builder.
+func (_d *CoreGatewayHttpRouteTargetDeleteOne) Where(ps ...predicate.CoreGatewayHttpRouteTarget) *CoreGatewayHttpRouteTargetDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *CoreGatewayHttpRouteTargetDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{coregatewayhttproutetarget.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *CoreGatewayHttpRouteTargetDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
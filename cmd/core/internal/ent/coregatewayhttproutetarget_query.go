@@ -0,0 +1,704 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/coregatewayhttproute"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/coregatewayhttproutetarget"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/coreupstream"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/predicate"
+)
+
+// CoreGatewayHttpRouteTargetQuery is the builder for querying CoreGatewayHttpRouteTarget entities.
+type CoreGatewayHttpRouteTargetQuery struct {
+	config
+	ctx                    *QueryContext
+	order                  []coregatewayhttproutetarget.OrderOption
+	inters                 []Interceptor
+	predicates             []predicate.CoreGatewayHttpRouteTarget
+	withTargetFromRoute    *CoreGatewayHttpRouteQuery
+	withTargetFromUpstream *CoreUpstreamQuery
+	modifiers              []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the CoreGatewayHttpRouteTargetQuery builder.
+func (_q *CoreGatewayHttpRouteTargetQuery) Where(ps ...predicate.CoreGatewayHttpRouteTarget) *CoreGatewayHttpRouteTargetQuery {
+	_q.predicates = append(_q.predicates, ps...)
+	return _q
+}
+
+// Limit the number of records to be returned by this query.
+func (_q *CoreGatewayHttpRouteTargetQuery) Limit(limit int) *CoreGatewayHttpRouteTargetQuery {
+	_q.ctx.Limit = &limit
+	return _q
+}
+
+// Offset to start from.
+func (_q *CoreGatewayHttpRouteTargetQuery) Offset(offset int) *CoreGatewayHttpRouteTargetQuery {
+	_q.ctx.Offset = &offset
+	return _q
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (_q *CoreGatewayHttpRouteTargetQuery) Unique(unique bool) *CoreGatewayHttpRouteTargetQuery {
+	_q.ctx.Unique = &unique
+	return _q
+}
+
+// Order specifies how the records should be ordered.
+func (_q *CoreGatewayHttpRouteTargetQuery) Order(o ...coregatewayhttproutetarget.OrderOption) *CoreGatewayHttpRouteTargetQuery {
+	_q.order = append(_q.order, o...)
+	return _q
+}
+
+// QueryTargetFromRoute chains the current query on the "target_from_route" edge.
+func (_q *CoreGatewayHttpRouteTargetQuery) QueryTargetFromRoute() *CoreGatewayHttpRouteQuery {
+	query := (&CoreGatewayHttpRouteClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(coregatewayhttproutetarget.Table, coregatewayhttproutetarget.FieldID, selector),
+			sqlgraph.To(coregatewayhttproute.Table, coregatewayhttproute.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, coregatewayhttproutetarget.TargetFromRouteTable, coregatewayhttproutetarget.TargetFromRouteColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryTargetFromUpstream chains the current query on the "target_from_upstream" edge.
+func (_q *CoreGatewayHttpRouteTargetQuery) QueryTargetFromUpstream() *CoreUpstreamQuery {
+	query := (&CoreUpstreamClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(coregatewayhttproutetarget.Table, coregatewayhttproutetarget.FieldID, selector),
+			sqlgraph.To(coreupstream.Table, coreupstream.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, coregatewayhttproutetarget.TargetFromUpstreamTable, coregatewayhttproutetarget.TargetFromUpstreamColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first CoreGatewayHttpRouteTarget entity from the query.
+// Returns a *NotFoundError when no CoreGatewayHttpRouteTarget was found.
+func (_q *CoreGatewayHttpRouteTargetQuery) First(ctx context.Context) (*CoreGatewayHttpRouteTarget, error) {
+	nodes, err := _q.Limit(1).All(setContextOp(ctx, _q.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{coregatewayhttproutetarget.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (_q *CoreGatewayHttpRouteTargetQuery) FirstX(ctx context.Context) *CoreGatewayHttpRouteTarget {
+	node, err := _q.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first CoreGatewayHttpRouteTarget ID from the query.
+// Returns a *NotFoundError when no CoreGatewayHttpRouteTarget ID was found.
+func (_q *CoreGatewayHttpRouteTargetQuery) FirstID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = _q.Limit(1).IDs(setContextOp(ctx, _q.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{coregatewayhttproutetarget.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (_q *CoreGatewayHttpRouteTargetQuery) FirstIDX(ctx context.Context) string {
+	id, err := _q.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single CoreGatewayHttpRouteTarget entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one CoreGatewayHttpRouteTarget entity is found.
+// Returns a *NotFoundError when no CoreGatewayHttpRouteTarget entities are found.
+func (_q *CoreGatewayHttpRouteTargetQuery) Only(ctx context.Context) (*CoreGatewayHttpRouteTarget, error) {
+	nodes, err := _q.Limit(2).All(setContextOp(ctx, _q.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{coregatewayhttproutetarget.Label}
+	default:
+		return nil, &NotSingularError{coregatewayhttproutetarget.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (_q *CoreGatewayHttpRouteTargetQuery) OnlyX(ctx context.Context) *CoreGatewayHttpRouteTarget {
+	node, err := _q.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only CoreGatewayHttpRouteTarget ID in the query.
+// Returns a *NotSingularError when more than one CoreGatewayHttpRouteTarget ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (_q *CoreGatewayHttpRouteTargetQuery) OnlyID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = _q.Limit(2).IDs(setContextOp(ctx, _q.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{coregatewayhttproutetarget.Label}
+	default:
+		err = &NotSingularError{coregatewayhttproutetarget.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (_q *CoreGatewayHttpRouteTargetQuery) OnlyIDX(ctx context.Context) string {
+	id, err := _q.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of CoreGatewayHttpRouteTargets.
+func (_q *CoreGatewayHttpRouteTargetQuery) All(ctx context.Context) ([]*CoreGatewayHttpRouteTarget, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryAll)
+	if err := _q.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*CoreGatewayHttpRouteTarget, *CoreGatewayHttpRouteTargetQuery]()
+	return withInterceptors[[]*CoreGatewayHttpRouteTarget](ctx, _q, qr, _q.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (_q *CoreGatewayHttpRouteTargetQuery) AllX(ctx context.Context) []*CoreGatewayHttpRouteTarget {
+	nodes, err := _q.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of CoreGatewayHttpRouteTarget IDs.
+func (_q *CoreGatewayHttpRouteTargetQuery) IDs(ctx context.Context) (ids []string, err error) {
+	if _q.ctx.Unique == nil && _q.path != nil {
+		_q.Unique(true)
+	}
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryIDs)
+	if err = _q.Select(coregatewayhttproutetarget.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (_q *CoreGatewayHttpRouteTargetQuery) IDsX(ctx context.Context) []string {
+	ids, err := _q.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (_q *CoreGatewayHttpRouteTargetQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryCount)
+	if err := _q.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, _q, querierCount[*CoreGatewayHttpRouteTargetQuery](), _q.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (_q *CoreGatewayHttpRouteTargetQuery) CountX(ctx context.Context) int {
+	count, err := _q.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (_q *CoreGatewayHttpRouteTargetQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryExist)
+	switch _, err := _q.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (_q *CoreGatewayHttpRouteTargetQuery) ExistX(ctx context.Context) bool {
+	exist, err := _q.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the CoreGatewayHttpRouteTargetQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (_q *CoreGatewayHttpRouteTargetQuery) Clone() *CoreGatewayHttpRouteTargetQuery {
+	if _q == nil {
+		return nil
+	}
+	return &CoreGatewayHttpRouteTargetQuery{
+		config:                 _q.config,
+		ctx:                    _q.ctx.Clone(),
+		order:                  append([]coregatewayhttproutetarget.OrderOption{}, _q.order...),
+		inters:                 append([]Interceptor{}, _q.inters...),
+		predicates:             append([]predicate.CoreGatewayHttpRouteTarget{}, _q.predicates...),
+		withTargetFromRoute:    _q.withTargetFromRoute.Clone(),
+		withTargetFromUpstream: _q.withTargetFromUpstream.Clone(),
+		// clone intermediate query.
+		sql:       _q.sql.Clone(),
+		path:      _q.path,
+		modifiers: append([]func(*sql.Selector){}, _q.modifiers...),
+	}
+}
+
+// WithTargetFromRoute tells the query-builder to eager-load the nodes that are connected to
+// the "target_from_route" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *CoreGatewayHttpRouteTargetQuery) WithTargetFromRoute(opts ...func(*CoreGatewayHttpRouteQuery)) *CoreGatewayHttpRouteTargetQuery {
+	query := (&CoreGatewayHttpRouteClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withTargetFromRoute = query
+	return _q
+}
+
+// WithTargetFromUpstream tells the query-builder to eager-load the nodes that are connected to
+// the "target_from_upstream" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *CoreGatewayHttpRouteTargetQuery) WithTargetFromUpstream(opts ...func(*CoreUpstreamQuery)) *CoreGatewayHttpRouteTargetQuery {
+	query := (&CoreUpstreamClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withTargetFromUpstream = query
+	return _q
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.CoreGatewayHttpRouteTarget.Query().
+//		GroupBy(coregatewayhttproutetarget.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (_q *CoreGatewayHttpRouteTargetQuery) GroupBy(field string, fields ...string) *CoreGatewayHttpRouteTargetGroupBy {
+	_q.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &CoreGatewayHttpRouteTargetGroupBy{build: _q}
+	grbuild.flds = &_q.ctx.Fields
+	grbuild.label = coregatewayhttproutetarget.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.CoreGatewayHttpRouteTarget.Query().
+//		Select(coregatewayhttproutetarget.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (_q *CoreGatewayHttpRouteTargetQuery) Select(fields ...string) *CoreGatewayHttpRouteTargetSelect {
+	_q.ctx.Fields = append(_q.ctx.Fields, fields...)
+	sbuild := &CoreGatewayHttpRouteTargetSelect{CoreGatewayHttpRouteTargetQuery: _q}
+	sbuild.label = coregatewayhttproutetarget.Label
+	sbuild.flds, sbuild.scan = &_q.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a CoreGatewayHttpRouteTargetSelect configured with the given aggregations.
+func (_q *CoreGatewayHttpRouteTargetQuery) Aggregate(fns ...AggregateFunc) *CoreGatewayHttpRouteTargetSelect {
+	return _q.Select().Aggregate(fns...)
+}
+
+func (_q *CoreGatewayHttpRouteTargetQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range _q.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, _q); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range _q.ctx.Fields {
+		if !coregatewayhttproutetarget.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if _q.path != nil {
+		prev, err := _q.path(ctx)
+		if err != nil {
+			return err
+		}
+		_q.sql = prev
+	}
+	return nil
+}
+
+func (_q *CoreGatewayHttpRouteTargetQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*CoreGatewayHttpRouteTarget, error) {
+	var (
+		nodes       = []*CoreGatewayHttpRouteTarget{}
+		_spec       = _q.querySpec()
+		loadedTypes = [2]bool{
+			_q.withTargetFromRoute != nil,
+			_q.withTargetFromUpstream != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*CoreGatewayHttpRouteTarget).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &CoreGatewayHttpRouteTarget{config: _q.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, _q.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := _q.withTargetFromRoute; query != nil {
+		if err := _q.loadTargetFromRoute(ctx, query, nodes, nil,
+			func(n *CoreGatewayHttpRouteTarget, e *CoreGatewayHttpRoute) { n.Edges.TargetFromRoute = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withTargetFromUpstream; query != nil {
+		if err := _q.loadTargetFromUpstream(ctx, query, nodes, nil,
+			func(n *CoreGatewayHttpRouteTarget, e *CoreUpstream) { n.Edges.TargetFromUpstream = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (_q *CoreGatewayHttpRouteTargetQuery) loadTargetFromRoute(ctx context.Context, query *CoreGatewayHttpRouteQuery, nodes []*CoreGatewayHttpRouteTarget, init func(*CoreGatewayHttpRouteTarget), assign func(*CoreGatewayHttpRouteTarget, *CoreGatewayHttpRoute)) error {
+	ids := make([]string, 0, len(nodes))
+	nodeids := make(map[string][]*CoreGatewayHttpRouteTarget)
+	for i := range nodes {
+		fk := nodes[i].RouteID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(coregatewayhttproute.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "route_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (_q *CoreGatewayHttpRouteTargetQuery) loadTargetFromUpstream(ctx context.Context, query *CoreUpstreamQuery, nodes []*CoreGatewayHttpRouteTarget, init func(*CoreGatewayHttpRouteTarget), assign func(*CoreGatewayHttpRouteTarget, *CoreUpstream)) error {
+	ids := make([]string, 0, len(nodes))
+	nodeids := make(map[string][]*CoreGatewayHttpRouteTarget)
+	for i := range nodes {
+		fk := nodes[i].UpstreamID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(coreupstream.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "upstream_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (_q *CoreGatewayHttpRouteTargetQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := _q.querySpec()
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
+	_spec.Node.Columns = _q.ctx.Fields
+	if len(_q.ctx.Fields) > 0 {
+		_spec.Unique = _q.ctx.Unique != nil && *_q.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, _q.driver, _spec)
+}
+
+func (_q *CoreGatewayHttpRouteTargetQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(coregatewayhttproutetarget.Table, coregatewayhttproutetarget.Columns, sqlgraph.NewFieldSpec(coregatewayhttproutetarget.FieldID, field.TypeString))
+	_spec.From = _q.sql
+	if unique := _q.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if _q.path != nil {
+		_spec.Unique = true
+	}
+	if fields := _q.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, coregatewayhttproutetarget.FieldID)
+		for i := range fields {
+			if fields[i] != coregatewayhttproutetarget.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if _q.withTargetFromRoute != nil {
+			_spec.Node.AddColumnOnce(coregatewayhttproutetarget.FieldRouteID)
+		}
+		if _q.withTargetFromUpstream != nil {
+			_spec.Node.AddColumnOnce(coregatewayhttproutetarget.FieldUpstreamID)
+		}
+	}
+	if ps := _q.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := _q.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := _q.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := _q.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (_q *CoreGatewayHttpRouteTargetQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(_q.driver.Dialect())
+	t1 := builder.Table(coregatewayhttproutetarget.Table)
+	columns := _q.ctx.Fields
+	if len(columns) == 0 {
+		columns = coregatewayhttproutetarget.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if _q.sql != nil {
+		selector = _q.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if _q.ctx.Unique != nil && *_q.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range _q.modifiers {
+		m(selector)
+	}
+	for _, p := range _q.predicates {
+		p(selector)
+	}
+	for _, p := range _q.order {
+		p(selector)
+	}
+	if offset := _q.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := _q.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_q *CoreGatewayHttpRouteTargetQuery) Modify(modifiers ...func(s *sql.Selector)) *CoreGatewayHttpRouteTargetSelect {
+	_q.modifiers = append(_q.modifiers, modifiers...)
+	return _q.Select()
+}
+
+// CoreGatewayHttpRouteTargetGroupBy is the group-by builder for CoreGatewayHttpRouteTarget entities.
+type CoreGatewayHttpRouteTargetGroupBy struct {
+	selector
+	build *CoreGatewayHttpRouteTargetQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (_g *CoreGatewayHttpRouteTargetGroupBy) Aggregate(fns ...AggregateFunc) *CoreGatewayHttpRouteTargetGroupBy {
+	_g.fns = append(_g.fns, fns...)
+	return _g
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (_g *CoreGatewayHttpRouteTargetGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, _g.build.ctx, ent.OpQueryGroupBy)
+	if err := _g.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*CoreGatewayHttpRouteTargetQuery, *CoreGatewayHttpRouteTargetGroupBy](ctx, _g.build, _g, _g.build.inters, v)
+}
+
+func (_g *CoreGatewayHttpRouteTargetGroupBy) sqlScan(ctx context.Context, root *CoreGatewayHttpRouteTargetQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(_g.fns))
+	for _, fn := range _g.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*_g.flds)+len(_g.fns))
+		for _, f := range *_g.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*_g.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := _g.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// CoreGatewayHttpRouteTargetSelect is the builder for selecting fields of CoreGatewayHttpRouteTarget entities.
+type CoreGatewayHttpRouteTargetSelect struct {
+	*CoreGatewayHttpRouteTargetQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (_s *CoreGatewayHttpRouteTargetSelect) Aggregate(fns ...AggregateFunc) *CoreGatewayHttpRouteTargetSelect {
+	_s.fns = append(_s.fns, fns...)
+	return _s
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (_s *CoreGatewayHttpRouteTargetSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, _s.ctx, ent.OpQuerySelect)
+	if err := _s.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*CoreGatewayHttpRouteTargetQuery, *CoreGatewayHttpRouteTargetSelect](ctx, _s.CoreGatewayHttpRouteTargetQuery, _s, _s.inters, v)
+}
+
+func (_s *CoreGatewayHttpRouteTargetSelect) sqlScan(ctx context.Context, root *CoreGatewayHttpRouteTargetQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(_s.fns))
+	for _, fn := range _s.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*_s.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := _s.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_s *CoreGatewayHttpRouteTargetSelect) Modify(modifiers ...func(s *sql.Selector)) *CoreGatewayHttpRouteTargetSelect {
+	_s.modifiers = append(_s.modifiers, modifiers...)
+	return _s
+}
@@ -0,0 +1,662 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/coregatewayhttproute"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/coregatewayhttproutetarget"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/coreupstream"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/predicate"
+)
+
+// CoreGatewayHttpRouteTargetUpdate is the builder for updating CoreGatewayHttpRouteTarget entities.
+type CoreGatewayHttpRouteTargetUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *CoreGatewayHttpRouteTargetMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the CoreGatewayHttpRouteTargetUpdate builder.
+func (_u *CoreGatewayHttpRouteTargetUpdate) Where(ps ...predicate.CoreGatewayHttpRouteTarget) *CoreGatewayHttpRouteTargetUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *CoreGatewayHttpRouteTargetUpdate) SetUpdatedAt(v time.Time) *CoreGatewayHttpRouteTargetUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *CoreGatewayHttpRouteTargetUpdate) SetDeletedAt(v time.Time) *CoreGatewayHttpRouteTargetUpdate {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *CoreGatewayHttpRouteTargetUpdate) SetNillableDeletedAt(v *time.Time) *CoreGatewayHttpRouteTargetUpdate {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *CoreGatewayHttpRouteTargetUpdate) ClearDeletedAt() *CoreGatewayHttpRouteTargetUpdate {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetRouteID sets the "route_id" field.
+func (_u *CoreGatewayHttpRouteTargetUpdate) SetRouteID(v string) *CoreGatewayHttpRouteTargetUpdate {
+	_u.mutation.SetRouteID(v)
+	return _u
+}
+
+// SetNillableRouteID sets the "route_id" field if the given value is not nil.
+func (_u *CoreGatewayHttpRouteTargetUpdate) SetNillableRouteID(v *string) *CoreGatewayHttpRouteTargetUpdate {
+	if v != nil {
+		_u.SetRouteID(*v)
+	}
+	return _u
+}
+
+// ClearRouteID clears the value of the "route_id" field.
+func (_u *CoreGatewayHttpRouteTargetUpdate) ClearRouteID() *CoreGatewayHttpRouteTargetUpdate {
+	_u.mutation.ClearRouteID()
+	return _u
+}
+
+// SetUpstreamID sets the "upstream_id" field.
+func (_u *CoreGatewayHttpRouteTargetUpdate) SetUpstreamID(v string) *CoreGatewayHttpRouteTargetUpdate {
+	_u.mutation.SetUpstreamID(v)
+	return _u
+}
+
+// SetNillableUpstreamID sets the "upstream_id" field if the given value is not nil.
+func (_u *CoreGatewayHttpRouteTargetUpdate) SetNillableUpstreamID(v *string) *CoreGatewayHttpRouteTargetUpdate {
+	if v != nil {
+		_u.SetUpstreamID(*v)
+	}
+	return _u
+}
+
+// ClearUpstreamID clears the value of the "upstream_id" field.
+func (_u *CoreGatewayHttpRouteTargetUpdate) ClearUpstreamID() *CoreGatewayHttpRouteTargetUpdate {
+	_u.mutation.ClearUpstreamID()
+	return _u
+}
+
+// SetWeight sets the "weight" field.
+func (_u *CoreGatewayHttpRouteTargetUpdate) SetWeight(v int) *CoreGatewayHttpRouteTargetUpdate {
+	_u.mutation.ResetWeight()
+	_u.mutation.SetWeight(v)
+	return _u
+}
+
+// SetNillableWeight sets the "weight" field if the given value is not nil.
+func (_u *CoreGatewayHttpRouteTargetUpdate) SetNillableWeight(v *int) *CoreGatewayHttpRouteTargetUpdate {
+	if v != nil {
+		_u.SetWeight(*v)
+	}
+	return _u
+}
+
+// AddWeight adds value to the "weight" field.
+func (_u *CoreGatewayHttpRouteTargetUpdate) AddWeight(v int) *CoreGatewayHttpRouteTargetUpdate {
+	_u.mutation.AddWeight(v)
+	return _u
+}
+
+// ClearWeight clears the value of the "weight" field.
+func (_u *CoreGatewayHttpRouteTargetUpdate) ClearWeight() *CoreGatewayHttpRouteTargetUpdate {
+	_u.mutation.ClearWeight()
+	return _u
+}
+
+// SetTargetFromRouteID sets the "target_from_route" edge to the CoreGatewayHttpRoute entity by ID.
+func (_u *CoreGatewayHttpRouteTargetUpdate) SetTargetFromRouteID(id string) *CoreGatewayHttpRouteTargetUpdate {
+	_u.mutation.SetTargetFromRouteID(id)
+	return _u
+}
+
+// SetNillableTargetFromRouteID sets the "target_from_route" edge to the CoreGatewayHttpRoute entity by ID if the given value is not nil.
+func (_u *CoreGatewayHttpRouteTargetUpdate) SetNillableTargetFromRouteID(id *string) *CoreGatewayHttpRouteTargetUpdate {
+	if id != nil {
+		_u = _u.SetTargetFromRouteID(*id)
+	}
+	return _u
+}
+
+// SetTargetFromRoute sets the "target_from_route" edge to the CoreGatewayHttpRoute entity.
+func (_u *CoreGatewayHttpRouteTargetUpdate) SetTargetFromRoute(v *CoreGatewayHttpRoute) *CoreGatewayHttpRouteTargetUpdate {
+	return _u.SetTargetFromRouteID(v.ID)
+}
+
+// SetTargetFromUpstreamID sets the "target_from_upstream" edge to the CoreUpstream entity by ID.
+func (_u *CoreGatewayHttpRouteTargetUpdate) SetTargetFromUpstreamID(id string) *CoreGatewayHttpRouteTargetUpdate {
+	_u.mutation.SetTargetFromUpstreamID(id)
+	return _u
+}
+
+// SetNillableTargetFromUpstreamID sets the "target_from_upstream" edge to the CoreUpstream entity by ID if the given value is not nil.
+func (_u *CoreGatewayHttpRouteTargetUpdate) SetNillableTargetFromUpstreamID(id *string) *CoreGatewayHttpRouteTargetUpdate {
+	if id != nil {
+		_u = _u.SetTargetFromUpstreamID(*id)
+	}
+	return _u
+}
+
+// SetTargetFromUpstream sets the "target_from_upstream" edge to the CoreUpstream entity.
+func (_u *CoreGatewayHttpRouteTargetUpdate) SetTargetFromUpstream(v *CoreUpstream) *CoreGatewayHttpRouteTargetUpdate {
+	return _u.SetTargetFromUpstreamID(v.ID)
+}
+
+// Mutation returns the CoreGatewayHttpRouteTargetMutation object of the builder.
+func (_u *CoreGatewayHttpRouteTargetUpdate) Mutation() *CoreGatewayHttpRouteTargetMutation {
+	return _u.mutation
+}
+
+// ClearTargetFromRoute clears the "target_from_route" edge to the CoreGatewayHttpRoute entity.
+func (_u *CoreGatewayHttpRouteTargetUpdate) ClearTargetFromRoute() *CoreGatewayHttpRouteTargetUpdate {
+	_u.mutation.ClearTargetFromRoute()
+	return _u
+}
+
+// ClearTargetFromUpstream clears the "target_from_upstream" edge to the CoreUpstream entity.
+func (_u *CoreGatewayHttpRouteTargetUpdate) ClearTargetFromUpstream() *CoreGatewayHttpRouteTargetUpdate {
+	_u.mutation.ClearTargetFromUpstream()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *CoreGatewayHttpRouteTargetUpdate) Save(ctx context.Context) (int, error) {
+	if err := _u.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *CoreGatewayHttpRouteTargetUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *CoreGatewayHttpRouteTargetUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *CoreGatewayHttpRouteTargetUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *CoreGatewayHttpRouteTargetUpdate) defaults() error {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		if coregatewayhttproutetarget.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized coregatewayhttproutetarget.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := coregatewayhttproutetarget.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *CoreGatewayHttpRouteTargetUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *CoreGatewayHttpRouteTargetUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *CoreGatewayHttpRouteTargetUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(coregatewayhttproutetarget.Table, coregatewayhttproutetarget.Columns, sqlgraph.NewFieldSpec(coregatewayhttproutetarget.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(coregatewayhttproutetarget.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(coregatewayhttproutetarget.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(coregatewayhttproutetarget.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Weight(); ok {
+		_spec.SetField(coregatewayhttproutetarget.FieldWeight, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedWeight(); ok {
+		_spec.AddField(coregatewayhttproutetarget.FieldWeight, field.TypeInt, value)
+	}
+	if _u.mutation.WeightCleared() {
+		_spec.ClearField(coregatewayhttproutetarget.FieldWeight, field.TypeInt)
+	}
+	if _u.mutation.TargetFromRouteCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   coregatewayhttproutetarget.TargetFromRouteTable,
+			Columns: []string{coregatewayhttproutetarget.TargetFromRouteColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(coregatewayhttproute.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TargetFromRouteIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   coregatewayhttproutetarget.TargetFromRouteTable,
+			Columns: []string{coregatewayhttproutetarget.TargetFromRouteColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(coregatewayhttproute.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.TargetFromUpstreamCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   coregatewayhttproutetarget.TargetFromUpstreamTable,
+			Columns: []string{coregatewayhttproutetarget.TargetFromUpstreamColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(coreupstream.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TargetFromUpstreamIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   coregatewayhttproutetarget.TargetFromUpstreamTable,
+			Columns: []string{coregatewayhttproutetarget.TargetFromUpstreamColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(coreupstream.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{coregatewayhttproutetarget.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// CoreGatewayHttpRouteTargetUpdateOne is the builder for updating a single CoreGatewayHttpRouteTarget entity.
+type CoreGatewayHttpRouteTargetUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *CoreGatewayHttpRouteTargetMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *CoreGatewayHttpRouteTargetUpdateOne) SetUpdatedAt(v time.Time) *CoreGatewayHttpRouteTargetUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *CoreGatewayHttpRouteTargetUpdateOne) SetDeletedAt(v time.Time) *CoreGatewayHttpRouteTargetUpdateOne {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *CoreGatewayHttpRouteTargetUpdateOne) SetNillableDeletedAt(v *time.Time) *CoreGatewayHttpRouteTargetUpdateOne {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *CoreGatewayHttpRouteTargetUpdateOne) ClearDeletedAt() *CoreGatewayHttpRouteTargetUpdateOne {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetRouteID sets the "route_id" field.
+func (_u *CoreGatewayHttpRouteTargetUpdateOne) SetRouteID(v string) *CoreGatewayHttpRouteTargetUpdateOne {
+	_u.mutation.SetRouteID(v)
+	return _u
+}
+
+// SetNillableRouteID sets the "route_id" field if the given value is not nil.
+func (_u *CoreGatewayHttpRouteTargetUpdateOne) SetNillableRouteID(v *string) *CoreGatewayHttpRouteTargetUpdateOne {
+	if v != nil {
+		_u.SetRouteID(*v)
+	}
+	return _u
+}
+
+// ClearRouteID clears the value of the "route_id" field.
+func (_u *CoreGatewayHttpRouteTargetUpdateOne) ClearRouteID() *CoreGatewayHttpRouteTargetUpdateOne {
+	_u.mutation.ClearRouteID()
+	return _u
+}
+
+// SetUpstreamID sets the "upstream_id" field.
+func (_u *CoreGatewayHttpRouteTargetUpdateOne) SetUpstreamID(v string) *CoreGatewayHttpRouteTargetUpdateOne {
+	_u.mutation.SetUpstreamID(v)
+	return _u
+}
+
+// SetNillableUpstreamID sets the "upstream_id" field if the given value is not nil.
+func (_u *CoreGatewayHttpRouteTargetUpdateOne) SetNillableUpstreamID(v *string) *CoreGatewayHttpRouteTargetUpdateOne {
+	if v != nil {
+		_u.SetUpstreamID(*v)
+	}
+	return _u
+}
+
+// ClearUpstreamID clears the value of the "upstream_id" field.
+func (_u *CoreGatewayHttpRouteTargetUpdateOne) ClearUpstreamID() *CoreGatewayHttpRouteTargetUpdateOne {
+	_u.mutation.ClearUpstreamID()
+	return _u
+}
+
+// SetWeight sets the "weight" field.
+func (_u *CoreGatewayHttpRouteTargetUpdateOne) SetWeight(v int) *CoreGatewayHttpRouteTargetUpdateOne {
+	_u.mutation.ResetWeight()
+	_u.mutation.SetWeight(v)
+	return _u
+}
+
+// SetNillableWeight sets the "weight" field if the given value is not nil.
+func (_u *CoreGatewayHttpRouteTargetUpdateOne) SetNillableWeight(v *int) *CoreGatewayHttpRouteTargetUpdateOne {
+	if v != nil {
+		_u.SetWeight(*v)
+	}
+	return _u
+}
+
+// AddWeight adds value to the "weight" field.
+func (_u *CoreGatewayHttpRouteTargetUpdateOne) AddWeight(v int) *CoreGatewayHttpRouteTargetUpdateOne {
+	_u.mutation.AddWeight(v)
+	return _u
+}
+
+// ClearWeight clears the value of the "weight" field.
+func (_u *CoreGatewayHttpRouteTargetUpdateOne) ClearWeight() *CoreGatewayHttpRouteTargetUpdateOne {
+	_u.mutation.ClearWeight()
+	return _u
+}
+
+// SetTargetFromRouteID sets the "target_from_route" edge to the CoreGatewayHttpRoute entity by ID.
+func (_u *CoreGatewayHttpRouteTargetUpdateOne) SetTargetFromRouteID(id string) *CoreGatewayHttpRouteTargetUpdateOne {
+	_u.mutation.SetTargetFromRouteID(id)
+	return _u
+}
+
+// SetNillableTargetFromRouteID sets the "target_from_route" edge to the CoreGatewayHttpRoute entity by ID if the given value is not nil.
+func (_u *CoreGatewayHttpRouteTargetUpdateOne) SetNillableTargetFromRouteID(id *string) *CoreGatewayHttpRouteTargetUpdateOne {
+	if id != nil {
+		_u = _u.SetTargetFromRouteID(*id)
+	}
+	return _u
+}
+
+// SetTargetFromRoute sets the "target_from_route" edge to the CoreGatewayHttpRoute entity.
+func (_u *CoreGatewayHttpRouteTargetUpdateOne) SetTargetFromRoute(v *CoreGatewayHttpRoute) *CoreGatewayHttpRouteTargetUpdateOne {
+	return _u.SetTargetFromRouteID(v.ID)
+}
+
+// SetTargetFromUpstreamID sets the "target_from_upstream" edge to the CoreUpstream entity by ID.
+func (_u *CoreGatewayHttpRouteTargetUpdateOne) SetTargetFromUpstreamID(id string) *CoreGatewayHttpRouteTargetUpdateOne {
+	_u.mutation.SetTargetFromUpstreamID(id)
+	return _u
+}
+
+// SetNillableTargetFromUpstreamID sets the "target_from_upstream" edge to the CoreUpstream entity by ID if the given value is not nil.
+func (_u *CoreGatewayHttpRouteTargetUpdateOne) SetNillableTargetFromUpstreamID(id *string) *CoreGatewayHttpRouteTargetUpdateOne {
+	if id != nil {
+		_u = _u.SetTargetFromUpstreamID(*id)
+	}
+	return _u
+}
+
+// SetTargetFromUpstream sets the "target_from_upstream" edge to the CoreUpstream entity.
+func (_u *CoreGatewayHttpRouteTargetUpdateOne) SetTargetFromUpstream(v *CoreUpstream) *CoreGatewayHttpRouteTargetUpdateOne {
+	return _u.SetTargetFromUpstreamID(v.ID)
+}
+
+// Mutation returns the CoreGatewayHttpRouteTargetMutation object of the builder.
+func (_u *CoreGatewayHttpRouteTargetUpdateOne) Mutation() *CoreGatewayHttpRouteTargetMutation {
+	return _u.mutation
+}
+
+// ClearTargetFromRoute clears the "target_from_route" edge to the CoreGatewayHttpRoute entity.
+func (_u *CoreGatewayHttpRouteTargetUpdateOne) ClearTargetFromRoute() *CoreGatewayHttpRouteTargetUpdateOne {
+	_u.mutation.ClearTargetFromRoute()
+	return _u
+}
+
+// ClearTargetFromUpstream clears the "target_from_upstream" edge to the CoreUpstream entity.
+func (_u *CoreGatewayHttpRouteTargetUpdateOne) ClearTargetFromUpstream() *CoreGatewayHttpRouteTargetUpdateOne {
+	_u.mutation.ClearTargetFromUpstream()
+	return _u
+}
+
+// Where appends a list predicates to the CoreGatewayHttpRouteTargetUpdate builder.
+func (_u *CoreGatewayHttpRouteTargetUpdateOne) Where(ps ...predicate.CoreGatewayHttpRouteTarget) *CoreGatewayHttpRouteTargetUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *CoreGatewayHttpRouteTargetUpdateOne) Select(field string, fields ...string) *CoreGatewayHttpRouteTargetUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated CoreGatewayHttpRouteTarget entity.
+func (_u *CoreGatewayHttpRouteTargetUpdateOne) Save(ctx context.Context) (*CoreGatewayHttpRouteTarget, error) {
+	if err := _u.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *CoreGatewayHttpRouteTargetUpdateOne) SaveX(ctx context.Context) *CoreGatewayHttpRouteTarget {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *CoreGatewayHttpRouteTargetUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *CoreGatewayHttpRouteTargetUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *CoreGatewayHttpRouteTargetUpdateOne) defaults() error {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		if coregatewayhttproutetarget.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized coregatewayhttproutetarget.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := coregatewayhttproutetarget.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *CoreGatewayHttpRouteTargetUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *CoreGatewayHttpRouteTargetUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *CoreGatewayHttpRouteTargetUpdateOne) sqlSave(ctx context.Context) (_node *CoreGatewayHttpRouteTarget, err error) {
+	_spec := sqlgraph.NewUpdateSpec(coregatewayhttproutetarget.Table, coregatewayhttproutetarget.Columns, sqlgraph.NewFieldSpec(coregatewayhttproutetarget.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "CoreGatewayHttpRouteTarget.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, coregatewayhttproutetarget.FieldID)
+		for _, f := range fields {
+			if !coregatewayhttproutetarget.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != coregatewayhttproutetarget.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(coregatewayhttproutetarget.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(coregatewayhttproutetarget.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(coregatewayhttproutetarget.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Weight(); ok {
+		_spec.SetField(coregatewayhttproutetarget.FieldWeight, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedWeight(); ok {
+		_spec.AddField(coregatewayhttproutetarget.FieldWeight, field.TypeInt, value)
+	}
+	if _u.mutation.WeightCleared() {
+		_spec.ClearField(coregatewayhttproutetarget.FieldWeight, field.TypeInt)
+	}
+	if _u.mutation.TargetFromRouteCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   coregatewayhttproutetarget.TargetFromRouteTable,
+			Columns: []string{coregatewayhttproutetarget.TargetFromRouteColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(coregatewayhttproute.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TargetFromRouteIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   coregatewayhttproutetarget.TargetFromRouteTable,
+			Columns: []string{coregatewayhttproutetarget.TargetFromRouteColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(coregatewayhttproute.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.TargetFromUpstreamCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   coregatewayhttproutetarget.TargetFromUpstreamTable,
+			Columns: []string{coregatewayhttproutetarget.TargetFromUpstreamColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(coreupstream.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TargetFromUpstreamIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   coregatewayhttproutetarget.TargetFromUpstreamTable,
+			Columns: []string{coregatewayhttproutetarget.TargetFromUpstreamColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(coreupstream.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &CoreGatewayHttpRouteTarget{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{coregatewayhttproutetarget.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -3,12 +3,14 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
+	"github.com/lyonmu/quebec/cmd/core/internal/common"
 	"github.com/lyonmu/quebec/cmd/core/internal/ent/coregatewayl7listener"
 	"github.com/lyonmu/quebec/pkg/constant"
 )
@@ -29,25 +31,54 @@ type CoreGatewayL7Listener struct {
 	Name string `json:"name,omitempty"`
 	// 监听器描述
 	Description string `json:"description,omitempty"`
+	// 所属网关集群ID(Envoy node.cluster)，为空表示所有集群
+	ClusterID string `json:"cluster_id,omitempty"`
 	// 监听端口
 	Port uint16 `json:"port,omitempty"`
 	// 监听地址
 	Host string `json:"host,omitempty"`
 	// 是否启用TLS [1: 启用, 2: 禁用]
 	EnableTLS constant.YesOrNo `json:"enable_tls,omitempty"`
+	// 本地限流，作用于监听器上未单独配置限流的路由
+	LocalRateLimit *common.LocalRateLimit `json:"local_rate_limit,omitempty"`
+	// 响应压缩，为空表示不压缩
+	Compression *common.Compression `json:"compression,omitempty"`
 	// 是否启用 [1: 启用, 2: 禁用]
-	Status       constant.YesOrNo `json:"status,omitempty"`
+	Status constant.YesOrNo `json:"status,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the CoreGatewayL7ListenerQuery when eager-loading is set.
+	Edges        CoreGatewayL7ListenerEdges `json:"-" gorm:"-"`
 	selectValues sql.SelectValues
 }
 
+// CoreGatewayL7ListenerEdges holds the relations/edges for other nodes in the graph.
+type CoreGatewayL7ListenerEdges struct {
+	// ListenerToVhost holds the value of the listener_to_vhost edge.
+	ListenerToVhost []*CoreGatewayVirtualHost `json:"listener_to_vhost,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// ListenerToVhostOrErr returns the ListenerToVhost value or an error if the edge
+// was not loaded in eager-loading.
+func (e CoreGatewayL7ListenerEdges) ListenerToVhostOrErr() ([]*CoreGatewayVirtualHost, error) {
+	if e.loadedTypes[0] {
+		return e.ListenerToVhost, nil
+	}
+	return nil, &NotLoadedError{edge: "listener_to_vhost"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*CoreGatewayL7Listener) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case coregatewayl7listener.FieldLocalRateLimit, coregatewayl7listener.FieldCompression:
+			values[i] = new([]byte)
 		case coregatewayl7listener.FieldPort, coregatewayl7listener.FieldEnableTLS, coregatewayl7listener.FieldStatus:
 			values[i] = new(sql.NullInt64)
-		case coregatewayl7listener.FieldID, coregatewayl7listener.FieldName, coregatewayl7listener.FieldDescription, coregatewayl7listener.FieldHost:
+		case coregatewayl7listener.FieldID, coregatewayl7listener.FieldName, coregatewayl7listener.FieldDescription, coregatewayl7listener.FieldClusterID, coregatewayl7listener.FieldHost:
 			values[i] = new(sql.NullString)
 		case coregatewayl7listener.FieldCreatedAt, coregatewayl7listener.FieldUpdatedAt, coregatewayl7listener.FieldDeletedAt:
 			values[i] = new(sql.NullTime)
@@ -103,6 +134,12 @@ func (_m *CoreGatewayL7Listener) assignValues(columns []string, values []any) er
 			} else if value.Valid {
 				_m.Description = value.String
 			}
+		case coregatewayl7listener.FieldClusterID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field cluster_id", values[i])
+			} else if value.Valid {
+				_m.ClusterID = value.String
+			}
 		case coregatewayl7listener.FieldPort:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field port", values[i])
@@ -121,6 +158,22 @@ func (_m *CoreGatewayL7Listener) assignValues(columns []string, values []any) er
 			} else if value.Valid {
 				_m.EnableTLS = constant.YesOrNo(value.Int64)
 			}
+		case coregatewayl7listener.FieldLocalRateLimit:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field local_rate_limit", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.LocalRateLimit); err != nil {
+					return fmt.Errorf("unmarshal field local_rate_limit: %w", err)
+				}
+			}
+		case coregatewayl7listener.FieldCompression:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field compression", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Compression); err != nil {
+					return fmt.Errorf("unmarshal field compression: %w", err)
+				}
+			}
 		case coregatewayl7listener.FieldStatus:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field status", values[i])
@@ -140,6 +193,11 @@ func (_m *CoreGatewayL7Listener) Value(name string) (ent.Value, error) {
 	return _m.selectValues.Get(name)
 }
 
+// QueryListenerToVhost queries the "listener_to_vhost" edge of the CoreGatewayL7Listener entity.
+func (_m *CoreGatewayL7Listener) QueryListenerToVhost() *CoreGatewayVirtualHostQuery {
+	return NewCoreGatewayL7ListenerClient(_m.config).QueryListenerToVhost(_m)
+}
+
 // Update returns a builder for updating this CoreGatewayL7Listener.
 // Note that you need to call CoreGatewayL7Listener.Unwrap() before calling this method if this CoreGatewayL7Listener
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -180,6 +238,9 @@ func (_m *CoreGatewayL7Listener) String() string {
 	builder.WriteString("description=")
 	builder.WriteString(_m.Description)
 	builder.WriteString(", ")
+	builder.WriteString("cluster_id=")
+	builder.WriteString(_m.ClusterID)
+	builder.WriteString(", ")
 	builder.WriteString("port=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Port))
 	builder.WriteString(", ")
@@ -189,6 +250,12 @@ func (_m *CoreGatewayL7Listener) String() string {
 	builder.WriteString("enable_tls=")
 	builder.WriteString(fmt.Sprintf("%v", _m.EnableTLS))
 	builder.WriteString(", ")
+	builder.WriteString("local_rate_limit=")
+	builder.WriteString(fmt.Sprintf("%v", _m.LocalRateLimit))
+	builder.WriteString(", ")
+	builder.WriteString("compression=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Compression))
+	builder.WriteString(", ")
 	builder.WriteString("status=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Status))
 	builder.WriteByte(')')
@@ -7,6 +7,7 @@ import (
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/lyonmu/quebec/pkg/constant"
 )
 
@@ -25,16 +26,31 @@ const (
 	FieldName = "name"
 	// FieldDescription holds the string denoting the description field in the database.
 	FieldDescription = "description"
+	// FieldClusterID holds the string denoting the cluster_id field in the database.
+	FieldClusterID = "cluster_id"
 	// FieldPort holds the string denoting the port field in the database.
 	FieldPort = "port"
 	// FieldHost holds the string denoting the host field in the database.
 	FieldHost = "host"
 	// FieldEnableTLS holds the string denoting the enable_tls field in the database.
 	FieldEnableTLS = "enable_tls"
+	// FieldLocalRateLimit holds the string denoting the local_rate_limit field in the database.
+	FieldLocalRateLimit = "local_rate_limit"
+	// FieldCompression holds the string denoting the compression field in the database.
+	FieldCompression = "compression"
 	// FieldStatus holds the string denoting the status field in the database.
 	FieldStatus = "status"
+	// EdgeListenerToVhost holds the string denoting the listener_to_vhost edge name in mutations.
+	EdgeListenerToVhost = "listener_to_vhost"
 	// Table holds the table name of the coregatewayl7listener in the database.
 	Table = "quebec_core_gateway_l7_listener"
+	// ListenerToVhostTable is the table that holds the listener_to_vhost relation/edge.
+	ListenerToVhostTable = "quebec_core_gateway_virtual_host"
+	// ListenerToVhostInverseTable is the table name for the CoreGatewayVirtualHost entity.
+	// It exists in this package in order to avoid circular dependency with the "coregatewayvirtualhost" package.
+	ListenerToVhostInverseTable = "quebec_core_gateway_virtual_host"
+	// ListenerToVhostColumn is the table column denoting the listener_to_vhost relation/edge.
+	ListenerToVhostColumn = "listener_id"
 )
 
 // Columns holds all SQL columns for coregatewayl7listener fields.
@@ -45,9 +61,12 @@ var Columns = []string{
 	FieldDeletedAt,
 	FieldName,
 	FieldDescription,
+	FieldClusterID,
 	FieldPort,
 	FieldHost,
 	FieldEnableTLS,
+	FieldLocalRateLimit,
+	FieldCompression,
 	FieldStatus,
 }
 
@@ -119,6 +138,11 @@ func ByDescription(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldDescription, opts...).ToFunc()
 }
 
+// ByClusterID orders the results by the cluster_id field.
+func ByClusterID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldClusterID, opts...).ToFunc()
+}
+
 // ByPort orders the results by the port field.
 func ByPort(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldPort, opts...).ToFunc()
@@ -138,3 +162,24 @@ func ByEnableTLS(opts ...sql.OrderTermOption) OrderOption {
 func ByStatus(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldStatus, opts...).ToFunc()
 }
+
+// ByListenerToVhostCount orders the results by listener_to_vhost count.
+func ByListenerToVhostCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newListenerToVhostStep(), opts...)
+	}
+}
+
+// ByListenerToVhost orders the results by listener_to_vhost terms.
+func ByListenerToVhost(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newListenerToVhostStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newListenerToVhostStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ListenerToVhostInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ListenerToVhostTable, ListenerToVhostColumn),
+	)
+}
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/lyonmu/quebec/cmd/core/internal/ent/predicate"
 	"github.com/lyonmu/quebec/pkg/constant"
 )
@@ -90,6 +91,11 @@ func Description(v string) predicate.CoreGatewayL7Listener {
 	return predicate.CoreGatewayL7Listener(sql.FieldEQ(FieldDescription, v))
 }
 
+// ClusterID applies equality check predicate on the "cluster_id" field. It's identical to ClusterIDEQ.
+func ClusterID(v string) predicate.CoreGatewayL7Listener {
+	return predicate.CoreGatewayL7Listener(sql.FieldEQ(FieldClusterID, v))
+}
+
 // Port applies equality check predicate on the "port" field. It's identical to PortEQ.
 func Port(v uint16) predicate.CoreGatewayL7Listener {
 	return predicate.CoreGatewayL7Listener(sql.FieldEQ(FieldPort, v))
@@ -392,6 +398,81 @@ func DescriptionContainsFold(v string) predicate.CoreGatewayL7Listener {
 	return predicate.CoreGatewayL7Listener(sql.FieldContainsFold(FieldDescription, v))
 }
 
+// ClusterIDEQ applies the EQ predicate on the "cluster_id" field.
+func ClusterIDEQ(v string) predicate.CoreGatewayL7Listener {
+	return predicate.CoreGatewayL7Listener(sql.FieldEQ(FieldClusterID, v))
+}
+
+// ClusterIDNEQ applies the NEQ predicate on the "cluster_id" field.
+func ClusterIDNEQ(v string) predicate.CoreGatewayL7Listener {
+	return predicate.CoreGatewayL7Listener(sql.FieldNEQ(FieldClusterID, v))
+}
+
+// ClusterIDIn applies the In predicate on the "cluster_id" field.
+func ClusterIDIn(vs ...string) predicate.CoreGatewayL7Listener {
+	return predicate.CoreGatewayL7Listener(sql.FieldIn(FieldClusterID, vs...))
+}
+
+// ClusterIDNotIn applies the NotIn predicate on the "cluster_id" field.
+func ClusterIDNotIn(vs ...string) predicate.CoreGatewayL7Listener {
+	return predicate.CoreGatewayL7Listener(sql.FieldNotIn(FieldClusterID, vs...))
+}
+
+// ClusterIDGT applies the GT predicate on the "cluster_id" field.
+func ClusterIDGT(v string) predicate.CoreGatewayL7Listener {
+	return predicate.CoreGatewayL7Listener(sql.FieldGT(FieldClusterID, v))
+}
+
+// ClusterIDGTE applies the GTE predicate on the "cluster_id" field.
+func ClusterIDGTE(v string) predicate.CoreGatewayL7Listener {
+	return predicate.CoreGatewayL7Listener(sql.FieldGTE(FieldClusterID, v))
+}
+
+// ClusterIDLT applies the LT predicate on the "cluster_id" field.
+func ClusterIDLT(v string) predicate.CoreGatewayL7Listener {
+	return predicate.CoreGatewayL7Listener(sql.FieldLT(FieldClusterID, v))
+}
+
+// ClusterIDLTE applies the LTE predicate on the "cluster_id" field.
+func ClusterIDLTE(v string) predicate.CoreGatewayL7Listener {
+	return predicate.CoreGatewayL7Listener(sql.FieldLTE(FieldClusterID, v))
+}
+
+// ClusterIDContains applies the Contains predicate on the "cluster_id" field.
+func ClusterIDContains(v string) predicate.CoreGatewayL7Listener {
+	return predicate.CoreGatewayL7Listener(sql.FieldContains(FieldClusterID, v))
+}
+
+// ClusterIDHasPrefix applies the HasPrefix predicate on the "cluster_id" field.
+func ClusterIDHasPrefix(v string) predicate.CoreGatewayL7Listener {
+	return predicate.CoreGatewayL7Listener(sql.FieldHasPrefix(FieldClusterID, v))
+}
+
+// ClusterIDHasSuffix applies the HasSuffix predicate on the "cluster_id" field.
+func ClusterIDHasSuffix(v string) predicate.CoreGatewayL7Listener {
+	return predicate.CoreGatewayL7Listener(sql.FieldHasSuffix(FieldClusterID, v))
+}
+
+// ClusterIDIsNil applies the IsNil predicate on the "cluster_id" field.
+func ClusterIDIsNil() predicate.CoreGatewayL7Listener {
+	return predicate.CoreGatewayL7Listener(sql.FieldIsNull(FieldClusterID))
+}
+
+// ClusterIDNotNil applies the NotNil predicate on the "cluster_id" field.
+func ClusterIDNotNil() predicate.CoreGatewayL7Listener {
+	return predicate.CoreGatewayL7Listener(sql.FieldNotNull(FieldClusterID))
+}
+
+// ClusterIDEqualFold applies the EqualFold predicate on the "cluster_id" field.
+func ClusterIDEqualFold(v string) predicate.CoreGatewayL7Listener {
+	return predicate.CoreGatewayL7Listener(sql.FieldEqualFold(FieldClusterID, v))
+}
+
+// ClusterIDContainsFold applies the ContainsFold predicate on the "cluster_id" field.
+func ClusterIDContainsFold(v string) predicate.CoreGatewayL7Listener {
+	return predicate.CoreGatewayL7Listener(sql.FieldContainsFold(FieldClusterID, v))
+}
+
 // PortEQ applies the EQ predicate on the "port" field.
 func PortEQ(v uint16) predicate.CoreGatewayL7Listener {
 	return predicate.CoreGatewayL7Listener(sql.FieldEQ(FieldPort, v))
@@ -581,6 +662,26 @@ func EnableTLSNotNil() predicate.CoreGatewayL7Listener {
 	return predicate.CoreGatewayL7Listener(sql.FieldNotNull(FieldEnableTLS))
 }
 
+// LocalRateLimitIsNil applies the IsNil predicate on the "local_rate_limit" field.
+func LocalRateLimitIsNil() predicate.CoreGatewayL7Listener {
+	return predicate.CoreGatewayL7Listener(sql.FieldIsNull(FieldLocalRateLimit))
+}
+
+// LocalRateLimitNotNil applies the NotNil predicate on the "local_rate_limit" field.
+func LocalRateLimitNotNil() predicate.CoreGatewayL7Listener {
+	return predicate.CoreGatewayL7Listener(sql.FieldNotNull(FieldLocalRateLimit))
+}
+
+// CompressionIsNil applies the IsNil predicate on the "compression" field.
+func CompressionIsNil() predicate.CoreGatewayL7Listener {
+	return predicate.CoreGatewayL7Listener(sql.FieldIsNull(FieldCompression))
+}
+
+// CompressionNotNil applies the NotNil predicate on the "compression" field.
+func CompressionNotNil() predicate.CoreGatewayL7Listener {
+	return predicate.CoreGatewayL7Listener(sql.FieldNotNull(FieldCompression))
+}
+
 // StatusEQ applies the EQ predicate on the "status" field.
 func StatusEQ(v constant.YesOrNo) predicate.CoreGatewayL7Listener {
 	vc := int8(v)
@@ -645,6 +746,29 @@ func StatusNotNil() predicate.CoreGatewayL7Listener {
 	return predicate.CoreGatewayL7Listener(sql.FieldNotNull(FieldStatus))
 }
 
+// HasListenerToVhost applies the HasEdge predicate on the "listener_to_vhost" edge.
+func HasListenerToVhost() predicate.CoreGatewayL7Listener {
+	return predicate.CoreGatewayL7Listener(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ListenerToVhostTable, ListenerToVhostColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasListenerToVhostWith applies the HasEdge predicate on the "listener_to_vhost" edge with a given conditions (other predicates).
+func HasListenerToVhostWith(preds ...predicate.CoreGatewayVirtualHost) predicate.CoreGatewayL7Listener {
+	return predicate.CoreGatewayL7Listener(func(s *sql.Selector) {
+		step := newListenerToVhostStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.CoreGatewayL7Listener) predicate.CoreGatewayL7Listener {
 	return predicate.CoreGatewayL7Listener(sql.AndPredicates(predicates...))
@@ -12,7 +12,9 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/lyonmu/quebec/cmd/core/internal/common"
 	"github.com/lyonmu/quebec/cmd/core/internal/ent/coregatewayl7listener"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/coregatewayvirtualhost"
 	"github.com/lyonmu/quebec/pkg/constant"
 )
 
@@ -94,6 +96,20 @@ func (_c *CoreGatewayL7ListenerCreate) SetNillableDescription(v *string) *CoreGa
 	return _c
 }
 
+// SetClusterID sets the "cluster_id" field.
+func (_c *CoreGatewayL7ListenerCreate) SetClusterID(v string) *CoreGatewayL7ListenerCreate {
+	_c.mutation.SetClusterID(v)
+	return _c
+}
+
+// SetNillableClusterID sets the "cluster_id" field if the given value is not nil.
+func (_c *CoreGatewayL7ListenerCreate) SetNillableClusterID(v *string) *CoreGatewayL7ListenerCreate {
+	if v != nil {
+		_c.SetClusterID(*v)
+	}
+	return _c
+}
+
 // SetPort sets the "port" field.
 func (_c *CoreGatewayL7ListenerCreate) SetPort(v uint16) *CoreGatewayL7ListenerCreate {
 	_c.mutation.SetPort(v)
@@ -136,6 +152,18 @@ func (_c *CoreGatewayL7ListenerCreate) SetNillableEnableTLS(v *constant.YesOrNo)
 	return _c
 }
 
+// SetLocalRateLimit sets the "local_rate_limit" field.
+func (_c *CoreGatewayL7ListenerCreate) SetLocalRateLimit(v *common.LocalRateLimit) *CoreGatewayL7ListenerCreate {
+	_c.mutation.SetLocalRateLimit(v)
+	return _c
+}
+
+// SetCompression sets the "compression" field.
+func (_c *CoreGatewayL7ListenerCreate) SetCompression(v *common.Compression) *CoreGatewayL7ListenerCreate {
+	_c.mutation.SetCompression(v)
+	return _c
+}
+
 // SetStatus sets the "status" field.
 func (_c *CoreGatewayL7ListenerCreate) SetStatus(v constant.YesOrNo) *CoreGatewayL7ListenerCreate {
 	_c.mutation.SetStatus(v)
@@ -164,6 +192,21 @@ func (_c *CoreGatewayL7ListenerCreate) SetNillableID(v *string) *CoreGatewayL7Li
 	return _c
 }
 
+// AddListenerToVhostIDs adds the "listener_to_vhost" edge to the CoreGatewayVirtualHost entity by IDs.
+func (_c *CoreGatewayL7ListenerCreate) AddListenerToVhostIDs(ids ...string) *CoreGatewayL7ListenerCreate {
+	_c.mutation.AddListenerToVhostIDs(ids...)
+	return _c
+}
+
+// AddListenerToVhost adds the "listener_to_vhost" edges to the CoreGatewayVirtualHost entity.
+func (_c *CoreGatewayL7ListenerCreate) AddListenerToVhost(v ...*CoreGatewayVirtualHost) *CoreGatewayL7ListenerCreate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddListenerToVhostIDs(ids...)
+}
+
 // Mutation returns the CoreGatewayL7ListenerMutation object of the builder.
 func (_c *CoreGatewayL7ListenerCreate) Mutation() *CoreGatewayL7ListenerMutation {
 	return _c.mutation
@@ -306,6 +349,10 @@ func (_c *CoreGatewayL7ListenerCreate) createSpec() (*CoreGatewayL7Listener, *sq
 		_spec.SetField(coregatewayl7listener.FieldDescription, field.TypeString, value)
 		_node.Description = value
 	}
+	if value, ok := _c.mutation.ClusterID(); ok {
+		_spec.SetField(coregatewayl7listener.FieldClusterID, field.TypeString, value)
+		_node.ClusterID = value
+	}
 	if value, ok := _c.mutation.Port(); ok {
 		_spec.SetField(coregatewayl7listener.FieldPort, field.TypeUint16, value)
 		_node.Port = value
@@ -318,10 +365,34 @@ func (_c *CoreGatewayL7ListenerCreate) createSpec() (*CoreGatewayL7Listener, *sq
 		_spec.SetField(coregatewayl7listener.FieldEnableTLS, field.TypeInt8, value)
 		_node.EnableTLS = value
 	}
+	if value, ok := _c.mutation.LocalRateLimit(); ok {
+		_spec.SetField(coregatewayl7listener.FieldLocalRateLimit, field.TypeJSON, value)
+		_node.LocalRateLimit = value
+	}
+	if value, ok := _c.mutation.Compression(); ok {
+		_spec.SetField(coregatewayl7listener.FieldCompression, field.TypeJSON, value)
+		_node.Compression = value
+	}
 	if value, ok := _c.mutation.Status(); ok {
 		_spec.SetField(coregatewayl7listener.FieldStatus, field.TypeInt8, value)
 		_node.Status = value
 	}
+	if nodes := _c.mutation.ListenerToVhostIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   coregatewayl7listener.ListenerToVhostTable,
+			Columns: []string{coregatewayl7listener.ListenerToVhostColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(coregatewayvirtualhost.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -440,6 +511,24 @@ func (u *CoreGatewayL7ListenerUpsert) ClearDescription() *CoreGatewayL7ListenerU
 	return u
 }
 
+// SetClusterID sets the "cluster_id" field.
+func (u *CoreGatewayL7ListenerUpsert) SetClusterID(v string) *CoreGatewayL7ListenerUpsert {
+	u.Set(coregatewayl7listener.FieldClusterID, v)
+	return u
+}
+
+// UpdateClusterID sets the "cluster_id" field to the value that was provided on create.
+func (u *CoreGatewayL7ListenerUpsert) UpdateClusterID() *CoreGatewayL7ListenerUpsert {
+	u.SetExcluded(coregatewayl7listener.FieldClusterID)
+	return u
+}
+
+// ClearClusterID clears the value of the "cluster_id" field.
+func (u *CoreGatewayL7ListenerUpsert) ClearClusterID() *CoreGatewayL7ListenerUpsert {
+	u.SetNull(coregatewayl7listener.FieldClusterID)
+	return u
+}
+
 // SetPort sets the "port" field.
 func (u *CoreGatewayL7ListenerUpsert) SetPort(v uint16) *CoreGatewayL7ListenerUpsert {
 	u.Set(coregatewayl7listener.FieldPort, v)
@@ -506,6 +595,42 @@ func (u *CoreGatewayL7ListenerUpsert) ClearEnableTLS() *CoreGatewayL7ListenerUps
 	return u
 }
 
+// SetLocalRateLimit sets the "local_rate_limit" field.
+func (u *CoreGatewayL7ListenerUpsert) SetLocalRateLimit(v *common.LocalRateLimit) *CoreGatewayL7ListenerUpsert {
+	u.Set(coregatewayl7listener.FieldLocalRateLimit, v)
+	return u
+}
+
+// UpdateLocalRateLimit sets the "local_rate_limit" field to the value that was provided on create.
+func (u *CoreGatewayL7ListenerUpsert) UpdateLocalRateLimit() *CoreGatewayL7ListenerUpsert {
+	u.SetExcluded(coregatewayl7listener.FieldLocalRateLimit)
+	return u
+}
+
+// ClearLocalRateLimit clears the value of the "local_rate_limit" field.
+func (u *CoreGatewayL7ListenerUpsert) ClearLocalRateLimit() *CoreGatewayL7ListenerUpsert {
+	u.SetNull(coregatewayl7listener.FieldLocalRateLimit)
+	return u
+}
+
+// SetCompression sets the "compression" field.
+func (u *CoreGatewayL7ListenerUpsert) SetCompression(v *common.Compression) *CoreGatewayL7ListenerUpsert {
+	u.Set(coregatewayl7listener.FieldCompression, v)
+	return u
+}
+
+// UpdateCompression sets the "compression" field to the value that was provided on create.
+func (u *CoreGatewayL7ListenerUpsert) UpdateCompression() *CoreGatewayL7ListenerUpsert {
+	u.SetExcluded(coregatewayl7listener.FieldCompression)
+	return u
+}
+
+// ClearCompression clears the value of the "compression" field.
+func (u *CoreGatewayL7ListenerUpsert) ClearCompression() *CoreGatewayL7ListenerUpsert {
+	u.SetNull(coregatewayl7listener.FieldCompression)
+	return u
+}
+
 // SetStatus sets the "status" field.
 func (u *CoreGatewayL7ListenerUpsert) SetStatus(v constant.YesOrNo) *CoreGatewayL7ListenerUpsert {
 	u.Set(coregatewayl7listener.FieldStatus, v)
@@ -658,6 +783,27 @@ func (u *CoreGatewayL7ListenerUpsertOne) ClearDescription() *CoreGatewayL7Listen
 	})
 }
 
+// SetClusterID sets the "cluster_id" field.
+func (u *CoreGatewayL7ListenerUpsertOne) SetClusterID(v string) *CoreGatewayL7ListenerUpsertOne {
+	return u.Update(func(s *CoreGatewayL7ListenerUpsert) {
+		s.SetClusterID(v)
+	})
+}
+
+// UpdateClusterID sets the "cluster_id" field to the value that was provided on create.
+func (u *CoreGatewayL7ListenerUpsertOne) UpdateClusterID() *CoreGatewayL7ListenerUpsertOne {
+	return u.Update(func(s *CoreGatewayL7ListenerUpsert) {
+		s.UpdateClusterID()
+	})
+}
+
+// ClearClusterID clears the value of the "cluster_id" field.
+func (u *CoreGatewayL7ListenerUpsertOne) ClearClusterID() *CoreGatewayL7ListenerUpsertOne {
+	return u.Update(func(s *CoreGatewayL7ListenerUpsert) {
+		s.ClearClusterID()
+	})
+}
+
 // SetPort sets the "port" field.
 func (u *CoreGatewayL7ListenerUpsertOne) SetPort(v uint16) *CoreGatewayL7ListenerUpsertOne {
 	return u.Update(func(s *CoreGatewayL7ListenerUpsert) {
@@ -735,6 +881,48 @@ func (u *CoreGatewayL7ListenerUpsertOne) ClearEnableTLS() *CoreGatewayL7Listener
 	})
 }
 
+// SetLocalRateLimit sets the "local_rate_limit" field.
+func (u *CoreGatewayL7ListenerUpsertOne) SetLocalRateLimit(v *common.LocalRateLimit) *CoreGatewayL7ListenerUpsertOne {
+	return u.Update(func(s *CoreGatewayL7ListenerUpsert) {
+		s.SetLocalRateLimit(v)
+	})
+}
+
+// UpdateLocalRateLimit sets the "local_rate_limit" field to the value that was provided on create.
+func (u *CoreGatewayL7ListenerUpsertOne) UpdateLocalRateLimit() *CoreGatewayL7ListenerUpsertOne {
+	return u.Update(func(s *CoreGatewayL7ListenerUpsert) {
+		s.UpdateLocalRateLimit()
+	})
+}
+
+// ClearLocalRateLimit clears the value of the "local_rate_limit" field.
+func (u *CoreGatewayL7ListenerUpsertOne) ClearLocalRateLimit() *CoreGatewayL7ListenerUpsertOne {
+	return u.Update(func(s *CoreGatewayL7ListenerUpsert) {
+		s.ClearLocalRateLimit()
+	})
+}
+
+// SetCompression sets the "compression" field.
+func (u *CoreGatewayL7ListenerUpsertOne) SetCompression(v *common.Compression) *CoreGatewayL7ListenerUpsertOne {
+	return u.Update(func(s *CoreGatewayL7ListenerUpsert) {
+		s.SetCompression(v)
+	})
+}
+
+// UpdateCompression sets the "compression" field to the value that was provided on create.
+func (u *CoreGatewayL7ListenerUpsertOne) UpdateCompression() *CoreGatewayL7ListenerUpsertOne {
+	return u.Update(func(s *CoreGatewayL7ListenerUpsert) {
+		s.UpdateCompression()
+	})
+}
+
+// ClearCompression clears the value of the "compression" field.
+func (u *CoreGatewayL7ListenerUpsertOne) ClearCompression() *CoreGatewayL7ListenerUpsertOne {
+	return u.Update(func(s *CoreGatewayL7ListenerUpsert) {
+		s.ClearCompression()
+	})
+}
+
 // SetStatus sets the "status" field.
 func (u *CoreGatewayL7ListenerUpsertOne) SetStatus(v constant.YesOrNo) *CoreGatewayL7ListenerUpsertOne {
 	return u.Update(func(s *CoreGatewayL7ListenerUpsert) {
@@ -1058,6 +1246,27 @@ func (u *CoreGatewayL7ListenerUpsertBulk) ClearDescription() *CoreGatewayL7Liste
 	})
 }
 
+// SetClusterID sets the "cluster_id" field.
+func (u *CoreGatewayL7ListenerUpsertBulk) SetClusterID(v string) *CoreGatewayL7ListenerUpsertBulk {
+	return u.Update(func(s *CoreGatewayL7ListenerUpsert) {
+		s.SetClusterID(v)
+	})
+}
+
+// UpdateClusterID sets the "cluster_id" field to the value that was provided on create.
+func (u *CoreGatewayL7ListenerUpsertBulk) UpdateClusterID() *CoreGatewayL7ListenerUpsertBulk {
+	return u.Update(func(s *CoreGatewayL7ListenerUpsert) {
+		s.UpdateClusterID()
+	})
+}
+
+// ClearClusterID clears the value of the "cluster_id" field.
+func (u *CoreGatewayL7ListenerUpsertBulk) ClearClusterID() *CoreGatewayL7ListenerUpsertBulk {
+	return u.Update(func(s *CoreGatewayL7ListenerUpsert) {
+		s.ClearClusterID()
+	})
+}
+
 // SetPort sets the "port" field.
 func (u *CoreGatewayL7ListenerUpsertBulk) SetPort(v uint16) *CoreGatewayL7ListenerUpsertBulk {
 	return u.Update(func(s *CoreGatewayL7ListenerUpsert) {
@@ -1135,6 +1344,48 @@ func (u *CoreGatewayL7ListenerUpsertBulk) ClearEnableTLS() *CoreGatewayL7Listene
 	})
 }
 
+// SetLocalRateLimit sets the "local_rate_limit" field.
+func (u *CoreGatewayL7ListenerUpsertBulk) SetLocalRateLimit(v *common.LocalRateLimit) *CoreGatewayL7ListenerUpsertBulk {
+	return u.Update(func(s *CoreGatewayL7ListenerUpsert) {
+		s.SetLocalRateLimit(v)
+	})
+}
+
+// UpdateLocalRateLimit sets the "local_rate_limit" field to the value that was provided on create.
+func (u *CoreGatewayL7ListenerUpsertBulk) UpdateLocalRateLimit() *CoreGatewayL7ListenerUpsertBulk {
+	return u.Update(func(s *CoreGatewayL7ListenerUpsert) {
+		s.UpdateLocalRateLimit()
+	})
+}
+
+// ClearLocalRateLimit clears the value of the "local_rate_limit" field.
+func (u *CoreGatewayL7ListenerUpsertBulk) ClearLocalRateLimit() *CoreGatewayL7ListenerUpsertBulk {
+	return u.Update(func(s *CoreGatewayL7ListenerUpsert) {
+		s.ClearLocalRateLimit()
+	})
+}
+
+// SetCompression sets the "compression" field.
+func (u *CoreGatewayL7ListenerUpsertBulk) SetCompression(v *common.Compression) *CoreGatewayL7ListenerUpsertBulk {
+	return u.Update(func(s *CoreGatewayL7ListenerUpsert) {
+		s.SetCompression(v)
+	})
+}
+
+// UpdateCompression sets the "compression" field to the value that was provided on create.
+func (u *CoreGatewayL7ListenerUpsertBulk) UpdateCompression() *CoreGatewayL7ListenerUpsertBulk {
+	return u.Update(func(s *CoreGatewayL7ListenerUpsert) {
+		s.UpdateCompression()
+	})
+}
+
+// ClearCompression clears the value of the "compression" field.
+func (u *CoreGatewayL7ListenerUpsertBulk) ClearCompression() *CoreGatewayL7ListenerUpsertBulk {
+	return u.Update(func(s *CoreGatewayL7ListenerUpsert) {
+		s.ClearCompression()
+	})
+}
+
 // SetStatus sets the "status" field.
 func (u *CoreGatewayL7ListenerUpsertBulk) SetStatus(v constant.YesOrNo) *CoreGatewayL7ListenerUpsertBulk {
 	return u.Update(func(s *CoreGatewayL7ListenerUpsert) {
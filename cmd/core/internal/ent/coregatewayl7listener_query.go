@@ -4,6 +4,7 @@ package ent
 
 import (
 	"context"
+	"database/sql/driver"
 	"fmt"
 	"math"
 
@@ -12,17 +13,19 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/lyonmu/quebec/cmd/core/internal/ent/coregatewayl7listener"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/coregatewayvirtualhost"
 	"github.com/lyonmu/quebec/cmd/core/internal/ent/predicate"
 )
 
 // CoreGatewayL7ListenerQuery is the builder for querying CoreGatewayL7Listener entities.
 type CoreGatewayL7ListenerQuery struct {
 	config
-	ctx        *QueryContext
-	order      []coregatewayl7listener.OrderOption
-	inters     []Interceptor
-	predicates []predicate.CoreGatewayL7Listener
-	modifiers  []func(*sql.Selector)
+	ctx                 *QueryContext
+	order               []coregatewayl7listener.OrderOption
+	inters              []Interceptor
+	predicates          []predicate.CoreGatewayL7Listener
+	withListenerToVhost *CoreGatewayVirtualHostQuery
+	modifiers           []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -59,6 +62,28 @@ func (_q *CoreGatewayL7ListenerQuery) Order(o ...coregatewayl7listener.OrderOpti
 	return _q
 }
 
+// QueryListenerToVhost chains the current query on the "listener_to_vhost" edge.
+func (_q *CoreGatewayL7ListenerQuery) QueryListenerToVhost() *CoreGatewayVirtualHostQuery {
+	query := (&CoreGatewayVirtualHostClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(coregatewayl7listener.Table, coregatewayl7listener.FieldID, selector),
+			sqlgraph.To(coregatewayvirtualhost.Table, coregatewayvirtualhost.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, coregatewayl7listener.ListenerToVhostTable, coregatewayl7listener.ListenerToVhostColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first CoreGatewayL7Listener entity from the query.
 // Returns a *NotFoundError when no CoreGatewayL7Listener was found.
 func (_q *CoreGatewayL7ListenerQuery) First(ctx context.Context) (*CoreGatewayL7Listener, error) {
@@ -246,11 +271,12 @@ func (_q *CoreGatewayL7ListenerQuery) Clone() *CoreGatewayL7ListenerQuery {
 		return nil
 	}
 	return &CoreGatewayL7ListenerQuery{
-		config:     _q.config,
-		ctx:        _q.ctx.Clone(),
-		order:      append([]coregatewayl7listener.OrderOption{}, _q.order...),
-		inters:     append([]Interceptor{}, _q.inters...),
-		predicates: append([]predicate.CoreGatewayL7Listener{}, _q.predicates...),
+		config:              _q.config,
+		ctx:                 _q.ctx.Clone(),
+		order:               append([]coregatewayl7listener.OrderOption{}, _q.order...),
+		inters:              append([]Interceptor{}, _q.inters...),
+		predicates:          append([]predicate.CoreGatewayL7Listener{}, _q.predicates...),
+		withListenerToVhost: _q.withListenerToVhost.Clone(),
 		// clone intermediate query.
 		sql:       _q.sql.Clone(),
 		path:      _q.path,
@@ -258,6 +284,17 @@ func (_q *CoreGatewayL7ListenerQuery) Clone() *CoreGatewayL7ListenerQuery {
 	}
 }
 
+// WithListenerToVhost tells the query-builder to eager-load the nodes that are connected to
+// the "listener_to_vhost" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *CoreGatewayL7ListenerQuery) WithListenerToVhost(opts ...func(*CoreGatewayVirtualHostQuery)) *CoreGatewayL7ListenerQuery {
+	query := (&CoreGatewayVirtualHostClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withListenerToVhost = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -334,8 +371,11 @@ func (_q *CoreGatewayL7ListenerQuery) prepareQuery(ctx context.Context) error {
 
 func (_q *CoreGatewayL7ListenerQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*CoreGatewayL7Listener, error) {
 	var (
-		nodes = []*CoreGatewayL7Listener{}
-		_spec = _q.querySpec()
+		nodes       = []*CoreGatewayL7Listener{}
+		_spec       = _q.querySpec()
+		loadedTypes = [1]bool{
+			_q.withListenerToVhost != nil,
+		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
 		return (*CoreGatewayL7Listener).scanValues(nil, columns)
@@ -343,6 +383,7 @@ func (_q *CoreGatewayL7ListenerQuery) sqlAll(ctx context.Context, hooks ...query
 	_spec.Assign = func(columns []string, values []any) error {
 		node := &CoreGatewayL7Listener{config: _q.config}
 		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
 	if len(_q.modifiers) > 0 {
@@ -357,9 +398,49 @@ func (_q *CoreGatewayL7ListenerQuery) sqlAll(ctx context.Context, hooks ...query
 	if len(nodes) == 0 {
 		return nodes, nil
 	}
+	if query := _q.withListenerToVhost; query != nil {
+		if err := _q.loadListenerToVhost(ctx, query, nodes,
+			func(n *CoreGatewayL7Listener) { n.Edges.ListenerToVhost = []*CoreGatewayVirtualHost{} },
+			func(n *CoreGatewayL7Listener, e *CoreGatewayVirtualHost) {
+				n.Edges.ListenerToVhost = append(n.Edges.ListenerToVhost, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
+func (_q *CoreGatewayL7ListenerQuery) loadListenerToVhost(ctx context.Context, query *CoreGatewayVirtualHostQuery, nodes []*CoreGatewayL7Listener, init func(*CoreGatewayL7Listener), assign func(*CoreGatewayL7Listener, *CoreGatewayVirtualHost)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*CoreGatewayL7Listener)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(coregatewayvirtualhost.FieldListenerID)
+	}
+	query.Where(predicate.CoreGatewayVirtualHost(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(coregatewayl7listener.ListenerToVhostColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ListenerID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "listener_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+
 func (_q *CoreGatewayL7ListenerQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
 	if len(_q.modifiers) > 0 {
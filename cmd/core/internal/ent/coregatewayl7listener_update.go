@@ -11,7 +11,9 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/lyonmu/quebec/cmd/core/internal/common"
 	"github.com/lyonmu/quebec/cmd/core/internal/ent/coregatewayl7listener"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/coregatewayvirtualhost"
 	"github.com/lyonmu/quebec/cmd/core/internal/ent/predicate"
 	"github.com/lyonmu/quebec/pkg/constant"
 )
@@ -96,6 +98,26 @@ func (_u *CoreGatewayL7ListenerUpdate) ClearDescription() *CoreGatewayL7Listener
 	return _u
 }
 
+// SetClusterID sets the "cluster_id" field.
+func (_u *CoreGatewayL7ListenerUpdate) SetClusterID(v string) *CoreGatewayL7ListenerUpdate {
+	_u.mutation.SetClusterID(v)
+	return _u
+}
+
+// SetNillableClusterID sets the "cluster_id" field if the given value is not nil.
+func (_u *CoreGatewayL7ListenerUpdate) SetNillableClusterID(v *string) *CoreGatewayL7ListenerUpdate {
+	if v != nil {
+		_u.SetClusterID(*v)
+	}
+	return _u
+}
+
+// ClearClusterID clears the value of the "cluster_id" field.
+func (_u *CoreGatewayL7ListenerUpdate) ClearClusterID() *CoreGatewayL7ListenerUpdate {
+	_u.mutation.ClearClusterID()
+	return _u
+}
+
 // SetPort sets the "port" field.
 func (_u *CoreGatewayL7ListenerUpdate) SetPort(v uint16) *CoreGatewayL7ListenerUpdate {
 	_u.mutation.ResetPort()
@@ -170,6 +192,30 @@ func (_u *CoreGatewayL7ListenerUpdate) ClearEnableTLS() *CoreGatewayL7ListenerUp
 	return _u
 }
 
+// SetLocalRateLimit sets the "local_rate_limit" field.
+func (_u *CoreGatewayL7ListenerUpdate) SetLocalRateLimit(v *common.LocalRateLimit) *CoreGatewayL7ListenerUpdate {
+	_u.mutation.SetLocalRateLimit(v)
+	return _u
+}
+
+// ClearLocalRateLimit clears the value of the "local_rate_limit" field.
+func (_u *CoreGatewayL7ListenerUpdate) ClearLocalRateLimit() *CoreGatewayL7ListenerUpdate {
+	_u.mutation.ClearLocalRateLimit()
+	return _u
+}
+
+// SetCompression sets the "compression" field.
+func (_u *CoreGatewayL7ListenerUpdate) SetCompression(v *common.Compression) *CoreGatewayL7ListenerUpdate {
+	_u.mutation.SetCompression(v)
+	return _u
+}
+
+// ClearCompression clears the value of the "compression" field.
+func (_u *CoreGatewayL7ListenerUpdate) ClearCompression() *CoreGatewayL7ListenerUpdate {
+	_u.mutation.ClearCompression()
+	return _u
+}
+
 // SetStatus sets the "status" field.
 func (_u *CoreGatewayL7ListenerUpdate) SetStatus(v constant.YesOrNo) *CoreGatewayL7ListenerUpdate {
 	_u.mutation.ResetStatus()
@@ -197,11 +243,47 @@ func (_u *CoreGatewayL7ListenerUpdate) ClearStatus() *CoreGatewayL7ListenerUpdat
 	return _u
 }
 
+// AddListenerToVhostIDs adds the "listener_to_vhost" edge to the CoreGatewayVirtualHost entity by IDs.
+func (_u *CoreGatewayL7ListenerUpdate) AddListenerToVhostIDs(ids ...string) *CoreGatewayL7ListenerUpdate {
+	_u.mutation.AddListenerToVhostIDs(ids...)
+	return _u
+}
+
+// AddListenerToVhost adds the "listener_to_vhost" edges to the CoreGatewayVirtualHost entity.
+func (_u *CoreGatewayL7ListenerUpdate) AddListenerToVhost(v ...*CoreGatewayVirtualHost) *CoreGatewayL7ListenerUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddListenerToVhostIDs(ids...)
+}
+
 // Mutation returns the CoreGatewayL7ListenerMutation object of the builder.
 func (_u *CoreGatewayL7ListenerUpdate) Mutation() *CoreGatewayL7ListenerMutation {
 	return _u.mutation
 }
 
+// ClearListenerToVhost clears all "listener_to_vhost" edges to the CoreGatewayVirtualHost entity.
+func (_u *CoreGatewayL7ListenerUpdate) ClearListenerToVhost() *CoreGatewayL7ListenerUpdate {
+	_u.mutation.ClearListenerToVhost()
+	return _u
+}
+
+// RemoveListenerToVhostIDs removes the "listener_to_vhost" edge to CoreGatewayVirtualHost entities by IDs.
+func (_u *CoreGatewayL7ListenerUpdate) RemoveListenerToVhostIDs(ids ...string) *CoreGatewayL7ListenerUpdate {
+	_u.mutation.RemoveListenerToVhostIDs(ids...)
+	return _u
+}
+
+// RemoveListenerToVhost removes "listener_to_vhost" edges to CoreGatewayVirtualHost entities.
+func (_u *CoreGatewayL7ListenerUpdate) RemoveListenerToVhost(v ...*CoreGatewayVirtualHost) *CoreGatewayL7ListenerUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveListenerToVhostIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *CoreGatewayL7ListenerUpdate) Save(ctx context.Context) (int, error) {
 	if err := _u.defaults(); err != nil {
@@ -280,6 +362,12 @@ func (_u *CoreGatewayL7ListenerUpdate) sqlSave(ctx context.Context) (_node int,
 	if _u.mutation.DescriptionCleared() {
 		_spec.ClearField(coregatewayl7listener.FieldDescription, field.TypeString)
 	}
+	if value, ok := _u.mutation.ClusterID(); ok {
+		_spec.SetField(coregatewayl7listener.FieldClusterID, field.TypeString, value)
+	}
+	if _u.mutation.ClusterIDCleared() {
+		_spec.ClearField(coregatewayl7listener.FieldClusterID, field.TypeString)
+	}
 	if value, ok := _u.mutation.Port(); ok {
 		_spec.SetField(coregatewayl7listener.FieldPort, field.TypeUint16, value)
 	}
@@ -304,6 +392,18 @@ func (_u *CoreGatewayL7ListenerUpdate) sqlSave(ctx context.Context) (_node int,
 	if _u.mutation.EnableTLSCleared() {
 		_spec.ClearField(coregatewayl7listener.FieldEnableTLS, field.TypeInt8)
 	}
+	if value, ok := _u.mutation.LocalRateLimit(); ok {
+		_spec.SetField(coregatewayl7listener.FieldLocalRateLimit, field.TypeJSON, value)
+	}
+	if _u.mutation.LocalRateLimitCleared() {
+		_spec.ClearField(coregatewayl7listener.FieldLocalRateLimit, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Compression(); ok {
+		_spec.SetField(coregatewayl7listener.FieldCompression, field.TypeJSON, value)
+	}
+	if _u.mutation.CompressionCleared() {
+		_spec.ClearField(coregatewayl7listener.FieldCompression, field.TypeJSON)
+	}
 	if value, ok := _u.mutation.Status(); ok {
 		_spec.SetField(coregatewayl7listener.FieldStatus, field.TypeInt8, value)
 	}
@@ -313,6 +413,51 @@ func (_u *CoreGatewayL7ListenerUpdate) sqlSave(ctx context.Context) (_node int,
 	if _u.mutation.StatusCleared() {
 		_spec.ClearField(coregatewayl7listener.FieldStatus, field.TypeInt8)
 	}
+	if _u.mutation.ListenerToVhostCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   coregatewayl7listener.ListenerToVhostTable,
+			Columns: []string{coregatewayl7listener.ListenerToVhostColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(coregatewayvirtualhost.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedListenerToVhostIDs(); len(nodes) > 0 && !_u.mutation.ListenerToVhostCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   coregatewayl7listener.ListenerToVhostTable,
+			Columns: []string{coregatewayl7listener.ListenerToVhostColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(coregatewayvirtualhost.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ListenerToVhostIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   coregatewayl7listener.ListenerToVhostTable,
+			Columns: []string{coregatewayl7listener.ListenerToVhostColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(coregatewayvirtualhost.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
@@ -401,6 +546,26 @@ func (_u *CoreGatewayL7ListenerUpdateOne) ClearDescription() *CoreGatewayL7Liste
 	return _u
 }
 
+// SetClusterID sets the "cluster_id" field.
+func (_u *CoreGatewayL7ListenerUpdateOne) SetClusterID(v string) *CoreGatewayL7ListenerUpdateOne {
+	_u.mutation.SetClusterID(v)
+	return _u
+}
+
+// SetNillableClusterID sets the "cluster_id" field if the given value is not nil.
+func (_u *CoreGatewayL7ListenerUpdateOne) SetNillableClusterID(v *string) *CoreGatewayL7ListenerUpdateOne {
+	if v != nil {
+		_u.SetClusterID(*v)
+	}
+	return _u
+}
+
+// ClearClusterID clears the value of the "cluster_id" field.
+func (_u *CoreGatewayL7ListenerUpdateOne) ClearClusterID() *CoreGatewayL7ListenerUpdateOne {
+	_u.mutation.ClearClusterID()
+	return _u
+}
+
 // SetPort sets the "port" field.
 func (_u *CoreGatewayL7ListenerUpdateOne) SetPort(v uint16) *CoreGatewayL7ListenerUpdateOne {
 	_u.mutation.ResetPort()
@@ -475,6 +640,30 @@ func (_u *CoreGatewayL7ListenerUpdateOne) ClearEnableTLS() *CoreGatewayL7Listene
 	return _u
 }
 
+// SetLocalRateLimit sets the "local_rate_limit" field.
+func (_u *CoreGatewayL7ListenerUpdateOne) SetLocalRateLimit(v *common.LocalRateLimit) *CoreGatewayL7ListenerUpdateOne {
+	_u.mutation.SetLocalRateLimit(v)
+	return _u
+}
+
+// ClearLocalRateLimit clears the value of the "local_rate_limit" field.
+func (_u *CoreGatewayL7ListenerUpdateOne) ClearLocalRateLimit() *CoreGatewayL7ListenerUpdateOne {
+	_u.mutation.ClearLocalRateLimit()
+	return _u
+}
+
+// SetCompression sets the "compression" field.
+func (_u *CoreGatewayL7ListenerUpdateOne) SetCompression(v *common.Compression) *CoreGatewayL7ListenerUpdateOne {
+	_u.mutation.SetCompression(v)
+	return _u
+}
+
+// ClearCompression clears the value of the "compression" field.
+func (_u *CoreGatewayL7ListenerUpdateOne) ClearCompression() *CoreGatewayL7ListenerUpdateOne {
+	_u.mutation.ClearCompression()
+	return _u
+}
+
 // SetStatus sets the "status" field.
 func (_u *CoreGatewayL7ListenerUpdateOne) SetStatus(v constant.YesOrNo) *CoreGatewayL7ListenerUpdateOne {
 	_u.mutation.ResetStatus()
@@ -502,11 +691,47 @@ func (_u *CoreGatewayL7ListenerUpdateOne) ClearStatus() *CoreGatewayL7ListenerUp
 	return _u
 }
 
+// AddListenerToVhostIDs adds the "listener_to_vhost" edge to the CoreGatewayVirtualHost entity by IDs.
+func (_u *CoreGatewayL7ListenerUpdateOne) AddListenerToVhostIDs(ids ...string) *CoreGatewayL7ListenerUpdateOne {
+	_u.mutation.AddListenerToVhostIDs(ids...)
+	return _u
+}
+
+// AddListenerToVhost adds the "listener_to_vhost" edges to the CoreGatewayVirtualHost entity.
+func (_u *CoreGatewayL7ListenerUpdateOne) AddListenerToVhost(v ...*CoreGatewayVirtualHost) *CoreGatewayL7ListenerUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddListenerToVhostIDs(ids...)
+}
+
 // Mutation returns the CoreGatewayL7ListenerMutation object of the builder.
 func (_u *CoreGatewayL7ListenerUpdateOne) Mutation() *CoreGatewayL7ListenerMutation {
 	return _u.mutation
 }
 
+// ClearListenerToVhost clears all "listener_to_vhost" edges to the CoreGatewayVirtualHost entity.
+func (_u *CoreGatewayL7ListenerUpdateOne) ClearListenerToVhost() *CoreGatewayL7ListenerUpdateOne {
+	_u.mutation.ClearListenerToVhost()
+	return _u
+}
+
+// RemoveListenerToVhostIDs removes the "listener_to_vhost" edge to CoreGatewayVirtualHost entities by IDs.
+func (_u *CoreGatewayL7ListenerUpdateOne) RemoveListenerToVhostIDs(ids ...string) *CoreGatewayL7ListenerUpdateOne {
+	_u.mutation.RemoveListenerToVhostIDs(ids...)
+	return _u
+}
+
+// RemoveListenerToVhost removes "listener_to_vhost" edges to CoreGatewayVirtualHost entities.
+func (_u *CoreGatewayL7ListenerUpdateOne) RemoveListenerToVhost(v ...*CoreGatewayVirtualHost) *CoreGatewayL7ListenerUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveListenerToVhostIDs(ids...)
+}
+
 // Where appends a list predicates to the CoreGatewayL7ListenerUpdate builder.
 func (_u *CoreGatewayL7ListenerUpdateOne) Where(ps ...predicate.CoreGatewayL7Listener) *CoreGatewayL7ListenerUpdateOne {
 	_u.mutation.Where(ps...)
@@ -615,6 +840,12 @@ func (_u *CoreGatewayL7ListenerUpdateOne) sqlSave(ctx context.Context) (_node *C
 	if _u.mutation.DescriptionCleared() {
 		_spec.ClearField(coregatewayl7listener.FieldDescription, field.TypeString)
 	}
+	if value, ok := _u.mutation.ClusterID(); ok {
+		_spec.SetField(coregatewayl7listener.FieldClusterID, field.TypeString, value)
+	}
+	if _u.mutation.ClusterIDCleared() {
+		_spec.ClearField(coregatewayl7listener.FieldClusterID, field.TypeString)
+	}
 	if value, ok := _u.mutation.Port(); ok {
 		_spec.SetField(coregatewayl7listener.FieldPort, field.TypeUint16, value)
 	}
@@ -639,6 +870,18 @@ func (_u *CoreGatewayL7ListenerUpdateOne) sqlSave(ctx context.Context) (_node *C
 	if _u.mutation.EnableTLSCleared() {
 		_spec.ClearField(coregatewayl7listener.FieldEnableTLS, field.TypeInt8)
 	}
+	if value, ok := _u.mutation.LocalRateLimit(); ok {
+		_spec.SetField(coregatewayl7listener.FieldLocalRateLimit, field.TypeJSON, value)
+	}
+	if _u.mutation.LocalRateLimitCleared() {
+		_spec.ClearField(coregatewayl7listener.FieldLocalRateLimit, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Compression(); ok {
+		_spec.SetField(coregatewayl7listener.FieldCompression, field.TypeJSON, value)
+	}
+	if _u.mutation.CompressionCleared() {
+		_spec.ClearField(coregatewayl7listener.FieldCompression, field.TypeJSON)
+	}
 	if value, ok := _u.mutation.Status(); ok {
 		_spec.SetField(coregatewayl7listener.FieldStatus, field.TypeInt8, value)
 	}
@@ -648,6 +891,51 @@ func (_u *CoreGatewayL7ListenerUpdateOne) sqlSave(ctx context.Context) (_node *C
 	if _u.mutation.StatusCleared() {
 		_spec.ClearField(coregatewayl7listener.FieldStatus, field.TypeInt8)
 	}
+	if _u.mutation.ListenerToVhostCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   coregatewayl7listener.ListenerToVhostTable,
+			Columns: []string{coregatewayl7listener.ListenerToVhostColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(coregatewayvirtualhost.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedListenerToVhostIDs(); len(nodes) > 0 && !_u.mutation.ListenerToVhostCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   coregatewayl7listener.ListenerToVhostTable,
+			Columns: []string{coregatewayl7listener.ListenerToVhostColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(coregatewayvirtualhost.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ListenerToVhostIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   coregatewayl7listener.ListenerToVhostTable,
+			Columns: []string{coregatewayl7listener.ListenerToVhostColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(coregatewayvirtualhost.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.AddModifiers(_u.modifiers...)
 	_node = &CoreGatewayL7Listener{config: _u.config}
 	_spec.Assign = _node.assignValues
@@ -3,12 +3,14 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
+	"github.com/lyonmu/quebec/cmd/core/internal/common"
 	"github.com/lyonmu/quebec/cmd/core/internal/ent/coregatewaycluster"
 	"github.com/lyonmu/quebec/cmd/core/internal/ent/coregatewaynode"
 )
@@ -35,6 +37,16 @@ type CoreGatewayNode struct {
 	NodeRegisterTime int64 `json:"node_register_time,omitempty"`
 	// 最新请求时间
 	NodeLastRequestTime int64 `json:"node_last_request_time,omitempty"`
+	// 最近 ACK 的配置修订号
+	AppliedRevision int64 `json:"applied_revision,omitempty"`
+	// 最近一次 NACK 错误信息，为空表示配置均已接受
+	LastError string `json:"last_error,omitempty"`
+	// 各类型 xDS 资源的回执状态，按 type_url 索引
+	XdsStatus map[string]common.XdsStatus `json:"xds_status,omitempty"`
+	// 累计请求数，来自访问日志
+	RequestTotal int64 `json:"request_total,omitempty"`
+	// 累计 5xx 响应数，来自访问日志
+	ErrorTotal int64 `json:"error_total,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the CoreGatewayNodeQuery when eager-loading is set.
 	Edges        CoreGatewayNodeEdges `json:"-" gorm:"-"`
@@ -66,9 +78,11 @@ func (*CoreGatewayNode) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case coregatewaynode.FieldGatewayID, coregatewaynode.FieldNodeRegisterTime, coregatewaynode.FieldNodeLastRequestTime:
+		case coregatewaynode.FieldXdsStatus:
+			values[i] = new([]byte)
+		case coregatewaynode.FieldGatewayID, coregatewaynode.FieldNodeRegisterTime, coregatewaynode.FieldNodeLastRequestTime, coregatewaynode.FieldAppliedRevision, coregatewaynode.FieldRequestTotal, coregatewaynode.FieldErrorTotal:
 			values[i] = new(sql.NullInt64)
-		case coregatewaynode.FieldID, coregatewaynode.FieldNodeID, coregatewaynode.FieldClusterID:
+		case coregatewaynode.FieldID, coregatewaynode.FieldNodeID, coregatewaynode.FieldClusterID, coregatewaynode.FieldLastError:
 			values[i] = new(sql.NullString)
 		case coregatewaynode.FieldCreatedAt, coregatewaynode.FieldUpdatedAt, coregatewaynode.FieldDeletedAt:
 			values[i] = new(sql.NullTime)
@@ -142,6 +156,38 @@ func (_m *CoreGatewayNode) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.NodeLastRequestTime = value.Int64
 			}
+		case coregatewaynode.FieldAppliedRevision:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field applied_revision", values[i])
+			} else if value.Valid {
+				_m.AppliedRevision = value.Int64
+			}
+		case coregatewaynode.FieldLastError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field last_error", values[i])
+			} else if value.Valid {
+				_m.LastError = value.String
+			}
+		case coregatewaynode.FieldXdsStatus:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field xds_status", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.XdsStatus); err != nil {
+					return fmt.Errorf("unmarshal field xds_status: %w", err)
+				}
+			}
+		case coregatewaynode.FieldRequestTotal:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field request_total", values[i])
+			} else if value.Valid {
+				_m.RequestTotal = value.Int64
+			}
+		case coregatewaynode.FieldErrorTotal:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field error_total", values[i])
+			} else if value.Valid {
+				_m.ErrorTotal = value.Int64
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -208,6 +254,21 @@ func (_m *CoreGatewayNode) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("node_last_request_time=")
 	builder.WriteString(fmt.Sprintf("%v", _m.NodeLastRequestTime))
+	builder.WriteString(", ")
+	builder.WriteString("applied_revision=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AppliedRevision))
+	builder.WriteString(", ")
+	builder.WriteString("last_error=")
+	builder.WriteString(_m.LastError)
+	builder.WriteString(", ")
+	builder.WriteString("xds_status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.XdsStatus))
+	builder.WriteString(", ")
+	builder.WriteString("request_total=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RequestTotal))
+	builder.WriteString(", ")
+	builder.WriteString("error_total=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ErrorTotal))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -31,6 +31,16 @@ const (
 	FieldNodeRegisterTime = "node_register_time"
 	// FieldNodeLastRequestTime holds the string denoting the node_last_request_time field in the database.
 	FieldNodeLastRequestTime = "node_last_request_time"
+	// FieldAppliedRevision holds the string denoting the applied_revision field in the database.
+	FieldAppliedRevision = "applied_revision"
+	// FieldLastError holds the string denoting the last_error field in the database.
+	FieldLastError = "last_error"
+	// FieldXdsStatus holds the string denoting the xds_status field in the database.
+	FieldXdsStatus = "xds_status"
+	// FieldRequestTotal holds the string denoting the request_total field in the database.
+	FieldRequestTotal = "request_total"
+	// FieldErrorTotal holds the string denoting the error_total field in the database.
+	FieldErrorTotal = "error_total"
 	// EdgeNodeFromCluster holds the string denoting the node_from_cluster edge name in mutations.
 	EdgeNodeFromCluster = "node_from_cluster"
 	// Table holds the table name of the coregatewaynode in the database.
@@ -55,6 +65,11 @@ var Columns = []string{
 	FieldGatewayID,
 	FieldNodeRegisterTime,
 	FieldNodeLastRequestTime,
+	FieldAppliedRevision,
+	FieldLastError,
+	FieldXdsStatus,
+	FieldRequestTotal,
+	FieldErrorTotal,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -138,6 +153,26 @@ func ByNodeLastRequestTime(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldNodeLastRequestTime, opts...).ToFunc()
 }
 
+// ByAppliedRevision orders the results by the applied_revision field.
+func ByAppliedRevision(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAppliedRevision, opts...).ToFunc()
+}
+
+// ByLastError orders the results by the last_error field.
+func ByLastError(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastError, opts...).ToFunc()
+}
+
+// ByRequestTotal orders the results by the request_total field.
+func ByRequestTotal(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRequestTotal, opts...).ToFunc()
+}
+
+// ByErrorTotal orders the results by the error_total field.
+func ByErrorTotal(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldErrorTotal, opts...).ToFunc()
+}
+
 // ByNodeFromClusterField orders the results by node_from_cluster field.
 func ByNodeFromClusterField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
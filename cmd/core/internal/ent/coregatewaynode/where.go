@@ -105,6 +105,26 @@ func NodeLastRequestTime(v int64) predicate.CoreGatewayNode {
 	return predicate.CoreGatewayNode(sql.FieldEQ(FieldNodeLastRequestTime, v))
 }
 
+// AppliedRevision applies equality check predicate on the "applied_revision" field. It's identical to AppliedRevisionEQ.
+func AppliedRevision(v int64) predicate.CoreGatewayNode {
+	return predicate.CoreGatewayNode(sql.FieldEQ(FieldAppliedRevision, v))
+}
+
+// LastError applies equality check predicate on the "last_error" field. It's identical to LastErrorEQ.
+func LastError(v string) predicate.CoreGatewayNode {
+	return predicate.CoreGatewayNode(sql.FieldEQ(FieldLastError, v))
+}
+
+// RequestTotal applies equality check predicate on the "request_total" field. It's identical to RequestTotalEQ.
+func RequestTotal(v int64) predicate.CoreGatewayNode {
+	return predicate.CoreGatewayNode(sql.FieldEQ(FieldRequestTotal, v))
+}
+
+// ErrorTotal applies equality check predicate on the "error_total" field. It's identical to ErrorTotalEQ.
+func ErrorTotal(v int64) predicate.CoreGatewayNode {
+	return predicate.CoreGatewayNode(sql.FieldEQ(FieldErrorTotal, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.CoreGatewayNode {
 	return predicate.CoreGatewayNode(sql.FieldEQ(FieldCreatedAt, v))
@@ -535,6 +555,241 @@ func NodeLastRequestTimeNotNil() predicate.CoreGatewayNode {
 	return predicate.CoreGatewayNode(sql.FieldNotNull(FieldNodeLastRequestTime))
 }
 
+// AppliedRevisionEQ applies the EQ predicate on the "applied_revision" field.
+func AppliedRevisionEQ(v int64) predicate.CoreGatewayNode {
+	return predicate.CoreGatewayNode(sql.FieldEQ(FieldAppliedRevision, v))
+}
+
+// AppliedRevisionNEQ applies the NEQ predicate on the "applied_revision" field.
+func AppliedRevisionNEQ(v int64) predicate.CoreGatewayNode {
+	return predicate.CoreGatewayNode(sql.FieldNEQ(FieldAppliedRevision, v))
+}
+
+// AppliedRevisionIn applies the In predicate on the "applied_revision" field.
+func AppliedRevisionIn(vs ...int64) predicate.CoreGatewayNode {
+	return predicate.CoreGatewayNode(sql.FieldIn(FieldAppliedRevision, vs...))
+}
+
+// AppliedRevisionNotIn applies the NotIn predicate on the "applied_revision" field.
+func AppliedRevisionNotIn(vs ...int64) predicate.CoreGatewayNode {
+	return predicate.CoreGatewayNode(sql.FieldNotIn(FieldAppliedRevision, vs...))
+}
+
+// AppliedRevisionGT applies the GT predicate on the "applied_revision" field.
+func AppliedRevisionGT(v int64) predicate.CoreGatewayNode {
+	return predicate.CoreGatewayNode(sql.FieldGT(FieldAppliedRevision, v))
+}
+
+// AppliedRevisionGTE applies the GTE predicate on the "applied_revision" field.
+func AppliedRevisionGTE(v int64) predicate.CoreGatewayNode {
+	return predicate.CoreGatewayNode(sql.FieldGTE(FieldAppliedRevision, v))
+}
+
+// AppliedRevisionLT applies the LT predicate on the "applied_revision" field.
+func AppliedRevisionLT(v int64) predicate.CoreGatewayNode {
+	return predicate.CoreGatewayNode(sql.FieldLT(FieldAppliedRevision, v))
+}
+
+// AppliedRevisionLTE applies the LTE predicate on the "applied_revision" field.
+func AppliedRevisionLTE(v int64) predicate.CoreGatewayNode {
+	return predicate.CoreGatewayNode(sql.FieldLTE(FieldAppliedRevision, v))
+}
+
+// AppliedRevisionIsNil applies the IsNil predicate on the "applied_revision" field.
+func AppliedRevisionIsNil() predicate.CoreGatewayNode {
+	return predicate.CoreGatewayNode(sql.FieldIsNull(FieldAppliedRevision))
+}
+
+// AppliedRevisionNotNil applies the NotNil predicate on the "applied_revision" field.
+func AppliedRevisionNotNil() predicate.CoreGatewayNode {
+	return predicate.CoreGatewayNode(sql.FieldNotNull(FieldAppliedRevision))
+}
+
+// LastErrorEQ applies the EQ predicate on the "last_error" field.
+func LastErrorEQ(v string) predicate.CoreGatewayNode {
+	return predicate.CoreGatewayNode(sql.FieldEQ(FieldLastError, v))
+}
+
+// LastErrorNEQ applies the NEQ predicate on the "last_error" field.
+func LastErrorNEQ(v string) predicate.CoreGatewayNode {
+	return predicate.CoreGatewayNode(sql.FieldNEQ(FieldLastError, v))
+}
+
+// LastErrorIn applies the In predicate on the "last_error" field.
+func LastErrorIn(vs ...string) predicate.CoreGatewayNode {
+	return predicate.CoreGatewayNode(sql.FieldIn(FieldLastError, vs...))
+}
+
+// LastErrorNotIn applies the NotIn predicate on the "last_error" field.
+func LastErrorNotIn(vs ...string) predicate.CoreGatewayNode {
+	return predicate.CoreGatewayNode(sql.FieldNotIn(FieldLastError, vs...))
+}
+
+// LastErrorGT applies the GT predicate on the "last_error" field.
+func LastErrorGT(v string) predicate.CoreGatewayNode {
+	return predicate.CoreGatewayNode(sql.FieldGT(FieldLastError, v))
+}
+
+// LastErrorGTE applies the GTE predicate on the "last_error" field.
+func LastErrorGTE(v string) predicate.CoreGatewayNode {
+	return predicate.CoreGatewayNode(sql.FieldGTE(FieldLastError, v))
+}
+
+// LastErrorLT applies the LT predicate on the "last_error" field.
+func LastErrorLT(v string) predicate.CoreGatewayNode {
+	return predicate.CoreGatewayNode(sql.FieldLT(FieldLastError, v))
+}
+
+// LastErrorLTE applies the LTE predicate on the "last_error" field.
+func LastErrorLTE(v string) predicate.CoreGatewayNode {
+	return predicate.CoreGatewayNode(sql.FieldLTE(FieldLastError, v))
+}
+
+// LastErrorContains applies the Contains predicate on the "last_error" field.
+func LastErrorContains(v string) predicate.CoreGatewayNode {
+	return predicate.CoreGatewayNode(sql.FieldContains(FieldLastError, v))
+}
+
+// LastErrorHasPrefix applies the HasPrefix predicate on the "last_error" field.
+func LastErrorHasPrefix(v string) predicate.CoreGatewayNode {
+	return predicate.CoreGatewayNode(sql.FieldHasPrefix(FieldLastError, v))
+}
+
+// LastErrorHasSuffix applies the HasSuffix predicate on the "last_error" field.
+func LastErrorHasSuffix(v string) predicate.CoreGatewayNode {
+	return predicate.CoreGatewayNode(sql.FieldHasSuffix(FieldLastError, v))
+}
+
+// LastErrorIsNil applies the IsNil predicate on the "last_error" field.
+func LastErrorIsNil() predicate.CoreGatewayNode {
+	return predicate.CoreGatewayNode(sql.FieldIsNull(FieldLastError))
+}
+
+// LastErrorNotNil applies the NotNil predicate on the "last_error" field.
+func LastErrorNotNil() predicate.CoreGatewayNode {
+	return predicate.CoreGatewayNode(sql.FieldNotNull(FieldLastError))
+}
+
+// LastErrorEqualFold applies the EqualFold predicate on the "last_error" field.
+func LastErrorEqualFold(v string) predicate.CoreGatewayNode {
+	return predicate.CoreGatewayNode(sql.FieldEqualFold(FieldLastError, v))
+}
+
+// LastErrorContainsFold applies the ContainsFold predicate on the "last_error" field.
+func LastErrorContainsFold(v string) predicate.CoreGatewayNode {
+	return predicate.CoreGatewayNode(sql.FieldContainsFold(FieldLastError, v))
+}
+
+// XdsStatusIsNil applies the IsNil predicate on the "xds_status" field.
+func XdsStatusIsNil() predicate.CoreGatewayNode {
+	return predicate.CoreGatewayNode(sql.FieldIsNull(FieldXdsStatus))
+}
+
+// XdsStatusNotNil applies the NotNil predicate on the "xds_status" field.
+func XdsStatusNotNil() predicate.CoreGatewayNode {
+	return predicate.CoreGatewayNode(sql.FieldNotNull(FieldXdsStatus))
+}
+
+// RequestTotalEQ applies the EQ predicate on the "request_total" field.
+func RequestTotalEQ(v int64) predicate.CoreGatewayNode {
+	return predicate.CoreGatewayNode(sql.FieldEQ(FieldRequestTotal, v))
+}
+
+// RequestTotalNEQ applies the NEQ predicate on the "request_total" field.
+func RequestTotalNEQ(v int64) predicate.CoreGatewayNode {
+	return predicate.CoreGatewayNode(sql.FieldNEQ(FieldRequestTotal, v))
+}
+
+// RequestTotalIn applies the In predicate on the "request_total" field.
+func RequestTotalIn(vs ...int64) predicate.CoreGatewayNode {
+	return predicate.CoreGatewayNode(sql.FieldIn(FieldRequestTotal, vs...))
+}
+
+// RequestTotalNotIn applies the NotIn predicate on the "request_total" field.
+func RequestTotalNotIn(vs ...int64) predicate.CoreGatewayNode {
+	return predicate.CoreGatewayNode(sql.FieldNotIn(FieldRequestTotal, vs...))
+}
+
+// RequestTotalGT applies the GT predicate on the "request_total" field.
+func RequestTotalGT(v int64) predicate.CoreGatewayNode {
+	return predicate.CoreGatewayNode(sql.FieldGT(FieldRequestTotal, v))
+}
+
+// RequestTotalGTE applies the GTE predicate on the "request_total" field.
+func RequestTotalGTE(v int64) predicate.CoreGatewayNode {
+	return predicate.CoreGatewayNode(sql.FieldGTE(FieldRequestTotal, v))
+}
+
+// RequestTotalLT applies the LT predicate on the "request_total" field.
+func RequestTotalLT(v int64) predicate.CoreGatewayNode {
+	return predicate.CoreGatewayNode(sql.FieldLT(FieldRequestTotal, v))
+}
+
+// RequestTotalLTE applies the LTE predicate on the "request_total" field.
+func RequestTotalLTE(v int64) predicate.CoreGatewayNode {
+	return predicate.CoreGatewayNode(sql.FieldLTE(FieldRequestTotal, v))
+}
+
+// RequestTotalIsNil applies the IsNil predicate on the "request_total" field.
+func RequestTotalIsNil() predicate.CoreGatewayNode {
+	return predicate.CoreGatewayNode(sql.FieldIsNull(FieldRequestTotal))
+}
+
+// RequestTotalNotNil applies the NotNil predicate on the "request_total" field.
+func RequestTotalNotNil() predicate.CoreGatewayNode {
+	return predicate.CoreGatewayNode(sql.FieldNotNull(FieldRequestTotal))
+}
+
+// ErrorTotalEQ applies the EQ predicate on the "error_total" field.
+func ErrorTotalEQ(v int64) predicate.CoreGatewayNode {
+	return predicate.CoreGatewayNode(sql.FieldEQ(FieldErrorTotal, v))
+}
+
+// ErrorTotalNEQ applies the NEQ predicate on the "error_total" field.
+func ErrorTotalNEQ(v int64) predicate.CoreGatewayNode {
+	return predicate.CoreGatewayNode(sql.FieldNEQ(FieldErrorTotal, v))
+}
+
+// ErrorTotalIn applies the In predicate on the "error_total" field.
+func ErrorTotalIn(vs ...int64) predicate.CoreGatewayNode {
+	return predicate.CoreGatewayNode(sql.FieldIn(FieldErrorTotal, vs...))
+}
+
+// ErrorTotalNotIn applies the NotIn predicate on the "error_total" field.
+func ErrorTotalNotIn(vs ...int64) predicate.CoreGatewayNode {
+	return predicate.CoreGatewayNode(sql.FieldNotIn(FieldErrorTotal, vs...))
+}
+
+// ErrorTotalGT applies the GT predicate on the "error_total" field.
+func ErrorTotalGT(v int64) predicate.CoreGatewayNode {
+	return predicate.CoreGatewayNode(sql.FieldGT(FieldErrorTotal, v))
+}
+
+// ErrorTotalGTE applies the GTE predicate on the "error_total" field.
+func ErrorTotalGTE(v int64) predicate.CoreGatewayNode {
+	return predicate.CoreGatewayNode(sql.FieldGTE(FieldErrorTotal, v))
+}
+
+// ErrorTotalLT applies the LT predicate on the "error_total" field.
+func ErrorTotalLT(v int64) predicate.CoreGatewayNode {
+	return predicate.CoreGatewayNode(sql.FieldLT(FieldErrorTotal, v))
+}
+
+// ErrorTotalLTE applies the LTE predicate on the "error_total" field.
+func ErrorTotalLTE(v int64) predicate.CoreGatewayNode {
+	return predicate.CoreGatewayNode(sql.FieldLTE(FieldErrorTotal, v))
+}
+
+// ErrorTotalIsNil applies the IsNil predicate on the "error_total" field.
+func ErrorTotalIsNil() predicate.CoreGatewayNode {
+	return predicate.CoreGatewayNode(sql.FieldIsNull(FieldErrorTotal))
+}
+
+// ErrorTotalNotNil applies the NotNil predicate on the "error_total" field.
+func ErrorTotalNotNil() predicate.CoreGatewayNode {
+	return predicate.CoreGatewayNode(sql.FieldNotNull(FieldErrorTotal))
+}
+
 // HasNodeFromCluster applies the HasEdge predicate on the "node_from_cluster" edge.
 func HasNodeFromCluster() predicate.CoreGatewayNode {
 	return predicate.CoreGatewayNode(func(s *sql.Selector) {
@@ -12,6 +12,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/lyonmu/quebec/cmd/core/internal/common"
 	"github.com/lyonmu/quebec/cmd/core/internal/ent/coregatewaycluster"
 	"github.com/lyonmu/quebec/cmd/core/internal/ent/coregatewaynode"
 )
@@ -136,6 +137,68 @@ func (_c *CoreGatewayNodeCreate) SetNillableNodeLastRequestTime(v *int64) *CoreG
 	return _c
 }
 
+// SetAppliedRevision sets the "applied_revision" field.
+func (_c *CoreGatewayNodeCreate) SetAppliedRevision(v int64) *CoreGatewayNodeCreate {
+	_c.mutation.SetAppliedRevision(v)
+	return _c
+}
+
+// SetNillableAppliedRevision sets the "applied_revision" field if the given value is not nil.
+func (_c *CoreGatewayNodeCreate) SetNillableAppliedRevision(v *int64) *CoreGatewayNodeCreate {
+	if v != nil {
+		_c.SetAppliedRevision(*v)
+	}
+	return _c
+}
+
+// SetLastError sets the "last_error" field.
+func (_c *CoreGatewayNodeCreate) SetLastError(v string) *CoreGatewayNodeCreate {
+	_c.mutation.SetLastError(v)
+	return _c
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (_c *CoreGatewayNodeCreate) SetNillableLastError(v *string) *CoreGatewayNodeCreate {
+	if v != nil {
+		_c.SetLastError(*v)
+	}
+	return _c
+}
+
+// SetXdsStatus sets the "xds_status" field.
+func (_c *CoreGatewayNodeCreate) SetXdsStatus(v map[string]common.XdsStatus) *CoreGatewayNodeCreate {
+	_c.mutation.SetXdsStatus(v)
+	return _c
+}
+
+// SetRequestTotal sets the "request_total" field.
+func (_c *CoreGatewayNodeCreate) SetRequestTotal(v int64) *CoreGatewayNodeCreate {
+	_c.mutation.SetRequestTotal(v)
+	return _c
+}
+
+// SetNillableRequestTotal sets the "request_total" field if the given value is not nil.
+func (_c *CoreGatewayNodeCreate) SetNillableRequestTotal(v *int64) *CoreGatewayNodeCreate {
+	if v != nil {
+		_c.SetRequestTotal(*v)
+	}
+	return _c
+}
+
+// SetErrorTotal sets the "error_total" field.
+func (_c *CoreGatewayNodeCreate) SetErrorTotal(v int64) *CoreGatewayNodeCreate {
+	_c.mutation.SetErrorTotal(v)
+	return _c
+}
+
+// SetNillableErrorTotal sets the "error_total" field if the given value is not nil.
+func (_c *CoreGatewayNodeCreate) SetNillableErrorTotal(v *int64) *CoreGatewayNodeCreate {
+	if v != nil {
+		_c.SetErrorTotal(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *CoreGatewayNodeCreate) SetID(v string) *CoreGatewayNodeCreate {
 	_c.mutation.SetID(v)
@@ -321,6 +384,26 @@ func (_c *CoreGatewayNodeCreate) createSpec() (*CoreGatewayNode, *sqlgraph.Creat
 		_spec.SetField(coregatewaynode.FieldNodeLastRequestTime, field.TypeInt64, value)
 		_node.NodeLastRequestTime = value
 	}
+	if value, ok := _c.mutation.AppliedRevision(); ok {
+		_spec.SetField(coregatewaynode.FieldAppliedRevision, field.TypeInt64, value)
+		_node.AppliedRevision = value
+	}
+	if value, ok := _c.mutation.LastError(); ok {
+		_spec.SetField(coregatewaynode.FieldLastError, field.TypeString, value)
+		_node.LastError = value
+	}
+	if value, ok := _c.mutation.XdsStatus(); ok {
+		_spec.SetField(coregatewaynode.FieldXdsStatus, field.TypeJSON, value)
+		_node.XdsStatus = value
+	}
+	if value, ok := _c.mutation.RequestTotal(); ok {
+		_spec.SetField(coregatewaynode.FieldRequestTotal, field.TypeInt64, value)
+		_node.RequestTotal = value
+	}
+	if value, ok := _c.mutation.ErrorTotal(); ok {
+		_spec.SetField(coregatewaynode.FieldErrorTotal, field.TypeInt64, value)
+		_node.ErrorTotal = value
+	}
 	if nodes := _c.mutation.NodeFromClusterIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -528,6 +611,114 @@ func (u *CoreGatewayNodeUpsert) ClearNodeLastRequestTime() *CoreGatewayNodeUpser
 	return u
 }
 
+// SetAppliedRevision sets the "applied_revision" field.
+func (u *CoreGatewayNodeUpsert) SetAppliedRevision(v int64) *CoreGatewayNodeUpsert {
+	u.Set(coregatewaynode.FieldAppliedRevision, v)
+	return u
+}
+
+// UpdateAppliedRevision sets the "applied_revision" field to the value that was provided on create.
+func (u *CoreGatewayNodeUpsert) UpdateAppliedRevision() *CoreGatewayNodeUpsert {
+	u.SetExcluded(coregatewaynode.FieldAppliedRevision)
+	return u
+}
+
+// AddAppliedRevision adds v to the "applied_revision" field.
+func (u *CoreGatewayNodeUpsert) AddAppliedRevision(v int64) *CoreGatewayNodeUpsert {
+	u.Add(coregatewaynode.FieldAppliedRevision, v)
+	return u
+}
+
+// ClearAppliedRevision clears the value of the "applied_revision" field.
+func (u *CoreGatewayNodeUpsert) ClearAppliedRevision() *CoreGatewayNodeUpsert {
+	u.SetNull(coregatewaynode.FieldAppliedRevision)
+	return u
+}
+
+// SetLastError sets the "last_error" field.
+func (u *CoreGatewayNodeUpsert) SetLastError(v string) *CoreGatewayNodeUpsert {
+	u.Set(coregatewaynode.FieldLastError, v)
+	return u
+}
+
+// UpdateLastError sets the "last_error" field to the value that was provided on create.
+func (u *CoreGatewayNodeUpsert) UpdateLastError() *CoreGatewayNodeUpsert {
+	u.SetExcluded(coregatewaynode.FieldLastError)
+	return u
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (u *CoreGatewayNodeUpsert) ClearLastError() *CoreGatewayNodeUpsert {
+	u.SetNull(coregatewaynode.FieldLastError)
+	return u
+}
+
+// SetXdsStatus sets the "xds_status" field.
+func (u *CoreGatewayNodeUpsert) SetXdsStatus(v map[string]common.XdsStatus) *CoreGatewayNodeUpsert {
+	u.Set(coregatewaynode.FieldXdsStatus, v)
+	return u
+}
+
+// UpdateXdsStatus sets the "xds_status" field to the value that was provided on create.
+func (u *CoreGatewayNodeUpsert) UpdateXdsStatus() *CoreGatewayNodeUpsert {
+	u.SetExcluded(coregatewaynode.FieldXdsStatus)
+	return u
+}
+
+// ClearXdsStatus clears the value of the "xds_status" field.
+func (u *CoreGatewayNodeUpsert) ClearXdsStatus() *CoreGatewayNodeUpsert {
+	u.SetNull(coregatewaynode.FieldXdsStatus)
+	return u
+}
+
+// SetRequestTotal sets the "request_total" field.
+func (u *CoreGatewayNodeUpsert) SetRequestTotal(v int64) *CoreGatewayNodeUpsert {
+	u.Set(coregatewaynode.FieldRequestTotal, v)
+	return u
+}
+
+// UpdateRequestTotal sets the "request_total" field to the value that was provided on create.
+func (u *CoreGatewayNodeUpsert) UpdateRequestTotal() *CoreGatewayNodeUpsert {
+	u.SetExcluded(coregatewaynode.FieldRequestTotal)
+	return u
+}
+
+// AddRequestTotal adds v to the "request_total" field.
+func (u *CoreGatewayNodeUpsert) AddRequestTotal(v int64) *CoreGatewayNodeUpsert {
+	u.Add(coregatewaynode.FieldRequestTotal, v)
+	return u
+}
+
+// ClearRequestTotal clears the value of the "request_total" field.
+func (u *CoreGatewayNodeUpsert) ClearRequestTotal() *CoreGatewayNodeUpsert {
+	u.SetNull(coregatewaynode.FieldRequestTotal)
+	return u
+}
+
+// SetErrorTotal sets the "error_total" field.
+func (u *CoreGatewayNodeUpsert) SetErrorTotal(v int64) *CoreGatewayNodeUpsert {
+	u.Set(coregatewaynode.FieldErrorTotal, v)
+	return u
+}
+
+// UpdateErrorTotal sets the "error_total" field to the value that was provided on create.
+func (u *CoreGatewayNodeUpsert) UpdateErrorTotal() *CoreGatewayNodeUpsert {
+	u.SetExcluded(coregatewaynode.FieldErrorTotal)
+	return u
+}
+
+// AddErrorTotal adds v to the "error_total" field.
+func (u *CoreGatewayNodeUpsert) AddErrorTotal(v int64) *CoreGatewayNodeUpsert {
+	u.Add(coregatewaynode.FieldErrorTotal, v)
+	return u
+}
+
+// ClearErrorTotal clears the value of the "error_total" field.
+func (u *CoreGatewayNodeUpsert) ClearErrorTotal() *CoreGatewayNodeUpsert {
+	u.SetNull(coregatewaynode.FieldErrorTotal)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
 // Using this option is equivalent to using:
 //
@@ -740,6 +931,132 @@ func (u *CoreGatewayNodeUpsertOne) ClearNodeLastRequestTime() *CoreGatewayNodeUp
 	})
 }
 
+// SetAppliedRevision sets the "applied_revision" field.
+func (u *CoreGatewayNodeUpsertOne) SetAppliedRevision(v int64) *CoreGatewayNodeUpsertOne {
+	return u.Update(func(s *CoreGatewayNodeUpsert) {
+		s.SetAppliedRevision(v)
+	})
+}
+
+// AddAppliedRevision adds v to the "applied_revision" field.
+func (u *CoreGatewayNodeUpsertOne) AddAppliedRevision(v int64) *CoreGatewayNodeUpsertOne {
+	return u.Update(func(s *CoreGatewayNodeUpsert) {
+		s.AddAppliedRevision(v)
+	})
+}
+
+// UpdateAppliedRevision sets the "applied_revision" field to the value that was provided on create.
+func (u *CoreGatewayNodeUpsertOne) UpdateAppliedRevision() *CoreGatewayNodeUpsertOne {
+	return u.Update(func(s *CoreGatewayNodeUpsert) {
+		s.UpdateAppliedRevision()
+	})
+}
+
+// ClearAppliedRevision clears the value of the "applied_revision" field.
+func (u *CoreGatewayNodeUpsertOne) ClearAppliedRevision() *CoreGatewayNodeUpsertOne {
+	return u.Update(func(s *CoreGatewayNodeUpsert) {
+		s.ClearAppliedRevision()
+	})
+}
+
+// SetLastError sets the "last_error" field.
+func (u *CoreGatewayNodeUpsertOne) SetLastError(v string) *CoreGatewayNodeUpsertOne {
+	return u.Update(func(s *CoreGatewayNodeUpsert) {
+		s.SetLastError(v)
+	})
+}
+
+// UpdateLastError sets the "last_error" field to the value that was provided on create.
+func (u *CoreGatewayNodeUpsertOne) UpdateLastError() *CoreGatewayNodeUpsertOne {
+	return u.Update(func(s *CoreGatewayNodeUpsert) {
+		s.UpdateLastError()
+	})
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (u *CoreGatewayNodeUpsertOne) ClearLastError() *CoreGatewayNodeUpsertOne {
+	return u.Update(func(s *CoreGatewayNodeUpsert) {
+		s.ClearLastError()
+	})
+}
+
+// SetXdsStatus sets the "xds_status" field.
+func (u *CoreGatewayNodeUpsertOne) SetXdsStatus(v map[string]common.XdsStatus) *CoreGatewayNodeUpsertOne {
+	return u.Update(func(s *CoreGatewayNodeUpsert) {
+		s.SetXdsStatus(v)
+	})
+}
+
+// UpdateXdsStatus sets the "xds_status" field to the value that was provided on create.
+func (u *CoreGatewayNodeUpsertOne) UpdateXdsStatus() *CoreGatewayNodeUpsertOne {
+	return u.Update(func(s *CoreGatewayNodeUpsert) {
+		s.UpdateXdsStatus()
+	})
+}
+
+// ClearXdsStatus clears the value of the "xds_status" field.
+func (u *CoreGatewayNodeUpsertOne) ClearXdsStatus() *CoreGatewayNodeUpsertOne {
+	return u.Update(func(s *CoreGatewayNodeUpsert) {
+		s.ClearXdsStatus()
+	})
+}
+
+// SetRequestTotal sets the "request_total" field.
+func (u *CoreGatewayNodeUpsertOne) SetRequestTotal(v int64) *CoreGatewayNodeUpsertOne {
+	return u.Update(func(s *CoreGatewayNodeUpsert) {
+		s.SetRequestTotal(v)
+	})
+}
+
+// AddRequestTotal adds v to the "request_total" field.
+func (u *CoreGatewayNodeUpsertOne) AddRequestTotal(v int64) *CoreGatewayNodeUpsertOne {
+	return u.Update(func(s *CoreGatewayNodeUpsert) {
+		s.AddRequestTotal(v)
+	})
+}
+
+// UpdateRequestTotal sets the "request_total" field to the value that was provided on create.
+func (u *CoreGatewayNodeUpsertOne) UpdateRequestTotal() *CoreGatewayNodeUpsertOne {
+	return u.Update(func(s *CoreGatewayNodeUpsert) {
+		s.UpdateRequestTotal()
+	})
+}
+
+// ClearRequestTotal clears the value of the "request_total" field.
+func (u *CoreGatewayNodeUpsertOne) ClearRequestTotal() *CoreGatewayNodeUpsertOne {
+	return u.Update(func(s *CoreGatewayNodeUpsert) {
+		s.ClearRequestTotal()
+	})
+}
+
+// SetErrorTotal sets the "error_total" field.
+func (u *CoreGatewayNodeUpsertOne) SetErrorTotal(v int64) *CoreGatewayNodeUpsertOne {
+	return u.Update(func(s *CoreGatewayNodeUpsert) {
+		s.SetErrorTotal(v)
+	})
+}
+
+// AddErrorTotal adds v to the "error_total" field.
+func (u *CoreGatewayNodeUpsertOne) AddErrorTotal(v int64) *CoreGatewayNodeUpsertOne {
+	return u.Update(func(s *CoreGatewayNodeUpsert) {
+		s.AddErrorTotal(v)
+	})
+}
+
+// UpdateErrorTotal sets the "error_total" field to the value that was provided on create.
+func (u *CoreGatewayNodeUpsertOne) UpdateErrorTotal() *CoreGatewayNodeUpsertOne {
+	return u.Update(func(s *CoreGatewayNodeUpsert) {
+		s.UpdateErrorTotal()
+	})
+}
+
+// ClearErrorTotal clears the value of the "error_total" field.
+func (u *CoreGatewayNodeUpsertOne) ClearErrorTotal() *CoreGatewayNodeUpsertOne {
+	return u.Update(func(s *CoreGatewayNodeUpsert) {
+		s.ClearErrorTotal()
+	})
+}
+
 // Exec executes the query.
 func (u *CoreGatewayNodeUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -1119,6 +1436,132 @@ func (u *CoreGatewayNodeUpsertBulk) ClearNodeLastRequestTime() *CoreGatewayNodeU
 	})
 }
 
+// SetAppliedRevision sets the "applied_revision" field.
+func (u *CoreGatewayNodeUpsertBulk) SetAppliedRevision(v int64) *CoreGatewayNodeUpsertBulk {
+	return u.Update(func(s *CoreGatewayNodeUpsert) {
+		s.SetAppliedRevision(v)
+	})
+}
+
+// AddAppliedRevision adds v to the "applied_revision" field.
+func (u *CoreGatewayNodeUpsertBulk) AddAppliedRevision(v int64) *CoreGatewayNodeUpsertBulk {
+	return u.Update(func(s *CoreGatewayNodeUpsert) {
+		s.AddAppliedRevision(v)
+	})
+}
+
+// UpdateAppliedRevision sets the "applied_revision" field to the value that was provided on create.
+func (u *CoreGatewayNodeUpsertBulk) UpdateAppliedRevision() *CoreGatewayNodeUpsertBulk {
+	return u.Update(func(s *CoreGatewayNodeUpsert) {
+		s.UpdateAppliedRevision()
+	})
+}
+
+// ClearAppliedRevision clears the value of the "applied_revision" field.
+func (u *CoreGatewayNodeUpsertBulk) ClearAppliedRevision() *CoreGatewayNodeUpsertBulk {
+	return u.Update(func(s *CoreGatewayNodeUpsert) {
+		s.ClearAppliedRevision()
+	})
+}
+
+// SetLastError sets the "last_error" field.
+func (u *CoreGatewayNodeUpsertBulk) SetLastError(v string) *CoreGatewayNodeUpsertBulk {
+	return u.Update(func(s *CoreGatewayNodeUpsert) {
+		s.SetLastError(v)
+	})
+}
+
+// UpdateLastError sets the "last_error" field to the value that was provided on create.
+func (u *CoreGatewayNodeUpsertBulk) UpdateLastError() *CoreGatewayNodeUpsertBulk {
+	return u.Update(func(s *CoreGatewayNodeUpsert) {
+		s.UpdateLastError()
+	})
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (u *CoreGatewayNodeUpsertBulk) ClearLastError() *CoreGatewayNodeUpsertBulk {
+	return u.Update(func(s *CoreGatewayNodeUpsert) {
+		s.ClearLastError()
+	})
+}
+
+// SetXdsStatus sets the "xds_status" field.
+func (u *CoreGatewayNodeUpsertBulk) SetXdsStatus(v map[string]common.XdsStatus) *CoreGatewayNodeUpsertBulk {
+	return u.Update(func(s *CoreGatewayNodeUpsert) {
+		s.SetXdsStatus(v)
+	})
+}
+
+// UpdateXdsStatus sets the "xds_status" field to the value that was provided on create.
+func (u *CoreGatewayNodeUpsertBulk) UpdateXdsStatus() *CoreGatewayNodeUpsertBulk {
+	return u.Update(func(s *CoreGatewayNodeUpsert) {
+		s.UpdateXdsStatus()
+	})
+}
+
+// ClearXdsStatus clears the value of the "xds_status" field.
+func (u *CoreGatewayNodeUpsertBulk) ClearXdsStatus() *CoreGatewayNodeUpsertBulk {
+	return u.Update(func(s *CoreGatewayNodeUpsert) {
+		s.ClearXdsStatus()
+	})
+}
+
+// SetRequestTotal sets the "request_total" field.
+func (u *CoreGatewayNodeUpsertBulk) SetRequestTotal(v int64) *CoreGatewayNodeUpsertBulk {
+	return u.Update(func(s *CoreGatewayNodeUpsert) {
+		s.SetRequestTotal(v)
+	})
+}
+
+// AddRequestTotal adds v to the "request_total" field.
+func (u *CoreGatewayNodeUpsertBulk) AddRequestTotal(v int64) *CoreGatewayNodeUpsertBulk {
+	return u.Update(func(s *CoreGatewayNodeUpsert) {
+		s.AddRequestTotal(v)
+	})
+}
+
+// UpdateRequestTotal sets the "request_total" field to the value that was provided on create.
+func (u *CoreGatewayNodeUpsertBulk) UpdateRequestTotal() *CoreGatewayNodeUpsertBulk {
+	return u.Update(func(s *CoreGatewayNodeUpsert) {
+		s.UpdateRequestTotal()
+	})
+}
+
+// ClearRequestTotal clears the value of the "request_total" field.
+func (u *CoreGatewayNodeUpsertBulk) ClearRequestTotal() *CoreGatewayNodeUpsertBulk {
+	return u.Update(func(s *CoreGatewayNodeUpsert) {
+		s.ClearRequestTotal()
+	})
+}
+
+// SetErrorTotal sets the "error_total" field.
+func (u *CoreGatewayNodeUpsertBulk) SetErrorTotal(v int64) *CoreGatewayNodeUpsertBulk {
+	return u.Update(func(s *CoreGatewayNodeUpsert) {
+		s.SetErrorTotal(v)
+	})
+}
+
+// AddErrorTotal adds v to the "error_total" field.
+func (u *CoreGatewayNodeUpsertBulk) AddErrorTotal(v int64) *CoreGatewayNodeUpsertBulk {
+	return u.Update(func(s *CoreGatewayNodeUpsert) {
+		s.AddErrorTotal(v)
+	})
+}
+
+// UpdateErrorTotal sets the "error_total" field to the value that was provided on create.
+func (u *CoreGatewayNodeUpsertBulk) UpdateErrorTotal() *CoreGatewayNodeUpsertBulk {
+	return u.Update(func(s *CoreGatewayNodeUpsert) {
+		s.UpdateErrorTotal()
+	})
+}
+
+// ClearErrorTotal clears the value of the "error_total" field.
+func (u *CoreGatewayNodeUpsertBulk) ClearErrorTotal() *CoreGatewayNodeUpsertBulk {
+	return u.Update(func(s *CoreGatewayNodeUpsert) {
+		s.ClearErrorTotal()
+	})
+}
+
 // Exec executes the query.
 func (u *CoreGatewayNodeUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
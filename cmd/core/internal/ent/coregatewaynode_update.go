@@ -11,6 +11,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/lyonmu/quebec/cmd/core/internal/common"
 	"github.com/lyonmu/quebec/cmd/core/internal/ent/coregatewaycluster"
 	"github.com/lyonmu/quebec/cmd/core/internal/ent/coregatewaynode"
 	"github.com/lyonmu/quebec/cmd/core/internal/ent/predicate"
@@ -177,6 +178,119 @@ func (_u *CoreGatewayNodeUpdate) ClearNodeLastRequestTime() *CoreGatewayNodeUpda
 	return _u
 }
 
+// SetAppliedRevision sets the "applied_revision" field.
+func (_u *CoreGatewayNodeUpdate) SetAppliedRevision(v int64) *CoreGatewayNodeUpdate {
+	_u.mutation.ResetAppliedRevision()
+	_u.mutation.SetAppliedRevision(v)
+	return _u
+}
+
+// SetNillableAppliedRevision sets the "applied_revision" field if the given value is not nil.
+func (_u *CoreGatewayNodeUpdate) SetNillableAppliedRevision(v *int64) *CoreGatewayNodeUpdate {
+	if v != nil {
+		_u.SetAppliedRevision(*v)
+	}
+	return _u
+}
+
+// AddAppliedRevision adds value to the "applied_revision" field.
+func (_u *CoreGatewayNodeUpdate) AddAppliedRevision(v int64) *CoreGatewayNodeUpdate {
+	_u.mutation.AddAppliedRevision(v)
+	return _u
+}
+
+// ClearAppliedRevision clears the value of the "applied_revision" field.
+func (_u *CoreGatewayNodeUpdate) ClearAppliedRevision() *CoreGatewayNodeUpdate {
+	_u.mutation.ClearAppliedRevision()
+	return _u
+}
+
+// SetLastError sets the "last_error" field.
+func (_u *CoreGatewayNodeUpdate) SetLastError(v string) *CoreGatewayNodeUpdate {
+	_u.mutation.SetLastError(v)
+	return _u
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (_u *CoreGatewayNodeUpdate) SetNillableLastError(v *string) *CoreGatewayNodeUpdate {
+	if v != nil {
+		_u.SetLastError(*v)
+	}
+	return _u
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (_u *CoreGatewayNodeUpdate) ClearLastError() *CoreGatewayNodeUpdate {
+	_u.mutation.ClearLastError()
+	return _u
+}
+
+// SetXdsStatus sets the "xds_status" field.
+func (_u *CoreGatewayNodeUpdate) SetXdsStatus(v map[string]common.XdsStatus) *CoreGatewayNodeUpdate {
+	_u.mutation.SetXdsStatus(v)
+	return _u
+}
+
+// ClearXdsStatus clears the value of the "xds_status" field.
+func (_u *CoreGatewayNodeUpdate) ClearXdsStatus() *CoreGatewayNodeUpdate {
+	_u.mutation.ClearXdsStatus()
+	return _u
+}
+
+// SetRequestTotal sets the "request_total" field.
+func (_u *CoreGatewayNodeUpdate) SetRequestTotal(v int64) *CoreGatewayNodeUpdate {
+	_u.mutation.ResetRequestTotal()
+	_u.mutation.SetRequestTotal(v)
+	return _u
+}
+
+// SetNillableRequestTotal sets the "request_total" field if the given value is not nil.
+func (_u *CoreGatewayNodeUpdate) SetNillableRequestTotal(v *int64) *CoreGatewayNodeUpdate {
+	if v != nil {
+		_u.SetRequestTotal(*v)
+	}
+	return _u
+}
+
+// AddRequestTotal adds value to the "request_total" field.
+func (_u *CoreGatewayNodeUpdate) AddRequestTotal(v int64) *CoreGatewayNodeUpdate {
+	_u.mutation.AddRequestTotal(v)
+	return _u
+}
+
+// ClearRequestTotal clears the value of the "request_total" field.
+func (_u *CoreGatewayNodeUpdate) ClearRequestTotal() *CoreGatewayNodeUpdate {
+	_u.mutation.ClearRequestTotal()
+	return _u
+}
+
+// SetErrorTotal sets the "error_total" field.
+func (_u *CoreGatewayNodeUpdate) SetErrorTotal(v int64) *CoreGatewayNodeUpdate {
+	_u.mutation.ResetErrorTotal()
+	_u.mutation.SetErrorTotal(v)
+	return _u
+}
+
+// SetNillableErrorTotal sets the "error_total" field if the given value is not nil.
+func (_u *CoreGatewayNodeUpdate) SetNillableErrorTotal(v *int64) *CoreGatewayNodeUpdate {
+	if v != nil {
+		_u.SetErrorTotal(*v)
+	}
+	return _u
+}
+
+// AddErrorTotal adds value to the "error_total" field.
+func (_u *CoreGatewayNodeUpdate) AddErrorTotal(v int64) *CoreGatewayNodeUpdate {
+	_u.mutation.AddErrorTotal(v)
+	return _u
+}
+
+// ClearErrorTotal clears the value of the "error_total" field.
+func (_u *CoreGatewayNodeUpdate) ClearErrorTotal() *CoreGatewayNodeUpdate {
+	_u.mutation.ClearErrorTotal()
+	return _u
+}
+
 // SetNodeFromClusterID sets the "node_from_cluster" edge to the CoreGatewayCluster entity by ID.
 func (_u *CoreGatewayNodeUpdate) SetNodeFromClusterID(id string) *CoreGatewayNodeUpdate {
 	_u.mutation.SetNodeFromClusterID(id)
@@ -306,6 +420,45 @@ func (_u *CoreGatewayNodeUpdate) sqlSave(ctx context.Context) (_node int, err er
 	if _u.mutation.NodeLastRequestTimeCleared() {
 		_spec.ClearField(coregatewaynode.FieldNodeLastRequestTime, field.TypeInt64)
 	}
+	if value, ok := _u.mutation.AppliedRevision(); ok {
+		_spec.SetField(coregatewaynode.FieldAppliedRevision, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedAppliedRevision(); ok {
+		_spec.AddField(coregatewaynode.FieldAppliedRevision, field.TypeInt64, value)
+	}
+	if _u.mutation.AppliedRevisionCleared() {
+		_spec.ClearField(coregatewaynode.FieldAppliedRevision, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.LastError(); ok {
+		_spec.SetField(coregatewaynode.FieldLastError, field.TypeString, value)
+	}
+	if _u.mutation.LastErrorCleared() {
+		_spec.ClearField(coregatewaynode.FieldLastError, field.TypeString)
+	}
+	if value, ok := _u.mutation.XdsStatus(); ok {
+		_spec.SetField(coregatewaynode.FieldXdsStatus, field.TypeJSON, value)
+	}
+	if _u.mutation.XdsStatusCleared() {
+		_spec.ClearField(coregatewaynode.FieldXdsStatus, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.RequestTotal(); ok {
+		_spec.SetField(coregatewaynode.FieldRequestTotal, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedRequestTotal(); ok {
+		_spec.AddField(coregatewaynode.FieldRequestTotal, field.TypeInt64, value)
+	}
+	if _u.mutation.RequestTotalCleared() {
+		_spec.ClearField(coregatewaynode.FieldRequestTotal, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.ErrorTotal(); ok {
+		_spec.SetField(coregatewaynode.FieldErrorTotal, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedErrorTotal(); ok {
+		_spec.AddField(coregatewaynode.FieldErrorTotal, field.TypeInt64, value)
+	}
+	if _u.mutation.ErrorTotalCleared() {
+		_spec.ClearField(coregatewaynode.FieldErrorTotal, field.TypeInt64)
+	}
 	if _u.mutation.NodeFromClusterCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -504,6 +657,119 @@ func (_u *CoreGatewayNodeUpdateOne) ClearNodeLastRequestTime() *CoreGatewayNodeU
 	return _u
 }
 
+// SetAppliedRevision sets the "applied_revision" field.
+func (_u *CoreGatewayNodeUpdateOne) SetAppliedRevision(v int64) *CoreGatewayNodeUpdateOne {
+	_u.mutation.ResetAppliedRevision()
+	_u.mutation.SetAppliedRevision(v)
+	return _u
+}
+
+// SetNillableAppliedRevision sets the "applied_revision" field if the given value is not nil.
+func (_u *CoreGatewayNodeUpdateOne) SetNillableAppliedRevision(v *int64) *CoreGatewayNodeUpdateOne {
+	if v != nil {
+		_u.SetAppliedRevision(*v)
+	}
+	return _u
+}
+
+// AddAppliedRevision adds value to the "applied_revision" field.
+func (_u *CoreGatewayNodeUpdateOne) AddAppliedRevision(v int64) *CoreGatewayNodeUpdateOne {
+	_u.mutation.AddAppliedRevision(v)
+	return _u
+}
+
+// ClearAppliedRevision clears the value of the "applied_revision" field.
+func (_u *CoreGatewayNodeUpdateOne) ClearAppliedRevision() *CoreGatewayNodeUpdateOne {
+	_u.mutation.ClearAppliedRevision()
+	return _u
+}
+
+// SetLastError sets the "last_error" field.
+func (_u *CoreGatewayNodeUpdateOne) SetLastError(v string) *CoreGatewayNodeUpdateOne {
+	_u.mutation.SetLastError(v)
+	return _u
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (_u *CoreGatewayNodeUpdateOne) SetNillableLastError(v *string) *CoreGatewayNodeUpdateOne {
+	if v != nil {
+		_u.SetLastError(*v)
+	}
+	return _u
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (_u *CoreGatewayNodeUpdateOne) ClearLastError() *CoreGatewayNodeUpdateOne {
+	_u.mutation.ClearLastError()
+	return _u
+}
+
+// SetXdsStatus sets the "xds_status" field.
+func (_u *CoreGatewayNodeUpdateOne) SetXdsStatus(v map[string]common.XdsStatus) *CoreGatewayNodeUpdateOne {
+	_u.mutation.SetXdsStatus(v)
+	return _u
+}
+
+// ClearXdsStatus clears the value of the "xds_status" field.
+func (_u *CoreGatewayNodeUpdateOne) ClearXdsStatus() *CoreGatewayNodeUpdateOne {
+	_u.mutation.ClearXdsStatus()
+	return _u
+}
+
+// SetRequestTotal sets the "request_total" field.
+func (_u *CoreGatewayNodeUpdateOne) SetRequestTotal(v int64) *CoreGatewayNodeUpdateOne {
+	_u.mutation.ResetRequestTotal()
+	_u.mutation.SetRequestTotal(v)
+	return _u
+}
+
+// SetNillableRequestTotal sets the "request_total" field if the given value is not nil.
+func (_u *CoreGatewayNodeUpdateOne) SetNillableRequestTotal(v *int64) *CoreGatewayNodeUpdateOne {
+	if v != nil {
+		_u.SetRequestTotal(*v)
+	}
+	return _u
+}
+
+// AddRequestTotal adds value to the "request_total" field.
+func (_u *CoreGatewayNodeUpdateOne) AddRequestTotal(v int64) *CoreGatewayNodeUpdateOne {
+	_u.mutation.AddRequestTotal(v)
+	return _u
+}
+
+// ClearRequestTotal clears the value of the "request_total" field.
+func (_u *CoreGatewayNodeUpdateOne) ClearRequestTotal() *CoreGatewayNodeUpdateOne {
+	_u.mutation.ClearRequestTotal()
+	return _u
+}
+
+// SetErrorTotal sets the "error_total" field.
+func (_u *CoreGatewayNodeUpdateOne) SetErrorTotal(v int64) *CoreGatewayNodeUpdateOne {
+	_u.mutation.ResetErrorTotal()
+	_u.mutation.SetErrorTotal(v)
+	return _u
+}
+
+// SetNillableErrorTotal sets the "error_total" field if the given value is not nil.
+func (_u *CoreGatewayNodeUpdateOne) SetNillableErrorTotal(v *int64) *CoreGatewayNodeUpdateOne {
+	if v != nil {
+		_u.SetErrorTotal(*v)
+	}
+	return _u
+}
+
+// AddErrorTotal adds value to the "error_total" field.
+func (_u *CoreGatewayNodeUpdateOne) AddErrorTotal(v int64) *CoreGatewayNodeUpdateOne {
+	_u.mutation.AddErrorTotal(v)
+	return _u
+}
+
+// ClearErrorTotal clears the value of the "error_total" field.
+func (_u *CoreGatewayNodeUpdateOne) ClearErrorTotal() *CoreGatewayNodeUpdateOne {
+	_u.mutation.ClearErrorTotal()
+	return _u
+}
+
 // SetNodeFromClusterID sets the "node_from_cluster" edge to the CoreGatewayCluster entity by ID.
 func (_u *CoreGatewayNodeUpdateOne) SetNodeFromClusterID(id string) *CoreGatewayNodeUpdateOne {
 	_u.mutation.SetNodeFromClusterID(id)
@@ -663,6 +929,45 @@ func (_u *CoreGatewayNodeUpdateOne) sqlSave(ctx context.Context) (_node *CoreGat
 	if _u.mutation.NodeLastRequestTimeCleared() {
 		_spec.ClearField(coregatewaynode.FieldNodeLastRequestTime, field.TypeInt64)
 	}
+	if value, ok := _u.mutation.AppliedRevision(); ok {
+		_spec.SetField(coregatewaynode.FieldAppliedRevision, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedAppliedRevision(); ok {
+		_spec.AddField(coregatewaynode.FieldAppliedRevision, field.TypeInt64, value)
+	}
+	if _u.mutation.AppliedRevisionCleared() {
+		_spec.ClearField(coregatewaynode.FieldAppliedRevision, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.LastError(); ok {
+		_spec.SetField(coregatewaynode.FieldLastError, field.TypeString, value)
+	}
+	if _u.mutation.LastErrorCleared() {
+		_spec.ClearField(coregatewaynode.FieldLastError, field.TypeString)
+	}
+	if value, ok := _u.mutation.XdsStatus(); ok {
+		_spec.SetField(coregatewaynode.FieldXdsStatus, field.TypeJSON, value)
+	}
+	if _u.mutation.XdsStatusCleared() {
+		_spec.ClearField(coregatewaynode.FieldXdsStatus, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.RequestTotal(); ok {
+		_spec.SetField(coregatewaynode.FieldRequestTotal, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedRequestTotal(); ok {
+		_spec.AddField(coregatewaynode.FieldRequestTotal, field.TypeInt64, value)
+	}
+	if _u.mutation.RequestTotalCleared() {
+		_spec.ClearField(coregatewaynode.FieldRequestTotal, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.ErrorTotal(); ok {
+		_spec.SetField(coregatewaynode.FieldErrorTotal, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedErrorTotal(); ok {
+		_spec.AddField(coregatewaynode.FieldErrorTotal, field.TypeInt64, value)
+	}
+	if _u.mutation.ErrorTotalCleared() {
+		_spec.ClearField(coregatewaynode.FieldErrorTotal, field.TypeInt64)
+	}
 	if _u.mutation.NodeFromClusterCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
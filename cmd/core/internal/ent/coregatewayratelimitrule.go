@@ -0,0 +1,205 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/lyonmu/quebec/cmd/core/internal/common"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/coregatewayratelimitrule"
+	"github.com/lyonmu/quebec/pkg/constant"
+)
+
+// 全局限流规则表
+type CoreGatewayRateLimitRule struct {
+	config `json:"-"`
+	// ID of the ent.
+	// 主键ID
+	ID string `json:"id,omitempty"`
+	// 创建时间
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// 更新时间
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// 删除时间
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// 限流规则名称
+	Name string `json:"name,omitempty"`
+	// 限流规则描述
+	Description string `json:"description,omitempty"`
+	// 匹配的描述符条目，需与路由的全局限流描述符条目顺序一致
+	Descriptors []common.RateLimitDescriptor `json:"descriptors,omitempty"`
+	// 计数周期 [1: 秒, 2: 分钟, 3: 小时, 4: 天]
+	Unit constant.ProxyRateLimitUnit `json:"unit,omitempty"`
+	// 每个计数周期允许的请求数
+	RequestsPerUnit uint32 `json:"requests_per_unit,omitempty"`
+	// 是否启用 [1: 启用, 2: 禁用]
+	Status       constant.YesOrNo `json:"status,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*CoreGatewayRateLimitRule) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case coregatewayratelimitrule.FieldDescriptors:
+			values[i] = new([]byte)
+		case coregatewayratelimitrule.FieldUnit, coregatewayratelimitrule.FieldRequestsPerUnit, coregatewayratelimitrule.FieldStatus:
+			values[i] = new(sql.NullInt64)
+		case coregatewayratelimitrule.FieldID, coregatewayratelimitrule.FieldName, coregatewayratelimitrule.FieldDescription:
+			values[i] = new(sql.NullString)
+		case coregatewayratelimitrule.FieldCreatedAt, coregatewayratelimitrule.FieldUpdatedAt, coregatewayratelimitrule.FieldDeletedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the CoreGatewayRateLimitRule fields.
+func (_m *CoreGatewayRateLimitRule) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case coregatewayratelimitrule.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case coregatewayratelimitrule.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case coregatewayratelimitrule.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case coregatewayratelimitrule.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				_m.DeletedAt = new(time.Time)
+				*_m.DeletedAt = value.Time
+			}
+		case coregatewayratelimitrule.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case coregatewayratelimitrule.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				_m.Description = value.String
+			}
+		case coregatewayratelimitrule.FieldDescriptors:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field descriptors", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Descriptors); err != nil {
+					return fmt.Errorf("unmarshal field descriptors: %w", err)
+				}
+			}
+		case coregatewayratelimitrule.FieldUnit:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field unit", values[i])
+			} else if value.Valid {
+				_m.Unit = constant.ProxyRateLimitUnit(value.Int64)
+			}
+		case coregatewayratelimitrule.FieldRequestsPerUnit:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field requests_per_unit", values[i])
+			} else if value.Valid {
+				_m.RequestsPerUnit = uint32(value.Int64)
+			}
+		case coregatewayratelimitrule.FieldStatus:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = constant.YesOrNo(value.Int64)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the CoreGatewayRateLimitRule.
+// This includes values selected through modifiers, order, etc.
+func (_m *CoreGatewayRateLimitRule) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this CoreGatewayRateLimitRule.
+// Note that you need to call CoreGatewayRateLimitRule.Unwrap() before calling this method if this CoreGatewayRateLimitRule
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *CoreGatewayRateLimitRule) Update() *CoreGatewayRateLimitRuleUpdateOne {
+	return NewCoreGatewayRateLimitRuleClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the CoreGatewayRateLimitRule entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *CoreGatewayRateLimitRule) Unwrap() *CoreGatewayRateLimitRule {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: CoreGatewayRateLimitRule is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *CoreGatewayRateLimitRule) String() string {
+	var builder strings.Builder
+	builder.WriteString("CoreGatewayRateLimitRule(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("description=")
+	builder.WriteString(_m.Description)
+	builder.WriteString(", ")
+	builder.WriteString("descriptors=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Descriptors))
+	builder.WriteString(", ")
+	builder.WriteString("unit=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Unit))
+	builder.WriteString(", ")
+	builder.WriteString("requests_per_unit=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RequestsPerUnit))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// CoreGatewayRateLimitRules is a parsable slice of CoreGatewayRateLimitRule.
+type CoreGatewayRateLimitRules []*CoreGatewayRateLimitRule
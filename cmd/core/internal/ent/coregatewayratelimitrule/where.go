@@ -0,0 +1,591 @@
+// Code generated by ent, DO NOT EDIT.
+
+package coregatewayratelimitrule
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/predicate"
+	"github.com/lyonmu/quebec/pkg/constant"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.CoreGatewayRateLimitRule {
+	return predicate.CoreGatewayRateLimitRule(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.CoreGatewayRateLimitRule {
+	return predicate.CoreGatewayRateLimitRule(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.CoreGatewayRateLimitRule {
+	return predicate.CoreGatewayRateLimitRule(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.CoreGatewayRateLimitRule {
+	return predicate.CoreGatewayRateLimitRule(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.CoreGatewayRateLimitRule {
+	return predicate.CoreGatewayRateLimitRule(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.CoreGatewayRateLimitRule {
+	return predicate.CoreGatewayRateLimitRule(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.CoreGatewayRateLimitRule {
+	return predicate.CoreGatewayRateLimitRule(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.CoreGatewayRateLimitRule {
+	return predicate.CoreGatewayRateLimitRule(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.CoreGatewayRateLimitRule {
+	return predicate.CoreGatewayRateLimitRule(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.CoreGatewayRateLimitRule {
+	return predicate.CoreGatewayRateLimitRule(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.CoreGatewayRateLimitRule {
+	return predicate.CoreGatewayRateLimitRule(sql.FieldContainsFold(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.CoreGatewayRateLimitRule {
+	return predicate.CoreGatewayRateLimitRule(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.CoreGatewayRateLimitRule {
+	return predicate.CoreGatewayRateLimitRule(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.CoreGatewayRateLimitRule {
+	return predicate.CoreGatewayRateLimitRule(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.CoreGatewayRateLimitRule {
+	return predicate.CoreGatewayRateLimitRule(sql.FieldEQ(FieldName, v))
+}
+
+// Description applies equality check predicate on the "description" field. It's identical to DescriptionEQ.
+func Description(v string) predicate.CoreGatewayRateLimitRule {
+	return predicate.CoreGatewayRateLimitRule(sql.FieldEQ(FieldDescription, v))
+}
+
+// Unit applies equality check predicate on the "unit" field. It's identical to UnitEQ.
+func Unit(v constant.ProxyRateLimitUnit) predicate.CoreGatewayRateLimitRule {
+	vc := int8(v)
+	return predicate.CoreGatewayRateLimitRule(sql.FieldEQ(FieldUnit, vc))
+}
+
+// RequestsPerUnit applies equality check predicate on the "requests_per_unit" field. It's identical to RequestsPerUnitEQ.
+func RequestsPerUnit(v uint32) predicate.CoreGatewayRateLimitRule {
+	return predicate.CoreGatewayRateLimitRule(sql.FieldEQ(FieldRequestsPerUnit, v))
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v constant.YesOrNo) predicate.CoreGatewayRateLimitRule {
+	vc := int8(v)
+	return predicate.CoreGatewayRateLimitRule(sql.FieldEQ(FieldStatus, vc))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.CoreGatewayRateLimitRule {
+	return predicate.CoreGatewayRateLimitRule(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.CoreGatewayRateLimitRule {
+	return predicate.CoreGatewayRateLimitRule(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.CoreGatewayRateLimitRule {
+	return predicate.CoreGatewayRateLimitRule(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.CoreGatewayRateLimitRule {
+	return predicate.CoreGatewayRateLimitRule(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.CoreGatewayRateLimitRule {
+	return predicate.CoreGatewayRateLimitRule(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.CoreGatewayRateLimitRule {
+	return predicate.CoreGatewayRateLimitRule(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.CoreGatewayRateLimitRule {
+	return predicate.CoreGatewayRateLimitRule(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.CoreGatewayRateLimitRule {
+	return predicate.CoreGatewayRateLimitRule(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.CoreGatewayRateLimitRule {
+	return predicate.CoreGatewayRateLimitRule(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.CoreGatewayRateLimitRule {
+	return predicate.CoreGatewayRateLimitRule(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.CoreGatewayRateLimitRule {
+	return predicate.CoreGatewayRateLimitRule(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.CoreGatewayRateLimitRule {
+	return predicate.CoreGatewayRateLimitRule(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.CoreGatewayRateLimitRule {
+	return predicate.CoreGatewayRateLimitRule(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.CoreGatewayRateLimitRule {
+	return predicate.CoreGatewayRateLimitRule(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.CoreGatewayRateLimitRule {
+	return predicate.CoreGatewayRateLimitRule(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.CoreGatewayRateLimitRule {
+	return predicate.CoreGatewayRateLimitRule(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.CoreGatewayRateLimitRule {
+	return predicate.CoreGatewayRateLimitRule(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.CoreGatewayRateLimitRule {
+	return predicate.CoreGatewayRateLimitRule(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.CoreGatewayRateLimitRule {
+	return predicate.CoreGatewayRateLimitRule(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.CoreGatewayRateLimitRule {
+	return predicate.CoreGatewayRateLimitRule(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.CoreGatewayRateLimitRule {
+	return predicate.CoreGatewayRateLimitRule(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.CoreGatewayRateLimitRule {
+	return predicate.CoreGatewayRateLimitRule(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.CoreGatewayRateLimitRule {
+	return predicate.CoreGatewayRateLimitRule(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.CoreGatewayRateLimitRule {
+	return predicate.CoreGatewayRateLimitRule(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.CoreGatewayRateLimitRule {
+	return predicate.CoreGatewayRateLimitRule(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.CoreGatewayRateLimitRule {
+	return predicate.CoreGatewayRateLimitRule(sql.FieldNotNull(FieldDeletedAt))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.CoreGatewayRateLimitRule {
+	return predicate.CoreGatewayRateLimitRule(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.CoreGatewayRateLimitRule {
+	return predicate.CoreGatewayRateLimitRule(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.CoreGatewayRateLimitRule {
+	return predicate.CoreGatewayRateLimitRule(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.CoreGatewayRateLimitRule {
+	return predicate.CoreGatewayRateLimitRule(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.CoreGatewayRateLimitRule {
+	return predicate.CoreGatewayRateLimitRule(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.CoreGatewayRateLimitRule {
+	return predicate.CoreGatewayRateLimitRule(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.CoreGatewayRateLimitRule {
+	return predicate.CoreGatewayRateLimitRule(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.CoreGatewayRateLimitRule {
+	return predicate.CoreGatewayRateLimitRule(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.CoreGatewayRateLimitRule {
+	return predicate.CoreGatewayRateLimitRule(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.CoreGatewayRateLimitRule {
+	return predicate.CoreGatewayRateLimitRule(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.CoreGatewayRateLimitRule {
+	return predicate.CoreGatewayRateLimitRule(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameIsNil applies the IsNil predicate on the "name" field.
+func NameIsNil() predicate.CoreGatewayRateLimitRule {
+	return predicate.CoreGatewayRateLimitRule(sql.FieldIsNull(FieldName))
+}
+
+// NameNotNil applies the NotNil predicate on the "name" field.
+func NameNotNil() predicate.CoreGatewayRateLimitRule {
+	return predicate.CoreGatewayRateLimitRule(sql.FieldNotNull(FieldName))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.CoreGatewayRateLimitRule {
+	return predicate.CoreGatewayRateLimitRule(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.CoreGatewayRateLimitRule {
+	return predicate.CoreGatewayRateLimitRule(sql.FieldContainsFold(FieldName, v))
+}
+
+// DescriptionEQ applies the EQ predicate on the "description" field.
+func DescriptionEQ(v string) predicate.CoreGatewayRateLimitRule {
+	return predicate.CoreGatewayRateLimitRule(sql.FieldEQ(FieldDescription, v))
+}
+
+// DescriptionNEQ applies the NEQ predicate on the "description" field.
+func DescriptionNEQ(v string) predicate.CoreGatewayRateLimitRule {
+	return predicate.CoreGatewayRateLimitRule(sql.FieldNEQ(FieldDescription, v))
+}
+
+// DescriptionIn applies the In predicate on the "description" field.
+func DescriptionIn(vs ...string) predicate.CoreGatewayRateLimitRule {
+	return predicate.CoreGatewayRateLimitRule(sql.FieldIn(FieldDescription, vs...))
+}
+
+// DescriptionNotIn applies the NotIn predicate on the "description" field.
+func DescriptionNotIn(vs ...string) predicate.CoreGatewayRateLimitRule {
+	return predicate.CoreGatewayRateLimitRule(sql.FieldNotIn(FieldDescription, vs...))
+}
+
+// DescriptionGT applies the GT predicate on the "description" field.
+func DescriptionGT(v string) predicate.CoreGatewayRateLimitRule {
+	return predicate.CoreGatewayRateLimitRule(sql.FieldGT(FieldDescription, v))
+}
+
+// DescriptionGTE applies the GTE predicate on the "description" field.
+func DescriptionGTE(v string) predicate.CoreGatewayRateLimitRule {
+	return predicate.CoreGatewayRateLimitRule(sql.FieldGTE(FieldDescription, v))
+}
+
+// DescriptionLT applies the LT predicate on the "description" field.
+func DescriptionLT(v string) predicate.CoreGatewayRateLimitRule {
+	return predicate.CoreGatewayRateLimitRule(sql.FieldLT(FieldDescription, v))
+}
+
+// DescriptionLTE applies the LTE predicate on the "description" field.
+func DescriptionLTE(v string) predicate.CoreGatewayRateLimitRule {
+	return predicate.CoreGatewayRateLimitRule(sql.FieldLTE(FieldDescription, v))
+}
+
+// DescriptionContains applies the Contains predicate on the "description" field.
+func DescriptionContains(v string) predicate.CoreGatewayRateLimitRule {
+	return predicate.CoreGatewayRateLimitRule(sql.FieldContains(FieldDescription, v))
+}
+
+// DescriptionHasPrefix applies the HasPrefix predicate on the "description" field.
+func DescriptionHasPrefix(v string) predicate.CoreGatewayRateLimitRule {
+	return predicate.CoreGatewayRateLimitRule(sql.FieldHasPrefix(FieldDescription, v))
+}
+
+// DescriptionHasSuffix applies the HasSuffix predicate on the "description" field.
+func DescriptionHasSuffix(v string) predicate.CoreGatewayRateLimitRule {
+	return predicate.CoreGatewayRateLimitRule(sql.FieldHasSuffix(FieldDescription, v))
+}
+
+// DescriptionIsNil applies the IsNil predicate on the "description" field.
+func DescriptionIsNil() predicate.CoreGatewayRateLimitRule {
+	return predicate.CoreGatewayRateLimitRule(sql.FieldIsNull(FieldDescription))
+}
+
+// DescriptionNotNil applies the NotNil predicate on the "description" field.
+func DescriptionNotNil() predicate.CoreGatewayRateLimitRule {
+	return predicate.CoreGatewayRateLimitRule(sql.FieldNotNull(FieldDescription))
+}
+
+// DescriptionEqualFold applies the EqualFold predicate on the "description" field.
+func DescriptionEqualFold(v string) predicate.CoreGatewayRateLimitRule {
+	return predicate.CoreGatewayRateLimitRule(sql.FieldEqualFold(FieldDescription, v))
+}
+
+// DescriptionContainsFold applies the ContainsFold predicate on the "description" field.
+func DescriptionContainsFold(v string) predicate.CoreGatewayRateLimitRule {
+	return predicate.CoreGatewayRateLimitRule(sql.FieldContainsFold(FieldDescription, v))
+}
+
+// DescriptorsIsNil applies the IsNil predicate on the "descriptors" field.
+func DescriptorsIsNil() predicate.CoreGatewayRateLimitRule {
+	return predicate.CoreGatewayRateLimitRule(sql.FieldIsNull(FieldDescriptors))
+}
+
+// DescriptorsNotNil applies the NotNil predicate on the "descriptors" field.
+func DescriptorsNotNil() predicate.CoreGatewayRateLimitRule {
+	return predicate.CoreGatewayRateLimitRule(sql.FieldNotNull(FieldDescriptors))
+}
+
+// UnitEQ applies the EQ predicate on the "unit" field.
+func UnitEQ(v constant.ProxyRateLimitUnit) predicate.CoreGatewayRateLimitRule {
+	vc := int8(v)
+	return predicate.CoreGatewayRateLimitRule(sql.FieldEQ(FieldUnit, vc))
+}
+
+// UnitNEQ applies the NEQ predicate on the "unit" field.
+func UnitNEQ(v constant.ProxyRateLimitUnit) predicate.CoreGatewayRateLimitRule {
+	vc := int8(v)
+	return predicate.CoreGatewayRateLimitRule(sql.FieldNEQ(FieldUnit, vc))
+}
+
+// UnitIn applies the In predicate on the "unit" field.
+func UnitIn(vs ...constant.ProxyRateLimitUnit) predicate.CoreGatewayRateLimitRule {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = int8(vs[i])
+	}
+	return predicate.CoreGatewayRateLimitRule(sql.FieldIn(FieldUnit, v...))
+}
+
+// UnitNotIn applies the NotIn predicate on the "unit" field.
+func UnitNotIn(vs ...constant.ProxyRateLimitUnit) predicate.CoreGatewayRateLimitRule {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = int8(vs[i])
+	}
+	return predicate.CoreGatewayRateLimitRule(sql.FieldNotIn(FieldUnit, v...))
+}
+
+// UnitGT applies the GT predicate on the "unit" field.
+func UnitGT(v constant.ProxyRateLimitUnit) predicate.CoreGatewayRateLimitRule {
+	vc := int8(v)
+	return predicate.CoreGatewayRateLimitRule(sql.FieldGT(FieldUnit, vc))
+}
+
+// UnitGTE applies the GTE predicate on the "unit" field.
+func UnitGTE(v constant.ProxyRateLimitUnit) predicate.CoreGatewayRateLimitRule {
+	vc := int8(v)
+	return predicate.CoreGatewayRateLimitRule(sql.FieldGTE(FieldUnit, vc))
+}
+
+// UnitLT applies the LT predicate on the "unit" field.
+func UnitLT(v constant.ProxyRateLimitUnit) predicate.CoreGatewayRateLimitRule {
+	vc := int8(v)
+	return predicate.CoreGatewayRateLimitRule(sql.FieldLT(FieldUnit, vc))
+}
+
+// UnitLTE applies the LTE predicate on the "unit" field.
+func UnitLTE(v constant.ProxyRateLimitUnit) predicate.CoreGatewayRateLimitRule {
+	vc := int8(v)
+	return predicate.CoreGatewayRateLimitRule(sql.FieldLTE(FieldUnit, vc))
+}
+
+// UnitIsNil applies the IsNil predicate on the "unit" field.
+func UnitIsNil() predicate.CoreGatewayRateLimitRule {
+	return predicate.CoreGatewayRateLimitRule(sql.FieldIsNull(FieldUnit))
+}
+
+// UnitNotNil applies the NotNil predicate on the "unit" field.
+func UnitNotNil() predicate.CoreGatewayRateLimitRule {
+	return predicate.CoreGatewayRateLimitRule(sql.FieldNotNull(FieldUnit))
+}
+
+// RequestsPerUnitEQ applies the EQ predicate on the "requests_per_unit" field.
+func RequestsPerUnitEQ(v uint32) predicate.CoreGatewayRateLimitRule {
+	return predicate.CoreGatewayRateLimitRule(sql.FieldEQ(FieldRequestsPerUnit, v))
+}
+
+// RequestsPerUnitNEQ applies the NEQ predicate on the "requests_per_unit" field.
+func RequestsPerUnitNEQ(v uint32) predicate.CoreGatewayRateLimitRule {
+	return predicate.CoreGatewayRateLimitRule(sql.FieldNEQ(FieldRequestsPerUnit, v))
+}
+
+// RequestsPerUnitIn applies the In predicate on the "requests_per_unit" field.
+func RequestsPerUnitIn(vs ...uint32) predicate.CoreGatewayRateLimitRule {
+	return predicate.CoreGatewayRateLimitRule(sql.FieldIn(FieldRequestsPerUnit, vs...))
+}
+
+// RequestsPerUnitNotIn applies the NotIn predicate on the "requests_per_unit" field.
+func RequestsPerUnitNotIn(vs ...uint32) predicate.CoreGatewayRateLimitRule {
+	return predicate.CoreGatewayRateLimitRule(sql.FieldNotIn(FieldRequestsPerUnit, vs...))
+}
+
+// RequestsPerUnitGT applies the GT predicate on the "requests_per_unit" field.
+func RequestsPerUnitGT(v uint32) predicate.CoreGatewayRateLimitRule {
+	return predicate.CoreGatewayRateLimitRule(sql.FieldGT(FieldRequestsPerUnit, v))
+}
+
+// RequestsPerUnitGTE applies the GTE predicate on the "requests_per_unit" field.
+func RequestsPerUnitGTE(v uint32) predicate.CoreGatewayRateLimitRule {
+	return predicate.CoreGatewayRateLimitRule(sql.FieldGTE(FieldRequestsPerUnit, v))
+}
+
+// RequestsPerUnitLT applies the LT predicate on the "requests_per_unit" field.
+func RequestsPerUnitLT(v uint32) predicate.CoreGatewayRateLimitRule {
+	return predicate.CoreGatewayRateLimitRule(sql.FieldLT(FieldRequestsPerUnit, v))
+}
+
+// RequestsPerUnitLTE applies the LTE predicate on the "requests_per_unit" field.
+func RequestsPerUnitLTE(v uint32) predicate.CoreGatewayRateLimitRule {
+	return predicate.CoreGatewayRateLimitRule(sql.FieldLTE(FieldRequestsPerUnit, v))
+}
+
+// RequestsPerUnitIsNil applies the IsNil predicate on the "requests_per_unit" field.
+func RequestsPerUnitIsNil() predicate.CoreGatewayRateLimitRule {
+	return predicate.CoreGatewayRateLimitRule(sql.FieldIsNull(FieldRequestsPerUnit))
+}
+
+// RequestsPerUnitNotNil applies the NotNil predicate on the "requests_per_unit" field.
+func RequestsPerUnitNotNil() predicate.CoreGatewayRateLimitRule {
+	return predicate.CoreGatewayRateLimitRule(sql.FieldNotNull(FieldRequestsPerUnit))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v constant.YesOrNo) predicate.CoreGatewayRateLimitRule {
+	vc := int8(v)
+	return predicate.CoreGatewayRateLimitRule(sql.FieldEQ(FieldStatus, vc))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v constant.YesOrNo) predicate.CoreGatewayRateLimitRule {
+	vc := int8(v)
+	return predicate.CoreGatewayRateLimitRule(sql.FieldNEQ(FieldStatus, vc))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...constant.YesOrNo) predicate.CoreGatewayRateLimitRule {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = int8(vs[i])
+	}
+	return predicate.CoreGatewayRateLimitRule(sql.FieldIn(FieldStatus, v...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...constant.YesOrNo) predicate.CoreGatewayRateLimitRule {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = int8(vs[i])
+	}
+	return predicate.CoreGatewayRateLimitRule(sql.FieldNotIn(FieldStatus, v...))
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v constant.YesOrNo) predicate.CoreGatewayRateLimitRule {
+	vc := int8(v)
+	return predicate.CoreGatewayRateLimitRule(sql.FieldGT(FieldStatus, vc))
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v constant.YesOrNo) predicate.CoreGatewayRateLimitRule {
+	vc := int8(v)
+	return predicate.CoreGatewayRateLimitRule(sql.FieldGTE(FieldStatus, vc))
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v constant.YesOrNo) predicate.CoreGatewayRateLimitRule {
+	vc := int8(v)
+	return predicate.CoreGatewayRateLimitRule(sql.FieldLT(FieldStatus, vc))
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v constant.YesOrNo) predicate.CoreGatewayRateLimitRule {
+	vc := int8(v)
+	return predicate.CoreGatewayRateLimitRule(sql.FieldLTE(FieldStatus, vc))
+}
+
+// StatusIsNil applies the IsNil predicate on the "status" field.
+func StatusIsNil() predicate.CoreGatewayRateLimitRule {
+	return predicate.CoreGatewayRateLimitRule(sql.FieldIsNull(FieldStatus))
+}
+
+// StatusNotNil applies the NotNil predicate on the "status" field.
+func StatusNotNil() predicate.CoreGatewayRateLimitRule {
+	return predicate.CoreGatewayRateLimitRule(sql.FieldNotNull(FieldStatus))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.CoreGatewayRateLimitRule) predicate.CoreGatewayRateLimitRule {
+	return predicate.CoreGatewayRateLimitRule(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.CoreGatewayRateLimitRule) predicate.CoreGatewayRateLimitRule {
+	return predicate.CoreGatewayRateLimitRule(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.CoreGatewayRateLimitRule) predicate.CoreGatewayRateLimitRule {
+	return predicate.CoreGatewayRateLimitRule(sql.NotPredicates(p))
+}
@@ -0,0 +1,1176 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/lyonmu/quebec/cmd/core/internal/common"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/coregatewayratelimitrule"
+	"github.com/lyonmu/quebec/pkg/constant"
+)
+
+// CoreGatewayRateLimitRuleCreate is the builder for creating a CoreGatewayRateLimitRule entity.
+type CoreGatewayRateLimitRuleCreate struct {
+	config
+	mutation *CoreGatewayRateLimitRuleMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *CoreGatewayRateLimitRuleCreate) SetCreatedAt(v time.Time) *CoreGatewayRateLimitRuleCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *CoreGatewayRateLimitRuleCreate) SetNillableCreatedAt(v *time.Time) *CoreGatewayRateLimitRuleCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *CoreGatewayRateLimitRuleCreate) SetUpdatedAt(v time.Time) *CoreGatewayRateLimitRuleCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *CoreGatewayRateLimitRuleCreate) SetNillableUpdatedAt(v *time.Time) *CoreGatewayRateLimitRuleCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_c *CoreGatewayRateLimitRuleCreate) SetDeletedAt(v time.Time) *CoreGatewayRateLimitRuleCreate {
+	_c.mutation.SetDeletedAt(v)
+	return _c
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_c *CoreGatewayRateLimitRuleCreate) SetNillableDeletedAt(v *time.Time) *CoreGatewayRateLimitRuleCreate {
+	if v != nil {
+		_c.SetDeletedAt(*v)
+	}
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *CoreGatewayRateLimitRuleCreate) SetName(v string) *CoreGatewayRateLimitRuleCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_c *CoreGatewayRateLimitRuleCreate) SetNillableName(v *string) *CoreGatewayRateLimitRuleCreate {
+	if v != nil {
+		_c.SetName(*v)
+	}
+	return _c
+}
+
+// SetDescription sets the "description" field.
+func (_c *CoreGatewayRateLimitRuleCreate) SetDescription(v string) *CoreGatewayRateLimitRuleCreate {
+	_c.mutation.SetDescription(v)
+	return _c
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_c *CoreGatewayRateLimitRuleCreate) SetNillableDescription(v *string) *CoreGatewayRateLimitRuleCreate {
+	if v != nil {
+		_c.SetDescription(*v)
+	}
+	return _c
+}
+
+// SetDescriptors sets the "descriptors" field.
+func (_c *CoreGatewayRateLimitRuleCreate) SetDescriptors(v []common.RateLimitDescriptor) *CoreGatewayRateLimitRuleCreate {
+	_c.mutation.SetDescriptors(v)
+	return _c
+}
+
+// SetUnit sets the "unit" field.
+func (_c *CoreGatewayRateLimitRuleCreate) SetUnit(v constant.ProxyRateLimitUnit) *CoreGatewayRateLimitRuleCreate {
+	_c.mutation.SetUnit(v)
+	return _c
+}
+
+// SetNillableUnit sets the "unit" field if the given value is not nil.
+func (_c *CoreGatewayRateLimitRuleCreate) SetNillableUnit(v *constant.ProxyRateLimitUnit) *CoreGatewayRateLimitRuleCreate {
+	if v != nil {
+		_c.SetUnit(*v)
+	}
+	return _c
+}
+
+// SetRequestsPerUnit sets the "requests_per_unit" field.
+func (_c *CoreGatewayRateLimitRuleCreate) SetRequestsPerUnit(v uint32) *CoreGatewayRateLimitRuleCreate {
+	_c.mutation.SetRequestsPerUnit(v)
+	return _c
+}
+
+// SetNillableRequestsPerUnit sets the "requests_per_unit" field if the given value is not nil.
+func (_c *CoreGatewayRateLimitRuleCreate) SetNillableRequestsPerUnit(v *uint32) *CoreGatewayRateLimitRuleCreate {
+	if v != nil {
+		_c.SetRequestsPerUnit(*v)
+	}
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *CoreGatewayRateLimitRuleCreate) SetStatus(v constant.YesOrNo) *CoreGatewayRateLimitRuleCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *CoreGatewayRateLimitRuleCreate) SetNillableStatus(v *constant.YesOrNo) *CoreGatewayRateLimitRuleCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *CoreGatewayRateLimitRuleCreate) SetID(v string) *CoreGatewayRateLimitRuleCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *CoreGatewayRateLimitRuleCreate) SetNillableID(v *string) *CoreGatewayRateLimitRuleCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the CoreGatewayRateLimitRuleMutation object of the builder.
+func (_c *CoreGatewayRateLimitRuleCreate) Mutation() *CoreGatewayRateLimitRuleMutation {
+	return _c.mutation
+}
+
+// Save creates the CoreGatewayRateLimitRule in the database.
+func (_c *CoreGatewayRateLimitRuleCreate) Save(ctx context.Context) (*CoreGatewayRateLimitRule, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *CoreGatewayRateLimitRuleCreate) SaveX(ctx context.Context) *CoreGatewayRateLimitRule {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *CoreGatewayRateLimitRuleCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *CoreGatewayRateLimitRuleCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *CoreGatewayRateLimitRuleCreate) defaults() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		if coregatewayratelimitrule.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized coregatewayratelimitrule.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := coregatewayratelimitrule.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		if coregatewayratelimitrule.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized coregatewayratelimitrule.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := coregatewayratelimitrule.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.Unit(); !ok {
+		v := coregatewayratelimitrule.DefaultUnit
+		_c.mutation.SetUnit(v)
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		v := coregatewayratelimitrule.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		if coregatewayratelimitrule.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized coregatewayratelimitrule.DefaultID (forgotten import ent/runtime?)")
+		}
+		v := coregatewayratelimitrule.DefaultID()
+		_c.mutation.SetID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *CoreGatewayRateLimitRuleCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "CoreGatewayRateLimitRule.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "CoreGatewayRateLimitRule.updated_at"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := coregatewayratelimitrule.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "CoreGatewayRateLimitRule.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *CoreGatewayRateLimitRuleCreate) sqlSave(ctx context.Context) (*CoreGatewayRateLimitRule, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected CoreGatewayRateLimitRule.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *CoreGatewayRateLimitRuleCreate) createSpec() (*CoreGatewayRateLimitRule, *sqlgraph.CreateSpec) {
+	var (
+		_node = &CoreGatewayRateLimitRule{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(coregatewayratelimitrule.Table, sqlgraph.NewFieldSpec(coregatewayratelimitrule.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(coregatewayratelimitrule.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(coregatewayratelimitrule.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.DeletedAt(); ok {
+		_spec.SetField(coregatewayratelimitrule.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(coregatewayratelimitrule.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.Description(); ok {
+		_spec.SetField(coregatewayratelimitrule.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if value, ok := _c.mutation.Descriptors(); ok {
+		_spec.SetField(coregatewayratelimitrule.FieldDescriptors, field.TypeJSON, value)
+		_node.Descriptors = value
+	}
+	if value, ok := _c.mutation.Unit(); ok {
+		_spec.SetField(coregatewayratelimitrule.FieldUnit, field.TypeInt8, value)
+		_node.Unit = value
+	}
+	if value, ok := _c.mutation.RequestsPerUnit(); ok {
+		_spec.SetField(coregatewayratelimitrule.FieldRequestsPerUnit, field.TypeUint32, value)
+		_node.RequestsPerUnit = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(coregatewayratelimitrule.FieldStatus, field.TypeInt8, value)
+		_node.Status = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.CoreGatewayRateLimitRule.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.CoreGatewayRateLimitRuleUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *CoreGatewayRateLimitRuleCreate) OnConflict(opts ...sql.ConflictOption) *CoreGatewayRateLimitRuleUpsertOne {
+	_c.conflict = opts
+	return &CoreGatewayRateLimitRuleUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.CoreGatewayRateLimitRule.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *CoreGatewayRateLimitRuleCreate) OnConflictColumns(columns ...string) *CoreGatewayRateLimitRuleUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &CoreGatewayRateLimitRuleUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// CoreGatewayRateLimitRuleUpsertOne is the builder for "upsert"-ing
+	//  one CoreGatewayRateLimitRule node.
+	CoreGatewayRateLimitRuleUpsertOne struct {
+		create *CoreGatewayRateLimitRuleCreate
+	}
+
+	// CoreGatewayRateLimitRuleUpsert is the "OnConflict" setter.
+	CoreGatewayRateLimitRuleUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *CoreGatewayRateLimitRuleUpsert) SetUpdatedAt(v time.Time) *CoreGatewayRateLimitRuleUpsert {
+	u.Set(coregatewayratelimitrule.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *CoreGatewayRateLimitRuleUpsert) UpdateUpdatedAt() *CoreGatewayRateLimitRuleUpsert {
+	u.SetExcluded(coregatewayratelimitrule.FieldUpdatedAt)
+	return u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *CoreGatewayRateLimitRuleUpsert) SetDeletedAt(v time.Time) *CoreGatewayRateLimitRuleUpsert {
+	u.Set(coregatewayratelimitrule.FieldDeletedAt, v)
+	return u
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *CoreGatewayRateLimitRuleUpsert) UpdateDeletedAt() *CoreGatewayRateLimitRuleUpsert {
+	u.SetExcluded(coregatewayratelimitrule.FieldDeletedAt)
+	return u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *CoreGatewayRateLimitRuleUpsert) ClearDeletedAt() *CoreGatewayRateLimitRuleUpsert {
+	u.SetNull(coregatewayratelimitrule.FieldDeletedAt)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *CoreGatewayRateLimitRuleUpsert) SetName(v string) *CoreGatewayRateLimitRuleUpsert {
+	u.Set(coregatewayratelimitrule.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *CoreGatewayRateLimitRuleUpsert) UpdateName() *CoreGatewayRateLimitRuleUpsert {
+	u.SetExcluded(coregatewayratelimitrule.FieldName)
+	return u
+}
+
+// ClearName clears the value of the "name" field.
+func (u *CoreGatewayRateLimitRuleUpsert) ClearName() *CoreGatewayRateLimitRuleUpsert {
+	u.SetNull(coregatewayratelimitrule.FieldName)
+	return u
+}
+
+// SetDescription sets the "description" field.
+func (u *CoreGatewayRateLimitRuleUpsert) SetDescription(v string) *CoreGatewayRateLimitRuleUpsert {
+	u.Set(coregatewayratelimitrule.FieldDescription, v)
+	return u
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *CoreGatewayRateLimitRuleUpsert) UpdateDescription() *CoreGatewayRateLimitRuleUpsert {
+	u.SetExcluded(coregatewayratelimitrule.FieldDescription)
+	return u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *CoreGatewayRateLimitRuleUpsert) ClearDescription() *CoreGatewayRateLimitRuleUpsert {
+	u.SetNull(coregatewayratelimitrule.FieldDescription)
+	return u
+}
+
+// SetDescriptors sets the "descriptors" field.
+func (u *CoreGatewayRateLimitRuleUpsert) SetDescriptors(v []common.RateLimitDescriptor) *CoreGatewayRateLimitRuleUpsert {
+	u.Set(coregatewayratelimitrule.FieldDescriptors, v)
+	return u
+}
+
+// UpdateDescriptors sets the "descriptors" field to the value that was provided on create.
+func (u *CoreGatewayRateLimitRuleUpsert) UpdateDescriptors() *CoreGatewayRateLimitRuleUpsert {
+	u.SetExcluded(coregatewayratelimitrule.FieldDescriptors)
+	return u
+}
+
+// ClearDescriptors clears the value of the "descriptors" field.
+func (u *CoreGatewayRateLimitRuleUpsert) ClearDescriptors() *CoreGatewayRateLimitRuleUpsert {
+	u.SetNull(coregatewayratelimitrule.FieldDescriptors)
+	return u
+}
+
+// SetUnit sets the "unit" field.
+func (u *CoreGatewayRateLimitRuleUpsert) SetUnit(v constant.ProxyRateLimitUnit) *CoreGatewayRateLimitRuleUpsert {
+	u.Set(coregatewayratelimitrule.FieldUnit, v)
+	return u
+}
+
+// UpdateUnit sets the "unit" field to the value that was provided on create.
+func (u *CoreGatewayRateLimitRuleUpsert) UpdateUnit() *CoreGatewayRateLimitRuleUpsert {
+	u.SetExcluded(coregatewayratelimitrule.FieldUnit)
+	return u
+}
+
+// AddUnit adds v to the "unit" field.
+func (u *CoreGatewayRateLimitRuleUpsert) AddUnit(v constant.ProxyRateLimitUnit) *CoreGatewayRateLimitRuleUpsert {
+	u.Add(coregatewayratelimitrule.FieldUnit, v)
+	return u
+}
+
+// ClearUnit clears the value of the "unit" field.
+func (u *CoreGatewayRateLimitRuleUpsert) ClearUnit() *CoreGatewayRateLimitRuleUpsert {
+	u.SetNull(coregatewayratelimitrule.FieldUnit)
+	return u
+}
+
+// SetRequestsPerUnit sets the "requests_per_unit" field.
+func (u *CoreGatewayRateLimitRuleUpsert) SetRequestsPerUnit(v uint32) *CoreGatewayRateLimitRuleUpsert {
+	u.Set(coregatewayratelimitrule.FieldRequestsPerUnit, v)
+	return u
+}
+
+// UpdateRequestsPerUnit sets the "requests_per_unit" field to the value that was provided on create.
+func (u *CoreGatewayRateLimitRuleUpsert) UpdateRequestsPerUnit() *CoreGatewayRateLimitRuleUpsert {
+	u.SetExcluded(coregatewayratelimitrule.FieldRequestsPerUnit)
+	return u
+}
+
+// AddRequestsPerUnit adds v to the "requests_per_unit" field.
+func (u *CoreGatewayRateLimitRuleUpsert) AddRequestsPerUnit(v uint32) *CoreGatewayRateLimitRuleUpsert {
+	u.Add(coregatewayratelimitrule.FieldRequestsPerUnit, v)
+	return u
+}
+
+// ClearRequestsPerUnit clears the value of the "requests_per_unit" field.
+func (u *CoreGatewayRateLimitRuleUpsert) ClearRequestsPerUnit() *CoreGatewayRateLimitRuleUpsert {
+	u.SetNull(coregatewayratelimitrule.FieldRequestsPerUnit)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *CoreGatewayRateLimitRuleUpsert) SetStatus(v constant.YesOrNo) *CoreGatewayRateLimitRuleUpsert {
+	u.Set(coregatewayratelimitrule.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *CoreGatewayRateLimitRuleUpsert) UpdateStatus() *CoreGatewayRateLimitRuleUpsert {
+	u.SetExcluded(coregatewayratelimitrule.FieldStatus)
+	return u
+}
+
+// AddStatus adds v to the "status" field.
+func (u *CoreGatewayRateLimitRuleUpsert) AddStatus(v constant.YesOrNo) *CoreGatewayRateLimitRuleUpsert {
+	u.Add(coregatewayratelimitrule.FieldStatus, v)
+	return u
+}
+
+// ClearStatus clears the value of the "status" field.
+func (u *CoreGatewayRateLimitRuleUpsert) ClearStatus() *CoreGatewayRateLimitRuleUpsert {
+	u.SetNull(coregatewayratelimitrule.FieldStatus)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.CoreGatewayRateLimitRule.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(coregatewayratelimitrule.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *CoreGatewayRateLimitRuleUpsertOne) UpdateNewValues() *CoreGatewayRateLimitRuleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(coregatewayratelimitrule.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(coregatewayratelimitrule.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.CoreGatewayRateLimitRule.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *CoreGatewayRateLimitRuleUpsertOne) Ignore() *CoreGatewayRateLimitRuleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *CoreGatewayRateLimitRuleUpsertOne) DoNothing() *CoreGatewayRateLimitRuleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the CoreGatewayRateLimitRuleCreate.OnConflict
+// documentation for more info.
+func (u *CoreGatewayRateLimitRuleUpsertOne) Update(set func(*CoreGatewayRateLimitRuleUpsert)) *CoreGatewayRateLimitRuleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&CoreGatewayRateLimitRuleUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *CoreGatewayRateLimitRuleUpsertOne) SetUpdatedAt(v time.Time) *CoreGatewayRateLimitRuleUpsertOne {
+	return u.Update(func(s *CoreGatewayRateLimitRuleUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *CoreGatewayRateLimitRuleUpsertOne) UpdateUpdatedAt() *CoreGatewayRateLimitRuleUpsertOne {
+	return u.Update(func(s *CoreGatewayRateLimitRuleUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *CoreGatewayRateLimitRuleUpsertOne) SetDeletedAt(v time.Time) *CoreGatewayRateLimitRuleUpsertOne {
+	return u.Update(func(s *CoreGatewayRateLimitRuleUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *CoreGatewayRateLimitRuleUpsertOne) UpdateDeletedAt() *CoreGatewayRateLimitRuleUpsertOne {
+	return u.Update(func(s *CoreGatewayRateLimitRuleUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *CoreGatewayRateLimitRuleUpsertOne) ClearDeletedAt() *CoreGatewayRateLimitRuleUpsertOne {
+	return u.Update(func(s *CoreGatewayRateLimitRuleUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *CoreGatewayRateLimitRuleUpsertOne) SetName(v string) *CoreGatewayRateLimitRuleUpsertOne {
+	return u.Update(func(s *CoreGatewayRateLimitRuleUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *CoreGatewayRateLimitRuleUpsertOne) UpdateName() *CoreGatewayRateLimitRuleUpsertOne {
+	return u.Update(func(s *CoreGatewayRateLimitRuleUpsert) {
+		s.UpdateName()
+	})
+}
+
+// ClearName clears the value of the "name" field.
+func (u *CoreGatewayRateLimitRuleUpsertOne) ClearName() *CoreGatewayRateLimitRuleUpsertOne {
+	return u.Update(func(s *CoreGatewayRateLimitRuleUpsert) {
+		s.ClearName()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *CoreGatewayRateLimitRuleUpsertOne) SetDescription(v string) *CoreGatewayRateLimitRuleUpsertOne {
+	return u.Update(func(s *CoreGatewayRateLimitRuleUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *CoreGatewayRateLimitRuleUpsertOne) UpdateDescription() *CoreGatewayRateLimitRuleUpsertOne {
+	return u.Update(func(s *CoreGatewayRateLimitRuleUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *CoreGatewayRateLimitRuleUpsertOne) ClearDescription() *CoreGatewayRateLimitRuleUpsertOne {
+	return u.Update(func(s *CoreGatewayRateLimitRuleUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetDescriptors sets the "descriptors" field.
+func (u *CoreGatewayRateLimitRuleUpsertOne) SetDescriptors(v []common.RateLimitDescriptor) *CoreGatewayRateLimitRuleUpsertOne {
+	return u.Update(func(s *CoreGatewayRateLimitRuleUpsert) {
+		s.SetDescriptors(v)
+	})
+}
+
+// UpdateDescriptors sets the "descriptors" field to the value that was provided on create.
+func (u *CoreGatewayRateLimitRuleUpsertOne) UpdateDescriptors() *CoreGatewayRateLimitRuleUpsertOne {
+	return u.Update(func(s *CoreGatewayRateLimitRuleUpsert) {
+		s.UpdateDescriptors()
+	})
+}
+
+// ClearDescriptors clears the value of the "descriptors" field.
+func (u *CoreGatewayRateLimitRuleUpsertOne) ClearDescriptors() *CoreGatewayRateLimitRuleUpsertOne {
+	return u.Update(func(s *CoreGatewayRateLimitRuleUpsert) {
+		s.ClearDescriptors()
+	})
+}
+
+// SetUnit sets the "unit" field.
+func (u *CoreGatewayRateLimitRuleUpsertOne) SetUnit(v constant.ProxyRateLimitUnit) *CoreGatewayRateLimitRuleUpsertOne {
+	return u.Update(func(s *CoreGatewayRateLimitRuleUpsert) {
+		s.SetUnit(v)
+	})
+}
+
+// AddUnit adds v to the "unit" field.
+func (u *CoreGatewayRateLimitRuleUpsertOne) AddUnit(v constant.ProxyRateLimitUnit) *CoreGatewayRateLimitRuleUpsertOne {
+	return u.Update(func(s *CoreGatewayRateLimitRuleUpsert) {
+		s.AddUnit(v)
+	})
+}
+
+// UpdateUnit sets the "unit" field to the value that was provided on create.
+func (u *CoreGatewayRateLimitRuleUpsertOne) UpdateUnit() *CoreGatewayRateLimitRuleUpsertOne {
+	return u.Update(func(s *CoreGatewayRateLimitRuleUpsert) {
+		s.UpdateUnit()
+	})
+}
+
+// ClearUnit clears the value of the "unit" field.
+func (u *CoreGatewayRateLimitRuleUpsertOne) ClearUnit() *CoreGatewayRateLimitRuleUpsertOne {
+	return u.Update(func(s *CoreGatewayRateLimitRuleUpsert) {
+		s.ClearUnit()
+	})
+}
+
+// SetRequestsPerUnit sets the "requests_per_unit" field.
+func (u *CoreGatewayRateLimitRuleUpsertOne) SetRequestsPerUnit(v uint32) *CoreGatewayRateLimitRuleUpsertOne {
+	return u.Update(func(s *CoreGatewayRateLimitRuleUpsert) {
+		s.SetRequestsPerUnit(v)
+	})
+}
+
+// AddRequestsPerUnit adds v to the "requests_per_unit" field.
+func (u *CoreGatewayRateLimitRuleUpsertOne) AddRequestsPerUnit(v uint32) *CoreGatewayRateLimitRuleUpsertOne {
+	return u.Update(func(s *CoreGatewayRateLimitRuleUpsert) {
+		s.AddRequestsPerUnit(v)
+	})
+}
+
+// UpdateRequestsPerUnit sets the "requests_per_unit" field to the value that was provided on create.
+func (u *CoreGatewayRateLimitRuleUpsertOne) UpdateRequestsPerUnit() *CoreGatewayRateLimitRuleUpsertOne {
+	return u.Update(func(s *CoreGatewayRateLimitRuleUpsert) {
+		s.UpdateRequestsPerUnit()
+	})
+}
+
+// ClearRequestsPerUnit clears the value of the "requests_per_unit" field.
+func (u *CoreGatewayRateLimitRuleUpsertOne) ClearRequestsPerUnit() *CoreGatewayRateLimitRuleUpsertOne {
+	return u.Update(func(s *CoreGatewayRateLimitRuleUpsert) {
+		s.ClearRequestsPerUnit()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *CoreGatewayRateLimitRuleUpsertOne) SetStatus(v constant.YesOrNo) *CoreGatewayRateLimitRuleUpsertOne {
+	return u.Update(func(s *CoreGatewayRateLimitRuleUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// AddStatus adds v to the "status" field.
+func (u *CoreGatewayRateLimitRuleUpsertOne) AddStatus(v constant.YesOrNo) *CoreGatewayRateLimitRuleUpsertOne {
+	return u.Update(func(s *CoreGatewayRateLimitRuleUpsert) {
+		s.AddStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *CoreGatewayRateLimitRuleUpsertOne) UpdateStatus() *CoreGatewayRateLimitRuleUpsertOne {
+	return u.Update(func(s *CoreGatewayRateLimitRuleUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// ClearStatus clears the value of the "status" field.
+func (u *CoreGatewayRateLimitRuleUpsertOne) ClearStatus() *CoreGatewayRateLimitRuleUpsertOne {
+	return u.Update(func(s *CoreGatewayRateLimitRuleUpsert) {
+		s.ClearStatus()
+	})
+}
+
+// Exec executes the query.
+func (u *CoreGatewayRateLimitRuleUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for CoreGatewayRateLimitRuleCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *CoreGatewayRateLimitRuleUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *CoreGatewayRateLimitRuleUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: CoreGatewayRateLimitRuleUpsertOne.ID is not supported by MySQL driver. Use CoreGatewayRateLimitRuleUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *CoreGatewayRateLimitRuleUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// CoreGatewayRateLimitRuleCreateBulk is the builder for creating many CoreGatewayRateLimitRule entities in bulk.
+type CoreGatewayRateLimitRuleCreateBulk struct {
+	config
+	err      error
+	builders []*CoreGatewayRateLimitRuleCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the CoreGatewayRateLimitRule entities in the database.
+func (_c *CoreGatewayRateLimitRuleCreateBulk) Save(ctx context.Context) ([]*CoreGatewayRateLimitRule, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*CoreGatewayRateLimitRule, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*CoreGatewayRateLimitRuleMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *CoreGatewayRateLimitRuleCreateBulk) SaveX(ctx context.Context) []*CoreGatewayRateLimitRule {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *CoreGatewayRateLimitRuleCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *CoreGatewayRateLimitRuleCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.CoreGatewayRateLimitRule.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.CoreGatewayRateLimitRuleUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *CoreGatewayRateLimitRuleCreateBulk) OnConflict(opts ...sql.ConflictOption) *CoreGatewayRateLimitRuleUpsertBulk {
+	_c.conflict = opts
+	return &CoreGatewayRateLimitRuleUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.CoreGatewayRateLimitRule.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *CoreGatewayRateLimitRuleCreateBulk) OnConflictColumns(columns ...string) *CoreGatewayRateLimitRuleUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &CoreGatewayRateLimitRuleUpsertBulk{
+		create: _c,
+	}
+}
+
+// CoreGatewayRateLimitRuleUpsertBulk is the builder for "upsert"-ing
+// a bulk of CoreGatewayRateLimitRule nodes.
+type CoreGatewayRateLimitRuleUpsertBulk struct {
+	create *CoreGatewayRateLimitRuleCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.CoreGatewayRateLimitRule.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(coregatewayratelimitrule.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *CoreGatewayRateLimitRuleUpsertBulk) UpdateNewValues() *CoreGatewayRateLimitRuleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(coregatewayratelimitrule.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(coregatewayratelimitrule.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.CoreGatewayRateLimitRule.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *CoreGatewayRateLimitRuleUpsertBulk) Ignore() *CoreGatewayRateLimitRuleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *CoreGatewayRateLimitRuleUpsertBulk) DoNothing() *CoreGatewayRateLimitRuleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the CoreGatewayRateLimitRuleCreateBulk.OnConflict
+// documentation for more info.
+func (u *CoreGatewayRateLimitRuleUpsertBulk) Update(set func(*CoreGatewayRateLimitRuleUpsert)) *CoreGatewayRateLimitRuleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&CoreGatewayRateLimitRuleUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *CoreGatewayRateLimitRuleUpsertBulk) SetUpdatedAt(v time.Time) *CoreGatewayRateLimitRuleUpsertBulk {
+	return u.Update(func(s *CoreGatewayRateLimitRuleUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *CoreGatewayRateLimitRuleUpsertBulk) UpdateUpdatedAt() *CoreGatewayRateLimitRuleUpsertBulk {
+	return u.Update(func(s *CoreGatewayRateLimitRuleUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *CoreGatewayRateLimitRuleUpsertBulk) SetDeletedAt(v time.Time) *CoreGatewayRateLimitRuleUpsertBulk {
+	return u.Update(func(s *CoreGatewayRateLimitRuleUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *CoreGatewayRateLimitRuleUpsertBulk) UpdateDeletedAt() *CoreGatewayRateLimitRuleUpsertBulk {
+	return u.Update(func(s *CoreGatewayRateLimitRuleUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *CoreGatewayRateLimitRuleUpsertBulk) ClearDeletedAt() *CoreGatewayRateLimitRuleUpsertBulk {
+	return u.Update(func(s *CoreGatewayRateLimitRuleUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *CoreGatewayRateLimitRuleUpsertBulk) SetName(v string) *CoreGatewayRateLimitRuleUpsertBulk {
+	return u.Update(func(s *CoreGatewayRateLimitRuleUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *CoreGatewayRateLimitRuleUpsertBulk) UpdateName() *CoreGatewayRateLimitRuleUpsertBulk {
+	return u.Update(func(s *CoreGatewayRateLimitRuleUpsert) {
+		s.UpdateName()
+	})
+}
+
+// ClearName clears the value of the "name" field.
+func (u *CoreGatewayRateLimitRuleUpsertBulk) ClearName() *CoreGatewayRateLimitRuleUpsertBulk {
+	return u.Update(func(s *CoreGatewayRateLimitRuleUpsert) {
+		s.ClearName()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *CoreGatewayRateLimitRuleUpsertBulk) SetDescription(v string) *CoreGatewayRateLimitRuleUpsertBulk {
+	return u.Update(func(s *CoreGatewayRateLimitRuleUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *CoreGatewayRateLimitRuleUpsertBulk) UpdateDescription() *CoreGatewayRateLimitRuleUpsertBulk {
+	return u.Update(func(s *CoreGatewayRateLimitRuleUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *CoreGatewayRateLimitRuleUpsertBulk) ClearDescription() *CoreGatewayRateLimitRuleUpsertBulk {
+	return u.Update(func(s *CoreGatewayRateLimitRuleUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetDescriptors sets the "descriptors" field.
+func (u *CoreGatewayRateLimitRuleUpsertBulk) SetDescriptors(v []common.RateLimitDescriptor) *CoreGatewayRateLimitRuleUpsertBulk {
+	return u.Update(func(s *CoreGatewayRateLimitRuleUpsert) {
+		s.SetDescriptors(v)
+	})
+}
+
+// UpdateDescriptors sets the "descriptors" field to the value that was provided on create.
+func (u *CoreGatewayRateLimitRuleUpsertBulk) UpdateDescriptors() *CoreGatewayRateLimitRuleUpsertBulk {
+	return u.Update(func(s *CoreGatewayRateLimitRuleUpsert) {
+		s.UpdateDescriptors()
+	})
+}
+
+// ClearDescriptors clears the value of the "descriptors" field.
+func (u *CoreGatewayRateLimitRuleUpsertBulk) ClearDescriptors() *CoreGatewayRateLimitRuleUpsertBulk {
+	return u.Update(func(s *CoreGatewayRateLimitRuleUpsert) {
+		s.ClearDescriptors()
+	})
+}
+
+// SetUnit sets the "unit" field.
+func (u *CoreGatewayRateLimitRuleUpsertBulk) SetUnit(v constant.ProxyRateLimitUnit) *CoreGatewayRateLimitRuleUpsertBulk {
+	return u.Update(func(s *CoreGatewayRateLimitRuleUpsert) {
+		s.SetUnit(v)
+	})
+}
+
+// AddUnit adds v to the "unit" field.
+func (u *CoreGatewayRateLimitRuleUpsertBulk) AddUnit(v constant.ProxyRateLimitUnit) *CoreGatewayRateLimitRuleUpsertBulk {
+	return u.Update(func(s *CoreGatewayRateLimitRuleUpsert) {
+		s.AddUnit(v)
+	})
+}
+
+// UpdateUnit sets the "unit" field to the value that was provided on create.
+func (u *CoreGatewayRateLimitRuleUpsertBulk) UpdateUnit() *CoreGatewayRateLimitRuleUpsertBulk {
+	return u.Update(func(s *CoreGatewayRateLimitRuleUpsert) {
+		s.UpdateUnit()
+	})
+}
+
+// ClearUnit clears the value of the "unit" field.
+func (u *CoreGatewayRateLimitRuleUpsertBulk) ClearUnit() *CoreGatewayRateLimitRuleUpsertBulk {
+	return u.Update(func(s *CoreGatewayRateLimitRuleUpsert) {
+		s.ClearUnit()
+	})
+}
+
+// SetRequestsPerUnit sets the "requests_per_unit" field.
+func (u *CoreGatewayRateLimitRuleUpsertBulk) SetRequestsPerUnit(v uint32) *CoreGatewayRateLimitRuleUpsertBulk {
+	return u.Update(func(s *CoreGatewayRateLimitRuleUpsert) {
+		s.SetRequestsPerUnit(v)
+	})
+}
+
+// AddRequestsPerUnit adds v to the "requests_per_unit" field.
+func (u *CoreGatewayRateLimitRuleUpsertBulk) AddRequestsPerUnit(v uint32) *CoreGatewayRateLimitRuleUpsertBulk {
+	return u.Update(func(s *CoreGatewayRateLimitRuleUpsert) {
+		s.AddRequestsPerUnit(v)
+	})
+}
+
+// UpdateRequestsPerUnit sets the "requests_per_unit" field to the value that was provided on create.
+func (u *CoreGatewayRateLimitRuleUpsertBulk) UpdateRequestsPerUnit() *CoreGatewayRateLimitRuleUpsertBulk {
+	return u.Update(func(s *CoreGatewayRateLimitRuleUpsert) {
+		s.UpdateRequestsPerUnit()
+	})
+}
+
+// ClearRequestsPerUnit clears the value of the "requests_per_unit" field.
+func (u *CoreGatewayRateLimitRuleUpsertBulk) ClearRequestsPerUnit() *CoreGatewayRateLimitRuleUpsertBulk {
+	return u.Update(func(s *CoreGatewayRateLimitRuleUpsert) {
+		s.ClearRequestsPerUnit()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *CoreGatewayRateLimitRuleUpsertBulk) SetStatus(v constant.YesOrNo) *CoreGatewayRateLimitRuleUpsertBulk {
+	return u.Update(func(s *CoreGatewayRateLimitRuleUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// AddStatus adds v to the "status" field.
+func (u *CoreGatewayRateLimitRuleUpsertBulk) AddStatus(v constant.YesOrNo) *CoreGatewayRateLimitRuleUpsertBulk {
+	return u.Update(func(s *CoreGatewayRateLimitRuleUpsert) {
+		s.AddStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *CoreGatewayRateLimitRuleUpsertBulk) UpdateStatus() *CoreGatewayRateLimitRuleUpsertBulk {
+	return u.Update(func(s *CoreGatewayRateLimitRuleUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// ClearStatus clears the value of the "status" field.
+func (u *CoreGatewayRateLimitRuleUpsertBulk) ClearStatus() *CoreGatewayRateLimitRuleUpsertBulk {
+	return u.Update(func(s *CoreGatewayRateLimitRuleUpsert) {
+		s.ClearStatus()
+	})
+}
+
+// Exec executes the query.
+func (u *CoreGatewayRateLimitRuleUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the CoreGatewayRateLimitRuleCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for CoreGatewayRateLimitRuleCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *CoreGatewayRateLimitRuleUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
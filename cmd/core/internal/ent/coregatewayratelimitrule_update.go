@@ -0,0 +1,673 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/lyonmu/quebec/cmd/core/internal/common"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/coregatewayratelimitrule"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/predicate"
+	"github.com/lyonmu/quebec/pkg/constant"
+)
+
+// CoreGatewayRateLimitRuleUpdate is the builder for updating CoreGatewayRateLimitRule entities.
+type CoreGatewayRateLimitRuleUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *CoreGatewayRateLimitRuleMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the CoreGatewayRateLimitRuleUpdate builder.
+func (_u *CoreGatewayRateLimitRuleUpdate) Where(ps ...predicate.CoreGatewayRateLimitRule) *CoreGatewayRateLimitRuleUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *CoreGatewayRateLimitRuleUpdate) SetUpdatedAt(v time.Time) *CoreGatewayRateLimitRuleUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *CoreGatewayRateLimitRuleUpdate) SetDeletedAt(v time.Time) *CoreGatewayRateLimitRuleUpdate {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *CoreGatewayRateLimitRuleUpdate) SetNillableDeletedAt(v *time.Time) *CoreGatewayRateLimitRuleUpdate {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *CoreGatewayRateLimitRuleUpdate) ClearDeletedAt() *CoreGatewayRateLimitRuleUpdate {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *CoreGatewayRateLimitRuleUpdate) SetName(v string) *CoreGatewayRateLimitRuleUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *CoreGatewayRateLimitRuleUpdate) SetNillableName(v *string) *CoreGatewayRateLimitRuleUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// ClearName clears the value of the "name" field.
+func (_u *CoreGatewayRateLimitRuleUpdate) ClearName() *CoreGatewayRateLimitRuleUpdate {
+	_u.mutation.ClearName()
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *CoreGatewayRateLimitRuleUpdate) SetDescription(v string) *CoreGatewayRateLimitRuleUpdate {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *CoreGatewayRateLimitRuleUpdate) SetNillableDescription(v *string) *CoreGatewayRateLimitRuleUpdate {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *CoreGatewayRateLimitRuleUpdate) ClearDescription() *CoreGatewayRateLimitRuleUpdate {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetDescriptors sets the "descriptors" field.
+func (_u *CoreGatewayRateLimitRuleUpdate) SetDescriptors(v []common.RateLimitDescriptor) *CoreGatewayRateLimitRuleUpdate {
+	_u.mutation.SetDescriptors(v)
+	return _u
+}
+
+// AppendDescriptors appends value to the "descriptors" field.
+func (_u *CoreGatewayRateLimitRuleUpdate) AppendDescriptors(v []common.RateLimitDescriptor) *CoreGatewayRateLimitRuleUpdate {
+	_u.mutation.AppendDescriptors(v)
+	return _u
+}
+
+// ClearDescriptors clears the value of the "descriptors" field.
+func (_u *CoreGatewayRateLimitRuleUpdate) ClearDescriptors() *CoreGatewayRateLimitRuleUpdate {
+	_u.mutation.ClearDescriptors()
+	return _u
+}
+
+// SetUnit sets the "unit" field.
+func (_u *CoreGatewayRateLimitRuleUpdate) SetUnit(v constant.ProxyRateLimitUnit) *CoreGatewayRateLimitRuleUpdate {
+	_u.mutation.ResetUnit()
+	_u.mutation.SetUnit(v)
+	return _u
+}
+
+// SetNillableUnit sets the "unit" field if the given value is not nil.
+func (_u *CoreGatewayRateLimitRuleUpdate) SetNillableUnit(v *constant.ProxyRateLimitUnit) *CoreGatewayRateLimitRuleUpdate {
+	if v != nil {
+		_u.SetUnit(*v)
+	}
+	return _u
+}
+
+// AddUnit adds value to the "unit" field.
+func (_u *CoreGatewayRateLimitRuleUpdate) AddUnit(v constant.ProxyRateLimitUnit) *CoreGatewayRateLimitRuleUpdate {
+	_u.mutation.AddUnit(v)
+	return _u
+}
+
+// ClearUnit clears the value of the "unit" field.
+func (_u *CoreGatewayRateLimitRuleUpdate) ClearUnit() *CoreGatewayRateLimitRuleUpdate {
+	_u.mutation.ClearUnit()
+	return _u
+}
+
+// SetRequestsPerUnit sets the "requests_per_unit" field.
+func (_u *CoreGatewayRateLimitRuleUpdate) SetRequestsPerUnit(v uint32) *CoreGatewayRateLimitRuleUpdate {
+	_u.mutation.ResetRequestsPerUnit()
+	_u.mutation.SetRequestsPerUnit(v)
+	return _u
+}
+
+// SetNillableRequestsPerUnit sets the "requests_per_unit" field if the given value is not nil.
+func (_u *CoreGatewayRateLimitRuleUpdate) SetNillableRequestsPerUnit(v *uint32) *CoreGatewayRateLimitRuleUpdate {
+	if v != nil {
+		_u.SetRequestsPerUnit(*v)
+	}
+	return _u
+}
+
+// AddRequestsPerUnit adds value to the "requests_per_unit" field.
+func (_u *CoreGatewayRateLimitRuleUpdate) AddRequestsPerUnit(v int32) *CoreGatewayRateLimitRuleUpdate {
+	_u.mutation.AddRequestsPerUnit(v)
+	return _u
+}
+
+// ClearRequestsPerUnit clears the value of the "requests_per_unit" field.
+func (_u *CoreGatewayRateLimitRuleUpdate) ClearRequestsPerUnit() *CoreGatewayRateLimitRuleUpdate {
+	_u.mutation.ClearRequestsPerUnit()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *CoreGatewayRateLimitRuleUpdate) SetStatus(v constant.YesOrNo) *CoreGatewayRateLimitRuleUpdate {
+	_u.mutation.ResetStatus()
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *CoreGatewayRateLimitRuleUpdate) SetNillableStatus(v *constant.YesOrNo) *CoreGatewayRateLimitRuleUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// AddStatus adds value to the "status" field.
+func (_u *CoreGatewayRateLimitRuleUpdate) AddStatus(v constant.YesOrNo) *CoreGatewayRateLimitRuleUpdate {
+	_u.mutation.AddStatus(v)
+	return _u
+}
+
+// ClearStatus clears the value of the "status" field.
+func (_u *CoreGatewayRateLimitRuleUpdate) ClearStatus() *CoreGatewayRateLimitRuleUpdate {
+	_u.mutation.ClearStatus()
+	return _u
+}
+
+// Mutation returns the CoreGatewayRateLimitRuleMutation object of the builder.
+func (_u *CoreGatewayRateLimitRuleUpdate) Mutation() *CoreGatewayRateLimitRuleMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *CoreGatewayRateLimitRuleUpdate) Save(ctx context.Context) (int, error) {
+	if err := _u.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *CoreGatewayRateLimitRuleUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *CoreGatewayRateLimitRuleUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *CoreGatewayRateLimitRuleUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *CoreGatewayRateLimitRuleUpdate) defaults() error {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		if coregatewayratelimitrule.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized coregatewayratelimitrule.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := coregatewayratelimitrule.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *CoreGatewayRateLimitRuleUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *CoreGatewayRateLimitRuleUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *CoreGatewayRateLimitRuleUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(coregatewayratelimitrule.Table, coregatewayratelimitrule.Columns, sqlgraph.NewFieldSpec(coregatewayratelimitrule.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(coregatewayratelimitrule.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(coregatewayratelimitrule.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(coregatewayratelimitrule.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(coregatewayratelimitrule.FieldName, field.TypeString, value)
+	}
+	if _u.mutation.NameCleared() {
+		_spec.ClearField(coregatewayratelimitrule.FieldName, field.TypeString)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(coregatewayratelimitrule.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(coregatewayratelimitrule.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.Descriptors(); ok {
+		_spec.SetField(coregatewayratelimitrule.FieldDescriptors, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedDescriptors(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, coregatewayratelimitrule.FieldDescriptors, value)
+		})
+	}
+	if _u.mutation.DescriptorsCleared() {
+		_spec.ClearField(coregatewayratelimitrule.FieldDescriptors, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Unit(); ok {
+		_spec.SetField(coregatewayratelimitrule.FieldUnit, field.TypeInt8, value)
+	}
+	if value, ok := _u.mutation.AddedUnit(); ok {
+		_spec.AddField(coregatewayratelimitrule.FieldUnit, field.TypeInt8, value)
+	}
+	if _u.mutation.UnitCleared() {
+		_spec.ClearField(coregatewayratelimitrule.FieldUnit, field.TypeInt8)
+	}
+	if value, ok := _u.mutation.RequestsPerUnit(); ok {
+		_spec.SetField(coregatewayratelimitrule.FieldRequestsPerUnit, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedRequestsPerUnit(); ok {
+		_spec.AddField(coregatewayratelimitrule.FieldRequestsPerUnit, field.TypeUint32, value)
+	}
+	if _u.mutation.RequestsPerUnitCleared() {
+		_spec.ClearField(coregatewayratelimitrule.FieldRequestsPerUnit, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(coregatewayratelimitrule.FieldStatus, field.TypeInt8, value)
+	}
+	if value, ok := _u.mutation.AddedStatus(); ok {
+		_spec.AddField(coregatewayratelimitrule.FieldStatus, field.TypeInt8, value)
+	}
+	if _u.mutation.StatusCleared() {
+		_spec.ClearField(coregatewayratelimitrule.FieldStatus, field.TypeInt8)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{coregatewayratelimitrule.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// CoreGatewayRateLimitRuleUpdateOne is the builder for updating a single CoreGatewayRateLimitRule entity.
+type CoreGatewayRateLimitRuleUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *CoreGatewayRateLimitRuleMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *CoreGatewayRateLimitRuleUpdateOne) SetUpdatedAt(v time.Time) *CoreGatewayRateLimitRuleUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *CoreGatewayRateLimitRuleUpdateOne) SetDeletedAt(v time.Time) *CoreGatewayRateLimitRuleUpdateOne {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *CoreGatewayRateLimitRuleUpdateOne) SetNillableDeletedAt(v *time.Time) *CoreGatewayRateLimitRuleUpdateOne {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *CoreGatewayRateLimitRuleUpdateOne) ClearDeletedAt() *CoreGatewayRateLimitRuleUpdateOne {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *CoreGatewayRateLimitRuleUpdateOne) SetName(v string) *CoreGatewayRateLimitRuleUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *CoreGatewayRateLimitRuleUpdateOne) SetNillableName(v *string) *CoreGatewayRateLimitRuleUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// ClearName clears the value of the "name" field.
+func (_u *CoreGatewayRateLimitRuleUpdateOne) ClearName() *CoreGatewayRateLimitRuleUpdateOne {
+	_u.mutation.ClearName()
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *CoreGatewayRateLimitRuleUpdateOne) SetDescription(v string) *CoreGatewayRateLimitRuleUpdateOne {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *CoreGatewayRateLimitRuleUpdateOne) SetNillableDescription(v *string) *CoreGatewayRateLimitRuleUpdateOne {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *CoreGatewayRateLimitRuleUpdateOne) ClearDescription() *CoreGatewayRateLimitRuleUpdateOne {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetDescriptors sets the "descriptors" field.
+func (_u *CoreGatewayRateLimitRuleUpdateOne) SetDescriptors(v []common.RateLimitDescriptor) *CoreGatewayRateLimitRuleUpdateOne {
+	_u.mutation.SetDescriptors(v)
+	return _u
+}
+
+// AppendDescriptors appends value to the "descriptors" field.
+func (_u *CoreGatewayRateLimitRuleUpdateOne) AppendDescriptors(v []common.RateLimitDescriptor) *CoreGatewayRateLimitRuleUpdateOne {
+	_u.mutation.AppendDescriptors(v)
+	return _u
+}
+
+// ClearDescriptors clears the value of the "descriptors" field.
+func (_u *CoreGatewayRateLimitRuleUpdateOne) ClearDescriptors() *CoreGatewayRateLimitRuleUpdateOne {
+	_u.mutation.ClearDescriptors()
+	return _u
+}
+
+// SetUnit sets the "unit" field.
+func (_u *CoreGatewayRateLimitRuleUpdateOne) SetUnit(v constant.ProxyRateLimitUnit) *CoreGatewayRateLimitRuleUpdateOne {
+	_u.mutation.ResetUnit()
+	_u.mutation.SetUnit(v)
+	return _u
+}
+
+// SetNillableUnit sets the "unit" field if the given value is not nil.
+func (_u *CoreGatewayRateLimitRuleUpdateOne) SetNillableUnit(v *constant.ProxyRateLimitUnit) *CoreGatewayRateLimitRuleUpdateOne {
+	if v != nil {
+		_u.SetUnit(*v)
+	}
+	return _u
+}
+
+// AddUnit adds value to the "unit" field.
+func (_u *CoreGatewayRateLimitRuleUpdateOne) AddUnit(v constant.ProxyRateLimitUnit) *CoreGatewayRateLimitRuleUpdateOne {
+	_u.mutation.AddUnit(v)
+	return _u
+}
+
+// ClearUnit clears the value of the "unit" field.
+func (_u *CoreGatewayRateLimitRuleUpdateOne) ClearUnit() *CoreGatewayRateLimitRuleUpdateOne {
+	_u.mutation.ClearUnit()
+	return _u
+}
+
+// SetRequestsPerUnit sets the "requests_per_unit" field.
+func (_u *CoreGatewayRateLimitRuleUpdateOne) SetRequestsPerUnit(v uint32) *CoreGatewayRateLimitRuleUpdateOne {
+	_u.mutation.ResetRequestsPerUnit()
+	_u.mutation.SetRequestsPerUnit(v)
+	return _u
+}
+
+// SetNillableRequestsPerUnit sets the "requests_per_unit" field if the given value is not nil.
+func (_u *CoreGatewayRateLimitRuleUpdateOne) SetNillableRequestsPerUnit(v *uint32) *CoreGatewayRateLimitRuleUpdateOne {
+	if v != nil {
+		_u.SetRequestsPerUnit(*v)
+	}
+	return _u
+}
+
+// AddRequestsPerUnit adds value to the "requests_per_unit" field.
+func (_u *CoreGatewayRateLimitRuleUpdateOne) AddRequestsPerUnit(v int32) *CoreGatewayRateLimitRuleUpdateOne {
+	_u.mutation.AddRequestsPerUnit(v)
+	return _u
+}
+
+// ClearRequestsPerUnit clears the value of the "requests_per_unit" field.
+func (_u *CoreGatewayRateLimitRuleUpdateOne) ClearRequestsPerUnit() *CoreGatewayRateLimitRuleUpdateOne {
+	_u.mutation.ClearRequestsPerUnit()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *CoreGatewayRateLimitRuleUpdateOne) SetStatus(v constant.YesOrNo) *CoreGatewayRateLimitRuleUpdateOne {
+	_u.mutation.ResetStatus()
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *CoreGatewayRateLimitRuleUpdateOne) SetNillableStatus(v *constant.YesOrNo) *CoreGatewayRateLimitRuleUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// AddStatus adds value to the "status" field.
+func (_u *CoreGatewayRateLimitRuleUpdateOne) AddStatus(v constant.YesOrNo) *CoreGatewayRateLimitRuleUpdateOne {
+	_u.mutation.AddStatus(v)
+	return _u
+}
+
+// ClearStatus clears the value of the "status" field.
+func (_u *CoreGatewayRateLimitRuleUpdateOne) ClearStatus() *CoreGatewayRateLimitRuleUpdateOne {
+	_u.mutation.ClearStatus()
+	return _u
+}
+
+// Mutation returns the CoreGatewayRateLimitRuleMutation object of the builder.
+func (_u *CoreGatewayRateLimitRuleUpdateOne) Mutation() *CoreGatewayRateLimitRuleMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the CoreGatewayRateLimitRuleUpdate builder.
+func (_u *CoreGatewayRateLimitRuleUpdateOne) Where(ps ...predicate.CoreGatewayRateLimitRule) *CoreGatewayRateLimitRuleUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *CoreGatewayRateLimitRuleUpdateOne) Select(field string, fields ...string) *CoreGatewayRateLimitRuleUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated CoreGatewayRateLimitRule entity.
+func (_u *CoreGatewayRateLimitRuleUpdateOne) Save(ctx context.Context) (*CoreGatewayRateLimitRule, error) {
+	if err := _u.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *CoreGatewayRateLimitRuleUpdateOne) SaveX(ctx context.Context) *CoreGatewayRateLimitRule {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *CoreGatewayRateLimitRuleUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *CoreGatewayRateLimitRuleUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *CoreGatewayRateLimitRuleUpdateOne) defaults() error {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		if coregatewayratelimitrule.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized coregatewayratelimitrule.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := coregatewayratelimitrule.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *CoreGatewayRateLimitRuleUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *CoreGatewayRateLimitRuleUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *CoreGatewayRateLimitRuleUpdateOne) sqlSave(ctx context.Context) (_node *CoreGatewayRateLimitRule, err error) {
+	_spec := sqlgraph.NewUpdateSpec(coregatewayratelimitrule.Table, coregatewayratelimitrule.Columns, sqlgraph.NewFieldSpec(coregatewayratelimitrule.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "CoreGatewayRateLimitRule.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, coregatewayratelimitrule.FieldID)
+		for _, f := range fields {
+			if !coregatewayratelimitrule.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != coregatewayratelimitrule.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(coregatewayratelimitrule.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(coregatewayratelimitrule.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(coregatewayratelimitrule.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(coregatewayratelimitrule.FieldName, field.TypeString, value)
+	}
+	if _u.mutation.NameCleared() {
+		_spec.ClearField(coregatewayratelimitrule.FieldName, field.TypeString)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(coregatewayratelimitrule.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(coregatewayratelimitrule.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.Descriptors(); ok {
+		_spec.SetField(coregatewayratelimitrule.FieldDescriptors, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedDescriptors(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, coregatewayratelimitrule.FieldDescriptors, value)
+		})
+	}
+	if _u.mutation.DescriptorsCleared() {
+		_spec.ClearField(coregatewayratelimitrule.FieldDescriptors, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Unit(); ok {
+		_spec.SetField(coregatewayratelimitrule.FieldUnit, field.TypeInt8, value)
+	}
+	if value, ok := _u.mutation.AddedUnit(); ok {
+		_spec.AddField(coregatewayratelimitrule.FieldUnit, field.TypeInt8, value)
+	}
+	if _u.mutation.UnitCleared() {
+		_spec.ClearField(coregatewayratelimitrule.FieldUnit, field.TypeInt8)
+	}
+	if value, ok := _u.mutation.RequestsPerUnit(); ok {
+		_spec.SetField(coregatewayratelimitrule.FieldRequestsPerUnit, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedRequestsPerUnit(); ok {
+		_spec.AddField(coregatewayratelimitrule.FieldRequestsPerUnit, field.TypeUint32, value)
+	}
+	if _u.mutation.RequestsPerUnitCleared() {
+		_spec.ClearField(coregatewayratelimitrule.FieldRequestsPerUnit, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(coregatewayratelimitrule.FieldStatus, field.TypeInt8, value)
+	}
+	if value, ok := _u.mutation.AddedStatus(); ok {
+		_spec.AddField(coregatewayratelimitrule.FieldStatus, field.TypeInt8, value)
+	}
+	if _u.mutation.StatusCleared() {
+		_spec.ClearField(coregatewayratelimitrule.FieldStatus, field.TypeInt8)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &CoreGatewayRateLimitRule{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{coregatewayratelimitrule.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
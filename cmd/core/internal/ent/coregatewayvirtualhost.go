@@ -0,0 +1,278 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/lyonmu/quebec/cmd/core/internal/common"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/coregatewayl7listener"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/coregatewayvirtualhost"
+	"github.com/lyonmu/quebec/pkg/constant"
+)
+
+// L7 虚拟主机表
+type CoreGatewayVirtualHost struct {
+	config `json:"-"`
+	// ID of the ent.
+	// 主键ID
+	ID string `json:"id,omitempty"`
+	// 创建时间
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// 更新时间
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// 删除时间
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// 虚拟主机名称
+	Name string `json:"name,omitempty"`
+	// 虚拟主机描述
+	Description string `json:"description,omitempty"`
+	// 所属L7监听器ID
+	ListenerID string `json:"listener_id,omitempty"`
+	// 匹配的域名，支持 *.example.com、example.* 与 *，同一监听器内不能重复
+	Domains []string `json:"domains,omitempty"`
+	// 请求头与响应头改写，作用于虚拟主机下所有路由
+	HeaderMutation *common.HeaderMutation `json:"header_mutation,omitempty"`
+	// 跨域策略，作用于虚拟主机下未单独配置的路由
+	CorsPolicy *common.CorsPolicy `json:"cors_policy,omitempty"`
+	// 维护模式，优先于网关集群的维护模式
+	Maintenance *common.Maintenance `json:"maintenance,omitempty"`
+	// 是否启用 [1: 启用, 2: 禁用]
+	Status constant.YesOrNo `json:"status,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the CoreGatewayVirtualHostQuery when eager-loading is set.
+	Edges        CoreGatewayVirtualHostEdges `json:"-" gorm:"-"`
+	selectValues sql.SelectValues
+}
+
+// CoreGatewayVirtualHostEdges holds the relations/edges for other nodes in the graph.
+type CoreGatewayVirtualHostEdges struct {
+	// VhostFromListener holds the value of the vhost_from_listener edge.
+	VhostFromListener *CoreGatewayL7Listener `json:"vhost_from_listener,omitempty"`
+	// VhostToRoute holds the value of the vhost_to_route edge.
+	VhostToRoute []*CoreGatewayHttpRoute `json:"vhost_to_route,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// VhostFromListenerOrErr returns the VhostFromListener value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e CoreGatewayVirtualHostEdges) VhostFromListenerOrErr() (*CoreGatewayL7Listener, error) {
+	if e.VhostFromListener != nil {
+		return e.VhostFromListener, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: coregatewayl7listener.Label}
+	}
+	return nil, &NotLoadedError{edge: "vhost_from_listener"}
+}
+
+// VhostToRouteOrErr returns the VhostToRoute value or an error if the edge
+// was not loaded in eager-loading.
+func (e CoreGatewayVirtualHostEdges) VhostToRouteOrErr() ([]*CoreGatewayHttpRoute, error) {
+	if e.loadedTypes[1] {
+		return e.VhostToRoute, nil
+	}
+	return nil, &NotLoadedError{edge: "vhost_to_route"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*CoreGatewayVirtualHost) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case coregatewayvirtualhost.FieldDomains, coregatewayvirtualhost.FieldHeaderMutation, coregatewayvirtualhost.FieldCorsPolicy, coregatewayvirtualhost.FieldMaintenance:
+			values[i] = new([]byte)
+		case coregatewayvirtualhost.FieldStatus:
+			values[i] = new(sql.NullInt64)
+		case coregatewayvirtualhost.FieldID, coregatewayvirtualhost.FieldName, coregatewayvirtualhost.FieldDescription, coregatewayvirtualhost.FieldListenerID:
+			values[i] = new(sql.NullString)
+		case coregatewayvirtualhost.FieldCreatedAt, coregatewayvirtualhost.FieldUpdatedAt, coregatewayvirtualhost.FieldDeletedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the CoreGatewayVirtualHost fields.
+func (_m *CoreGatewayVirtualHost) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case coregatewayvirtualhost.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case coregatewayvirtualhost.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case coregatewayvirtualhost.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case coregatewayvirtualhost.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				_m.DeletedAt = new(time.Time)
+				*_m.DeletedAt = value.Time
+			}
+		case coregatewayvirtualhost.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case coregatewayvirtualhost.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				_m.Description = value.String
+			}
+		case coregatewayvirtualhost.FieldListenerID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field listener_id", values[i])
+			} else if value.Valid {
+				_m.ListenerID = value.String
+			}
+		case coregatewayvirtualhost.FieldDomains:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field domains", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Domains); err != nil {
+					return fmt.Errorf("unmarshal field domains: %w", err)
+				}
+			}
+		case coregatewayvirtualhost.FieldHeaderMutation:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field header_mutation", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.HeaderMutation); err != nil {
+					return fmt.Errorf("unmarshal field header_mutation: %w", err)
+				}
+			}
+		case coregatewayvirtualhost.FieldCorsPolicy:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field cors_policy", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.CorsPolicy); err != nil {
+					return fmt.Errorf("unmarshal field cors_policy: %w", err)
+				}
+			}
+		case coregatewayvirtualhost.FieldMaintenance:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field maintenance", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Maintenance); err != nil {
+					return fmt.Errorf("unmarshal field maintenance: %w", err)
+				}
+			}
+		case coregatewayvirtualhost.FieldStatus:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = constant.YesOrNo(value.Int64)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the CoreGatewayVirtualHost.
+// This includes values selected through modifiers, order, etc.
+func (_m *CoreGatewayVirtualHost) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryVhostFromListener queries the "vhost_from_listener" edge of the CoreGatewayVirtualHost entity.
+func (_m *CoreGatewayVirtualHost) QueryVhostFromListener() *CoreGatewayL7ListenerQuery {
+	return NewCoreGatewayVirtualHostClient(_m.config).QueryVhostFromListener(_m)
+}
+
+// QueryVhostToRoute queries the "vhost_to_route" edge of the CoreGatewayVirtualHost entity.
+func (_m *CoreGatewayVirtualHost) QueryVhostToRoute() *CoreGatewayHttpRouteQuery {
+	return NewCoreGatewayVirtualHostClient(_m.config).QueryVhostToRoute(_m)
+}
+
+// Update returns a builder for updating this CoreGatewayVirtualHost.
+// Note that you need to call CoreGatewayVirtualHost.Unwrap() before calling this method if this CoreGatewayVirtualHost
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *CoreGatewayVirtualHost) Update() *CoreGatewayVirtualHostUpdateOne {
+	return NewCoreGatewayVirtualHostClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the CoreGatewayVirtualHost entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *CoreGatewayVirtualHost) Unwrap() *CoreGatewayVirtualHost {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: CoreGatewayVirtualHost is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *CoreGatewayVirtualHost) String() string {
+	var builder strings.Builder
+	builder.WriteString("CoreGatewayVirtualHost(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("description=")
+	builder.WriteString(_m.Description)
+	builder.WriteString(", ")
+	builder.WriteString("listener_id=")
+	builder.WriteString(_m.ListenerID)
+	builder.WriteString(", ")
+	builder.WriteString("domains=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Domains))
+	builder.WriteString(", ")
+	builder.WriteString("header_mutation=")
+	builder.WriteString(fmt.Sprintf("%v", _m.HeaderMutation))
+	builder.WriteString(", ")
+	builder.WriteString("cors_policy=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CorsPolicy))
+	builder.WriteString(", ")
+	builder.WriteString("maintenance=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Maintenance))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// CoreGatewayVirtualHosts is a parsable slice of CoreGatewayVirtualHost.
+type CoreGatewayVirtualHosts []*CoreGatewayVirtualHost
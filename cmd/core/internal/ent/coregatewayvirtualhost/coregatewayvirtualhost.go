@@ -0,0 +1,186 @@
+// Code generated by ent, DO NOT EDIT.
+
+package coregatewayvirtualhost
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/lyonmu/quebec/pkg/constant"
+)
+
+const (
+	// Label holds the string label denoting the coregatewayvirtualhost type in the database.
+	Label = "core_gateway_virtual_host"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
+	FieldDeletedAt = "deleted_at"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldDescription holds the string denoting the description field in the database.
+	FieldDescription = "description"
+	// FieldListenerID holds the string denoting the listener_id field in the database.
+	FieldListenerID = "listener_id"
+	// FieldDomains holds the string denoting the domains field in the database.
+	FieldDomains = "domains"
+	// FieldHeaderMutation holds the string denoting the header_mutation field in the database.
+	FieldHeaderMutation = "header_mutation"
+	// FieldCorsPolicy holds the string denoting the cors_policy field in the database.
+	FieldCorsPolicy = "cors_policy"
+	// FieldMaintenance holds the string denoting the maintenance field in the database.
+	FieldMaintenance = "maintenance"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// EdgeVhostFromListener holds the string denoting the vhost_from_listener edge name in mutations.
+	EdgeVhostFromListener = "vhost_from_listener"
+	// EdgeVhostToRoute holds the string denoting the vhost_to_route edge name in mutations.
+	EdgeVhostToRoute = "vhost_to_route"
+	// Table holds the table name of the coregatewayvirtualhost in the database.
+	Table = "quebec_core_gateway_virtual_host"
+	// VhostFromListenerTable is the table that holds the vhost_from_listener relation/edge.
+	VhostFromListenerTable = "quebec_core_gateway_virtual_host"
+	// VhostFromListenerInverseTable is the table name for the CoreGatewayL7Listener entity.
+	// It exists in this package in order to avoid circular dependency with the "coregatewayl7listener" package.
+	VhostFromListenerInverseTable = "quebec_core_gateway_l7_listener"
+	// VhostFromListenerColumn is the table column denoting the vhost_from_listener relation/edge.
+	VhostFromListenerColumn = "listener_id"
+	// VhostToRouteTable is the table that holds the vhost_to_route relation/edge.
+	VhostToRouteTable = "quebec_core_gateway_http_route"
+	// VhostToRouteInverseTable is the table name for the CoreGatewayHttpRoute entity.
+	// It exists in this package in order to avoid circular dependency with the "coregatewayhttproute" package.
+	VhostToRouteInverseTable = "quebec_core_gateway_http_route"
+	// VhostToRouteColumn is the table column denoting the vhost_to_route relation/edge.
+	VhostToRouteColumn = "virtual_host_id"
+)
+
+// Columns holds all SQL columns for coregatewayvirtualhost fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldDeletedAt,
+	FieldName,
+	FieldDescription,
+	FieldListenerID,
+	FieldDomains,
+	FieldHeaderMutation,
+	FieldCorsPolicy,
+	FieldMaintenance,
+	FieldStatus,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "github.com/lyonmu/quebec/cmd/core/internal/ent/runtime"
+var (
+	Hooks [2]ent.Hook
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultStatus holds the default value on creation for the "status" field.
+	DefaultStatus constant.YesOrNo
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() string
+	// IDValidator is a validator for the "id" field. It is called by the builders before save.
+	IDValidator func(string) error
+)
+
+// OrderOption defines the ordering options for the CoreGatewayVirtualHost queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByDeletedAt orders the results by the deleted_at field.
+func ByDeletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletedAt, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByDescription orders the results by the description field.
+func ByDescription(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDescription, opts...).ToFunc()
+}
+
+// ByListenerID orders the results by the listener_id field.
+func ByListenerID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldListenerID, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByVhostFromListenerField orders the results by vhost_from_listener field.
+func ByVhostFromListenerField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newVhostFromListenerStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByVhostToRouteCount orders the results by vhost_to_route count.
+func ByVhostToRouteCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newVhostToRouteStep(), opts...)
+	}
+}
+
+// ByVhostToRoute orders the results by vhost_to_route terms.
+func ByVhostToRoute(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newVhostToRouteStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newVhostFromListenerStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(VhostFromListenerInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, VhostFromListenerTable, VhostFromListenerColumn),
+	)
+}
+func newVhostToRouteStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(VhostToRouteInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, VhostToRouteTable, VhostToRouteColumn),
+	)
+}
@@ -0,0 +1,623 @@
+// Code generated by ent, DO NOT EDIT.
+
+package coregatewayvirtualhost
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/predicate"
+	"github.com/lyonmu/quebec/pkg/constant"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.CoreGatewayVirtualHost {
+	return predicate.CoreGatewayVirtualHost(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.CoreGatewayVirtualHost {
+	return predicate.CoreGatewayVirtualHost(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.CoreGatewayVirtualHost {
+	return predicate.CoreGatewayVirtualHost(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.CoreGatewayVirtualHost {
+	return predicate.CoreGatewayVirtualHost(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.CoreGatewayVirtualHost {
+	return predicate.CoreGatewayVirtualHost(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.CoreGatewayVirtualHost {
+	return predicate.CoreGatewayVirtualHost(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.CoreGatewayVirtualHost {
+	return predicate.CoreGatewayVirtualHost(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.CoreGatewayVirtualHost {
+	return predicate.CoreGatewayVirtualHost(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.CoreGatewayVirtualHost {
+	return predicate.CoreGatewayVirtualHost(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.CoreGatewayVirtualHost {
+	return predicate.CoreGatewayVirtualHost(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.CoreGatewayVirtualHost {
+	return predicate.CoreGatewayVirtualHost(sql.FieldContainsFold(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.CoreGatewayVirtualHost {
+	return predicate.CoreGatewayVirtualHost(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.CoreGatewayVirtualHost {
+	return predicate.CoreGatewayVirtualHost(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.CoreGatewayVirtualHost {
+	return predicate.CoreGatewayVirtualHost(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.CoreGatewayVirtualHost {
+	return predicate.CoreGatewayVirtualHost(sql.FieldEQ(FieldName, v))
+}
+
+// Description applies equality check predicate on the "description" field. It's identical to DescriptionEQ.
+func Description(v string) predicate.CoreGatewayVirtualHost {
+	return predicate.CoreGatewayVirtualHost(sql.FieldEQ(FieldDescription, v))
+}
+
+// ListenerID applies equality check predicate on the "listener_id" field. It's identical to ListenerIDEQ.
+func ListenerID(v string) predicate.CoreGatewayVirtualHost {
+	return predicate.CoreGatewayVirtualHost(sql.FieldEQ(FieldListenerID, v))
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v constant.YesOrNo) predicate.CoreGatewayVirtualHost {
+	vc := int8(v)
+	return predicate.CoreGatewayVirtualHost(sql.FieldEQ(FieldStatus, vc))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.CoreGatewayVirtualHost {
+	return predicate.CoreGatewayVirtualHost(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.CoreGatewayVirtualHost {
+	return predicate.CoreGatewayVirtualHost(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.CoreGatewayVirtualHost {
+	return predicate.CoreGatewayVirtualHost(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.CoreGatewayVirtualHost {
+	return predicate.CoreGatewayVirtualHost(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.CoreGatewayVirtualHost {
+	return predicate.CoreGatewayVirtualHost(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.CoreGatewayVirtualHost {
+	return predicate.CoreGatewayVirtualHost(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.CoreGatewayVirtualHost {
+	return predicate.CoreGatewayVirtualHost(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.CoreGatewayVirtualHost {
+	return predicate.CoreGatewayVirtualHost(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.CoreGatewayVirtualHost {
+	return predicate.CoreGatewayVirtualHost(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.CoreGatewayVirtualHost {
+	return predicate.CoreGatewayVirtualHost(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.CoreGatewayVirtualHost {
+	return predicate.CoreGatewayVirtualHost(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.CoreGatewayVirtualHost {
+	return predicate.CoreGatewayVirtualHost(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.CoreGatewayVirtualHost {
+	return predicate.CoreGatewayVirtualHost(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.CoreGatewayVirtualHost {
+	return predicate.CoreGatewayVirtualHost(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.CoreGatewayVirtualHost {
+	return predicate.CoreGatewayVirtualHost(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.CoreGatewayVirtualHost {
+	return predicate.CoreGatewayVirtualHost(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.CoreGatewayVirtualHost {
+	return predicate.CoreGatewayVirtualHost(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.CoreGatewayVirtualHost {
+	return predicate.CoreGatewayVirtualHost(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.CoreGatewayVirtualHost {
+	return predicate.CoreGatewayVirtualHost(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.CoreGatewayVirtualHost {
+	return predicate.CoreGatewayVirtualHost(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.CoreGatewayVirtualHost {
+	return predicate.CoreGatewayVirtualHost(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.CoreGatewayVirtualHost {
+	return predicate.CoreGatewayVirtualHost(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.CoreGatewayVirtualHost {
+	return predicate.CoreGatewayVirtualHost(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.CoreGatewayVirtualHost {
+	return predicate.CoreGatewayVirtualHost(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.CoreGatewayVirtualHost {
+	return predicate.CoreGatewayVirtualHost(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.CoreGatewayVirtualHost {
+	return predicate.CoreGatewayVirtualHost(sql.FieldNotNull(FieldDeletedAt))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.CoreGatewayVirtualHost {
+	return predicate.CoreGatewayVirtualHost(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.CoreGatewayVirtualHost {
+	return predicate.CoreGatewayVirtualHost(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.CoreGatewayVirtualHost {
+	return predicate.CoreGatewayVirtualHost(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.CoreGatewayVirtualHost {
+	return predicate.CoreGatewayVirtualHost(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.CoreGatewayVirtualHost {
+	return predicate.CoreGatewayVirtualHost(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.CoreGatewayVirtualHost {
+	return predicate.CoreGatewayVirtualHost(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.CoreGatewayVirtualHost {
+	return predicate.CoreGatewayVirtualHost(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.CoreGatewayVirtualHost {
+	return predicate.CoreGatewayVirtualHost(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.CoreGatewayVirtualHost {
+	return predicate.CoreGatewayVirtualHost(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.CoreGatewayVirtualHost {
+	return predicate.CoreGatewayVirtualHost(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.CoreGatewayVirtualHost {
+	return predicate.CoreGatewayVirtualHost(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameIsNil applies the IsNil predicate on the "name" field.
+func NameIsNil() predicate.CoreGatewayVirtualHost {
+	return predicate.CoreGatewayVirtualHost(sql.FieldIsNull(FieldName))
+}
+
+// NameNotNil applies the NotNil predicate on the "name" field.
+func NameNotNil() predicate.CoreGatewayVirtualHost {
+	return predicate.CoreGatewayVirtualHost(sql.FieldNotNull(FieldName))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.CoreGatewayVirtualHost {
+	return predicate.CoreGatewayVirtualHost(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.CoreGatewayVirtualHost {
+	return predicate.CoreGatewayVirtualHost(sql.FieldContainsFold(FieldName, v))
+}
+
+// DescriptionEQ applies the EQ predicate on the "description" field.
+func DescriptionEQ(v string) predicate.CoreGatewayVirtualHost {
+	return predicate.CoreGatewayVirtualHost(sql.FieldEQ(FieldDescription, v))
+}
+
+// DescriptionNEQ applies the NEQ predicate on the "description" field.
+func DescriptionNEQ(v string) predicate.CoreGatewayVirtualHost {
+	return predicate.CoreGatewayVirtualHost(sql.FieldNEQ(FieldDescription, v))
+}
+
+// DescriptionIn applies the In predicate on the "description" field.
+func DescriptionIn(vs ...string) predicate.CoreGatewayVirtualHost {
+	return predicate.CoreGatewayVirtualHost(sql.FieldIn(FieldDescription, vs...))
+}
+
+// DescriptionNotIn applies the NotIn predicate on the "description" field.
+func DescriptionNotIn(vs ...string) predicate.CoreGatewayVirtualHost {
+	return predicate.CoreGatewayVirtualHost(sql.FieldNotIn(FieldDescription, vs...))
+}
+
+// DescriptionGT applies the GT predicate on the "description" field.
+func DescriptionGT(v string) predicate.CoreGatewayVirtualHost {
+	return predicate.CoreGatewayVirtualHost(sql.FieldGT(FieldDescription, v))
+}
+
+// DescriptionGTE applies the GTE predicate on the "description" field.
+func DescriptionGTE(v string) predicate.CoreGatewayVirtualHost {
+	return predicate.CoreGatewayVirtualHost(sql.FieldGTE(FieldDescription, v))
+}
+
+// DescriptionLT applies the LT predicate on the "description" field.
+func DescriptionLT(v string) predicate.CoreGatewayVirtualHost {
+	return predicate.CoreGatewayVirtualHost(sql.FieldLT(FieldDescription, v))
+}
+
+// DescriptionLTE applies the LTE predicate on the "description" field.
+func DescriptionLTE(v string) predicate.CoreGatewayVirtualHost {
+	return predicate.CoreGatewayVirtualHost(sql.FieldLTE(FieldDescription, v))
+}
+
+// DescriptionContains applies the Contains predicate on the "description" field.
+func DescriptionContains(v string) predicate.CoreGatewayVirtualHost {
+	return predicate.CoreGatewayVirtualHost(sql.FieldContains(FieldDescription, v))
+}
+
+// DescriptionHasPrefix applies the HasPrefix predicate on the "description" field.
+func DescriptionHasPrefix(v string) predicate.CoreGatewayVirtualHost {
+	return predicate.CoreGatewayVirtualHost(sql.FieldHasPrefix(FieldDescription, v))
+}
+
+// DescriptionHasSuffix applies the HasSuffix predicate on the "description" field.
+func DescriptionHasSuffix(v string) predicate.CoreGatewayVirtualHost {
+	return predicate.CoreGatewayVirtualHost(sql.FieldHasSuffix(FieldDescription, v))
+}
+
+// DescriptionIsNil applies the IsNil predicate on the "description" field.
+func DescriptionIsNil() predicate.CoreGatewayVirtualHost {
+	return predicate.CoreGatewayVirtualHost(sql.FieldIsNull(FieldDescription))
+}
+
+// DescriptionNotNil applies the NotNil predicate on the "description" field.
+func DescriptionNotNil() predicate.CoreGatewayVirtualHost {
+	return predicate.CoreGatewayVirtualHost(sql.FieldNotNull(FieldDescription))
+}
+
+// DescriptionEqualFold applies the EqualFold predicate on the "description" field.
+func DescriptionEqualFold(v string) predicate.CoreGatewayVirtualHost {
+	return predicate.CoreGatewayVirtualHost(sql.FieldEqualFold(FieldDescription, v))
+}
+
+// DescriptionContainsFold applies the ContainsFold predicate on the "description" field.
+func DescriptionContainsFold(v string) predicate.CoreGatewayVirtualHost {
+	return predicate.CoreGatewayVirtualHost(sql.FieldContainsFold(FieldDescription, v))
+}
+
+// ListenerIDEQ applies the EQ predicate on the "listener_id" field.
+func ListenerIDEQ(v string) predicate.CoreGatewayVirtualHost {
+	return predicate.CoreGatewayVirtualHost(sql.FieldEQ(FieldListenerID, v))
+}
+
+// ListenerIDNEQ applies the NEQ predicate on the "listener_id" field.
+func ListenerIDNEQ(v string) predicate.CoreGatewayVirtualHost {
+	return predicate.CoreGatewayVirtualHost(sql.FieldNEQ(FieldListenerID, v))
+}
+
+// ListenerIDIn applies the In predicate on the "listener_id" field.
+func ListenerIDIn(vs ...string) predicate.CoreGatewayVirtualHost {
+	return predicate.CoreGatewayVirtualHost(sql.FieldIn(FieldListenerID, vs...))
+}
+
+// ListenerIDNotIn applies the NotIn predicate on the "listener_id" field.
+func ListenerIDNotIn(vs ...string) predicate.CoreGatewayVirtualHost {
+	return predicate.CoreGatewayVirtualHost(sql.FieldNotIn(FieldListenerID, vs...))
+}
+
+// ListenerIDGT applies the GT predicate on the "listener_id" field.
+func ListenerIDGT(v string) predicate.CoreGatewayVirtualHost {
+	return predicate.CoreGatewayVirtualHost(sql.FieldGT(FieldListenerID, v))
+}
+
+// ListenerIDGTE applies the GTE predicate on the "listener_id" field.
+func ListenerIDGTE(v string) predicate.CoreGatewayVirtualHost {
+	return predicate.CoreGatewayVirtualHost(sql.FieldGTE(FieldListenerID, v))
+}
+
+// ListenerIDLT applies the LT predicate on the "listener_id" field.
+func ListenerIDLT(v string) predicate.CoreGatewayVirtualHost {
+	return predicate.CoreGatewayVirtualHost(sql.FieldLT(FieldListenerID, v))
+}
+
+// ListenerIDLTE applies the LTE predicate on the "listener_id" field.
+func ListenerIDLTE(v string) predicate.CoreGatewayVirtualHost {
+	return predicate.CoreGatewayVirtualHost(sql.FieldLTE(FieldListenerID, v))
+}
+
+// ListenerIDContains applies the Contains predicate on the "listener_id" field.
+func ListenerIDContains(v string) predicate.CoreGatewayVirtualHost {
+	return predicate.CoreGatewayVirtualHost(sql.FieldContains(FieldListenerID, v))
+}
+
+// ListenerIDHasPrefix applies the HasPrefix predicate on the "listener_id" field.
+func ListenerIDHasPrefix(v string) predicate.CoreGatewayVirtualHost {
+	return predicate.CoreGatewayVirtualHost(sql.FieldHasPrefix(FieldListenerID, v))
+}
+
+// ListenerIDHasSuffix applies the HasSuffix predicate on the "listener_id" field.
+func ListenerIDHasSuffix(v string) predicate.CoreGatewayVirtualHost {
+	return predicate.CoreGatewayVirtualHost(sql.FieldHasSuffix(FieldListenerID, v))
+}
+
+// ListenerIDIsNil applies the IsNil predicate on the "listener_id" field.
+func ListenerIDIsNil() predicate.CoreGatewayVirtualHost {
+	return predicate.CoreGatewayVirtualHost(sql.FieldIsNull(FieldListenerID))
+}
+
+// ListenerIDNotNil applies the NotNil predicate on the "listener_id" field.
+func ListenerIDNotNil() predicate.CoreGatewayVirtualHost {
+	return predicate.CoreGatewayVirtualHost(sql.FieldNotNull(FieldListenerID))
+}
+
+// ListenerIDEqualFold applies the EqualFold predicate on the "listener_id" field.
+func ListenerIDEqualFold(v string) predicate.CoreGatewayVirtualHost {
+	return predicate.CoreGatewayVirtualHost(sql.FieldEqualFold(FieldListenerID, v))
+}
+
+// ListenerIDContainsFold applies the ContainsFold predicate on the "listener_id" field.
+func ListenerIDContainsFold(v string) predicate.CoreGatewayVirtualHost {
+	return predicate.CoreGatewayVirtualHost(sql.FieldContainsFold(FieldListenerID, v))
+}
+
+// DomainsIsNil applies the IsNil predicate on the "domains" field.
+func DomainsIsNil() predicate.CoreGatewayVirtualHost {
+	return predicate.CoreGatewayVirtualHost(sql.FieldIsNull(FieldDomains))
+}
+
+// DomainsNotNil applies the NotNil predicate on the "domains" field.
+func DomainsNotNil() predicate.CoreGatewayVirtualHost {
+	return predicate.CoreGatewayVirtualHost(sql.FieldNotNull(FieldDomains))
+}
+
+// HeaderMutationIsNil applies the IsNil predicate on the "header_mutation" field.
+func HeaderMutationIsNil() predicate.CoreGatewayVirtualHost {
+	return predicate.CoreGatewayVirtualHost(sql.FieldIsNull(FieldHeaderMutation))
+}
+
+// HeaderMutationNotNil applies the NotNil predicate on the "header_mutation" field.
+func HeaderMutationNotNil() predicate.CoreGatewayVirtualHost {
+	return predicate.CoreGatewayVirtualHost(sql.FieldNotNull(FieldHeaderMutation))
+}
+
+// CorsPolicyIsNil applies the IsNil predicate on the "cors_policy" field.
+func CorsPolicyIsNil() predicate.CoreGatewayVirtualHost {
+	return predicate.CoreGatewayVirtualHost(sql.FieldIsNull(FieldCorsPolicy))
+}
+
+// CorsPolicyNotNil applies the NotNil predicate on the "cors_policy" field.
+func CorsPolicyNotNil() predicate.CoreGatewayVirtualHost {
+	return predicate.CoreGatewayVirtualHost(sql.FieldNotNull(FieldCorsPolicy))
+}
+
+// MaintenanceIsNil applies the IsNil predicate on the "maintenance" field.
+func MaintenanceIsNil() predicate.CoreGatewayVirtualHost {
+	return predicate.CoreGatewayVirtualHost(sql.FieldIsNull(FieldMaintenance))
+}
+
+// MaintenanceNotNil applies the NotNil predicate on the "maintenance" field.
+func MaintenanceNotNil() predicate.CoreGatewayVirtualHost {
+	return predicate.CoreGatewayVirtualHost(sql.FieldNotNull(FieldMaintenance))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v constant.YesOrNo) predicate.CoreGatewayVirtualHost {
+	vc := int8(v)
+	return predicate.CoreGatewayVirtualHost(sql.FieldEQ(FieldStatus, vc))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v constant.YesOrNo) predicate.CoreGatewayVirtualHost {
+	vc := int8(v)
+	return predicate.CoreGatewayVirtualHost(sql.FieldNEQ(FieldStatus, vc))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...constant.YesOrNo) predicate.CoreGatewayVirtualHost {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = int8(vs[i])
+	}
+	return predicate.CoreGatewayVirtualHost(sql.FieldIn(FieldStatus, v...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...constant.YesOrNo) predicate.CoreGatewayVirtualHost {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = int8(vs[i])
+	}
+	return predicate.CoreGatewayVirtualHost(sql.FieldNotIn(FieldStatus, v...))
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v constant.YesOrNo) predicate.CoreGatewayVirtualHost {
+	vc := int8(v)
+	return predicate.CoreGatewayVirtualHost(sql.FieldGT(FieldStatus, vc))
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v constant.YesOrNo) predicate.CoreGatewayVirtualHost {
+	vc := int8(v)
+	return predicate.CoreGatewayVirtualHost(sql.FieldGTE(FieldStatus, vc))
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v constant.YesOrNo) predicate.CoreGatewayVirtualHost {
+	vc := int8(v)
+	return predicate.CoreGatewayVirtualHost(sql.FieldLT(FieldStatus, vc))
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v constant.YesOrNo) predicate.CoreGatewayVirtualHost {
+	vc := int8(v)
+	return predicate.CoreGatewayVirtualHost(sql.FieldLTE(FieldStatus, vc))
+}
+
+// StatusIsNil applies the IsNil predicate on the "status" field.
+func StatusIsNil() predicate.CoreGatewayVirtualHost {
+	return predicate.CoreGatewayVirtualHost(sql.FieldIsNull(FieldStatus))
+}
+
+// StatusNotNil applies the NotNil predicate on the "status" field.
+func StatusNotNil() predicate.CoreGatewayVirtualHost {
+	return predicate.CoreGatewayVirtualHost(sql.FieldNotNull(FieldStatus))
+}
+
+// HasVhostFromListener applies the HasEdge predicate on the "vhost_from_listener" edge.
+func HasVhostFromListener() predicate.CoreGatewayVirtualHost {
+	return predicate.CoreGatewayVirtualHost(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, VhostFromListenerTable, VhostFromListenerColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasVhostFromListenerWith applies the HasEdge predicate on the "vhost_from_listener" edge with a given conditions (other predicates).
+func HasVhostFromListenerWith(preds ...predicate.CoreGatewayL7Listener) predicate.CoreGatewayVirtualHost {
+	return predicate.CoreGatewayVirtualHost(func(s *sql.Selector) {
+		step := newVhostFromListenerStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasVhostToRoute applies the HasEdge predicate on the "vhost_to_route" edge.
+func HasVhostToRoute() predicate.CoreGatewayVirtualHost {
+	return predicate.CoreGatewayVirtualHost(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, VhostToRouteTable, VhostToRouteColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasVhostToRouteWith applies the HasEdge predicate on the "vhost_to_route" edge with a given conditions (other predicates).
+func HasVhostToRouteWith(preds ...predicate.CoreGatewayHttpRoute) predicate.CoreGatewayVirtualHost {
+	return predicate.CoreGatewayVirtualHost(func(s *sql.Selector) {
+		step := newVhostToRouteStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.CoreGatewayVirtualHost) predicate.CoreGatewayVirtualHost {
+	return predicate.CoreGatewayVirtualHost(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.CoreGatewayVirtualHost) predicate.CoreGatewayVirtualHost {
+	return predicate.CoreGatewayVirtualHost(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.CoreGatewayVirtualHost) predicate.CoreGatewayVirtualHost {
+	return predicate.CoreGatewayVirtualHost(sql.NotPredicates(p))
+}
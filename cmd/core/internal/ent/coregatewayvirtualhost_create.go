@@ -0,0 +1,1329 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/lyonmu/quebec/cmd/core/internal/common"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/coregatewayhttproute"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/coregatewayl7listener"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/coregatewayvirtualhost"
+	"github.com/lyonmu/quebec/pkg/constant"
+)
+
+// CoreGatewayVirtualHostCreate is the builder for creating a CoreGatewayVirtualHost entity.
+type CoreGatewayVirtualHostCreate struct {
+	config
+	mutation *CoreGatewayVirtualHostMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *CoreGatewayVirtualHostCreate) SetCreatedAt(v time.Time) *CoreGatewayVirtualHostCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *CoreGatewayVirtualHostCreate) SetNillableCreatedAt(v *time.Time) *CoreGatewayVirtualHostCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *CoreGatewayVirtualHostCreate) SetUpdatedAt(v time.Time) *CoreGatewayVirtualHostCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *CoreGatewayVirtualHostCreate) SetNillableUpdatedAt(v *time.Time) *CoreGatewayVirtualHostCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_c *CoreGatewayVirtualHostCreate) SetDeletedAt(v time.Time) *CoreGatewayVirtualHostCreate {
+	_c.mutation.SetDeletedAt(v)
+	return _c
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_c *CoreGatewayVirtualHostCreate) SetNillableDeletedAt(v *time.Time) *CoreGatewayVirtualHostCreate {
+	if v != nil {
+		_c.SetDeletedAt(*v)
+	}
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *CoreGatewayVirtualHostCreate) SetName(v string) *CoreGatewayVirtualHostCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_c *CoreGatewayVirtualHostCreate) SetNillableName(v *string) *CoreGatewayVirtualHostCreate {
+	if v != nil {
+		_c.SetName(*v)
+	}
+	return _c
+}
+
+// SetDescription sets the "description" field.
+func (_c *CoreGatewayVirtualHostCreate) SetDescription(v string) *CoreGatewayVirtualHostCreate {
+	_c.mutation.SetDescription(v)
+	return _c
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_c *CoreGatewayVirtualHostCreate) SetNillableDescription(v *string) *CoreGatewayVirtualHostCreate {
+	if v != nil {
+		_c.SetDescription(*v)
+	}
+	return _c
+}
+
+// SetListenerID sets the "listener_id" field.
+func (_c *CoreGatewayVirtualHostCreate) SetListenerID(v string) *CoreGatewayVirtualHostCreate {
+	_c.mutation.SetListenerID(v)
+	return _c
+}
+
+// SetNillableListenerID sets the "listener_id" field if the given value is not nil.
+func (_c *CoreGatewayVirtualHostCreate) SetNillableListenerID(v *string) *CoreGatewayVirtualHostCreate {
+	if v != nil {
+		_c.SetListenerID(*v)
+	}
+	return _c
+}
+
+// SetDomains sets the "domains" field.
+func (_c *CoreGatewayVirtualHostCreate) SetDomains(v []string) *CoreGatewayVirtualHostCreate {
+	_c.mutation.SetDomains(v)
+	return _c
+}
+
+// SetHeaderMutation sets the "header_mutation" field.
+func (_c *CoreGatewayVirtualHostCreate) SetHeaderMutation(v *common.HeaderMutation) *CoreGatewayVirtualHostCreate {
+	_c.mutation.SetHeaderMutation(v)
+	return _c
+}
+
+// SetCorsPolicy sets the "cors_policy" field.
+func (_c *CoreGatewayVirtualHostCreate) SetCorsPolicy(v *common.CorsPolicy) *CoreGatewayVirtualHostCreate {
+	_c.mutation.SetCorsPolicy(v)
+	return _c
+}
+
+// SetMaintenance sets the "maintenance" field.
+func (_c *CoreGatewayVirtualHostCreate) SetMaintenance(v *common.Maintenance) *CoreGatewayVirtualHostCreate {
+	_c.mutation.SetMaintenance(v)
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *CoreGatewayVirtualHostCreate) SetStatus(v constant.YesOrNo) *CoreGatewayVirtualHostCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *CoreGatewayVirtualHostCreate) SetNillableStatus(v *constant.YesOrNo) *CoreGatewayVirtualHostCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *CoreGatewayVirtualHostCreate) SetID(v string) *CoreGatewayVirtualHostCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *CoreGatewayVirtualHostCreate) SetNillableID(v *string) *CoreGatewayVirtualHostCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetVhostFromListenerID sets the "vhost_from_listener" edge to the CoreGatewayL7Listener entity by ID.
+func (_c *CoreGatewayVirtualHostCreate) SetVhostFromListenerID(id string) *CoreGatewayVirtualHostCreate {
+	_c.mutation.SetVhostFromListenerID(id)
+	return _c
+}
+
+// SetNillableVhostFromListenerID sets the "vhost_from_listener" edge to the CoreGatewayL7Listener entity by ID if the given value is not nil.
+func (_c *CoreGatewayVirtualHostCreate) SetNillableVhostFromListenerID(id *string) *CoreGatewayVirtualHostCreate {
+	if id != nil {
+		_c = _c.SetVhostFromListenerID(*id)
+	}
+	return _c
+}
+
+// SetVhostFromListener sets the "vhost_from_listener" edge to the CoreGatewayL7Listener entity.
+func (_c *CoreGatewayVirtualHostCreate) SetVhostFromListener(v *CoreGatewayL7Listener) *CoreGatewayVirtualHostCreate {
+	return _c.SetVhostFromListenerID(v.ID)
+}
+
+// AddVhostToRouteIDs adds the "vhost_to_route" edge to the CoreGatewayHttpRoute entity by IDs.
+func (_c *CoreGatewayVirtualHostCreate) AddVhostToRouteIDs(ids ...string) *CoreGatewayVirtualHostCreate {
+	_c.mutation.AddVhostToRouteIDs(ids...)
+	return _c
+}
+
+// AddVhostToRoute adds the "vhost_to_route" edges to the CoreGatewayHttpRoute entity.
+func (_c *CoreGatewayVirtualHostCreate) AddVhostToRoute(v ...*CoreGatewayHttpRoute) *CoreGatewayVirtualHostCreate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddVhostToRouteIDs(ids...)
+}
+
+// Mutation returns the CoreGatewayVirtualHostMutation object of the builder.
+func (_c *CoreGatewayVirtualHostCreate) Mutation() *CoreGatewayVirtualHostMutation {
+	return _c.mutation
+}
+
+// Save creates the CoreGatewayVirtualHost in the database.
+func (_c *CoreGatewayVirtualHostCreate) Save(ctx context.Context) (*CoreGatewayVirtualHost, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *CoreGatewayVirtualHostCreate) SaveX(ctx context.Context) *CoreGatewayVirtualHost {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *CoreGatewayVirtualHostCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *CoreGatewayVirtualHostCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *CoreGatewayVirtualHostCreate) defaults() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		if coregatewayvirtualhost.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized coregatewayvirtualhost.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := coregatewayvirtualhost.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		if coregatewayvirtualhost.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized coregatewayvirtualhost.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := coregatewayvirtualhost.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		v := coregatewayvirtualhost.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		if coregatewayvirtualhost.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized coregatewayvirtualhost.DefaultID (forgotten import ent/runtime?)")
+		}
+		v := coregatewayvirtualhost.DefaultID()
+		_c.mutation.SetID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *CoreGatewayVirtualHostCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "CoreGatewayVirtualHost.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "CoreGatewayVirtualHost.updated_at"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := coregatewayvirtualhost.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "CoreGatewayVirtualHost.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *CoreGatewayVirtualHostCreate) sqlSave(ctx context.Context) (*CoreGatewayVirtualHost, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected CoreGatewayVirtualHost.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *CoreGatewayVirtualHostCreate) createSpec() (*CoreGatewayVirtualHost, *sqlgraph.CreateSpec) {
+	var (
+		_node = &CoreGatewayVirtualHost{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(coregatewayvirtualhost.Table, sqlgraph.NewFieldSpec(coregatewayvirtualhost.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(coregatewayvirtualhost.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(coregatewayvirtualhost.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.DeletedAt(); ok {
+		_spec.SetField(coregatewayvirtualhost.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(coregatewayvirtualhost.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.Description(); ok {
+		_spec.SetField(coregatewayvirtualhost.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if value, ok := _c.mutation.Domains(); ok {
+		_spec.SetField(coregatewayvirtualhost.FieldDomains, field.TypeJSON, value)
+		_node.Domains = value
+	}
+	if value, ok := _c.mutation.HeaderMutation(); ok {
+		_spec.SetField(coregatewayvirtualhost.FieldHeaderMutation, field.TypeJSON, value)
+		_node.HeaderMutation = value
+	}
+	if value, ok := _c.mutation.CorsPolicy(); ok {
+		_spec.SetField(coregatewayvirtualhost.FieldCorsPolicy, field.TypeJSON, value)
+		_node.CorsPolicy = value
+	}
+	if value, ok := _c.mutation.Maintenance(); ok {
+		_spec.SetField(coregatewayvirtualhost.FieldMaintenance, field.TypeJSON, value)
+		_node.Maintenance = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(coregatewayvirtualhost.FieldStatus, field.TypeInt8, value)
+		_node.Status = value
+	}
+	if nodes := _c.mutation.VhostFromListenerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   coregatewayvirtualhost.VhostFromListenerTable,
+			Columns: []string{coregatewayvirtualhost.VhostFromListenerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(coregatewayl7listener.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ListenerID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.VhostToRouteIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   coregatewayvirtualhost.VhostToRouteTable,
+			Columns: []string{coregatewayvirtualhost.VhostToRouteColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(coregatewayhttproute.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.CoreGatewayVirtualHost.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.CoreGatewayVirtualHostUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *CoreGatewayVirtualHostCreate) OnConflict(opts ...sql.ConflictOption) *CoreGatewayVirtualHostUpsertOne {
+	_c.conflict = opts
+	return &CoreGatewayVirtualHostUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.CoreGatewayVirtualHost.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *CoreGatewayVirtualHostCreate) OnConflictColumns(columns ...string) *CoreGatewayVirtualHostUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &CoreGatewayVirtualHostUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// CoreGatewayVirtualHostUpsertOne is the builder for "upsert"-ing
+	//  one CoreGatewayVirtualHost node.
+	CoreGatewayVirtualHostUpsertOne struct {
+		create *CoreGatewayVirtualHostCreate
+	}
+
+	// CoreGatewayVirtualHostUpsert is the "OnConflict" setter.
+	CoreGatewayVirtualHostUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *CoreGatewayVirtualHostUpsert) SetUpdatedAt(v time.Time) *CoreGatewayVirtualHostUpsert {
+	u.Set(coregatewayvirtualhost.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *CoreGatewayVirtualHostUpsert) UpdateUpdatedAt() *CoreGatewayVirtualHostUpsert {
+	u.SetExcluded(coregatewayvirtualhost.FieldUpdatedAt)
+	return u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *CoreGatewayVirtualHostUpsert) SetDeletedAt(v time.Time) *CoreGatewayVirtualHostUpsert {
+	u.Set(coregatewayvirtualhost.FieldDeletedAt, v)
+	return u
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *CoreGatewayVirtualHostUpsert) UpdateDeletedAt() *CoreGatewayVirtualHostUpsert {
+	u.SetExcluded(coregatewayvirtualhost.FieldDeletedAt)
+	return u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *CoreGatewayVirtualHostUpsert) ClearDeletedAt() *CoreGatewayVirtualHostUpsert {
+	u.SetNull(coregatewayvirtualhost.FieldDeletedAt)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *CoreGatewayVirtualHostUpsert) SetName(v string) *CoreGatewayVirtualHostUpsert {
+	u.Set(coregatewayvirtualhost.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *CoreGatewayVirtualHostUpsert) UpdateName() *CoreGatewayVirtualHostUpsert {
+	u.SetExcluded(coregatewayvirtualhost.FieldName)
+	return u
+}
+
+// ClearName clears the value of the "name" field.
+func (u *CoreGatewayVirtualHostUpsert) ClearName() *CoreGatewayVirtualHostUpsert {
+	u.SetNull(coregatewayvirtualhost.FieldName)
+	return u
+}
+
+// SetDescription sets the "description" field.
+func (u *CoreGatewayVirtualHostUpsert) SetDescription(v string) *CoreGatewayVirtualHostUpsert {
+	u.Set(coregatewayvirtualhost.FieldDescription, v)
+	return u
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *CoreGatewayVirtualHostUpsert) UpdateDescription() *CoreGatewayVirtualHostUpsert {
+	u.SetExcluded(coregatewayvirtualhost.FieldDescription)
+	return u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *CoreGatewayVirtualHostUpsert) ClearDescription() *CoreGatewayVirtualHostUpsert {
+	u.SetNull(coregatewayvirtualhost.FieldDescription)
+	return u
+}
+
+// SetListenerID sets the "listener_id" field.
+func (u *CoreGatewayVirtualHostUpsert) SetListenerID(v string) *CoreGatewayVirtualHostUpsert {
+	u.Set(coregatewayvirtualhost.FieldListenerID, v)
+	return u
+}
+
+// UpdateListenerID sets the "listener_id" field to the value that was provided on create.
+func (u *CoreGatewayVirtualHostUpsert) UpdateListenerID() *CoreGatewayVirtualHostUpsert {
+	u.SetExcluded(coregatewayvirtualhost.FieldListenerID)
+	return u
+}
+
+// ClearListenerID clears the value of the "listener_id" field.
+func (u *CoreGatewayVirtualHostUpsert) ClearListenerID() *CoreGatewayVirtualHostUpsert {
+	u.SetNull(coregatewayvirtualhost.FieldListenerID)
+	return u
+}
+
+// SetDomains sets the "domains" field.
+func (u *CoreGatewayVirtualHostUpsert) SetDomains(v []string) *CoreGatewayVirtualHostUpsert {
+	u.Set(coregatewayvirtualhost.FieldDomains, v)
+	return u
+}
+
+// UpdateDomains sets the "domains" field to the value that was provided on create.
+func (u *CoreGatewayVirtualHostUpsert) UpdateDomains() *CoreGatewayVirtualHostUpsert {
+	u.SetExcluded(coregatewayvirtualhost.FieldDomains)
+	return u
+}
+
+// ClearDomains clears the value of the "domains" field.
+func (u *CoreGatewayVirtualHostUpsert) ClearDomains() *CoreGatewayVirtualHostUpsert {
+	u.SetNull(coregatewayvirtualhost.FieldDomains)
+	return u
+}
+
+// SetHeaderMutation sets the "header_mutation" field.
+func (u *CoreGatewayVirtualHostUpsert) SetHeaderMutation(v *common.HeaderMutation) *CoreGatewayVirtualHostUpsert {
+	u.Set(coregatewayvirtualhost.FieldHeaderMutation, v)
+	return u
+}
+
+// UpdateHeaderMutation sets the "header_mutation" field to the value that was provided on create.
+func (u *CoreGatewayVirtualHostUpsert) UpdateHeaderMutation() *CoreGatewayVirtualHostUpsert {
+	u.SetExcluded(coregatewayvirtualhost.FieldHeaderMutation)
+	return u
+}
+
+// ClearHeaderMutation clears the value of the "header_mutation" field.
+func (u *CoreGatewayVirtualHostUpsert) ClearHeaderMutation() *CoreGatewayVirtualHostUpsert {
+	u.SetNull(coregatewayvirtualhost.FieldHeaderMutation)
+	return u
+}
+
+// SetCorsPolicy sets the "cors_policy" field.
+func (u *CoreGatewayVirtualHostUpsert) SetCorsPolicy(v *common.CorsPolicy) *CoreGatewayVirtualHostUpsert {
+	u.Set(coregatewayvirtualhost.FieldCorsPolicy, v)
+	return u
+}
+
+// UpdateCorsPolicy sets the "cors_policy" field to the value that was provided on create.
+func (u *CoreGatewayVirtualHostUpsert) UpdateCorsPolicy() *CoreGatewayVirtualHostUpsert {
+	u.SetExcluded(coregatewayvirtualhost.FieldCorsPolicy)
+	return u
+}
+
+// ClearCorsPolicy clears the value of the "cors_policy" field.
+func (u *CoreGatewayVirtualHostUpsert) ClearCorsPolicy() *CoreGatewayVirtualHostUpsert {
+	u.SetNull(coregatewayvirtualhost.FieldCorsPolicy)
+	return u
+}
+
+// SetMaintenance sets the "maintenance" field.
+func (u *CoreGatewayVirtualHostUpsert) SetMaintenance(v *common.Maintenance) *CoreGatewayVirtualHostUpsert {
+	u.Set(coregatewayvirtualhost.FieldMaintenance, v)
+	return u
+}
+
+// UpdateMaintenance sets the "maintenance" field to the value that was provided on create.
+func (u *CoreGatewayVirtualHostUpsert) UpdateMaintenance() *CoreGatewayVirtualHostUpsert {
+	u.SetExcluded(coregatewayvirtualhost.FieldMaintenance)
+	return u
+}
+
+// ClearMaintenance clears the value of the "maintenance" field.
+func (u *CoreGatewayVirtualHostUpsert) ClearMaintenance() *CoreGatewayVirtualHostUpsert {
+	u.SetNull(coregatewayvirtualhost.FieldMaintenance)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *CoreGatewayVirtualHostUpsert) SetStatus(v constant.YesOrNo) *CoreGatewayVirtualHostUpsert {
+	u.Set(coregatewayvirtualhost.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *CoreGatewayVirtualHostUpsert) UpdateStatus() *CoreGatewayVirtualHostUpsert {
+	u.SetExcluded(coregatewayvirtualhost.FieldStatus)
+	return u
+}
+
+// AddStatus adds v to the "status" field.
+func (u *CoreGatewayVirtualHostUpsert) AddStatus(v constant.YesOrNo) *CoreGatewayVirtualHostUpsert {
+	u.Add(coregatewayvirtualhost.FieldStatus, v)
+	return u
+}
+
+// ClearStatus clears the value of the "status" field.
+func (u *CoreGatewayVirtualHostUpsert) ClearStatus() *CoreGatewayVirtualHostUpsert {
+	u.SetNull(coregatewayvirtualhost.FieldStatus)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.CoreGatewayVirtualHost.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(coregatewayvirtualhost.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *CoreGatewayVirtualHostUpsertOne) UpdateNewValues() *CoreGatewayVirtualHostUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(coregatewayvirtualhost.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(coregatewayvirtualhost.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.CoreGatewayVirtualHost.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *CoreGatewayVirtualHostUpsertOne) Ignore() *CoreGatewayVirtualHostUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *CoreGatewayVirtualHostUpsertOne) DoNothing() *CoreGatewayVirtualHostUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the CoreGatewayVirtualHostCreate.OnConflict
+// documentation for more info.
+func (u *CoreGatewayVirtualHostUpsertOne) Update(set func(*CoreGatewayVirtualHostUpsert)) *CoreGatewayVirtualHostUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&CoreGatewayVirtualHostUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *CoreGatewayVirtualHostUpsertOne) SetUpdatedAt(v time.Time) *CoreGatewayVirtualHostUpsertOne {
+	return u.Update(func(s *CoreGatewayVirtualHostUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *CoreGatewayVirtualHostUpsertOne) UpdateUpdatedAt() *CoreGatewayVirtualHostUpsertOne {
+	return u.Update(func(s *CoreGatewayVirtualHostUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *CoreGatewayVirtualHostUpsertOne) SetDeletedAt(v time.Time) *CoreGatewayVirtualHostUpsertOne {
+	return u.Update(func(s *CoreGatewayVirtualHostUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *CoreGatewayVirtualHostUpsertOne) UpdateDeletedAt() *CoreGatewayVirtualHostUpsertOne {
+	return u.Update(func(s *CoreGatewayVirtualHostUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *CoreGatewayVirtualHostUpsertOne) ClearDeletedAt() *CoreGatewayVirtualHostUpsertOne {
+	return u.Update(func(s *CoreGatewayVirtualHostUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *CoreGatewayVirtualHostUpsertOne) SetName(v string) *CoreGatewayVirtualHostUpsertOne {
+	return u.Update(func(s *CoreGatewayVirtualHostUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *CoreGatewayVirtualHostUpsertOne) UpdateName() *CoreGatewayVirtualHostUpsertOne {
+	return u.Update(func(s *CoreGatewayVirtualHostUpsert) {
+		s.UpdateName()
+	})
+}
+
+// ClearName clears the value of the "name" field.
+func (u *CoreGatewayVirtualHostUpsertOne) ClearName() *CoreGatewayVirtualHostUpsertOne {
+	return u.Update(func(s *CoreGatewayVirtualHostUpsert) {
+		s.ClearName()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *CoreGatewayVirtualHostUpsertOne) SetDescription(v string) *CoreGatewayVirtualHostUpsertOne {
+	return u.Update(func(s *CoreGatewayVirtualHostUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *CoreGatewayVirtualHostUpsertOne) UpdateDescription() *CoreGatewayVirtualHostUpsertOne {
+	return u.Update(func(s *CoreGatewayVirtualHostUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *CoreGatewayVirtualHostUpsertOne) ClearDescription() *CoreGatewayVirtualHostUpsertOne {
+	return u.Update(func(s *CoreGatewayVirtualHostUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetListenerID sets the "listener_id" field.
+func (u *CoreGatewayVirtualHostUpsertOne) SetListenerID(v string) *CoreGatewayVirtualHostUpsertOne {
+	return u.Update(func(s *CoreGatewayVirtualHostUpsert) {
+		s.SetListenerID(v)
+	})
+}
+
+// UpdateListenerID sets the "listener_id" field to the value that was provided on create.
+func (u *CoreGatewayVirtualHostUpsertOne) UpdateListenerID() *CoreGatewayVirtualHostUpsertOne {
+	return u.Update(func(s *CoreGatewayVirtualHostUpsert) {
+		s.UpdateListenerID()
+	})
+}
+
+// ClearListenerID clears the value of the "listener_id" field.
+func (u *CoreGatewayVirtualHostUpsertOne) ClearListenerID() *CoreGatewayVirtualHostUpsertOne {
+	return u.Update(func(s *CoreGatewayVirtualHostUpsert) {
+		s.ClearListenerID()
+	})
+}
+
+// SetDomains sets the "domains" field.
+func (u *CoreGatewayVirtualHostUpsertOne) SetDomains(v []string) *CoreGatewayVirtualHostUpsertOne {
+	return u.Update(func(s *CoreGatewayVirtualHostUpsert) {
+		s.SetDomains(v)
+	})
+}
+
+// UpdateDomains sets the "domains" field to the value that was provided on create.
+func (u *CoreGatewayVirtualHostUpsertOne) UpdateDomains() *CoreGatewayVirtualHostUpsertOne {
+	return u.Update(func(s *CoreGatewayVirtualHostUpsert) {
+		s.UpdateDomains()
+	})
+}
+
+// ClearDomains clears the value of the "domains" field.
+func (u *CoreGatewayVirtualHostUpsertOne) ClearDomains() *CoreGatewayVirtualHostUpsertOne {
+	return u.Update(func(s *CoreGatewayVirtualHostUpsert) {
+		s.ClearDomains()
+	})
+}
+
+// SetHeaderMutation sets the "header_mutation" field.
+func (u *CoreGatewayVirtualHostUpsertOne) SetHeaderMutation(v *common.HeaderMutation) *CoreGatewayVirtualHostUpsertOne {
+	return u.Update(func(s *CoreGatewayVirtualHostUpsert) {
+		s.SetHeaderMutation(v)
+	})
+}
+
+// UpdateHeaderMutation sets the "header_mutation" field to the value that was provided on create.
+func (u *CoreGatewayVirtualHostUpsertOne) UpdateHeaderMutation() *CoreGatewayVirtualHostUpsertOne {
+	return u.Update(func(s *CoreGatewayVirtualHostUpsert) {
+		s.UpdateHeaderMutation()
+	})
+}
+
+// ClearHeaderMutation clears the value of the "header_mutation" field.
+func (u *CoreGatewayVirtualHostUpsertOne) ClearHeaderMutation() *CoreGatewayVirtualHostUpsertOne {
+	return u.Update(func(s *CoreGatewayVirtualHostUpsert) {
+		s.ClearHeaderMutation()
+	})
+}
+
+// SetCorsPolicy sets the "cors_policy" field.
+func (u *CoreGatewayVirtualHostUpsertOne) SetCorsPolicy(v *common.CorsPolicy) *CoreGatewayVirtualHostUpsertOne {
+	return u.Update(func(s *CoreGatewayVirtualHostUpsert) {
+		s.SetCorsPolicy(v)
+	})
+}
+
+// UpdateCorsPolicy sets the "cors_policy" field to the value that was provided on create.
+func (u *CoreGatewayVirtualHostUpsertOne) UpdateCorsPolicy() *CoreGatewayVirtualHostUpsertOne {
+	return u.Update(func(s *CoreGatewayVirtualHostUpsert) {
+		s.UpdateCorsPolicy()
+	})
+}
+
+// ClearCorsPolicy clears the value of the "cors_policy" field.
+func (u *CoreGatewayVirtualHostUpsertOne) ClearCorsPolicy() *CoreGatewayVirtualHostUpsertOne {
+	return u.Update(func(s *CoreGatewayVirtualHostUpsert) {
+		s.ClearCorsPolicy()
+	})
+}
+
+// SetMaintenance sets the "maintenance" field.
+func (u *CoreGatewayVirtualHostUpsertOne) SetMaintenance(v *common.Maintenance) *CoreGatewayVirtualHostUpsertOne {
+	return u.Update(func(s *CoreGatewayVirtualHostUpsert) {
+		s.SetMaintenance(v)
+	})
+}
+
+// UpdateMaintenance sets the "maintenance" field to the value that was provided on create.
+func (u *CoreGatewayVirtualHostUpsertOne) UpdateMaintenance() *CoreGatewayVirtualHostUpsertOne {
+	return u.Update(func(s *CoreGatewayVirtualHostUpsert) {
+		s.UpdateMaintenance()
+	})
+}
+
+// ClearMaintenance clears the value of the "maintenance" field.
+func (u *CoreGatewayVirtualHostUpsertOne) ClearMaintenance() *CoreGatewayVirtualHostUpsertOne {
+	return u.Update(func(s *CoreGatewayVirtualHostUpsert) {
+		s.ClearMaintenance()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *CoreGatewayVirtualHostUpsertOne) SetStatus(v constant.YesOrNo) *CoreGatewayVirtualHostUpsertOne {
+	return u.Update(func(s *CoreGatewayVirtualHostUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// AddStatus adds v to the "status" field.
+func (u *CoreGatewayVirtualHostUpsertOne) AddStatus(v constant.YesOrNo) *CoreGatewayVirtualHostUpsertOne {
+	return u.Update(func(s *CoreGatewayVirtualHostUpsert) {
+		s.AddStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *CoreGatewayVirtualHostUpsertOne) UpdateStatus() *CoreGatewayVirtualHostUpsertOne {
+	return u.Update(func(s *CoreGatewayVirtualHostUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// ClearStatus clears the value of the "status" field.
+func (u *CoreGatewayVirtualHostUpsertOne) ClearStatus() *CoreGatewayVirtualHostUpsertOne {
+	return u.Update(func(s *CoreGatewayVirtualHostUpsert) {
+		s.ClearStatus()
+	})
+}
+
+// Exec executes the query.
+func (u *CoreGatewayVirtualHostUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for CoreGatewayVirtualHostCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *CoreGatewayVirtualHostUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *CoreGatewayVirtualHostUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: CoreGatewayVirtualHostUpsertOne.ID is not supported by MySQL driver. Use CoreGatewayVirtualHostUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *CoreGatewayVirtualHostUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// CoreGatewayVirtualHostCreateBulk is the builder for creating many CoreGatewayVirtualHost entities in bulk.
+type CoreGatewayVirtualHostCreateBulk struct {
+	config
+	err      error
+	builders []*CoreGatewayVirtualHostCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the CoreGatewayVirtualHost entities in the database.
+func (_c *CoreGatewayVirtualHostCreateBulk) Save(ctx context.Context) ([]*CoreGatewayVirtualHost, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*CoreGatewayVirtualHost, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*CoreGatewayVirtualHostMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *CoreGatewayVirtualHostCreateBulk) SaveX(ctx context.Context) []*CoreGatewayVirtualHost {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *CoreGatewayVirtualHostCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *CoreGatewayVirtualHostCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.CoreGatewayVirtualHost.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.CoreGatewayVirtualHostUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *CoreGatewayVirtualHostCreateBulk) OnConflict(opts ...sql.ConflictOption) *CoreGatewayVirtualHostUpsertBulk {
+	_c.conflict = opts
+	return &CoreGatewayVirtualHostUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.CoreGatewayVirtualHost.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *CoreGatewayVirtualHostCreateBulk) OnConflictColumns(columns ...string) *CoreGatewayVirtualHostUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &CoreGatewayVirtualHostUpsertBulk{
+		create: _c,
+	}
+}
+
+// CoreGatewayVirtualHostUpsertBulk is the builder for "upsert"-ing
+// a bulk of CoreGatewayVirtualHost nodes.
+type CoreGatewayVirtualHostUpsertBulk struct {
+	create *CoreGatewayVirtualHostCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.CoreGatewayVirtualHost.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(coregatewayvirtualhost.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *CoreGatewayVirtualHostUpsertBulk) UpdateNewValues() *CoreGatewayVirtualHostUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(coregatewayvirtualhost.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(coregatewayvirtualhost.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.CoreGatewayVirtualHost.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *CoreGatewayVirtualHostUpsertBulk) Ignore() *CoreGatewayVirtualHostUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *CoreGatewayVirtualHostUpsertBulk) DoNothing() *CoreGatewayVirtualHostUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the CoreGatewayVirtualHostCreateBulk.OnConflict
+// documentation for more info.
+func (u *CoreGatewayVirtualHostUpsertBulk) Update(set func(*CoreGatewayVirtualHostUpsert)) *CoreGatewayVirtualHostUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&CoreGatewayVirtualHostUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *CoreGatewayVirtualHostUpsertBulk) SetUpdatedAt(v time.Time) *CoreGatewayVirtualHostUpsertBulk {
+	return u.Update(func(s *CoreGatewayVirtualHostUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *CoreGatewayVirtualHostUpsertBulk) UpdateUpdatedAt() *CoreGatewayVirtualHostUpsertBulk {
+	return u.Update(func(s *CoreGatewayVirtualHostUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *CoreGatewayVirtualHostUpsertBulk) SetDeletedAt(v time.Time) *CoreGatewayVirtualHostUpsertBulk {
+	return u.Update(func(s *CoreGatewayVirtualHostUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *CoreGatewayVirtualHostUpsertBulk) UpdateDeletedAt() *CoreGatewayVirtualHostUpsertBulk {
+	return u.Update(func(s *CoreGatewayVirtualHostUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *CoreGatewayVirtualHostUpsertBulk) ClearDeletedAt() *CoreGatewayVirtualHostUpsertBulk {
+	return u.Update(func(s *CoreGatewayVirtualHostUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *CoreGatewayVirtualHostUpsertBulk) SetName(v string) *CoreGatewayVirtualHostUpsertBulk {
+	return u.Update(func(s *CoreGatewayVirtualHostUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *CoreGatewayVirtualHostUpsertBulk) UpdateName() *CoreGatewayVirtualHostUpsertBulk {
+	return u.Update(func(s *CoreGatewayVirtualHostUpsert) {
+		s.UpdateName()
+	})
+}
+
+// ClearName clears the value of the "name" field.
+func (u *CoreGatewayVirtualHostUpsertBulk) ClearName() *CoreGatewayVirtualHostUpsertBulk {
+	return u.Update(func(s *CoreGatewayVirtualHostUpsert) {
+		s.ClearName()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *CoreGatewayVirtualHostUpsertBulk) SetDescription(v string) *CoreGatewayVirtualHostUpsertBulk {
+	return u.Update(func(s *CoreGatewayVirtualHostUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *CoreGatewayVirtualHostUpsertBulk) UpdateDescription() *CoreGatewayVirtualHostUpsertBulk {
+	return u.Update(func(s *CoreGatewayVirtualHostUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *CoreGatewayVirtualHostUpsertBulk) ClearDescription() *CoreGatewayVirtualHostUpsertBulk {
+	return u.Update(func(s *CoreGatewayVirtualHostUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetListenerID sets the "listener_id" field.
+func (u *CoreGatewayVirtualHostUpsertBulk) SetListenerID(v string) *CoreGatewayVirtualHostUpsertBulk {
+	return u.Update(func(s *CoreGatewayVirtualHostUpsert) {
+		s.SetListenerID(v)
+	})
+}
+
+// UpdateListenerID sets the "listener_id" field to the value that was provided on create.
+func (u *CoreGatewayVirtualHostUpsertBulk) UpdateListenerID() *CoreGatewayVirtualHostUpsertBulk {
+	return u.Update(func(s *CoreGatewayVirtualHostUpsert) {
+		s.UpdateListenerID()
+	})
+}
+
+// ClearListenerID clears the value of the "listener_id" field.
+func (u *CoreGatewayVirtualHostUpsertBulk) ClearListenerID() *CoreGatewayVirtualHostUpsertBulk {
+	return u.Update(func(s *CoreGatewayVirtualHostUpsert) {
+		s.ClearListenerID()
+	})
+}
+
+// SetDomains sets the "domains" field.
+func (u *CoreGatewayVirtualHostUpsertBulk) SetDomains(v []string) *CoreGatewayVirtualHostUpsertBulk {
+	return u.Update(func(s *CoreGatewayVirtualHostUpsert) {
+		s.SetDomains(v)
+	})
+}
+
+// UpdateDomains sets the "domains" field to the value that was provided on create.
+func (u *CoreGatewayVirtualHostUpsertBulk) UpdateDomains() *CoreGatewayVirtualHostUpsertBulk {
+	return u.Update(func(s *CoreGatewayVirtualHostUpsert) {
+		s.UpdateDomains()
+	})
+}
+
+// ClearDomains clears the value of the "domains" field.
+func (u *CoreGatewayVirtualHostUpsertBulk) ClearDomains() *CoreGatewayVirtualHostUpsertBulk {
+	return u.Update(func(s *CoreGatewayVirtualHostUpsert) {
+		s.ClearDomains()
+	})
+}
+
+// SetHeaderMutation sets the "header_mutation" field.
+func (u *CoreGatewayVirtualHostUpsertBulk) SetHeaderMutation(v *common.HeaderMutation) *CoreGatewayVirtualHostUpsertBulk {
+	return u.Update(func(s *CoreGatewayVirtualHostUpsert) {
+		s.SetHeaderMutation(v)
+	})
+}
+
+// UpdateHeaderMutation sets the "header_mutation" field to the value that was provided on create.
+func (u *CoreGatewayVirtualHostUpsertBulk) UpdateHeaderMutation() *CoreGatewayVirtualHostUpsertBulk {
+	return u.Update(func(s *CoreGatewayVirtualHostUpsert) {
+		s.UpdateHeaderMutation()
+	})
+}
+
+// ClearHeaderMutation clears the value of the "header_mutation" field.
+func (u *CoreGatewayVirtualHostUpsertBulk) ClearHeaderMutation() *CoreGatewayVirtualHostUpsertBulk {
+	return u.Update(func(s *CoreGatewayVirtualHostUpsert) {
+		s.ClearHeaderMutation()
+	})
+}
+
+// SetCorsPolicy sets the "cors_policy" field.
+func (u *CoreGatewayVirtualHostUpsertBulk) SetCorsPolicy(v *common.CorsPolicy) *CoreGatewayVirtualHostUpsertBulk {
+	return u.Update(func(s *CoreGatewayVirtualHostUpsert) {
+		s.SetCorsPolicy(v)
+	})
+}
+
+// UpdateCorsPolicy sets the "cors_policy" field to the value that was provided on create.
+func (u *CoreGatewayVirtualHostUpsertBulk) UpdateCorsPolicy() *CoreGatewayVirtualHostUpsertBulk {
+	return u.Update(func(s *CoreGatewayVirtualHostUpsert) {
+		s.UpdateCorsPolicy()
+	})
+}
+
+// ClearCorsPolicy clears the value of the "cors_policy" field.
+func (u *CoreGatewayVirtualHostUpsertBulk) ClearCorsPolicy() *CoreGatewayVirtualHostUpsertBulk {
+	return u.Update(func(s *CoreGatewayVirtualHostUpsert) {
+		s.ClearCorsPolicy()
+	})
+}
+
+// SetMaintenance sets the "maintenance" field.
+func (u *CoreGatewayVirtualHostUpsertBulk) SetMaintenance(v *common.Maintenance) *CoreGatewayVirtualHostUpsertBulk {
+	return u.Update(func(s *CoreGatewayVirtualHostUpsert) {
+		s.SetMaintenance(v)
+	})
+}
+
+// UpdateMaintenance sets the "maintenance" field to the value that was provided on create.
+func (u *CoreGatewayVirtualHostUpsertBulk) UpdateMaintenance() *CoreGatewayVirtualHostUpsertBulk {
+	return u.Update(func(s *CoreGatewayVirtualHostUpsert) {
+		s.UpdateMaintenance()
+	})
+}
+
+// ClearMaintenance clears the value of the "maintenance" field.
+func (u *CoreGatewayVirtualHostUpsertBulk) ClearMaintenance() *CoreGatewayVirtualHostUpsertBulk {
+	return u.Update(func(s *CoreGatewayVirtualHostUpsert) {
+		s.ClearMaintenance()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *CoreGatewayVirtualHostUpsertBulk) SetStatus(v constant.YesOrNo) *CoreGatewayVirtualHostUpsertBulk {
+	return u.Update(func(s *CoreGatewayVirtualHostUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// AddStatus adds v to the "status" field.
+func (u *CoreGatewayVirtualHostUpsertBulk) AddStatus(v constant.YesOrNo) *CoreGatewayVirtualHostUpsertBulk {
+	return u.Update(func(s *CoreGatewayVirtualHostUpsert) {
+		s.AddStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *CoreGatewayVirtualHostUpsertBulk) UpdateStatus() *CoreGatewayVirtualHostUpsertBulk {
+	return u.Update(func(s *CoreGatewayVirtualHostUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// ClearStatus clears the value of the "status" field.
+func (u *CoreGatewayVirtualHostUpsertBulk) ClearStatus() *CoreGatewayVirtualHostUpsertBulk {
+	return u.Update(func(s *CoreGatewayVirtualHostUpsert) {
+		s.ClearStatus()
+	})
+}
+
+// Exec executes the query.
+func (u *CoreGatewayVirtualHostUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the CoreGatewayVirtualHostCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for CoreGatewayVirtualHostCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *CoreGatewayVirtualHostUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
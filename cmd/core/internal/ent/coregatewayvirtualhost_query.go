@@ -0,0 +1,706 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/coregatewayhttproute"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/coregatewayl7listener"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/coregatewayvirtualhost"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/predicate"
+)
+
+// CoreGatewayVirtualHostQuery is the builder for querying CoreGatewayVirtualHost entities.
+type CoreGatewayVirtualHostQuery struct {
+	config
+	ctx                   *QueryContext
+	order                 []coregatewayvirtualhost.OrderOption
+	inters                []Interceptor
+	predicates            []predicate.CoreGatewayVirtualHost
+	withVhostFromListener *CoreGatewayL7ListenerQuery
+	withVhostToRoute      *CoreGatewayHttpRouteQuery
+	modifiers             []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the CoreGatewayVirtualHostQuery builder.
+func (_q *CoreGatewayVirtualHostQuery) Where(ps ...predicate.CoreGatewayVirtualHost) *CoreGatewayVirtualHostQuery {
+	_q.predicates = append(_q.predicates, ps...)
+	return _q
+}
+
+// Limit the number of records to be returned by this query.
+func (_q *CoreGatewayVirtualHostQuery) Limit(limit int) *CoreGatewayVirtualHostQuery {
+	_q.ctx.Limit = &limit
+	return _q
+}
+
+// Offset to start from.
+func (_q *CoreGatewayVirtualHostQuery) Offset(offset int) *CoreGatewayVirtualHostQuery {
+	_q.ctx.Offset = &offset
+	return _q
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (_q *CoreGatewayVirtualHostQuery) Unique(unique bool) *CoreGatewayVirtualHostQuery {
+	_q.ctx.Unique = &unique
+	return _q
+}
+
+// Order specifies how the records should be ordered.
+func (_q *CoreGatewayVirtualHostQuery) Order(o ...coregatewayvirtualhost.OrderOption) *CoreGatewayVirtualHostQuery {
+	_q.order = append(_q.order, o...)
+	return _q
+}
+
+// QueryVhostFromListener chains the current query on the "vhost_from_listener" edge.
+func (_q *CoreGatewayVirtualHostQuery) QueryVhostFromListener() *CoreGatewayL7ListenerQuery {
+	query := (&CoreGatewayL7ListenerClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(coregatewayvirtualhost.Table, coregatewayvirtualhost.FieldID, selector),
+			sqlgraph.To(coregatewayl7listener.Table, coregatewayl7listener.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, coregatewayvirtualhost.VhostFromListenerTable, coregatewayvirtualhost.VhostFromListenerColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryVhostToRoute chains the current query on the "vhost_to_route" edge.
+func (_q *CoreGatewayVirtualHostQuery) QueryVhostToRoute() *CoreGatewayHttpRouteQuery {
+	query := (&CoreGatewayHttpRouteClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(coregatewayvirtualhost.Table, coregatewayvirtualhost.FieldID, selector),
+			sqlgraph.To(coregatewayhttproute.Table, coregatewayhttproute.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, coregatewayvirtualhost.VhostToRouteTable, coregatewayvirtualhost.VhostToRouteColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first CoreGatewayVirtualHost entity from the query.
+// Returns a *NotFoundError when no CoreGatewayVirtualHost was found.
+func (_q *CoreGatewayVirtualHostQuery) First(ctx context.Context) (*CoreGatewayVirtualHost, error) {
+	nodes, err := _q.Limit(1).All(setContextOp(ctx, _q.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{coregatewayvirtualhost.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (_q *CoreGatewayVirtualHostQuery) FirstX(ctx context.Context) *CoreGatewayVirtualHost {
+	node, err := _q.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first CoreGatewayVirtualHost ID from the query.
+// Returns a *NotFoundError when no CoreGatewayVirtualHost ID was found.
+func (_q *CoreGatewayVirtualHostQuery) FirstID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = _q.Limit(1).IDs(setContextOp(ctx, _q.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{coregatewayvirtualhost.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (_q *CoreGatewayVirtualHostQuery) FirstIDX(ctx context.Context) string {
+	id, err := _q.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single CoreGatewayVirtualHost entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one CoreGatewayVirtualHost entity is found.
+// Returns a *NotFoundError when no CoreGatewayVirtualHost entities are found.
+func (_q *CoreGatewayVirtualHostQuery) Only(ctx context.Context) (*CoreGatewayVirtualHost, error) {
+	nodes, err := _q.Limit(2).All(setContextOp(ctx, _q.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{coregatewayvirtualhost.Label}
+	default:
+		return nil, &NotSingularError{coregatewayvirtualhost.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (_q *CoreGatewayVirtualHostQuery) OnlyX(ctx context.Context) *CoreGatewayVirtualHost {
+	node, err := _q.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only CoreGatewayVirtualHost ID in the query.
+// Returns a *NotSingularError when more than one CoreGatewayVirtualHost ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (_q *CoreGatewayVirtualHostQuery) OnlyID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = _q.Limit(2).IDs(setContextOp(ctx, _q.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{coregatewayvirtualhost.Label}
+	default:
+		err = &NotSingularError{coregatewayvirtualhost.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (_q *CoreGatewayVirtualHostQuery) OnlyIDX(ctx context.Context) string {
+	id, err := _q.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of CoreGatewayVirtualHosts.
+func (_q *CoreGatewayVirtualHostQuery) All(ctx context.Context) ([]*CoreGatewayVirtualHost, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryAll)
+	if err := _q.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*CoreGatewayVirtualHost, *CoreGatewayVirtualHostQuery]()
+	return withInterceptors[[]*CoreGatewayVirtualHost](ctx, _q, qr, _q.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (_q *CoreGatewayVirtualHostQuery) AllX(ctx context.Context) []*CoreGatewayVirtualHost {
+	nodes, err := _q.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of CoreGatewayVirtualHost IDs.
+func (_q *CoreGatewayVirtualHostQuery) IDs(ctx context.Context) (ids []string, err error) {
+	if _q.ctx.Unique == nil && _q.path != nil {
+		_q.Unique(true)
+	}
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryIDs)
+	if err = _q.Select(coregatewayvirtualhost.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (_q *CoreGatewayVirtualHostQuery) IDsX(ctx context.Context) []string {
+	ids, err := _q.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (_q *CoreGatewayVirtualHostQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryCount)
+	if err := _q.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, _q, querierCount[*CoreGatewayVirtualHostQuery](), _q.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (_q *CoreGatewayVirtualHostQuery) CountX(ctx context.Context) int {
+	count, err := _q.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (_q *CoreGatewayVirtualHostQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryExist)
+	switch _, err := _q.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (_q *CoreGatewayVirtualHostQuery) ExistX(ctx context.Context) bool {
+	exist, err := _q.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the CoreGatewayVirtualHostQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (_q *CoreGatewayVirtualHostQuery) Clone() *CoreGatewayVirtualHostQuery {
+	if _q == nil {
+		return nil
+	}
+	return &CoreGatewayVirtualHostQuery{
+		config:                _q.config,
+		ctx:                   _q.ctx.Clone(),
+		order:                 append([]coregatewayvirtualhost.OrderOption{}, _q.order...),
+		inters:                append([]Interceptor{}, _q.inters...),
+		predicates:            append([]predicate.CoreGatewayVirtualHost{}, _q.predicates...),
+		withVhostFromListener: _q.withVhostFromListener.Clone(),
+		withVhostToRoute:      _q.withVhostToRoute.Clone(),
+		// clone intermediate query.
+		sql:       _q.sql.Clone(),
+		path:      _q.path,
+		modifiers: append([]func(*sql.Selector){}, _q.modifiers...),
+	}
+}
+
+// WithVhostFromListener tells the query-builder to eager-load the nodes that are connected to
+// the "vhost_from_listener" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *CoreGatewayVirtualHostQuery) WithVhostFromListener(opts ...func(*CoreGatewayL7ListenerQuery)) *CoreGatewayVirtualHostQuery {
+	query := (&CoreGatewayL7ListenerClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withVhostFromListener = query
+	return _q
+}
+
+// WithVhostToRoute tells the query-builder to eager-load the nodes that are connected to
+// the "vhost_to_route" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *CoreGatewayVirtualHostQuery) WithVhostToRoute(opts ...func(*CoreGatewayHttpRouteQuery)) *CoreGatewayVirtualHostQuery {
+	query := (&CoreGatewayHttpRouteClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withVhostToRoute = query
+	return _q
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.CoreGatewayVirtualHost.Query().
+//		GroupBy(coregatewayvirtualhost.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (_q *CoreGatewayVirtualHostQuery) GroupBy(field string, fields ...string) *CoreGatewayVirtualHostGroupBy {
+	_q.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &CoreGatewayVirtualHostGroupBy{build: _q}
+	grbuild.flds = &_q.ctx.Fields
+	grbuild.label = coregatewayvirtualhost.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.CoreGatewayVirtualHost.Query().
+//		Select(coregatewayvirtualhost.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (_q *CoreGatewayVirtualHostQuery) Select(fields ...string) *CoreGatewayVirtualHostSelect {
+	_q.ctx.Fields = append(_q.ctx.Fields, fields...)
+	sbuild := &CoreGatewayVirtualHostSelect{CoreGatewayVirtualHostQuery: _q}
+	sbuild.label = coregatewayvirtualhost.Label
+	sbuild.flds, sbuild.scan = &_q.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a CoreGatewayVirtualHostSelect configured with the given aggregations.
+func (_q *CoreGatewayVirtualHostQuery) Aggregate(fns ...AggregateFunc) *CoreGatewayVirtualHostSelect {
+	return _q.Select().Aggregate(fns...)
+}
+
+func (_q *CoreGatewayVirtualHostQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range _q.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, _q); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range _q.ctx.Fields {
+		if !coregatewayvirtualhost.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if _q.path != nil {
+		prev, err := _q.path(ctx)
+		if err != nil {
+			return err
+		}
+		_q.sql = prev
+	}
+	return nil
+}
+
+func (_q *CoreGatewayVirtualHostQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*CoreGatewayVirtualHost, error) {
+	var (
+		nodes       = []*CoreGatewayVirtualHost{}
+		_spec       = _q.querySpec()
+		loadedTypes = [2]bool{
+			_q.withVhostFromListener != nil,
+			_q.withVhostToRoute != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*CoreGatewayVirtualHost).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &CoreGatewayVirtualHost{config: _q.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, _q.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := _q.withVhostFromListener; query != nil {
+		if err := _q.loadVhostFromListener(ctx, query, nodes, nil,
+			func(n *CoreGatewayVirtualHost, e *CoreGatewayL7Listener) { n.Edges.VhostFromListener = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withVhostToRoute; query != nil {
+		if err := _q.loadVhostToRoute(ctx, query, nodes,
+			func(n *CoreGatewayVirtualHost) { n.Edges.VhostToRoute = []*CoreGatewayHttpRoute{} },
+			func(n *CoreGatewayVirtualHost, e *CoreGatewayHttpRoute) {
+				n.Edges.VhostToRoute = append(n.Edges.VhostToRoute, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (_q *CoreGatewayVirtualHostQuery) loadVhostFromListener(ctx context.Context, query *CoreGatewayL7ListenerQuery, nodes []*CoreGatewayVirtualHost, init func(*CoreGatewayVirtualHost), assign func(*CoreGatewayVirtualHost, *CoreGatewayL7Listener)) error {
+	ids := make([]string, 0, len(nodes))
+	nodeids := make(map[string][]*CoreGatewayVirtualHost)
+	for i := range nodes {
+		fk := nodes[i].ListenerID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(coregatewayl7listener.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "listener_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (_q *CoreGatewayVirtualHostQuery) loadVhostToRoute(ctx context.Context, query *CoreGatewayHttpRouteQuery, nodes []*CoreGatewayVirtualHost, init func(*CoreGatewayVirtualHost), assign func(*CoreGatewayVirtualHost, *CoreGatewayHttpRoute)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*CoreGatewayVirtualHost)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(coregatewayhttproute.FieldVirtualHostID)
+	}
+	query.Where(predicate.CoreGatewayHttpRoute(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(coregatewayvirtualhost.VhostToRouteColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.VirtualHostID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "virtual_host_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+
+func (_q *CoreGatewayVirtualHostQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := _q.querySpec()
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
+	_spec.Node.Columns = _q.ctx.Fields
+	if len(_q.ctx.Fields) > 0 {
+		_spec.Unique = _q.ctx.Unique != nil && *_q.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, _q.driver, _spec)
+}
+
+func (_q *CoreGatewayVirtualHostQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(coregatewayvirtualhost.Table, coregatewayvirtualhost.Columns, sqlgraph.NewFieldSpec(coregatewayvirtualhost.FieldID, field.TypeString))
+	_spec.From = _q.sql
+	if unique := _q.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if _q.path != nil {
+		_spec.Unique = true
+	}
+	if fields := _q.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, coregatewayvirtualhost.FieldID)
+		for i := range fields {
+			if fields[i] != coregatewayvirtualhost.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if _q.withVhostFromListener != nil {
+			_spec.Node.AddColumnOnce(coregatewayvirtualhost.FieldListenerID)
+		}
+	}
+	if ps := _q.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := _q.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := _q.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := _q.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (_q *CoreGatewayVirtualHostQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(_q.driver.Dialect())
+	t1 := builder.Table(coregatewayvirtualhost.Table)
+	columns := _q.ctx.Fields
+	if len(columns) == 0 {
+		columns = coregatewayvirtualhost.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if _q.sql != nil {
+		selector = _q.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if _q.ctx.Unique != nil && *_q.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range _q.modifiers {
+		m(selector)
+	}
+	for _, p := range _q.predicates {
+		p(selector)
+	}
+	for _, p := range _q.order {
+		p(selector)
+	}
+	if offset := _q.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := _q.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_q *CoreGatewayVirtualHostQuery) Modify(modifiers ...func(s *sql.Selector)) *CoreGatewayVirtualHostSelect {
+	_q.modifiers = append(_q.modifiers, modifiers...)
+	return _q.Select()
+}
+
+// CoreGatewayVirtualHostGroupBy is the group-by builder for CoreGatewayVirtualHost entities.
+type CoreGatewayVirtualHostGroupBy struct {
+	selector
+	build *CoreGatewayVirtualHostQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (_g *CoreGatewayVirtualHostGroupBy) Aggregate(fns ...AggregateFunc) *CoreGatewayVirtualHostGroupBy {
+	_g.fns = append(_g.fns, fns...)
+	return _g
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (_g *CoreGatewayVirtualHostGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, _g.build.ctx, ent.OpQueryGroupBy)
+	if err := _g.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*CoreGatewayVirtualHostQuery, *CoreGatewayVirtualHostGroupBy](ctx, _g.build, _g, _g.build.inters, v)
+}
+
+func (_g *CoreGatewayVirtualHostGroupBy) sqlScan(ctx context.Context, root *CoreGatewayVirtualHostQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(_g.fns))
+	for _, fn := range _g.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*_g.flds)+len(_g.fns))
+		for _, f := range *_g.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*_g.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := _g.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// CoreGatewayVirtualHostSelect is the builder for selecting fields of CoreGatewayVirtualHost entities.
+type CoreGatewayVirtualHostSelect struct {
+	*CoreGatewayVirtualHostQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (_s *CoreGatewayVirtualHostSelect) Aggregate(fns ...AggregateFunc) *CoreGatewayVirtualHostSelect {
+	_s.fns = append(_s.fns, fns...)
+	return _s
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (_s *CoreGatewayVirtualHostSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, _s.ctx, ent.OpQuerySelect)
+	if err := _s.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*CoreGatewayVirtualHostQuery, *CoreGatewayVirtualHostSelect](ctx, _s.CoreGatewayVirtualHostQuery, _s, _s.inters, v)
+}
+
+func (_s *CoreGatewayVirtualHostSelect) sqlScan(ctx context.Context, root *CoreGatewayVirtualHostQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(_s.fns))
+	for _, fn := range _s.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*_s.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := _s.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_s *CoreGatewayVirtualHostSelect) Modify(modifiers ...func(s *sql.Selector)) *CoreGatewayVirtualHostSelect {
+	_s.modifiers = append(_s.modifiers, modifiers...)
+	return _s
+}
@@ -0,0 +1,949 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/lyonmu/quebec/cmd/core/internal/common"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/coregatewayhttproute"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/coregatewayl7listener"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/coregatewayvirtualhost"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/predicate"
+	"github.com/lyonmu/quebec/pkg/constant"
+)
+
+// CoreGatewayVirtualHostUpdate is the builder for updating CoreGatewayVirtualHost entities.
+type CoreGatewayVirtualHostUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *CoreGatewayVirtualHostMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the CoreGatewayVirtualHostUpdate builder.
+func (_u *CoreGatewayVirtualHostUpdate) Where(ps ...predicate.CoreGatewayVirtualHost) *CoreGatewayVirtualHostUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *CoreGatewayVirtualHostUpdate) SetUpdatedAt(v time.Time) *CoreGatewayVirtualHostUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *CoreGatewayVirtualHostUpdate) SetDeletedAt(v time.Time) *CoreGatewayVirtualHostUpdate {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *CoreGatewayVirtualHostUpdate) SetNillableDeletedAt(v *time.Time) *CoreGatewayVirtualHostUpdate {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *CoreGatewayVirtualHostUpdate) ClearDeletedAt() *CoreGatewayVirtualHostUpdate {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *CoreGatewayVirtualHostUpdate) SetName(v string) *CoreGatewayVirtualHostUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *CoreGatewayVirtualHostUpdate) SetNillableName(v *string) *CoreGatewayVirtualHostUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// ClearName clears the value of the "name" field.
+func (_u *CoreGatewayVirtualHostUpdate) ClearName() *CoreGatewayVirtualHostUpdate {
+	_u.mutation.ClearName()
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *CoreGatewayVirtualHostUpdate) SetDescription(v string) *CoreGatewayVirtualHostUpdate {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *CoreGatewayVirtualHostUpdate) SetNillableDescription(v *string) *CoreGatewayVirtualHostUpdate {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *CoreGatewayVirtualHostUpdate) ClearDescription() *CoreGatewayVirtualHostUpdate {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetListenerID sets the "listener_id" field.
+func (_u *CoreGatewayVirtualHostUpdate) SetListenerID(v string) *CoreGatewayVirtualHostUpdate {
+	_u.mutation.SetListenerID(v)
+	return _u
+}
+
+// SetNillableListenerID sets the "listener_id" field if the given value is not nil.
+func (_u *CoreGatewayVirtualHostUpdate) SetNillableListenerID(v *string) *CoreGatewayVirtualHostUpdate {
+	if v != nil {
+		_u.SetListenerID(*v)
+	}
+	return _u
+}
+
+// ClearListenerID clears the value of the "listener_id" field.
+func (_u *CoreGatewayVirtualHostUpdate) ClearListenerID() *CoreGatewayVirtualHostUpdate {
+	_u.mutation.ClearListenerID()
+	return _u
+}
+
+// SetDomains sets the "domains" field.
+func (_u *CoreGatewayVirtualHostUpdate) SetDomains(v []string) *CoreGatewayVirtualHostUpdate {
+	_u.mutation.SetDomains(v)
+	return _u
+}
+
+// AppendDomains appends value to the "domains" field.
+func (_u *CoreGatewayVirtualHostUpdate) AppendDomains(v []string) *CoreGatewayVirtualHostUpdate {
+	_u.mutation.AppendDomains(v)
+	return _u
+}
+
+// ClearDomains clears the value of the "domains" field.
+func (_u *CoreGatewayVirtualHostUpdate) ClearDomains() *CoreGatewayVirtualHostUpdate {
+	_u.mutation.ClearDomains()
+	return _u
+}
+
+// SetHeaderMutation sets the "header_mutation" field.
+func (_u *CoreGatewayVirtualHostUpdate) SetHeaderMutation(v *common.HeaderMutation) *CoreGatewayVirtualHostUpdate {
+	_u.mutation.SetHeaderMutation(v)
+	return _u
+}
+
+// ClearHeaderMutation clears the value of the "header_mutation" field.
+func (_u *CoreGatewayVirtualHostUpdate) ClearHeaderMutation() *CoreGatewayVirtualHostUpdate {
+	_u.mutation.ClearHeaderMutation()
+	return _u
+}
+
+// SetCorsPolicy sets the "cors_policy" field.
+func (_u *CoreGatewayVirtualHostUpdate) SetCorsPolicy(v *common.CorsPolicy) *CoreGatewayVirtualHostUpdate {
+	_u.mutation.SetCorsPolicy(v)
+	return _u
+}
+
+// ClearCorsPolicy clears the value of the "cors_policy" field.
+func (_u *CoreGatewayVirtualHostUpdate) ClearCorsPolicy() *CoreGatewayVirtualHostUpdate {
+	_u.mutation.ClearCorsPolicy()
+	return _u
+}
+
+// SetMaintenance sets the "maintenance" field.
+func (_u *CoreGatewayVirtualHostUpdate) SetMaintenance(v *common.Maintenance) *CoreGatewayVirtualHostUpdate {
+	_u.mutation.SetMaintenance(v)
+	return _u
+}
+
+// ClearMaintenance clears the value of the "maintenance" field.
+func (_u *CoreGatewayVirtualHostUpdate) ClearMaintenance() *CoreGatewayVirtualHostUpdate {
+	_u.mutation.ClearMaintenance()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *CoreGatewayVirtualHostUpdate) SetStatus(v constant.YesOrNo) *CoreGatewayVirtualHostUpdate {
+	_u.mutation.ResetStatus()
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *CoreGatewayVirtualHostUpdate) SetNillableStatus(v *constant.YesOrNo) *CoreGatewayVirtualHostUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// AddStatus adds value to the "status" field.
+func (_u *CoreGatewayVirtualHostUpdate) AddStatus(v constant.YesOrNo) *CoreGatewayVirtualHostUpdate {
+	_u.mutation.AddStatus(v)
+	return _u
+}
+
+// ClearStatus clears the value of the "status" field.
+func (_u *CoreGatewayVirtualHostUpdate) ClearStatus() *CoreGatewayVirtualHostUpdate {
+	_u.mutation.ClearStatus()
+	return _u
+}
+
+// SetVhostFromListenerID sets the "vhost_from_listener" edge to the CoreGatewayL7Listener entity by ID.
+func (_u *CoreGatewayVirtualHostUpdate) SetVhostFromListenerID(id string) *CoreGatewayVirtualHostUpdate {
+	_u.mutation.SetVhostFromListenerID(id)
+	return _u
+}
+
+// SetNillableVhostFromListenerID sets the "vhost_from_listener" edge to the CoreGatewayL7Listener entity by ID if the given value is not nil.
+func (_u *CoreGatewayVirtualHostUpdate) SetNillableVhostFromListenerID(id *string) *CoreGatewayVirtualHostUpdate {
+	if id != nil {
+		_u = _u.SetVhostFromListenerID(*id)
+	}
+	return _u
+}
+
+// SetVhostFromListener sets the "vhost_from_listener" edge to the CoreGatewayL7Listener entity.
+func (_u *CoreGatewayVirtualHostUpdate) SetVhostFromListener(v *CoreGatewayL7Listener) *CoreGatewayVirtualHostUpdate {
+	return _u.SetVhostFromListenerID(v.ID)
+}
+
+// AddVhostToRouteIDs adds the "vhost_to_route" edge to the CoreGatewayHttpRoute entity by IDs.
+func (_u *CoreGatewayVirtualHostUpdate) AddVhostToRouteIDs(ids ...string) *CoreGatewayVirtualHostUpdate {
+	_u.mutation.AddVhostToRouteIDs(ids...)
+	return _u
+}
+
+// AddVhostToRoute adds the "vhost_to_route" edges to the CoreGatewayHttpRoute entity.
+func (_u *CoreGatewayVirtualHostUpdate) AddVhostToRoute(v ...*CoreGatewayHttpRoute) *CoreGatewayVirtualHostUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddVhostToRouteIDs(ids...)
+}
+
+// Mutation returns the CoreGatewayVirtualHostMutation object of the builder.
+func (_u *CoreGatewayVirtualHostUpdate) Mutation() *CoreGatewayVirtualHostMutation {
+	return _u.mutation
+}
+
+// ClearVhostFromListener clears the "vhost_from_listener" edge to the CoreGatewayL7Listener entity.
+func (_u *CoreGatewayVirtualHostUpdate) ClearVhostFromListener() *CoreGatewayVirtualHostUpdate {
+	_u.mutation.ClearVhostFromListener()
+	return _u
+}
+
+// ClearVhostToRoute clears all "vhost_to_route" edges to the CoreGatewayHttpRoute entity.
+func (_u *CoreGatewayVirtualHostUpdate) ClearVhostToRoute() *CoreGatewayVirtualHostUpdate {
+	_u.mutation.ClearVhostToRoute()
+	return _u
+}
+
+// RemoveVhostToRouteIDs removes the "vhost_to_route" edge to CoreGatewayHttpRoute entities by IDs.
+func (_u *CoreGatewayVirtualHostUpdate) RemoveVhostToRouteIDs(ids ...string) *CoreGatewayVirtualHostUpdate {
+	_u.mutation.RemoveVhostToRouteIDs(ids...)
+	return _u
+}
+
+// RemoveVhostToRoute removes "vhost_to_route" edges to CoreGatewayHttpRoute entities.
+func (_u *CoreGatewayVirtualHostUpdate) RemoveVhostToRoute(v ...*CoreGatewayHttpRoute) *CoreGatewayVirtualHostUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveVhostToRouteIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *CoreGatewayVirtualHostUpdate) Save(ctx context.Context) (int, error) {
+	if err := _u.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *CoreGatewayVirtualHostUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *CoreGatewayVirtualHostUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *CoreGatewayVirtualHostUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *CoreGatewayVirtualHostUpdate) defaults() error {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		if coregatewayvirtualhost.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized coregatewayvirtualhost.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := coregatewayvirtualhost.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *CoreGatewayVirtualHostUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *CoreGatewayVirtualHostUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *CoreGatewayVirtualHostUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(coregatewayvirtualhost.Table, coregatewayvirtualhost.Columns, sqlgraph.NewFieldSpec(coregatewayvirtualhost.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(coregatewayvirtualhost.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(coregatewayvirtualhost.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(coregatewayvirtualhost.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(coregatewayvirtualhost.FieldName, field.TypeString, value)
+	}
+	if _u.mutation.NameCleared() {
+		_spec.ClearField(coregatewayvirtualhost.FieldName, field.TypeString)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(coregatewayvirtualhost.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(coregatewayvirtualhost.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.Domains(); ok {
+		_spec.SetField(coregatewayvirtualhost.FieldDomains, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedDomains(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, coregatewayvirtualhost.FieldDomains, value)
+		})
+	}
+	if _u.mutation.DomainsCleared() {
+		_spec.ClearField(coregatewayvirtualhost.FieldDomains, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.HeaderMutation(); ok {
+		_spec.SetField(coregatewayvirtualhost.FieldHeaderMutation, field.TypeJSON, value)
+	}
+	if _u.mutation.HeaderMutationCleared() {
+		_spec.ClearField(coregatewayvirtualhost.FieldHeaderMutation, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.CorsPolicy(); ok {
+		_spec.SetField(coregatewayvirtualhost.FieldCorsPolicy, field.TypeJSON, value)
+	}
+	if _u.mutation.CorsPolicyCleared() {
+		_spec.ClearField(coregatewayvirtualhost.FieldCorsPolicy, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Maintenance(); ok {
+		_spec.SetField(coregatewayvirtualhost.FieldMaintenance, field.TypeJSON, value)
+	}
+	if _u.mutation.MaintenanceCleared() {
+		_spec.ClearField(coregatewayvirtualhost.FieldMaintenance, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(coregatewayvirtualhost.FieldStatus, field.TypeInt8, value)
+	}
+	if value, ok := _u.mutation.AddedStatus(); ok {
+		_spec.AddField(coregatewayvirtualhost.FieldStatus, field.TypeInt8, value)
+	}
+	if _u.mutation.StatusCleared() {
+		_spec.ClearField(coregatewayvirtualhost.FieldStatus, field.TypeInt8)
+	}
+	if _u.mutation.VhostFromListenerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   coregatewayvirtualhost.VhostFromListenerTable,
+			Columns: []string{coregatewayvirtualhost.VhostFromListenerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(coregatewayl7listener.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.VhostFromListenerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   coregatewayvirtualhost.VhostFromListenerTable,
+			Columns: []string{coregatewayvirtualhost.VhostFromListenerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(coregatewayl7listener.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.VhostToRouteCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   coregatewayvirtualhost.VhostToRouteTable,
+			Columns: []string{coregatewayvirtualhost.VhostToRouteColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(coregatewayhttproute.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedVhostToRouteIDs(); len(nodes) > 0 && !_u.mutation.VhostToRouteCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   coregatewayvirtualhost.VhostToRouteTable,
+			Columns: []string{coregatewayvirtualhost.VhostToRouteColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(coregatewayhttproute.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.VhostToRouteIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   coregatewayvirtualhost.VhostToRouteTable,
+			Columns: []string{coregatewayvirtualhost.VhostToRouteColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(coregatewayhttproute.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{coregatewayvirtualhost.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// CoreGatewayVirtualHostUpdateOne is the builder for updating a single CoreGatewayVirtualHost entity.
+type CoreGatewayVirtualHostUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *CoreGatewayVirtualHostMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *CoreGatewayVirtualHostUpdateOne) SetUpdatedAt(v time.Time) *CoreGatewayVirtualHostUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *CoreGatewayVirtualHostUpdateOne) SetDeletedAt(v time.Time) *CoreGatewayVirtualHostUpdateOne {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *CoreGatewayVirtualHostUpdateOne) SetNillableDeletedAt(v *time.Time) *CoreGatewayVirtualHostUpdateOne {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *CoreGatewayVirtualHostUpdateOne) ClearDeletedAt() *CoreGatewayVirtualHostUpdateOne {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *CoreGatewayVirtualHostUpdateOne) SetName(v string) *CoreGatewayVirtualHostUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *CoreGatewayVirtualHostUpdateOne) SetNillableName(v *string) *CoreGatewayVirtualHostUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// ClearName clears the value of the "name" field.
+func (_u *CoreGatewayVirtualHostUpdateOne) ClearName() *CoreGatewayVirtualHostUpdateOne {
+	_u.mutation.ClearName()
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *CoreGatewayVirtualHostUpdateOne) SetDescription(v string) *CoreGatewayVirtualHostUpdateOne {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *CoreGatewayVirtualHostUpdateOne) SetNillableDescription(v *string) *CoreGatewayVirtualHostUpdateOne {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *CoreGatewayVirtualHostUpdateOne) ClearDescription() *CoreGatewayVirtualHostUpdateOne {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetListenerID sets the "listener_id" field.
+func (_u *CoreGatewayVirtualHostUpdateOne) SetListenerID(v string) *CoreGatewayVirtualHostUpdateOne {
+	_u.mutation.SetListenerID(v)
+	return _u
+}
+
+// SetNillableListenerID sets the "listener_id" field if the given value is not nil.
+func (_u *CoreGatewayVirtualHostUpdateOne) SetNillableListenerID(v *string) *CoreGatewayVirtualHostUpdateOne {
+	if v != nil {
+		_u.SetListenerID(*v)
+	}
+	return _u
+}
+
+// ClearListenerID clears the value of the "listener_id" field.
+func (_u *CoreGatewayVirtualHostUpdateOne) ClearListenerID() *CoreGatewayVirtualHostUpdateOne {
+	_u.mutation.ClearListenerID()
+	return _u
+}
+
+// SetDomains sets the "domains" field.
+func (_u *CoreGatewayVirtualHostUpdateOne) SetDomains(v []string) *CoreGatewayVirtualHostUpdateOne {
+	_u.mutation.SetDomains(v)
+	return _u
+}
+
+// AppendDomains appends value to the "domains" field.
+func (_u *CoreGatewayVirtualHostUpdateOne) AppendDomains(v []string) *CoreGatewayVirtualHostUpdateOne {
+	_u.mutation.AppendDomains(v)
+	return _u
+}
+
+// ClearDomains clears the value of the "domains" field.
+func (_u *CoreGatewayVirtualHostUpdateOne) ClearDomains() *CoreGatewayVirtualHostUpdateOne {
+	_u.mutation.ClearDomains()
+	return _u
+}
+
+// SetHeaderMutation sets the "header_mutation" field.
+func (_u *CoreGatewayVirtualHostUpdateOne) SetHeaderMutation(v *common.HeaderMutation) *CoreGatewayVirtualHostUpdateOne {
+	_u.mutation.SetHeaderMutation(v)
+	return _u
+}
+
+// ClearHeaderMutation clears the value of the "header_mutation" field.
+func (_u *CoreGatewayVirtualHostUpdateOne) ClearHeaderMutation() *CoreGatewayVirtualHostUpdateOne {
+	_u.mutation.ClearHeaderMutation()
+	return _u
+}
+
+// SetCorsPolicy sets the "cors_policy" field.
+func (_u *CoreGatewayVirtualHostUpdateOne) SetCorsPolicy(v *common.CorsPolicy) *CoreGatewayVirtualHostUpdateOne {
+	_u.mutation.SetCorsPolicy(v)
+	return _u
+}
+
+// ClearCorsPolicy clears the value of the "cors_policy" field.
+func (_u *CoreGatewayVirtualHostUpdateOne) ClearCorsPolicy() *CoreGatewayVirtualHostUpdateOne {
+	_u.mutation.ClearCorsPolicy()
+	return _u
+}
+
+// SetMaintenance sets the "maintenance" field.
+func (_u *CoreGatewayVirtualHostUpdateOne) SetMaintenance(v *common.Maintenance) *CoreGatewayVirtualHostUpdateOne {
+	_u.mutation.SetMaintenance(v)
+	return _u
+}
+
+// ClearMaintenance clears the value of the "maintenance" field.
+func (_u *CoreGatewayVirtualHostUpdateOne) ClearMaintenance() *CoreGatewayVirtualHostUpdateOne {
+	_u.mutation.ClearMaintenance()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *CoreGatewayVirtualHostUpdateOne) SetStatus(v constant.YesOrNo) *CoreGatewayVirtualHostUpdateOne {
+	_u.mutation.ResetStatus()
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *CoreGatewayVirtualHostUpdateOne) SetNillableStatus(v *constant.YesOrNo) *CoreGatewayVirtualHostUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// AddStatus adds value to the "status" field.
+func (_u *CoreGatewayVirtualHostUpdateOne) AddStatus(v constant.YesOrNo) *CoreGatewayVirtualHostUpdateOne {
+	_u.mutation.AddStatus(v)
+	return _u
+}
+
+// ClearStatus clears the value of the "status" field.
+func (_u *CoreGatewayVirtualHostUpdateOne) ClearStatus() *CoreGatewayVirtualHostUpdateOne {
+	_u.mutation.ClearStatus()
+	return _u
+}
+
+// SetVhostFromListenerID sets the "vhost_from_listener" edge to the CoreGatewayL7Listener entity by ID.
+func (_u *CoreGatewayVirtualHostUpdateOne) SetVhostFromListenerID(id string) *CoreGatewayVirtualHostUpdateOne {
+	_u.mutation.SetVhostFromListenerID(id)
+	return _u
+}
+
+// SetNillableVhostFromListenerID sets the "vhost_from_listener" edge to the CoreGatewayL7Listener entity by ID if the given value is not nil.
+func (_u *CoreGatewayVirtualHostUpdateOne) SetNillableVhostFromListenerID(id *string) *CoreGatewayVirtualHostUpdateOne {
+	if id != nil {
+		_u = _u.SetVhostFromListenerID(*id)
+	}
+	return _u
+}
+
+// SetVhostFromListener sets the "vhost_from_listener" edge to the CoreGatewayL7Listener entity.
+func (_u *CoreGatewayVirtualHostUpdateOne) SetVhostFromListener(v *CoreGatewayL7Listener) *CoreGatewayVirtualHostUpdateOne {
+	return _u.SetVhostFromListenerID(v.ID)
+}
+
+// AddVhostToRouteIDs adds the "vhost_to_route" edge to the CoreGatewayHttpRoute entity by IDs.
+func (_u *CoreGatewayVirtualHostUpdateOne) AddVhostToRouteIDs(ids ...string) *CoreGatewayVirtualHostUpdateOne {
+	_u.mutation.AddVhostToRouteIDs(ids...)
+	return _u
+}
+
+// AddVhostToRoute adds the "vhost_to_route" edges to the CoreGatewayHttpRoute entity.
+func (_u *CoreGatewayVirtualHostUpdateOne) AddVhostToRoute(v ...*CoreGatewayHttpRoute) *CoreGatewayVirtualHostUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddVhostToRouteIDs(ids...)
+}
+
+// Mutation returns the CoreGatewayVirtualHostMutation object of the builder.
+func (_u *CoreGatewayVirtualHostUpdateOne) Mutation() *CoreGatewayVirtualHostMutation {
+	return _u.mutation
+}
+
+// ClearVhostFromListener clears the "vhost_from_listener" edge to the CoreGatewayL7Listener entity.
+func (_u *CoreGatewayVirtualHostUpdateOne) ClearVhostFromListener() *CoreGatewayVirtualHostUpdateOne {
+	_u.mutation.ClearVhostFromListener()
+	return _u
+}
+
+// ClearVhostToRoute clears all "vhost_to_route" edges to the CoreGatewayHttpRoute entity.
+func (_u *CoreGatewayVirtualHostUpdateOne) ClearVhostToRoute() *CoreGatewayVirtualHostUpdateOne {
+	_u.mutation.ClearVhostToRoute()
+	return _u
+}
+
+// RemoveVhostToRouteIDs removes the "vhost_to_route" edge to CoreGatewayHttpRoute entities by IDs.
+func (_u *CoreGatewayVirtualHostUpdateOne) RemoveVhostToRouteIDs(ids ...string) *CoreGatewayVirtualHostUpdateOne {
+	_u.mutation.RemoveVhostToRouteIDs(ids...)
+	return _u
+}
+
+// RemoveVhostToRoute removes "vhost_to_route" edges to CoreGatewayHttpRoute entities.
+func (_u *CoreGatewayVirtualHostUpdateOne) RemoveVhostToRoute(v ...*CoreGatewayHttpRoute) *CoreGatewayVirtualHostUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveVhostToRouteIDs(ids...)
+}
+
+// Where appends a list predicates to the CoreGatewayVirtualHostUpdate builder.
+func (_u *CoreGatewayVirtualHostUpdateOne) Where(ps ...predicate.CoreGatewayVirtualHost) *CoreGatewayVirtualHostUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *CoreGatewayVirtualHostUpdateOne) Select(field string, fields ...string) *CoreGatewayVirtualHostUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated CoreGatewayVirtualHost entity.
+func (_u *CoreGatewayVirtualHostUpdateOne) Save(ctx context.Context) (*CoreGatewayVirtualHost, error) {
+	if err := _u.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *CoreGatewayVirtualHostUpdateOne) SaveX(ctx context.Context) *CoreGatewayVirtualHost {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *CoreGatewayVirtualHostUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *CoreGatewayVirtualHostUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *CoreGatewayVirtualHostUpdateOne) defaults() error {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		if coregatewayvirtualhost.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized coregatewayvirtualhost.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := coregatewayvirtualhost.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *CoreGatewayVirtualHostUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *CoreGatewayVirtualHostUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *CoreGatewayVirtualHostUpdateOne) sqlSave(ctx context.Context) (_node *CoreGatewayVirtualHost, err error) {
+	_spec := sqlgraph.NewUpdateSpec(coregatewayvirtualhost.Table, coregatewayvirtualhost.Columns, sqlgraph.NewFieldSpec(coregatewayvirtualhost.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "CoreGatewayVirtualHost.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, coregatewayvirtualhost.FieldID)
+		for _, f := range fields {
+			if !coregatewayvirtualhost.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != coregatewayvirtualhost.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(coregatewayvirtualhost.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(coregatewayvirtualhost.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(coregatewayvirtualhost.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(coregatewayvirtualhost.FieldName, field.TypeString, value)
+	}
+	if _u.mutation.NameCleared() {
+		_spec.ClearField(coregatewayvirtualhost.FieldName, field.TypeString)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(coregatewayvirtualhost.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(coregatewayvirtualhost.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.Domains(); ok {
+		_spec.SetField(coregatewayvirtualhost.FieldDomains, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedDomains(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, coregatewayvirtualhost.FieldDomains, value)
+		})
+	}
+	if _u.mutation.DomainsCleared() {
+		_spec.ClearField(coregatewayvirtualhost.FieldDomains, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.HeaderMutation(); ok {
+		_spec.SetField(coregatewayvirtualhost.FieldHeaderMutation, field.TypeJSON, value)
+	}
+	if _u.mutation.HeaderMutationCleared() {
+		_spec.ClearField(coregatewayvirtualhost.FieldHeaderMutation, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.CorsPolicy(); ok {
+		_spec.SetField(coregatewayvirtualhost.FieldCorsPolicy, field.TypeJSON, value)
+	}
+	if _u.mutation.CorsPolicyCleared() {
+		_spec.ClearField(coregatewayvirtualhost.FieldCorsPolicy, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Maintenance(); ok {
+		_spec.SetField(coregatewayvirtualhost.FieldMaintenance, field.TypeJSON, value)
+	}
+	if _u.mutation.MaintenanceCleared() {
+		_spec.ClearField(coregatewayvirtualhost.FieldMaintenance, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(coregatewayvirtualhost.FieldStatus, field.TypeInt8, value)
+	}
+	if value, ok := _u.mutation.AddedStatus(); ok {
+		_spec.AddField(coregatewayvirtualhost.FieldStatus, field.TypeInt8, value)
+	}
+	if _u.mutation.StatusCleared() {
+		_spec.ClearField(coregatewayvirtualhost.FieldStatus, field.TypeInt8)
+	}
+	if _u.mutation.VhostFromListenerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   coregatewayvirtualhost.VhostFromListenerTable,
+			Columns: []string{coregatewayvirtualhost.VhostFromListenerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(coregatewayl7listener.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.VhostFromListenerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   coregatewayvirtualhost.VhostFromListenerTable,
+			Columns: []string{coregatewayvirtualhost.VhostFromListenerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(coregatewayl7listener.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.VhostToRouteCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   coregatewayvirtualhost.VhostToRouteTable,
+			Columns: []string{coregatewayvirtualhost.VhostToRouteColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(coregatewayhttproute.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedVhostToRouteIDs(); len(nodes) > 0 && !_u.mutation.VhostToRouteCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   coregatewayvirtualhost.VhostToRouteTable,
+			Columns: []string{coregatewayvirtualhost.VhostToRouteColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(coregatewayhttproute.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.VhostToRouteIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   coregatewayvirtualhost.VhostToRouteTable,
+			Columns: []string{coregatewayvirtualhost.VhostToRouteColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(coregatewayhttproute.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &CoreGatewayVirtualHost{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{coregatewayvirtualhost.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,210 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/coreproxyrevision"
+	"github.com/lyonmu/quebec/pkg/constant"
+)
+
+// 代理配置修订记录表
+type CoreProxyRevision struct {
+	config `json:"-"`
+	// ID of the ent.
+	// 主键ID
+	ID string `json:"id,omitempty"`
+	// 创建时间
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// 更新时间
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// 删除时间
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// 配置修订号
+	Revision int64 `json:"revision,omitempty"`
+	// 完整代理配置(protojson)
+	Config string `json:"config,omitempty"`
+	// 修订来源: 1-发布 2-回滚
+	Action constant.ProxyRevisionAction `json:"action,omitempty"`
+	// 回滚的目标修订号，仅回滚时有效
+	RollbackFrom int64 `json:"rollback_from,omitempty"`
+	// 发布人ID，为空表示系统检测到的变更
+	AuthorID string `json:"author_id,omitempty"`
+	// 发布人名称
+	AuthorName string `json:"author_name,omitempty"`
+	// 变更摘要
+	Summary      string `json:"summary,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*CoreProxyRevision) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case coreproxyrevision.FieldRevision, coreproxyrevision.FieldAction, coreproxyrevision.FieldRollbackFrom:
+			values[i] = new(sql.NullInt64)
+		case coreproxyrevision.FieldID, coreproxyrevision.FieldConfig, coreproxyrevision.FieldAuthorID, coreproxyrevision.FieldAuthorName, coreproxyrevision.FieldSummary:
+			values[i] = new(sql.NullString)
+		case coreproxyrevision.FieldCreatedAt, coreproxyrevision.FieldUpdatedAt, coreproxyrevision.FieldDeletedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the CoreProxyRevision fields.
+func (_m *CoreProxyRevision) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case coreproxyrevision.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case coreproxyrevision.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case coreproxyrevision.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case coreproxyrevision.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				_m.DeletedAt = new(time.Time)
+				*_m.DeletedAt = value.Time
+			}
+		case coreproxyrevision.FieldRevision:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field revision", values[i])
+			} else if value.Valid {
+				_m.Revision = value.Int64
+			}
+		case coreproxyrevision.FieldConfig:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field config", values[i])
+			} else if value.Valid {
+				_m.Config = value.String
+			}
+		case coreproxyrevision.FieldAction:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field action", values[i])
+			} else if value.Valid {
+				_m.Action = constant.ProxyRevisionAction(value.Int64)
+			}
+		case coreproxyrevision.FieldRollbackFrom:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field rollback_from", values[i])
+			} else if value.Valid {
+				_m.RollbackFrom = value.Int64
+			}
+		case coreproxyrevision.FieldAuthorID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field author_id", values[i])
+			} else if value.Valid {
+				_m.AuthorID = value.String
+			}
+		case coreproxyrevision.FieldAuthorName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field author_name", values[i])
+			} else if value.Valid {
+				_m.AuthorName = value.String
+			}
+		case coreproxyrevision.FieldSummary:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field summary", values[i])
+			} else if value.Valid {
+				_m.Summary = value.String
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the CoreProxyRevision.
+// This includes values selected through modifiers, order, etc.
+func (_m *CoreProxyRevision) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this CoreProxyRevision.
+// Note that you need to call CoreProxyRevision.Unwrap() before calling this method if this CoreProxyRevision
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *CoreProxyRevision) Update() *CoreProxyRevisionUpdateOne {
+	return NewCoreProxyRevisionClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the CoreProxyRevision entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *CoreProxyRevision) Unwrap() *CoreProxyRevision {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: CoreProxyRevision is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *CoreProxyRevision) String() string {
+	var builder strings.Builder
+	builder.WriteString("CoreProxyRevision(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("revision=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Revision))
+	builder.WriteString(", ")
+	builder.WriteString("config=")
+	builder.WriteString(_m.Config)
+	builder.WriteString(", ")
+	builder.WriteString("action=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Action))
+	builder.WriteString(", ")
+	builder.WriteString("rollback_from=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RollbackFrom))
+	builder.WriteString(", ")
+	builder.WriteString("author_id=")
+	builder.WriteString(_m.AuthorID)
+	builder.WriteString(", ")
+	builder.WriteString("author_name=")
+	builder.WriteString(_m.AuthorName)
+	builder.WriteString(", ")
+	builder.WriteString("summary=")
+	builder.WriteString(_m.Summary)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// CoreProxyRevisions is a parsable slice of CoreProxyRevision.
+type CoreProxyRevisions []*CoreProxyRevision
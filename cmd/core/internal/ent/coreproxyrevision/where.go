@@ -0,0 +1,726 @@
+// Code generated by ent, DO NOT EDIT.
+
+package coreproxyrevision
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/predicate"
+	"github.com/lyonmu/quebec/pkg/constant"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.CoreProxyRevision {
+	return predicate.CoreProxyRevision(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.CoreProxyRevision {
+	return predicate.CoreProxyRevision(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.CoreProxyRevision {
+	return predicate.CoreProxyRevision(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.CoreProxyRevision {
+	return predicate.CoreProxyRevision(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.CoreProxyRevision {
+	return predicate.CoreProxyRevision(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.CoreProxyRevision {
+	return predicate.CoreProxyRevision(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.CoreProxyRevision {
+	return predicate.CoreProxyRevision(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.CoreProxyRevision {
+	return predicate.CoreProxyRevision(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.CoreProxyRevision {
+	return predicate.CoreProxyRevision(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.CoreProxyRevision {
+	return predicate.CoreProxyRevision(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.CoreProxyRevision {
+	return predicate.CoreProxyRevision(sql.FieldContainsFold(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.CoreProxyRevision {
+	return predicate.CoreProxyRevision(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.CoreProxyRevision {
+	return predicate.CoreProxyRevision(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.CoreProxyRevision {
+	return predicate.CoreProxyRevision(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// Revision applies equality check predicate on the "revision" field. It's identical to RevisionEQ.
+func Revision(v int64) predicate.CoreProxyRevision {
+	return predicate.CoreProxyRevision(sql.FieldEQ(FieldRevision, v))
+}
+
+// Config applies equality check predicate on the "config" field. It's identical to ConfigEQ.
+func Config(v string) predicate.CoreProxyRevision {
+	return predicate.CoreProxyRevision(sql.FieldEQ(FieldConfig, v))
+}
+
+// Action applies equality check predicate on the "action" field. It's identical to ActionEQ.
+func Action(v constant.ProxyRevisionAction) predicate.CoreProxyRevision {
+	vc := int8(v)
+	return predicate.CoreProxyRevision(sql.FieldEQ(FieldAction, vc))
+}
+
+// RollbackFrom applies equality check predicate on the "rollback_from" field. It's identical to RollbackFromEQ.
+func RollbackFrom(v int64) predicate.CoreProxyRevision {
+	return predicate.CoreProxyRevision(sql.FieldEQ(FieldRollbackFrom, v))
+}
+
+// AuthorID applies equality check predicate on the "author_id" field. It's identical to AuthorIDEQ.
+func AuthorID(v string) predicate.CoreProxyRevision {
+	return predicate.CoreProxyRevision(sql.FieldEQ(FieldAuthorID, v))
+}
+
+// AuthorName applies equality check predicate on the "author_name" field. It's identical to AuthorNameEQ.
+func AuthorName(v string) predicate.CoreProxyRevision {
+	return predicate.CoreProxyRevision(sql.FieldEQ(FieldAuthorName, v))
+}
+
+// Summary applies equality check predicate on the "summary" field. It's identical to SummaryEQ.
+func Summary(v string) predicate.CoreProxyRevision {
+	return predicate.CoreProxyRevision(sql.FieldEQ(FieldSummary, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.CoreProxyRevision {
+	return predicate.CoreProxyRevision(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.CoreProxyRevision {
+	return predicate.CoreProxyRevision(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.CoreProxyRevision {
+	return predicate.CoreProxyRevision(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.CoreProxyRevision {
+	return predicate.CoreProxyRevision(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.CoreProxyRevision {
+	return predicate.CoreProxyRevision(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.CoreProxyRevision {
+	return predicate.CoreProxyRevision(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.CoreProxyRevision {
+	return predicate.CoreProxyRevision(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.CoreProxyRevision {
+	return predicate.CoreProxyRevision(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.CoreProxyRevision {
+	return predicate.CoreProxyRevision(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.CoreProxyRevision {
+	return predicate.CoreProxyRevision(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.CoreProxyRevision {
+	return predicate.CoreProxyRevision(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.CoreProxyRevision {
+	return predicate.CoreProxyRevision(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.CoreProxyRevision {
+	return predicate.CoreProxyRevision(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.CoreProxyRevision {
+	return predicate.CoreProxyRevision(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.CoreProxyRevision {
+	return predicate.CoreProxyRevision(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.CoreProxyRevision {
+	return predicate.CoreProxyRevision(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.CoreProxyRevision {
+	return predicate.CoreProxyRevision(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.CoreProxyRevision {
+	return predicate.CoreProxyRevision(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.CoreProxyRevision {
+	return predicate.CoreProxyRevision(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.CoreProxyRevision {
+	return predicate.CoreProxyRevision(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.CoreProxyRevision {
+	return predicate.CoreProxyRevision(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.CoreProxyRevision {
+	return predicate.CoreProxyRevision(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.CoreProxyRevision {
+	return predicate.CoreProxyRevision(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.CoreProxyRevision {
+	return predicate.CoreProxyRevision(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.CoreProxyRevision {
+	return predicate.CoreProxyRevision(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.CoreProxyRevision {
+	return predicate.CoreProxyRevision(sql.FieldNotNull(FieldDeletedAt))
+}
+
+// RevisionEQ applies the EQ predicate on the "revision" field.
+func RevisionEQ(v int64) predicate.CoreProxyRevision {
+	return predicate.CoreProxyRevision(sql.FieldEQ(FieldRevision, v))
+}
+
+// RevisionNEQ applies the NEQ predicate on the "revision" field.
+func RevisionNEQ(v int64) predicate.CoreProxyRevision {
+	return predicate.CoreProxyRevision(sql.FieldNEQ(FieldRevision, v))
+}
+
+// RevisionIn applies the In predicate on the "revision" field.
+func RevisionIn(vs ...int64) predicate.CoreProxyRevision {
+	return predicate.CoreProxyRevision(sql.FieldIn(FieldRevision, vs...))
+}
+
+// RevisionNotIn applies the NotIn predicate on the "revision" field.
+func RevisionNotIn(vs ...int64) predicate.CoreProxyRevision {
+	return predicate.CoreProxyRevision(sql.FieldNotIn(FieldRevision, vs...))
+}
+
+// RevisionGT applies the GT predicate on the "revision" field.
+func RevisionGT(v int64) predicate.CoreProxyRevision {
+	return predicate.CoreProxyRevision(sql.FieldGT(FieldRevision, v))
+}
+
+// RevisionGTE applies the GTE predicate on the "revision" field.
+func RevisionGTE(v int64) predicate.CoreProxyRevision {
+	return predicate.CoreProxyRevision(sql.FieldGTE(FieldRevision, v))
+}
+
+// RevisionLT applies the LT predicate on the "revision" field.
+func RevisionLT(v int64) predicate.CoreProxyRevision {
+	return predicate.CoreProxyRevision(sql.FieldLT(FieldRevision, v))
+}
+
+// RevisionLTE applies the LTE predicate on the "revision" field.
+func RevisionLTE(v int64) predicate.CoreProxyRevision {
+	return predicate.CoreProxyRevision(sql.FieldLTE(FieldRevision, v))
+}
+
+// RevisionIsNil applies the IsNil predicate on the "revision" field.
+func RevisionIsNil() predicate.CoreProxyRevision {
+	return predicate.CoreProxyRevision(sql.FieldIsNull(FieldRevision))
+}
+
+// RevisionNotNil applies the NotNil predicate on the "revision" field.
+func RevisionNotNil() predicate.CoreProxyRevision {
+	return predicate.CoreProxyRevision(sql.FieldNotNull(FieldRevision))
+}
+
+// ConfigEQ applies the EQ predicate on the "config" field.
+func ConfigEQ(v string) predicate.CoreProxyRevision {
+	return predicate.CoreProxyRevision(sql.FieldEQ(FieldConfig, v))
+}
+
+// ConfigNEQ applies the NEQ predicate on the "config" field.
+func ConfigNEQ(v string) predicate.CoreProxyRevision {
+	return predicate.CoreProxyRevision(sql.FieldNEQ(FieldConfig, v))
+}
+
+// ConfigIn applies the In predicate on the "config" field.
+func ConfigIn(vs ...string) predicate.CoreProxyRevision {
+	return predicate.CoreProxyRevision(sql.FieldIn(FieldConfig, vs...))
+}
+
+// ConfigNotIn applies the NotIn predicate on the "config" field.
+func ConfigNotIn(vs ...string) predicate.CoreProxyRevision {
+	return predicate.CoreProxyRevision(sql.FieldNotIn(FieldConfig, vs...))
+}
+
+// ConfigGT applies the GT predicate on the "config" field.
+func ConfigGT(v string) predicate.CoreProxyRevision {
+	return predicate.CoreProxyRevision(sql.FieldGT(FieldConfig, v))
+}
+
+// ConfigGTE applies the GTE predicate on the "config" field.
+func ConfigGTE(v string) predicate.CoreProxyRevision {
+	return predicate.CoreProxyRevision(sql.FieldGTE(FieldConfig, v))
+}
+
+// ConfigLT applies the LT predicate on the "config" field.
+func ConfigLT(v string) predicate.CoreProxyRevision {
+	return predicate.CoreProxyRevision(sql.FieldLT(FieldConfig, v))
+}
+
+// ConfigLTE applies the LTE predicate on the "config" field.
+func ConfigLTE(v string) predicate.CoreProxyRevision {
+	return predicate.CoreProxyRevision(sql.FieldLTE(FieldConfig, v))
+}
+
+// ConfigContains applies the Contains predicate on the "config" field.
+func ConfigContains(v string) predicate.CoreProxyRevision {
+	return predicate.CoreProxyRevision(sql.FieldContains(FieldConfig, v))
+}
+
+// ConfigHasPrefix applies the HasPrefix predicate on the "config" field.
+func ConfigHasPrefix(v string) predicate.CoreProxyRevision {
+	return predicate.CoreProxyRevision(sql.FieldHasPrefix(FieldConfig, v))
+}
+
+// ConfigHasSuffix applies the HasSuffix predicate on the "config" field.
+func ConfigHasSuffix(v string) predicate.CoreProxyRevision {
+	return predicate.CoreProxyRevision(sql.FieldHasSuffix(FieldConfig, v))
+}
+
+// ConfigIsNil applies the IsNil predicate on the "config" field.
+func ConfigIsNil() predicate.CoreProxyRevision {
+	return predicate.CoreProxyRevision(sql.FieldIsNull(FieldConfig))
+}
+
+// ConfigNotNil applies the NotNil predicate on the "config" field.
+func ConfigNotNil() predicate.CoreProxyRevision {
+	return predicate.CoreProxyRevision(sql.FieldNotNull(FieldConfig))
+}
+
+// ConfigEqualFold applies the EqualFold predicate on the "config" field.
+func ConfigEqualFold(v string) predicate.CoreProxyRevision {
+	return predicate.CoreProxyRevision(sql.FieldEqualFold(FieldConfig, v))
+}
+
+// ConfigContainsFold applies the ContainsFold predicate on the "config" field.
+func ConfigContainsFold(v string) predicate.CoreProxyRevision {
+	return predicate.CoreProxyRevision(sql.FieldContainsFold(FieldConfig, v))
+}
+
+// ActionEQ applies the EQ predicate on the "action" field.
+func ActionEQ(v constant.ProxyRevisionAction) predicate.CoreProxyRevision {
+	vc := int8(v)
+	return predicate.CoreProxyRevision(sql.FieldEQ(FieldAction, vc))
+}
+
+// ActionNEQ applies the NEQ predicate on the "action" field.
+func ActionNEQ(v constant.ProxyRevisionAction) predicate.CoreProxyRevision {
+	vc := int8(v)
+	return predicate.CoreProxyRevision(sql.FieldNEQ(FieldAction, vc))
+}
+
+// ActionIn applies the In predicate on the "action" field.
+func ActionIn(vs ...constant.ProxyRevisionAction) predicate.CoreProxyRevision {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = int8(vs[i])
+	}
+	return predicate.CoreProxyRevision(sql.FieldIn(FieldAction, v...))
+}
+
+// ActionNotIn applies the NotIn predicate on the "action" field.
+func ActionNotIn(vs ...constant.ProxyRevisionAction) predicate.CoreProxyRevision {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = int8(vs[i])
+	}
+	return predicate.CoreProxyRevision(sql.FieldNotIn(FieldAction, v...))
+}
+
+// ActionGT applies the GT predicate on the "action" field.
+func ActionGT(v constant.ProxyRevisionAction) predicate.CoreProxyRevision {
+	vc := int8(v)
+	return predicate.CoreProxyRevision(sql.FieldGT(FieldAction, vc))
+}
+
+// ActionGTE applies the GTE predicate on the "action" field.
+func ActionGTE(v constant.ProxyRevisionAction) predicate.CoreProxyRevision {
+	vc := int8(v)
+	return predicate.CoreProxyRevision(sql.FieldGTE(FieldAction, vc))
+}
+
+// ActionLT applies the LT predicate on the "action" field.
+func ActionLT(v constant.ProxyRevisionAction) predicate.CoreProxyRevision {
+	vc := int8(v)
+	return predicate.CoreProxyRevision(sql.FieldLT(FieldAction, vc))
+}
+
+// ActionLTE applies the LTE predicate on the "action" field.
+func ActionLTE(v constant.ProxyRevisionAction) predicate.CoreProxyRevision {
+	vc := int8(v)
+	return predicate.CoreProxyRevision(sql.FieldLTE(FieldAction, vc))
+}
+
+// ActionIsNil applies the IsNil predicate on the "action" field.
+func ActionIsNil() predicate.CoreProxyRevision {
+	return predicate.CoreProxyRevision(sql.FieldIsNull(FieldAction))
+}
+
+// ActionNotNil applies the NotNil predicate on the "action" field.
+func ActionNotNil() predicate.CoreProxyRevision {
+	return predicate.CoreProxyRevision(sql.FieldNotNull(FieldAction))
+}
+
+// RollbackFromEQ applies the EQ predicate on the "rollback_from" field.
+func RollbackFromEQ(v int64) predicate.CoreProxyRevision {
+	return predicate.CoreProxyRevision(sql.FieldEQ(FieldRollbackFrom, v))
+}
+
+// RollbackFromNEQ applies the NEQ predicate on the "rollback_from" field.
+func RollbackFromNEQ(v int64) predicate.CoreProxyRevision {
+	return predicate.CoreProxyRevision(sql.FieldNEQ(FieldRollbackFrom, v))
+}
+
+// RollbackFromIn applies the In predicate on the "rollback_from" field.
+func RollbackFromIn(vs ...int64) predicate.CoreProxyRevision {
+	return predicate.CoreProxyRevision(sql.FieldIn(FieldRollbackFrom, vs...))
+}
+
+// RollbackFromNotIn applies the NotIn predicate on the "rollback_from" field.
+func RollbackFromNotIn(vs ...int64) predicate.CoreProxyRevision {
+	return predicate.CoreProxyRevision(sql.FieldNotIn(FieldRollbackFrom, vs...))
+}
+
+// RollbackFromGT applies the GT predicate on the "rollback_from" field.
+func RollbackFromGT(v int64) predicate.CoreProxyRevision {
+	return predicate.CoreProxyRevision(sql.FieldGT(FieldRollbackFrom, v))
+}
+
+// RollbackFromGTE applies the GTE predicate on the "rollback_from" field.
+func RollbackFromGTE(v int64) predicate.CoreProxyRevision {
+	return predicate.CoreProxyRevision(sql.FieldGTE(FieldRollbackFrom, v))
+}
+
+// RollbackFromLT applies the LT predicate on the "rollback_from" field.
+func RollbackFromLT(v int64) predicate.CoreProxyRevision {
+	return predicate.CoreProxyRevision(sql.FieldLT(FieldRollbackFrom, v))
+}
+
+// RollbackFromLTE applies the LTE predicate on the "rollback_from" field.
+func RollbackFromLTE(v int64) predicate.CoreProxyRevision {
+	return predicate.CoreProxyRevision(sql.FieldLTE(FieldRollbackFrom, v))
+}
+
+// RollbackFromIsNil applies the IsNil predicate on the "rollback_from" field.
+func RollbackFromIsNil() predicate.CoreProxyRevision {
+	return predicate.CoreProxyRevision(sql.FieldIsNull(FieldRollbackFrom))
+}
+
+// RollbackFromNotNil applies the NotNil predicate on the "rollback_from" field.
+func RollbackFromNotNil() predicate.CoreProxyRevision {
+	return predicate.CoreProxyRevision(sql.FieldNotNull(FieldRollbackFrom))
+}
+
+// AuthorIDEQ applies the EQ predicate on the "author_id" field.
+func AuthorIDEQ(v string) predicate.CoreProxyRevision {
+	return predicate.CoreProxyRevision(sql.FieldEQ(FieldAuthorID, v))
+}
+
+// AuthorIDNEQ applies the NEQ predicate on the "author_id" field.
+func AuthorIDNEQ(v string) predicate.CoreProxyRevision {
+	return predicate.CoreProxyRevision(sql.FieldNEQ(FieldAuthorID, v))
+}
+
+// AuthorIDIn applies the In predicate on the "author_id" field.
+func AuthorIDIn(vs ...string) predicate.CoreProxyRevision {
+	return predicate.CoreProxyRevision(sql.FieldIn(FieldAuthorID, vs...))
+}
+
+// AuthorIDNotIn applies the NotIn predicate on the "author_id" field.
+func AuthorIDNotIn(vs ...string) predicate.CoreProxyRevision {
+	return predicate.CoreProxyRevision(sql.FieldNotIn(FieldAuthorID, vs...))
+}
+
+// AuthorIDGT applies the GT predicate on the "author_id" field.
+func AuthorIDGT(v string) predicate.CoreProxyRevision {
+	return predicate.CoreProxyRevision(sql.FieldGT(FieldAuthorID, v))
+}
+
+// AuthorIDGTE applies the GTE predicate on the "author_id" field.
+func AuthorIDGTE(v string) predicate.CoreProxyRevision {
+	return predicate.CoreProxyRevision(sql.FieldGTE(FieldAuthorID, v))
+}
+
+// AuthorIDLT applies the LT predicate on the "author_id" field.
+func AuthorIDLT(v string) predicate.CoreProxyRevision {
+	return predicate.CoreProxyRevision(sql.FieldLT(FieldAuthorID, v))
+}
+
+// AuthorIDLTE applies the LTE predicate on the "author_id" field.
+func AuthorIDLTE(v string) predicate.CoreProxyRevision {
+	return predicate.CoreProxyRevision(sql.FieldLTE(FieldAuthorID, v))
+}
+
+// AuthorIDContains applies the Contains predicate on the "author_id" field.
+func AuthorIDContains(v string) predicate.CoreProxyRevision {
+	return predicate.CoreProxyRevision(sql.FieldContains(FieldAuthorID, v))
+}
+
+// AuthorIDHasPrefix applies the HasPrefix predicate on the "author_id" field.
+func AuthorIDHasPrefix(v string) predicate.CoreProxyRevision {
+	return predicate.CoreProxyRevision(sql.FieldHasPrefix(FieldAuthorID, v))
+}
+
+// AuthorIDHasSuffix applies the HasSuffix predicate on the "author_id" field.
+func AuthorIDHasSuffix(v string) predicate.CoreProxyRevision {
+	return predicate.CoreProxyRevision(sql.FieldHasSuffix(FieldAuthorID, v))
+}
+
+// AuthorIDIsNil applies the IsNil predicate on the "author_id" field.
+func AuthorIDIsNil() predicate.CoreProxyRevision {
+	return predicate.CoreProxyRevision(sql.FieldIsNull(FieldAuthorID))
+}
+
+// AuthorIDNotNil applies the NotNil predicate on the "author_id" field.
+func AuthorIDNotNil() predicate.CoreProxyRevision {
+	return predicate.CoreProxyRevision(sql.FieldNotNull(FieldAuthorID))
+}
+
+// AuthorIDEqualFold applies the EqualFold predicate on the "author_id" field.
+func AuthorIDEqualFold(v string) predicate.CoreProxyRevision {
+	return predicate.CoreProxyRevision(sql.FieldEqualFold(FieldAuthorID, v))
+}
+
+// AuthorIDContainsFold applies the ContainsFold predicate on the "author_id" field.
+func AuthorIDContainsFold(v string) predicate.CoreProxyRevision {
+	return predicate.CoreProxyRevision(sql.FieldContainsFold(FieldAuthorID, v))
+}
+
+// AuthorNameEQ applies the EQ predicate on the "author_name" field.
+func AuthorNameEQ(v string) predicate.CoreProxyRevision {
+	return predicate.CoreProxyRevision(sql.FieldEQ(FieldAuthorName, v))
+}
+
+// AuthorNameNEQ applies the NEQ predicate on the "author_name" field.
+func AuthorNameNEQ(v string) predicate.CoreProxyRevision {
+	return predicate.CoreProxyRevision(sql.FieldNEQ(FieldAuthorName, v))
+}
+
+// AuthorNameIn applies the In predicate on the "author_name" field.
+func AuthorNameIn(vs ...string) predicate.CoreProxyRevision {
+	return predicate.CoreProxyRevision(sql.FieldIn(FieldAuthorName, vs...))
+}
+
+// AuthorNameNotIn applies the NotIn predicate on the "author_name" field.
+func AuthorNameNotIn(vs ...string) predicate.CoreProxyRevision {
+	return predicate.CoreProxyRevision(sql.FieldNotIn(FieldAuthorName, vs...))
+}
+
+// AuthorNameGT applies the GT predicate on the "author_name" field.
+func AuthorNameGT(v string) predicate.CoreProxyRevision {
+	return predicate.CoreProxyRevision(sql.FieldGT(FieldAuthorName, v))
+}
+
+// AuthorNameGTE applies the GTE predicate on the "author_name" field.
+func AuthorNameGTE(v string) predicate.CoreProxyRevision {
+	return predicate.CoreProxyRevision(sql.FieldGTE(FieldAuthorName, v))
+}
+
+// AuthorNameLT applies the LT predicate on the "author_name" field.
+func AuthorNameLT(v string) predicate.CoreProxyRevision {
+	return predicate.CoreProxyRevision(sql.FieldLT(FieldAuthorName, v))
+}
+
+// AuthorNameLTE applies the LTE predicate on the "author_name" field.
+func AuthorNameLTE(v string) predicate.CoreProxyRevision {
+	return predicate.CoreProxyRevision(sql.FieldLTE(FieldAuthorName, v))
+}
+
+// AuthorNameContains applies the Contains predicate on the "author_name" field.
+func AuthorNameContains(v string) predicate.CoreProxyRevision {
+	return predicate.CoreProxyRevision(sql.FieldContains(FieldAuthorName, v))
+}
+
+// AuthorNameHasPrefix applies the HasPrefix predicate on the "author_name" field.
+func AuthorNameHasPrefix(v string) predicate.CoreProxyRevision {
+	return predicate.CoreProxyRevision(sql.FieldHasPrefix(FieldAuthorName, v))
+}
+
+// AuthorNameHasSuffix applies the HasSuffix predicate on the "author_name" field.
+func AuthorNameHasSuffix(v string) predicate.CoreProxyRevision {
+	return predicate.CoreProxyRevision(sql.FieldHasSuffix(FieldAuthorName, v))
+}
+
+// AuthorNameIsNil applies the IsNil predicate on the "author_name" field.
+func AuthorNameIsNil() predicate.CoreProxyRevision {
+	return predicate.CoreProxyRevision(sql.FieldIsNull(FieldAuthorName))
+}
+
+// AuthorNameNotNil applies the NotNil predicate on the "author_name" field.
+func AuthorNameNotNil() predicate.CoreProxyRevision {
+	return predicate.CoreProxyRevision(sql.FieldNotNull(FieldAuthorName))
+}
+
+// AuthorNameEqualFold applies the EqualFold predicate on the "author_name" field.
+func AuthorNameEqualFold(v string) predicate.CoreProxyRevision {
+	return predicate.CoreProxyRevision(sql.FieldEqualFold(FieldAuthorName, v))
+}
+
+// AuthorNameContainsFold applies the ContainsFold predicate on the "author_name" field.
+func AuthorNameContainsFold(v string) predicate.CoreProxyRevision {
+	return predicate.CoreProxyRevision(sql.FieldContainsFold(FieldAuthorName, v))
+}
+
+// SummaryEQ applies the EQ predicate on the "summary" field.
+func SummaryEQ(v string) predicate.CoreProxyRevision {
+	return predicate.CoreProxyRevision(sql.FieldEQ(FieldSummary, v))
+}
+
+// SummaryNEQ applies the NEQ predicate on the "summary" field.
+func SummaryNEQ(v string) predicate.CoreProxyRevision {
+	return predicate.CoreProxyRevision(sql.FieldNEQ(FieldSummary, v))
+}
+
+// SummaryIn applies the In predicate on the "summary" field.
+func SummaryIn(vs ...string) predicate.CoreProxyRevision {
+	return predicate.CoreProxyRevision(sql.FieldIn(FieldSummary, vs...))
+}
+
+// SummaryNotIn applies the NotIn predicate on the "summary" field.
+func SummaryNotIn(vs ...string) predicate.CoreProxyRevision {
+	return predicate.CoreProxyRevision(sql.FieldNotIn(FieldSummary, vs...))
+}
+
+// SummaryGT applies the GT predicate on the "summary" field.
+func SummaryGT(v string) predicate.CoreProxyRevision {
+	return predicate.CoreProxyRevision(sql.FieldGT(FieldSummary, v))
+}
+
+// SummaryGTE applies the GTE predicate on the "summary" field.
+func SummaryGTE(v string) predicate.CoreProxyRevision {
+	return predicate.CoreProxyRevision(sql.FieldGTE(FieldSummary, v))
+}
+
+// SummaryLT applies the LT predicate on the "summary" field.
+func SummaryLT(v string) predicate.CoreProxyRevision {
+	return predicate.CoreProxyRevision(sql.FieldLT(FieldSummary, v))
+}
+
+// SummaryLTE applies the LTE predicate on the "summary" field.
+func SummaryLTE(v string) predicate.CoreProxyRevision {
+	return predicate.CoreProxyRevision(sql.FieldLTE(FieldSummary, v))
+}
+
+// SummaryContains applies the Contains predicate on the "summary" field.
+func SummaryContains(v string) predicate.CoreProxyRevision {
+	return predicate.CoreProxyRevision(sql.FieldContains(FieldSummary, v))
+}
+
+// SummaryHasPrefix applies the HasPrefix predicate on the "summary" field.
+func SummaryHasPrefix(v string) predicate.CoreProxyRevision {
+	return predicate.CoreProxyRevision(sql.FieldHasPrefix(FieldSummary, v))
+}
+
+// SummaryHasSuffix applies the HasSuffix predicate on the "summary" field.
+func SummaryHasSuffix(v string) predicate.CoreProxyRevision {
+	return predicate.CoreProxyRevision(sql.FieldHasSuffix(FieldSummary, v))
+}
+
+// SummaryIsNil applies the IsNil predicate on the "summary" field.
+func SummaryIsNil() predicate.CoreProxyRevision {
+	return predicate.CoreProxyRevision(sql.FieldIsNull(FieldSummary))
+}
+
+// SummaryNotNil applies the NotNil predicate on the "summary" field.
+func SummaryNotNil() predicate.CoreProxyRevision {
+	return predicate.CoreProxyRevision(sql.FieldNotNull(FieldSummary))
+}
+
+// SummaryEqualFold applies the EqualFold predicate on the "summary" field.
+func SummaryEqualFold(v string) predicate.CoreProxyRevision {
+	return predicate.CoreProxyRevision(sql.FieldEqualFold(FieldSummary, v))
+}
+
+// SummaryContainsFold applies the ContainsFold predicate on the "summary" field.
+func SummaryContainsFold(v string) predicate.CoreProxyRevision {
+	return predicate.CoreProxyRevision(sql.FieldContainsFold(FieldSummary, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.CoreProxyRevision) predicate.CoreProxyRevision {
+	return predicate.CoreProxyRevision(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.CoreProxyRevision) predicate.CoreProxyRevision {
+	return predicate.CoreProxyRevision(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.CoreProxyRevision) predicate.CoreProxyRevision {
+	return predicate.CoreProxyRevision(sql.NotPredicates(p))
+}
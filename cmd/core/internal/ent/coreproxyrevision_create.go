@@ -0,0 +1,819 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/coreproxyrevision"
+	"github.com/lyonmu/quebec/pkg/constant"
+)
+
+// CoreProxyRevisionCreate is the builder for creating a CoreProxyRevision entity.
+type CoreProxyRevisionCreate struct {
+	config
+	mutation *CoreProxyRevisionMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *CoreProxyRevisionCreate) SetCreatedAt(v time.Time) *CoreProxyRevisionCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *CoreProxyRevisionCreate) SetNillableCreatedAt(v *time.Time) *CoreProxyRevisionCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *CoreProxyRevisionCreate) SetUpdatedAt(v time.Time) *CoreProxyRevisionCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *CoreProxyRevisionCreate) SetNillableUpdatedAt(v *time.Time) *CoreProxyRevisionCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_c *CoreProxyRevisionCreate) SetDeletedAt(v time.Time) *CoreProxyRevisionCreate {
+	_c.mutation.SetDeletedAt(v)
+	return _c
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_c *CoreProxyRevisionCreate) SetNillableDeletedAt(v *time.Time) *CoreProxyRevisionCreate {
+	if v != nil {
+		_c.SetDeletedAt(*v)
+	}
+	return _c
+}
+
+// SetRevision sets the "revision" field.
+func (_c *CoreProxyRevisionCreate) SetRevision(v int64) *CoreProxyRevisionCreate {
+	_c.mutation.SetRevision(v)
+	return _c
+}
+
+// SetNillableRevision sets the "revision" field if the given value is not nil.
+func (_c *CoreProxyRevisionCreate) SetNillableRevision(v *int64) *CoreProxyRevisionCreate {
+	if v != nil {
+		_c.SetRevision(*v)
+	}
+	return _c
+}
+
+// SetConfig sets the "config" field.
+func (_c *CoreProxyRevisionCreate) SetConfig(v string) *CoreProxyRevisionCreate {
+	_c.mutation.SetConfig(v)
+	return _c
+}
+
+// SetNillableConfig sets the "config" field if the given value is not nil.
+func (_c *CoreProxyRevisionCreate) SetNillableConfig(v *string) *CoreProxyRevisionCreate {
+	if v != nil {
+		_c.SetConfig(*v)
+	}
+	return _c
+}
+
+// SetAction sets the "action" field.
+func (_c *CoreProxyRevisionCreate) SetAction(v constant.ProxyRevisionAction) *CoreProxyRevisionCreate {
+	_c.mutation.SetAction(v)
+	return _c
+}
+
+// SetNillableAction sets the "action" field if the given value is not nil.
+func (_c *CoreProxyRevisionCreate) SetNillableAction(v *constant.ProxyRevisionAction) *CoreProxyRevisionCreate {
+	if v != nil {
+		_c.SetAction(*v)
+	}
+	return _c
+}
+
+// SetRollbackFrom sets the "rollback_from" field.
+func (_c *CoreProxyRevisionCreate) SetRollbackFrom(v int64) *CoreProxyRevisionCreate {
+	_c.mutation.SetRollbackFrom(v)
+	return _c
+}
+
+// SetNillableRollbackFrom sets the "rollback_from" field if the given value is not nil.
+func (_c *CoreProxyRevisionCreate) SetNillableRollbackFrom(v *int64) *CoreProxyRevisionCreate {
+	if v != nil {
+		_c.SetRollbackFrom(*v)
+	}
+	return _c
+}
+
+// SetAuthorID sets the "author_id" field.
+func (_c *CoreProxyRevisionCreate) SetAuthorID(v string) *CoreProxyRevisionCreate {
+	_c.mutation.SetAuthorID(v)
+	return _c
+}
+
+// SetNillableAuthorID sets the "author_id" field if the given value is not nil.
+func (_c *CoreProxyRevisionCreate) SetNillableAuthorID(v *string) *CoreProxyRevisionCreate {
+	if v != nil {
+		_c.SetAuthorID(*v)
+	}
+	return _c
+}
+
+// SetAuthorName sets the "author_name" field.
+func (_c *CoreProxyRevisionCreate) SetAuthorName(v string) *CoreProxyRevisionCreate {
+	_c.mutation.SetAuthorName(v)
+	return _c
+}
+
+// SetNillableAuthorName sets the "author_name" field if the given value is not nil.
+func (_c *CoreProxyRevisionCreate) SetNillableAuthorName(v *string) *CoreProxyRevisionCreate {
+	if v != nil {
+		_c.SetAuthorName(*v)
+	}
+	return _c
+}
+
+// SetSummary sets the "summary" field.
+func (_c *CoreProxyRevisionCreate) SetSummary(v string) *CoreProxyRevisionCreate {
+	_c.mutation.SetSummary(v)
+	return _c
+}
+
+// SetNillableSummary sets the "summary" field if the given value is not nil.
+func (_c *CoreProxyRevisionCreate) SetNillableSummary(v *string) *CoreProxyRevisionCreate {
+	if v != nil {
+		_c.SetSummary(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *CoreProxyRevisionCreate) SetID(v string) *CoreProxyRevisionCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *CoreProxyRevisionCreate) SetNillableID(v *string) *CoreProxyRevisionCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the CoreProxyRevisionMutation object of the builder.
+func (_c *CoreProxyRevisionCreate) Mutation() *CoreProxyRevisionMutation {
+	return _c.mutation
+}
+
+// Save creates the CoreProxyRevision in the database.
+func (_c *CoreProxyRevisionCreate) Save(ctx context.Context) (*CoreProxyRevision, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *CoreProxyRevisionCreate) SaveX(ctx context.Context) *CoreProxyRevision {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *CoreProxyRevisionCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *CoreProxyRevisionCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *CoreProxyRevisionCreate) defaults() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		if coreproxyrevision.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized coreproxyrevision.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := coreproxyrevision.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		if coreproxyrevision.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized coreproxyrevision.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := coreproxyrevision.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.Action(); !ok {
+		v := coreproxyrevision.DefaultAction
+		_c.mutation.SetAction(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		if coreproxyrevision.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized coreproxyrevision.DefaultID (forgotten import ent/runtime?)")
+		}
+		v := coreproxyrevision.DefaultID()
+		_c.mutation.SetID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *CoreProxyRevisionCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "CoreProxyRevision.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "CoreProxyRevision.updated_at"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := coreproxyrevision.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "CoreProxyRevision.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *CoreProxyRevisionCreate) sqlSave(ctx context.Context) (*CoreProxyRevision, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected CoreProxyRevision.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *CoreProxyRevisionCreate) createSpec() (*CoreProxyRevision, *sqlgraph.CreateSpec) {
+	var (
+		_node = &CoreProxyRevision{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(coreproxyrevision.Table, sqlgraph.NewFieldSpec(coreproxyrevision.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(coreproxyrevision.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(coreproxyrevision.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.DeletedAt(); ok {
+		_spec.SetField(coreproxyrevision.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if value, ok := _c.mutation.Revision(); ok {
+		_spec.SetField(coreproxyrevision.FieldRevision, field.TypeInt64, value)
+		_node.Revision = value
+	}
+	if value, ok := _c.mutation.Config(); ok {
+		_spec.SetField(coreproxyrevision.FieldConfig, field.TypeString, value)
+		_node.Config = value
+	}
+	if value, ok := _c.mutation.Action(); ok {
+		_spec.SetField(coreproxyrevision.FieldAction, field.TypeInt8, value)
+		_node.Action = value
+	}
+	if value, ok := _c.mutation.RollbackFrom(); ok {
+		_spec.SetField(coreproxyrevision.FieldRollbackFrom, field.TypeInt64, value)
+		_node.RollbackFrom = value
+	}
+	if value, ok := _c.mutation.AuthorID(); ok {
+		_spec.SetField(coreproxyrevision.FieldAuthorID, field.TypeString, value)
+		_node.AuthorID = value
+	}
+	if value, ok := _c.mutation.AuthorName(); ok {
+		_spec.SetField(coreproxyrevision.FieldAuthorName, field.TypeString, value)
+		_node.AuthorName = value
+	}
+	if value, ok := _c.mutation.Summary(); ok {
+		_spec.SetField(coreproxyrevision.FieldSummary, field.TypeString, value)
+		_node.Summary = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.CoreProxyRevision.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.CoreProxyRevisionUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *CoreProxyRevisionCreate) OnConflict(opts ...sql.ConflictOption) *CoreProxyRevisionUpsertOne {
+	_c.conflict = opts
+	return &CoreProxyRevisionUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.CoreProxyRevision.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *CoreProxyRevisionCreate) OnConflictColumns(columns ...string) *CoreProxyRevisionUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &CoreProxyRevisionUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// CoreProxyRevisionUpsertOne is the builder for "upsert"-ing
+	//  one CoreProxyRevision node.
+	CoreProxyRevisionUpsertOne struct {
+		create *CoreProxyRevisionCreate
+	}
+
+	// CoreProxyRevisionUpsert is the "OnConflict" setter.
+	CoreProxyRevisionUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *CoreProxyRevisionUpsert) SetUpdatedAt(v time.Time) *CoreProxyRevisionUpsert {
+	u.Set(coreproxyrevision.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *CoreProxyRevisionUpsert) UpdateUpdatedAt() *CoreProxyRevisionUpsert {
+	u.SetExcluded(coreproxyrevision.FieldUpdatedAt)
+	return u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *CoreProxyRevisionUpsert) SetDeletedAt(v time.Time) *CoreProxyRevisionUpsert {
+	u.Set(coreproxyrevision.FieldDeletedAt, v)
+	return u
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *CoreProxyRevisionUpsert) UpdateDeletedAt() *CoreProxyRevisionUpsert {
+	u.SetExcluded(coreproxyrevision.FieldDeletedAt)
+	return u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *CoreProxyRevisionUpsert) ClearDeletedAt() *CoreProxyRevisionUpsert {
+	u.SetNull(coreproxyrevision.FieldDeletedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.CoreProxyRevision.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(coreproxyrevision.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *CoreProxyRevisionUpsertOne) UpdateNewValues() *CoreProxyRevisionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(coreproxyrevision.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(coreproxyrevision.FieldCreatedAt)
+		}
+		if _, exists := u.create.mutation.Revision(); exists {
+			s.SetIgnore(coreproxyrevision.FieldRevision)
+		}
+		if _, exists := u.create.mutation.Config(); exists {
+			s.SetIgnore(coreproxyrevision.FieldConfig)
+		}
+		if _, exists := u.create.mutation.Action(); exists {
+			s.SetIgnore(coreproxyrevision.FieldAction)
+		}
+		if _, exists := u.create.mutation.RollbackFrom(); exists {
+			s.SetIgnore(coreproxyrevision.FieldRollbackFrom)
+		}
+		if _, exists := u.create.mutation.AuthorID(); exists {
+			s.SetIgnore(coreproxyrevision.FieldAuthorID)
+		}
+		if _, exists := u.create.mutation.AuthorName(); exists {
+			s.SetIgnore(coreproxyrevision.FieldAuthorName)
+		}
+		if _, exists := u.create.mutation.Summary(); exists {
+			s.SetIgnore(coreproxyrevision.FieldSummary)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.CoreProxyRevision.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *CoreProxyRevisionUpsertOne) Ignore() *CoreProxyRevisionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *CoreProxyRevisionUpsertOne) DoNothing() *CoreProxyRevisionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the CoreProxyRevisionCreate.OnConflict
+// documentation for more info.
+func (u *CoreProxyRevisionUpsertOne) Update(set func(*CoreProxyRevisionUpsert)) *CoreProxyRevisionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&CoreProxyRevisionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *CoreProxyRevisionUpsertOne) SetUpdatedAt(v time.Time) *CoreProxyRevisionUpsertOne {
+	return u.Update(func(s *CoreProxyRevisionUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *CoreProxyRevisionUpsertOne) UpdateUpdatedAt() *CoreProxyRevisionUpsertOne {
+	return u.Update(func(s *CoreProxyRevisionUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *CoreProxyRevisionUpsertOne) SetDeletedAt(v time.Time) *CoreProxyRevisionUpsertOne {
+	return u.Update(func(s *CoreProxyRevisionUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *CoreProxyRevisionUpsertOne) UpdateDeletedAt() *CoreProxyRevisionUpsertOne {
+	return u.Update(func(s *CoreProxyRevisionUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *CoreProxyRevisionUpsertOne) ClearDeletedAt() *CoreProxyRevisionUpsertOne {
+	return u.Update(func(s *CoreProxyRevisionUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *CoreProxyRevisionUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for CoreProxyRevisionCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *CoreProxyRevisionUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *CoreProxyRevisionUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: CoreProxyRevisionUpsertOne.ID is not supported by MySQL driver. Use CoreProxyRevisionUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *CoreProxyRevisionUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// CoreProxyRevisionCreateBulk is the builder for creating many CoreProxyRevision entities in bulk.
+type CoreProxyRevisionCreateBulk struct {
+	config
+	err      error
+	builders []*CoreProxyRevisionCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the CoreProxyRevision entities in the database.
+func (_c *CoreProxyRevisionCreateBulk) Save(ctx context.Context) ([]*CoreProxyRevision, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*CoreProxyRevision, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*CoreProxyRevisionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *CoreProxyRevisionCreateBulk) SaveX(ctx context.Context) []*CoreProxyRevision {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *CoreProxyRevisionCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *CoreProxyRevisionCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.CoreProxyRevision.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.CoreProxyRevisionUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *CoreProxyRevisionCreateBulk) OnConflict(opts ...sql.ConflictOption) *CoreProxyRevisionUpsertBulk {
+	_c.conflict = opts
+	return &CoreProxyRevisionUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.CoreProxyRevision.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *CoreProxyRevisionCreateBulk) OnConflictColumns(columns ...string) *CoreProxyRevisionUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &CoreProxyRevisionUpsertBulk{
+		create: _c,
+	}
+}
+
+// CoreProxyRevisionUpsertBulk is the builder for "upsert"-ing
+// a bulk of CoreProxyRevision nodes.
+type CoreProxyRevisionUpsertBulk struct {
+	create *CoreProxyRevisionCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.CoreProxyRevision.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(coreproxyrevision.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *CoreProxyRevisionUpsertBulk) UpdateNewValues() *CoreProxyRevisionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(coreproxyrevision.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(coreproxyrevision.FieldCreatedAt)
+			}
+			if _, exists := b.mutation.Revision(); exists {
+				s.SetIgnore(coreproxyrevision.FieldRevision)
+			}
+			if _, exists := b.mutation.Config(); exists {
+				s.SetIgnore(coreproxyrevision.FieldConfig)
+			}
+			if _, exists := b.mutation.Action(); exists {
+				s.SetIgnore(coreproxyrevision.FieldAction)
+			}
+			if _, exists := b.mutation.RollbackFrom(); exists {
+				s.SetIgnore(coreproxyrevision.FieldRollbackFrom)
+			}
+			if _, exists := b.mutation.AuthorID(); exists {
+				s.SetIgnore(coreproxyrevision.FieldAuthorID)
+			}
+			if _, exists := b.mutation.AuthorName(); exists {
+				s.SetIgnore(coreproxyrevision.FieldAuthorName)
+			}
+			if _, exists := b.mutation.Summary(); exists {
+				s.SetIgnore(coreproxyrevision.FieldSummary)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.CoreProxyRevision.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *CoreProxyRevisionUpsertBulk) Ignore() *CoreProxyRevisionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *CoreProxyRevisionUpsertBulk) DoNothing() *CoreProxyRevisionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the CoreProxyRevisionCreateBulk.OnConflict
+// documentation for more info.
+func (u *CoreProxyRevisionUpsertBulk) Update(set func(*CoreProxyRevisionUpsert)) *CoreProxyRevisionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&CoreProxyRevisionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *CoreProxyRevisionUpsertBulk) SetUpdatedAt(v time.Time) *CoreProxyRevisionUpsertBulk {
+	return u.Update(func(s *CoreProxyRevisionUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *CoreProxyRevisionUpsertBulk) UpdateUpdatedAt() *CoreProxyRevisionUpsertBulk {
+	return u.Update(func(s *CoreProxyRevisionUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *CoreProxyRevisionUpsertBulk) SetDeletedAt(v time.Time) *CoreProxyRevisionUpsertBulk {
+	return u.Update(func(s *CoreProxyRevisionUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *CoreProxyRevisionUpsertBulk) UpdateDeletedAt() *CoreProxyRevisionUpsertBulk {
+	return u.Update(func(s *CoreProxyRevisionUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *CoreProxyRevisionUpsertBulk) ClearDeletedAt() *CoreProxyRevisionUpsertBulk {
+	return u.Update(func(s *CoreProxyRevisionUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *CoreProxyRevisionUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the CoreProxyRevisionCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for CoreProxyRevisionCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *CoreProxyRevisionUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
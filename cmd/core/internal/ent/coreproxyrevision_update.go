@@ -0,0 +1,334 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/coreproxyrevision"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/predicate"
+)
+
+// CoreProxyRevisionUpdate is the builder for updating CoreProxyRevision entities.
+type CoreProxyRevisionUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *CoreProxyRevisionMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the CoreProxyRevisionUpdate builder.
+func (_u *CoreProxyRevisionUpdate) Where(ps ...predicate.CoreProxyRevision) *CoreProxyRevisionUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *CoreProxyRevisionUpdate) SetUpdatedAt(v time.Time) *CoreProxyRevisionUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *CoreProxyRevisionUpdate) SetDeletedAt(v time.Time) *CoreProxyRevisionUpdate {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *CoreProxyRevisionUpdate) SetNillableDeletedAt(v *time.Time) *CoreProxyRevisionUpdate {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *CoreProxyRevisionUpdate) ClearDeletedAt() *CoreProxyRevisionUpdate {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// Mutation returns the CoreProxyRevisionMutation object of the builder.
+func (_u *CoreProxyRevisionUpdate) Mutation() *CoreProxyRevisionMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *CoreProxyRevisionUpdate) Save(ctx context.Context) (int, error) {
+	if err := _u.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *CoreProxyRevisionUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *CoreProxyRevisionUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *CoreProxyRevisionUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *CoreProxyRevisionUpdate) defaults() error {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		if coreproxyrevision.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized coreproxyrevision.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := coreproxyrevision.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *CoreProxyRevisionUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *CoreProxyRevisionUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *CoreProxyRevisionUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(coreproxyrevision.Table, coreproxyrevision.Columns, sqlgraph.NewFieldSpec(coreproxyrevision.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(coreproxyrevision.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(coreproxyrevision.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(coreproxyrevision.FieldDeletedAt, field.TypeTime)
+	}
+	if _u.mutation.RevisionCleared() {
+		_spec.ClearField(coreproxyrevision.FieldRevision, field.TypeInt64)
+	}
+	if _u.mutation.ConfigCleared() {
+		_spec.ClearField(coreproxyrevision.FieldConfig, field.TypeString)
+	}
+	if _u.mutation.ActionCleared() {
+		_spec.ClearField(coreproxyrevision.FieldAction, field.TypeInt8)
+	}
+	if _u.mutation.RollbackFromCleared() {
+		_spec.ClearField(coreproxyrevision.FieldRollbackFrom, field.TypeInt64)
+	}
+	if _u.mutation.AuthorIDCleared() {
+		_spec.ClearField(coreproxyrevision.FieldAuthorID, field.TypeString)
+	}
+	if _u.mutation.AuthorNameCleared() {
+		_spec.ClearField(coreproxyrevision.FieldAuthorName, field.TypeString)
+	}
+	if _u.mutation.SummaryCleared() {
+		_spec.ClearField(coreproxyrevision.FieldSummary, field.TypeString)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{coreproxyrevision.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// CoreProxyRevisionUpdateOne is the builder for updating a single CoreProxyRevision entity.
+type CoreProxyRevisionUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *CoreProxyRevisionMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *CoreProxyRevisionUpdateOne) SetUpdatedAt(v time.Time) *CoreProxyRevisionUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *CoreProxyRevisionUpdateOne) SetDeletedAt(v time.Time) *CoreProxyRevisionUpdateOne {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *CoreProxyRevisionUpdateOne) SetNillableDeletedAt(v *time.Time) *CoreProxyRevisionUpdateOne {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *CoreProxyRevisionUpdateOne) ClearDeletedAt() *CoreProxyRevisionUpdateOne {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// Mutation returns the CoreProxyRevisionMutation object of the builder.
+func (_u *CoreProxyRevisionUpdateOne) Mutation() *CoreProxyRevisionMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the CoreProxyRevisionUpdate builder.
+func (_u *CoreProxyRevisionUpdateOne) Where(ps ...predicate.CoreProxyRevision) *CoreProxyRevisionUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *CoreProxyRevisionUpdateOne) Select(field string, fields ...string) *CoreProxyRevisionUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated CoreProxyRevision entity.
+func (_u *CoreProxyRevisionUpdateOne) Save(ctx context.Context) (*CoreProxyRevision, error) {
+	if err := _u.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *CoreProxyRevisionUpdateOne) SaveX(ctx context.Context) *CoreProxyRevision {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *CoreProxyRevisionUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *CoreProxyRevisionUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *CoreProxyRevisionUpdateOne) defaults() error {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		if coreproxyrevision.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized coreproxyrevision.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := coreproxyrevision.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *CoreProxyRevisionUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *CoreProxyRevisionUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *CoreProxyRevisionUpdateOne) sqlSave(ctx context.Context) (_node *CoreProxyRevision, err error) {
+	_spec := sqlgraph.NewUpdateSpec(coreproxyrevision.Table, coreproxyrevision.Columns, sqlgraph.NewFieldSpec(coreproxyrevision.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "CoreProxyRevision.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, coreproxyrevision.FieldID)
+		for _, f := range fields {
+			if !coreproxyrevision.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != coreproxyrevision.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(coreproxyrevision.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(coreproxyrevision.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(coreproxyrevision.FieldDeletedAt, field.TypeTime)
+	}
+	if _u.mutation.RevisionCleared() {
+		_spec.ClearField(coreproxyrevision.FieldRevision, field.TypeInt64)
+	}
+	if _u.mutation.ConfigCleared() {
+		_spec.ClearField(coreproxyrevision.FieldConfig, field.TypeString)
+	}
+	if _u.mutation.ActionCleared() {
+		_spec.ClearField(coreproxyrevision.FieldAction, field.TypeInt8)
+	}
+	if _u.mutation.RollbackFromCleared() {
+		_spec.ClearField(coreproxyrevision.FieldRollbackFrom, field.TypeInt64)
+	}
+	if _u.mutation.AuthorIDCleared() {
+		_spec.ClearField(coreproxyrevision.FieldAuthorID, field.TypeString)
+	}
+	if _u.mutation.AuthorNameCleared() {
+		_spec.ClearField(coreproxyrevision.FieldAuthorName, field.TypeString)
+	}
+	if _u.mutation.SummaryCleared() {
+		_spec.ClearField(coreproxyrevision.FieldSummary, field.TypeString)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &CoreProxyRevision{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{coreproxyrevision.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
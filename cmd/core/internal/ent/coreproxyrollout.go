@@ -0,0 +1,297 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/lyonmu/quebec/cmd/core/internal/common"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/coreproxyrollout"
+	"github.com/lyonmu/quebec/pkg/constant"
+)
+
+// 灰度发布记录表
+type CoreProxyRollout struct {
+	config `json:"-"`
+	// ID of the ent.
+	// 主键ID
+	ID string `json:"id,omitempty"`
+	// 创建时间
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// 更新时间
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// 删除时间
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// 网关集群ID (Envoy node.cluster)
+	ClusterID string `json:"cluster_id,omitempty"`
+	// 灰度中的配置修订号
+	Revision int64 `json:"revision,omitempty"`
+	// 非灰度节点使用的稳定修订号
+	StableRevision int64 `json:"stable_revision,omitempty"`
+	// 灰度节点 (Envoy node.id)
+	CanaryNodeIds []string `json:"canary_node_ids,omitempty"`
+	// 灰度开始时各灰度节点的累计流量，用于计算灰度期间的错误率
+	Baseline map[string]common.TrafficCounter `json:"baseline,omitempty"`
+	// 观察时长(秒)
+	SoakSeconds int `json:"soak_seconds,omitempty"`
+	// 允许的最大错误率(%)
+	MaxErrorRate float64 `json:"max_error_rate,omitempty"`
+	// 评估错误率所需的最少请求数
+	MinRequests int64 `json:"min_requests,omitempty"`
+	// 灰度期间灰度节点的请求数
+	Requests int64 `json:"requests,omitempty"`
+	// 灰度期间灰度节点的 5xx 响应数
+	Errors int64 `json:"errors,omitempty"`
+	// 状态: 1-灰度中 2-已全量 3-已中止 4-已被取代
+	Status constant.ProxyRolloutStatus `json:"status,omitempty"`
+	// 结束原因
+	Reason string `json:"reason,omitempty"`
+	// 开始时间
+	StartedAt int64 `json:"started_at,omitempty"`
+	// 结束时间
+	FinishedAt   int64 `json:"finished_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*CoreProxyRollout) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case coreproxyrollout.FieldCanaryNodeIds, coreproxyrollout.FieldBaseline:
+			values[i] = new([]byte)
+		case coreproxyrollout.FieldMaxErrorRate:
+			values[i] = new(sql.NullFloat64)
+		case coreproxyrollout.FieldRevision, coreproxyrollout.FieldStableRevision, coreproxyrollout.FieldSoakSeconds, coreproxyrollout.FieldMinRequests, coreproxyrollout.FieldRequests, coreproxyrollout.FieldErrors, coreproxyrollout.FieldStatus, coreproxyrollout.FieldStartedAt, coreproxyrollout.FieldFinishedAt:
+			values[i] = new(sql.NullInt64)
+		case coreproxyrollout.FieldID, coreproxyrollout.FieldClusterID, coreproxyrollout.FieldReason:
+			values[i] = new(sql.NullString)
+		case coreproxyrollout.FieldCreatedAt, coreproxyrollout.FieldUpdatedAt, coreproxyrollout.FieldDeletedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the CoreProxyRollout fields.
+func (_m *CoreProxyRollout) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case coreproxyrollout.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case coreproxyrollout.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case coreproxyrollout.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case coreproxyrollout.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				_m.DeletedAt = new(time.Time)
+				*_m.DeletedAt = value.Time
+			}
+		case coreproxyrollout.FieldClusterID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field cluster_id", values[i])
+			} else if value.Valid {
+				_m.ClusterID = value.String
+			}
+		case coreproxyrollout.FieldRevision:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field revision", values[i])
+			} else if value.Valid {
+				_m.Revision = value.Int64
+			}
+		case coreproxyrollout.FieldStableRevision:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field stable_revision", values[i])
+			} else if value.Valid {
+				_m.StableRevision = value.Int64
+			}
+		case coreproxyrollout.FieldCanaryNodeIds:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field canary_node_ids", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.CanaryNodeIds); err != nil {
+					return fmt.Errorf("unmarshal field canary_node_ids: %w", err)
+				}
+			}
+		case coreproxyrollout.FieldBaseline:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field baseline", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Baseline); err != nil {
+					return fmt.Errorf("unmarshal field baseline: %w", err)
+				}
+			}
+		case coreproxyrollout.FieldSoakSeconds:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field soak_seconds", values[i])
+			} else if value.Valid {
+				_m.SoakSeconds = int(value.Int64)
+			}
+		case coreproxyrollout.FieldMaxErrorRate:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field max_error_rate", values[i])
+			} else if value.Valid {
+				_m.MaxErrorRate = value.Float64
+			}
+		case coreproxyrollout.FieldMinRequests:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field min_requests", values[i])
+			} else if value.Valid {
+				_m.MinRequests = value.Int64
+			}
+		case coreproxyrollout.FieldRequests:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field requests", values[i])
+			} else if value.Valid {
+				_m.Requests = value.Int64
+			}
+		case coreproxyrollout.FieldErrors:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field errors", values[i])
+			} else if value.Valid {
+				_m.Errors = value.Int64
+			}
+		case coreproxyrollout.FieldStatus:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = constant.ProxyRolloutStatus(value.Int64)
+			}
+		case coreproxyrollout.FieldReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reason", values[i])
+			} else if value.Valid {
+				_m.Reason = value.String
+			}
+		case coreproxyrollout.FieldStartedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field started_at", values[i])
+			} else if value.Valid {
+				_m.StartedAt = value.Int64
+			}
+		case coreproxyrollout.FieldFinishedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field finished_at", values[i])
+			} else if value.Valid {
+				_m.FinishedAt = value.Int64
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the CoreProxyRollout.
+// This includes values selected through modifiers, order, etc.
+func (_m *CoreProxyRollout) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this CoreProxyRollout.
+// Note that you need to call CoreProxyRollout.Unwrap() before calling this method if this CoreProxyRollout
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *CoreProxyRollout) Update() *CoreProxyRolloutUpdateOne {
+	return NewCoreProxyRolloutClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the CoreProxyRollout entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *CoreProxyRollout) Unwrap() *CoreProxyRollout {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: CoreProxyRollout is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *CoreProxyRollout) String() string {
+	var builder strings.Builder
+	builder.WriteString("CoreProxyRollout(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("cluster_id=")
+	builder.WriteString(_m.ClusterID)
+	builder.WriteString(", ")
+	builder.WriteString("revision=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Revision))
+	builder.WriteString(", ")
+	builder.WriteString("stable_revision=")
+	builder.WriteString(fmt.Sprintf("%v", _m.StableRevision))
+	builder.WriteString(", ")
+	builder.WriteString("canary_node_ids=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CanaryNodeIds))
+	builder.WriteString(", ")
+	builder.WriteString("baseline=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Baseline))
+	builder.WriteString(", ")
+	builder.WriteString("soak_seconds=")
+	builder.WriteString(fmt.Sprintf("%v", _m.SoakSeconds))
+	builder.WriteString(", ")
+	builder.WriteString("max_error_rate=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MaxErrorRate))
+	builder.WriteString(", ")
+	builder.WriteString("min_requests=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MinRequests))
+	builder.WriteString(", ")
+	builder.WriteString("requests=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Requests))
+	builder.WriteString(", ")
+	builder.WriteString("errors=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Errors))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteString(", ")
+	builder.WriteString("reason=")
+	builder.WriteString(_m.Reason)
+	builder.WriteString(", ")
+	builder.WriteString("started_at=")
+	builder.WriteString(fmt.Sprintf("%v", _m.StartedAt))
+	builder.WriteString(", ")
+	builder.WriteString("finished_at=")
+	builder.WriteString(fmt.Sprintf("%v", _m.FinishedAt))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// CoreProxyRollouts is a parsable slice of CoreProxyRollout.
+type CoreProxyRollouts []*CoreProxyRollout
@@ -0,0 +1,190 @@
+// Code generated by ent, DO NOT EDIT.
+
+package coreproxyrollout
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/lyonmu/quebec/pkg/constant"
+)
+
+const (
+	// Label holds the string label denoting the coreproxyrollout type in the database.
+	Label = "core_proxy_rollout"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
+	FieldDeletedAt = "deleted_at"
+	// FieldClusterID holds the string denoting the cluster_id field in the database.
+	FieldClusterID = "cluster_id"
+	// FieldRevision holds the string denoting the revision field in the database.
+	FieldRevision = "revision"
+	// FieldStableRevision holds the string denoting the stable_revision field in the database.
+	FieldStableRevision = "stable_revision"
+	// FieldCanaryNodeIds holds the string denoting the canary_node_ids field in the database.
+	FieldCanaryNodeIds = "canary_node_ids"
+	// FieldBaseline holds the string denoting the baseline field in the database.
+	FieldBaseline = "baseline"
+	// FieldSoakSeconds holds the string denoting the soak_seconds field in the database.
+	FieldSoakSeconds = "soak_seconds"
+	// FieldMaxErrorRate holds the string denoting the max_error_rate field in the database.
+	FieldMaxErrorRate = "max_error_rate"
+	// FieldMinRequests holds the string denoting the min_requests field in the database.
+	FieldMinRequests = "min_requests"
+	// FieldRequests holds the string denoting the requests field in the database.
+	FieldRequests = "requests"
+	// FieldErrors holds the string denoting the errors field in the database.
+	FieldErrors = "errors"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldReason holds the string denoting the reason field in the database.
+	FieldReason = "reason"
+	// FieldStartedAt holds the string denoting the started_at field in the database.
+	FieldStartedAt = "started_at"
+	// FieldFinishedAt holds the string denoting the finished_at field in the database.
+	FieldFinishedAt = "finished_at"
+	// Table holds the table name of the coreproxyrollout in the database.
+	Table = "quebec_core_proxy_rollout"
+)
+
+// Columns holds all SQL columns for coreproxyrollout fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldDeletedAt,
+	FieldClusterID,
+	FieldRevision,
+	FieldStableRevision,
+	FieldCanaryNodeIds,
+	FieldBaseline,
+	FieldSoakSeconds,
+	FieldMaxErrorRate,
+	FieldMinRequests,
+	FieldRequests,
+	FieldErrors,
+	FieldStatus,
+	FieldReason,
+	FieldStartedAt,
+	FieldFinishedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "github.com/lyonmu/quebec/cmd/core/internal/ent/runtime"
+var (
+	Hooks [2]ent.Hook
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultStatus holds the default value on creation for the "status" field.
+	DefaultStatus constant.ProxyRolloutStatus
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() string
+	// IDValidator is a validator for the "id" field. It is called by the builders before save.
+	IDValidator func(string) error
+)
+
+// OrderOption defines the ordering options for the CoreProxyRollout queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByDeletedAt orders the results by the deleted_at field.
+func ByDeletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletedAt, opts...).ToFunc()
+}
+
+// ByClusterID orders the results by the cluster_id field.
+func ByClusterID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldClusterID, opts...).ToFunc()
+}
+
+// ByRevision orders the results by the revision field.
+func ByRevision(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRevision, opts...).ToFunc()
+}
+
+// ByStableRevision orders the results by the stable_revision field.
+func ByStableRevision(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStableRevision, opts...).ToFunc()
+}
+
+// BySoakSeconds orders the results by the soak_seconds field.
+func BySoakSeconds(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSoakSeconds, opts...).ToFunc()
+}
+
+// ByMaxErrorRate orders the results by the max_error_rate field.
+func ByMaxErrorRate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMaxErrorRate, opts...).ToFunc()
+}
+
+// ByMinRequests orders the results by the min_requests field.
+func ByMinRequests(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMinRequests, opts...).ToFunc()
+}
+
+// ByRequests orders the results by the requests field.
+func ByRequests(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRequests, opts...).ToFunc()
+}
+
+// ByErrors orders the results by the errors field.
+func ByErrors(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldErrors, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByReason orders the results by the reason field.
+func ByReason(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReason, opts...).ToFunc()
+}
+
+// ByStartedAt orders the results by the started_at field.
+func ByStartedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStartedAt, opts...).ToFunc()
+}
+
+// ByFinishedAt orders the results by the finished_at field.
+func ByFinishedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFinishedAt, opts...).ToFunc()
+}
@@ -0,0 +1,971 @@
+// Code generated by ent, DO NOT EDIT.
+
+package coreproxyrollout
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/predicate"
+	"github.com/lyonmu/quebec/pkg/constant"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldContainsFold(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// ClusterID applies equality check predicate on the "cluster_id" field. It's identical to ClusterIDEQ.
+func ClusterID(v string) predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldEQ(FieldClusterID, v))
+}
+
+// Revision applies equality check predicate on the "revision" field. It's identical to RevisionEQ.
+func Revision(v int64) predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldEQ(FieldRevision, v))
+}
+
+// StableRevision applies equality check predicate on the "stable_revision" field. It's identical to StableRevisionEQ.
+func StableRevision(v int64) predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldEQ(FieldStableRevision, v))
+}
+
+// SoakSeconds applies equality check predicate on the "soak_seconds" field. It's identical to SoakSecondsEQ.
+func SoakSeconds(v int) predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldEQ(FieldSoakSeconds, v))
+}
+
+// MaxErrorRate applies equality check predicate on the "max_error_rate" field. It's identical to MaxErrorRateEQ.
+func MaxErrorRate(v float64) predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldEQ(FieldMaxErrorRate, v))
+}
+
+// MinRequests applies equality check predicate on the "min_requests" field. It's identical to MinRequestsEQ.
+func MinRequests(v int64) predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldEQ(FieldMinRequests, v))
+}
+
+// Requests applies equality check predicate on the "requests" field. It's identical to RequestsEQ.
+func Requests(v int64) predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldEQ(FieldRequests, v))
+}
+
+// Errors applies equality check predicate on the "errors" field. It's identical to ErrorsEQ.
+func Errors(v int64) predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldEQ(FieldErrors, v))
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v constant.ProxyRolloutStatus) predicate.CoreProxyRollout {
+	vc := int8(v)
+	return predicate.CoreProxyRollout(sql.FieldEQ(FieldStatus, vc))
+}
+
+// Reason applies equality check predicate on the "reason" field. It's identical to ReasonEQ.
+func Reason(v string) predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldEQ(FieldReason, v))
+}
+
+// StartedAt applies equality check predicate on the "started_at" field. It's identical to StartedAtEQ.
+func StartedAt(v int64) predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldEQ(FieldStartedAt, v))
+}
+
+// FinishedAt applies equality check predicate on the "finished_at" field. It's identical to FinishedAtEQ.
+func FinishedAt(v int64) predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldEQ(FieldFinishedAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldNotNull(FieldDeletedAt))
+}
+
+// ClusterIDEQ applies the EQ predicate on the "cluster_id" field.
+func ClusterIDEQ(v string) predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldEQ(FieldClusterID, v))
+}
+
+// ClusterIDNEQ applies the NEQ predicate on the "cluster_id" field.
+func ClusterIDNEQ(v string) predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldNEQ(FieldClusterID, v))
+}
+
+// ClusterIDIn applies the In predicate on the "cluster_id" field.
+func ClusterIDIn(vs ...string) predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldIn(FieldClusterID, vs...))
+}
+
+// ClusterIDNotIn applies the NotIn predicate on the "cluster_id" field.
+func ClusterIDNotIn(vs ...string) predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldNotIn(FieldClusterID, vs...))
+}
+
+// ClusterIDGT applies the GT predicate on the "cluster_id" field.
+func ClusterIDGT(v string) predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldGT(FieldClusterID, v))
+}
+
+// ClusterIDGTE applies the GTE predicate on the "cluster_id" field.
+func ClusterIDGTE(v string) predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldGTE(FieldClusterID, v))
+}
+
+// ClusterIDLT applies the LT predicate on the "cluster_id" field.
+func ClusterIDLT(v string) predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldLT(FieldClusterID, v))
+}
+
+// ClusterIDLTE applies the LTE predicate on the "cluster_id" field.
+func ClusterIDLTE(v string) predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldLTE(FieldClusterID, v))
+}
+
+// ClusterIDContains applies the Contains predicate on the "cluster_id" field.
+func ClusterIDContains(v string) predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldContains(FieldClusterID, v))
+}
+
+// ClusterIDHasPrefix applies the HasPrefix predicate on the "cluster_id" field.
+func ClusterIDHasPrefix(v string) predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldHasPrefix(FieldClusterID, v))
+}
+
+// ClusterIDHasSuffix applies the HasSuffix predicate on the "cluster_id" field.
+func ClusterIDHasSuffix(v string) predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldHasSuffix(FieldClusterID, v))
+}
+
+// ClusterIDIsNil applies the IsNil predicate on the "cluster_id" field.
+func ClusterIDIsNil() predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldIsNull(FieldClusterID))
+}
+
+// ClusterIDNotNil applies the NotNil predicate on the "cluster_id" field.
+func ClusterIDNotNil() predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldNotNull(FieldClusterID))
+}
+
+// ClusterIDEqualFold applies the EqualFold predicate on the "cluster_id" field.
+func ClusterIDEqualFold(v string) predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldEqualFold(FieldClusterID, v))
+}
+
+// ClusterIDContainsFold applies the ContainsFold predicate on the "cluster_id" field.
+func ClusterIDContainsFold(v string) predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldContainsFold(FieldClusterID, v))
+}
+
+// RevisionEQ applies the EQ predicate on the "revision" field.
+func RevisionEQ(v int64) predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldEQ(FieldRevision, v))
+}
+
+// RevisionNEQ applies the NEQ predicate on the "revision" field.
+func RevisionNEQ(v int64) predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldNEQ(FieldRevision, v))
+}
+
+// RevisionIn applies the In predicate on the "revision" field.
+func RevisionIn(vs ...int64) predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldIn(FieldRevision, vs...))
+}
+
+// RevisionNotIn applies the NotIn predicate on the "revision" field.
+func RevisionNotIn(vs ...int64) predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldNotIn(FieldRevision, vs...))
+}
+
+// RevisionGT applies the GT predicate on the "revision" field.
+func RevisionGT(v int64) predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldGT(FieldRevision, v))
+}
+
+// RevisionGTE applies the GTE predicate on the "revision" field.
+func RevisionGTE(v int64) predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldGTE(FieldRevision, v))
+}
+
+// RevisionLT applies the LT predicate on the "revision" field.
+func RevisionLT(v int64) predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldLT(FieldRevision, v))
+}
+
+// RevisionLTE applies the LTE predicate on the "revision" field.
+func RevisionLTE(v int64) predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldLTE(FieldRevision, v))
+}
+
+// RevisionIsNil applies the IsNil predicate on the "revision" field.
+func RevisionIsNil() predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldIsNull(FieldRevision))
+}
+
+// RevisionNotNil applies the NotNil predicate on the "revision" field.
+func RevisionNotNil() predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldNotNull(FieldRevision))
+}
+
+// StableRevisionEQ applies the EQ predicate on the "stable_revision" field.
+func StableRevisionEQ(v int64) predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldEQ(FieldStableRevision, v))
+}
+
+// StableRevisionNEQ applies the NEQ predicate on the "stable_revision" field.
+func StableRevisionNEQ(v int64) predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldNEQ(FieldStableRevision, v))
+}
+
+// StableRevisionIn applies the In predicate on the "stable_revision" field.
+func StableRevisionIn(vs ...int64) predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldIn(FieldStableRevision, vs...))
+}
+
+// StableRevisionNotIn applies the NotIn predicate on the "stable_revision" field.
+func StableRevisionNotIn(vs ...int64) predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldNotIn(FieldStableRevision, vs...))
+}
+
+// StableRevisionGT applies the GT predicate on the "stable_revision" field.
+func StableRevisionGT(v int64) predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldGT(FieldStableRevision, v))
+}
+
+// StableRevisionGTE applies the GTE predicate on the "stable_revision" field.
+func StableRevisionGTE(v int64) predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldGTE(FieldStableRevision, v))
+}
+
+// StableRevisionLT applies the LT predicate on the "stable_revision" field.
+func StableRevisionLT(v int64) predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldLT(FieldStableRevision, v))
+}
+
+// StableRevisionLTE applies the LTE predicate on the "stable_revision" field.
+func StableRevisionLTE(v int64) predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldLTE(FieldStableRevision, v))
+}
+
+// StableRevisionIsNil applies the IsNil predicate on the "stable_revision" field.
+func StableRevisionIsNil() predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldIsNull(FieldStableRevision))
+}
+
+// StableRevisionNotNil applies the NotNil predicate on the "stable_revision" field.
+func StableRevisionNotNil() predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldNotNull(FieldStableRevision))
+}
+
+// CanaryNodeIdsIsNil applies the IsNil predicate on the "canary_node_ids" field.
+func CanaryNodeIdsIsNil() predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldIsNull(FieldCanaryNodeIds))
+}
+
+// CanaryNodeIdsNotNil applies the NotNil predicate on the "canary_node_ids" field.
+func CanaryNodeIdsNotNil() predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldNotNull(FieldCanaryNodeIds))
+}
+
+// BaselineIsNil applies the IsNil predicate on the "baseline" field.
+func BaselineIsNil() predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldIsNull(FieldBaseline))
+}
+
+// BaselineNotNil applies the NotNil predicate on the "baseline" field.
+func BaselineNotNil() predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldNotNull(FieldBaseline))
+}
+
+// SoakSecondsEQ applies the EQ predicate on the "soak_seconds" field.
+func SoakSecondsEQ(v int) predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldEQ(FieldSoakSeconds, v))
+}
+
+// SoakSecondsNEQ applies the NEQ predicate on the "soak_seconds" field.
+func SoakSecondsNEQ(v int) predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldNEQ(FieldSoakSeconds, v))
+}
+
+// SoakSecondsIn applies the In predicate on the "soak_seconds" field.
+func SoakSecondsIn(vs ...int) predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldIn(FieldSoakSeconds, vs...))
+}
+
+// SoakSecondsNotIn applies the NotIn predicate on the "soak_seconds" field.
+func SoakSecondsNotIn(vs ...int) predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldNotIn(FieldSoakSeconds, vs...))
+}
+
+// SoakSecondsGT applies the GT predicate on the "soak_seconds" field.
+func SoakSecondsGT(v int) predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldGT(FieldSoakSeconds, v))
+}
+
+// SoakSecondsGTE applies the GTE predicate on the "soak_seconds" field.
+func SoakSecondsGTE(v int) predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldGTE(FieldSoakSeconds, v))
+}
+
+// SoakSecondsLT applies the LT predicate on the "soak_seconds" field.
+func SoakSecondsLT(v int) predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldLT(FieldSoakSeconds, v))
+}
+
+// SoakSecondsLTE applies the LTE predicate on the "soak_seconds" field.
+func SoakSecondsLTE(v int) predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldLTE(FieldSoakSeconds, v))
+}
+
+// SoakSecondsIsNil applies the IsNil predicate on the "soak_seconds" field.
+func SoakSecondsIsNil() predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldIsNull(FieldSoakSeconds))
+}
+
+// SoakSecondsNotNil applies the NotNil predicate on the "soak_seconds" field.
+func SoakSecondsNotNil() predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldNotNull(FieldSoakSeconds))
+}
+
+// MaxErrorRateEQ applies the EQ predicate on the "max_error_rate" field.
+func MaxErrorRateEQ(v float64) predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldEQ(FieldMaxErrorRate, v))
+}
+
+// MaxErrorRateNEQ applies the NEQ predicate on the "max_error_rate" field.
+func MaxErrorRateNEQ(v float64) predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldNEQ(FieldMaxErrorRate, v))
+}
+
+// MaxErrorRateIn applies the In predicate on the "max_error_rate" field.
+func MaxErrorRateIn(vs ...float64) predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldIn(FieldMaxErrorRate, vs...))
+}
+
+// MaxErrorRateNotIn applies the NotIn predicate on the "max_error_rate" field.
+func MaxErrorRateNotIn(vs ...float64) predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldNotIn(FieldMaxErrorRate, vs...))
+}
+
+// MaxErrorRateGT applies the GT predicate on the "max_error_rate" field.
+func MaxErrorRateGT(v float64) predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldGT(FieldMaxErrorRate, v))
+}
+
+// MaxErrorRateGTE applies the GTE predicate on the "max_error_rate" field.
+func MaxErrorRateGTE(v float64) predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldGTE(FieldMaxErrorRate, v))
+}
+
+// MaxErrorRateLT applies the LT predicate on the "max_error_rate" field.
+func MaxErrorRateLT(v float64) predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldLT(FieldMaxErrorRate, v))
+}
+
+// MaxErrorRateLTE applies the LTE predicate on the "max_error_rate" field.
+func MaxErrorRateLTE(v float64) predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldLTE(FieldMaxErrorRate, v))
+}
+
+// MaxErrorRateIsNil applies the IsNil predicate on the "max_error_rate" field.
+func MaxErrorRateIsNil() predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldIsNull(FieldMaxErrorRate))
+}
+
+// MaxErrorRateNotNil applies the NotNil predicate on the "max_error_rate" field.
+func MaxErrorRateNotNil() predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldNotNull(FieldMaxErrorRate))
+}
+
+// MinRequestsEQ applies the EQ predicate on the "min_requests" field.
+func MinRequestsEQ(v int64) predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldEQ(FieldMinRequests, v))
+}
+
+// MinRequestsNEQ applies the NEQ predicate on the "min_requests" field.
+func MinRequestsNEQ(v int64) predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldNEQ(FieldMinRequests, v))
+}
+
+// MinRequestsIn applies the In predicate on the "min_requests" field.
+func MinRequestsIn(vs ...int64) predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldIn(FieldMinRequests, vs...))
+}
+
+// MinRequestsNotIn applies the NotIn predicate on the "min_requests" field.
+func MinRequestsNotIn(vs ...int64) predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldNotIn(FieldMinRequests, vs...))
+}
+
+// MinRequestsGT applies the GT predicate on the "min_requests" field.
+func MinRequestsGT(v int64) predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldGT(FieldMinRequests, v))
+}
+
+// MinRequestsGTE applies the GTE predicate on the "min_requests" field.
+func MinRequestsGTE(v int64) predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldGTE(FieldMinRequests, v))
+}
+
+// MinRequestsLT applies the LT predicate on the "min_requests" field.
+func MinRequestsLT(v int64) predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldLT(FieldMinRequests, v))
+}
+
+// MinRequestsLTE applies the LTE predicate on the "min_requests" field.
+func MinRequestsLTE(v int64) predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldLTE(FieldMinRequests, v))
+}
+
+// MinRequestsIsNil applies the IsNil predicate on the "min_requests" field.
+func MinRequestsIsNil() predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldIsNull(FieldMinRequests))
+}
+
+// MinRequestsNotNil applies the NotNil predicate on the "min_requests" field.
+func MinRequestsNotNil() predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldNotNull(FieldMinRequests))
+}
+
+// RequestsEQ applies the EQ predicate on the "requests" field.
+func RequestsEQ(v int64) predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldEQ(FieldRequests, v))
+}
+
+// RequestsNEQ applies the NEQ predicate on the "requests" field.
+func RequestsNEQ(v int64) predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldNEQ(FieldRequests, v))
+}
+
+// RequestsIn applies the In predicate on the "requests" field.
+func RequestsIn(vs ...int64) predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldIn(FieldRequests, vs...))
+}
+
+// RequestsNotIn applies the NotIn predicate on the "requests" field.
+func RequestsNotIn(vs ...int64) predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldNotIn(FieldRequests, vs...))
+}
+
+// RequestsGT applies the GT predicate on the "requests" field.
+func RequestsGT(v int64) predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldGT(FieldRequests, v))
+}
+
+// RequestsGTE applies the GTE predicate on the "requests" field.
+func RequestsGTE(v int64) predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldGTE(FieldRequests, v))
+}
+
+// RequestsLT applies the LT predicate on the "requests" field.
+func RequestsLT(v int64) predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldLT(FieldRequests, v))
+}
+
+// RequestsLTE applies the LTE predicate on the "requests" field.
+func RequestsLTE(v int64) predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldLTE(FieldRequests, v))
+}
+
+// RequestsIsNil applies the IsNil predicate on the "requests" field.
+func RequestsIsNil() predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldIsNull(FieldRequests))
+}
+
+// RequestsNotNil applies the NotNil predicate on the "requests" field.
+func RequestsNotNil() predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldNotNull(FieldRequests))
+}
+
+// ErrorsEQ applies the EQ predicate on the "errors" field.
+func ErrorsEQ(v int64) predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldEQ(FieldErrors, v))
+}
+
+// ErrorsNEQ applies the NEQ predicate on the "errors" field.
+func ErrorsNEQ(v int64) predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldNEQ(FieldErrors, v))
+}
+
+// ErrorsIn applies the In predicate on the "errors" field.
+func ErrorsIn(vs ...int64) predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldIn(FieldErrors, vs...))
+}
+
+// ErrorsNotIn applies the NotIn predicate on the "errors" field.
+func ErrorsNotIn(vs ...int64) predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldNotIn(FieldErrors, vs...))
+}
+
+// ErrorsGT applies the GT predicate on the "errors" field.
+func ErrorsGT(v int64) predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldGT(FieldErrors, v))
+}
+
+// ErrorsGTE applies the GTE predicate on the "errors" field.
+func ErrorsGTE(v int64) predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldGTE(FieldErrors, v))
+}
+
+// ErrorsLT applies the LT predicate on the "errors" field.
+func ErrorsLT(v int64) predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldLT(FieldErrors, v))
+}
+
+// ErrorsLTE applies the LTE predicate on the "errors" field.
+func ErrorsLTE(v int64) predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldLTE(FieldErrors, v))
+}
+
+// ErrorsIsNil applies the IsNil predicate on the "errors" field.
+func ErrorsIsNil() predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldIsNull(FieldErrors))
+}
+
+// ErrorsNotNil applies the NotNil predicate on the "errors" field.
+func ErrorsNotNil() predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldNotNull(FieldErrors))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v constant.ProxyRolloutStatus) predicate.CoreProxyRollout {
+	vc := int8(v)
+	return predicate.CoreProxyRollout(sql.FieldEQ(FieldStatus, vc))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v constant.ProxyRolloutStatus) predicate.CoreProxyRollout {
+	vc := int8(v)
+	return predicate.CoreProxyRollout(sql.FieldNEQ(FieldStatus, vc))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...constant.ProxyRolloutStatus) predicate.CoreProxyRollout {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = int8(vs[i])
+	}
+	return predicate.CoreProxyRollout(sql.FieldIn(FieldStatus, v...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...constant.ProxyRolloutStatus) predicate.CoreProxyRollout {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = int8(vs[i])
+	}
+	return predicate.CoreProxyRollout(sql.FieldNotIn(FieldStatus, v...))
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v constant.ProxyRolloutStatus) predicate.CoreProxyRollout {
+	vc := int8(v)
+	return predicate.CoreProxyRollout(sql.FieldGT(FieldStatus, vc))
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v constant.ProxyRolloutStatus) predicate.CoreProxyRollout {
+	vc := int8(v)
+	return predicate.CoreProxyRollout(sql.FieldGTE(FieldStatus, vc))
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v constant.ProxyRolloutStatus) predicate.CoreProxyRollout {
+	vc := int8(v)
+	return predicate.CoreProxyRollout(sql.FieldLT(FieldStatus, vc))
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v constant.ProxyRolloutStatus) predicate.CoreProxyRollout {
+	vc := int8(v)
+	return predicate.CoreProxyRollout(sql.FieldLTE(FieldStatus, vc))
+}
+
+// StatusIsNil applies the IsNil predicate on the "status" field.
+func StatusIsNil() predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldIsNull(FieldStatus))
+}
+
+// StatusNotNil applies the NotNil predicate on the "status" field.
+func StatusNotNil() predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldNotNull(FieldStatus))
+}
+
+// ReasonEQ applies the EQ predicate on the "reason" field.
+func ReasonEQ(v string) predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldEQ(FieldReason, v))
+}
+
+// ReasonNEQ applies the NEQ predicate on the "reason" field.
+func ReasonNEQ(v string) predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldNEQ(FieldReason, v))
+}
+
+// ReasonIn applies the In predicate on the "reason" field.
+func ReasonIn(vs ...string) predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldIn(FieldReason, vs...))
+}
+
+// ReasonNotIn applies the NotIn predicate on the "reason" field.
+func ReasonNotIn(vs ...string) predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldNotIn(FieldReason, vs...))
+}
+
+// ReasonGT applies the GT predicate on the "reason" field.
+func ReasonGT(v string) predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldGT(FieldReason, v))
+}
+
+// ReasonGTE applies the GTE predicate on the "reason" field.
+func ReasonGTE(v string) predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldGTE(FieldReason, v))
+}
+
+// ReasonLT applies the LT predicate on the "reason" field.
+func ReasonLT(v string) predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldLT(FieldReason, v))
+}
+
+// ReasonLTE applies the LTE predicate on the "reason" field.
+func ReasonLTE(v string) predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldLTE(FieldReason, v))
+}
+
+// ReasonContains applies the Contains predicate on the "reason" field.
+func ReasonContains(v string) predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldContains(FieldReason, v))
+}
+
+// ReasonHasPrefix applies the HasPrefix predicate on the "reason" field.
+func ReasonHasPrefix(v string) predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldHasPrefix(FieldReason, v))
+}
+
+// ReasonHasSuffix applies the HasSuffix predicate on the "reason" field.
+func ReasonHasSuffix(v string) predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldHasSuffix(FieldReason, v))
+}
+
+// ReasonIsNil applies the IsNil predicate on the "reason" field.
+func ReasonIsNil() predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldIsNull(FieldReason))
+}
+
+// ReasonNotNil applies the NotNil predicate on the "reason" field.
+func ReasonNotNil() predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldNotNull(FieldReason))
+}
+
+// ReasonEqualFold applies the EqualFold predicate on the "reason" field.
+func ReasonEqualFold(v string) predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldEqualFold(FieldReason, v))
+}
+
+// ReasonContainsFold applies the ContainsFold predicate on the "reason" field.
+func ReasonContainsFold(v string) predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldContainsFold(FieldReason, v))
+}
+
+// StartedAtEQ applies the EQ predicate on the "started_at" field.
+func StartedAtEQ(v int64) predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldEQ(FieldStartedAt, v))
+}
+
+// StartedAtNEQ applies the NEQ predicate on the "started_at" field.
+func StartedAtNEQ(v int64) predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldNEQ(FieldStartedAt, v))
+}
+
+// StartedAtIn applies the In predicate on the "started_at" field.
+func StartedAtIn(vs ...int64) predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldIn(FieldStartedAt, vs...))
+}
+
+// StartedAtNotIn applies the NotIn predicate on the "started_at" field.
+func StartedAtNotIn(vs ...int64) predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldNotIn(FieldStartedAt, vs...))
+}
+
+// StartedAtGT applies the GT predicate on the "started_at" field.
+func StartedAtGT(v int64) predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldGT(FieldStartedAt, v))
+}
+
+// StartedAtGTE applies the GTE predicate on the "started_at" field.
+func StartedAtGTE(v int64) predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldGTE(FieldStartedAt, v))
+}
+
+// StartedAtLT applies the LT predicate on the "started_at" field.
+func StartedAtLT(v int64) predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldLT(FieldStartedAt, v))
+}
+
+// StartedAtLTE applies the LTE predicate on the "started_at" field.
+func StartedAtLTE(v int64) predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldLTE(FieldStartedAt, v))
+}
+
+// StartedAtIsNil applies the IsNil predicate on the "started_at" field.
+func StartedAtIsNil() predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldIsNull(FieldStartedAt))
+}
+
+// StartedAtNotNil applies the NotNil predicate on the "started_at" field.
+func StartedAtNotNil() predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldNotNull(FieldStartedAt))
+}
+
+// FinishedAtEQ applies the EQ predicate on the "finished_at" field.
+func FinishedAtEQ(v int64) predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldEQ(FieldFinishedAt, v))
+}
+
+// FinishedAtNEQ applies the NEQ predicate on the "finished_at" field.
+func FinishedAtNEQ(v int64) predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldNEQ(FieldFinishedAt, v))
+}
+
+// FinishedAtIn applies the In predicate on the "finished_at" field.
+func FinishedAtIn(vs ...int64) predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldIn(FieldFinishedAt, vs...))
+}
+
+// FinishedAtNotIn applies the NotIn predicate on the "finished_at" field.
+func FinishedAtNotIn(vs ...int64) predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldNotIn(FieldFinishedAt, vs...))
+}
+
+// FinishedAtGT applies the GT predicate on the "finished_at" field.
+func FinishedAtGT(v int64) predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldGT(FieldFinishedAt, v))
+}
+
+// FinishedAtGTE applies the GTE predicate on the "finished_at" field.
+func FinishedAtGTE(v int64) predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldGTE(FieldFinishedAt, v))
+}
+
+// FinishedAtLT applies the LT predicate on the "finished_at" field.
+func FinishedAtLT(v int64) predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldLT(FieldFinishedAt, v))
+}
+
+// FinishedAtLTE applies the LTE predicate on the "finished_at" field.
+func FinishedAtLTE(v int64) predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldLTE(FieldFinishedAt, v))
+}
+
+// FinishedAtIsNil applies the IsNil predicate on the "finished_at" field.
+func FinishedAtIsNil() predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldIsNull(FieldFinishedAt))
+}
+
+// FinishedAtNotNil applies the NotNil predicate on the "finished_at" field.
+func FinishedAtNotNil() predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.FieldNotNull(FieldFinishedAt))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.CoreProxyRollout) predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.CoreProxyRollout) predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.CoreProxyRollout) predicate.CoreProxyRollout {
+	return predicate.CoreProxyRollout(sql.NotPredicates(p))
+}
@@ -0,0 +1,1928 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/lyonmu/quebec/cmd/core/internal/common"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/coreproxyrollout"
+	"github.com/lyonmu/quebec/pkg/constant"
+)
+
+// CoreProxyRolloutCreate is the builder for creating a CoreProxyRollout entity.
+type CoreProxyRolloutCreate struct {
+	config
+	mutation *CoreProxyRolloutMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *CoreProxyRolloutCreate) SetCreatedAt(v time.Time) *CoreProxyRolloutCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *CoreProxyRolloutCreate) SetNillableCreatedAt(v *time.Time) *CoreProxyRolloutCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *CoreProxyRolloutCreate) SetUpdatedAt(v time.Time) *CoreProxyRolloutCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *CoreProxyRolloutCreate) SetNillableUpdatedAt(v *time.Time) *CoreProxyRolloutCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_c *CoreProxyRolloutCreate) SetDeletedAt(v time.Time) *CoreProxyRolloutCreate {
+	_c.mutation.SetDeletedAt(v)
+	return _c
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_c *CoreProxyRolloutCreate) SetNillableDeletedAt(v *time.Time) *CoreProxyRolloutCreate {
+	if v != nil {
+		_c.SetDeletedAt(*v)
+	}
+	return _c
+}
+
+// SetClusterID sets the "cluster_id" field.
+func (_c *CoreProxyRolloutCreate) SetClusterID(v string) *CoreProxyRolloutCreate {
+	_c.mutation.SetClusterID(v)
+	return _c
+}
+
+// SetNillableClusterID sets the "cluster_id" field if the given value is not nil.
+func (_c *CoreProxyRolloutCreate) SetNillableClusterID(v *string) *CoreProxyRolloutCreate {
+	if v != nil {
+		_c.SetClusterID(*v)
+	}
+	return _c
+}
+
+// SetRevision sets the "revision" field.
+func (_c *CoreProxyRolloutCreate) SetRevision(v int64) *CoreProxyRolloutCreate {
+	_c.mutation.SetRevision(v)
+	return _c
+}
+
+// SetNillableRevision sets the "revision" field if the given value is not nil.
+func (_c *CoreProxyRolloutCreate) SetNillableRevision(v *int64) *CoreProxyRolloutCreate {
+	if v != nil {
+		_c.SetRevision(*v)
+	}
+	return _c
+}
+
+// SetStableRevision sets the "stable_revision" field.
+func (_c *CoreProxyRolloutCreate) SetStableRevision(v int64) *CoreProxyRolloutCreate {
+	_c.mutation.SetStableRevision(v)
+	return _c
+}
+
+// SetNillableStableRevision sets the "stable_revision" field if the given value is not nil.
+func (_c *CoreProxyRolloutCreate) SetNillableStableRevision(v *int64) *CoreProxyRolloutCreate {
+	if v != nil {
+		_c.SetStableRevision(*v)
+	}
+	return _c
+}
+
+// SetCanaryNodeIds sets the "canary_node_ids" field.
+func (_c *CoreProxyRolloutCreate) SetCanaryNodeIds(v []string) *CoreProxyRolloutCreate {
+	_c.mutation.SetCanaryNodeIds(v)
+	return _c
+}
+
+// SetBaseline sets the "baseline" field.
+func (_c *CoreProxyRolloutCreate) SetBaseline(v map[string]common.TrafficCounter) *CoreProxyRolloutCreate {
+	_c.mutation.SetBaseline(v)
+	return _c
+}
+
+// SetSoakSeconds sets the "soak_seconds" field.
+func (_c *CoreProxyRolloutCreate) SetSoakSeconds(v int) *CoreProxyRolloutCreate {
+	_c.mutation.SetSoakSeconds(v)
+	return _c
+}
+
+// SetNillableSoakSeconds sets the "soak_seconds" field if the given value is not nil.
+func (_c *CoreProxyRolloutCreate) SetNillableSoakSeconds(v *int) *CoreProxyRolloutCreate {
+	if v != nil {
+		_c.SetSoakSeconds(*v)
+	}
+	return _c
+}
+
+// SetMaxErrorRate sets the "max_error_rate" field.
+func (_c *CoreProxyRolloutCreate) SetMaxErrorRate(v float64) *CoreProxyRolloutCreate {
+	_c.mutation.SetMaxErrorRate(v)
+	return _c
+}
+
+// SetNillableMaxErrorRate sets the "max_error_rate" field if the given value is not nil.
+func (_c *CoreProxyRolloutCreate) SetNillableMaxErrorRate(v *float64) *CoreProxyRolloutCreate {
+	if v != nil {
+		_c.SetMaxErrorRate(*v)
+	}
+	return _c
+}
+
+// SetMinRequests sets the "min_requests" field.
+func (_c *CoreProxyRolloutCreate) SetMinRequests(v int64) *CoreProxyRolloutCreate {
+	_c.mutation.SetMinRequests(v)
+	return _c
+}
+
+// SetNillableMinRequests sets the "min_requests" field if the given value is not nil.
+func (_c *CoreProxyRolloutCreate) SetNillableMinRequests(v *int64) *CoreProxyRolloutCreate {
+	if v != nil {
+		_c.SetMinRequests(*v)
+	}
+	return _c
+}
+
+// SetRequests sets the "requests" field.
+func (_c *CoreProxyRolloutCreate) SetRequests(v int64) *CoreProxyRolloutCreate {
+	_c.mutation.SetRequests(v)
+	return _c
+}
+
+// SetNillableRequests sets the "requests" field if the given value is not nil.
+func (_c *CoreProxyRolloutCreate) SetNillableRequests(v *int64) *CoreProxyRolloutCreate {
+	if v != nil {
+		_c.SetRequests(*v)
+	}
+	return _c
+}
+
+// SetErrors sets the "errors" field.
+func (_c *CoreProxyRolloutCreate) SetErrors(v int64) *CoreProxyRolloutCreate {
+	_c.mutation.SetErrors(v)
+	return _c
+}
+
+// SetNillableErrors sets the "errors" field if the given value is not nil.
+func (_c *CoreProxyRolloutCreate) SetNillableErrors(v *int64) *CoreProxyRolloutCreate {
+	if v != nil {
+		_c.SetErrors(*v)
+	}
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *CoreProxyRolloutCreate) SetStatus(v constant.ProxyRolloutStatus) *CoreProxyRolloutCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *CoreProxyRolloutCreate) SetNillableStatus(v *constant.ProxyRolloutStatus) *CoreProxyRolloutCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetReason sets the "reason" field.
+func (_c *CoreProxyRolloutCreate) SetReason(v string) *CoreProxyRolloutCreate {
+	_c.mutation.SetReason(v)
+	return _c
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_c *CoreProxyRolloutCreate) SetNillableReason(v *string) *CoreProxyRolloutCreate {
+	if v != nil {
+		_c.SetReason(*v)
+	}
+	return _c
+}
+
+// SetStartedAt sets the "started_at" field.
+func (_c *CoreProxyRolloutCreate) SetStartedAt(v int64) *CoreProxyRolloutCreate {
+	_c.mutation.SetStartedAt(v)
+	return _c
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (_c *CoreProxyRolloutCreate) SetNillableStartedAt(v *int64) *CoreProxyRolloutCreate {
+	if v != nil {
+		_c.SetStartedAt(*v)
+	}
+	return _c
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (_c *CoreProxyRolloutCreate) SetFinishedAt(v int64) *CoreProxyRolloutCreate {
+	_c.mutation.SetFinishedAt(v)
+	return _c
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (_c *CoreProxyRolloutCreate) SetNillableFinishedAt(v *int64) *CoreProxyRolloutCreate {
+	if v != nil {
+		_c.SetFinishedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *CoreProxyRolloutCreate) SetID(v string) *CoreProxyRolloutCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *CoreProxyRolloutCreate) SetNillableID(v *string) *CoreProxyRolloutCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the CoreProxyRolloutMutation object of the builder.
+func (_c *CoreProxyRolloutCreate) Mutation() *CoreProxyRolloutMutation {
+	return _c.mutation
+}
+
+// Save creates the CoreProxyRollout in the database.
+func (_c *CoreProxyRolloutCreate) Save(ctx context.Context) (*CoreProxyRollout, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *CoreProxyRolloutCreate) SaveX(ctx context.Context) *CoreProxyRollout {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *CoreProxyRolloutCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *CoreProxyRolloutCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *CoreProxyRolloutCreate) defaults() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		if coreproxyrollout.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized coreproxyrollout.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := coreproxyrollout.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		if coreproxyrollout.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized coreproxyrollout.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := coreproxyrollout.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		v := coreproxyrollout.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		if coreproxyrollout.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized coreproxyrollout.DefaultID (forgotten import ent/runtime?)")
+		}
+		v := coreproxyrollout.DefaultID()
+		_c.mutation.SetID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *CoreProxyRolloutCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "CoreProxyRollout.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "CoreProxyRollout.updated_at"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := coreproxyrollout.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "CoreProxyRollout.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *CoreProxyRolloutCreate) sqlSave(ctx context.Context) (*CoreProxyRollout, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected CoreProxyRollout.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *CoreProxyRolloutCreate) createSpec() (*CoreProxyRollout, *sqlgraph.CreateSpec) {
+	var (
+		_node = &CoreProxyRollout{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(coreproxyrollout.Table, sqlgraph.NewFieldSpec(coreproxyrollout.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(coreproxyrollout.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(coreproxyrollout.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.DeletedAt(); ok {
+		_spec.SetField(coreproxyrollout.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if value, ok := _c.mutation.ClusterID(); ok {
+		_spec.SetField(coreproxyrollout.FieldClusterID, field.TypeString, value)
+		_node.ClusterID = value
+	}
+	if value, ok := _c.mutation.Revision(); ok {
+		_spec.SetField(coreproxyrollout.FieldRevision, field.TypeInt64, value)
+		_node.Revision = value
+	}
+	if value, ok := _c.mutation.StableRevision(); ok {
+		_spec.SetField(coreproxyrollout.FieldStableRevision, field.TypeInt64, value)
+		_node.StableRevision = value
+	}
+	if value, ok := _c.mutation.CanaryNodeIds(); ok {
+		_spec.SetField(coreproxyrollout.FieldCanaryNodeIds, field.TypeJSON, value)
+		_node.CanaryNodeIds = value
+	}
+	if value, ok := _c.mutation.Baseline(); ok {
+		_spec.SetField(coreproxyrollout.FieldBaseline, field.TypeJSON, value)
+		_node.Baseline = value
+	}
+	if value, ok := _c.mutation.SoakSeconds(); ok {
+		_spec.SetField(coreproxyrollout.FieldSoakSeconds, field.TypeInt, value)
+		_node.SoakSeconds = value
+	}
+	if value, ok := _c.mutation.MaxErrorRate(); ok {
+		_spec.SetField(coreproxyrollout.FieldMaxErrorRate, field.TypeFloat64, value)
+		_node.MaxErrorRate = value
+	}
+	if value, ok := _c.mutation.MinRequests(); ok {
+		_spec.SetField(coreproxyrollout.FieldMinRequests, field.TypeInt64, value)
+		_node.MinRequests = value
+	}
+	if value, ok := _c.mutation.Requests(); ok {
+		_spec.SetField(coreproxyrollout.FieldRequests, field.TypeInt64, value)
+		_node.Requests = value
+	}
+	if value, ok := _c.mutation.Errors(); ok {
+		_spec.SetField(coreproxyrollout.FieldErrors, field.TypeInt64, value)
+		_node.Errors = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(coreproxyrollout.FieldStatus, field.TypeInt8, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.Reason(); ok {
+		_spec.SetField(coreproxyrollout.FieldReason, field.TypeString, value)
+		_node.Reason = value
+	}
+	if value, ok := _c.mutation.StartedAt(); ok {
+		_spec.SetField(coreproxyrollout.FieldStartedAt, field.TypeInt64, value)
+		_node.StartedAt = value
+	}
+	if value, ok := _c.mutation.FinishedAt(); ok {
+		_spec.SetField(coreproxyrollout.FieldFinishedAt, field.TypeInt64, value)
+		_node.FinishedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.CoreProxyRollout.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.CoreProxyRolloutUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *CoreProxyRolloutCreate) OnConflict(opts ...sql.ConflictOption) *CoreProxyRolloutUpsertOne {
+	_c.conflict = opts
+	return &CoreProxyRolloutUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.CoreProxyRollout.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *CoreProxyRolloutCreate) OnConflictColumns(columns ...string) *CoreProxyRolloutUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &CoreProxyRolloutUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// CoreProxyRolloutUpsertOne is the builder for "upsert"-ing
+	//  one CoreProxyRollout node.
+	CoreProxyRolloutUpsertOne struct {
+		create *CoreProxyRolloutCreate
+	}
+
+	// CoreProxyRolloutUpsert is the "OnConflict" setter.
+	CoreProxyRolloutUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *CoreProxyRolloutUpsert) SetUpdatedAt(v time.Time) *CoreProxyRolloutUpsert {
+	u.Set(coreproxyrollout.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *CoreProxyRolloutUpsert) UpdateUpdatedAt() *CoreProxyRolloutUpsert {
+	u.SetExcluded(coreproxyrollout.FieldUpdatedAt)
+	return u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *CoreProxyRolloutUpsert) SetDeletedAt(v time.Time) *CoreProxyRolloutUpsert {
+	u.Set(coreproxyrollout.FieldDeletedAt, v)
+	return u
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *CoreProxyRolloutUpsert) UpdateDeletedAt() *CoreProxyRolloutUpsert {
+	u.SetExcluded(coreproxyrollout.FieldDeletedAt)
+	return u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *CoreProxyRolloutUpsert) ClearDeletedAt() *CoreProxyRolloutUpsert {
+	u.SetNull(coreproxyrollout.FieldDeletedAt)
+	return u
+}
+
+// SetClusterID sets the "cluster_id" field.
+func (u *CoreProxyRolloutUpsert) SetClusterID(v string) *CoreProxyRolloutUpsert {
+	u.Set(coreproxyrollout.FieldClusterID, v)
+	return u
+}
+
+// UpdateClusterID sets the "cluster_id" field to the value that was provided on create.
+func (u *CoreProxyRolloutUpsert) UpdateClusterID() *CoreProxyRolloutUpsert {
+	u.SetExcluded(coreproxyrollout.FieldClusterID)
+	return u
+}
+
+// ClearClusterID clears the value of the "cluster_id" field.
+func (u *CoreProxyRolloutUpsert) ClearClusterID() *CoreProxyRolloutUpsert {
+	u.SetNull(coreproxyrollout.FieldClusterID)
+	return u
+}
+
+// SetRevision sets the "revision" field.
+func (u *CoreProxyRolloutUpsert) SetRevision(v int64) *CoreProxyRolloutUpsert {
+	u.Set(coreproxyrollout.FieldRevision, v)
+	return u
+}
+
+// UpdateRevision sets the "revision" field to the value that was provided on create.
+func (u *CoreProxyRolloutUpsert) UpdateRevision() *CoreProxyRolloutUpsert {
+	u.SetExcluded(coreproxyrollout.FieldRevision)
+	return u
+}
+
+// AddRevision adds v to the "revision" field.
+func (u *CoreProxyRolloutUpsert) AddRevision(v int64) *CoreProxyRolloutUpsert {
+	u.Add(coreproxyrollout.FieldRevision, v)
+	return u
+}
+
+// ClearRevision clears the value of the "revision" field.
+func (u *CoreProxyRolloutUpsert) ClearRevision() *CoreProxyRolloutUpsert {
+	u.SetNull(coreproxyrollout.FieldRevision)
+	return u
+}
+
+// SetStableRevision sets the "stable_revision" field.
+func (u *CoreProxyRolloutUpsert) SetStableRevision(v int64) *CoreProxyRolloutUpsert {
+	u.Set(coreproxyrollout.FieldStableRevision, v)
+	return u
+}
+
+// UpdateStableRevision sets the "stable_revision" field to the value that was provided on create.
+func (u *CoreProxyRolloutUpsert) UpdateStableRevision() *CoreProxyRolloutUpsert {
+	u.SetExcluded(coreproxyrollout.FieldStableRevision)
+	return u
+}
+
+// AddStableRevision adds v to the "stable_revision" field.
+func (u *CoreProxyRolloutUpsert) AddStableRevision(v int64) *CoreProxyRolloutUpsert {
+	u.Add(coreproxyrollout.FieldStableRevision, v)
+	return u
+}
+
+// ClearStableRevision clears the value of the "stable_revision" field.
+func (u *CoreProxyRolloutUpsert) ClearStableRevision() *CoreProxyRolloutUpsert {
+	u.SetNull(coreproxyrollout.FieldStableRevision)
+	return u
+}
+
+// SetCanaryNodeIds sets the "canary_node_ids" field.
+func (u *CoreProxyRolloutUpsert) SetCanaryNodeIds(v []string) *CoreProxyRolloutUpsert {
+	u.Set(coreproxyrollout.FieldCanaryNodeIds, v)
+	return u
+}
+
+// UpdateCanaryNodeIds sets the "canary_node_ids" field to the value that was provided on create.
+func (u *CoreProxyRolloutUpsert) UpdateCanaryNodeIds() *CoreProxyRolloutUpsert {
+	u.SetExcluded(coreproxyrollout.FieldCanaryNodeIds)
+	return u
+}
+
+// ClearCanaryNodeIds clears the value of the "canary_node_ids" field.
+func (u *CoreProxyRolloutUpsert) ClearCanaryNodeIds() *CoreProxyRolloutUpsert {
+	u.SetNull(coreproxyrollout.FieldCanaryNodeIds)
+	return u
+}
+
+// SetBaseline sets the "baseline" field.
+func (u *CoreProxyRolloutUpsert) SetBaseline(v map[string]common.TrafficCounter) *CoreProxyRolloutUpsert {
+	u.Set(coreproxyrollout.FieldBaseline, v)
+	return u
+}
+
+// UpdateBaseline sets the "baseline" field to the value that was provided on create.
+func (u *CoreProxyRolloutUpsert) UpdateBaseline() *CoreProxyRolloutUpsert {
+	u.SetExcluded(coreproxyrollout.FieldBaseline)
+	return u
+}
+
+// ClearBaseline clears the value of the "baseline" field.
+func (u *CoreProxyRolloutUpsert) ClearBaseline() *CoreProxyRolloutUpsert {
+	u.SetNull(coreproxyrollout.FieldBaseline)
+	return u
+}
+
+// SetSoakSeconds sets the "soak_seconds" field.
+func (u *CoreProxyRolloutUpsert) SetSoakSeconds(v int) *CoreProxyRolloutUpsert {
+	u.Set(coreproxyrollout.FieldSoakSeconds, v)
+	return u
+}
+
+// UpdateSoakSeconds sets the "soak_seconds" field to the value that was provided on create.
+func (u *CoreProxyRolloutUpsert) UpdateSoakSeconds() *CoreProxyRolloutUpsert {
+	u.SetExcluded(coreproxyrollout.FieldSoakSeconds)
+	return u
+}
+
+// AddSoakSeconds adds v to the "soak_seconds" field.
+func (u *CoreProxyRolloutUpsert) AddSoakSeconds(v int) *CoreProxyRolloutUpsert {
+	u.Add(coreproxyrollout.FieldSoakSeconds, v)
+	return u
+}
+
+// ClearSoakSeconds clears the value of the "soak_seconds" field.
+func (u *CoreProxyRolloutUpsert) ClearSoakSeconds() *CoreProxyRolloutUpsert {
+	u.SetNull(coreproxyrollout.FieldSoakSeconds)
+	return u
+}
+
+// SetMaxErrorRate sets the "max_error_rate" field.
+func (u *CoreProxyRolloutUpsert) SetMaxErrorRate(v float64) *CoreProxyRolloutUpsert {
+	u.Set(coreproxyrollout.FieldMaxErrorRate, v)
+	return u
+}
+
+// UpdateMaxErrorRate sets the "max_error_rate" field to the value that was provided on create.
+func (u *CoreProxyRolloutUpsert) UpdateMaxErrorRate() *CoreProxyRolloutUpsert {
+	u.SetExcluded(coreproxyrollout.FieldMaxErrorRate)
+	return u
+}
+
+// AddMaxErrorRate adds v to the "max_error_rate" field.
+func (u *CoreProxyRolloutUpsert) AddMaxErrorRate(v float64) *CoreProxyRolloutUpsert {
+	u.Add(coreproxyrollout.FieldMaxErrorRate, v)
+	return u
+}
+
+// ClearMaxErrorRate clears the value of the "max_error_rate" field.
+func (u *CoreProxyRolloutUpsert) ClearMaxErrorRate() *CoreProxyRolloutUpsert {
+	u.SetNull(coreproxyrollout.FieldMaxErrorRate)
+	return u
+}
+
+// SetMinRequests sets the "min_requests" field.
+func (u *CoreProxyRolloutUpsert) SetMinRequests(v int64) *CoreProxyRolloutUpsert {
+	u.Set(coreproxyrollout.FieldMinRequests, v)
+	return u
+}
+
+// UpdateMinRequests sets the "min_requests" field to the value that was provided on create.
+func (u *CoreProxyRolloutUpsert) UpdateMinRequests() *CoreProxyRolloutUpsert {
+	u.SetExcluded(coreproxyrollout.FieldMinRequests)
+	return u
+}
+
+// AddMinRequests adds v to the "min_requests" field.
+func (u *CoreProxyRolloutUpsert) AddMinRequests(v int64) *CoreProxyRolloutUpsert {
+	u.Add(coreproxyrollout.FieldMinRequests, v)
+	return u
+}
+
+// ClearMinRequests clears the value of the "min_requests" field.
+func (u *CoreProxyRolloutUpsert) ClearMinRequests() *CoreProxyRolloutUpsert {
+	u.SetNull(coreproxyrollout.FieldMinRequests)
+	return u
+}
+
+// SetRequests sets the "requests" field.
+func (u *CoreProxyRolloutUpsert) SetRequests(v int64) *CoreProxyRolloutUpsert {
+	u.Set(coreproxyrollout.FieldRequests, v)
+	return u
+}
+
+// UpdateRequests sets the "requests" field to the value that was provided on create.
+func (u *CoreProxyRolloutUpsert) UpdateRequests() *CoreProxyRolloutUpsert {
+	u.SetExcluded(coreproxyrollout.FieldRequests)
+	return u
+}
+
+// AddRequests adds v to the "requests" field.
+func (u *CoreProxyRolloutUpsert) AddRequests(v int64) *CoreProxyRolloutUpsert {
+	u.Add(coreproxyrollout.FieldRequests, v)
+	return u
+}
+
+// ClearRequests clears the value of the "requests" field.
+func (u *CoreProxyRolloutUpsert) ClearRequests() *CoreProxyRolloutUpsert {
+	u.SetNull(coreproxyrollout.FieldRequests)
+	return u
+}
+
+// SetErrors sets the "errors" field.
+func (u *CoreProxyRolloutUpsert) SetErrors(v int64) *CoreProxyRolloutUpsert {
+	u.Set(coreproxyrollout.FieldErrors, v)
+	return u
+}
+
+// UpdateErrors sets the "errors" field to the value that was provided on create.
+func (u *CoreProxyRolloutUpsert) UpdateErrors() *CoreProxyRolloutUpsert {
+	u.SetExcluded(coreproxyrollout.FieldErrors)
+	return u
+}
+
+// AddErrors adds v to the "errors" field.
+func (u *CoreProxyRolloutUpsert) AddErrors(v int64) *CoreProxyRolloutUpsert {
+	u.Add(coreproxyrollout.FieldErrors, v)
+	return u
+}
+
+// ClearErrors clears the value of the "errors" field.
+func (u *CoreProxyRolloutUpsert) ClearErrors() *CoreProxyRolloutUpsert {
+	u.SetNull(coreproxyrollout.FieldErrors)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *CoreProxyRolloutUpsert) SetStatus(v constant.ProxyRolloutStatus) *CoreProxyRolloutUpsert {
+	u.Set(coreproxyrollout.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *CoreProxyRolloutUpsert) UpdateStatus() *CoreProxyRolloutUpsert {
+	u.SetExcluded(coreproxyrollout.FieldStatus)
+	return u
+}
+
+// AddStatus adds v to the "status" field.
+func (u *CoreProxyRolloutUpsert) AddStatus(v constant.ProxyRolloutStatus) *CoreProxyRolloutUpsert {
+	u.Add(coreproxyrollout.FieldStatus, v)
+	return u
+}
+
+// ClearStatus clears the value of the "status" field.
+func (u *CoreProxyRolloutUpsert) ClearStatus() *CoreProxyRolloutUpsert {
+	u.SetNull(coreproxyrollout.FieldStatus)
+	return u
+}
+
+// SetReason sets the "reason" field.
+func (u *CoreProxyRolloutUpsert) SetReason(v string) *CoreProxyRolloutUpsert {
+	u.Set(coreproxyrollout.FieldReason, v)
+	return u
+}
+
+// UpdateReason sets the "reason" field to the value that was provided on create.
+func (u *CoreProxyRolloutUpsert) UpdateReason() *CoreProxyRolloutUpsert {
+	u.SetExcluded(coreproxyrollout.FieldReason)
+	return u
+}
+
+// ClearReason clears the value of the "reason" field.
+func (u *CoreProxyRolloutUpsert) ClearReason() *CoreProxyRolloutUpsert {
+	u.SetNull(coreproxyrollout.FieldReason)
+	return u
+}
+
+// SetStartedAt sets the "started_at" field.
+func (u *CoreProxyRolloutUpsert) SetStartedAt(v int64) *CoreProxyRolloutUpsert {
+	u.Set(coreproxyrollout.FieldStartedAt, v)
+	return u
+}
+
+// UpdateStartedAt sets the "started_at" field to the value that was provided on create.
+func (u *CoreProxyRolloutUpsert) UpdateStartedAt() *CoreProxyRolloutUpsert {
+	u.SetExcluded(coreproxyrollout.FieldStartedAt)
+	return u
+}
+
+// AddStartedAt adds v to the "started_at" field.
+func (u *CoreProxyRolloutUpsert) AddStartedAt(v int64) *CoreProxyRolloutUpsert {
+	u.Add(coreproxyrollout.FieldStartedAt, v)
+	return u
+}
+
+// ClearStartedAt clears the value of the "started_at" field.
+func (u *CoreProxyRolloutUpsert) ClearStartedAt() *CoreProxyRolloutUpsert {
+	u.SetNull(coreproxyrollout.FieldStartedAt)
+	return u
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (u *CoreProxyRolloutUpsert) SetFinishedAt(v int64) *CoreProxyRolloutUpsert {
+	u.Set(coreproxyrollout.FieldFinishedAt, v)
+	return u
+}
+
+// UpdateFinishedAt sets the "finished_at" field to the value that was provided on create.
+func (u *CoreProxyRolloutUpsert) UpdateFinishedAt() *CoreProxyRolloutUpsert {
+	u.SetExcluded(coreproxyrollout.FieldFinishedAt)
+	return u
+}
+
+// AddFinishedAt adds v to the "finished_at" field.
+func (u *CoreProxyRolloutUpsert) AddFinishedAt(v int64) *CoreProxyRolloutUpsert {
+	u.Add(coreproxyrollout.FieldFinishedAt, v)
+	return u
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (u *CoreProxyRolloutUpsert) ClearFinishedAt() *CoreProxyRolloutUpsert {
+	u.SetNull(coreproxyrollout.FieldFinishedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.CoreProxyRollout.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(coreproxyrollout.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *CoreProxyRolloutUpsertOne) UpdateNewValues() *CoreProxyRolloutUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(coreproxyrollout.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(coreproxyrollout.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.CoreProxyRollout.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *CoreProxyRolloutUpsertOne) Ignore() *CoreProxyRolloutUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *CoreProxyRolloutUpsertOne) DoNothing() *CoreProxyRolloutUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the CoreProxyRolloutCreate.OnConflict
+// documentation for more info.
+func (u *CoreProxyRolloutUpsertOne) Update(set func(*CoreProxyRolloutUpsert)) *CoreProxyRolloutUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&CoreProxyRolloutUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *CoreProxyRolloutUpsertOne) SetUpdatedAt(v time.Time) *CoreProxyRolloutUpsertOne {
+	return u.Update(func(s *CoreProxyRolloutUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *CoreProxyRolloutUpsertOne) UpdateUpdatedAt() *CoreProxyRolloutUpsertOne {
+	return u.Update(func(s *CoreProxyRolloutUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *CoreProxyRolloutUpsertOne) SetDeletedAt(v time.Time) *CoreProxyRolloutUpsertOne {
+	return u.Update(func(s *CoreProxyRolloutUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *CoreProxyRolloutUpsertOne) UpdateDeletedAt() *CoreProxyRolloutUpsertOne {
+	return u.Update(func(s *CoreProxyRolloutUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *CoreProxyRolloutUpsertOne) ClearDeletedAt() *CoreProxyRolloutUpsertOne {
+	return u.Update(func(s *CoreProxyRolloutUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetClusterID sets the "cluster_id" field.
+func (u *CoreProxyRolloutUpsertOne) SetClusterID(v string) *CoreProxyRolloutUpsertOne {
+	return u.Update(func(s *CoreProxyRolloutUpsert) {
+		s.SetClusterID(v)
+	})
+}
+
+// UpdateClusterID sets the "cluster_id" field to the value that was provided on create.
+func (u *CoreProxyRolloutUpsertOne) UpdateClusterID() *CoreProxyRolloutUpsertOne {
+	return u.Update(func(s *CoreProxyRolloutUpsert) {
+		s.UpdateClusterID()
+	})
+}
+
+// ClearClusterID clears the value of the "cluster_id" field.
+func (u *CoreProxyRolloutUpsertOne) ClearClusterID() *CoreProxyRolloutUpsertOne {
+	return u.Update(func(s *CoreProxyRolloutUpsert) {
+		s.ClearClusterID()
+	})
+}
+
+// SetRevision sets the "revision" field.
+func (u *CoreProxyRolloutUpsertOne) SetRevision(v int64) *CoreProxyRolloutUpsertOne {
+	return u.Update(func(s *CoreProxyRolloutUpsert) {
+		s.SetRevision(v)
+	})
+}
+
+// AddRevision adds v to the "revision" field.
+func (u *CoreProxyRolloutUpsertOne) AddRevision(v int64) *CoreProxyRolloutUpsertOne {
+	return u.Update(func(s *CoreProxyRolloutUpsert) {
+		s.AddRevision(v)
+	})
+}
+
+// UpdateRevision sets the "revision" field to the value that was provided on create.
+func (u *CoreProxyRolloutUpsertOne) UpdateRevision() *CoreProxyRolloutUpsertOne {
+	return u.Update(func(s *CoreProxyRolloutUpsert) {
+		s.UpdateRevision()
+	})
+}
+
+// ClearRevision clears the value of the "revision" field.
+func (u *CoreProxyRolloutUpsertOne) ClearRevision() *CoreProxyRolloutUpsertOne {
+	return u.Update(func(s *CoreProxyRolloutUpsert) {
+		s.ClearRevision()
+	})
+}
+
+// SetStableRevision sets the "stable_revision" field.
+func (u *CoreProxyRolloutUpsertOne) SetStableRevision(v int64) *CoreProxyRolloutUpsertOne {
+	return u.Update(func(s *CoreProxyRolloutUpsert) {
+		s.SetStableRevision(v)
+	})
+}
+
+// AddStableRevision adds v to the "stable_revision" field.
+func (u *CoreProxyRolloutUpsertOne) AddStableRevision(v int64) *CoreProxyRolloutUpsertOne {
+	return u.Update(func(s *CoreProxyRolloutUpsert) {
+		s.AddStableRevision(v)
+	})
+}
+
+// UpdateStableRevision sets the "stable_revision" field to the value that was provided on create.
+func (u *CoreProxyRolloutUpsertOne) UpdateStableRevision() *CoreProxyRolloutUpsertOne {
+	return u.Update(func(s *CoreProxyRolloutUpsert) {
+		s.UpdateStableRevision()
+	})
+}
+
+// ClearStableRevision clears the value of the "stable_revision" field.
+func (u *CoreProxyRolloutUpsertOne) ClearStableRevision() *CoreProxyRolloutUpsertOne {
+	return u.Update(func(s *CoreProxyRolloutUpsert) {
+		s.ClearStableRevision()
+	})
+}
+
+// SetCanaryNodeIds sets the "canary_node_ids" field.
+func (u *CoreProxyRolloutUpsertOne) SetCanaryNodeIds(v []string) *CoreProxyRolloutUpsertOne {
+	return u.Update(func(s *CoreProxyRolloutUpsert) {
+		s.SetCanaryNodeIds(v)
+	})
+}
+
+// UpdateCanaryNodeIds sets the "canary_node_ids" field to the value that was provided on create.
+func (u *CoreProxyRolloutUpsertOne) UpdateCanaryNodeIds() *CoreProxyRolloutUpsertOne {
+	return u.Update(func(s *CoreProxyRolloutUpsert) {
+		s.UpdateCanaryNodeIds()
+	})
+}
+
+// ClearCanaryNodeIds clears the value of the "canary_node_ids" field.
+func (u *CoreProxyRolloutUpsertOne) ClearCanaryNodeIds() *CoreProxyRolloutUpsertOne {
+	return u.Update(func(s *CoreProxyRolloutUpsert) {
+		s.ClearCanaryNodeIds()
+	})
+}
+
+// SetBaseline sets the "baseline" field.
+func (u *CoreProxyRolloutUpsertOne) SetBaseline(v map[string]common.TrafficCounter) *CoreProxyRolloutUpsertOne {
+	return u.Update(func(s *CoreProxyRolloutUpsert) {
+		s.SetBaseline(v)
+	})
+}
+
+// UpdateBaseline sets the "baseline" field to the value that was provided on create.
+func (u *CoreProxyRolloutUpsertOne) UpdateBaseline() *CoreProxyRolloutUpsertOne {
+	return u.Update(func(s *CoreProxyRolloutUpsert) {
+		s.UpdateBaseline()
+	})
+}
+
+// ClearBaseline clears the value of the "baseline" field.
+func (u *CoreProxyRolloutUpsertOne) ClearBaseline() *CoreProxyRolloutUpsertOne {
+	return u.Update(func(s *CoreProxyRolloutUpsert) {
+		s.ClearBaseline()
+	})
+}
+
+// SetSoakSeconds sets the "soak_seconds" field.
+func (u *CoreProxyRolloutUpsertOne) SetSoakSeconds(v int) *CoreProxyRolloutUpsertOne {
+	return u.Update(func(s *CoreProxyRolloutUpsert) {
+		s.SetSoakSeconds(v)
+	})
+}
+
+// AddSoakSeconds adds v to the "soak_seconds" field.
+func (u *CoreProxyRolloutUpsertOne) AddSoakSeconds(v int) *CoreProxyRolloutUpsertOne {
+	return u.Update(func(s *CoreProxyRolloutUpsert) {
+		s.AddSoakSeconds(v)
+	})
+}
+
+// UpdateSoakSeconds sets the "soak_seconds" field to the value that was provided on create.
+func (u *CoreProxyRolloutUpsertOne) UpdateSoakSeconds() *CoreProxyRolloutUpsertOne {
+	return u.Update(func(s *CoreProxyRolloutUpsert) {
+		s.UpdateSoakSeconds()
+	})
+}
+
+// ClearSoakSeconds clears the value of the "soak_seconds" field.
+func (u *CoreProxyRolloutUpsertOne) ClearSoakSeconds() *CoreProxyRolloutUpsertOne {
+	return u.Update(func(s *CoreProxyRolloutUpsert) {
+		s.ClearSoakSeconds()
+	})
+}
+
+// SetMaxErrorRate sets the "max_error_rate" field.
+func (u *CoreProxyRolloutUpsertOne) SetMaxErrorRate(v float64) *CoreProxyRolloutUpsertOne {
+	return u.Update(func(s *CoreProxyRolloutUpsert) {
+		s.SetMaxErrorRate(v)
+	})
+}
+
+// AddMaxErrorRate adds v to the "max_error_rate" field.
+func (u *CoreProxyRolloutUpsertOne) AddMaxErrorRate(v float64) *CoreProxyRolloutUpsertOne {
+	return u.Update(func(s *CoreProxyRolloutUpsert) {
+		s.AddMaxErrorRate(v)
+	})
+}
+
+// UpdateMaxErrorRate sets the "max_error_rate" field to the value that was provided on create.
+func (u *CoreProxyRolloutUpsertOne) UpdateMaxErrorRate() *CoreProxyRolloutUpsertOne {
+	return u.Update(func(s *CoreProxyRolloutUpsert) {
+		s.UpdateMaxErrorRate()
+	})
+}
+
+// ClearMaxErrorRate clears the value of the "max_error_rate" field.
+func (u *CoreProxyRolloutUpsertOne) ClearMaxErrorRate() *CoreProxyRolloutUpsertOne {
+	return u.Update(func(s *CoreProxyRolloutUpsert) {
+		s.ClearMaxErrorRate()
+	})
+}
+
+// SetMinRequests sets the "min_requests" field.
+func (u *CoreProxyRolloutUpsertOne) SetMinRequests(v int64) *CoreProxyRolloutUpsertOne {
+	return u.Update(func(s *CoreProxyRolloutUpsert) {
+		s.SetMinRequests(v)
+	})
+}
+
+// AddMinRequests adds v to the "min_requests" field.
+func (u *CoreProxyRolloutUpsertOne) AddMinRequests(v int64) *CoreProxyRolloutUpsertOne {
+	return u.Update(func(s *CoreProxyRolloutUpsert) {
+		s.AddMinRequests(v)
+	})
+}
+
+// UpdateMinRequests sets the "min_requests" field to the value that was provided on create.
+func (u *CoreProxyRolloutUpsertOne) UpdateMinRequests() *CoreProxyRolloutUpsertOne {
+	return u.Update(func(s *CoreProxyRolloutUpsert) {
+		s.UpdateMinRequests()
+	})
+}
+
+// ClearMinRequests clears the value of the "min_requests" field.
+func (u *CoreProxyRolloutUpsertOne) ClearMinRequests() *CoreProxyRolloutUpsertOne {
+	return u.Update(func(s *CoreProxyRolloutUpsert) {
+		s.ClearMinRequests()
+	})
+}
+
+// SetRequests sets the "requests" field.
+func (u *CoreProxyRolloutUpsertOne) SetRequests(v int64) *CoreProxyRolloutUpsertOne {
+	return u.Update(func(s *CoreProxyRolloutUpsert) {
+		s.SetRequests(v)
+	})
+}
+
+// AddRequests adds v to the "requests" field.
+func (u *CoreProxyRolloutUpsertOne) AddRequests(v int64) *CoreProxyRolloutUpsertOne {
+	return u.Update(func(s *CoreProxyRolloutUpsert) {
+		s.AddRequests(v)
+	})
+}
+
+// UpdateRequests sets the "requests" field to the value that was provided on create.
+func (u *CoreProxyRolloutUpsertOne) UpdateRequests() *CoreProxyRolloutUpsertOne {
+	return u.Update(func(s *CoreProxyRolloutUpsert) {
+		s.UpdateRequests()
+	})
+}
+
+// ClearRequests clears the value of the "requests" field.
+func (u *CoreProxyRolloutUpsertOne) ClearRequests() *CoreProxyRolloutUpsertOne {
+	return u.Update(func(s *CoreProxyRolloutUpsert) {
+		s.ClearRequests()
+	})
+}
+
+// SetErrors sets the "errors" field.
+func (u *CoreProxyRolloutUpsertOne) SetErrors(v int64) *CoreProxyRolloutUpsertOne {
+	return u.Update(func(s *CoreProxyRolloutUpsert) {
+		s.SetErrors(v)
+	})
+}
+
+// AddErrors adds v to the "errors" field.
+func (u *CoreProxyRolloutUpsertOne) AddErrors(v int64) *CoreProxyRolloutUpsertOne {
+	return u.Update(func(s *CoreProxyRolloutUpsert) {
+		s.AddErrors(v)
+	})
+}
+
+// UpdateErrors sets the "errors" field to the value that was provided on create.
+func (u *CoreProxyRolloutUpsertOne) UpdateErrors() *CoreProxyRolloutUpsertOne {
+	return u.Update(func(s *CoreProxyRolloutUpsert) {
+		s.UpdateErrors()
+	})
+}
+
+// ClearErrors clears the value of the "errors" field.
+func (u *CoreProxyRolloutUpsertOne) ClearErrors() *CoreProxyRolloutUpsertOne {
+	return u.Update(func(s *CoreProxyRolloutUpsert) {
+		s.ClearErrors()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *CoreProxyRolloutUpsertOne) SetStatus(v constant.ProxyRolloutStatus) *CoreProxyRolloutUpsertOne {
+	return u.Update(func(s *CoreProxyRolloutUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// AddStatus adds v to the "status" field.
+func (u *CoreProxyRolloutUpsertOne) AddStatus(v constant.ProxyRolloutStatus) *CoreProxyRolloutUpsertOne {
+	return u.Update(func(s *CoreProxyRolloutUpsert) {
+		s.AddStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *CoreProxyRolloutUpsertOne) UpdateStatus() *CoreProxyRolloutUpsertOne {
+	return u.Update(func(s *CoreProxyRolloutUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// ClearStatus clears the value of the "status" field.
+func (u *CoreProxyRolloutUpsertOne) ClearStatus() *CoreProxyRolloutUpsertOne {
+	return u.Update(func(s *CoreProxyRolloutUpsert) {
+		s.ClearStatus()
+	})
+}
+
+// SetReason sets the "reason" field.
+func (u *CoreProxyRolloutUpsertOne) SetReason(v string) *CoreProxyRolloutUpsertOne {
+	return u.Update(func(s *CoreProxyRolloutUpsert) {
+		s.SetReason(v)
+	})
+}
+
+// UpdateReason sets the "reason" field to the value that was provided on create.
+func (u *CoreProxyRolloutUpsertOne) UpdateReason() *CoreProxyRolloutUpsertOne {
+	return u.Update(func(s *CoreProxyRolloutUpsert) {
+		s.UpdateReason()
+	})
+}
+
+// ClearReason clears the value of the "reason" field.
+func (u *CoreProxyRolloutUpsertOne) ClearReason() *CoreProxyRolloutUpsertOne {
+	return u.Update(func(s *CoreProxyRolloutUpsert) {
+		s.ClearReason()
+	})
+}
+
+// SetStartedAt sets the "started_at" field.
+func (u *CoreProxyRolloutUpsertOne) SetStartedAt(v int64) *CoreProxyRolloutUpsertOne {
+	return u.Update(func(s *CoreProxyRolloutUpsert) {
+		s.SetStartedAt(v)
+	})
+}
+
+// AddStartedAt adds v to the "started_at" field.
+func (u *CoreProxyRolloutUpsertOne) AddStartedAt(v int64) *CoreProxyRolloutUpsertOne {
+	return u.Update(func(s *CoreProxyRolloutUpsert) {
+		s.AddStartedAt(v)
+	})
+}
+
+// UpdateStartedAt sets the "started_at" field to the value that was provided on create.
+func (u *CoreProxyRolloutUpsertOne) UpdateStartedAt() *CoreProxyRolloutUpsertOne {
+	return u.Update(func(s *CoreProxyRolloutUpsert) {
+		s.UpdateStartedAt()
+	})
+}
+
+// ClearStartedAt clears the value of the "started_at" field.
+func (u *CoreProxyRolloutUpsertOne) ClearStartedAt() *CoreProxyRolloutUpsertOne {
+	return u.Update(func(s *CoreProxyRolloutUpsert) {
+		s.ClearStartedAt()
+	})
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (u *CoreProxyRolloutUpsertOne) SetFinishedAt(v int64) *CoreProxyRolloutUpsertOne {
+	return u.Update(func(s *CoreProxyRolloutUpsert) {
+		s.SetFinishedAt(v)
+	})
+}
+
+// AddFinishedAt adds v to the "finished_at" field.
+func (u *CoreProxyRolloutUpsertOne) AddFinishedAt(v int64) *CoreProxyRolloutUpsertOne {
+	return u.Update(func(s *CoreProxyRolloutUpsert) {
+		s.AddFinishedAt(v)
+	})
+}
+
+// UpdateFinishedAt sets the "finished_at" field to the value that was provided on create.
+func (u *CoreProxyRolloutUpsertOne) UpdateFinishedAt() *CoreProxyRolloutUpsertOne {
+	return u.Update(func(s *CoreProxyRolloutUpsert) {
+		s.UpdateFinishedAt()
+	})
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (u *CoreProxyRolloutUpsertOne) ClearFinishedAt() *CoreProxyRolloutUpsertOne {
+	return u.Update(func(s *CoreProxyRolloutUpsert) {
+		s.ClearFinishedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *CoreProxyRolloutUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for CoreProxyRolloutCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *CoreProxyRolloutUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *CoreProxyRolloutUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: CoreProxyRolloutUpsertOne.ID is not supported by MySQL driver. Use CoreProxyRolloutUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *CoreProxyRolloutUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// CoreProxyRolloutCreateBulk is the builder for creating many CoreProxyRollout entities in bulk.
+type CoreProxyRolloutCreateBulk struct {
+	config
+	err      error
+	builders []*CoreProxyRolloutCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the CoreProxyRollout entities in the database.
+func (_c *CoreProxyRolloutCreateBulk) Save(ctx context.Context) ([]*CoreProxyRollout, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*CoreProxyRollout, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*CoreProxyRolloutMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *CoreProxyRolloutCreateBulk) SaveX(ctx context.Context) []*CoreProxyRollout {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *CoreProxyRolloutCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *CoreProxyRolloutCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.CoreProxyRollout.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.CoreProxyRolloutUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *CoreProxyRolloutCreateBulk) OnConflict(opts ...sql.ConflictOption) *CoreProxyRolloutUpsertBulk {
+	_c.conflict = opts
+	return &CoreProxyRolloutUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.CoreProxyRollout.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *CoreProxyRolloutCreateBulk) OnConflictColumns(columns ...string) *CoreProxyRolloutUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &CoreProxyRolloutUpsertBulk{
+		create: _c,
+	}
+}
+
+// CoreProxyRolloutUpsertBulk is the builder for "upsert"-ing
+// a bulk of CoreProxyRollout nodes.
+type CoreProxyRolloutUpsertBulk struct {
+	create *CoreProxyRolloutCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.CoreProxyRollout.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(coreproxyrollout.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *CoreProxyRolloutUpsertBulk) UpdateNewValues() *CoreProxyRolloutUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(coreproxyrollout.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(coreproxyrollout.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.CoreProxyRollout.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *CoreProxyRolloutUpsertBulk) Ignore() *CoreProxyRolloutUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *CoreProxyRolloutUpsertBulk) DoNothing() *CoreProxyRolloutUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the CoreProxyRolloutCreateBulk.OnConflict
+// documentation for more info.
+func (u *CoreProxyRolloutUpsertBulk) Update(set func(*CoreProxyRolloutUpsert)) *CoreProxyRolloutUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&CoreProxyRolloutUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *CoreProxyRolloutUpsertBulk) SetUpdatedAt(v time.Time) *CoreProxyRolloutUpsertBulk {
+	return u.Update(func(s *CoreProxyRolloutUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *CoreProxyRolloutUpsertBulk) UpdateUpdatedAt() *CoreProxyRolloutUpsertBulk {
+	return u.Update(func(s *CoreProxyRolloutUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *CoreProxyRolloutUpsertBulk) SetDeletedAt(v time.Time) *CoreProxyRolloutUpsertBulk {
+	return u.Update(func(s *CoreProxyRolloutUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *CoreProxyRolloutUpsertBulk) UpdateDeletedAt() *CoreProxyRolloutUpsertBulk {
+	return u.Update(func(s *CoreProxyRolloutUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *CoreProxyRolloutUpsertBulk) ClearDeletedAt() *CoreProxyRolloutUpsertBulk {
+	return u.Update(func(s *CoreProxyRolloutUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetClusterID sets the "cluster_id" field.
+func (u *CoreProxyRolloutUpsertBulk) SetClusterID(v string) *CoreProxyRolloutUpsertBulk {
+	return u.Update(func(s *CoreProxyRolloutUpsert) {
+		s.SetClusterID(v)
+	})
+}
+
+// UpdateClusterID sets the "cluster_id" field to the value that was provided on create.
+func (u *CoreProxyRolloutUpsertBulk) UpdateClusterID() *CoreProxyRolloutUpsertBulk {
+	return u.Update(func(s *CoreProxyRolloutUpsert) {
+		s.UpdateClusterID()
+	})
+}
+
+// ClearClusterID clears the value of the "cluster_id" field.
+func (u *CoreProxyRolloutUpsertBulk) ClearClusterID() *CoreProxyRolloutUpsertBulk {
+	return u.Update(func(s *CoreProxyRolloutUpsert) {
+		s.ClearClusterID()
+	})
+}
+
+// SetRevision sets the "revision" field.
+func (u *CoreProxyRolloutUpsertBulk) SetRevision(v int64) *CoreProxyRolloutUpsertBulk {
+	return u.Update(func(s *CoreProxyRolloutUpsert) {
+		s.SetRevision(v)
+	})
+}
+
+// AddRevision adds v to the "revision" field.
+func (u *CoreProxyRolloutUpsertBulk) AddRevision(v int64) *CoreProxyRolloutUpsertBulk {
+	return u.Update(func(s *CoreProxyRolloutUpsert) {
+		s.AddRevision(v)
+	})
+}
+
+// UpdateRevision sets the "revision" field to the value that was provided on create.
+func (u *CoreProxyRolloutUpsertBulk) UpdateRevision() *CoreProxyRolloutUpsertBulk {
+	return u.Update(func(s *CoreProxyRolloutUpsert) {
+		s.UpdateRevision()
+	})
+}
+
+// ClearRevision clears the value of the "revision" field.
+func (u *CoreProxyRolloutUpsertBulk) ClearRevision() *CoreProxyRolloutUpsertBulk {
+	return u.Update(func(s *CoreProxyRolloutUpsert) {
+		s.ClearRevision()
+	})
+}
+
+// SetStableRevision sets the "stable_revision" field.
+func (u *CoreProxyRolloutUpsertBulk) SetStableRevision(v int64) *CoreProxyRolloutUpsertBulk {
+	return u.Update(func(s *CoreProxyRolloutUpsert) {
+		s.SetStableRevision(v)
+	})
+}
+
+// AddStableRevision adds v to the "stable_revision" field.
+func (u *CoreProxyRolloutUpsertBulk) AddStableRevision(v int64) *CoreProxyRolloutUpsertBulk {
+	return u.Update(func(s *CoreProxyRolloutUpsert) {
+		s.AddStableRevision(v)
+	})
+}
+
+// UpdateStableRevision sets the "stable_revision" field to the value that was provided on create.
+func (u *CoreProxyRolloutUpsertBulk) UpdateStableRevision() *CoreProxyRolloutUpsertBulk {
+	return u.Update(func(s *CoreProxyRolloutUpsert) {
+		s.UpdateStableRevision()
+	})
+}
+
+// ClearStableRevision clears the value of the "stable_revision" field.
+func (u *CoreProxyRolloutUpsertBulk) ClearStableRevision() *CoreProxyRolloutUpsertBulk {
+	return u.Update(func(s *CoreProxyRolloutUpsert) {
+		s.ClearStableRevision()
+	})
+}
+
+// SetCanaryNodeIds sets the "canary_node_ids" field.
+func (u *CoreProxyRolloutUpsertBulk) SetCanaryNodeIds(v []string) *CoreProxyRolloutUpsertBulk {
+	return u.Update(func(s *CoreProxyRolloutUpsert) {
+		s.SetCanaryNodeIds(v)
+	})
+}
+
+// UpdateCanaryNodeIds sets the "canary_node_ids" field to the value that was provided on create.
+func (u *CoreProxyRolloutUpsertBulk) UpdateCanaryNodeIds() *CoreProxyRolloutUpsertBulk {
+	return u.Update(func(s *CoreProxyRolloutUpsert) {
+		s.UpdateCanaryNodeIds()
+	})
+}
+
+// ClearCanaryNodeIds clears the value of the "canary_node_ids" field.
+func (u *CoreProxyRolloutUpsertBulk) ClearCanaryNodeIds() *CoreProxyRolloutUpsertBulk {
+	return u.Update(func(s *CoreProxyRolloutUpsert) {
+		s.ClearCanaryNodeIds()
+	})
+}
+
+// SetBaseline sets the "baseline" field.
+func (u *CoreProxyRolloutUpsertBulk) SetBaseline(v map[string]common.TrafficCounter) *CoreProxyRolloutUpsertBulk {
+	return u.Update(func(s *CoreProxyRolloutUpsert) {
+		s.SetBaseline(v)
+	})
+}
+
+// UpdateBaseline sets the "baseline" field to the value that was provided on create.
+func (u *CoreProxyRolloutUpsertBulk) UpdateBaseline() *CoreProxyRolloutUpsertBulk {
+	return u.Update(func(s *CoreProxyRolloutUpsert) {
+		s.UpdateBaseline()
+	})
+}
+
+// ClearBaseline clears the value of the "baseline" field.
+func (u *CoreProxyRolloutUpsertBulk) ClearBaseline() *CoreProxyRolloutUpsertBulk {
+	return u.Update(func(s *CoreProxyRolloutUpsert) {
+		s.ClearBaseline()
+	})
+}
+
+// SetSoakSeconds sets the "soak_seconds" field.
+func (u *CoreProxyRolloutUpsertBulk) SetSoakSeconds(v int) *CoreProxyRolloutUpsertBulk {
+	return u.Update(func(s *CoreProxyRolloutUpsert) {
+		s.SetSoakSeconds(v)
+	})
+}
+
+// AddSoakSeconds adds v to the "soak_seconds" field.
+func (u *CoreProxyRolloutUpsertBulk) AddSoakSeconds(v int) *CoreProxyRolloutUpsertBulk {
+	return u.Update(func(s *CoreProxyRolloutUpsert) {
+		s.AddSoakSeconds(v)
+	})
+}
+
+// UpdateSoakSeconds sets the "soak_seconds" field to the value that was provided on create.
+func (u *CoreProxyRolloutUpsertBulk) UpdateSoakSeconds() *CoreProxyRolloutUpsertBulk {
+	return u.Update(func(s *CoreProxyRolloutUpsert) {
+		s.UpdateSoakSeconds()
+	})
+}
+
+// ClearSoakSeconds clears the value of the "soak_seconds" field.
+func (u *CoreProxyRolloutUpsertBulk) ClearSoakSeconds() *CoreProxyRolloutUpsertBulk {
+	return u.Update(func(s *CoreProxyRolloutUpsert) {
+		s.ClearSoakSeconds()
+	})
+}
+
+// SetMaxErrorRate sets the "max_error_rate" field.
+func (u *CoreProxyRolloutUpsertBulk) SetMaxErrorRate(v float64) *CoreProxyRolloutUpsertBulk {
+	return u.Update(func(s *CoreProxyRolloutUpsert) {
+		s.SetMaxErrorRate(v)
+	})
+}
+
+// AddMaxErrorRate adds v to the "max_error_rate" field.
+func (u *CoreProxyRolloutUpsertBulk) AddMaxErrorRate(v float64) *CoreProxyRolloutUpsertBulk {
+	return u.Update(func(s *CoreProxyRolloutUpsert) {
+		s.AddMaxErrorRate(v)
+	})
+}
+
+// UpdateMaxErrorRate sets the "max_error_rate" field to the value that was provided on create.
+func (u *CoreProxyRolloutUpsertBulk) UpdateMaxErrorRate() *CoreProxyRolloutUpsertBulk {
+	return u.Update(func(s *CoreProxyRolloutUpsert) {
+		s.UpdateMaxErrorRate()
+	})
+}
+
+// ClearMaxErrorRate clears the value of the "max_error_rate" field.
+func (u *CoreProxyRolloutUpsertBulk) ClearMaxErrorRate() *CoreProxyRolloutUpsertBulk {
+	return u.Update(func(s *CoreProxyRolloutUpsert) {
+		s.ClearMaxErrorRate()
+	})
+}
+
+// SetMinRequests sets the "min_requests" field.
+func (u *CoreProxyRolloutUpsertBulk) SetMinRequests(v int64) *CoreProxyRolloutUpsertBulk {
+	return u.Update(func(s *CoreProxyRolloutUpsert) {
+		s.SetMinRequests(v)
+	})
+}
+
+// AddMinRequests adds v to the "min_requests" field.
+func (u *CoreProxyRolloutUpsertBulk) AddMinRequests(v int64) *CoreProxyRolloutUpsertBulk {
+	return u.Update(func(s *CoreProxyRolloutUpsert) {
+		s.AddMinRequests(v)
+	})
+}
+
+// UpdateMinRequests sets the "min_requests" field to the value that was provided on create.
+func (u *CoreProxyRolloutUpsertBulk) UpdateMinRequests() *CoreProxyRolloutUpsertBulk {
+	return u.Update(func(s *CoreProxyRolloutUpsert) {
+		s.UpdateMinRequests()
+	})
+}
+
+// ClearMinRequests clears the value of the "min_requests" field.
+func (u *CoreProxyRolloutUpsertBulk) ClearMinRequests() *CoreProxyRolloutUpsertBulk {
+	return u.Update(func(s *CoreProxyRolloutUpsert) {
+		s.ClearMinRequests()
+	})
+}
+
+// SetRequests sets the "requests" field.
+func (u *CoreProxyRolloutUpsertBulk) SetRequests(v int64) *CoreProxyRolloutUpsertBulk {
+	return u.Update(func(s *CoreProxyRolloutUpsert) {
+		s.SetRequests(v)
+	})
+}
+
+// AddRequests adds v to the "requests" field.
+func (u *CoreProxyRolloutUpsertBulk) AddRequests(v int64) *CoreProxyRolloutUpsertBulk {
+	return u.Update(func(s *CoreProxyRolloutUpsert) {
+		s.AddRequests(v)
+	})
+}
+
+// UpdateRequests sets the "requests" field to the value that was provided on create.
+func (u *CoreProxyRolloutUpsertBulk) UpdateRequests() *CoreProxyRolloutUpsertBulk {
+	return u.Update(func(s *CoreProxyRolloutUpsert) {
+		s.UpdateRequests()
+	})
+}
+
+// ClearRequests clears the value of the "requests" field.
+func (u *CoreProxyRolloutUpsertBulk) ClearRequests() *CoreProxyRolloutUpsertBulk {
+	return u.Update(func(s *CoreProxyRolloutUpsert) {
+		s.ClearRequests()
+	})
+}
+
+// SetErrors sets the "errors" field.
+func (u *CoreProxyRolloutUpsertBulk) SetErrors(v int64) *CoreProxyRolloutUpsertBulk {
+	return u.Update(func(s *CoreProxyRolloutUpsert) {
+		s.SetErrors(v)
+	})
+}
+
+// AddErrors adds v to the "errors" field.
+func (u *CoreProxyRolloutUpsertBulk) AddErrors(v int64) *CoreProxyRolloutUpsertBulk {
+	return u.Update(func(s *CoreProxyRolloutUpsert) {
+		s.AddErrors(v)
+	})
+}
+
+// UpdateErrors sets the "errors" field to the value that was provided on create.
+func (u *CoreProxyRolloutUpsertBulk) UpdateErrors() *CoreProxyRolloutUpsertBulk {
+	return u.Update(func(s *CoreProxyRolloutUpsert) {
+		s.UpdateErrors()
+	})
+}
+
+// ClearErrors clears the value of the "errors" field.
+func (u *CoreProxyRolloutUpsertBulk) ClearErrors() *CoreProxyRolloutUpsertBulk {
+	return u.Update(func(s *CoreProxyRolloutUpsert) {
+		s.ClearErrors()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *CoreProxyRolloutUpsertBulk) SetStatus(v constant.ProxyRolloutStatus) *CoreProxyRolloutUpsertBulk {
+	return u.Update(func(s *CoreProxyRolloutUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// AddStatus adds v to the "status" field.
+func (u *CoreProxyRolloutUpsertBulk) AddStatus(v constant.ProxyRolloutStatus) *CoreProxyRolloutUpsertBulk {
+	return u.Update(func(s *CoreProxyRolloutUpsert) {
+		s.AddStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *CoreProxyRolloutUpsertBulk) UpdateStatus() *CoreProxyRolloutUpsertBulk {
+	return u.Update(func(s *CoreProxyRolloutUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// ClearStatus clears the value of the "status" field.
+func (u *CoreProxyRolloutUpsertBulk) ClearStatus() *CoreProxyRolloutUpsertBulk {
+	return u.Update(func(s *CoreProxyRolloutUpsert) {
+		s.ClearStatus()
+	})
+}
+
+// SetReason sets the "reason" field.
+func (u *CoreProxyRolloutUpsertBulk) SetReason(v string) *CoreProxyRolloutUpsertBulk {
+	return u.Update(func(s *CoreProxyRolloutUpsert) {
+		s.SetReason(v)
+	})
+}
+
+// UpdateReason sets the "reason" field to the value that was provided on create.
+func (u *CoreProxyRolloutUpsertBulk) UpdateReason() *CoreProxyRolloutUpsertBulk {
+	return u.Update(func(s *CoreProxyRolloutUpsert) {
+		s.UpdateReason()
+	})
+}
+
+// ClearReason clears the value of the "reason" field.
+func (u *CoreProxyRolloutUpsertBulk) ClearReason() *CoreProxyRolloutUpsertBulk {
+	return u.Update(func(s *CoreProxyRolloutUpsert) {
+		s.ClearReason()
+	})
+}
+
+// SetStartedAt sets the "started_at" field.
+func (u *CoreProxyRolloutUpsertBulk) SetStartedAt(v int64) *CoreProxyRolloutUpsertBulk {
+	return u.Update(func(s *CoreProxyRolloutUpsert) {
+		s.SetStartedAt(v)
+	})
+}
+
+// AddStartedAt adds v to the "started_at" field.
+func (u *CoreProxyRolloutUpsertBulk) AddStartedAt(v int64) *CoreProxyRolloutUpsertBulk {
+	return u.Update(func(s *CoreProxyRolloutUpsert) {
+		s.AddStartedAt(v)
+	})
+}
+
+// UpdateStartedAt sets the "started_at" field to the value that was provided on create.
+func (u *CoreProxyRolloutUpsertBulk) UpdateStartedAt() *CoreProxyRolloutUpsertBulk {
+	return u.Update(func(s *CoreProxyRolloutUpsert) {
+		s.UpdateStartedAt()
+	})
+}
+
+// ClearStartedAt clears the value of the "started_at" field.
+func (u *CoreProxyRolloutUpsertBulk) ClearStartedAt() *CoreProxyRolloutUpsertBulk {
+	return u.Update(func(s *CoreProxyRolloutUpsert) {
+		s.ClearStartedAt()
+	})
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (u *CoreProxyRolloutUpsertBulk) SetFinishedAt(v int64) *CoreProxyRolloutUpsertBulk {
+	return u.Update(func(s *CoreProxyRolloutUpsert) {
+		s.SetFinishedAt(v)
+	})
+}
+
+// AddFinishedAt adds v to the "finished_at" field.
+func (u *CoreProxyRolloutUpsertBulk) AddFinishedAt(v int64) *CoreProxyRolloutUpsertBulk {
+	return u.Update(func(s *CoreProxyRolloutUpsert) {
+		s.AddFinishedAt(v)
+	})
+}
+
+// UpdateFinishedAt sets the "finished_at" field to the value that was provided on create.
+func (u *CoreProxyRolloutUpsertBulk) UpdateFinishedAt() *CoreProxyRolloutUpsertBulk {
+	return u.Update(func(s *CoreProxyRolloutUpsert) {
+		s.UpdateFinishedAt()
+	})
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (u *CoreProxyRolloutUpsertBulk) ClearFinishedAt() *CoreProxyRolloutUpsertBulk {
+	return u.Update(func(s *CoreProxyRolloutUpsert) {
+		s.ClearFinishedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *CoreProxyRolloutUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the CoreProxyRolloutCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for CoreProxyRolloutCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *CoreProxyRolloutUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
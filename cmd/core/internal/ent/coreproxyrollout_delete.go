@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/coreproxyrollout"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/predicate"
+)
+
+// CoreProxyRolloutDelete is the builder for deleting a CoreProxyRollout entity.
+type CoreProxyRolloutDelete struct {
+	config
+	hooks    []Hook
+	mutation *CoreProxyRolloutMutation
+}
+
+// Where appends a list predicates to the CoreProxyRolloutDelete builder.
+func (_d *CoreProxyRolloutDelete) Where(ps ...predicate.CoreProxyRollout) *CoreProxyRolloutDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *CoreProxyRolloutDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *CoreProxyRolloutDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *CoreProxyRolloutDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(coreproxyrollout.Table, sqlgraph.NewFieldSpec(coreproxyrollout.FieldID, field.TypeString))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// CoreProxyRolloutDeleteOne is the builder for deleting a single CoreProxyRollout entity.
+type CoreProxyRolloutDeleteOne struct {
+	_d *CoreProxyRolloutDelete
+}
+
+// Where appends a list predicates to the CoreProxyRolloutDelete builder.
+func (_d *CoreProxyRolloutDeleteOne) Where(ps ...predicate.CoreProxyRollout) *CoreProxyRolloutDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *CoreProxyRolloutDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{coreproxyrollout.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *CoreProxyRolloutDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,1213 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/lyonmu/quebec/cmd/core/internal/common"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/coreproxyrollout"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/predicate"
+	"github.com/lyonmu/quebec/pkg/constant"
+)
+
+// CoreProxyRolloutUpdate is the builder for updating CoreProxyRollout entities.
+type CoreProxyRolloutUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *CoreProxyRolloutMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the CoreProxyRolloutUpdate builder.
+func (_u *CoreProxyRolloutUpdate) Where(ps ...predicate.CoreProxyRollout) *CoreProxyRolloutUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *CoreProxyRolloutUpdate) SetUpdatedAt(v time.Time) *CoreProxyRolloutUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *CoreProxyRolloutUpdate) SetDeletedAt(v time.Time) *CoreProxyRolloutUpdate {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *CoreProxyRolloutUpdate) SetNillableDeletedAt(v *time.Time) *CoreProxyRolloutUpdate {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *CoreProxyRolloutUpdate) ClearDeletedAt() *CoreProxyRolloutUpdate {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetClusterID sets the "cluster_id" field.
+func (_u *CoreProxyRolloutUpdate) SetClusterID(v string) *CoreProxyRolloutUpdate {
+	_u.mutation.SetClusterID(v)
+	return _u
+}
+
+// SetNillableClusterID sets the "cluster_id" field if the given value is not nil.
+func (_u *CoreProxyRolloutUpdate) SetNillableClusterID(v *string) *CoreProxyRolloutUpdate {
+	if v != nil {
+		_u.SetClusterID(*v)
+	}
+	return _u
+}
+
+// ClearClusterID clears the value of the "cluster_id" field.
+func (_u *CoreProxyRolloutUpdate) ClearClusterID() *CoreProxyRolloutUpdate {
+	_u.mutation.ClearClusterID()
+	return _u
+}
+
+// SetRevision sets the "revision" field.
+func (_u *CoreProxyRolloutUpdate) SetRevision(v int64) *CoreProxyRolloutUpdate {
+	_u.mutation.ResetRevision()
+	_u.mutation.SetRevision(v)
+	return _u
+}
+
+// SetNillableRevision sets the "revision" field if the given value is not nil.
+func (_u *CoreProxyRolloutUpdate) SetNillableRevision(v *int64) *CoreProxyRolloutUpdate {
+	if v != nil {
+		_u.SetRevision(*v)
+	}
+	return _u
+}
+
+// AddRevision adds value to the "revision" field.
+func (_u *CoreProxyRolloutUpdate) AddRevision(v int64) *CoreProxyRolloutUpdate {
+	_u.mutation.AddRevision(v)
+	return _u
+}
+
+// ClearRevision clears the value of the "revision" field.
+func (_u *CoreProxyRolloutUpdate) ClearRevision() *CoreProxyRolloutUpdate {
+	_u.mutation.ClearRevision()
+	return _u
+}
+
+// SetStableRevision sets the "stable_revision" field.
+func (_u *CoreProxyRolloutUpdate) SetStableRevision(v int64) *CoreProxyRolloutUpdate {
+	_u.mutation.ResetStableRevision()
+	_u.mutation.SetStableRevision(v)
+	return _u
+}
+
+// SetNillableStableRevision sets the "stable_revision" field if the given value is not nil.
+func (_u *CoreProxyRolloutUpdate) SetNillableStableRevision(v *int64) *CoreProxyRolloutUpdate {
+	if v != nil {
+		_u.SetStableRevision(*v)
+	}
+	return _u
+}
+
+// AddStableRevision adds value to the "stable_revision" field.
+func (_u *CoreProxyRolloutUpdate) AddStableRevision(v int64) *CoreProxyRolloutUpdate {
+	_u.mutation.AddStableRevision(v)
+	return _u
+}
+
+// ClearStableRevision clears the value of the "stable_revision" field.
+func (_u *CoreProxyRolloutUpdate) ClearStableRevision() *CoreProxyRolloutUpdate {
+	_u.mutation.ClearStableRevision()
+	return _u
+}
+
+// SetCanaryNodeIds sets the "canary_node_ids" field.
+func (_u *CoreProxyRolloutUpdate) SetCanaryNodeIds(v []string) *CoreProxyRolloutUpdate {
+	_u.mutation.SetCanaryNodeIds(v)
+	return _u
+}
+
+// AppendCanaryNodeIds appends value to the "canary_node_ids" field.
+func (_u *CoreProxyRolloutUpdate) AppendCanaryNodeIds(v []string) *CoreProxyRolloutUpdate {
+	_u.mutation.AppendCanaryNodeIds(v)
+	return _u
+}
+
+// ClearCanaryNodeIds clears the value of the "canary_node_ids" field.
+func (_u *CoreProxyRolloutUpdate) ClearCanaryNodeIds() *CoreProxyRolloutUpdate {
+	_u.mutation.ClearCanaryNodeIds()
+	return _u
+}
+
+// SetBaseline sets the "baseline" field.
+func (_u *CoreProxyRolloutUpdate) SetBaseline(v map[string]common.TrafficCounter) *CoreProxyRolloutUpdate {
+	_u.mutation.SetBaseline(v)
+	return _u
+}
+
+// ClearBaseline clears the value of the "baseline" field.
+func (_u *CoreProxyRolloutUpdate) ClearBaseline() *CoreProxyRolloutUpdate {
+	_u.mutation.ClearBaseline()
+	return _u
+}
+
+// SetSoakSeconds sets the "soak_seconds" field.
+func (_u *CoreProxyRolloutUpdate) SetSoakSeconds(v int) *CoreProxyRolloutUpdate {
+	_u.mutation.ResetSoakSeconds()
+	_u.mutation.SetSoakSeconds(v)
+	return _u
+}
+
+// SetNillableSoakSeconds sets the "soak_seconds" field if the given value is not nil.
+func (_u *CoreProxyRolloutUpdate) SetNillableSoakSeconds(v *int) *CoreProxyRolloutUpdate {
+	if v != nil {
+		_u.SetSoakSeconds(*v)
+	}
+	return _u
+}
+
+// AddSoakSeconds adds value to the "soak_seconds" field.
+func (_u *CoreProxyRolloutUpdate) AddSoakSeconds(v int) *CoreProxyRolloutUpdate {
+	_u.mutation.AddSoakSeconds(v)
+	return _u
+}
+
+// ClearSoakSeconds clears the value of the "soak_seconds" field.
+func (_u *CoreProxyRolloutUpdate) ClearSoakSeconds() *CoreProxyRolloutUpdate {
+	_u.mutation.ClearSoakSeconds()
+	return _u
+}
+
+// SetMaxErrorRate sets the "max_error_rate" field.
+func (_u *CoreProxyRolloutUpdate) SetMaxErrorRate(v float64) *CoreProxyRolloutUpdate {
+	_u.mutation.ResetMaxErrorRate()
+	_u.mutation.SetMaxErrorRate(v)
+	return _u
+}
+
+// SetNillableMaxErrorRate sets the "max_error_rate" field if the given value is not nil.
+func (_u *CoreProxyRolloutUpdate) SetNillableMaxErrorRate(v *float64) *CoreProxyRolloutUpdate {
+	if v != nil {
+		_u.SetMaxErrorRate(*v)
+	}
+	return _u
+}
+
+// AddMaxErrorRate adds value to the "max_error_rate" field.
+func (_u *CoreProxyRolloutUpdate) AddMaxErrorRate(v float64) *CoreProxyRolloutUpdate {
+	_u.mutation.AddMaxErrorRate(v)
+	return _u
+}
+
+// ClearMaxErrorRate clears the value of the "max_error_rate" field.
+func (_u *CoreProxyRolloutUpdate) ClearMaxErrorRate() *CoreProxyRolloutUpdate {
+	_u.mutation.ClearMaxErrorRate()
+	return _u
+}
+
+// SetMinRequests sets the "min_requests" field.
+func (_u *CoreProxyRolloutUpdate) SetMinRequests(v int64) *CoreProxyRolloutUpdate {
+	_u.mutation.ResetMinRequests()
+	_u.mutation.SetMinRequests(v)
+	return _u
+}
+
+// SetNillableMinRequests sets the "min_requests" field if the given value is not nil.
+func (_u *CoreProxyRolloutUpdate) SetNillableMinRequests(v *int64) *CoreProxyRolloutUpdate {
+	if v != nil {
+		_u.SetMinRequests(*v)
+	}
+	return _u
+}
+
+// AddMinRequests adds value to the "min_requests" field.
+func (_u *CoreProxyRolloutUpdate) AddMinRequests(v int64) *CoreProxyRolloutUpdate {
+	_u.mutation.AddMinRequests(v)
+	return _u
+}
+
+// ClearMinRequests clears the value of the "min_requests" field.
+func (_u *CoreProxyRolloutUpdate) ClearMinRequests() *CoreProxyRolloutUpdate {
+	_u.mutation.ClearMinRequests()
+	return _u
+}
+
+// SetRequests sets the "requests" field.
+func (_u *CoreProxyRolloutUpdate) SetRequests(v int64) *CoreProxyRolloutUpdate {
+	_u.mutation.ResetRequests()
+	_u.mutation.SetRequests(v)
+	return _u
+}
+
+// SetNillableRequests sets the "requests" field if the given value is not nil.
+func (_u *CoreProxyRolloutUpdate) SetNillableRequests(v *int64) *CoreProxyRolloutUpdate {
+	if v != nil {
+		_u.SetRequests(*v)
+	}
+	return _u
+}
+
+// AddRequests adds value to the "requests" field.
+func (_u *CoreProxyRolloutUpdate) AddRequests(v int64) *CoreProxyRolloutUpdate {
+	_u.mutation.AddRequests(v)
+	return _u
+}
+
+// ClearRequests clears the value of the "requests" field.
+func (_u *CoreProxyRolloutUpdate) ClearRequests() *CoreProxyRolloutUpdate {
+	_u.mutation.ClearRequests()
+	return _u
+}
+
+// SetErrors sets the "errors" field.
+func (_u *CoreProxyRolloutUpdate) SetErrors(v int64) *CoreProxyRolloutUpdate {
+	_u.mutation.ResetErrors()
+	_u.mutation.SetErrors(v)
+	return _u
+}
+
+// SetNillableErrors sets the "errors" field if the given value is not nil.
+func (_u *CoreProxyRolloutUpdate) SetNillableErrors(v *int64) *CoreProxyRolloutUpdate {
+	if v != nil {
+		_u.SetErrors(*v)
+	}
+	return _u
+}
+
+// AddErrors adds value to the "errors" field.
+func (_u *CoreProxyRolloutUpdate) AddErrors(v int64) *CoreProxyRolloutUpdate {
+	_u.mutation.AddErrors(v)
+	return _u
+}
+
+// ClearErrors clears the value of the "errors" field.
+func (_u *CoreProxyRolloutUpdate) ClearErrors() *CoreProxyRolloutUpdate {
+	_u.mutation.ClearErrors()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *CoreProxyRolloutUpdate) SetStatus(v constant.ProxyRolloutStatus) *CoreProxyRolloutUpdate {
+	_u.mutation.ResetStatus()
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *CoreProxyRolloutUpdate) SetNillableStatus(v *constant.ProxyRolloutStatus) *CoreProxyRolloutUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// AddStatus adds value to the "status" field.
+func (_u *CoreProxyRolloutUpdate) AddStatus(v constant.ProxyRolloutStatus) *CoreProxyRolloutUpdate {
+	_u.mutation.AddStatus(v)
+	return _u
+}
+
+// ClearStatus clears the value of the "status" field.
+func (_u *CoreProxyRolloutUpdate) ClearStatus() *CoreProxyRolloutUpdate {
+	_u.mutation.ClearStatus()
+	return _u
+}
+
+// SetReason sets the "reason" field.
+func (_u *CoreProxyRolloutUpdate) SetReason(v string) *CoreProxyRolloutUpdate {
+	_u.mutation.SetReason(v)
+	return _u
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_u *CoreProxyRolloutUpdate) SetNillableReason(v *string) *CoreProxyRolloutUpdate {
+	if v != nil {
+		_u.SetReason(*v)
+	}
+	return _u
+}
+
+// ClearReason clears the value of the "reason" field.
+func (_u *CoreProxyRolloutUpdate) ClearReason() *CoreProxyRolloutUpdate {
+	_u.mutation.ClearReason()
+	return _u
+}
+
+// SetStartedAt sets the "started_at" field.
+func (_u *CoreProxyRolloutUpdate) SetStartedAt(v int64) *CoreProxyRolloutUpdate {
+	_u.mutation.ResetStartedAt()
+	_u.mutation.SetStartedAt(v)
+	return _u
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (_u *CoreProxyRolloutUpdate) SetNillableStartedAt(v *int64) *CoreProxyRolloutUpdate {
+	if v != nil {
+		_u.SetStartedAt(*v)
+	}
+	return _u
+}
+
+// AddStartedAt adds value to the "started_at" field.
+func (_u *CoreProxyRolloutUpdate) AddStartedAt(v int64) *CoreProxyRolloutUpdate {
+	_u.mutation.AddStartedAt(v)
+	return _u
+}
+
+// ClearStartedAt clears the value of the "started_at" field.
+func (_u *CoreProxyRolloutUpdate) ClearStartedAt() *CoreProxyRolloutUpdate {
+	_u.mutation.ClearStartedAt()
+	return _u
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (_u *CoreProxyRolloutUpdate) SetFinishedAt(v int64) *CoreProxyRolloutUpdate {
+	_u.mutation.ResetFinishedAt()
+	_u.mutation.SetFinishedAt(v)
+	return _u
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (_u *CoreProxyRolloutUpdate) SetNillableFinishedAt(v *int64) *CoreProxyRolloutUpdate {
+	if v != nil {
+		_u.SetFinishedAt(*v)
+	}
+	return _u
+}
+
+// AddFinishedAt adds value to the "finished_at" field.
+func (_u *CoreProxyRolloutUpdate) AddFinishedAt(v int64) *CoreProxyRolloutUpdate {
+	_u.mutation.AddFinishedAt(v)
+	return _u
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (_u *CoreProxyRolloutUpdate) ClearFinishedAt() *CoreProxyRolloutUpdate {
+	_u.mutation.ClearFinishedAt()
+	return _u
+}
+
+// Mutation returns the CoreProxyRolloutMutation object of the builder.
+func (_u *CoreProxyRolloutUpdate) Mutation() *CoreProxyRolloutMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *CoreProxyRolloutUpdate) Save(ctx context.Context) (int, error) {
+	if err := _u.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *CoreProxyRolloutUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *CoreProxyRolloutUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *CoreProxyRolloutUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *CoreProxyRolloutUpdate) defaults() error {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		if coreproxyrollout.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized coreproxyrollout.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := coreproxyrollout.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *CoreProxyRolloutUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *CoreProxyRolloutUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *CoreProxyRolloutUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(coreproxyrollout.Table, coreproxyrollout.Columns, sqlgraph.NewFieldSpec(coreproxyrollout.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(coreproxyrollout.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(coreproxyrollout.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(coreproxyrollout.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ClusterID(); ok {
+		_spec.SetField(coreproxyrollout.FieldClusterID, field.TypeString, value)
+	}
+	if _u.mutation.ClusterIDCleared() {
+		_spec.ClearField(coreproxyrollout.FieldClusterID, field.TypeString)
+	}
+	if value, ok := _u.mutation.Revision(); ok {
+		_spec.SetField(coreproxyrollout.FieldRevision, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedRevision(); ok {
+		_spec.AddField(coreproxyrollout.FieldRevision, field.TypeInt64, value)
+	}
+	if _u.mutation.RevisionCleared() {
+		_spec.ClearField(coreproxyrollout.FieldRevision, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.StableRevision(); ok {
+		_spec.SetField(coreproxyrollout.FieldStableRevision, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedStableRevision(); ok {
+		_spec.AddField(coreproxyrollout.FieldStableRevision, field.TypeInt64, value)
+	}
+	if _u.mutation.StableRevisionCleared() {
+		_spec.ClearField(coreproxyrollout.FieldStableRevision, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.CanaryNodeIds(); ok {
+		_spec.SetField(coreproxyrollout.FieldCanaryNodeIds, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedCanaryNodeIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, coreproxyrollout.FieldCanaryNodeIds, value)
+		})
+	}
+	if _u.mutation.CanaryNodeIdsCleared() {
+		_spec.ClearField(coreproxyrollout.FieldCanaryNodeIds, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Baseline(); ok {
+		_spec.SetField(coreproxyrollout.FieldBaseline, field.TypeJSON, value)
+	}
+	if _u.mutation.BaselineCleared() {
+		_spec.ClearField(coreproxyrollout.FieldBaseline, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.SoakSeconds(); ok {
+		_spec.SetField(coreproxyrollout.FieldSoakSeconds, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSoakSeconds(); ok {
+		_spec.AddField(coreproxyrollout.FieldSoakSeconds, field.TypeInt, value)
+	}
+	if _u.mutation.SoakSecondsCleared() {
+		_spec.ClearField(coreproxyrollout.FieldSoakSeconds, field.TypeInt)
+	}
+	if value, ok := _u.mutation.MaxErrorRate(); ok {
+		_spec.SetField(coreproxyrollout.FieldMaxErrorRate, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedMaxErrorRate(); ok {
+		_spec.AddField(coreproxyrollout.FieldMaxErrorRate, field.TypeFloat64, value)
+	}
+	if _u.mutation.MaxErrorRateCleared() {
+		_spec.ClearField(coreproxyrollout.FieldMaxErrorRate, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.MinRequests(); ok {
+		_spec.SetField(coreproxyrollout.FieldMinRequests, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedMinRequests(); ok {
+		_spec.AddField(coreproxyrollout.FieldMinRequests, field.TypeInt64, value)
+	}
+	if _u.mutation.MinRequestsCleared() {
+		_spec.ClearField(coreproxyrollout.FieldMinRequests, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.Requests(); ok {
+		_spec.SetField(coreproxyrollout.FieldRequests, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedRequests(); ok {
+		_spec.AddField(coreproxyrollout.FieldRequests, field.TypeInt64, value)
+	}
+	if _u.mutation.RequestsCleared() {
+		_spec.ClearField(coreproxyrollout.FieldRequests, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.Errors(); ok {
+		_spec.SetField(coreproxyrollout.FieldErrors, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedErrors(); ok {
+		_spec.AddField(coreproxyrollout.FieldErrors, field.TypeInt64, value)
+	}
+	if _u.mutation.ErrorsCleared() {
+		_spec.ClearField(coreproxyrollout.FieldErrors, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(coreproxyrollout.FieldStatus, field.TypeInt8, value)
+	}
+	if value, ok := _u.mutation.AddedStatus(); ok {
+		_spec.AddField(coreproxyrollout.FieldStatus, field.TypeInt8, value)
+	}
+	if _u.mutation.StatusCleared() {
+		_spec.ClearField(coreproxyrollout.FieldStatus, field.TypeInt8)
+	}
+	if value, ok := _u.mutation.Reason(); ok {
+		_spec.SetField(coreproxyrollout.FieldReason, field.TypeString, value)
+	}
+	if _u.mutation.ReasonCleared() {
+		_spec.ClearField(coreproxyrollout.FieldReason, field.TypeString)
+	}
+	if value, ok := _u.mutation.StartedAt(); ok {
+		_spec.SetField(coreproxyrollout.FieldStartedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedStartedAt(); ok {
+		_spec.AddField(coreproxyrollout.FieldStartedAt, field.TypeInt64, value)
+	}
+	if _u.mutation.StartedAtCleared() {
+		_spec.ClearField(coreproxyrollout.FieldStartedAt, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.FinishedAt(); ok {
+		_spec.SetField(coreproxyrollout.FieldFinishedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedFinishedAt(); ok {
+		_spec.AddField(coreproxyrollout.FieldFinishedAt, field.TypeInt64, value)
+	}
+	if _u.mutation.FinishedAtCleared() {
+		_spec.ClearField(coreproxyrollout.FieldFinishedAt, field.TypeInt64)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{coreproxyrollout.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// CoreProxyRolloutUpdateOne is the builder for updating a single CoreProxyRollout entity.
+type CoreProxyRolloutUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *CoreProxyRolloutMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *CoreProxyRolloutUpdateOne) SetUpdatedAt(v time.Time) *CoreProxyRolloutUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *CoreProxyRolloutUpdateOne) SetDeletedAt(v time.Time) *CoreProxyRolloutUpdateOne {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *CoreProxyRolloutUpdateOne) SetNillableDeletedAt(v *time.Time) *CoreProxyRolloutUpdateOne {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *CoreProxyRolloutUpdateOne) ClearDeletedAt() *CoreProxyRolloutUpdateOne {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetClusterID sets the "cluster_id" field.
+func (_u *CoreProxyRolloutUpdateOne) SetClusterID(v string) *CoreProxyRolloutUpdateOne {
+	_u.mutation.SetClusterID(v)
+	return _u
+}
+
+// SetNillableClusterID sets the "cluster_id" field if the given value is not nil.
+func (_u *CoreProxyRolloutUpdateOne) SetNillableClusterID(v *string) *CoreProxyRolloutUpdateOne {
+	if v != nil {
+		_u.SetClusterID(*v)
+	}
+	return _u
+}
+
+// ClearClusterID clears the value of the "cluster_id" field.
+func (_u *CoreProxyRolloutUpdateOne) ClearClusterID() *CoreProxyRolloutUpdateOne {
+	_u.mutation.ClearClusterID()
+	return _u
+}
+
+// SetRevision sets the "revision" field.
+func (_u *CoreProxyRolloutUpdateOne) SetRevision(v int64) *CoreProxyRolloutUpdateOne {
+	_u.mutation.ResetRevision()
+	_u.mutation.SetRevision(v)
+	return _u
+}
+
+// SetNillableRevision sets the "revision" field if the given value is not nil.
+func (_u *CoreProxyRolloutUpdateOne) SetNillableRevision(v *int64) *CoreProxyRolloutUpdateOne {
+	if v != nil {
+		_u.SetRevision(*v)
+	}
+	return _u
+}
+
+// AddRevision adds value to the "revision" field.
+func (_u *CoreProxyRolloutUpdateOne) AddRevision(v int64) *CoreProxyRolloutUpdateOne {
+	_u.mutation.AddRevision(v)
+	return _u
+}
+
+// ClearRevision clears the value of the "revision" field.
+func (_u *CoreProxyRolloutUpdateOne) ClearRevision() *CoreProxyRolloutUpdateOne {
+	_u.mutation.ClearRevision()
+	return _u
+}
+
+// SetStableRevision sets the "stable_revision" field.
+func (_u *CoreProxyRolloutUpdateOne) SetStableRevision(v int64) *CoreProxyRolloutUpdateOne {
+	_u.mutation.ResetStableRevision()
+	_u.mutation.SetStableRevision(v)
+	return _u
+}
+
+// SetNillableStableRevision sets the "stable_revision" field if the given value is not nil.
+func (_u *CoreProxyRolloutUpdateOne) SetNillableStableRevision(v *int64) *CoreProxyRolloutUpdateOne {
+	if v != nil {
+		_u.SetStableRevision(*v)
+	}
+	return _u
+}
+
+// AddStableRevision adds value to the "stable_revision" field.
+func (_u *CoreProxyRolloutUpdateOne) AddStableRevision(v int64) *CoreProxyRolloutUpdateOne {
+	_u.mutation.AddStableRevision(v)
+	return _u
+}
+
+// ClearStableRevision clears the value of the "stable_revision" field.
+func (_u *CoreProxyRolloutUpdateOne) ClearStableRevision() *CoreProxyRolloutUpdateOne {
+	_u.mutation.ClearStableRevision()
+	return _u
+}
+
+// SetCanaryNodeIds sets the "canary_node_ids" field.
+func (_u *CoreProxyRolloutUpdateOne) SetCanaryNodeIds(v []string) *CoreProxyRolloutUpdateOne {
+	_u.mutation.SetCanaryNodeIds(v)
+	return _u
+}
+
+// AppendCanaryNodeIds appends value to the "canary_node_ids" field.
+func (_u *CoreProxyRolloutUpdateOne) AppendCanaryNodeIds(v []string) *CoreProxyRolloutUpdateOne {
+	_u.mutation.AppendCanaryNodeIds(v)
+	return _u
+}
+
+// ClearCanaryNodeIds clears the value of the "canary_node_ids" field.
+func (_u *CoreProxyRolloutUpdateOne) ClearCanaryNodeIds() *CoreProxyRolloutUpdateOne {
+	_u.mutation.ClearCanaryNodeIds()
+	return _u
+}
+
+// SetBaseline sets the "baseline" field.
+func (_u *CoreProxyRolloutUpdateOne) SetBaseline(v map[string]common.TrafficCounter) *CoreProxyRolloutUpdateOne {
+	_u.mutation.SetBaseline(v)
+	return _u
+}
+
+// ClearBaseline clears the value of the "baseline" field.
+func (_u *CoreProxyRolloutUpdateOne) ClearBaseline() *CoreProxyRolloutUpdateOne {
+	_u.mutation.ClearBaseline()
+	return _u
+}
+
+// SetSoakSeconds sets the "soak_seconds" field.
+func (_u *CoreProxyRolloutUpdateOne) SetSoakSeconds(v int) *CoreProxyRolloutUpdateOne {
+	_u.mutation.ResetSoakSeconds()
+	_u.mutation.SetSoakSeconds(v)
+	return _u
+}
+
+// SetNillableSoakSeconds sets the "soak_seconds" field if the given value is not nil.
+func (_u *CoreProxyRolloutUpdateOne) SetNillableSoakSeconds(v *int) *CoreProxyRolloutUpdateOne {
+	if v != nil {
+		_u.SetSoakSeconds(*v)
+	}
+	return _u
+}
+
+// AddSoakSeconds adds value to the "soak_seconds" field.
+func (_u *CoreProxyRolloutUpdateOne) AddSoakSeconds(v int) *CoreProxyRolloutUpdateOne {
+	_u.mutation.AddSoakSeconds(v)
+	return _u
+}
+
+// ClearSoakSeconds clears the value of the "soak_seconds" field.
+func (_u *CoreProxyRolloutUpdateOne) ClearSoakSeconds() *CoreProxyRolloutUpdateOne {
+	_u.mutation.ClearSoakSeconds()
+	return _u
+}
+
+// SetMaxErrorRate sets the "max_error_rate" field.
+func (_u *CoreProxyRolloutUpdateOne) SetMaxErrorRate(v float64) *CoreProxyRolloutUpdateOne {
+	_u.mutation.ResetMaxErrorRate()
+	_u.mutation.SetMaxErrorRate(v)
+	return _u
+}
+
+// SetNillableMaxErrorRate sets the "max_error_rate" field if the given value is not nil.
+func (_u *CoreProxyRolloutUpdateOne) SetNillableMaxErrorRate(v *float64) *CoreProxyRolloutUpdateOne {
+	if v != nil {
+		_u.SetMaxErrorRate(*v)
+	}
+	return _u
+}
+
+// AddMaxErrorRate adds value to the "max_error_rate" field.
+func (_u *CoreProxyRolloutUpdateOne) AddMaxErrorRate(v float64) *CoreProxyRolloutUpdateOne {
+	_u.mutation.AddMaxErrorRate(v)
+	return _u
+}
+
+// ClearMaxErrorRate clears the value of the "max_error_rate" field.
+func (_u *CoreProxyRolloutUpdateOne) ClearMaxErrorRate() *CoreProxyRolloutUpdateOne {
+	_u.mutation.ClearMaxErrorRate()
+	return _u
+}
+
+// SetMinRequests sets the "min_requests" field.
+func (_u *CoreProxyRolloutUpdateOne) SetMinRequests(v int64) *CoreProxyRolloutUpdateOne {
+	_u.mutation.ResetMinRequests()
+	_u.mutation.SetMinRequests(v)
+	return _u
+}
+
+// SetNillableMinRequests sets the "min_requests" field if the given value is not nil.
+func (_u *CoreProxyRolloutUpdateOne) SetNillableMinRequests(v *int64) *CoreProxyRolloutUpdateOne {
+	if v != nil {
+		_u.SetMinRequests(*v)
+	}
+	return _u
+}
+
+// AddMinRequests adds value to the "min_requests" field.
+func (_u *CoreProxyRolloutUpdateOne) AddMinRequests(v int64) *CoreProxyRolloutUpdateOne {
+	_u.mutation.AddMinRequests(v)
+	return _u
+}
+
+// ClearMinRequests clears the value of the "min_requests" field.
+func (_u *CoreProxyRolloutUpdateOne) ClearMinRequests() *CoreProxyRolloutUpdateOne {
+	_u.mutation.ClearMinRequests()
+	return _u
+}
+
+// SetRequests sets the "requests" field.
+func (_u *CoreProxyRolloutUpdateOne) SetRequests(v int64) *CoreProxyRolloutUpdateOne {
+	_u.mutation.ResetRequests()
+	_u.mutation.SetRequests(v)
+	return _u
+}
+
+// SetNillableRequests sets the "requests" field if the given value is not nil.
+func (_u *CoreProxyRolloutUpdateOne) SetNillableRequests(v *int64) *CoreProxyRolloutUpdateOne {
+	if v != nil {
+		_u.SetRequests(*v)
+	}
+	return _u
+}
+
+// AddRequests adds value to the "requests" field.
+func (_u *CoreProxyRolloutUpdateOne) AddRequests(v int64) *CoreProxyRolloutUpdateOne {
+	_u.mutation.AddRequests(v)
+	return _u
+}
+
+// ClearRequests clears the value of the "requests" field.
+func (_u *CoreProxyRolloutUpdateOne) ClearRequests() *CoreProxyRolloutUpdateOne {
+	_u.mutation.ClearRequests()
+	return _u
+}
+
+// SetErrors sets the "errors" field.
+func (_u *CoreProxyRolloutUpdateOne) SetErrors(v int64) *CoreProxyRolloutUpdateOne {
+	_u.mutation.ResetErrors()
+	_u.mutation.SetErrors(v)
+	return _u
+}
+
+// SetNillableErrors sets the "errors" field if the given value is not nil.
+func (_u *CoreProxyRolloutUpdateOne) SetNillableErrors(v *int64) *CoreProxyRolloutUpdateOne {
+	if v != nil {
+		_u.SetErrors(*v)
+	}
+	return _u
+}
+
+// AddErrors adds value to the "errors" field.
+func (_u *CoreProxyRolloutUpdateOne) AddErrors(v int64) *CoreProxyRolloutUpdateOne {
+	_u.mutation.AddErrors(v)
+	return _u
+}
+
+// ClearErrors clears the value of the "errors" field.
+func (_u *CoreProxyRolloutUpdateOne) ClearErrors() *CoreProxyRolloutUpdateOne {
+	_u.mutation.ClearErrors()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *CoreProxyRolloutUpdateOne) SetStatus(v constant.ProxyRolloutStatus) *CoreProxyRolloutUpdateOne {
+	_u.mutation.ResetStatus()
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *CoreProxyRolloutUpdateOne) SetNillableStatus(v *constant.ProxyRolloutStatus) *CoreProxyRolloutUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// AddStatus adds value to the "status" field.
+func (_u *CoreProxyRolloutUpdateOne) AddStatus(v constant.ProxyRolloutStatus) *CoreProxyRolloutUpdateOne {
+	_u.mutation.AddStatus(v)
+	return _u
+}
+
+// ClearStatus clears the value of the "status" field.
+func (_u *CoreProxyRolloutUpdateOne) ClearStatus() *CoreProxyRolloutUpdateOne {
+	_u.mutation.ClearStatus()
+	return _u
+}
+
+// SetReason sets the "reason" field.
+func (_u *CoreProxyRolloutUpdateOne) SetReason(v string) *CoreProxyRolloutUpdateOne {
+	_u.mutation.SetReason(v)
+	return _u
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_u *CoreProxyRolloutUpdateOne) SetNillableReason(v *string) *CoreProxyRolloutUpdateOne {
+	if v != nil {
+		_u.SetReason(*v)
+	}
+	return _u
+}
+
+// ClearReason clears the value of the "reason" field.
+func (_u *CoreProxyRolloutUpdateOne) ClearReason() *CoreProxyRolloutUpdateOne {
+	_u.mutation.ClearReason()
+	return _u
+}
+
+// SetStartedAt sets the "started_at" field.
+func (_u *CoreProxyRolloutUpdateOne) SetStartedAt(v int64) *CoreProxyRolloutUpdateOne {
+	_u.mutation.ResetStartedAt()
+	_u.mutation.SetStartedAt(v)
+	return _u
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (_u *CoreProxyRolloutUpdateOne) SetNillableStartedAt(v *int64) *CoreProxyRolloutUpdateOne {
+	if v != nil {
+		_u.SetStartedAt(*v)
+	}
+	return _u
+}
+
+// AddStartedAt adds value to the "started_at" field.
+func (_u *CoreProxyRolloutUpdateOne) AddStartedAt(v int64) *CoreProxyRolloutUpdateOne {
+	_u.mutation.AddStartedAt(v)
+	return _u
+}
+
+// ClearStartedAt clears the value of the "started_at" field.
+func (_u *CoreProxyRolloutUpdateOne) ClearStartedAt() *CoreProxyRolloutUpdateOne {
+	_u.mutation.ClearStartedAt()
+	return _u
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (_u *CoreProxyRolloutUpdateOne) SetFinishedAt(v int64) *CoreProxyRolloutUpdateOne {
+	_u.mutation.ResetFinishedAt()
+	_u.mutation.SetFinishedAt(v)
+	return _u
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (_u *CoreProxyRolloutUpdateOne) SetNillableFinishedAt(v *int64) *CoreProxyRolloutUpdateOne {
+	if v != nil {
+		_u.SetFinishedAt(*v)
+	}
+	return _u
+}
+
+// AddFinishedAt adds value to the "finished_at" field.
+func (_u *CoreProxyRolloutUpdateOne) AddFinishedAt(v int64) *CoreProxyRolloutUpdateOne {
+	_u.mutation.AddFinishedAt(v)
+	return _u
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (_u *CoreProxyRolloutUpdateOne) ClearFinishedAt() *CoreProxyRolloutUpdateOne {
+	_u.mutation.ClearFinishedAt()
+	return _u
+}
+
+// Mutation returns the CoreProxyRolloutMutation object of the builder.
+func (_u *CoreProxyRolloutUpdateOne) Mutation() *CoreProxyRolloutMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the CoreProxyRolloutUpdate builder.
+func (_u *CoreProxyRolloutUpdateOne) Where(ps ...predicate.CoreProxyRollout) *CoreProxyRolloutUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *CoreProxyRolloutUpdateOne) Select(field string, fields ...string) *CoreProxyRolloutUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated CoreProxyRollout entity.
+func (_u *CoreProxyRolloutUpdateOne) Save(ctx context.Context) (*CoreProxyRollout, error) {
+	if err := _u.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *CoreProxyRolloutUpdateOne) SaveX(ctx context.Context) *CoreProxyRollout {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *CoreProxyRolloutUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *CoreProxyRolloutUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *CoreProxyRolloutUpdateOne) defaults() error {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		if coreproxyrollout.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized coreproxyrollout.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := coreproxyrollout.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *CoreProxyRolloutUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *CoreProxyRolloutUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *CoreProxyRolloutUpdateOne) sqlSave(ctx context.Context) (_node *CoreProxyRollout, err error) {
+	_spec := sqlgraph.NewUpdateSpec(coreproxyrollout.Table, coreproxyrollout.Columns, sqlgraph.NewFieldSpec(coreproxyrollout.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "CoreProxyRollout.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, coreproxyrollout.FieldID)
+		for _, f := range fields {
+			if !coreproxyrollout.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != coreproxyrollout.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(coreproxyrollout.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(coreproxyrollout.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(coreproxyrollout.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ClusterID(); ok {
+		_spec.SetField(coreproxyrollout.FieldClusterID, field.TypeString, value)
+	}
+	if _u.mutation.ClusterIDCleared() {
+		_spec.ClearField(coreproxyrollout.FieldClusterID, field.TypeString)
+	}
+	if value, ok := _u.mutation.Revision(); ok {
+		_spec.SetField(coreproxyrollout.FieldRevision, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedRevision(); ok {
+		_spec.AddField(coreproxyrollout.FieldRevision, field.TypeInt64, value)
+	}
+	if _u.mutation.RevisionCleared() {
+		_spec.ClearField(coreproxyrollout.FieldRevision, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.StableRevision(); ok {
+		_spec.SetField(coreproxyrollout.FieldStableRevision, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedStableRevision(); ok {
+		_spec.AddField(coreproxyrollout.FieldStableRevision, field.TypeInt64, value)
+	}
+	if _u.mutation.StableRevisionCleared() {
+		_spec.ClearField(coreproxyrollout.FieldStableRevision, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.CanaryNodeIds(); ok {
+		_spec.SetField(coreproxyrollout.FieldCanaryNodeIds, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedCanaryNodeIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, coreproxyrollout.FieldCanaryNodeIds, value)
+		})
+	}
+	if _u.mutation.CanaryNodeIdsCleared() {
+		_spec.ClearField(coreproxyrollout.FieldCanaryNodeIds, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Baseline(); ok {
+		_spec.SetField(coreproxyrollout.FieldBaseline, field.TypeJSON, value)
+	}
+	if _u.mutation.BaselineCleared() {
+		_spec.ClearField(coreproxyrollout.FieldBaseline, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.SoakSeconds(); ok {
+		_spec.SetField(coreproxyrollout.FieldSoakSeconds, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSoakSeconds(); ok {
+		_spec.AddField(coreproxyrollout.FieldSoakSeconds, field.TypeInt, value)
+	}
+	if _u.mutation.SoakSecondsCleared() {
+		_spec.ClearField(coreproxyrollout.FieldSoakSeconds, field.TypeInt)
+	}
+	if value, ok := _u.mutation.MaxErrorRate(); ok {
+		_spec.SetField(coreproxyrollout.FieldMaxErrorRate, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedMaxErrorRate(); ok {
+		_spec.AddField(coreproxyrollout.FieldMaxErrorRate, field.TypeFloat64, value)
+	}
+	if _u.mutation.MaxErrorRateCleared() {
+		_spec.ClearField(coreproxyrollout.FieldMaxErrorRate, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.MinRequests(); ok {
+		_spec.SetField(coreproxyrollout.FieldMinRequests, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedMinRequests(); ok {
+		_spec.AddField(coreproxyrollout.FieldMinRequests, field.TypeInt64, value)
+	}
+	if _u.mutation.MinRequestsCleared() {
+		_spec.ClearField(coreproxyrollout.FieldMinRequests, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.Requests(); ok {
+		_spec.SetField(coreproxyrollout.FieldRequests, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedRequests(); ok {
+		_spec.AddField(coreproxyrollout.FieldRequests, field.TypeInt64, value)
+	}
+	if _u.mutation.RequestsCleared() {
+		_spec.ClearField(coreproxyrollout.FieldRequests, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.Errors(); ok {
+		_spec.SetField(coreproxyrollout.FieldErrors, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedErrors(); ok {
+		_spec.AddField(coreproxyrollout.FieldErrors, field.TypeInt64, value)
+	}
+	if _u.mutation.ErrorsCleared() {
+		_spec.ClearField(coreproxyrollout.FieldErrors, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(coreproxyrollout.FieldStatus, field.TypeInt8, value)
+	}
+	if value, ok := _u.mutation.AddedStatus(); ok {
+		_spec.AddField(coreproxyrollout.FieldStatus, field.TypeInt8, value)
+	}
+	if _u.mutation.StatusCleared() {
+		_spec.ClearField(coreproxyrollout.FieldStatus, field.TypeInt8)
+	}
+	if value, ok := _u.mutation.Reason(); ok {
+		_spec.SetField(coreproxyrollout.FieldReason, field.TypeString, value)
+	}
+	if _u.mutation.ReasonCleared() {
+		_spec.ClearField(coreproxyrollout.FieldReason, field.TypeString)
+	}
+	if value, ok := _u.mutation.StartedAt(); ok {
+		_spec.SetField(coreproxyrollout.FieldStartedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedStartedAt(); ok {
+		_spec.AddField(coreproxyrollout.FieldStartedAt, field.TypeInt64, value)
+	}
+	if _u.mutation.StartedAtCleared() {
+		_spec.ClearField(coreproxyrollout.FieldStartedAt, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.FinishedAt(); ok {
+		_spec.SetField(coreproxyrollout.FieldFinishedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedFinishedAt(); ok {
+		_spec.AddField(coreproxyrollout.FieldFinishedAt, field.TypeInt64, value)
+	}
+	if _u.mutation.FinishedAtCleared() {
+		_spec.ClearField(coreproxyrollout.FieldFinishedAt, field.TypeInt64)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &CoreProxyRollout{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{coreproxyrollout.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,217 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/coreproxyrolloutpolicy"
+	"github.com/lyonmu/quebec/pkg/constant"
+)
+
+// 灰度发布策略表
+type CoreProxyRolloutPolicy struct {
+	config `json:"-"`
+	// ID of the ent.
+	// 主键ID
+	ID string `json:"id,omitempty"`
+	// 创建时间
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// 更新时间
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// 删除时间
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// 网关集群ID (Envoy node.cluster)
+	ClusterID string `json:"cluster_id,omitempty"`
+	// 灰度节点比例(%)，指定灰度节点时忽略
+	Percentage int `json:"percentage,omitempty"`
+	// 指定的灰度节点 (Envoy node.id)
+	NodeIds []string `json:"node_ids,omitempty"`
+	// 观察时长(秒)，期间无 NACK 且错误率达标则自动全量
+	SoakSeconds int `json:"soak_seconds,omitempty"`
+	// 灰度节点允许的最大错误率(%)，超过则自动中止
+	MaxErrorRate float64 `json:"max_error_rate,omitempty"`
+	// 评估错误率所需的最少请求数
+	MinRequests int64 `json:"min_requests,omitempty"`
+	// 是否启用 [1: 启用, 2: 禁用]
+	Status       constant.YesOrNo `json:"status,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*CoreProxyRolloutPolicy) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case coreproxyrolloutpolicy.FieldNodeIds:
+			values[i] = new([]byte)
+		case coreproxyrolloutpolicy.FieldMaxErrorRate:
+			values[i] = new(sql.NullFloat64)
+		case coreproxyrolloutpolicy.FieldPercentage, coreproxyrolloutpolicy.FieldSoakSeconds, coreproxyrolloutpolicy.FieldMinRequests, coreproxyrolloutpolicy.FieldStatus:
+			values[i] = new(sql.NullInt64)
+		case coreproxyrolloutpolicy.FieldID, coreproxyrolloutpolicy.FieldClusterID:
+			values[i] = new(sql.NullString)
+		case coreproxyrolloutpolicy.FieldCreatedAt, coreproxyrolloutpolicy.FieldUpdatedAt, coreproxyrolloutpolicy.FieldDeletedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the CoreProxyRolloutPolicy fields.
+func (_m *CoreProxyRolloutPolicy) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case coreproxyrolloutpolicy.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case coreproxyrolloutpolicy.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case coreproxyrolloutpolicy.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case coreproxyrolloutpolicy.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				_m.DeletedAt = new(time.Time)
+				*_m.DeletedAt = value.Time
+			}
+		case coreproxyrolloutpolicy.FieldClusterID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field cluster_id", values[i])
+			} else if value.Valid {
+				_m.ClusterID = value.String
+			}
+		case coreproxyrolloutpolicy.FieldPercentage:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field percentage", values[i])
+			} else if value.Valid {
+				_m.Percentage = int(value.Int64)
+			}
+		case coreproxyrolloutpolicy.FieldNodeIds:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field node_ids", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.NodeIds); err != nil {
+					return fmt.Errorf("unmarshal field node_ids: %w", err)
+				}
+			}
+		case coreproxyrolloutpolicy.FieldSoakSeconds:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field soak_seconds", values[i])
+			} else if value.Valid {
+				_m.SoakSeconds = int(value.Int64)
+			}
+		case coreproxyrolloutpolicy.FieldMaxErrorRate:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field max_error_rate", values[i])
+			} else if value.Valid {
+				_m.MaxErrorRate = value.Float64
+			}
+		case coreproxyrolloutpolicy.FieldMinRequests:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field min_requests", values[i])
+			} else if value.Valid {
+				_m.MinRequests = value.Int64
+			}
+		case coreproxyrolloutpolicy.FieldStatus:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = constant.YesOrNo(value.Int64)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the CoreProxyRolloutPolicy.
+// This includes values selected through modifiers, order, etc.
+func (_m *CoreProxyRolloutPolicy) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this CoreProxyRolloutPolicy.
+// Note that you need to call CoreProxyRolloutPolicy.Unwrap() before calling this method if this CoreProxyRolloutPolicy
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *CoreProxyRolloutPolicy) Update() *CoreProxyRolloutPolicyUpdateOne {
+	return NewCoreProxyRolloutPolicyClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the CoreProxyRolloutPolicy entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *CoreProxyRolloutPolicy) Unwrap() *CoreProxyRolloutPolicy {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: CoreProxyRolloutPolicy is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *CoreProxyRolloutPolicy) String() string {
+	var builder strings.Builder
+	builder.WriteString("CoreProxyRolloutPolicy(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("cluster_id=")
+	builder.WriteString(_m.ClusterID)
+	builder.WriteString(", ")
+	builder.WriteString("percentage=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Percentage))
+	builder.WriteString(", ")
+	builder.WriteString("node_ids=")
+	builder.WriteString(fmt.Sprintf("%v", _m.NodeIds))
+	builder.WriteString(", ")
+	builder.WriteString("soak_seconds=")
+	builder.WriteString(fmt.Sprintf("%v", _m.SoakSeconds))
+	builder.WriteString(", ")
+	builder.WriteString("max_error_rate=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MaxErrorRate))
+	builder.WriteString(", ")
+	builder.WriteString("min_requests=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MinRequests))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// CoreProxyRolloutPolicies is a parsable slice of CoreProxyRolloutPolicy.
+type CoreProxyRolloutPolicies []*CoreProxyRolloutPolicy
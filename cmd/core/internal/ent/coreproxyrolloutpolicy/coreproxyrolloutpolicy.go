@@ -0,0 +1,147 @@
+// Code generated by ent, DO NOT EDIT.
+
+package coreproxyrolloutpolicy
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/lyonmu/quebec/pkg/constant"
+)
+
+const (
+	// Label holds the string label denoting the coreproxyrolloutpolicy type in the database.
+	Label = "core_proxy_rollout_policy"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
+	FieldDeletedAt = "deleted_at"
+	// FieldClusterID holds the string denoting the cluster_id field in the database.
+	FieldClusterID = "cluster_id"
+	// FieldPercentage holds the string denoting the percentage field in the database.
+	FieldPercentage = "percentage"
+	// FieldNodeIds holds the string denoting the node_ids field in the database.
+	FieldNodeIds = "node_ids"
+	// FieldSoakSeconds holds the string denoting the soak_seconds field in the database.
+	FieldSoakSeconds = "soak_seconds"
+	// FieldMaxErrorRate holds the string denoting the max_error_rate field in the database.
+	FieldMaxErrorRate = "max_error_rate"
+	// FieldMinRequests holds the string denoting the min_requests field in the database.
+	FieldMinRequests = "min_requests"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// Table holds the table name of the coreproxyrolloutpolicy in the database.
+	Table = "quebec_core_proxy_rollout_policy"
+)
+
+// Columns holds all SQL columns for coreproxyrolloutpolicy fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldDeletedAt,
+	FieldClusterID,
+	FieldPercentage,
+	FieldNodeIds,
+	FieldSoakSeconds,
+	FieldMaxErrorRate,
+	FieldMinRequests,
+	FieldStatus,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "github.com/lyonmu/quebec/cmd/core/internal/ent/runtime"
+var (
+	Hooks [2]ent.Hook
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultPercentage holds the default value on creation for the "percentage" field.
+	DefaultPercentage int
+	// DefaultSoakSeconds holds the default value on creation for the "soak_seconds" field.
+	DefaultSoakSeconds int
+	// DefaultMaxErrorRate holds the default value on creation for the "max_error_rate" field.
+	DefaultMaxErrorRate float64
+	// DefaultMinRequests holds the default value on creation for the "min_requests" field.
+	DefaultMinRequests int64
+	// DefaultStatus holds the default value on creation for the "status" field.
+	DefaultStatus constant.YesOrNo
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() string
+	// IDValidator is a validator for the "id" field. It is called by the builders before save.
+	IDValidator func(string) error
+)
+
+// OrderOption defines the ordering options for the CoreProxyRolloutPolicy queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByDeletedAt orders the results by the deleted_at field.
+func ByDeletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletedAt, opts...).ToFunc()
+}
+
+// ByClusterID orders the results by the cluster_id field.
+func ByClusterID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldClusterID, opts...).ToFunc()
+}
+
+// ByPercentage orders the results by the percentage field.
+func ByPercentage(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPercentage, opts...).ToFunc()
+}
+
+// BySoakSeconds orders the results by the soak_seconds field.
+func BySoakSeconds(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSoakSeconds, opts...).ToFunc()
+}
+
+// ByMaxErrorRate orders the results by the max_error_rate field.
+func ByMaxErrorRate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMaxErrorRate, opts...).ToFunc()
+}
+
+// ByMinRequests orders the results by the min_requests field.
+func ByMinRequests(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMinRequests, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
@@ -0,0 +1,606 @@
+// Code generated by ent, DO NOT EDIT.
+
+package coreproxyrolloutpolicy
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/predicate"
+	"github.com/lyonmu/quebec/pkg/constant"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.CoreProxyRolloutPolicy {
+	return predicate.CoreProxyRolloutPolicy(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.CoreProxyRolloutPolicy {
+	return predicate.CoreProxyRolloutPolicy(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.CoreProxyRolloutPolicy {
+	return predicate.CoreProxyRolloutPolicy(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.CoreProxyRolloutPolicy {
+	return predicate.CoreProxyRolloutPolicy(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.CoreProxyRolloutPolicy {
+	return predicate.CoreProxyRolloutPolicy(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.CoreProxyRolloutPolicy {
+	return predicate.CoreProxyRolloutPolicy(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.CoreProxyRolloutPolicy {
+	return predicate.CoreProxyRolloutPolicy(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.CoreProxyRolloutPolicy {
+	return predicate.CoreProxyRolloutPolicy(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.CoreProxyRolloutPolicy {
+	return predicate.CoreProxyRolloutPolicy(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.CoreProxyRolloutPolicy {
+	return predicate.CoreProxyRolloutPolicy(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.CoreProxyRolloutPolicy {
+	return predicate.CoreProxyRolloutPolicy(sql.FieldContainsFold(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.CoreProxyRolloutPolicy {
+	return predicate.CoreProxyRolloutPolicy(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.CoreProxyRolloutPolicy {
+	return predicate.CoreProxyRolloutPolicy(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.CoreProxyRolloutPolicy {
+	return predicate.CoreProxyRolloutPolicy(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// ClusterID applies equality check predicate on the "cluster_id" field. It's identical to ClusterIDEQ.
+func ClusterID(v string) predicate.CoreProxyRolloutPolicy {
+	return predicate.CoreProxyRolloutPolicy(sql.FieldEQ(FieldClusterID, v))
+}
+
+// Percentage applies equality check predicate on the "percentage" field. It's identical to PercentageEQ.
+func Percentage(v int) predicate.CoreProxyRolloutPolicy {
+	return predicate.CoreProxyRolloutPolicy(sql.FieldEQ(FieldPercentage, v))
+}
+
+// SoakSeconds applies equality check predicate on the "soak_seconds" field. It's identical to SoakSecondsEQ.
+func SoakSeconds(v int) predicate.CoreProxyRolloutPolicy {
+	return predicate.CoreProxyRolloutPolicy(sql.FieldEQ(FieldSoakSeconds, v))
+}
+
+// MaxErrorRate applies equality check predicate on the "max_error_rate" field. It's identical to MaxErrorRateEQ.
+func MaxErrorRate(v float64) predicate.CoreProxyRolloutPolicy {
+	return predicate.CoreProxyRolloutPolicy(sql.FieldEQ(FieldMaxErrorRate, v))
+}
+
+// MinRequests applies equality check predicate on the "min_requests" field. It's identical to MinRequestsEQ.
+func MinRequests(v int64) predicate.CoreProxyRolloutPolicy {
+	return predicate.CoreProxyRolloutPolicy(sql.FieldEQ(FieldMinRequests, v))
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v constant.YesOrNo) predicate.CoreProxyRolloutPolicy {
+	vc := int8(v)
+	return predicate.CoreProxyRolloutPolicy(sql.FieldEQ(FieldStatus, vc))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.CoreProxyRolloutPolicy {
+	return predicate.CoreProxyRolloutPolicy(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.CoreProxyRolloutPolicy {
+	return predicate.CoreProxyRolloutPolicy(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.CoreProxyRolloutPolicy {
+	return predicate.CoreProxyRolloutPolicy(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.CoreProxyRolloutPolicy {
+	return predicate.CoreProxyRolloutPolicy(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.CoreProxyRolloutPolicy {
+	return predicate.CoreProxyRolloutPolicy(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.CoreProxyRolloutPolicy {
+	return predicate.CoreProxyRolloutPolicy(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.CoreProxyRolloutPolicy {
+	return predicate.CoreProxyRolloutPolicy(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.CoreProxyRolloutPolicy {
+	return predicate.CoreProxyRolloutPolicy(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.CoreProxyRolloutPolicy {
+	return predicate.CoreProxyRolloutPolicy(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.CoreProxyRolloutPolicy {
+	return predicate.CoreProxyRolloutPolicy(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.CoreProxyRolloutPolicy {
+	return predicate.CoreProxyRolloutPolicy(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.CoreProxyRolloutPolicy {
+	return predicate.CoreProxyRolloutPolicy(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.CoreProxyRolloutPolicy {
+	return predicate.CoreProxyRolloutPolicy(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.CoreProxyRolloutPolicy {
+	return predicate.CoreProxyRolloutPolicy(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.CoreProxyRolloutPolicy {
+	return predicate.CoreProxyRolloutPolicy(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.CoreProxyRolloutPolicy {
+	return predicate.CoreProxyRolloutPolicy(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.CoreProxyRolloutPolicy {
+	return predicate.CoreProxyRolloutPolicy(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.CoreProxyRolloutPolicy {
+	return predicate.CoreProxyRolloutPolicy(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.CoreProxyRolloutPolicy {
+	return predicate.CoreProxyRolloutPolicy(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.CoreProxyRolloutPolicy {
+	return predicate.CoreProxyRolloutPolicy(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.CoreProxyRolloutPolicy {
+	return predicate.CoreProxyRolloutPolicy(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.CoreProxyRolloutPolicy {
+	return predicate.CoreProxyRolloutPolicy(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.CoreProxyRolloutPolicy {
+	return predicate.CoreProxyRolloutPolicy(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.CoreProxyRolloutPolicy {
+	return predicate.CoreProxyRolloutPolicy(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.CoreProxyRolloutPolicy {
+	return predicate.CoreProxyRolloutPolicy(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.CoreProxyRolloutPolicy {
+	return predicate.CoreProxyRolloutPolicy(sql.FieldNotNull(FieldDeletedAt))
+}
+
+// ClusterIDEQ applies the EQ predicate on the "cluster_id" field.
+func ClusterIDEQ(v string) predicate.CoreProxyRolloutPolicy {
+	return predicate.CoreProxyRolloutPolicy(sql.FieldEQ(FieldClusterID, v))
+}
+
+// ClusterIDNEQ applies the NEQ predicate on the "cluster_id" field.
+func ClusterIDNEQ(v string) predicate.CoreProxyRolloutPolicy {
+	return predicate.CoreProxyRolloutPolicy(sql.FieldNEQ(FieldClusterID, v))
+}
+
+// ClusterIDIn applies the In predicate on the "cluster_id" field.
+func ClusterIDIn(vs ...string) predicate.CoreProxyRolloutPolicy {
+	return predicate.CoreProxyRolloutPolicy(sql.FieldIn(FieldClusterID, vs...))
+}
+
+// ClusterIDNotIn applies the NotIn predicate on the "cluster_id" field.
+func ClusterIDNotIn(vs ...string) predicate.CoreProxyRolloutPolicy {
+	return predicate.CoreProxyRolloutPolicy(sql.FieldNotIn(FieldClusterID, vs...))
+}
+
+// ClusterIDGT applies the GT predicate on the "cluster_id" field.
+func ClusterIDGT(v string) predicate.CoreProxyRolloutPolicy {
+	return predicate.CoreProxyRolloutPolicy(sql.FieldGT(FieldClusterID, v))
+}
+
+// ClusterIDGTE applies the GTE predicate on the "cluster_id" field.
+func ClusterIDGTE(v string) predicate.CoreProxyRolloutPolicy {
+	return predicate.CoreProxyRolloutPolicy(sql.FieldGTE(FieldClusterID, v))
+}
+
+// ClusterIDLT applies the LT predicate on the "cluster_id" field.
+func ClusterIDLT(v string) predicate.CoreProxyRolloutPolicy {
+	return predicate.CoreProxyRolloutPolicy(sql.FieldLT(FieldClusterID, v))
+}
+
+// ClusterIDLTE applies the LTE predicate on the "cluster_id" field.
+func ClusterIDLTE(v string) predicate.CoreProxyRolloutPolicy {
+	return predicate.CoreProxyRolloutPolicy(sql.FieldLTE(FieldClusterID, v))
+}
+
+// ClusterIDContains applies the Contains predicate on the "cluster_id" field.
+func ClusterIDContains(v string) predicate.CoreProxyRolloutPolicy {
+	return predicate.CoreProxyRolloutPolicy(sql.FieldContains(FieldClusterID, v))
+}
+
+// ClusterIDHasPrefix applies the HasPrefix predicate on the "cluster_id" field.
+func ClusterIDHasPrefix(v string) predicate.CoreProxyRolloutPolicy {
+	return predicate.CoreProxyRolloutPolicy(sql.FieldHasPrefix(FieldClusterID, v))
+}
+
+// ClusterIDHasSuffix applies the HasSuffix predicate on the "cluster_id" field.
+func ClusterIDHasSuffix(v string) predicate.CoreProxyRolloutPolicy {
+	return predicate.CoreProxyRolloutPolicy(sql.FieldHasSuffix(FieldClusterID, v))
+}
+
+// ClusterIDIsNil applies the IsNil predicate on the "cluster_id" field.
+func ClusterIDIsNil() predicate.CoreProxyRolloutPolicy {
+	return predicate.CoreProxyRolloutPolicy(sql.FieldIsNull(FieldClusterID))
+}
+
+// ClusterIDNotNil applies the NotNil predicate on the "cluster_id" field.
+func ClusterIDNotNil() predicate.CoreProxyRolloutPolicy {
+	return predicate.CoreProxyRolloutPolicy(sql.FieldNotNull(FieldClusterID))
+}
+
+// ClusterIDEqualFold applies the EqualFold predicate on the "cluster_id" field.
+func ClusterIDEqualFold(v string) predicate.CoreProxyRolloutPolicy {
+	return predicate.CoreProxyRolloutPolicy(sql.FieldEqualFold(FieldClusterID, v))
+}
+
+// ClusterIDContainsFold applies the ContainsFold predicate on the "cluster_id" field.
+func ClusterIDContainsFold(v string) predicate.CoreProxyRolloutPolicy {
+	return predicate.CoreProxyRolloutPolicy(sql.FieldContainsFold(FieldClusterID, v))
+}
+
+// PercentageEQ applies the EQ predicate on the "percentage" field.
+func PercentageEQ(v int) predicate.CoreProxyRolloutPolicy {
+	return predicate.CoreProxyRolloutPolicy(sql.FieldEQ(FieldPercentage, v))
+}
+
+// PercentageNEQ applies the NEQ predicate on the "percentage" field.
+func PercentageNEQ(v int) predicate.CoreProxyRolloutPolicy {
+	return predicate.CoreProxyRolloutPolicy(sql.FieldNEQ(FieldPercentage, v))
+}
+
+// PercentageIn applies the In predicate on the "percentage" field.
+func PercentageIn(vs ...int) predicate.CoreProxyRolloutPolicy {
+	return predicate.CoreProxyRolloutPolicy(sql.FieldIn(FieldPercentage, vs...))
+}
+
+// PercentageNotIn applies the NotIn predicate on the "percentage" field.
+func PercentageNotIn(vs ...int) predicate.CoreProxyRolloutPolicy {
+	return predicate.CoreProxyRolloutPolicy(sql.FieldNotIn(FieldPercentage, vs...))
+}
+
+// PercentageGT applies the GT predicate on the "percentage" field.
+func PercentageGT(v int) predicate.CoreProxyRolloutPolicy {
+	return predicate.CoreProxyRolloutPolicy(sql.FieldGT(FieldPercentage, v))
+}
+
+// PercentageGTE applies the GTE predicate on the "percentage" field.
+func PercentageGTE(v int) predicate.CoreProxyRolloutPolicy {
+	return predicate.CoreProxyRolloutPolicy(sql.FieldGTE(FieldPercentage, v))
+}
+
+// PercentageLT applies the LT predicate on the "percentage" field.
+func PercentageLT(v int) predicate.CoreProxyRolloutPolicy {
+	return predicate.CoreProxyRolloutPolicy(sql.FieldLT(FieldPercentage, v))
+}
+
+// PercentageLTE applies the LTE predicate on the "percentage" field.
+func PercentageLTE(v int) predicate.CoreProxyRolloutPolicy {
+	return predicate.CoreProxyRolloutPolicy(sql.FieldLTE(FieldPercentage, v))
+}
+
+// PercentageIsNil applies the IsNil predicate on the "percentage" field.
+func PercentageIsNil() predicate.CoreProxyRolloutPolicy {
+	return predicate.CoreProxyRolloutPolicy(sql.FieldIsNull(FieldPercentage))
+}
+
+// PercentageNotNil applies the NotNil predicate on the "percentage" field.
+func PercentageNotNil() predicate.CoreProxyRolloutPolicy {
+	return predicate.CoreProxyRolloutPolicy(sql.FieldNotNull(FieldPercentage))
+}
+
+// NodeIdsIsNil applies the IsNil predicate on the "node_ids" field.
+func NodeIdsIsNil() predicate.CoreProxyRolloutPolicy {
+	return predicate.CoreProxyRolloutPolicy(sql.FieldIsNull(FieldNodeIds))
+}
+
+// NodeIdsNotNil applies the NotNil predicate on the "node_ids" field.
+func NodeIdsNotNil() predicate.CoreProxyRolloutPolicy {
+	return predicate.CoreProxyRolloutPolicy(sql.FieldNotNull(FieldNodeIds))
+}
+
+// SoakSecondsEQ applies the EQ predicate on the "soak_seconds" field.
+func SoakSecondsEQ(v int) predicate.CoreProxyRolloutPolicy {
+	return predicate.CoreProxyRolloutPolicy(sql.FieldEQ(FieldSoakSeconds, v))
+}
+
+// SoakSecondsNEQ applies the NEQ predicate on the "soak_seconds" field.
+func SoakSecondsNEQ(v int) predicate.CoreProxyRolloutPolicy {
+	return predicate.CoreProxyRolloutPolicy(sql.FieldNEQ(FieldSoakSeconds, v))
+}
+
+// SoakSecondsIn applies the In predicate on the "soak_seconds" field.
+func SoakSecondsIn(vs ...int) predicate.CoreProxyRolloutPolicy {
+	return predicate.CoreProxyRolloutPolicy(sql.FieldIn(FieldSoakSeconds, vs...))
+}
+
+// SoakSecondsNotIn applies the NotIn predicate on the "soak_seconds" field.
+func SoakSecondsNotIn(vs ...int) predicate.CoreProxyRolloutPolicy {
+	return predicate.CoreProxyRolloutPolicy(sql.FieldNotIn(FieldSoakSeconds, vs...))
+}
+
+// SoakSecondsGT applies the GT predicate on the "soak_seconds" field.
+func SoakSecondsGT(v int) predicate.CoreProxyRolloutPolicy {
+	return predicate.CoreProxyRolloutPolicy(sql.FieldGT(FieldSoakSeconds, v))
+}
+
+// SoakSecondsGTE applies the GTE predicate on the "soak_seconds" field.
+func SoakSecondsGTE(v int) predicate.CoreProxyRolloutPolicy {
+	return predicate.CoreProxyRolloutPolicy(sql.FieldGTE(FieldSoakSeconds, v))
+}
+
+// SoakSecondsLT applies the LT predicate on the "soak_seconds" field.
+func SoakSecondsLT(v int) predicate.CoreProxyRolloutPolicy {
+	return predicate.CoreProxyRolloutPolicy(sql.FieldLT(FieldSoakSeconds, v))
+}
+
+// SoakSecondsLTE applies the LTE predicate on the "soak_seconds" field.
+func SoakSecondsLTE(v int) predicate.CoreProxyRolloutPolicy {
+	return predicate.CoreProxyRolloutPolicy(sql.FieldLTE(FieldSoakSeconds, v))
+}
+
+// SoakSecondsIsNil applies the IsNil predicate on the "soak_seconds" field.
+func SoakSecondsIsNil() predicate.CoreProxyRolloutPolicy {
+	return predicate.CoreProxyRolloutPolicy(sql.FieldIsNull(FieldSoakSeconds))
+}
+
+// SoakSecondsNotNil applies the NotNil predicate on the "soak_seconds" field.
+func SoakSecondsNotNil() predicate.CoreProxyRolloutPolicy {
+	return predicate.CoreProxyRolloutPolicy(sql.FieldNotNull(FieldSoakSeconds))
+}
+
+// MaxErrorRateEQ applies the EQ predicate on the "max_error_rate" field.
+func MaxErrorRateEQ(v float64) predicate.CoreProxyRolloutPolicy {
+	return predicate.CoreProxyRolloutPolicy(sql.FieldEQ(FieldMaxErrorRate, v))
+}
+
+// MaxErrorRateNEQ applies the NEQ predicate on the "max_error_rate" field.
+func MaxErrorRateNEQ(v float64) predicate.CoreProxyRolloutPolicy {
+	return predicate.CoreProxyRolloutPolicy(sql.FieldNEQ(FieldMaxErrorRate, v))
+}
+
+// MaxErrorRateIn applies the In predicate on the "max_error_rate" field.
+func MaxErrorRateIn(vs ...float64) predicate.CoreProxyRolloutPolicy {
+	return predicate.CoreProxyRolloutPolicy(sql.FieldIn(FieldMaxErrorRate, vs...))
+}
+
+// MaxErrorRateNotIn applies the NotIn predicate on the "max_error_rate" field.
+func MaxErrorRateNotIn(vs ...float64) predicate.CoreProxyRolloutPolicy {
+	return predicate.CoreProxyRolloutPolicy(sql.FieldNotIn(FieldMaxErrorRate, vs...))
+}
+
+// MaxErrorRateGT applies the GT predicate on the "max_error_rate" field.
+func MaxErrorRateGT(v float64) predicate.CoreProxyRolloutPolicy {
+	return predicate.CoreProxyRolloutPolicy(sql.FieldGT(FieldMaxErrorRate, v))
+}
+
+// MaxErrorRateGTE applies the GTE predicate on the "max_error_rate" field.
+func MaxErrorRateGTE(v float64) predicate.CoreProxyRolloutPolicy {
+	return predicate.CoreProxyRolloutPolicy(sql.FieldGTE(FieldMaxErrorRate, v))
+}
+
+// MaxErrorRateLT applies the LT predicate on the "max_error_rate" field.
+func MaxErrorRateLT(v float64) predicate.CoreProxyRolloutPolicy {
+	return predicate.CoreProxyRolloutPolicy(sql.FieldLT(FieldMaxErrorRate, v))
+}
+
+// MaxErrorRateLTE applies the LTE predicate on the "max_error_rate" field.
+func MaxErrorRateLTE(v float64) predicate.CoreProxyRolloutPolicy {
+	return predicate.CoreProxyRolloutPolicy(sql.FieldLTE(FieldMaxErrorRate, v))
+}
+
+// MaxErrorRateIsNil applies the IsNil predicate on the "max_error_rate" field.
+func MaxErrorRateIsNil() predicate.CoreProxyRolloutPolicy {
+	return predicate.CoreProxyRolloutPolicy(sql.FieldIsNull(FieldMaxErrorRate))
+}
+
+// MaxErrorRateNotNil applies the NotNil predicate on the "max_error_rate" field.
+func MaxErrorRateNotNil() predicate.CoreProxyRolloutPolicy {
+	return predicate.CoreProxyRolloutPolicy(sql.FieldNotNull(FieldMaxErrorRate))
+}
+
+// MinRequestsEQ applies the EQ predicate on the "min_requests" field.
+func MinRequestsEQ(v int64) predicate.CoreProxyRolloutPolicy {
+	return predicate.CoreProxyRolloutPolicy(sql.FieldEQ(FieldMinRequests, v))
+}
+
+// MinRequestsNEQ applies the NEQ predicate on the "min_requests" field.
+func MinRequestsNEQ(v int64) predicate.CoreProxyRolloutPolicy {
+	return predicate.CoreProxyRolloutPolicy(sql.FieldNEQ(FieldMinRequests, v))
+}
+
+// MinRequestsIn applies the In predicate on the "min_requests" field.
+func MinRequestsIn(vs ...int64) predicate.CoreProxyRolloutPolicy {
+	return predicate.CoreProxyRolloutPolicy(sql.FieldIn(FieldMinRequests, vs...))
+}
+
+// MinRequestsNotIn applies the NotIn predicate on the "min_requests" field.
+func MinRequestsNotIn(vs ...int64) predicate.CoreProxyRolloutPolicy {
+	return predicate.CoreProxyRolloutPolicy(sql.FieldNotIn(FieldMinRequests, vs...))
+}
+
+// MinRequestsGT applies the GT predicate on the "min_requests" field.
+func MinRequestsGT(v int64) predicate.CoreProxyRolloutPolicy {
+	return predicate.CoreProxyRolloutPolicy(sql.FieldGT(FieldMinRequests, v))
+}
+
+// MinRequestsGTE applies the GTE predicate on the "min_requests" field.
+func MinRequestsGTE(v int64) predicate.CoreProxyRolloutPolicy {
+	return predicate.CoreProxyRolloutPolicy(sql.FieldGTE(FieldMinRequests, v))
+}
+
+// MinRequestsLT applies the LT predicate on the "min_requests" field.
+func MinRequestsLT(v int64) predicate.CoreProxyRolloutPolicy {
+	return predicate.CoreProxyRolloutPolicy(sql.FieldLT(FieldMinRequests, v))
+}
+
+// MinRequestsLTE applies the LTE predicate on the "min_requests" field.
+func MinRequestsLTE(v int64) predicate.CoreProxyRolloutPolicy {
+	return predicate.CoreProxyRolloutPolicy(sql.FieldLTE(FieldMinRequests, v))
+}
+
+// MinRequestsIsNil applies the IsNil predicate on the "min_requests" field.
+func MinRequestsIsNil() predicate.CoreProxyRolloutPolicy {
+	return predicate.CoreProxyRolloutPolicy(sql.FieldIsNull(FieldMinRequests))
+}
+
+// MinRequestsNotNil applies the NotNil predicate on the "min_requests" field.
+func MinRequestsNotNil() predicate.CoreProxyRolloutPolicy {
+	return predicate.CoreProxyRolloutPolicy(sql.FieldNotNull(FieldMinRequests))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v constant.YesOrNo) predicate.CoreProxyRolloutPolicy {
+	vc := int8(v)
+	return predicate.CoreProxyRolloutPolicy(sql.FieldEQ(FieldStatus, vc))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v constant.YesOrNo) predicate.CoreProxyRolloutPolicy {
+	vc := int8(v)
+	return predicate.CoreProxyRolloutPolicy(sql.FieldNEQ(FieldStatus, vc))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...constant.YesOrNo) predicate.CoreProxyRolloutPolicy {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = int8(vs[i])
+	}
+	return predicate.CoreProxyRolloutPolicy(sql.FieldIn(FieldStatus, v...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...constant.YesOrNo) predicate.CoreProxyRolloutPolicy {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = int8(vs[i])
+	}
+	return predicate.CoreProxyRolloutPolicy(sql.FieldNotIn(FieldStatus, v...))
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v constant.YesOrNo) predicate.CoreProxyRolloutPolicy {
+	vc := int8(v)
+	return predicate.CoreProxyRolloutPolicy(sql.FieldGT(FieldStatus, vc))
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v constant.YesOrNo) predicate.CoreProxyRolloutPolicy {
+	vc := int8(v)
+	return predicate.CoreProxyRolloutPolicy(sql.FieldGTE(FieldStatus, vc))
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v constant.YesOrNo) predicate.CoreProxyRolloutPolicy {
+	vc := int8(v)
+	return predicate.CoreProxyRolloutPolicy(sql.FieldLT(FieldStatus, vc))
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v constant.YesOrNo) predicate.CoreProxyRolloutPolicy {
+	vc := int8(v)
+	return predicate.CoreProxyRolloutPolicy(sql.FieldLTE(FieldStatus, vc))
+}
+
+// StatusIsNil applies the IsNil predicate on the "status" field.
+func StatusIsNil() predicate.CoreProxyRolloutPolicy {
+	return predicate.CoreProxyRolloutPolicy(sql.FieldIsNull(FieldStatus))
+}
+
+// StatusNotNil applies the NotNil predicate on the "status" field.
+func StatusNotNil() predicate.CoreProxyRolloutPolicy {
+	return predicate.CoreProxyRolloutPolicy(sql.FieldNotNull(FieldStatus))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.CoreProxyRolloutPolicy) predicate.CoreProxyRolloutPolicy {
+	return predicate.CoreProxyRolloutPolicy(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.CoreProxyRolloutPolicy) predicate.CoreProxyRolloutPolicy {
+	return predicate.CoreProxyRolloutPolicy(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.CoreProxyRolloutPolicy) predicate.CoreProxyRolloutPolicy {
+	return predicate.CoreProxyRolloutPolicy(sql.NotPredicates(p))
+}
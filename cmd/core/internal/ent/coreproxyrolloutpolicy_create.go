@@ -0,0 +1,1305 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/coreproxyrolloutpolicy"
+	"github.com/lyonmu/quebec/pkg/constant"
+)
+
+// CoreProxyRolloutPolicyCreate is the builder for creating a CoreProxyRolloutPolicy entity.
+type CoreProxyRolloutPolicyCreate struct {
+	config
+	mutation *CoreProxyRolloutPolicyMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *CoreProxyRolloutPolicyCreate) SetCreatedAt(v time.Time) *CoreProxyRolloutPolicyCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *CoreProxyRolloutPolicyCreate) SetNillableCreatedAt(v *time.Time) *CoreProxyRolloutPolicyCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *CoreProxyRolloutPolicyCreate) SetUpdatedAt(v time.Time) *CoreProxyRolloutPolicyCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *CoreProxyRolloutPolicyCreate) SetNillableUpdatedAt(v *time.Time) *CoreProxyRolloutPolicyCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_c *CoreProxyRolloutPolicyCreate) SetDeletedAt(v time.Time) *CoreProxyRolloutPolicyCreate {
+	_c.mutation.SetDeletedAt(v)
+	return _c
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_c *CoreProxyRolloutPolicyCreate) SetNillableDeletedAt(v *time.Time) *CoreProxyRolloutPolicyCreate {
+	if v != nil {
+		_c.SetDeletedAt(*v)
+	}
+	return _c
+}
+
+// SetClusterID sets the "cluster_id" field.
+func (_c *CoreProxyRolloutPolicyCreate) SetClusterID(v string) *CoreProxyRolloutPolicyCreate {
+	_c.mutation.SetClusterID(v)
+	return _c
+}
+
+// SetNillableClusterID sets the "cluster_id" field if the given value is not nil.
+func (_c *CoreProxyRolloutPolicyCreate) SetNillableClusterID(v *string) *CoreProxyRolloutPolicyCreate {
+	if v != nil {
+		_c.SetClusterID(*v)
+	}
+	return _c
+}
+
+// SetPercentage sets the "percentage" field.
+func (_c *CoreProxyRolloutPolicyCreate) SetPercentage(v int) *CoreProxyRolloutPolicyCreate {
+	_c.mutation.SetPercentage(v)
+	return _c
+}
+
+// SetNillablePercentage sets the "percentage" field if the given value is not nil.
+func (_c *CoreProxyRolloutPolicyCreate) SetNillablePercentage(v *int) *CoreProxyRolloutPolicyCreate {
+	if v != nil {
+		_c.SetPercentage(*v)
+	}
+	return _c
+}
+
+// SetNodeIds sets the "node_ids" field.
+func (_c *CoreProxyRolloutPolicyCreate) SetNodeIds(v []string) *CoreProxyRolloutPolicyCreate {
+	_c.mutation.SetNodeIds(v)
+	return _c
+}
+
+// SetSoakSeconds sets the "soak_seconds" field.
+func (_c *CoreProxyRolloutPolicyCreate) SetSoakSeconds(v int) *CoreProxyRolloutPolicyCreate {
+	_c.mutation.SetSoakSeconds(v)
+	return _c
+}
+
+// SetNillableSoakSeconds sets the "soak_seconds" field if the given value is not nil.
+func (_c *CoreProxyRolloutPolicyCreate) SetNillableSoakSeconds(v *int) *CoreProxyRolloutPolicyCreate {
+	if v != nil {
+		_c.SetSoakSeconds(*v)
+	}
+	return _c
+}
+
+// SetMaxErrorRate sets the "max_error_rate" field.
+func (_c *CoreProxyRolloutPolicyCreate) SetMaxErrorRate(v float64) *CoreProxyRolloutPolicyCreate {
+	_c.mutation.SetMaxErrorRate(v)
+	return _c
+}
+
+// SetNillableMaxErrorRate sets the "max_error_rate" field if the given value is not nil.
+func (_c *CoreProxyRolloutPolicyCreate) SetNillableMaxErrorRate(v *float64) *CoreProxyRolloutPolicyCreate {
+	if v != nil {
+		_c.SetMaxErrorRate(*v)
+	}
+	return _c
+}
+
+// SetMinRequests sets the "min_requests" field.
+func (_c *CoreProxyRolloutPolicyCreate) SetMinRequests(v int64) *CoreProxyRolloutPolicyCreate {
+	_c.mutation.SetMinRequests(v)
+	return _c
+}
+
+// SetNillableMinRequests sets the "min_requests" field if the given value is not nil.
+func (_c *CoreProxyRolloutPolicyCreate) SetNillableMinRequests(v *int64) *CoreProxyRolloutPolicyCreate {
+	if v != nil {
+		_c.SetMinRequests(*v)
+	}
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *CoreProxyRolloutPolicyCreate) SetStatus(v constant.YesOrNo) *CoreProxyRolloutPolicyCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *CoreProxyRolloutPolicyCreate) SetNillableStatus(v *constant.YesOrNo) *CoreProxyRolloutPolicyCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *CoreProxyRolloutPolicyCreate) SetID(v string) *CoreProxyRolloutPolicyCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *CoreProxyRolloutPolicyCreate) SetNillableID(v *string) *CoreProxyRolloutPolicyCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the CoreProxyRolloutPolicyMutation object of the builder.
+func (_c *CoreProxyRolloutPolicyCreate) Mutation() *CoreProxyRolloutPolicyMutation {
+	return _c.mutation
+}
+
+// Save creates the CoreProxyRolloutPolicy in the database.
+func (_c *CoreProxyRolloutPolicyCreate) Save(ctx context.Context) (*CoreProxyRolloutPolicy, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *CoreProxyRolloutPolicyCreate) SaveX(ctx context.Context) *CoreProxyRolloutPolicy {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *CoreProxyRolloutPolicyCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *CoreProxyRolloutPolicyCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *CoreProxyRolloutPolicyCreate) defaults() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		if coreproxyrolloutpolicy.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized coreproxyrolloutpolicy.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := coreproxyrolloutpolicy.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		if coreproxyrolloutpolicy.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized coreproxyrolloutpolicy.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := coreproxyrolloutpolicy.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.Percentage(); !ok {
+		v := coreproxyrolloutpolicy.DefaultPercentage
+		_c.mutation.SetPercentage(v)
+	}
+	if _, ok := _c.mutation.SoakSeconds(); !ok {
+		v := coreproxyrolloutpolicy.DefaultSoakSeconds
+		_c.mutation.SetSoakSeconds(v)
+	}
+	if _, ok := _c.mutation.MaxErrorRate(); !ok {
+		v := coreproxyrolloutpolicy.DefaultMaxErrorRate
+		_c.mutation.SetMaxErrorRate(v)
+	}
+	if _, ok := _c.mutation.MinRequests(); !ok {
+		v := coreproxyrolloutpolicy.DefaultMinRequests
+		_c.mutation.SetMinRequests(v)
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		v := coreproxyrolloutpolicy.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		if coreproxyrolloutpolicy.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized coreproxyrolloutpolicy.DefaultID (forgotten import ent/runtime?)")
+		}
+		v := coreproxyrolloutpolicy.DefaultID()
+		_c.mutation.SetID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *CoreProxyRolloutPolicyCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "CoreProxyRolloutPolicy.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "CoreProxyRolloutPolicy.updated_at"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := coreproxyrolloutpolicy.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "CoreProxyRolloutPolicy.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *CoreProxyRolloutPolicyCreate) sqlSave(ctx context.Context) (*CoreProxyRolloutPolicy, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected CoreProxyRolloutPolicy.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *CoreProxyRolloutPolicyCreate) createSpec() (*CoreProxyRolloutPolicy, *sqlgraph.CreateSpec) {
+	var (
+		_node = &CoreProxyRolloutPolicy{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(coreproxyrolloutpolicy.Table, sqlgraph.NewFieldSpec(coreproxyrolloutpolicy.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(coreproxyrolloutpolicy.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(coreproxyrolloutpolicy.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.DeletedAt(); ok {
+		_spec.SetField(coreproxyrolloutpolicy.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if value, ok := _c.mutation.ClusterID(); ok {
+		_spec.SetField(coreproxyrolloutpolicy.FieldClusterID, field.TypeString, value)
+		_node.ClusterID = value
+	}
+	if value, ok := _c.mutation.Percentage(); ok {
+		_spec.SetField(coreproxyrolloutpolicy.FieldPercentage, field.TypeInt, value)
+		_node.Percentage = value
+	}
+	if value, ok := _c.mutation.NodeIds(); ok {
+		_spec.SetField(coreproxyrolloutpolicy.FieldNodeIds, field.TypeJSON, value)
+		_node.NodeIds = value
+	}
+	if value, ok := _c.mutation.SoakSeconds(); ok {
+		_spec.SetField(coreproxyrolloutpolicy.FieldSoakSeconds, field.TypeInt, value)
+		_node.SoakSeconds = value
+	}
+	if value, ok := _c.mutation.MaxErrorRate(); ok {
+		_spec.SetField(coreproxyrolloutpolicy.FieldMaxErrorRate, field.TypeFloat64, value)
+		_node.MaxErrorRate = value
+	}
+	if value, ok := _c.mutation.MinRequests(); ok {
+		_spec.SetField(coreproxyrolloutpolicy.FieldMinRequests, field.TypeInt64, value)
+		_node.MinRequests = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(coreproxyrolloutpolicy.FieldStatus, field.TypeInt8, value)
+		_node.Status = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.CoreProxyRolloutPolicy.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.CoreProxyRolloutPolicyUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *CoreProxyRolloutPolicyCreate) OnConflict(opts ...sql.ConflictOption) *CoreProxyRolloutPolicyUpsertOne {
+	_c.conflict = opts
+	return &CoreProxyRolloutPolicyUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.CoreProxyRolloutPolicy.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *CoreProxyRolloutPolicyCreate) OnConflictColumns(columns ...string) *CoreProxyRolloutPolicyUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &CoreProxyRolloutPolicyUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// CoreProxyRolloutPolicyUpsertOne is the builder for "upsert"-ing
+	//  one CoreProxyRolloutPolicy node.
+	CoreProxyRolloutPolicyUpsertOne struct {
+		create *CoreProxyRolloutPolicyCreate
+	}
+
+	// CoreProxyRolloutPolicyUpsert is the "OnConflict" setter.
+	CoreProxyRolloutPolicyUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *CoreProxyRolloutPolicyUpsert) SetUpdatedAt(v time.Time) *CoreProxyRolloutPolicyUpsert {
+	u.Set(coreproxyrolloutpolicy.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *CoreProxyRolloutPolicyUpsert) UpdateUpdatedAt() *CoreProxyRolloutPolicyUpsert {
+	u.SetExcluded(coreproxyrolloutpolicy.FieldUpdatedAt)
+	return u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *CoreProxyRolloutPolicyUpsert) SetDeletedAt(v time.Time) *CoreProxyRolloutPolicyUpsert {
+	u.Set(coreproxyrolloutpolicy.FieldDeletedAt, v)
+	return u
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *CoreProxyRolloutPolicyUpsert) UpdateDeletedAt() *CoreProxyRolloutPolicyUpsert {
+	u.SetExcluded(coreproxyrolloutpolicy.FieldDeletedAt)
+	return u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *CoreProxyRolloutPolicyUpsert) ClearDeletedAt() *CoreProxyRolloutPolicyUpsert {
+	u.SetNull(coreproxyrolloutpolicy.FieldDeletedAt)
+	return u
+}
+
+// SetClusterID sets the "cluster_id" field.
+func (u *CoreProxyRolloutPolicyUpsert) SetClusterID(v string) *CoreProxyRolloutPolicyUpsert {
+	u.Set(coreproxyrolloutpolicy.FieldClusterID, v)
+	return u
+}
+
+// UpdateClusterID sets the "cluster_id" field to the value that was provided on create.
+func (u *CoreProxyRolloutPolicyUpsert) UpdateClusterID() *CoreProxyRolloutPolicyUpsert {
+	u.SetExcluded(coreproxyrolloutpolicy.FieldClusterID)
+	return u
+}
+
+// ClearClusterID clears the value of the "cluster_id" field.
+func (u *CoreProxyRolloutPolicyUpsert) ClearClusterID() *CoreProxyRolloutPolicyUpsert {
+	u.SetNull(coreproxyrolloutpolicy.FieldClusterID)
+	return u
+}
+
+// SetPercentage sets the "percentage" field.
+func (u *CoreProxyRolloutPolicyUpsert) SetPercentage(v int) *CoreProxyRolloutPolicyUpsert {
+	u.Set(coreproxyrolloutpolicy.FieldPercentage, v)
+	return u
+}
+
+// UpdatePercentage sets the "percentage" field to the value that was provided on create.
+func (u *CoreProxyRolloutPolicyUpsert) UpdatePercentage() *CoreProxyRolloutPolicyUpsert {
+	u.SetExcluded(coreproxyrolloutpolicy.FieldPercentage)
+	return u
+}
+
+// AddPercentage adds v to the "percentage" field.
+func (u *CoreProxyRolloutPolicyUpsert) AddPercentage(v int) *CoreProxyRolloutPolicyUpsert {
+	u.Add(coreproxyrolloutpolicy.FieldPercentage, v)
+	return u
+}
+
+// ClearPercentage clears the value of the "percentage" field.
+func (u *CoreProxyRolloutPolicyUpsert) ClearPercentage() *CoreProxyRolloutPolicyUpsert {
+	u.SetNull(coreproxyrolloutpolicy.FieldPercentage)
+	return u
+}
+
+// SetNodeIds sets the "node_ids" field.
+func (u *CoreProxyRolloutPolicyUpsert) SetNodeIds(v []string) *CoreProxyRolloutPolicyUpsert {
+	u.Set(coreproxyrolloutpolicy.FieldNodeIds, v)
+	return u
+}
+
+// UpdateNodeIds sets the "node_ids" field to the value that was provided on create.
+func (u *CoreProxyRolloutPolicyUpsert) UpdateNodeIds() *CoreProxyRolloutPolicyUpsert {
+	u.SetExcluded(coreproxyrolloutpolicy.FieldNodeIds)
+	return u
+}
+
+// ClearNodeIds clears the value of the "node_ids" field.
+func (u *CoreProxyRolloutPolicyUpsert) ClearNodeIds() *CoreProxyRolloutPolicyUpsert {
+	u.SetNull(coreproxyrolloutpolicy.FieldNodeIds)
+	return u
+}
+
+// SetSoakSeconds sets the "soak_seconds" field.
+func (u *CoreProxyRolloutPolicyUpsert) SetSoakSeconds(v int) *CoreProxyRolloutPolicyUpsert {
+	u.Set(coreproxyrolloutpolicy.FieldSoakSeconds, v)
+	return u
+}
+
+// UpdateSoakSeconds sets the "soak_seconds" field to the value that was provided on create.
+func (u *CoreProxyRolloutPolicyUpsert) UpdateSoakSeconds() *CoreProxyRolloutPolicyUpsert {
+	u.SetExcluded(coreproxyrolloutpolicy.FieldSoakSeconds)
+	return u
+}
+
+// AddSoakSeconds adds v to the "soak_seconds" field.
+func (u *CoreProxyRolloutPolicyUpsert) AddSoakSeconds(v int) *CoreProxyRolloutPolicyUpsert {
+	u.Add(coreproxyrolloutpolicy.FieldSoakSeconds, v)
+	return u
+}
+
+// ClearSoakSeconds clears the value of the "soak_seconds" field.
+func (u *CoreProxyRolloutPolicyUpsert) ClearSoakSeconds() *CoreProxyRolloutPolicyUpsert {
+	u.SetNull(coreproxyrolloutpolicy.FieldSoakSeconds)
+	return u
+}
+
+// SetMaxErrorRate sets the "max_error_rate" field.
+func (u *CoreProxyRolloutPolicyUpsert) SetMaxErrorRate(v float64) *CoreProxyRolloutPolicyUpsert {
+	u.Set(coreproxyrolloutpolicy.FieldMaxErrorRate, v)
+	return u
+}
+
+// UpdateMaxErrorRate sets the "max_error_rate" field to the value that was provided on create.
+func (u *CoreProxyRolloutPolicyUpsert) UpdateMaxErrorRate() *CoreProxyRolloutPolicyUpsert {
+	u.SetExcluded(coreproxyrolloutpolicy.FieldMaxErrorRate)
+	return u
+}
+
+// AddMaxErrorRate adds v to the "max_error_rate" field.
+func (u *CoreProxyRolloutPolicyUpsert) AddMaxErrorRate(v float64) *CoreProxyRolloutPolicyUpsert {
+	u.Add(coreproxyrolloutpolicy.FieldMaxErrorRate, v)
+	return u
+}
+
+// ClearMaxErrorRate clears the value of the "max_error_rate" field.
+func (u *CoreProxyRolloutPolicyUpsert) ClearMaxErrorRate() *CoreProxyRolloutPolicyUpsert {
+	u.SetNull(coreproxyrolloutpolicy.FieldMaxErrorRate)
+	return u
+}
+
+// SetMinRequests sets the "min_requests" field.
+func (u *CoreProxyRolloutPolicyUpsert) SetMinRequests(v int64) *CoreProxyRolloutPolicyUpsert {
+	u.Set(coreproxyrolloutpolicy.FieldMinRequests, v)
+	return u
+}
+
+// UpdateMinRequests sets the "min_requests" field to the value that was provided on create.
+func (u *CoreProxyRolloutPolicyUpsert) UpdateMinRequests() *CoreProxyRolloutPolicyUpsert {
+	u.SetExcluded(coreproxyrolloutpolicy.FieldMinRequests)
+	return u
+}
+
+// AddMinRequests adds v to the "min_requests" field.
+func (u *CoreProxyRolloutPolicyUpsert) AddMinRequests(v int64) *CoreProxyRolloutPolicyUpsert {
+	u.Add(coreproxyrolloutpolicy.FieldMinRequests, v)
+	return u
+}
+
+// ClearMinRequests clears the value of the "min_requests" field.
+func (u *CoreProxyRolloutPolicyUpsert) ClearMinRequests() *CoreProxyRolloutPolicyUpsert {
+	u.SetNull(coreproxyrolloutpolicy.FieldMinRequests)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *CoreProxyRolloutPolicyUpsert) SetStatus(v constant.YesOrNo) *CoreProxyRolloutPolicyUpsert {
+	u.Set(coreproxyrolloutpolicy.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *CoreProxyRolloutPolicyUpsert) UpdateStatus() *CoreProxyRolloutPolicyUpsert {
+	u.SetExcluded(coreproxyrolloutpolicy.FieldStatus)
+	return u
+}
+
+// AddStatus adds v to the "status" field.
+func (u *CoreProxyRolloutPolicyUpsert) AddStatus(v constant.YesOrNo) *CoreProxyRolloutPolicyUpsert {
+	u.Add(coreproxyrolloutpolicy.FieldStatus, v)
+	return u
+}
+
+// ClearStatus clears the value of the "status" field.
+func (u *CoreProxyRolloutPolicyUpsert) ClearStatus() *CoreProxyRolloutPolicyUpsert {
+	u.SetNull(coreproxyrolloutpolicy.FieldStatus)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.CoreProxyRolloutPolicy.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(coreproxyrolloutpolicy.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *CoreProxyRolloutPolicyUpsertOne) UpdateNewValues() *CoreProxyRolloutPolicyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(coreproxyrolloutpolicy.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(coreproxyrolloutpolicy.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.CoreProxyRolloutPolicy.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *CoreProxyRolloutPolicyUpsertOne) Ignore() *CoreProxyRolloutPolicyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *CoreProxyRolloutPolicyUpsertOne) DoNothing() *CoreProxyRolloutPolicyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the CoreProxyRolloutPolicyCreate.OnConflict
+// documentation for more info.
+func (u *CoreProxyRolloutPolicyUpsertOne) Update(set func(*CoreProxyRolloutPolicyUpsert)) *CoreProxyRolloutPolicyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&CoreProxyRolloutPolicyUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *CoreProxyRolloutPolicyUpsertOne) SetUpdatedAt(v time.Time) *CoreProxyRolloutPolicyUpsertOne {
+	return u.Update(func(s *CoreProxyRolloutPolicyUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *CoreProxyRolloutPolicyUpsertOne) UpdateUpdatedAt() *CoreProxyRolloutPolicyUpsertOne {
+	return u.Update(func(s *CoreProxyRolloutPolicyUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *CoreProxyRolloutPolicyUpsertOne) SetDeletedAt(v time.Time) *CoreProxyRolloutPolicyUpsertOne {
+	return u.Update(func(s *CoreProxyRolloutPolicyUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *CoreProxyRolloutPolicyUpsertOne) UpdateDeletedAt() *CoreProxyRolloutPolicyUpsertOne {
+	return u.Update(func(s *CoreProxyRolloutPolicyUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *CoreProxyRolloutPolicyUpsertOne) ClearDeletedAt() *CoreProxyRolloutPolicyUpsertOne {
+	return u.Update(func(s *CoreProxyRolloutPolicyUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetClusterID sets the "cluster_id" field.
+func (u *CoreProxyRolloutPolicyUpsertOne) SetClusterID(v string) *CoreProxyRolloutPolicyUpsertOne {
+	return u.Update(func(s *CoreProxyRolloutPolicyUpsert) {
+		s.SetClusterID(v)
+	})
+}
+
+// UpdateClusterID sets the "cluster_id" field to the value that was provided on create.
+func (u *CoreProxyRolloutPolicyUpsertOne) UpdateClusterID() *CoreProxyRolloutPolicyUpsertOne {
+	return u.Update(func(s *CoreProxyRolloutPolicyUpsert) {
+		s.UpdateClusterID()
+	})
+}
+
+// ClearClusterID clears the value of the "cluster_id" field.
+func (u *CoreProxyRolloutPolicyUpsertOne) ClearClusterID() *CoreProxyRolloutPolicyUpsertOne {
+	return u.Update(func(s *CoreProxyRolloutPolicyUpsert) {
+		s.ClearClusterID()
+	})
+}
+
+// SetPercentage sets the "percentage" field.
+func (u *CoreProxyRolloutPolicyUpsertOne) SetPercentage(v int) *CoreProxyRolloutPolicyUpsertOne {
+	return u.Update(func(s *CoreProxyRolloutPolicyUpsert) {
+		s.SetPercentage(v)
+	})
+}
+
+// AddPercentage adds v to the "percentage" field.
+func (u *CoreProxyRolloutPolicyUpsertOne) AddPercentage(v int) *CoreProxyRolloutPolicyUpsertOne {
+	return u.Update(func(s *CoreProxyRolloutPolicyUpsert) {
+		s.AddPercentage(v)
+	})
+}
+
+// UpdatePercentage sets the "percentage" field to the value that was provided on create.
+func (u *CoreProxyRolloutPolicyUpsertOne) UpdatePercentage() *CoreProxyRolloutPolicyUpsertOne {
+	return u.Update(func(s *CoreProxyRolloutPolicyUpsert) {
+		s.UpdatePercentage()
+	})
+}
+
+// ClearPercentage clears the value of the "percentage" field.
+func (u *CoreProxyRolloutPolicyUpsertOne) ClearPercentage() *CoreProxyRolloutPolicyUpsertOne {
+	return u.Update(func(s *CoreProxyRolloutPolicyUpsert) {
+		s.ClearPercentage()
+	})
+}
+
+// SetNodeIds sets the "node_ids" field.
+func (u *CoreProxyRolloutPolicyUpsertOne) SetNodeIds(v []string) *CoreProxyRolloutPolicyUpsertOne {
+	return u.Update(func(s *CoreProxyRolloutPolicyUpsert) {
+		s.SetNodeIds(v)
+	})
+}
+
+// UpdateNodeIds sets the "node_ids" field to the value that was provided on create.
+func (u *CoreProxyRolloutPolicyUpsertOne) UpdateNodeIds() *CoreProxyRolloutPolicyUpsertOne {
+	return u.Update(func(s *CoreProxyRolloutPolicyUpsert) {
+		s.UpdateNodeIds()
+	})
+}
+
+// ClearNodeIds clears the value of the "node_ids" field.
+func (u *CoreProxyRolloutPolicyUpsertOne) ClearNodeIds() *CoreProxyRolloutPolicyUpsertOne {
+	return u.Update(func(s *CoreProxyRolloutPolicyUpsert) {
+		s.ClearNodeIds()
+	})
+}
+
+// SetSoakSeconds sets the "soak_seconds" field.
+func (u *CoreProxyRolloutPolicyUpsertOne) SetSoakSeconds(v int) *CoreProxyRolloutPolicyUpsertOne {
+	return u.Update(func(s *CoreProxyRolloutPolicyUpsert) {
+		s.SetSoakSeconds(v)
+	})
+}
+
+// AddSoakSeconds adds v to the "soak_seconds" field.
+func (u *CoreProxyRolloutPolicyUpsertOne) AddSoakSeconds(v int) *CoreProxyRolloutPolicyUpsertOne {
+	return u.Update(func(s *CoreProxyRolloutPolicyUpsert) {
+		s.AddSoakSeconds(v)
+	})
+}
+
+// UpdateSoakSeconds sets the "soak_seconds" field to the value that was provided on create.
+func (u *CoreProxyRolloutPolicyUpsertOne) UpdateSoakSeconds() *CoreProxyRolloutPolicyUpsertOne {
+	return u.Update(func(s *CoreProxyRolloutPolicyUpsert) {
+		s.UpdateSoakSeconds()
+	})
+}
+
+// ClearSoakSeconds clears the value of the "soak_seconds" field.
+func (u *CoreProxyRolloutPolicyUpsertOne) ClearSoakSeconds() *CoreProxyRolloutPolicyUpsertOne {
+	return u.Update(func(s *CoreProxyRolloutPolicyUpsert) {
+		s.ClearSoakSeconds()
+	})
+}
+
+// SetMaxErrorRate sets the "max_error_rate" field.
+func (u *CoreProxyRolloutPolicyUpsertOne) SetMaxErrorRate(v float64) *CoreProxyRolloutPolicyUpsertOne {
+	return u.Update(func(s *CoreProxyRolloutPolicyUpsert) {
+		s.SetMaxErrorRate(v)
+	})
+}
+
+// AddMaxErrorRate adds v to the "max_error_rate" field.
+func (u *CoreProxyRolloutPolicyUpsertOne) AddMaxErrorRate(v float64) *CoreProxyRolloutPolicyUpsertOne {
+	return u.Update(func(s *CoreProxyRolloutPolicyUpsert) {
+		s.AddMaxErrorRate(v)
+	})
+}
+
+// UpdateMaxErrorRate sets the "max_error_rate" field to the value that was provided on create.
+func (u *CoreProxyRolloutPolicyUpsertOne) UpdateMaxErrorRate() *CoreProxyRolloutPolicyUpsertOne {
+	return u.Update(func(s *CoreProxyRolloutPolicyUpsert) {
+		s.UpdateMaxErrorRate()
+	})
+}
+
+// ClearMaxErrorRate clears the value of the "max_error_rate" field.
+func (u *CoreProxyRolloutPolicyUpsertOne) ClearMaxErrorRate() *CoreProxyRolloutPolicyUpsertOne {
+	return u.Update(func(s *CoreProxyRolloutPolicyUpsert) {
+		s.ClearMaxErrorRate()
+	})
+}
+
+// SetMinRequests sets the "min_requests" field.
+func (u *CoreProxyRolloutPolicyUpsertOne) SetMinRequests(v int64) *CoreProxyRolloutPolicyUpsertOne {
+	return u.Update(func(s *CoreProxyRolloutPolicyUpsert) {
+		s.SetMinRequests(v)
+	})
+}
+
+// AddMinRequests adds v to the "min_requests" field.
+func (u *CoreProxyRolloutPolicyUpsertOne) AddMinRequests(v int64) *CoreProxyRolloutPolicyUpsertOne {
+	return u.Update(func(s *CoreProxyRolloutPolicyUpsert) {
+		s.AddMinRequests(v)
+	})
+}
+
+// UpdateMinRequests sets the "min_requests" field to the value that was provided on create.
+func (u *CoreProxyRolloutPolicyUpsertOne) UpdateMinRequests() *CoreProxyRolloutPolicyUpsertOne {
+	return u.Update(func(s *CoreProxyRolloutPolicyUpsert) {
+		s.UpdateMinRequests()
+	})
+}
+
+// ClearMinRequests clears the value of the "min_requests" field.
+func (u *CoreProxyRolloutPolicyUpsertOne) ClearMinRequests() *CoreProxyRolloutPolicyUpsertOne {
+	return u.Update(func(s *CoreProxyRolloutPolicyUpsert) {
+		s.ClearMinRequests()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *CoreProxyRolloutPolicyUpsertOne) SetStatus(v constant.YesOrNo) *CoreProxyRolloutPolicyUpsertOne {
+	return u.Update(func(s *CoreProxyRolloutPolicyUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// AddStatus adds v to the "status" field.
+func (u *CoreProxyRolloutPolicyUpsertOne) AddStatus(v constant.YesOrNo) *CoreProxyRolloutPolicyUpsertOne {
+	return u.Update(func(s *CoreProxyRolloutPolicyUpsert) {
+		s.AddStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *CoreProxyRolloutPolicyUpsertOne) UpdateStatus() *CoreProxyRolloutPolicyUpsertOne {
+	return u.Update(func(s *CoreProxyRolloutPolicyUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// ClearStatus clears the value of the "status" field.
+func (u *CoreProxyRolloutPolicyUpsertOne) ClearStatus() *CoreProxyRolloutPolicyUpsertOne {
+	return u.Update(func(s *CoreProxyRolloutPolicyUpsert) {
+		s.ClearStatus()
+	})
+}
+
+// Exec executes the query.
+func (u *CoreProxyRolloutPolicyUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for CoreProxyRolloutPolicyCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *CoreProxyRolloutPolicyUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *CoreProxyRolloutPolicyUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: CoreProxyRolloutPolicyUpsertOne.ID is not supported by MySQL driver. Use CoreProxyRolloutPolicyUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *CoreProxyRolloutPolicyUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// CoreProxyRolloutPolicyCreateBulk is the builder for creating many CoreProxyRolloutPolicy entities in bulk.
+type CoreProxyRolloutPolicyCreateBulk struct {
+	config
+	err      error
+	builders []*CoreProxyRolloutPolicyCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the CoreProxyRolloutPolicy entities in the database.
+func (_c *CoreProxyRolloutPolicyCreateBulk) Save(ctx context.Context) ([]*CoreProxyRolloutPolicy, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*CoreProxyRolloutPolicy, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*CoreProxyRolloutPolicyMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *CoreProxyRolloutPolicyCreateBulk) SaveX(ctx context.Context) []*CoreProxyRolloutPolicy {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *CoreProxyRolloutPolicyCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *CoreProxyRolloutPolicyCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.CoreProxyRolloutPolicy.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.CoreProxyRolloutPolicyUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *CoreProxyRolloutPolicyCreateBulk) OnConflict(opts ...sql.ConflictOption) *CoreProxyRolloutPolicyUpsertBulk {
+	_c.conflict = opts
+	return &CoreProxyRolloutPolicyUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.CoreProxyRolloutPolicy.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *CoreProxyRolloutPolicyCreateBulk) OnConflictColumns(columns ...string) *CoreProxyRolloutPolicyUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &CoreProxyRolloutPolicyUpsertBulk{
+		create: _c,
+	}
+}
+
+// CoreProxyRolloutPolicyUpsertBulk is the builder for "upsert"-ing
+// a bulk of CoreProxyRolloutPolicy nodes.
+type CoreProxyRolloutPolicyUpsertBulk struct {
+	create *CoreProxyRolloutPolicyCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.CoreProxyRolloutPolicy.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(coreproxyrolloutpolicy.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *CoreProxyRolloutPolicyUpsertBulk) UpdateNewValues() *CoreProxyRolloutPolicyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(coreproxyrolloutpolicy.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(coreproxyrolloutpolicy.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.CoreProxyRolloutPolicy.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *CoreProxyRolloutPolicyUpsertBulk) Ignore() *CoreProxyRolloutPolicyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *CoreProxyRolloutPolicyUpsertBulk) DoNothing() *CoreProxyRolloutPolicyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the CoreProxyRolloutPolicyCreateBulk.OnConflict
+// documentation for more info.
+func (u *CoreProxyRolloutPolicyUpsertBulk) Update(set func(*CoreProxyRolloutPolicyUpsert)) *CoreProxyRolloutPolicyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&CoreProxyRolloutPolicyUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *CoreProxyRolloutPolicyUpsertBulk) SetUpdatedAt(v time.Time) *CoreProxyRolloutPolicyUpsertBulk {
+	return u.Update(func(s *CoreProxyRolloutPolicyUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *CoreProxyRolloutPolicyUpsertBulk) UpdateUpdatedAt() *CoreProxyRolloutPolicyUpsertBulk {
+	return u.Update(func(s *CoreProxyRolloutPolicyUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *CoreProxyRolloutPolicyUpsertBulk) SetDeletedAt(v time.Time) *CoreProxyRolloutPolicyUpsertBulk {
+	return u.Update(func(s *CoreProxyRolloutPolicyUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *CoreProxyRolloutPolicyUpsertBulk) UpdateDeletedAt() *CoreProxyRolloutPolicyUpsertBulk {
+	return u.Update(func(s *CoreProxyRolloutPolicyUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *CoreProxyRolloutPolicyUpsertBulk) ClearDeletedAt() *CoreProxyRolloutPolicyUpsertBulk {
+	return u.Update(func(s *CoreProxyRolloutPolicyUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetClusterID sets the "cluster_id" field.
+func (u *CoreProxyRolloutPolicyUpsertBulk) SetClusterID(v string) *CoreProxyRolloutPolicyUpsertBulk {
+	return u.Update(func(s *CoreProxyRolloutPolicyUpsert) {
+		s.SetClusterID(v)
+	})
+}
+
+// UpdateClusterID sets the "cluster_id" field to the value that was provided on create.
+func (u *CoreProxyRolloutPolicyUpsertBulk) UpdateClusterID() *CoreProxyRolloutPolicyUpsertBulk {
+	return u.Update(func(s *CoreProxyRolloutPolicyUpsert) {
+		s.UpdateClusterID()
+	})
+}
+
+// ClearClusterID clears the value of the "cluster_id" field.
+func (u *CoreProxyRolloutPolicyUpsertBulk) ClearClusterID() *CoreProxyRolloutPolicyUpsertBulk {
+	return u.Update(func(s *CoreProxyRolloutPolicyUpsert) {
+		s.ClearClusterID()
+	})
+}
+
+// SetPercentage sets the "percentage" field.
+func (u *CoreProxyRolloutPolicyUpsertBulk) SetPercentage(v int) *CoreProxyRolloutPolicyUpsertBulk {
+	return u.Update(func(s *CoreProxyRolloutPolicyUpsert) {
+		s.SetPercentage(v)
+	})
+}
+
+// AddPercentage adds v to the "percentage" field.
+func (u *CoreProxyRolloutPolicyUpsertBulk) AddPercentage(v int) *CoreProxyRolloutPolicyUpsertBulk {
+	return u.Update(func(s *CoreProxyRolloutPolicyUpsert) {
+		s.AddPercentage(v)
+	})
+}
+
+// UpdatePercentage sets the "percentage" field to the value that was provided on create.
+func (u *CoreProxyRolloutPolicyUpsertBulk) UpdatePercentage() *CoreProxyRolloutPolicyUpsertBulk {
+	return u.Update(func(s *CoreProxyRolloutPolicyUpsert) {
+		s.UpdatePercentage()
+	})
+}
+
+// ClearPercentage clears the value of the "percentage" field.
+func (u *CoreProxyRolloutPolicyUpsertBulk) ClearPercentage() *CoreProxyRolloutPolicyUpsertBulk {
+	return u.Update(func(s *CoreProxyRolloutPolicyUpsert) {
+		s.ClearPercentage()
+	})
+}
+
+// SetNodeIds sets the "node_ids" field.
+func (u *CoreProxyRolloutPolicyUpsertBulk) SetNodeIds(v []string) *CoreProxyRolloutPolicyUpsertBulk {
+	return u.Update(func(s *CoreProxyRolloutPolicyUpsert) {
+		s.SetNodeIds(v)
+	})
+}
+
+// UpdateNodeIds sets the "node_ids" field to the value that was provided on create.
+func (u *CoreProxyRolloutPolicyUpsertBulk) UpdateNodeIds() *CoreProxyRolloutPolicyUpsertBulk {
+	return u.Update(func(s *CoreProxyRolloutPolicyUpsert) {
+		s.UpdateNodeIds()
+	})
+}
+
+// ClearNodeIds clears the value of the "node_ids" field.
+func (u *CoreProxyRolloutPolicyUpsertBulk) ClearNodeIds() *CoreProxyRolloutPolicyUpsertBulk {
+	return u.Update(func(s *CoreProxyRolloutPolicyUpsert) {
+		s.ClearNodeIds()
+	})
+}
+
+// SetSoakSeconds sets the "soak_seconds" field.
+func (u *CoreProxyRolloutPolicyUpsertBulk) SetSoakSeconds(v int) *CoreProxyRolloutPolicyUpsertBulk {
+	return u.Update(func(s *CoreProxyRolloutPolicyUpsert) {
+		s.SetSoakSeconds(v)
+	})
+}
+
+// AddSoakSeconds adds v to the "soak_seconds" field.
+func (u *CoreProxyRolloutPolicyUpsertBulk) AddSoakSeconds(v int) *CoreProxyRolloutPolicyUpsertBulk {
+	return u.Update(func(s *CoreProxyRolloutPolicyUpsert) {
+		s.AddSoakSeconds(v)
+	})
+}
+
+// UpdateSoakSeconds sets the "soak_seconds" field to the value that was provided on create.
+func (u *CoreProxyRolloutPolicyUpsertBulk) UpdateSoakSeconds() *CoreProxyRolloutPolicyUpsertBulk {
+	return u.Update(func(s *CoreProxyRolloutPolicyUpsert) {
+		s.UpdateSoakSeconds()
+	})
+}
+
+// ClearSoakSeconds clears the value of the "soak_seconds" field.
+func (u *CoreProxyRolloutPolicyUpsertBulk) ClearSoakSeconds() *CoreProxyRolloutPolicyUpsertBulk {
+	return u.Update(func(s *CoreProxyRolloutPolicyUpsert) {
+		s.ClearSoakSeconds()
+	})
+}
+
+// SetMaxErrorRate sets the "max_error_rate" field.
+func (u *CoreProxyRolloutPolicyUpsertBulk) SetMaxErrorRate(v float64) *CoreProxyRolloutPolicyUpsertBulk {
+	return u.Update(func(s *CoreProxyRolloutPolicyUpsert) {
+		s.SetMaxErrorRate(v)
+	})
+}
+
+// AddMaxErrorRate adds v to the "max_error_rate" field.
+func (u *CoreProxyRolloutPolicyUpsertBulk) AddMaxErrorRate(v float64) *CoreProxyRolloutPolicyUpsertBulk {
+	return u.Update(func(s *CoreProxyRolloutPolicyUpsert) {
+		s.AddMaxErrorRate(v)
+	})
+}
+
+// UpdateMaxErrorRate sets the "max_error_rate" field to the value that was provided on create.
+func (u *CoreProxyRolloutPolicyUpsertBulk) UpdateMaxErrorRate() *CoreProxyRolloutPolicyUpsertBulk {
+	return u.Update(func(s *CoreProxyRolloutPolicyUpsert) {
+		s.UpdateMaxErrorRate()
+	})
+}
+
+// ClearMaxErrorRate clears the value of the "max_error_rate" field.
+func (u *CoreProxyRolloutPolicyUpsertBulk) ClearMaxErrorRate() *CoreProxyRolloutPolicyUpsertBulk {
+	return u.Update(func(s *CoreProxyRolloutPolicyUpsert) {
+		s.ClearMaxErrorRate()
+	})
+}
+
+// SetMinRequests sets the "min_requests" field.
+func (u *CoreProxyRolloutPolicyUpsertBulk) SetMinRequests(v int64) *CoreProxyRolloutPolicyUpsertBulk {
+	return u.Update(func(s *CoreProxyRolloutPolicyUpsert) {
+		s.SetMinRequests(v)
+	})
+}
+
+// AddMinRequests adds v to the "min_requests" field.
+func (u *CoreProxyRolloutPolicyUpsertBulk) AddMinRequests(v int64) *CoreProxyRolloutPolicyUpsertBulk {
+	return u.Update(func(s *CoreProxyRolloutPolicyUpsert) {
+		s.AddMinRequests(v)
+	})
+}
+
+// UpdateMinRequests sets the "min_requests" field to the value that was provided on create.
+func (u *CoreProxyRolloutPolicyUpsertBulk) UpdateMinRequests() *CoreProxyRolloutPolicyUpsertBulk {
+	return u.Update(func(s *CoreProxyRolloutPolicyUpsert) {
+		s.UpdateMinRequests()
+	})
+}
+
+// ClearMinRequests clears the value of the "min_requests" field.
+func (u *CoreProxyRolloutPolicyUpsertBulk) ClearMinRequests() *CoreProxyRolloutPolicyUpsertBulk {
+	return u.Update(func(s *CoreProxyRolloutPolicyUpsert) {
+		s.ClearMinRequests()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *CoreProxyRolloutPolicyUpsertBulk) SetStatus(v constant.YesOrNo) *CoreProxyRolloutPolicyUpsertBulk {
+	return u.Update(func(s *CoreProxyRolloutPolicyUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// AddStatus adds v to the "status" field.
+func (u *CoreProxyRolloutPolicyUpsertBulk) AddStatus(v constant.YesOrNo) *CoreProxyRolloutPolicyUpsertBulk {
+	return u.Update(func(s *CoreProxyRolloutPolicyUpsert) {
+		s.AddStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *CoreProxyRolloutPolicyUpsertBulk) UpdateStatus() *CoreProxyRolloutPolicyUpsertBulk {
+	return u.Update(func(s *CoreProxyRolloutPolicyUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// ClearStatus clears the value of the "status" field.
+func (u *CoreProxyRolloutPolicyUpsertBulk) ClearStatus() *CoreProxyRolloutPolicyUpsertBulk {
+	return u.Update(func(s *CoreProxyRolloutPolicyUpsert) {
+		s.ClearStatus()
+	})
+}
+
+// Exec executes the query.
+func (u *CoreProxyRolloutPolicyUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the CoreProxyRolloutPolicyCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for CoreProxyRolloutPolicyCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *CoreProxyRolloutPolicyUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/coreproxyrolloutpolicy"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/predicate"
+)
+
+// CoreProxyRolloutPolicyDelete is the builder for deleting a CoreProxyRolloutPolicy entity.
+type CoreProxyRolloutPolicyDelete struct {
+	config
+	hooks    []Hook
+	mutation *CoreProxyRolloutPolicyMutation
+}
+
+// Where appends a list predicates to the CoreProxyRolloutPolicyDelete builder.
+func (_d *CoreProxyRolloutPolicyDelete) Where(ps ...predicate.CoreProxyRolloutPolicy) *CoreProxyRolloutPolicyDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *CoreProxyRolloutPolicyDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *CoreProxyRolloutPolicyDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *CoreProxyRolloutPolicyDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(coreproxyrolloutpolicy.Table, sqlgraph.NewFieldSpec(coreproxyrolloutpolicy.FieldID, field.TypeString))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// CoreProxyRolloutPolicyDeleteOne is the builder for deleting a single CoreProxyRolloutPolicy entity.
+type CoreProxyRolloutPolicyDeleteOne struct {
+	_d *CoreProxyRolloutPolicyDelete
+}
+
+// Where appends a list predicates to the CoreProxyRolloutPolicyDelete builder.
+func (_d *CoreProxyRolloutPolicyDeleteOne) Where(ps ...predicate.CoreProxyRolloutPolicy) *CoreProxyRolloutPolicyDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *CoreProxyRolloutPolicyDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{coreproxyrolloutpolicy.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *CoreProxyRolloutPolicyDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
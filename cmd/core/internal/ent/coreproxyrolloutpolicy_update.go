@@ -0,0 +1,764 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/coreproxyrolloutpolicy"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/predicate"
+	"github.com/lyonmu/quebec/pkg/constant"
+)
+
+// CoreProxyRolloutPolicyUpdate is the builder for updating CoreProxyRolloutPolicy entities.
+type CoreProxyRolloutPolicyUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *CoreProxyRolloutPolicyMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the CoreProxyRolloutPolicyUpdate builder.
+func (_u *CoreProxyRolloutPolicyUpdate) Where(ps ...predicate.CoreProxyRolloutPolicy) *CoreProxyRolloutPolicyUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *CoreProxyRolloutPolicyUpdate) SetUpdatedAt(v time.Time) *CoreProxyRolloutPolicyUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *CoreProxyRolloutPolicyUpdate) SetDeletedAt(v time.Time) *CoreProxyRolloutPolicyUpdate {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *CoreProxyRolloutPolicyUpdate) SetNillableDeletedAt(v *time.Time) *CoreProxyRolloutPolicyUpdate {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *CoreProxyRolloutPolicyUpdate) ClearDeletedAt() *CoreProxyRolloutPolicyUpdate {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetClusterID sets the "cluster_id" field.
+func (_u *CoreProxyRolloutPolicyUpdate) SetClusterID(v string) *CoreProxyRolloutPolicyUpdate {
+	_u.mutation.SetClusterID(v)
+	return _u
+}
+
+// SetNillableClusterID sets the "cluster_id" field if the given value is not nil.
+func (_u *CoreProxyRolloutPolicyUpdate) SetNillableClusterID(v *string) *CoreProxyRolloutPolicyUpdate {
+	if v != nil {
+		_u.SetClusterID(*v)
+	}
+	return _u
+}
+
+// ClearClusterID clears the value of the "cluster_id" field.
+func (_u *CoreProxyRolloutPolicyUpdate) ClearClusterID() *CoreProxyRolloutPolicyUpdate {
+	_u.mutation.ClearClusterID()
+	return _u
+}
+
+// SetPercentage sets the "percentage" field.
+func (_u *CoreProxyRolloutPolicyUpdate) SetPercentage(v int) *CoreProxyRolloutPolicyUpdate {
+	_u.mutation.ResetPercentage()
+	_u.mutation.SetPercentage(v)
+	return _u
+}
+
+// SetNillablePercentage sets the "percentage" field if the given value is not nil.
+func (_u *CoreProxyRolloutPolicyUpdate) SetNillablePercentage(v *int) *CoreProxyRolloutPolicyUpdate {
+	if v != nil {
+		_u.SetPercentage(*v)
+	}
+	return _u
+}
+
+// AddPercentage adds value to the "percentage" field.
+func (_u *CoreProxyRolloutPolicyUpdate) AddPercentage(v int) *CoreProxyRolloutPolicyUpdate {
+	_u.mutation.AddPercentage(v)
+	return _u
+}
+
+// ClearPercentage clears the value of the "percentage" field.
+func (_u *CoreProxyRolloutPolicyUpdate) ClearPercentage() *CoreProxyRolloutPolicyUpdate {
+	_u.mutation.ClearPercentage()
+	return _u
+}
+
+// SetNodeIds sets the "node_ids" field.
+func (_u *CoreProxyRolloutPolicyUpdate) SetNodeIds(v []string) *CoreProxyRolloutPolicyUpdate {
+	_u.mutation.SetNodeIds(v)
+	return _u
+}
+
+// AppendNodeIds appends value to the "node_ids" field.
+func (_u *CoreProxyRolloutPolicyUpdate) AppendNodeIds(v []string) *CoreProxyRolloutPolicyUpdate {
+	_u.mutation.AppendNodeIds(v)
+	return _u
+}
+
+// ClearNodeIds clears the value of the "node_ids" field.
+func (_u *CoreProxyRolloutPolicyUpdate) ClearNodeIds() *CoreProxyRolloutPolicyUpdate {
+	_u.mutation.ClearNodeIds()
+	return _u
+}
+
+// SetSoakSeconds sets the "soak_seconds" field.
+func (_u *CoreProxyRolloutPolicyUpdate) SetSoakSeconds(v int) *CoreProxyRolloutPolicyUpdate {
+	_u.mutation.ResetSoakSeconds()
+	_u.mutation.SetSoakSeconds(v)
+	return _u
+}
+
+// SetNillableSoakSeconds sets the "soak_seconds" field if the given value is not nil.
+func (_u *CoreProxyRolloutPolicyUpdate) SetNillableSoakSeconds(v *int) *CoreProxyRolloutPolicyUpdate {
+	if v != nil {
+		_u.SetSoakSeconds(*v)
+	}
+	return _u
+}
+
+// AddSoakSeconds adds value to the "soak_seconds" field.
+func (_u *CoreProxyRolloutPolicyUpdate) AddSoakSeconds(v int) *CoreProxyRolloutPolicyUpdate {
+	_u.mutation.AddSoakSeconds(v)
+	return _u
+}
+
+// ClearSoakSeconds clears the value of the "soak_seconds" field.
+func (_u *CoreProxyRolloutPolicyUpdate) ClearSoakSeconds() *CoreProxyRolloutPolicyUpdate {
+	_u.mutation.ClearSoakSeconds()
+	return _u
+}
+
+// SetMaxErrorRate sets the "max_error_rate" field.
+func (_u *CoreProxyRolloutPolicyUpdate) SetMaxErrorRate(v float64) *CoreProxyRolloutPolicyUpdate {
+	_u.mutation.ResetMaxErrorRate()
+	_u.mutation.SetMaxErrorRate(v)
+	return _u
+}
+
+// SetNillableMaxErrorRate sets the "max_error_rate" field if the given value is not nil.
+func (_u *CoreProxyRolloutPolicyUpdate) SetNillableMaxErrorRate(v *float64) *CoreProxyRolloutPolicyUpdate {
+	if v != nil {
+		_u.SetMaxErrorRate(*v)
+	}
+	return _u
+}
+
+// AddMaxErrorRate adds value to the "max_error_rate" field.
+func (_u *CoreProxyRolloutPolicyUpdate) AddMaxErrorRate(v float64) *CoreProxyRolloutPolicyUpdate {
+	_u.mutation.AddMaxErrorRate(v)
+	return _u
+}
+
+// ClearMaxErrorRate clears the value of the "max_error_rate" field.
+func (_u *CoreProxyRolloutPolicyUpdate) ClearMaxErrorRate() *CoreProxyRolloutPolicyUpdate {
+	_u.mutation.ClearMaxErrorRate()
+	return _u
+}
+
+// SetMinRequests sets the "min_requests" field.
+func (_u *CoreProxyRolloutPolicyUpdate) SetMinRequests(v int64) *CoreProxyRolloutPolicyUpdate {
+	_u.mutation.ResetMinRequests()
+	_u.mutation.SetMinRequests(v)
+	return _u
+}
+
+// SetNillableMinRequests sets the "min_requests" field if the given value is not nil.
+func (_u *CoreProxyRolloutPolicyUpdate) SetNillableMinRequests(v *int64) *CoreProxyRolloutPolicyUpdate {
+	if v != nil {
+		_u.SetMinRequests(*v)
+	}
+	return _u
+}
+
+// AddMinRequests adds value to the "min_requests" field.
+func (_u *CoreProxyRolloutPolicyUpdate) AddMinRequests(v int64) *CoreProxyRolloutPolicyUpdate {
+	_u.mutation.AddMinRequests(v)
+	return _u
+}
+
+// ClearMinRequests clears the value of the "min_requests" field.
+func (_u *CoreProxyRolloutPolicyUpdate) ClearMinRequests() *CoreProxyRolloutPolicyUpdate {
+	_u.mutation.ClearMinRequests()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *CoreProxyRolloutPolicyUpdate) SetStatus(v constant.YesOrNo) *CoreProxyRolloutPolicyUpdate {
+	_u.mutation.ResetStatus()
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *CoreProxyRolloutPolicyUpdate) SetNillableStatus(v *constant.YesOrNo) *CoreProxyRolloutPolicyUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// AddStatus adds value to the "status" field.
+func (_u *CoreProxyRolloutPolicyUpdate) AddStatus(v constant.YesOrNo) *CoreProxyRolloutPolicyUpdate {
+	_u.mutation.AddStatus(v)
+	return _u
+}
+
+// ClearStatus clears the value of the "status" field.
+func (_u *CoreProxyRolloutPolicyUpdate) ClearStatus() *CoreProxyRolloutPolicyUpdate {
+	_u.mutation.ClearStatus()
+	return _u
+}
+
+// Mutation returns the CoreProxyRolloutPolicyMutation object of the builder.
+func (_u *CoreProxyRolloutPolicyUpdate) Mutation() *CoreProxyRolloutPolicyMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *CoreProxyRolloutPolicyUpdate) Save(ctx context.Context) (int, error) {
+	if err := _u.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *CoreProxyRolloutPolicyUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *CoreProxyRolloutPolicyUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *CoreProxyRolloutPolicyUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *CoreProxyRolloutPolicyUpdate) defaults() error {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		if coreproxyrolloutpolicy.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized coreproxyrolloutpolicy.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := coreproxyrolloutpolicy.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *CoreProxyRolloutPolicyUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *CoreProxyRolloutPolicyUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *CoreProxyRolloutPolicyUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(coreproxyrolloutpolicy.Table, coreproxyrolloutpolicy.Columns, sqlgraph.NewFieldSpec(coreproxyrolloutpolicy.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(coreproxyrolloutpolicy.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(coreproxyrolloutpolicy.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(coreproxyrolloutpolicy.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ClusterID(); ok {
+		_spec.SetField(coreproxyrolloutpolicy.FieldClusterID, field.TypeString, value)
+	}
+	if _u.mutation.ClusterIDCleared() {
+		_spec.ClearField(coreproxyrolloutpolicy.FieldClusterID, field.TypeString)
+	}
+	if value, ok := _u.mutation.Percentage(); ok {
+		_spec.SetField(coreproxyrolloutpolicy.FieldPercentage, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPercentage(); ok {
+		_spec.AddField(coreproxyrolloutpolicy.FieldPercentage, field.TypeInt, value)
+	}
+	if _u.mutation.PercentageCleared() {
+		_spec.ClearField(coreproxyrolloutpolicy.FieldPercentage, field.TypeInt)
+	}
+	if value, ok := _u.mutation.NodeIds(); ok {
+		_spec.SetField(coreproxyrolloutpolicy.FieldNodeIds, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedNodeIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, coreproxyrolloutpolicy.FieldNodeIds, value)
+		})
+	}
+	if _u.mutation.NodeIdsCleared() {
+		_spec.ClearField(coreproxyrolloutpolicy.FieldNodeIds, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.SoakSeconds(); ok {
+		_spec.SetField(coreproxyrolloutpolicy.FieldSoakSeconds, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSoakSeconds(); ok {
+		_spec.AddField(coreproxyrolloutpolicy.FieldSoakSeconds, field.TypeInt, value)
+	}
+	if _u.mutation.SoakSecondsCleared() {
+		_spec.ClearField(coreproxyrolloutpolicy.FieldSoakSeconds, field.TypeInt)
+	}
+	if value, ok := _u.mutation.MaxErrorRate(); ok {
+		_spec.SetField(coreproxyrolloutpolicy.FieldMaxErrorRate, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedMaxErrorRate(); ok {
+		_spec.AddField(coreproxyrolloutpolicy.FieldMaxErrorRate, field.TypeFloat64, value)
+	}
+	if _u.mutation.MaxErrorRateCleared() {
+		_spec.ClearField(coreproxyrolloutpolicy.FieldMaxErrorRate, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.MinRequests(); ok {
+		_spec.SetField(coreproxyrolloutpolicy.FieldMinRequests, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedMinRequests(); ok {
+		_spec.AddField(coreproxyrolloutpolicy.FieldMinRequests, field.TypeInt64, value)
+	}
+	if _u.mutation.MinRequestsCleared() {
+		_spec.ClearField(coreproxyrolloutpolicy.FieldMinRequests, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(coreproxyrolloutpolicy.FieldStatus, field.TypeInt8, value)
+	}
+	if value, ok := _u.mutation.AddedStatus(); ok {
+		_spec.AddField(coreproxyrolloutpolicy.FieldStatus, field.TypeInt8, value)
+	}
+	if _u.mutation.StatusCleared() {
+		_spec.ClearField(coreproxyrolloutpolicy.FieldStatus, field.TypeInt8)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{coreproxyrolloutpolicy.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// CoreProxyRolloutPolicyUpdateOne is the builder for updating a single CoreProxyRolloutPolicy entity.
+type CoreProxyRolloutPolicyUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *CoreProxyRolloutPolicyMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *CoreProxyRolloutPolicyUpdateOne) SetUpdatedAt(v time.Time) *CoreProxyRolloutPolicyUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *CoreProxyRolloutPolicyUpdateOne) SetDeletedAt(v time.Time) *CoreProxyRolloutPolicyUpdateOne {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *CoreProxyRolloutPolicyUpdateOne) SetNillableDeletedAt(v *time.Time) *CoreProxyRolloutPolicyUpdateOne {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *CoreProxyRolloutPolicyUpdateOne) ClearDeletedAt() *CoreProxyRolloutPolicyUpdateOne {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetClusterID sets the "cluster_id" field.
+func (_u *CoreProxyRolloutPolicyUpdateOne) SetClusterID(v string) *CoreProxyRolloutPolicyUpdateOne {
+	_u.mutation.SetClusterID(v)
+	return _u
+}
+
+// SetNillableClusterID sets the "cluster_id" field if the given value is not nil.
+func (_u *CoreProxyRolloutPolicyUpdateOne) SetNillableClusterID(v *string) *CoreProxyRolloutPolicyUpdateOne {
+	if v != nil {
+		_u.SetClusterID(*v)
+	}
+	return _u
+}
+
+// ClearClusterID clears the value of the "cluster_id" field.
+func (_u *CoreProxyRolloutPolicyUpdateOne) ClearClusterID() *CoreProxyRolloutPolicyUpdateOne {
+	_u.mutation.ClearClusterID()
+	return _u
+}
+
+// SetPercentage sets the "percentage" field.
+func (_u *CoreProxyRolloutPolicyUpdateOne) SetPercentage(v int) *CoreProxyRolloutPolicyUpdateOne {
+	_u.mutation.ResetPercentage()
+	_u.mutation.SetPercentage(v)
+	return _u
+}
+
+// SetNillablePercentage sets the "percentage" field if the given value is not nil.
+func (_u *CoreProxyRolloutPolicyUpdateOne) SetNillablePercentage(v *int) *CoreProxyRolloutPolicyUpdateOne {
+	if v != nil {
+		_u.SetPercentage(*v)
+	}
+	return _u
+}
+
+// AddPercentage adds value to the "percentage" field.
+func (_u *CoreProxyRolloutPolicyUpdateOne) AddPercentage(v int) *CoreProxyRolloutPolicyUpdateOne {
+	_u.mutation.AddPercentage(v)
+	return _u
+}
+
+// ClearPercentage clears the value of the "percentage" field.
+func (_u *CoreProxyRolloutPolicyUpdateOne) ClearPercentage() *CoreProxyRolloutPolicyUpdateOne {
+	_u.mutation.ClearPercentage()
+	return _u
+}
+
+// SetNodeIds sets the "node_ids" field.
+func (_u *CoreProxyRolloutPolicyUpdateOne) SetNodeIds(v []string) *CoreProxyRolloutPolicyUpdateOne {
+	_u.mutation.SetNodeIds(v)
+	return _u
+}
+
+// AppendNodeIds appends value to the "node_ids" field.
+func (_u *CoreProxyRolloutPolicyUpdateOne) AppendNodeIds(v []string) *CoreProxyRolloutPolicyUpdateOne {
+	_u.mutation.AppendNodeIds(v)
+	return _u
+}
+
+// ClearNodeIds clears the value of the "node_ids" field.
+func (_u *CoreProxyRolloutPolicyUpdateOne) ClearNodeIds() *CoreProxyRolloutPolicyUpdateOne {
+	_u.mutation.ClearNodeIds()
+	return _u
+}
+
+// SetSoakSeconds sets the "soak_seconds" field.
+func (_u *CoreProxyRolloutPolicyUpdateOne) SetSoakSeconds(v int) *CoreProxyRolloutPolicyUpdateOne {
+	_u.mutation.ResetSoakSeconds()
+	_u.mutation.SetSoakSeconds(v)
+	return _u
+}
+
+// SetNillableSoakSeconds sets the "soak_seconds" field if the given value is not nil.
+func (_u *CoreProxyRolloutPolicyUpdateOne) SetNillableSoakSeconds(v *int) *CoreProxyRolloutPolicyUpdateOne {
+	if v != nil {
+		_u.SetSoakSeconds(*v)
+	}
+	return _u
+}
+
+// AddSoakSeconds adds value to the "soak_seconds" field.
+func (_u *CoreProxyRolloutPolicyUpdateOne) AddSoakSeconds(v int) *CoreProxyRolloutPolicyUpdateOne {
+	_u.mutation.AddSoakSeconds(v)
+	return _u
+}
+
+// ClearSoakSeconds clears the value of the "soak_seconds" field.
+func (_u *CoreProxyRolloutPolicyUpdateOne) ClearSoakSeconds() *CoreProxyRolloutPolicyUpdateOne {
+	_u.mutation.ClearSoakSeconds()
+	return _u
+}
+
+// SetMaxErrorRate sets the "max_error_rate" field.
+func (_u *CoreProxyRolloutPolicyUpdateOne) SetMaxErrorRate(v float64) *CoreProxyRolloutPolicyUpdateOne {
+	_u.mutation.ResetMaxErrorRate()
+	_u.mutation.SetMaxErrorRate(v)
+	return _u
+}
+
+// SetNillableMaxErrorRate sets the "max_error_rate" field if the given value is not nil.
+func (_u *CoreProxyRolloutPolicyUpdateOne) SetNillableMaxErrorRate(v *float64) *CoreProxyRolloutPolicyUpdateOne {
+	if v != nil {
+		_u.SetMaxErrorRate(*v)
+	}
+	return _u
+}
+
+// AddMaxErrorRate adds value to the "max_error_rate" field.
+func (_u *CoreProxyRolloutPolicyUpdateOne) AddMaxErrorRate(v float64) *CoreProxyRolloutPolicyUpdateOne {
+	_u.mutation.AddMaxErrorRate(v)
+	return _u
+}
+
+// ClearMaxErrorRate clears the value of the "max_error_rate" field.
+func (_u *CoreProxyRolloutPolicyUpdateOne) ClearMaxErrorRate() *CoreProxyRolloutPolicyUpdateOne {
+	_u.mutation.ClearMaxErrorRate()
+	return _u
+}
+
+// SetMinRequests sets the "min_requests" field.
+func (_u *CoreProxyRolloutPolicyUpdateOne) SetMinRequests(v int64) *CoreProxyRolloutPolicyUpdateOne {
+	_u.mutation.ResetMinRequests()
+	_u.mutation.SetMinRequests(v)
+	return _u
+}
+
+// SetNillableMinRequests sets the "min_requests" field if the given value is not nil.
+func (_u *CoreProxyRolloutPolicyUpdateOne) SetNillableMinRequests(v *int64) *CoreProxyRolloutPolicyUpdateOne {
+	if v != nil {
+		_u.SetMinRequests(*v)
+	}
+	return _u
+}
+
+// AddMinRequests adds value to the "min_requests" field.
+func (_u *CoreProxyRolloutPolicyUpdateOne) AddMinRequests(v int64) *CoreProxyRolloutPolicyUpdateOne {
+	_u.mutation.AddMinRequests(v)
+	return _u
+}
+
+// ClearMinRequests clears the value of the "min_requests" field.
+func (_u *CoreProxyRolloutPolicyUpdateOne) ClearMinRequests() *CoreProxyRolloutPolicyUpdateOne {
+	_u.mutation.ClearMinRequests()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *CoreProxyRolloutPolicyUpdateOne) SetStatus(v constant.YesOrNo) *CoreProxyRolloutPolicyUpdateOne {
+	_u.mutation.ResetStatus()
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *CoreProxyRolloutPolicyUpdateOne) SetNillableStatus(v *constant.YesOrNo) *CoreProxyRolloutPolicyUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// AddStatus adds value to the "status" field.
+func (_u *CoreProxyRolloutPolicyUpdateOne) AddStatus(v constant.YesOrNo) *CoreProxyRolloutPolicyUpdateOne {
+	_u.mutation.AddStatus(v)
+	return _u
+}
+
+// ClearStatus clears the value of the "status" field.
+func (_u *CoreProxyRolloutPolicyUpdateOne) ClearStatus() *CoreProxyRolloutPolicyUpdateOne {
+	_u.mutation.ClearStatus()
+	return _u
+}
+
+// Mutation returns the CoreProxyRolloutPolicyMutation object of the builder.
+func (_u *CoreProxyRolloutPolicyUpdateOne) Mutation() *CoreProxyRolloutPolicyMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the CoreProxyRolloutPolicyUpdate builder.
+func (_u *CoreProxyRolloutPolicyUpdateOne) Where(ps ...predicate.CoreProxyRolloutPolicy) *CoreProxyRolloutPolicyUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *CoreProxyRolloutPolicyUpdateOne) Select(field string, fields ...string) *CoreProxyRolloutPolicyUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated CoreProxyRolloutPolicy entity.
+func (_u *CoreProxyRolloutPolicyUpdateOne) Save(ctx context.Context) (*CoreProxyRolloutPolicy, error) {
+	if err := _u.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *CoreProxyRolloutPolicyUpdateOne) SaveX(ctx context.Context) *CoreProxyRolloutPolicy {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *CoreProxyRolloutPolicyUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *CoreProxyRolloutPolicyUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *CoreProxyRolloutPolicyUpdateOne) defaults() error {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		if coreproxyrolloutpolicy.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized coreproxyrolloutpolicy.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := coreproxyrolloutpolicy.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *CoreProxyRolloutPolicyUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *CoreProxyRolloutPolicyUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *CoreProxyRolloutPolicyUpdateOne) sqlSave(ctx context.Context) (_node *CoreProxyRolloutPolicy, err error) {
+	_spec := sqlgraph.NewUpdateSpec(coreproxyrolloutpolicy.Table, coreproxyrolloutpolicy.Columns, sqlgraph.NewFieldSpec(coreproxyrolloutpolicy.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "CoreProxyRolloutPolicy.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, coreproxyrolloutpolicy.FieldID)
+		for _, f := range fields {
+			if !coreproxyrolloutpolicy.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != coreproxyrolloutpolicy.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(coreproxyrolloutpolicy.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(coreproxyrolloutpolicy.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(coreproxyrolloutpolicy.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ClusterID(); ok {
+		_spec.SetField(coreproxyrolloutpolicy.FieldClusterID, field.TypeString, value)
+	}
+	if _u.mutation.ClusterIDCleared() {
+		_spec.ClearField(coreproxyrolloutpolicy.FieldClusterID, field.TypeString)
+	}
+	if value, ok := _u.mutation.Percentage(); ok {
+		_spec.SetField(coreproxyrolloutpolicy.FieldPercentage, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPercentage(); ok {
+		_spec.AddField(coreproxyrolloutpolicy.FieldPercentage, field.TypeInt, value)
+	}
+	if _u.mutation.PercentageCleared() {
+		_spec.ClearField(coreproxyrolloutpolicy.FieldPercentage, field.TypeInt)
+	}
+	if value, ok := _u.mutation.NodeIds(); ok {
+		_spec.SetField(coreproxyrolloutpolicy.FieldNodeIds, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedNodeIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, coreproxyrolloutpolicy.FieldNodeIds, value)
+		})
+	}
+	if _u.mutation.NodeIdsCleared() {
+		_spec.ClearField(coreproxyrolloutpolicy.FieldNodeIds, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.SoakSeconds(); ok {
+		_spec.SetField(coreproxyrolloutpolicy.FieldSoakSeconds, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSoakSeconds(); ok {
+		_spec.AddField(coreproxyrolloutpolicy.FieldSoakSeconds, field.TypeInt, value)
+	}
+	if _u.mutation.SoakSecondsCleared() {
+		_spec.ClearField(coreproxyrolloutpolicy.FieldSoakSeconds, field.TypeInt)
+	}
+	if value, ok := _u.mutation.MaxErrorRate(); ok {
+		_spec.SetField(coreproxyrolloutpolicy.FieldMaxErrorRate, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedMaxErrorRate(); ok {
+		_spec.AddField(coreproxyrolloutpolicy.FieldMaxErrorRate, field.TypeFloat64, value)
+	}
+	if _u.mutation.MaxErrorRateCleared() {
+		_spec.ClearField(coreproxyrolloutpolicy.FieldMaxErrorRate, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.MinRequests(); ok {
+		_spec.SetField(coreproxyrolloutpolicy.FieldMinRequests, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedMinRequests(); ok {
+		_spec.AddField(coreproxyrolloutpolicy.FieldMinRequests, field.TypeInt64, value)
+	}
+	if _u.mutation.MinRequestsCleared() {
+		_spec.ClearField(coreproxyrolloutpolicy.FieldMinRequests, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(coreproxyrolloutpolicy.FieldStatus, field.TypeInt8, value)
+	}
+	if value, ok := _u.mutation.AddedStatus(); ok {
+		_spec.AddField(coreproxyrolloutpolicy.FieldStatus, field.TypeInt8, value)
+	}
+	if _u.mutation.StatusCleared() {
+		_spec.ClearField(coreproxyrolloutpolicy.FieldStatus, field.TypeInt8)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &CoreProxyRolloutPolicy{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{coreproxyrolloutpolicy.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
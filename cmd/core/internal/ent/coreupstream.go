@@ -31,6 +31,12 @@ type CoreUpstream struct {
 	Description string `json:"description,omitempty"`
 	// 负载均衡策略: 1-ROUND_ROBIN 2-LEAST_REQUEST 3-RANDOM 4-RING_HASH 5-MAGLEV
 	LbPolicy constant.ProxyLbPolicy `json:"lb_policy,omitempty"`
+	// 环哈希的最小环大小，0 表示使用 Envoy 默认值
+	RingMinSize uint64 `json:"ring_min_size,omitempty"`
+	// 环哈希的最大环大小，0 表示使用 Envoy 默认值
+	RingMaxSize uint64 `json:"ring_max_size,omitempty"`
+	// Maglev 查找表大小，必须为质数，0 表示使用 Envoy 默认值
+	MaglevTableSize uint64 `json:"maglev_table_size,omitempty"`
 	// 连接超时(毫秒)
 	ConnectTimeoutMs int `json:"connect_timeout_ms,omitempty"`
 	// 最大连接数
@@ -42,16 +48,59 @@ type CoreUpstream struct {
 	// 最大重试次数
 	MaxRetries int `json:"max_retries,omitempty"`
 	// 状态 [1-启用 2-禁用]
-	Status       constant.YesOrNo `json:"status,omitempty"`
+	Status constant.YesOrNo `json:"status,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the CoreUpstreamQuery when eager-loading is set.
+	Edges        CoreUpstreamEdges `json:"-" gorm:"-"`
 	selectValues sql.SelectValues
 }
 
+// CoreUpstreamEdges holds the relations/edges for other nodes in the graph.
+type CoreUpstreamEdges struct {
+	// UpstreamToHost holds the value of the upstream_to_host edge.
+	UpstreamToHost []*CoreUpstreamHost `json:"upstream_to_host,omitempty"`
+	// UpstreamToRoute holds the value of the upstream_to_route edge.
+	UpstreamToRoute []*CoreGatewayHttpRoute `json:"upstream_to_route,omitempty"`
+	// UpstreamToTarget holds the value of the upstream_to_target edge.
+	UpstreamToTarget []*CoreGatewayHttpRouteTarget `json:"upstream_to_target,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [3]bool
+}
+
+// UpstreamToHostOrErr returns the UpstreamToHost value or an error if the edge
+// was not loaded in eager-loading.
+func (e CoreUpstreamEdges) UpstreamToHostOrErr() ([]*CoreUpstreamHost, error) {
+	if e.loadedTypes[0] {
+		return e.UpstreamToHost, nil
+	}
+	return nil, &NotLoadedError{edge: "upstream_to_host"}
+}
+
+// UpstreamToRouteOrErr returns the UpstreamToRoute value or an error if the edge
+// was not loaded in eager-loading.
+func (e CoreUpstreamEdges) UpstreamToRouteOrErr() ([]*CoreGatewayHttpRoute, error) {
+	if e.loadedTypes[1] {
+		return e.UpstreamToRoute, nil
+	}
+	return nil, &NotLoadedError{edge: "upstream_to_route"}
+}
+
+// UpstreamToTargetOrErr returns the UpstreamToTarget value or an error if the edge
+// was not loaded in eager-loading.
+func (e CoreUpstreamEdges) UpstreamToTargetOrErr() ([]*CoreGatewayHttpRouteTarget, error) {
+	if e.loadedTypes[2] {
+		return e.UpstreamToTarget, nil
+	}
+	return nil, &NotLoadedError{edge: "upstream_to_target"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*CoreUpstream) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case coreupstream.FieldLbPolicy, coreupstream.FieldConnectTimeoutMs, coreupstream.FieldMaxConnections, coreupstream.FieldMaxPendingRequests, coreupstream.FieldMaxRequests, coreupstream.FieldMaxRetries, coreupstream.FieldStatus:
+		case coreupstream.FieldLbPolicy, coreupstream.FieldRingMinSize, coreupstream.FieldRingMaxSize, coreupstream.FieldMaglevTableSize, coreupstream.FieldConnectTimeoutMs, coreupstream.FieldMaxConnections, coreupstream.FieldMaxPendingRequests, coreupstream.FieldMaxRequests, coreupstream.FieldMaxRetries, coreupstream.FieldStatus:
 			values[i] = new(sql.NullInt64)
 		case coreupstream.FieldID, coreupstream.FieldName, coreupstream.FieldDescription:
 			values[i] = new(sql.NullString)
@@ -115,6 +164,24 @@ func (_m *CoreUpstream) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.LbPolicy = constant.ProxyLbPolicy(value.Int64)
 			}
+		case coreupstream.FieldRingMinSize:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field ring_min_size", values[i])
+			} else if value.Valid {
+				_m.RingMinSize = uint64(value.Int64)
+			}
+		case coreupstream.FieldRingMaxSize:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field ring_max_size", values[i])
+			} else if value.Valid {
+				_m.RingMaxSize = uint64(value.Int64)
+			}
+		case coreupstream.FieldMaglevTableSize:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field maglev_table_size", values[i])
+			} else if value.Valid {
+				_m.MaglevTableSize = uint64(value.Int64)
+			}
 		case coreupstream.FieldConnectTimeoutMs:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field connect_timeout_ms", values[i])
@@ -164,6 +231,21 @@ func (_m *CoreUpstream) Value(name string) (ent.Value, error) {
 	return _m.selectValues.Get(name)
 }
 
+// QueryUpstreamToHost queries the "upstream_to_host" edge of the CoreUpstream entity.
+func (_m *CoreUpstream) QueryUpstreamToHost() *CoreUpstreamHostQuery {
+	return NewCoreUpstreamClient(_m.config).QueryUpstreamToHost(_m)
+}
+
+// QueryUpstreamToRoute queries the "upstream_to_route" edge of the CoreUpstream entity.
+func (_m *CoreUpstream) QueryUpstreamToRoute() *CoreGatewayHttpRouteQuery {
+	return NewCoreUpstreamClient(_m.config).QueryUpstreamToRoute(_m)
+}
+
+// QueryUpstreamToTarget queries the "upstream_to_target" edge of the CoreUpstream entity.
+func (_m *CoreUpstream) QueryUpstreamToTarget() *CoreGatewayHttpRouteTargetQuery {
+	return NewCoreUpstreamClient(_m.config).QueryUpstreamToTarget(_m)
+}
+
 // Update returns a builder for updating this CoreUpstream.
 // Note that you need to call CoreUpstream.Unwrap() before calling this method if this CoreUpstream
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -207,6 +289,15 @@ func (_m *CoreUpstream) String() string {
 	builder.WriteString("lb_policy=")
 	builder.WriteString(fmt.Sprintf("%v", _m.LbPolicy))
 	builder.WriteString(", ")
+	builder.WriteString("ring_min_size=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RingMinSize))
+	builder.WriteString(", ")
+	builder.WriteString("ring_max_size=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RingMaxSize))
+	builder.WriteString(", ")
+	builder.WriteString("maglev_table_size=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MaglevTableSize))
+	builder.WriteString(", ")
 	builder.WriteString("connect_timeout_ms=")
 	builder.WriteString(fmt.Sprintf("%v", _m.ConnectTimeoutMs))
 	builder.WriteString(", ")
@@ -7,6 +7,7 @@ import (
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/lyonmu/quebec/pkg/constant"
 )
 
@@ -27,6 +28,12 @@ const (
 	FieldDescription = "description"
 	// FieldLbPolicy holds the string denoting the lb_policy field in the database.
 	FieldLbPolicy = "lb_policy"
+	// FieldRingMinSize holds the string denoting the ring_min_size field in the database.
+	FieldRingMinSize = "ring_min_size"
+	// FieldRingMaxSize holds the string denoting the ring_max_size field in the database.
+	FieldRingMaxSize = "ring_max_size"
+	// FieldMaglevTableSize holds the string denoting the maglev_table_size field in the database.
+	FieldMaglevTableSize = "maglev_table_size"
 	// FieldConnectTimeoutMs holds the string denoting the connect_timeout_ms field in the database.
 	FieldConnectTimeoutMs = "connect_timeout_ms"
 	// FieldMaxConnections holds the string denoting the max_connections field in the database.
@@ -39,8 +46,35 @@ const (
 	FieldMaxRetries = "max_retries"
 	// FieldStatus holds the string denoting the status field in the database.
 	FieldStatus = "status"
+	// EdgeUpstreamToHost holds the string denoting the upstream_to_host edge name in mutations.
+	EdgeUpstreamToHost = "upstream_to_host"
+	// EdgeUpstreamToRoute holds the string denoting the upstream_to_route edge name in mutations.
+	EdgeUpstreamToRoute = "upstream_to_route"
+	// EdgeUpstreamToTarget holds the string denoting the upstream_to_target edge name in mutations.
+	EdgeUpstreamToTarget = "upstream_to_target"
 	// Table holds the table name of the coreupstream in the database.
 	Table = "quebec_core_upstream"
+	// UpstreamToHostTable is the table that holds the upstream_to_host relation/edge.
+	UpstreamToHostTable = "quebec_core_upstream_host"
+	// UpstreamToHostInverseTable is the table name for the CoreUpstreamHost entity.
+	// It exists in this package in order to avoid circular dependency with the "coreupstreamhost" package.
+	UpstreamToHostInverseTable = "quebec_core_upstream_host"
+	// UpstreamToHostColumn is the table column denoting the upstream_to_host relation/edge.
+	UpstreamToHostColumn = "upstream_id"
+	// UpstreamToRouteTable is the table that holds the upstream_to_route relation/edge.
+	UpstreamToRouteTable = "quebec_core_gateway_http_route"
+	// UpstreamToRouteInverseTable is the table name for the CoreGatewayHttpRoute entity.
+	// It exists in this package in order to avoid circular dependency with the "coregatewayhttproute" package.
+	UpstreamToRouteInverseTable = "quebec_core_gateway_http_route"
+	// UpstreamToRouteColumn is the table column denoting the upstream_to_route relation/edge.
+	UpstreamToRouteColumn = "upstream_id"
+	// UpstreamToTargetTable is the table that holds the upstream_to_target relation/edge.
+	UpstreamToTargetTable = "quebec_core_gateway_http_route_target"
+	// UpstreamToTargetInverseTable is the table name for the CoreGatewayHttpRouteTarget entity.
+	// It exists in this package in order to avoid circular dependency with the "coregatewayhttproutetarget" package.
+	UpstreamToTargetInverseTable = "quebec_core_gateway_http_route_target"
+	// UpstreamToTargetColumn is the table column denoting the upstream_to_target relation/edge.
+	UpstreamToTargetColumn = "upstream_id"
 )
 
 // Columns holds all SQL columns for coreupstream fields.
@@ -52,6 +86,9 @@ var Columns = []string{
 	FieldName,
 	FieldDescription,
 	FieldLbPolicy,
+	FieldRingMinSize,
+	FieldRingMaxSize,
+	FieldMaglevTableSize,
 	FieldConnectTimeoutMs,
 	FieldMaxConnections,
 	FieldMaxPendingRequests,
@@ -85,6 +122,12 @@ var (
 	UpdateDefaultUpdatedAt func() time.Time
 	// DefaultLbPolicy holds the default value on creation for the "lb_policy" field.
 	DefaultLbPolicy constant.ProxyLbPolicy
+	// DefaultRingMinSize holds the default value on creation for the "ring_min_size" field.
+	DefaultRingMinSize uint64
+	// DefaultRingMaxSize holds the default value on creation for the "ring_max_size" field.
+	DefaultRingMaxSize uint64
+	// DefaultMaglevTableSize holds the default value on creation for the "maglev_table_size" field.
+	DefaultMaglevTableSize uint64
 	// DefaultConnectTimeoutMs holds the default value on creation for the "connect_timeout_ms" field.
 	DefaultConnectTimeoutMs int
 	// DefaultMaxConnections holds the default value on creation for the "max_connections" field.
@@ -141,6 +184,21 @@ func ByLbPolicy(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldLbPolicy, opts...).ToFunc()
 }
 
+// ByRingMinSize orders the results by the ring_min_size field.
+func ByRingMinSize(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRingMinSize, opts...).ToFunc()
+}
+
+// ByRingMaxSize orders the results by the ring_max_size field.
+func ByRingMaxSize(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRingMaxSize, opts...).ToFunc()
+}
+
+// ByMaglevTableSize orders the results by the maglev_table_size field.
+func ByMaglevTableSize(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMaglevTableSize, opts...).ToFunc()
+}
+
 // ByConnectTimeoutMs orders the results by the connect_timeout_ms field.
 func ByConnectTimeoutMs(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldConnectTimeoutMs, opts...).ToFunc()
@@ -170,3 +228,66 @@ func ByMaxRetries(opts ...sql.OrderTermOption) OrderOption {
 func ByStatus(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldStatus, opts...).ToFunc()
 }
+
+// ByUpstreamToHostCount orders the results by upstream_to_host count.
+func ByUpstreamToHostCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newUpstreamToHostStep(), opts...)
+	}
+}
+
+// ByUpstreamToHost orders the results by upstream_to_host terms.
+func ByUpstreamToHost(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newUpstreamToHostStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByUpstreamToRouteCount orders the results by upstream_to_route count.
+func ByUpstreamToRouteCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newUpstreamToRouteStep(), opts...)
+	}
+}
+
+// ByUpstreamToRoute orders the results by upstream_to_route terms.
+func ByUpstreamToRoute(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newUpstreamToRouteStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByUpstreamToTargetCount orders the results by upstream_to_target count.
+func ByUpstreamToTargetCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newUpstreamToTargetStep(), opts...)
+	}
+}
+
+// ByUpstreamToTarget orders the results by upstream_to_target terms.
+func ByUpstreamToTarget(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newUpstreamToTargetStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newUpstreamToHostStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(UpstreamToHostInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, UpstreamToHostTable, UpstreamToHostColumn),
+	)
+}
+func newUpstreamToRouteStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(UpstreamToRouteInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, UpstreamToRouteTable, UpstreamToRouteColumn),
+	)
+}
+func newUpstreamToTargetStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(UpstreamToTargetInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, UpstreamToTargetTable, UpstreamToTargetColumn),
+	)
+}
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/lyonmu/quebec/cmd/core/internal/ent/predicate"
 	"github.com/lyonmu/quebec/pkg/constant"
 )
@@ -96,6 +97,21 @@ func LbPolicy(v constant.ProxyLbPolicy) predicate.CoreUpstream {
 	return predicate.CoreUpstream(sql.FieldEQ(FieldLbPolicy, vc))
 }
 
+// RingMinSize applies equality check predicate on the "ring_min_size" field. It's identical to RingMinSizeEQ.
+func RingMinSize(v uint64) predicate.CoreUpstream {
+	return predicate.CoreUpstream(sql.FieldEQ(FieldRingMinSize, v))
+}
+
+// RingMaxSize applies equality check predicate on the "ring_max_size" field. It's identical to RingMaxSizeEQ.
+func RingMaxSize(v uint64) predicate.CoreUpstream {
+	return predicate.CoreUpstream(sql.FieldEQ(FieldRingMaxSize, v))
+}
+
+// MaglevTableSize applies equality check predicate on the "maglev_table_size" field. It's identical to MaglevTableSizeEQ.
+func MaglevTableSize(v uint64) predicate.CoreUpstream {
+	return predicate.CoreUpstream(sql.FieldEQ(FieldMaglevTableSize, v))
+}
+
 // ConnectTimeoutMs applies equality check predicate on the "connect_timeout_ms" field. It's identical to ConnectTimeoutMsEQ.
 func ConnectTimeoutMs(v int) predicate.CoreUpstream {
 	return predicate.CoreUpstream(sql.FieldEQ(FieldConnectTimeoutMs, v))
@@ -471,6 +487,156 @@ func LbPolicyNotNil() predicate.CoreUpstream {
 	return predicate.CoreUpstream(sql.FieldNotNull(FieldLbPolicy))
 }
 
+// RingMinSizeEQ applies the EQ predicate on the "ring_min_size" field.
+func RingMinSizeEQ(v uint64) predicate.CoreUpstream {
+	return predicate.CoreUpstream(sql.FieldEQ(FieldRingMinSize, v))
+}
+
+// RingMinSizeNEQ applies the NEQ predicate on the "ring_min_size" field.
+func RingMinSizeNEQ(v uint64) predicate.CoreUpstream {
+	return predicate.CoreUpstream(sql.FieldNEQ(FieldRingMinSize, v))
+}
+
+// RingMinSizeIn applies the In predicate on the "ring_min_size" field.
+func RingMinSizeIn(vs ...uint64) predicate.CoreUpstream {
+	return predicate.CoreUpstream(sql.FieldIn(FieldRingMinSize, vs...))
+}
+
+// RingMinSizeNotIn applies the NotIn predicate on the "ring_min_size" field.
+func RingMinSizeNotIn(vs ...uint64) predicate.CoreUpstream {
+	return predicate.CoreUpstream(sql.FieldNotIn(FieldRingMinSize, vs...))
+}
+
+// RingMinSizeGT applies the GT predicate on the "ring_min_size" field.
+func RingMinSizeGT(v uint64) predicate.CoreUpstream {
+	return predicate.CoreUpstream(sql.FieldGT(FieldRingMinSize, v))
+}
+
+// RingMinSizeGTE applies the GTE predicate on the "ring_min_size" field.
+func RingMinSizeGTE(v uint64) predicate.CoreUpstream {
+	return predicate.CoreUpstream(sql.FieldGTE(FieldRingMinSize, v))
+}
+
+// RingMinSizeLT applies the LT predicate on the "ring_min_size" field.
+func RingMinSizeLT(v uint64) predicate.CoreUpstream {
+	return predicate.CoreUpstream(sql.FieldLT(FieldRingMinSize, v))
+}
+
+// RingMinSizeLTE applies the LTE predicate on the "ring_min_size" field.
+func RingMinSizeLTE(v uint64) predicate.CoreUpstream {
+	return predicate.CoreUpstream(sql.FieldLTE(FieldRingMinSize, v))
+}
+
+// RingMinSizeIsNil applies the IsNil predicate on the "ring_min_size" field.
+func RingMinSizeIsNil() predicate.CoreUpstream {
+	return predicate.CoreUpstream(sql.FieldIsNull(FieldRingMinSize))
+}
+
+// RingMinSizeNotNil applies the NotNil predicate on the "ring_min_size" field.
+func RingMinSizeNotNil() predicate.CoreUpstream {
+	return predicate.CoreUpstream(sql.FieldNotNull(FieldRingMinSize))
+}
+
+// RingMaxSizeEQ applies the EQ predicate on the "ring_max_size" field.
+func RingMaxSizeEQ(v uint64) predicate.CoreUpstream {
+	return predicate.CoreUpstream(sql.FieldEQ(FieldRingMaxSize, v))
+}
+
+// RingMaxSizeNEQ applies the NEQ predicate on the "ring_max_size" field.
+func RingMaxSizeNEQ(v uint64) predicate.CoreUpstream {
+	return predicate.CoreUpstream(sql.FieldNEQ(FieldRingMaxSize, v))
+}
+
+// RingMaxSizeIn applies the In predicate on the "ring_max_size" field.
+func RingMaxSizeIn(vs ...uint64) predicate.CoreUpstream {
+	return predicate.CoreUpstream(sql.FieldIn(FieldRingMaxSize, vs...))
+}
+
+// RingMaxSizeNotIn applies the NotIn predicate on the "ring_max_size" field.
+func RingMaxSizeNotIn(vs ...uint64) predicate.CoreUpstream {
+	return predicate.CoreUpstream(sql.FieldNotIn(FieldRingMaxSize, vs...))
+}
+
+// RingMaxSizeGT applies the GT predicate on the "ring_max_size" field.
+func RingMaxSizeGT(v uint64) predicate.CoreUpstream {
+	return predicate.CoreUpstream(sql.FieldGT(FieldRingMaxSize, v))
+}
+
+// RingMaxSizeGTE applies the GTE predicate on the "ring_max_size" field.
+func RingMaxSizeGTE(v uint64) predicate.CoreUpstream {
+	return predicate.CoreUpstream(sql.FieldGTE(FieldRingMaxSize, v))
+}
+
+// RingMaxSizeLT applies the LT predicate on the "ring_max_size" field.
+func RingMaxSizeLT(v uint64) predicate.CoreUpstream {
+	return predicate.CoreUpstream(sql.FieldLT(FieldRingMaxSize, v))
+}
+
+// RingMaxSizeLTE applies the LTE predicate on the "ring_max_size" field.
+func RingMaxSizeLTE(v uint64) predicate.CoreUpstream {
+	return predicate.CoreUpstream(sql.FieldLTE(FieldRingMaxSize, v))
+}
+
+// RingMaxSizeIsNil applies the IsNil predicate on the "ring_max_size" field.
+func RingMaxSizeIsNil() predicate.CoreUpstream {
+	return predicate.CoreUpstream(sql.FieldIsNull(FieldRingMaxSize))
+}
+
+// RingMaxSizeNotNil applies the NotNil predicate on the "ring_max_size" field.
+func RingMaxSizeNotNil() predicate.CoreUpstream {
+	return predicate.CoreUpstream(sql.FieldNotNull(FieldRingMaxSize))
+}
+
+// MaglevTableSizeEQ applies the EQ predicate on the "maglev_table_size" field.
+func MaglevTableSizeEQ(v uint64) predicate.CoreUpstream {
+	return predicate.CoreUpstream(sql.FieldEQ(FieldMaglevTableSize, v))
+}
+
+// MaglevTableSizeNEQ applies the NEQ predicate on the "maglev_table_size" field.
+func MaglevTableSizeNEQ(v uint64) predicate.CoreUpstream {
+	return predicate.CoreUpstream(sql.FieldNEQ(FieldMaglevTableSize, v))
+}
+
+// MaglevTableSizeIn applies the In predicate on the "maglev_table_size" field.
+func MaglevTableSizeIn(vs ...uint64) predicate.CoreUpstream {
+	return predicate.CoreUpstream(sql.FieldIn(FieldMaglevTableSize, vs...))
+}
+
+// MaglevTableSizeNotIn applies the NotIn predicate on the "maglev_table_size" field.
+func MaglevTableSizeNotIn(vs ...uint64) predicate.CoreUpstream {
+	return predicate.CoreUpstream(sql.FieldNotIn(FieldMaglevTableSize, vs...))
+}
+
+// MaglevTableSizeGT applies the GT predicate on the "maglev_table_size" field.
+func MaglevTableSizeGT(v uint64) predicate.CoreUpstream {
+	return predicate.CoreUpstream(sql.FieldGT(FieldMaglevTableSize, v))
+}
+
+// MaglevTableSizeGTE applies the GTE predicate on the "maglev_table_size" field.
+func MaglevTableSizeGTE(v uint64) predicate.CoreUpstream {
+	return predicate.CoreUpstream(sql.FieldGTE(FieldMaglevTableSize, v))
+}
+
+// MaglevTableSizeLT applies the LT predicate on the "maglev_table_size" field.
+func MaglevTableSizeLT(v uint64) predicate.CoreUpstream {
+	return predicate.CoreUpstream(sql.FieldLT(FieldMaglevTableSize, v))
+}
+
+// MaglevTableSizeLTE applies the LTE predicate on the "maglev_table_size" field.
+func MaglevTableSizeLTE(v uint64) predicate.CoreUpstream {
+	return predicate.CoreUpstream(sql.FieldLTE(FieldMaglevTableSize, v))
+}
+
+// MaglevTableSizeIsNil applies the IsNil predicate on the "maglev_table_size" field.
+func MaglevTableSizeIsNil() predicate.CoreUpstream {
+	return predicate.CoreUpstream(sql.FieldIsNull(FieldMaglevTableSize))
+}
+
+// MaglevTableSizeNotNil applies the NotNil predicate on the "maglev_table_size" field.
+func MaglevTableSizeNotNil() predicate.CoreUpstream {
+	return predicate.CoreUpstream(sql.FieldNotNull(FieldMaglevTableSize))
+}
+
 // ConnectTimeoutMsEQ applies the EQ predicate on the "connect_timeout_ms" field.
 func ConnectTimeoutMsEQ(v int) predicate.CoreUpstream {
 	return predicate.CoreUpstream(sql.FieldEQ(FieldConnectTimeoutMs, v))
@@ -785,6 +951,75 @@ func StatusNotNil() predicate.CoreUpstream {
 	return predicate.CoreUpstream(sql.FieldNotNull(FieldStatus))
 }
 
+// HasUpstreamToHost applies the HasEdge predicate on the "upstream_to_host" edge.
+func HasUpstreamToHost() predicate.CoreUpstream {
+	return predicate.CoreUpstream(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, UpstreamToHostTable, UpstreamToHostColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUpstreamToHostWith applies the HasEdge predicate on the "upstream_to_host" edge with a given conditions (other predicates).
+func HasUpstreamToHostWith(preds ...predicate.CoreUpstreamHost) predicate.CoreUpstream {
+	return predicate.CoreUpstream(func(s *sql.Selector) {
+		step := newUpstreamToHostStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasUpstreamToRoute applies the HasEdge predicate on the "upstream_to_route" edge.
+func HasUpstreamToRoute() predicate.CoreUpstream {
+	return predicate.CoreUpstream(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, UpstreamToRouteTable, UpstreamToRouteColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUpstreamToRouteWith applies the HasEdge predicate on the "upstream_to_route" edge with a given conditions (other predicates).
+func HasUpstreamToRouteWith(preds ...predicate.CoreGatewayHttpRoute) predicate.CoreUpstream {
+	return predicate.CoreUpstream(func(s *sql.Selector) {
+		step := newUpstreamToRouteStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasUpstreamToTarget applies the HasEdge predicate on the "upstream_to_target" edge.
+func HasUpstreamToTarget() predicate.CoreUpstream {
+	return predicate.CoreUpstream(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, UpstreamToTargetTable, UpstreamToTargetColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUpstreamToTargetWith applies the HasEdge predicate on the "upstream_to_target" edge with a given conditions (other predicates).
+func HasUpstreamToTargetWith(preds ...predicate.CoreGatewayHttpRouteTarget) predicate.CoreUpstream {
+	return predicate.CoreUpstream(func(s *sql.Selector) {
+		step := newUpstreamToTargetStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.CoreUpstream) predicate.CoreUpstream {
 	return predicate.CoreUpstream(sql.AndPredicates(predicates...))
@@ -12,7 +12,10 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/coregatewayhttproute"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/coregatewayhttproutetarget"
 	"github.com/lyonmu/quebec/cmd/core/internal/ent/coreupstream"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/coreupstreamhost"
 	"github.com/lyonmu/quebec/pkg/constant"
 )
 
@@ -108,6 +111,48 @@ func (_c *CoreUpstreamCreate) SetNillableLbPolicy(v *constant.ProxyLbPolicy) *Co
 	return _c
 }
 
+// SetRingMinSize sets the "ring_min_size" field.
+func (_c *CoreUpstreamCreate) SetRingMinSize(v uint64) *CoreUpstreamCreate {
+	_c.mutation.SetRingMinSize(v)
+	return _c
+}
+
+// SetNillableRingMinSize sets the "ring_min_size" field if the given value is not nil.
+func (_c *CoreUpstreamCreate) SetNillableRingMinSize(v *uint64) *CoreUpstreamCreate {
+	if v != nil {
+		_c.SetRingMinSize(*v)
+	}
+	return _c
+}
+
+// SetRingMaxSize sets the "ring_max_size" field.
+func (_c *CoreUpstreamCreate) SetRingMaxSize(v uint64) *CoreUpstreamCreate {
+	_c.mutation.SetRingMaxSize(v)
+	return _c
+}
+
+// SetNillableRingMaxSize sets the "ring_max_size" field if the given value is not nil.
+func (_c *CoreUpstreamCreate) SetNillableRingMaxSize(v *uint64) *CoreUpstreamCreate {
+	if v != nil {
+		_c.SetRingMaxSize(*v)
+	}
+	return _c
+}
+
+// SetMaglevTableSize sets the "maglev_table_size" field.
+func (_c *CoreUpstreamCreate) SetMaglevTableSize(v uint64) *CoreUpstreamCreate {
+	_c.mutation.SetMaglevTableSize(v)
+	return _c
+}
+
+// SetNillableMaglevTableSize sets the "maglev_table_size" field if the given value is not nil.
+func (_c *CoreUpstreamCreate) SetNillableMaglevTableSize(v *uint64) *CoreUpstreamCreate {
+	if v != nil {
+		_c.SetMaglevTableSize(*v)
+	}
+	return _c
+}
+
 // SetConnectTimeoutMs sets the "connect_timeout_ms" field.
 func (_c *CoreUpstreamCreate) SetConnectTimeoutMs(v int) *CoreUpstreamCreate {
 	_c.mutation.SetConnectTimeoutMs(v)
@@ -206,6 +251,51 @@ func (_c *CoreUpstreamCreate) SetNillableID(v *string) *CoreUpstreamCreate {
 	return _c
 }
 
+// AddUpstreamToHostIDs adds the "upstream_to_host" edge to the CoreUpstreamHost entity by IDs.
+func (_c *CoreUpstreamCreate) AddUpstreamToHostIDs(ids ...string) *CoreUpstreamCreate {
+	_c.mutation.AddUpstreamToHostIDs(ids...)
+	return _c
+}
+
+// AddUpstreamToHost adds the "upstream_to_host" edges to the CoreUpstreamHost entity.
+func (_c *CoreUpstreamCreate) AddUpstreamToHost(v ...*CoreUpstreamHost) *CoreUpstreamCreate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddUpstreamToHostIDs(ids...)
+}
+
+// AddUpstreamToRouteIDs adds the "upstream_to_route" edge to the CoreGatewayHttpRoute entity by IDs.
+func (_c *CoreUpstreamCreate) AddUpstreamToRouteIDs(ids ...string) *CoreUpstreamCreate {
+	_c.mutation.AddUpstreamToRouteIDs(ids...)
+	return _c
+}
+
+// AddUpstreamToRoute adds the "upstream_to_route" edges to the CoreGatewayHttpRoute entity.
+func (_c *CoreUpstreamCreate) AddUpstreamToRoute(v ...*CoreGatewayHttpRoute) *CoreUpstreamCreate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddUpstreamToRouteIDs(ids...)
+}
+
+// AddUpstreamToTargetIDs adds the "upstream_to_target" edge to the CoreGatewayHttpRouteTarget entity by IDs.
+func (_c *CoreUpstreamCreate) AddUpstreamToTargetIDs(ids ...string) *CoreUpstreamCreate {
+	_c.mutation.AddUpstreamToTargetIDs(ids...)
+	return _c
+}
+
+// AddUpstreamToTarget adds the "upstream_to_target" edges to the CoreGatewayHttpRouteTarget entity.
+func (_c *CoreUpstreamCreate) AddUpstreamToTarget(v ...*CoreGatewayHttpRouteTarget) *CoreUpstreamCreate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddUpstreamToTargetIDs(ids...)
+}
+
 // Mutation returns the CoreUpstreamMutation object of the builder.
 func (_c *CoreUpstreamCreate) Mutation() *CoreUpstreamMutation {
 	return _c.mutation
@@ -261,6 +351,18 @@ func (_c *CoreUpstreamCreate) defaults() error {
 		v := coreupstream.DefaultLbPolicy
 		_c.mutation.SetLbPolicy(v)
 	}
+	if _, ok := _c.mutation.RingMinSize(); !ok {
+		v := coreupstream.DefaultRingMinSize
+		_c.mutation.SetRingMinSize(v)
+	}
+	if _, ok := _c.mutation.RingMaxSize(); !ok {
+		v := coreupstream.DefaultRingMaxSize
+		_c.mutation.SetRingMaxSize(v)
+	}
+	if _, ok := _c.mutation.MaglevTableSize(); !ok {
+		v := coreupstream.DefaultMaglevTableSize
+		_c.mutation.SetMaglevTableSize(v)
+	}
 	if _, ok := _c.mutation.ConnectTimeoutMs(); !ok {
 		v := coreupstream.DefaultConnectTimeoutMs
 		_c.mutation.SetConnectTimeoutMs(v)
@@ -368,6 +470,18 @@ func (_c *CoreUpstreamCreate) createSpec() (*CoreUpstream, *sqlgraph.CreateSpec)
 		_spec.SetField(coreupstream.FieldLbPolicy, field.TypeInt8, value)
 		_node.LbPolicy = value
 	}
+	if value, ok := _c.mutation.RingMinSize(); ok {
+		_spec.SetField(coreupstream.FieldRingMinSize, field.TypeUint64, value)
+		_node.RingMinSize = value
+	}
+	if value, ok := _c.mutation.RingMaxSize(); ok {
+		_spec.SetField(coreupstream.FieldRingMaxSize, field.TypeUint64, value)
+		_node.RingMaxSize = value
+	}
+	if value, ok := _c.mutation.MaglevTableSize(); ok {
+		_spec.SetField(coreupstream.FieldMaglevTableSize, field.TypeUint64, value)
+		_node.MaglevTableSize = value
+	}
 	if value, ok := _c.mutation.ConnectTimeoutMs(); ok {
 		_spec.SetField(coreupstream.FieldConnectTimeoutMs, field.TypeInt, value)
 		_node.ConnectTimeoutMs = value
@@ -392,6 +506,54 @@ func (_c *CoreUpstreamCreate) createSpec() (*CoreUpstream, *sqlgraph.CreateSpec)
 		_spec.SetField(coreupstream.FieldStatus, field.TypeInt8, value)
 		_node.Status = value
 	}
+	if nodes := _c.mutation.UpstreamToHostIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   coreupstream.UpstreamToHostTable,
+			Columns: []string{coreupstream.UpstreamToHostColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(coreupstreamhost.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.UpstreamToRouteIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   coreupstream.UpstreamToRouteTable,
+			Columns: []string{coreupstream.UpstreamToRouteColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(coregatewayhttproute.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.UpstreamToTargetIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   coreupstream.UpstreamToTargetTable,
+			Columns: []string{coreupstream.UpstreamToTargetColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(coregatewayhttproutetarget.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -534,6 +696,78 @@ func (u *CoreUpstreamUpsert) ClearLbPolicy() *CoreUpstreamUpsert {
 	return u
 }
 
+// SetRingMinSize sets the "ring_min_size" field.
+func (u *CoreUpstreamUpsert) SetRingMinSize(v uint64) *CoreUpstreamUpsert {
+	u.Set(coreupstream.FieldRingMinSize, v)
+	return u
+}
+
+// UpdateRingMinSize sets the "ring_min_size" field to the value that was provided on create.
+func (u *CoreUpstreamUpsert) UpdateRingMinSize() *CoreUpstreamUpsert {
+	u.SetExcluded(coreupstream.FieldRingMinSize)
+	return u
+}
+
+// AddRingMinSize adds v to the "ring_min_size" field.
+func (u *CoreUpstreamUpsert) AddRingMinSize(v uint64) *CoreUpstreamUpsert {
+	u.Add(coreupstream.FieldRingMinSize, v)
+	return u
+}
+
+// ClearRingMinSize clears the value of the "ring_min_size" field.
+func (u *CoreUpstreamUpsert) ClearRingMinSize() *CoreUpstreamUpsert {
+	u.SetNull(coreupstream.FieldRingMinSize)
+	return u
+}
+
+// SetRingMaxSize sets the "ring_max_size" field.
+func (u *CoreUpstreamUpsert) SetRingMaxSize(v uint64) *CoreUpstreamUpsert {
+	u.Set(coreupstream.FieldRingMaxSize, v)
+	return u
+}
+
+// UpdateRingMaxSize sets the "ring_max_size" field to the value that was provided on create.
+func (u *CoreUpstreamUpsert) UpdateRingMaxSize() *CoreUpstreamUpsert {
+	u.SetExcluded(coreupstream.FieldRingMaxSize)
+	return u
+}
+
+// AddRingMaxSize adds v to the "ring_max_size" field.
+func (u *CoreUpstreamUpsert) AddRingMaxSize(v uint64) *CoreUpstreamUpsert {
+	u.Add(coreupstream.FieldRingMaxSize, v)
+	return u
+}
+
+// ClearRingMaxSize clears the value of the "ring_max_size" field.
+func (u *CoreUpstreamUpsert) ClearRingMaxSize() *CoreUpstreamUpsert {
+	u.SetNull(coreupstream.FieldRingMaxSize)
+	return u
+}
+
+// SetMaglevTableSize sets the "maglev_table_size" field.
+func (u *CoreUpstreamUpsert) SetMaglevTableSize(v uint64) *CoreUpstreamUpsert {
+	u.Set(coreupstream.FieldMaglevTableSize, v)
+	return u
+}
+
+// UpdateMaglevTableSize sets the "maglev_table_size" field to the value that was provided on create.
+func (u *CoreUpstreamUpsert) UpdateMaglevTableSize() *CoreUpstreamUpsert {
+	u.SetExcluded(coreupstream.FieldMaglevTableSize)
+	return u
+}
+
+// AddMaglevTableSize adds v to the "maglev_table_size" field.
+func (u *CoreUpstreamUpsert) AddMaglevTableSize(v uint64) *CoreUpstreamUpsert {
+	u.Add(coreupstream.FieldMaglevTableSize, v)
+	return u
+}
+
+// ClearMaglevTableSize clears the value of the "maglev_table_size" field.
+func (u *CoreUpstreamUpsert) ClearMaglevTableSize() *CoreUpstreamUpsert {
+	u.SetNull(coreupstream.FieldMaglevTableSize)
+	return u
+}
+
 // SetConnectTimeoutMs sets the "connect_timeout_ms" field.
 func (u *CoreUpstreamUpsert) SetConnectTimeoutMs(v int) *CoreUpstreamUpsert {
 	u.Set(coreupstream.FieldConnectTimeoutMs, v)
@@ -834,6 +1068,90 @@ func (u *CoreUpstreamUpsertOne) ClearLbPolicy() *CoreUpstreamUpsertOne {
 	})
 }
 
+// SetRingMinSize sets the "ring_min_size" field.
+func (u *CoreUpstreamUpsertOne) SetRingMinSize(v uint64) *CoreUpstreamUpsertOne {
+	return u.Update(func(s *CoreUpstreamUpsert) {
+		s.SetRingMinSize(v)
+	})
+}
+
+// AddRingMinSize adds v to the "ring_min_size" field.
+func (u *CoreUpstreamUpsertOne) AddRingMinSize(v uint64) *CoreUpstreamUpsertOne {
+	return u.Update(func(s *CoreUpstreamUpsert) {
+		s.AddRingMinSize(v)
+	})
+}
+
+// UpdateRingMinSize sets the "ring_min_size" field to the value that was provided on create.
+func (u *CoreUpstreamUpsertOne) UpdateRingMinSize() *CoreUpstreamUpsertOne {
+	return u.Update(func(s *CoreUpstreamUpsert) {
+		s.UpdateRingMinSize()
+	})
+}
+
+// ClearRingMinSize clears the value of the "ring_min_size" field.
+func (u *CoreUpstreamUpsertOne) ClearRingMinSize() *CoreUpstreamUpsertOne {
+	return u.Update(func(s *CoreUpstreamUpsert) {
+		s.ClearRingMinSize()
+	})
+}
+
+// SetRingMaxSize sets the "ring_max_size" field.
+func (u *CoreUpstreamUpsertOne) SetRingMaxSize(v uint64) *CoreUpstreamUpsertOne {
+	return u.Update(func(s *CoreUpstreamUpsert) {
+		s.SetRingMaxSize(v)
+	})
+}
+
+// AddRingMaxSize adds v to the "ring_max_size" field.
+func (u *CoreUpstreamUpsertOne) AddRingMaxSize(v uint64) *CoreUpstreamUpsertOne {
+	return u.Update(func(s *CoreUpstreamUpsert) {
+		s.AddRingMaxSize(v)
+	})
+}
+
+// UpdateRingMaxSize sets the "ring_max_size" field to the value that was provided on create.
+func (u *CoreUpstreamUpsertOne) UpdateRingMaxSize() *CoreUpstreamUpsertOne {
+	return u.Update(func(s *CoreUpstreamUpsert) {
+		s.UpdateRingMaxSize()
+	})
+}
+
+// ClearRingMaxSize clears the value of the "ring_max_size" field.
+func (u *CoreUpstreamUpsertOne) ClearRingMaxSize() *CoreUpstreamUpsertOne {
+	return u.Update(func(s *CoreUpstreamUpsert) {
+		s.ClearRingMaxSize()
+	})
+}
+
+// SetMaglevTableSize sets the "maglev_table_size" field.
+func (u *CoreUpstreamUpsertOne) SetMaglevTableSize(v uint64) *CoreUpstreamUpsertOne {
+	return u.Update(func(s *CoreUpstreamUpsert) {
+		s.SetMaglevTableSize(v)
+	})
+}
+
+// AddMaglevTableSize adds v to the "maglev_table_size" field.
+func (u *CoreUpstreamUpsertOne) AddMaglevTableSize(v uint64) *CoreUpstreamUpsertOne {
+	return u.Update(func(s *CoreUpstreamUpsert) {
+		s.AddMaglevTableSize(v)
+	})
+}
+
+// UpdateMaglevTableSize sets the "maglev_table_size" field to the value that was provided on create.
+func (u *CoreUpstreamUpsertOne) UpdateMaglevTableSize() *CoreUpstreamUpsertOne {
+	return u.Update(func(s *CoreUpstreamUpsert) {
+		s.UpdateMaglevTableSize()
+	})
+}
+
+// ClearMaglevTableSize clears the value of the "maglev_table_size" field.
+func (u *CoreUpstreamUpsertOne) ClearMaglevTableSize() *CoreUpstreamUpsertOne {
+	return u.Update(func(s *CoreUpstreamUpsert) {
+		s.ClearMaglevTableSize()
+	})
+}
+
 // SetConnectTimeoutMs sets the "connect_timeout_ms" field.
 func (u *CoreUpstreamUpsertOne) SetConnectTimeoutMs(v int) *CoreUpstreamUpsertOne {
 	return u.Update(func(s *CoreUpstreamUpsert) {
@@ -1325,6 +1643,90 @@ func (u *CoreUpstreamUpsertBulk) ClearLbPolicy() *CoreUpstreamUpsertBulk {
 	})
 }
 
+// SetRingMinSize sets the "ring_min_size" field.
+func (u *CoreUpstreamUpsertBulk) SetRingMinSize(v uint64) *CoreUpstreamUpsertBulk {
+	return u.Update(func(s *CoreUpstreamUpsert) {
+		s.SetRingMinSize(v)
+	})
+}
+
+// AddRingMinSize adds v to the "ring_min_size" field.
+func (u *CoreUpstreamUpsertBulk) AddRingMinSize(v uint64) *CoreUpstreamUpsertBulk {
+	return u.Update(func(s *CoreUpstreamUpsert) {
+		s.AddRingMinSize(v)
+	})
+}
+
+// UpdateRingMinSize sets the "ring_min_size" field to the value that was provided on create.
+func (u *CoreUpstreamUpsertBulk) UpdateRingMinSize() *CoreUpstreamUpsertBulk {
+	return u.Update(func(s *CoreUpstreamUpsert) {
+		s.UpdateRingMinSize()
+	})
+}
+
+// ClearRingMinSize clears the value of the "ring_min_size" field.
+func (u *CoreUpstreamUpsertBulk) ClearRingMinSize() *CoreUpstreamUpsertBulk {
+	return u.Update(func(s *CoreUpstreamUpsert) {
+		s.ClearRingMinSize()
+	})
+}
+
+// SetRingMaxSize sets the "ring_max_size" field.
+func (u *CoreUpstreamUpsertBulk) SetRingMaxSize(v uint64) *CoreUpstreamUpsertBulk {
+	return u.Update(func(s *CoreUpstreamUpsert) {
+		s.SetRingMaxSize(v)
+	})
+}
+
+// AddRingMaxSize adds v to the "ring_max_size" field.
+func (u *CoreUpstreamUpsertBulk) AddRingMaxSize(v uint64) *CoreUpstreamUpsertBulk {
+	return u.Update(func(s *CoreUpstreamUpsert) {
+		s.AddRingMaxSize(v)
+	})
+}
+
+// UpdateRingMaxSize sets the "ring_max_size" field to the value that was provided on create.
+func (u *CoreUpstreamUpsertBulk) UpdateRingMaxSize() *CoreUpstreamUpsertBulk {
+	return u.Update(func(s *CoreUpstreamUpsert) {
+		s.UpdateRingMaxSize()
+	})
+}
+
+// ClearRingMaxSize clears the value of the "ring_max_size" field.
+func (u *CoreUpstreamUpsertBulk) ClearRingMaxSize() *CoreUpstreamUpsertBulk {
+	return u.Update(func(s *CoreUpstreamUpsert) {
+		s.ClearRingMaxSize()
+	})
+}
+
+// SetMaglevTableSize sets the "maglev_table_size" field.
+func (u *CoreUpstreamUpsertBulk) SetMaglevTableSize(v uint64) *CoreUpstreamUpsertBulk {
+	return u.Update(func(s *CoreUpstreamUpsert) {
+		s.SetMaglevTableSize(v)
+	})
+}
+
+// AddMaglevTableSize adds v to the "maglev_table_size" field.
+func (u *CoreUpstreamUpsertBulk) AddMaglevTableSize(v uint64) *CoreUpstreamUpsertBulk {
+	return u.Update(func(s *CoreUpstreamUpsert) {
+		s.AddMaglevTableSize(v)
+	})
+}
+
+// UpdateMaglevTableSize sets the "maglev_table_size" field to the value that was provided on create.
+func (u *CoreUpstreamUpsertBulk) UpdateMaglevTableSize() *CoreUpstreamUpsertBulk {
+	return u.Update(func(s *CoreUpstreamUpsert) {
+		s.UpdateMaglevTableSize()
+	})
+}
+
+// ClearMaglevTableSize clears the value of the "maglev_table_size" field.
+func (u *CoreUpstreamUpsertBulk) ClearMaglevTableSize() *CoreUpstreamUpsertBulk {
+	return u.Update(func(s *CoreUpstreamUpsert) {
+		s.ClearMaglevTableSize()
+	})
+}
+
 // SetConnectTimeoutMs sets the "connect_timeout_ms" field.
 func (u *CoreUpstreamUpsertBulk) SetConnectTimeoutMs(v int) *CoreUpstreamUpsertBulk {
 	return u.Update(func(s *CoreUpstreamUpsert) {
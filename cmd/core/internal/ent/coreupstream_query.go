@@ -4,6 +4,7 @@ package ent
 
 import (
 	"context"
+	"database/sql/driver"
 	"fmt"
 	"math"
 
@@ -11,18 +12,24 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/coregatewayhttproute"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/coregatewayhttproutetarget"
 	"github.com/lyonmu/quebec/cmd/core/internal/ent/coreupstream"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/coreupstreamhost"
 	"github.com/lyonmu/quebec/cmd/core/internal/ent/predicate"
 )
 
 // CoreUpstreamQuery is the builder for querying CoreUpstream entities.
 type CoreUpstreamQuery struct {
 	config
-	ctx        *QueryContext
-	order      []coreupstream.OrderOption
-	inters     []Interceptor
-	predicates []predicate.CoreUpstream
-	modifiers  []func(*sql.Selector)
+	ctx                  *QueryContext
+	order                []coreupstream.OrderOption
+	inters               []Interceptor
+	predicates           []predicate.CoreUpstream
+	withUpstreamToHost   *CoreUpstreamHostQuery
+	withUpstreamToRoute  *CoreGatewayHttpRouteQuery
+	withUpstreamToTarget *CoreGatewayHttpRouteTargetQuery
+	modifiers            []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -59,6 +66,72 @@ func (_q *CoreUpstreamQuery) Order(o ...coreupstream.OrderOption) *CoreUpstreamQ
 	return _q
 }
 
+// QueryUpstreamToHost chains the current query on the "upstream_to_host" edge.
+func (_q *CoreUpstreamQuery) QueryUpstreamToHost() *CoreUpstreamHostQuery {
+	query := (&CoreUpstreamHostClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(coreupstream.Table, coreupstream.FieldID, selector),
+			sqlgraph.To(coreupstreamhost.Table, coreupstreamhost.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, coreupstream.UpstreamToHostTable, coreupstream.UpstreamToHostColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryUpstreamToRoute chains the current query on the "upstream_to_route" edge.
+func (_q *CoreUpstreamQuery) QueryUpstreamToRoute() *CoreGatewayHttpRouteQuery {
+	query := (&CoreGatewayHttpRouteClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(coreupstream.Table, coreupstream.FieldID, selector),
+			sqlgraph.To(coregatewayhttproute.Table, coregatewayhttproute.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, coreupstream.UpstreamToRouteTable, coreupstream.UpstreamToRouteColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryUpstreamToTarget chains the current query on the "upstream_to_target" edge.
+func (_q *CoreUpstreamQuery) QueryUpstreamToTarget() *CoreGatewayHttpRouteTargetQuery {
+	query := (&CoreGatewayHttpRouteTargetClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(coreupstream.Table, coreupstream.FieldID, selector),
+			sqlgraph.To(coregatewayhttproutetarget.Table, coregatewayhttproutetarget.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, coreupstream.UpstreamToTargetTable, coreupstream.UpstreamToTargetColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first CoreUpstream entity from the query.
 // Returns a *NotFoundError when no CoreUpstream was found.
 func (_q *CoreUpstreamQuery) First(ctx context.Context) (*CoreUpstream, error) {
@@ -246,11 +319,14 @@ func (_q *CoreUpstreamQuery) Clone() *CoreUpstreamQuery {
 		return nil
 	}
 	return &CoreUpstreamQuery{
-		config:     _q.config,
-		ctx:        _q.ctx.Clone(),
-		order:      append([]coreupstream.OrderOption{}, _q.order...),
-		inters:     append([]Interceptor{}, _q.inters...),
-		predicates: append([]predicate.CoreUpstream{}, _q.predicates...),
+		config:               _q.config,
+		ctx:                  _q.ctx.Clone(),
+		order:                append([]coreupstream.OrderOption{}, _q.order...),
+		inters:               append([]Interceptor{}, _q.inters...),
+		predicates:           append([]predicate.CoreUpstream{}, _q.predicates...),
+		withUpstreamToHost:   _q.withUpstreamToHost.Clone(),
+		withUpstreamToRoute:  _q.withUpstreamToRoute.Clone(),
+		withUpstreamToTarget: _q.withUpstreamToTarget.Clone(),
 		// clone intermediate query.
 		sql:       _q.sql.Clone(),
 		path:      _q.path,
@@ -258,6 +334,39 @@ func (_q *CoreUpstreamQuery) Clone() *CoreUpstreamQuery {
 	}
 }
 
+// WithUpstreamToHost tells the query-builder to eager-load the nodes that are connected to
+// the "upstream_to_host" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *CoreUpstreamQuery) WithUpstreamToHost(opts ...func(*CoreUpstreamHostQuery)) *CoreUpstreamQuery {
+	query := (&CoreUpstreamHostClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withUpstreamToHost = query
+	return _q
+}
+
+// WithUpstreamToRoute tells the query-builder to eager-load the nodes that are connected to
+// the "upstream_to_route" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *CoreUpstreamQuery) WithUpstreamToRoute(opts ...func(*CoreGatewayHttpRouteQuery)) *CoreUpstreamQuery {
+	query := (&CoreGatewayHttpRouteClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withUpstreamToRoute = query
+	return _q
+}
+
+// WithUpstreamToTarget tells the query-builder to eager-load the nodes that are connected to
+// the "upstream_to_target" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *CoreUpstreamQuery) WithUpstreamToTarget(opts ...func(*CoreGatewayHttpRouteTargetQuery)) *CoreUpstreamQuery {
+	query := (&CoreGatewayHttpRouteTargetClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withUpstreamToTarget = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -334,8 +443,13 @@ func (_q *CoreUpstreamQuery) prepareQuery(ctx context.Context) error {
 
 func (_q *CoreUpstreamQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*CoreUpstream, error) {
 	var (
-		nodes = []*CoreUpstream{}
-		_spec = _q.querySpec()
+		nodes       = []*CoreUpstream{}
+		_spec       = _q.querySpec()
+		loadedTypes = [3]bool{
+			_q.withUpstreamToHost != nil,
+			_q.withUpstreamToRoute != nil,
+			_q.withUpstreamToTarget != nil,
+		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
 		return (*CoreUpstream).scanValues(nil, columns)
@@ -343,6 +457,7 @@ func (_q *CoreUpstreamQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]
 	_spec.Assign = func(columns []string, values []any) error {
 		node := &CoreUpstream{config: _q.config}
 		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
 	if len(_q.modifiers) > 0 {
@@ -357,9 +472,125 @@ func (_q *CoreUpstreamQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]
 	if len(nodes) == 0 {
 		return nodes, nil
 	}
+	if query := _q.withUpstreamToHost; query != nil {
+		if err := _q.loadUpstreamToHost(ctx, query, nodes,
+			func(n *CoreUpstream) { n.Edges.UpstreamToHost = []*CoreUpstreamHost{} },
+			func(n *CoreUpstream, e *CoreUpstreamHost) { n.Edges.UpstreamToHost = append(n.Edges.UpstreamToHost, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withUpstreamToRoute; query != nil {
+		if err := _q.loadUpstreamToRoute(ctx, query, nodes,
+			func(n *CoreUpstream) { n.Edges.UpstreamToRoute = []*CoreGatewayHttpRoute{} },
+			func(n *CoreUpstream, e *CoreGatewayHttpRoute) {
+				n.Edges.UpstreamToRoute = append(n.Edges.UpstreamToRoute, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withUpstreamToTarget; query != nil {
+		if err := _q.loadUpstreamToTarget(ctx, query, nodes,
+			func(n *CoreUpstream) { n.Edges.UpstreamToTarget = []*CoreGatewayHttpRouteTarget{} },
+			func(n *CoreUpstream, e *CoreGatewayHttpRouteTarget) {
+				n.Edges.UpstreamToTarget = append(n.Edges.UpstreamToTarget, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
+func (_q *CoreUpstreamQuery) loadUpstreamToHost(ctx context.Context, query *CoreUpstreamHostQuery, nodes []*CoreUpstream, init func(*CoreUpstream), assign func(*CoreUpstream, *CoreUpstreamHost)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*CoreUpstream)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(coreupstreamhost.FieldUpstreamID)
+	}
+	query.Where(predicate.CoreUpstreamHost(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(coreupstream.UpstreamToHostColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.UpstreamID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "upstream_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *CoreUpstreamQuery) loadUpstreamToRoute(ctx context.Context, query *CoreGatewayHttpRouteQuery, nodes []*CoreUpstream, init func(*CoreUpstream), assign func(*CoreUpstream, *CoreGatewayHttpRoute)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*CoreUpstream)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(coregatewayhttproute.FieldUpstreamID)
+	}
+	query.Where(predicate.CoreGatewayHttpRoute(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(coreupstream.UpstreamToRouteColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.UpstreamID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "upstream_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *CoreUpstreamQuery) loadUpstreamToTarget(ctx context.Context, query *CoreGatewayHttpRouteTargetQuery, nodes []*CoreUpstream, init func(*CoreUpstream), assign func(*CoreUpstream, *CoreGatewayHttpRouteTarget)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*CoreUpstream)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(coregatewayhttproutetarget.FieldUpstreamID)
+	}
+	query.Where(predicate.CoreGatewayHttpRouteTarget(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(coreupstream.UpstreamToTargetColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.UpstreamID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "upstream_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+
 func (_q *CoreUpstreamQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
 	if len(_q.modifiers) > 0 {
@@ -11,7 +11,10 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/coregatewayhttproute"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/coregatewayhttproutetarget"
 	"github.com/lyonmu/quebec/cmd/core/internal/ent/coreupstream"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/coreupstreamhost"
 	"github.com/lyonmu/quebec/cmd/core/internal/ent/predicate"
 	"github.com/lyonmu/quebec/pkg/constant"
 )
@@ -123,6 +126,87 @@ func (_u *CoreUpstreamUpdate) ClearLbPolicy() *CoreUpstreamUpdate {
 	return _u
 }
 
+// SetRingMinSize sets the "ring_min_size" field.
+func (_u *CoreUpstreamUpdate) SetRingMinSize(v uint64) *CoreUpstreamUpdate {
+	_u.mutation.ResetRingMinSize()
+	_u.mutation.SetRingMinSize(v)
+	return _u
+}
+
+// SetNillableRingMinSize sets the "ring_min_size" field if the given value is not nil.
+func (_u *CoreUpstreamUpdate) SetNillableRingMinSize(v *uint64) *CoreUpstreamUpdate {
+	if v != nil {
+		_u.SetRingMinSize(*v)
+	}
+	return _u
+}
+
+// AddRingMinSize adds value to the "ring_min_size" field.
+func (_u *CoreUpstreamUpdate) AddRingMinSize(v int64) *CoreUpstreamUpdate {
+	_u.mutation.AddRingMinSize(v)
+	return _u
+}
+
+// ClearRingMinSize clears the value of the "ring_min_size" field.
+func (_u *CoreUpstreamUpdate) ClearRingMinSize() *CoreUpstreamUpdate {
+	_u.mutation.ClearRingMinSize()
+	return _u
+}
+
+// SetRingMaxSize sets the "ring_max_size" field.
+func (_u *CoreUpstreamUpdate) SetRingMaxSize(v uint64) *CoreUpstreamUpdate {
+	_u.mutation.ResetRingMaxSize()
+	_u.mutation.SetRingMaxSize(v)
+	return _u
+}
+
+// SetNillableRingMaxSize sets the "ring_max_size" field if the given value is not nil.
+func (_u *CoreUpstreamUpdate) SetNillableRingMaxSize(v *uint64) *CoreUpstreamUpdate {
+	if v != nil {
+		_u.SetRingMaxSize(*v)
+	}
+	return _u
+}
+
+// AddRingMaxSize adds value to the "ring_max_size" field.
+func (_u *CoreUpstreamUpdate) AddRingMaxSize(v int64) *CoreUpstreamUpdate {
+	_u.mutation.AddRingMaxSize(v)
+	return _u
+}
+
+// ClearRingMaxSize clears the value of the "ring_max_size" field.
+func (_u *CoreUpstreamUpdate) ClearRingMaxSize() *CoreUpstreamUpdate {
+	_u.mutation.ClearRingMaxSize()
+	return _u
+}
+
+// SetMaglevTableSize sets the "maglev_table_size" field.
+func (_u *CoreUpstreamUpdate) SetMaglevTableSize(v uint64) *CoreUpstreamUpdate {
+	_u.mutation.ResetMaglevTableSize()
+	_u.mutation.SetMaglevTableSize(v)
+	return _u
+}
+
+// SetNillableMaglevTableSize sets the "maglev_table_size" field if the given value is not nil.
+func (_u *CoreUpstreamUpdate) SetNillableMaglevTableSize(v *uint64) *CoreUpstreamUpdate {
+	if v != nil {
+		_u.SetMaglevTableSize(*v)
+	}
+	return _u
+}
+
+// AddMaglevTableSize adds value to the "maglev_table_size" field.
+func (_u *CoreUpstreamUpdate) AddMaglevTableSize(v int64) *CoreUpstreamUpdate {
+	_u.mutation.AddMaglevTableSize(v)
+	return _u
+}
+
+// ClearMaglevTableSize clears the value of the "maglev_table_size" field.
+func (_u *CoreUpstreamUpdate) ClearMaglevTableSize() *CoreUpstreamUpdate {
+	_u.mutation.ClearMaglevTableSize()
+	return _u
+}
+
 // SetConnectTimeoutMs sets the "connect_timeout_ms" field.
 func (_u *CoreUpstreamUpdate) SetConnectTimeoutMs(v int) *CoreUpstreamUpdate {
 	_u.mutation.ResetConnectTimeoutMs()
@@ -285,11 +369,119 @@ func (_u *CoreUpstreamUpdate) ClearStatus() *CoreUpstreamUpdate {
 	return _u
 }
 
+// AddUpstreamToHostIDs adds the "upstream_to_host" edge to the CoreUpstreamHost entity by IDs.
+func (_u *CoreUpstreamUpdate) AddUpstreamToHostIDs(ids ...string) *CoreUpstreamUpdate {
+	_u.mutation.AddUpstreamToHostIDs(ids...)
+	return _u
+}
+
+// AddUpstreamToHost adds the "upstream_to_host" edges to the CoreUpstreamHost entity.
+func (_u *CoreUpstreamUpdate) AddUpstreamToHost(v ...*CoreUpstreamHost) *CoreUpstreamUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddUpstreamToHostIDs(ids...)
+}
+
+// AddUpstreamToRouteIDs adds the "upstream_to_route" edge to the CoreGatewayHttpRoute entity by IDs.
+func (_u *CoreUpstreamUpdate) AddUpstreamToRouteIDs(ids ...string) *CoreUpstreamUpdate {
+	_u.mutation.AddUpstreamToRouteIDs(ids...)
+	return _u
+}
+
+// AddUpstreamToRoute adds the "upstream_to_route" edges to the CoreGatewayHttpRoute entity.
+func (_u *CoreUpstreamUpdate) AddUpstreamToRoute(v ...*CoreGatewayHttpRoute) *CoreUpstreamUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddUpstreamToRouteIDs(ids...)
+}
+
+// AddUpstreamToTargetIDs adds the "upstream_to_target" edge to the CoreGatewayHttpRouteTarget entity by IDs.
+func (_u *CoreUpstreamUpdate) AddUpstreamToTargetIDs(ids ...string) *CoreUpstreamUpdate {
+	_u.mutation.AddUpstreamToTargetIDs(ids...)
+	return _u
+}
+
+// AddUpstreamToTarget adds the "upstream_to_target" edges to the CoreGatewayHttpRouteTarget entity.
+func (_u *CoreUpstreamUpdate) AddUpstreamToTarget(v ...*CoreGatewayHttpRouteTarget) *CoreUpstreamUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddUpstreamToTargetIDs(ids...)
+}
+
 // Mutation returns the CoreUpstreamMutation object of the builder.
 func (_u *CoreUpstreamUpdate) Mutation() *CoreUpstreamMutation {
 	return _u.mutation
 }
 
+// ClearUpstreamToHost clears all "upstream_to_host" edges to the CoreUpstreamHost entity.
+func (_u *CoreUpstreamUpdate) ClearUpstreamToHost() *CoreUpstreamUpdate {
+	_u.mutation.ClearUpstreamToHost()
+	return _u
+}
+
+// RemoveUpstreamToHostIDs removes the "upstream_to_host" edge to CoreUpstreamHost entities by IDs.
+func (_u *CoreUpstreamUpdate) RemoveUpstreamToHostIDs(ids ...string) *CoreUpstreamUpdate {
+	_u.mutation.RemoveUpstreamToHostIDs(ids...)
+	return _u
+}
+
+// RemoveUpstreamToHost removes "upstream_to_host" edges to CoreUpstreamHost entities.
+func (_u *CoreUpstreamUpdate) RemoveUpstreamToHost(v ...*CoreUpstreamHost) *CoreUpstreamUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveUpstreamToHostIDs(ids...)
+}
+
+// ClearUpstreamToRoute clears all "upstream_to_route" edges to the CoreGatewayHttpRoute entity.
+func (_u *CoreUpstreamUpdate) ClearUpstreamToRoute() *CoreUpstreamUpdate {
+	_u.mutation.ClearUpstreamToRoute()
+	return _u
+}
+
+// RemoveUpstreamToRouteIDs removes the "upstream_to_route" edge to CoreGatewayHttpRoute entities by IDs.
+func (_u *CoreUpstreamUpdate) RemoveUpstreamToRouteIDs(ids ...string) *CoreUpstreamUpdate {
+	_u.mutation.RemoveUpstreamToRouteIDs(ids...)
+	return _u
+}
+
+// RemoveUpstreamToRoute removes "upstream_to_route" edges to CoreGatewayHttpRoute entities.
+func (_u *CoreUpstreamUpdate) RemoveUpstreamToRoute(v ...*CoreGatewayHttpRoute) *CoreUpstreamUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveUpstreamToRouteIDs(ids...)
+}
+
+// ClearUpstreamToTarget clears all "upstream_to_target" edges to the CoreGatewayHttpRouteTarget entity.
+func (_u *CoreUpstreamUpdate) ClearUpstreamToTarget() *CoreUpstreamUpdate {
+	_u.mutation.ClearUpstreamToTarget()
+	return _u
+}
+
+// RemoveUpstreamToTargetIDs removes the "upstream_to_target" edge to CoreGatewayHttpRouteTarget entities by IDs.
+func (_u *CoreUpstreamUpdate) RemoveUpstreamToTargetIDs(ids ...string) *CoreUpstreamUpdate {
+	_u.mutation.RemoveUpstreamToTargetIDs(ids...)
+	return _u
+}
+
+// RemoveUpstreamToTarget removes "upstream_to_target" edges to CoreGatewayHttpRouteTarget entities.
+func (_u *CoreUpstreamUpdate) RemoveUpstreamToTarget(v ...*CoreGatewayHttpRouteTarget) *CoreUpstreamUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveUpstreamToTargetIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *CoreUpstreamUpdate) Save(ctx context.Context) (int, error) {
 	if err := _u.defaults(); err != nil {
@@ -377,6 +569,33 @@ func (_u *CoreUpstreamUpdate) sqlSave(ctx context.Context) (_node int, err error
 	if _u.mutation.LbPolicyCleared() {
 		_spec.ClearField(coreupstream.FieldLbPolicy, field.TypeInt8)
 	}
+	if value, ok := _u.mutation.RingMinSize(); ok {
+		_spec.SetField(coreupstream.FieldRingMinSize, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.AddedRingMinSize(); ok {
+		_spec.AddField(coreupstream.FieldRingMinSize, field.TypeUint64, value)
+	}
+	if _u.mutation.RingMinSizeCleared() {
+		_spec.ClearField(coreupstream.FieldRingMinSize, field.TypeUint64)
+	}
+	if value, ok := _u.mutation.RingMaxSize(); ok {
+		_spec.SetField(coreupstream.FieldRingMaxSize, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.AddedRingMaxSize(); ok {
+		_spec.AddField(coreupstream.FieldRingMaxSize, field.TypeUint64, value)
+	}
+	if _u.mutation.RingMaxSizeCleared() {
+		_spec.ClearField(coreupstream.FieldRingMaxSize, field.TypeUint64)
+	}
+	if value, ok := _u.mutation.MaglevTableSize(); ok {
+		_spec.SetField(coreupstream.FieldMaglevTableSize, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.AddedMaglevTableSize(); ok {
+		_spec.AddField(coreupstream.FieldMaglevTableSize, field.TypeUint64, value)
+	}
+	if _u.mutation.MaglevTableSizeCleared() {
+		_spec.ClearField(coreupstream.FieldMaglevTableSize, field.TypeUint64)
+	}
 	if value, ok := _u.mutation.ConnectTimeoutMs(); ok {
 		_spec.SetField(coreupstream.FieldConnectTimeoutMs, field.TypeInt, value)
 	}
@@ -431,6 +650,141 @@ func (_u *CoreUpstreamUpdate) sqlSave(ctx context.Context) (_node int, err error
 	if _u.mutation.StatusCleared() {
 		_spec.ClearField(coreupstream.FieldStatus, field.TypeInt8)
 	}
+	if _u.mutation.UpstreamToHostCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   coreupstream.UpstreamToHostTable,
+			Columns: []string{coreupstream.UpstreamToHostColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(coreupstreamhost.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedUpstreamToHostIDs(); len(nodes) > 0 && !_u.mutation.UpstreamToHostCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   coreupstream.UpstreamToHostTable,
+			Columns: []string{coreupstream.UpstreamToHostColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(coreupstreamhost.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UpstreamToHostIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   coreupstream.UpstreamToHostTable,
+			Columns: []string{coreupstream.UpstreamToHostColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(coreupstreamhost.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.UpstreamToRouteCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   coreupstream.UpstreamToRouteTable,
+			Columns: []string{coreupstream.UpstreamToRouteColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(coregatewayhttproute.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedUpstreamToRouteIDs(); len(nodes) > 0 && !_u.mutation.UpstreamToRouteCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   coreupstream.UpstreamToRouteTable,
+			Columns: []string{coreupstream.UpstreamToRouteColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(coregatewayhttproute.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UpstreamToRouteIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   coreupstream.UpstreamToRouteTable,
+			Columns: []string{coreupstream.UpstreamToRouteColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(coregatewayhttproute.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.UpstreamToTargetCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   coreupstream.UpstreamToTargetTable,
+			Columns: []string{coreupstream.UpstreamToTargetColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(coregatewayhttproutetarget.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedUpstreamToTargetIDs(); len(nodes) > 0 && !_u.mutation.UpstreamToTargetCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   coreupstream.UpstreamToTargetTable,
+			Columns: []string{coreupstream.UpstreamToTargetColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(coregatewayhttproutetarget.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UpstreamToTargetIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   coreupstream.UpstreamToTargetTable,
+			Columns: []string{coreupstream.UpstreamToTargetColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(coregatewayhttproutetarget.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
@@ -546,6 +900,87 @@ func (_u *CoreUpstreamUpdateOne) ClearLbPolicy() *CoreUpstreamUpdateOne {
 	return _u
 }
 
+// SetRingMinSize sets the "ring_min_size" field.
+func (_u *CoreUpstreamUpdateOne) SetRingMinSize(v uint64) *CoreUpstreamUpdateOne {
+	_u.mutation.ResetRingMinSize()
+	_u.mutation.SetRingMinSize(v)
+	return _u
+}
+
+// SetNillableRingMinSize sets the "ring_min_size" field if the given value is not nil.
+func (_u *CoreUpstreamUpdateOne) SetNillableRingMinSize(v *uint64) *CoreUpstreamUpdateOne {
+	if v != nil {
+		_u.SetRingMinSize(*v)
+	}
+	return _u
+}
+
+// AddRingMinSize adds value to the "ring_min_size" field.
+func (_u *CoreUpstreamUpdateOne) AddRingMinSize(v int64) *CoreUpstreamUpdateOne {
+	_u.mutation.AddRingMinSize(v)
+	return _u
+}
+
+// ClearRingMinSize clears the value of the "ring_min_size" field.
+func (_u *CoreUpstreamUpdateOne) ClearRingMinSize() *CoreUpstreamUpdateOne {
+	_u.mutation.ClearRingMinSize()
+	return _u
+}
+
+// SetRingMaxSize sets the "ring_max_size" field.
+func (_u *CoreUpstreamUpdateOne) SetRingMaxSize(v uint64) *CoreUpstreamUpdateOne {
+	_u.mutation.ResetRingMaxSize()
+	_u.mutation.SetRingMaxSize(v)
+	return _u
+}
+
+// SetNillableRingMaxSize sets the "ring_max_size" field if the given value is not nil.
+func (_u *CoreUpstreamUpdateOne) SetNillableRingMaxSize(v *uint64) *CoreUpstreamUpdateOne {
+	if v != nil {
+		_u.SetRingMaxSize(*v)
+	}
+	return _u
+}
+
+// AddRingMaxSize adds value to the "ring_max_size" field.
+func (_u *CoreUpstreamUpdateOne) AddRingMaxSize(v int64) *CoreUpstreamUpdateOne {
+	_u.mutation.AddRingMaxSize(v)
+	return _u
+}
+
+// ClearRingMaxSize clears the value of the "ring_max_size" field.
+func (_u *CoreUpstreamUpdateOne) ClearRingMaxSize() *CoreUpstreamUpdateOne {
+	_u.mutation.ClearRingMaxSize()
+	return _u
+}
+
+// SetMaglevTableSize sets the "maglev_table_size" field.
+func (_u *CoreUpstreamUpdateOne) SetMaglevTableSize(v uint64) *CoreUpstreamUpdateOne {
+	_u.mutation.ResetMaglevTableSize()
+	_u.mutation.SetMaglevTableSize(v)
+	return _u
+}
+
+// SetNillableMaglevTableSize sets the "maglev_table_size" field if the given value is not nil.
+func (_u *CoreUpstreamUpdateOne) SetNillableMaglevTableSize(v *uint64) *CoreUpstreamUpdateOne {
+	if v != nil {
+		_u.SetMaglevTableSize(*v)
+	}
+	return _u
+}
+
+// AddMaglevTableSize adds value to the "maglev_table_size" field.
+func (_u *CoreUpstreamUpdateOne) AddMaglevTableSize(v int64) *CoreUpstreamUpdateOne {
+	_u.mutation.AddMaglevTableSize(v)
+	return _u
+}
+
+// ClearMaglevTableSize clears the value of the "maglev_table_size" field.
+func (_u *CoreUpstreamUpdateOne) ClearMaglevTableSize() *CoreUpstreamUpdateOne {
+	_u.mutation.ClearMaglevTableSize()
+	return _u
+}
+
 // SetConnectTimeoutMs sets the "connect_timeout_ms" field.
 func (_u *CoreUpstreamUpdateOne) SetConnectTimeoutMs(v int) *CoreUpstreamUpdateOne {
 	_u.mutation.ResetConnectTimeoutMs()
@@ -708,11 +1143,119 @@ func (_u *CoreUpstreamUpdateOne) ClearStatus() *CoreUpstreamUpdateOne {
 	return _u
 }
 
+// AddUpstreamToHostIDs adds the "upstream_to_host" edge to the CoreUpstreamHost entity by IDs.
+func (_u *CoreUpstreamUpdateOne) AddUpstreamToHostIDs(ids ...string) *CoreUpstreamUpdateOne {
+	_u.mutation.AddUpstreamToHostIDs(ids...)
+	return _u
+}
+
+// AddUpstreamToHost adds the "upstream_to_host" edges to the CoreUpstreamHost entity.
+func (_u *CoreUpstreamUpdateOne) AddUpstreamToHost(v ...*CoreUpstreamHost) *CoreUpstreamUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddUpstreamToHostIDs(ids...)
+}
+
+// AddUpstreamToRouteIDs adds the "upstream_to_route" edge to the CoreGatewayHttpRoute entity by IDs.
+func (_u *CoreUpstreamUpdateOne) AddUpstreamToRouteIDs(ids ...string) *CoreUpstreamUpdateOne {
+	_u.mutation.AddUpstreamToRouteIDs(ids...)
+	return _u
+}
+
+// AddUpstreamToRoute adds the "upstream_to_route" edges to the CoreGatewayHttpRoute entity.
+func (_u *CoreUpstreamUpdateOne) AddUpstreamToRoute(v ...*CoreGatewayHttpRoute) *CoreUpstreamUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddUpstreamToRouteIDs(ids...)
+}
+
+// AddUpstreamToTargetIDs adds the "upstream_to_target" edge to the CoreGatewayHttpRouteTarget entity by IDs.
+func (_u *CoreUpstreamUpdateOne) AddUpstreamToTargetIDs(ids ...string) *CoreUpstreamUpdateOne {
+	_u.mutation.AddUpstreamToTargetIDs(ids...)
+	return _u
+}
+
+// AddUpstreamToTarget adds the "upstream_to_target" edges to the CoreGatewayHttpRouteTarget entity.
+func (_u *CoreUpstreamUpdateOne) AddUpstreamToTarget(v ...*CoreGatewayHttpRouteTarget) *CoreUpstreamUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddUpstreamToTargetIDs(ids...)
+}
+
 // Mutation returns the CoreUpstreamMutation object of the builder.
 func (_u *CoreUpstreamUpdateOne) Mutation() *CoreUpstreamMutation {
 	return _u.mutation
 }
 
+// ClearUpstreamToHost clears all "upstream_to_host" edges to the CoreUpstreamHost entity.
+func (_u *CoreUpstreamUpdateOne) ClearUpstreamToHost() *CoreUpstreamUpdateOne {
+	_u.mutation.ClearUpstreamToHost()
+	return _u
+}
+
+// RemoveUpstreamToHostIDs removes the "upstream_to_host" edge to CoreUpstreamHost entities by IDs.
+func (_u *CoreUpstreamUpdateOne) RemoveUpstreamToHostIDs(ids ...string) *CoreUpstreamUpdateOne {
+	_u.mutation.RemoveUpstreamToHostIDs(ids...)
+	return _u
+}
+
+// RemoveUpstreamToHost removes "upstream_to_host" edges to CoreUpstreamHost entities.
+func (_u *CoreUpstreamUpdateOne) RemoveUpstreamToHost(v ...*CoreUpstreamHost) *CoreUpstreamUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveUpstreamToHostIDs(ids...)
+}
+
+// ClearUpstreamToRoute clears all "upstream_to_route" edges to the CoreGatewayHttpRoute entity.
+func (_u *CoreUpstreamUpdateOne) ClearUpstreamToRoute() *CoreUpstreamUpdateOne {
+	_u.mutation.ClearUpstreamToRoute()
+	return _u
+}
+
+// RemoveUpstreamToRouteIDs removes the "upstream_to_route" edge to CoreGatewayHttpRoute entities by IDs.
+func (_u *CoreUpstreamUpdateOne) RemoveUpstreamToRouteIDs(ids ...string) *CoreUpstreamUpdateOne {
+	_u.mutation.RemoveUpstreamToRouteIDs(ids...)
+	return _u
+}
+
+// RemoveUpstreamToRoute removes "upstream_to_route" edges to CoreGatewayHttpRoute entities.
+func (_u *CoreUpstreamUpdateOne) RemoveUpstreamToRoute(v ...*CoreGatewayHttpRoute) *CoreUpstreamUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveUpstreamToRouteIDs(ids...)
+}
+
+// ClearUpstreamToTarget clears all "upstream_to_target" edges to the CoreGatewayHttpRouteTarget entity.
+func (_u *CoreUpstreamUpdateOne) ClearUpstreamToTarget() *CoreUpstreamUpdateOne {
+	_u.mutation.ClearUpstreamToTarget()
+	return _u
+}
+
+// RemoveUpstreamToTargetIDs removes the "upstream_to_target" edge to CoreGatewayHttpRouteTarget entities by IDs.
+func (_u *CoreUpstreamUpdateOne) RemoveUpstreamToTargetIDs(ids ...string) *CoreUpstreamUpdateOne {
+	_u.mutation.RemoveUpstreamToTargetIDs(ids...)
+	return _u
+}
+
+// RemoveUpstreamToTarget removes "upstream_to_target" edges to CoreGatewayHttpRouteTarget entities.
+func (_u *CoreUpstreamUpdateOne) RemoveUpstreamToTarget(v ...*CoreGatewayHttpRouteTarget) *CoreUpstreamUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveUpstreamToTargetIDs(ids...)
+}
+
 // Where appends a list predicates to the CoreUpstreamUpdate builder.
 func (_u *CoreUpstreamUpdateOne) Where(ps ...predicate.CoreUpstream) *CoreUpstreamUpdateOne {
 	_u.mutation.Where(ps...)
@@ -830,6 +1373,33 @@ func (_u *CoreUpstreamUpdateOne) sqlSave(ctx context.Context) (_node *CoreUpstre
 	if _u.mutation.LbPolicyCleared() {
 		_spec.ClearField(coreupstream.FieldLbPolicy, field.TypeInt8)
 	}
+	if value, ok := _u.mutation.RingMinSize(); ok {
+		_spec.SetField(coreupstream.FieldRingMinSize, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.AddedRingMinSize(); ok {
+		_spec.AddField(coreupstream.FieldRingMinSize, field.TypeUint64, value)
+	}
+	if _u.mutation.RingMinSizeCleared() {
+		_spec.ClearField(coreupstream.FieldRingMinSize, field.TypeUint64)
+	}
+	if value, ok := _u.mutation.RingMaxSize(); ok {
+		_spec.SetField(coreupstream.FieldRingMaxSize, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.AddedRingMaxSize(); ok {
+		_spec.AddField(coreupstream.FieldRingMaxSize, field.TypeUint64, value)
+	}
+	if _u.mutation.RingMaxSizeCleared() {
+		_spec.ClearField(coreupstream.FieldRingMaxSize, field.TypeUint64)
+	}
+	if value, ok := _u.mutation.MaglevTableSize(); ok {
+		_spec.SetField(coreupstream.FieldMaglevTableSize, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.AddedMaglevTableSize(); ok {
+		_spec.AddField(coreupstream.FieldMaglevTableSize, field.TypeUint64, value)
+	}
+	if _u.mutation.MaglevTableSizeCleared() {
+		_spec.ClearField(coreupstream.FieldMaglevTableSize, field.TypeUint64)
+	}
 	if value, ok := _u.mutation.ConnectTimeoutMs(); ok {
 		_spec.SetField(coreupstream.FieldConnectTimeoutMs, field.TypeInt, value)
 	}
@@ -884,6 +1454,141 @@ func (_u *CoreUpstreamUpdateOne) sqlSave(ctx context.Context) (_node *CoreUpstre
 	if _u.mutation.StatusCleared() {
 		_spec.ClearField(coreupstream.FieldStatus, field.TypeInt8)
 	}
+	if _u.mutation.UpstreamToHostCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   coreupstream.UpstreamToHostTable,
+			Columns: []string{coreupstream.UpstreamToHostColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(coreupstreamhost.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedUpstreamToHostIDs(); len(nodes) > 0 && !_u.mutation.UpstreamToHostCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   coreupstream.UpstreamToHostTable,
+			Columns: []string{coreupstream.UpstreamToHostColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(coreupstreamhost.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UpstreamToHostIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   coreupstream.UpstreamToHostTable,
+			Columns: []string{coreupstream.UpstreamToHostColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(coreupstreamhost.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.UpstreamToRouteCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   coreupstream.UpstreamToRouteTable,
+			Columns: []string{coreupstream.UpstreamToRouteColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(coregatewayhttproute.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedUpstreamToRouteIDs(); len(nodes) > 0 && !_u.mutation.UpstreamToRouteCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   coreupstream.UpstreamToRouteTable,
+			Columns: []string{coreupstream.UpstreamToRouteColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(coregatewayhttproute.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UpstreamToRouteIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   coreupstream.UpstreamToRouteTable,
+			Columns: []string{coreupstream.UpstreamToRouteColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(coregatewayhttproute.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.UpstreamToTargetCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   coreupstream.UpstreamToTargetTable,
+			Columns: []string{coreupstream.UpstreamToTargetColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(coregatewayhttproutetarget.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedUpstreamToTargetIDs(); len(nodes) > 0 && !_u.mutation.UpstreamToTargetCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   coreupstream.UpstreamToTargetTable,
+			Columns: []string{coreupstream.UpstreamToTargetColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(coregatewayhttproutetarget.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UpstreamToTargetIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   coreupstream.UpstreamToTargetTable,
+			Columns: []string{coreupstream.UpstreamToTargetColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(coregatewayhttproutetarget.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.AddModifiers(_u.modifiers...)
 	_node = &CoreUpstream{config: _u.config}
 	_spec.Assign = _node.assignValues
@@ -9,6 +9,7 @@ import (
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/coreupstream"
 	"github.com/lyonmu/quebec/cmd/core/internal/ent/coreupstreamhost"
 	"github.com/lyonmu/quebec/pkg/constant"
 )
@@ -25,6 +26,8 @@ type CoreUpstreamHost struct {
 	UpdatedAt time.Time `json:"updated_at,omitempty"`
 	// 删除时间
 	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// 上游服务ID
+	UpstreamID string `json:"upstream_id,omitempty"`
 	// 后端地址IP
 	Address string `json:"address,omitempty"`
 	// 权重(相对权重)
@@ -32,10 +35,33 @@ type CoreUpstreamHost struct {
 	// 后端端口
 	Port int `json:"port,omitempty"`
 	// 是否可用 [1: 是, 2: 否]
-	Enabled      constant.YesOrNo `json:"enabled,omitempty"`
+	Enabled constant.YesOrNo `json:"enabled,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the CoreUpstreamHostQuery when eager-loading is set.
+	Edges        CoreUpstreamHostEdges `json:"-" gorm:"-"`
 	selectValues sql.SelectValues
 }
 
+// CoreUpstreamHostEdges holds the relations/edges for other nodes in the graph.
+type CoreUpstreamHostEdges struct {
+	// HostFromUpstream holds the value of the host_from_upstream edge.
+	HostFromUpstream *CoreUpstream `json:"host_from_upstream,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// HostFromUpstreamOrErr returns the HostFromUpstream value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e CoreUpstreamHostEdges) HostFromUpstreamOrErr() (*CoreUpstream, error) {
+	if e.HostFromUpstream != nil {
+		return e.HostFromUpstream, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: coreupstream.Label}
+	}
+	return nil, &NotLoadedError{edge: "host_from_upstream"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*CoreUpstreamHost) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -43,7 +69,7 @@ func (*CoreUpstreamHost) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case coreupstreamhost.FieldWeight, coreupstreamhost.FieldPort, coreupstreamhost.FieldEnabled:
 			values[i] = new(sql.NullInt64)
-		case coreupstreamhost.FieldID, coreupstreamhost.FieldAddress:
+		case coreupstreamhost.FieldID, coreupstreamhost.FieldUpstreamID, coreupstreamhost.FieldAddress:
 			values[i] = new(sql.NullString)
 		case coreupstreamhost.FieldCreatedAt, coreupstreamhost.FieldUpdatedAt, coreupstreamhost.FieldDeletedAt:
 			values[i] = new(sql.NullTime)
@@ -87,6 +113,12 @@ func (_m *CoreUpstreamHost) assignValues(columns []string, values []any) error {
 				_m.DeletedAt = new(time.Time)
 				*_m.DeletedAt = value.Time
 			}
+		case coreupstreamhost.FieldUpstreamID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field upstream_id", values[i])
+			} else if value.Valid {
+				_m.UpstreamID = value.String
+			}
 		case coreupstreamhost.FieldAddress:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field address", values[i])
@@ -124,6 +156,11 @@ func (_m *CoreUpstreamHost) Value(name string) (ent.Value, error) {
 	return _m.selectValues.Get(name)
 }
 
+// QueryHostFromUpstream queries the "host_from_upstream" edge of the CoreUpstreamHost entity.
+func (_m *CoreUpstreamHost) QueryHostFromUpstream() *CoreUpstreamQuery {
+	return NewCoreUpstreamHostClient(_m.config).QueryHostFromUpstream(_m)
+}
+
 // Update returns a builder for updating this CoreUpstreamHost.
 // Note that you need to call CoreUpstreamHost.Unwrap() before calling this method if this CoreUpstreamHost
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -158,6 +195,9 @@ func (_m *CoreUpstreamHost) String() string {
 		builder.WriteString(v.Format(time.ANSIC))
 	}
 	builder.WriteString(", ")
+	builder.WriteString("upstream_id=")
+	builder.WriteString(_m.UpstreamID)
+	builder.WriteString(", ")
 	builder.WriteString("address=")
 	builder.WriteString(_m.Address)
 	builder.WriteString(", ")
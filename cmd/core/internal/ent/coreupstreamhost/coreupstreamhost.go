@@ -7,6 +7,7 @@ import (
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/lyonmu/quebec/pkg/constant"
 )
 
@@ -21,6 +22,8 @@ const (
 	FieldUpdatedAt = "updated_at"
 	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
 	FieldDeletedAt = "deleted_at"
+	// FieldUpstreamID holds the string denoting the upstream_id field in the database.
+	FieldUpstreamID = "upstream_id"
 	// FieldAddress holds the string denoting the address field in the database.
 	FieldAddress = "address"
 	// FieldWeight holds the string denoting the weight field in the database.
@@ -29,8 +32,17 @@ const (
 	FieldPort = "port"
 	// FieldEnabled holds the string denoting the enabled field in the database.
 	FieldEnabled = "enabled"
+	// EdgeHostFromUpstream holds the string denoting the host_from_upstream edge name in mutations.
+	EdgeHostFromUpstream = "host_from_upstream"
 	// Table holds the table name of the coreupstreamhost in the database.
 	Table = "quebec_core_upstream_host"
+	// HostFromUpstreamTable is the table that holds the host_from_upstream relation/edge.
+	HostFromUpstreamTable = "quebec_core_upstream_host"
+	// HostFromUpstreamInverseTable is the table name for the CoreUpstream entity.
+	// It exists in this package in order to avoid circular dependency with the "coreupstream" package.
+	HostFromUpstreamInverseTable = "quebec_core_upstream"
+	// HostFromUpstreamColumn is the table column denoting the host_from_upstream relation/edge.
+	HostFromUpstreamColumn = "upstream_id"
 )
 
 // Columns holds all SQL columns for coreupstreamhost fields.
@@ -39,6 +51,7 @@ var Columns = []string{
 	FieldCreatedAt,
 	FieldUpdatedAt,
 	FieldDeletedAt,
+	FieldUpstreamID,
 	FieldAddress,
 	FieldWeight,
 	FieldPort,
@@ -101,6 +114,11 @@ func ByDeletedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldDeletedAt, opts...).ToFunc()
 }
 
+// ByUpstreamID orders the results by the upstream_id field.
+func ByUpstreamID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpstreamID, opts...).ToFunc()
+}
+
 // ByAddress orders the results by the address field.
 func ByAddress(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldAddress, opts...).ToFunc()
@@ -120,3 +138,17 @@ func ByPort(opts ...sql.OrderTermOption) OrderOption {
 func ByEnabled(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldEnabled, opts...).ToFunc()
 }
+
+// ByHostFromUpstreamField orders the results by host_from_upstream field.
+func ByHostFromUpstreamField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newHostFromUpstreamStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newHostFromUpstreamStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(HostFromUpstreamInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, HostFromUpstreamTable, HostFromUpstreamColumn),
+	)
+}
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/lyonmu/quebec/cmd/core/internal/ent/predicate"
 	"github.com/lyonmu/quebec/pkg/constant"
 )
@@ -80,6 +81,11 @@ func DeletedAt(v time.Time) predicate.CoreUpstreamHost {
 	return predicate.CoreUpstreamHost(sql.FieldEQ(FieldDeletedAt, v))
 }
 
+// UpstreamID applies equality check predicate on the "upstream_id" field. It's identical to UpstreamIDEQ.
+func UpstreamID(v string) predicate.CoreUpstreamHost {
+	return predicate.CoreUpstreamHost(sql.FieldEQ(FieldUpstreamID, v))
+}
+
 // Address applies equality check predicate on the "address" field. It's identical to AddressEQ.
 func Address(v string) predicate.CoreUpstreamHost {
 	return predicate.CoreUpstreamHost(sql.FieldEQ(FieldAddress, v))
@@ -231,6 +237,81 @@ func DeletedAtNotNil() predicate.CoreUpstreamHost {
 	return predicate.CoreUpstreamHost(sql.FieldNotNull(FieldDeletedAt))
 }
 
+// UpstreamIDEQ applies the EQ predicate on the "upstream_id" field.
+func UpstreamIDEQ(v string) predicate.CoreUpstreamHost {
+	return predicate.CoreUpstreamHost(sql.FieldEQ(FieldUpstreamID, v))
+}
+
+// UpstreamIDNEQ applies the NEQ predicate on the "upstream_id" field.
+func UpstreamIDNEQ(v string) predicate.CoreUpstreamHost {
+	return predicate.CoreUpstreamHost(sql.FieldNEQ(FieldUpstreamID, v))
+}
+
+// UpstreamIDIn applies the In predicate on the "upstream_id" field.
+func UpstreamIDIn(vs ...string) predicate.CoreUpstreamHost {
+	return predicate.CoreUpstreamHost(sql.FieldIn(FieldUpstreamID, vs...))
+}
+
+// UpstreamIDNotIn applies the NotIn predicate on the "upstream_id" field.
+func UpstreamIDNotIn(vs ...string) predicate.CoreUpstreamHost {
+	return predicate.CoreUpstreamHost(sql.FieldNotIn(FieldUpstreamID, vs...))
+}
+
+// UpstreamIDGT applies the GT predicate on the "upstream_id" field.
+func UpstreamIDGT(v string) predicate.CoreUpstreamHost {
+	return predicate.CoreUpstreamHost(sql.FieldGT(FieldUpstreamID, v))
+}
+
+// UpstreamIDGTE applies the GTE predicate on the "upstream_id" field.
+func UpstreamIDGTE(v string) predicate.CoreUpstreamHost {
+	return predicate.CoreUpstreamHost(sql.FieldGTE(FieldUpstreamID, v))
+}
+
+// UpstreamIDLT applies the LT predicate on the "upstream_id" field.
+func UpstreamIDLT(v string) predicate.CoreUpstreamHost {
+	return predicate.CoreUpstreamHost(sql.FieldLT(FieldUpstreamID, v))
+}
+
+// UpstreamIDLTE applies the LTE predicate on the "upstream_id" field.
+func UpstreamIDLTE(v string) predicate.CoreUpstreamHost {
+	return predicate.CoreUpstreamHost(sql.FieldLTE(FieldUpstreamID, v))
+}
+
+// UpstreamIDContains applies the Contains predicate on the "upstream_id" field.
+func UpstreamIDContains(v string) predicate.CoreUpstreamHost {
+	return predicate.CoreUpstreamHost(sql.FieldContains(FieldUpstreamID, v))
+}
+
+// UpstreamIDHasPrefix applies the HasPrefix predicate on the "upstream_id" field.
+func UpstreamIDHasPrefix(v string) predicate.CoreUpstreamHost {
+	return predicate.CoreUpstreamHost(sql.FieldHasPrefix(FieldUpstreamID, v))
+}
+
+// UpstreamIDHasSuffix applies the HasSuffix predicate on the "upstream_id" field.
+func UpstreamIDHasSuffix(v string) predicate.CoreUpstreamHost {
+	return predicate.CoreUpstreamHost(sql.FieldHasSuffix(FieldUpstreamID, v))
+}
+
+// UpstreamIDIsNil applies the IsNil predicate on the "upstream_id" field.
+func UpstreamIDIsNil() predicate.CoreUpstreamHost {
+	return predicate.CoreUpstreamHost(sql.FieldIsNull(FieldUpstreamID))
+}
+
+// UpstreamIDNotNil applies the NotNil predicate on the "upstream_id" field.
+func UpstreamIDNotNil() predicate.CoreUpstreamHost {
+	return predicate.CoreUpstreamHost(sql.FieldNotNull(FieldUpstreamID))
+}
+
+// UpstreamIDEqualFold applies the EqualFold predicate on the "upstream_id" field.
+func UpstreamIDEqualFold(v string) predicate.CoreUpstreamHost {
+	return predicate.CoreUpstreamHost(sql.FieldEqualFold(FieldUpstreamID, v))
+}
+
+// UpstreamIDContainsFold applies the ContainsFold predicate on the "upstream_id" field.
+func UpstreamIDContainsFold(v string) predicate.CoreUpstreamHost {
+	return predicate.CoreUpstreamHost(sql.FieldContainsFold(FieldUpstreamID, v))
+}
+
 // AddressEQ applies the EQ predicate on the "address" field.
 func AddressEQ(v string) predicate.CoreUpstreamHost {
 	return predicate.CoreUpstreamHost(sql.FieldEQ(FieldAddress, v))
@@ -470,6 +551,29 @@ func EnabledNotNil() predicate.CoreUpstreamHost {
 	return predicate.CoreUpstreamHost(sql.FieldNotNull(FieldEnabled))
 }
 
+// HasHostFromUpstream applies the HasEdge predicate on the "host_from_upstream" edge.
+func HasHostFromUpstream() predicate.CoreUpstreamHost {
+	return predicate.CoreUpstreamHost(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, HostFromUpstreamTable, HostFromUpstreamColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasHostFromUpstreamWith applies the HasEdge predicate on the "host_from_upstream" edge with a given conditions (other predicates).
+func HasHostFromUpstreamWith(preds ...predicate.CoreUpstream) predicate.CoreUpstreamHost {
+	return predicate.CoreUpstreamHost(func(s *sql.Selector) {
+		step := newHostFromUpstreamStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.CoreUpstreamHost) predicate.CoreUpstreamHost {
 	return predicate.CoreUpstreamHost(sql.AndPredicates(predicates...))
@@ -12,6 +12,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/coreupstream"
 	"github.com/lyonmu/quebec/cmd/core/internal/ent/coreupstreamhost"
 	"github.com/lyonmu/quebec/pkg/constant"
 )
@@ -66,6 +67,20 @@ func (_c *CoreUpstreamHostCreate) SetNillableDeletedAt(v *time.Time) *CoreUpstre
 	return _c
 }
 
+// SetUpstreamID sets the "upstream_id" field.
+func (_c *CoreUpstreamHostCreate) SetUpstreamID(v string) *CoreUpstreamHostCreate {
+	_c.mutation.SetUpstreamID(v)
+	return _c
+}
+
+// SetNillableUpstreamID sets the "upstream_id" field if the given value is not nil.
+func (_c *CoreUpstreamHostCreate) SetNillableUpstreamID(v *string) *CoreUpstreamHostCreate {
+	if v != nil {
+		_c.SetUpstreamID(*v)
+	}
+	return _c
+}
+
 // SetAddress sets the "address" field.
 func (_c *CoreUpstreamHostCreate) SetAddress(v string) *CoreUpstreamHostCreate {
 	_c.mutation.SetAddress(v)
@@ -136,6 +151,25 @@ func (_c *CoreUpstreamHostCreate) SetNillableID(v *string) *CoreUpstreamHostCrea
 	return _c
 }
 
+// SetHostFromUpstreamID sets the "host_from_upstream" edge to the CoreUpstream entity by ID.
+func (_c *CoreUpstreamHostCreate) SetHostFromUpstreamID(id string) *CoreUpstreamHostCreate {
+	_c.mutation.SetHostFromUpstreamID(id)
+	return _c
+}
+
+// SetNillableHostFromUpstreamID sets the "host_from_upstream" edge to the CoreUpstream entity by ID if the given value is not nil.
+func (_c *CoreUpstreamHostCreate) SetNillableHostFromUpstreamID(id *string) *CoreUpstreamHostCreate {
+	if id != nil {
+		_c = _c.SetHostFromUpstreamID(*id)
+	}
+	return _c
+}
+
+// SetHostFromUpstream sets the "host_from_upstream" edge to the CoreUpstream entity.
+func (_c *CoreUpstreamHostCreate) SetHostFromUpstream(v *CoreUpstream) *CoreUpstreamHostCreate {
+	return _c.SetHostFromUpstreamID(v.ID)
+}
+
 // Mutation returns the CoreUpstreamHostMutation object of the builder.
 func (_c *CoreUpstreamHostCreate) Mutation() *CoreUpstreamHostMutation {
 	return _c.mutation
@@ -282,6 +316,23 @@ func (_c *CoreUpstreamHostCreate) createSpec() (*CoreUpstreamHost, *sqlgraph.Cre
 		_spec.SetField(coreupstreamhost.FieldEnabled, field.TypeInt8, value)
 		_node.Enabled = value
 	}
+	if nodes := _c.mutation.HostFromUpstreamIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   coreupstreamhost.HostFromUpstreamTable,
+			Columns: []string{coreupstreamhost.HostFromUpstreamColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(coreupstream.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UpstreamID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -364,6 +415,24 @@ func (u *CoreUpstreamHostUpsert) ClearDeletedAt() *CoreUpstreamHostUpsert {
 	return u
 }
 
+// SetUpstreamID sets the "upstream_id" field.
+func (u *CoreUpstreamHostUpsert) SetUpstreamID(v string) *CoreUpstreamHostUpsert {
+	u.Set(coreupstreamhost.FieldUpstreamID, v)
+	return u
+}
+
+// UpdateUpstreamID sets the "upstream_id" field to the value that was provided on create.
+func (u *CoreUpstreamHostUpsert) UpdateUpstreamID() *CoreUpstreamHostUpsert {
+	u.SetExcluded(coreupstreamhost.FieldUpstreamID)
+	return u
+}
+
+// ClearUpstreamID clears the value of the "upstream_id" field.
+func (u *CoreUpstreamHostUpsert) ClearUpstreamID() *CoreUpstreamHostUpsert {
+	u.SetNull(coreupstreamhost.FieldUpstreamID)
+	return u
+}
+
 // SetAddress sets the "address" field.
 func (u *CoreUpstreamHostUpsert) SetAddress(v string) *CoreUpstreamHostUpsert {
 	u.Set(coreupstreamhost.FieldAddress, v)
@@ -540,6 +609,27 @@ func (u *CoreUpstreamHostUpsertOne) ClearDeletedAt() *CoreUpstreamHostUpsertOne
 	})
 }
 
+// SetUpstreamID sets the "upstream_id" field.
+func (u *CoreUpstreamHostUpsertOne) SetUpstreamID(v string) *CoreUpstreamHostUpsertOne {
+	return u.Update(func(s *CoreUpstreamHostUpsert) {
+		s.SetUpstreamID(v)
+	})
+}
+
+// UpdateUpstreamID sets the "upstream_id" field to the value that was provided on create.
+func (u *CoreUpstreamHostUpsertOne) UpdateUpstreamID() *CoreUpstreamHostUpsertOne {
+	return u.Update(func(s *CoreUpstreamHostUpsert) {
+		s.UpdateUpstreamID()
+	})
+}
+
+// ClearUpstreamID clears the value of the "upstream_id" field.
+func (u *CoreUpstreamHostUpsertOne) ClearUpstreamID() *CoreUpstreamHostUpsertOne {
+	return u.Update(func(s *CoreUpstreamHostUpsert) {
+		s.ClearUpstreamID()
+	})
+}
+
 // SetAddress sets the "address" field.
 func (u *CoreUpstreamHostUpsertOne) SetAddress(v string) *CoreUpstreamHostUpsertOne {
 	return u.Update(func(s *CoreUpstreamHostUpsert) {
@@ -898,6 +988,27 @@ func (u *CoreUpstreamHostUpsertBulk) ClearDeletedAt() *CoreUpstreamHostUpsertBul
 	})
 }
 
+// SetUpstreamID sets the "upstream_id" field.
+func (u *CoreUpstreamHostUpsertBulk) SetUpstreamID(v string) *CoreUpstreamHostUpsertBulk {
+	return u.Update(func(s *CoreUpstreamHostUpsert) {
+		s.SetUpstreamID(v)
+	})
+}
+
+// UpdateUpstreamID sets the "upstream_id" field to the value that was provided on create.
+func (u *CoreUpstreamHostUpsertBulk) UpdateUpstreamID() *CoreUpstreamHostUpsertBulk {
+	return u.Update(func(s *CoreUpstreamHostUpsert) {
+		s.UpdateUpstreamID()
+	})
+}
+
+// ClearUpstreamID clears the value of the "upstream_id" field.
+func (u *CoreUpstreamHostUpsertBulk) ClearUpstreamID() *CoreUpstreamHostUpsertBulk {
+	return u.Update(func(s *CoreUpstreamHostUpsert) {
+		s.ClearUpstreamID()
+	})
+}
+
 // SetAddress sets the "address" field.
 func (u *CoreUpstreamHostUpsertBulk) SetAddress(v string) *CoreUpstreamHostUpsertBulk {
 	return u.Update(func(s *CoreUpstreamHostUpsert) {
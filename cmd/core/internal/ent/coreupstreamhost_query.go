@@ -11,6 +11,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/coreupstream"
 	"github.com/lyonmu/quebec/cmd/core/internal/ent/coreupstreamhost"
 	"github.com/lyonmu/quebec/cmd/core/internal/ent/predicate"
 )
@@ -18,11 +19,12 @@ import (
 // CoreUpstreamHostQuery is the builder for querying CoreUpstreamHost entities.
 type CoreUpstreamHostQuery struct {
 	config
-	ctx        *QueryContext
-	order      []coreupstreamhost.OrderOption
-	inters     []Interceptor
-	predicates []predicate.CoreUpstreamHost
-	modifiers  []func(*sql.Selector)
+	ctx                  *QueryContext
+	order                []coreupstreamhost.OrderOption
+	inters               []Interceptor
+	predicates           []predicate.CoreUpstreamHost
+	withHostFromUpstream *CoreUpstreamQuery
+	modifiers            []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -59,6 +61,28 @@ func (_q *CoreUpstreamHostQuery) Order(o ...coreupstreamhost.OrderOption) *CoreU
 	return _q
 }
 
+// QueryHostFromUpstream chains the current query on the "host_from_upstream" edge.
+func (_q *CoreUpstreamHostQuery) QueryHostFromUpstream() *CoreUpstreamQuery {
+	query := (&CoreUpstreamClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(coreupstreamhost.Table, coreupstreamhost.FieldID, selector),
+			sqlgraph.To(coreupstream.Table, coreupstream.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, coreupstreamhost.HostFromUpstreamTable, coreupstreamhost.HostFromUpstreamColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first CoreUpstreamHost entity from the query.
 // Returns a *NotFoundError when no CoreUpstreamHost was found.
 func (_q *CoreUpstreamHostQuery) First(ctx context.Context) (*CoreUpstreamHost, error) {
@@ -246,11 +270,12 @@ func (_q *CoreUpstreamHostQuery) Clone() *CoreUpstreamHostQuery {
 		return nil
 	}
 	return &CoreUpstreamHostQuery{
-		config:     _q.config,
-		ctx:        _q.ctx.Clone(),
-		order:      append([]coreupstreamhost.OrderOption{}, _q.order...),
-		inters:     append([]Interceptor{}, _q.inters...),
-		predicates: append([]predicate.CoreUpstreamHost{}, _q.predicates...),
+		config:               _q.config,
+		ctx:                  _q.ctx.Clone(),
+		order:                append([]coreupstreamhost.OrderOption{}, _q.order...),
+		inters:               append([]Interceptor{}, _q.inters...),
+		predicates:           append([]predicate.CoreUpstreamHost{}, _q.predicates...),
+		withHostFromUpstream: _q.withHostFromUpstream.Clone(),
 		// clone intermediate query.
 		sql:       _q.sql.Clone(),
 		path:      _q.path,
@@ -258,6 +283,17 @@ func (_q *CoreUpstreamHostQuery) Clone() *CoreUpstreamHostQuery {
 	}
 }
 
+// WithHostFromUpstream tells the query-builder to eager-load the nodes that are connected to
+// the "host_from_upstream" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *CoreUpstreamHostQuery) WithHostFromUpstream(opts ...func(*CoreUpstreamQuery)) *CoreUpstreamHostQuery {
+	query := (&CoreUpstreamClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withHostFromUpstream = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -334,8 +370,11 @@ func (_q *CoreUpstreamHostQuery) prepareQuery(ctx context.Context) error {
 
 func (_q *CoreUpstreamHostQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*CoreUpstreamHost, error) {
 	var (
-		nodes = []*CoreUpstreamHost{}
-		_spec = _q.querySpec()
+		nodes       = []*CoreUpstreamHost{}
+		_spec       = _q.querySpec()
+		loadedTypes = [1]bool{
+			_q.withHostFromUpstream != nil,
+		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
 		return (*CoreUpstreamHost).scanValues(nil, columns)
@@ -343,6 +382,7 @@ func (_q *CoreUpstreamHostQuery) sqlAll(ctx context.Context, hooks ...queryHook)
 	_spec.Assign = func(columns []string, values []any) error {
 		node := &CoreUpstreamHost{config: _q.config}
 		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
 	if len(_q.modifiers) > 0 {
@@ -357,9 +397,45 @@ func (_q *CoreUpstreamHostQuery) sqlAll(ctx context.Context, hooks ...queryHook)
 	if len(nodes) == 0 {
 		return nodes, nil
 	}
+	if query := _q.withHostFromUpstream; query != nil {
+		if err := _q.loadHostFromUpstream(ctx, query, nodes, nil,
+			func(n *CoreUpstreamHost, e *CoreUpstream) { n.Edges.HostFromUpstream = e }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
+func (_q *CoreUpstreamHostQuery) loadHostFromUpstream(ctx context.Context, query *CoreUpstreamQuery, nodes []*CoreUpstreamHost, init func(*CoreUpstreamHost), assign func(*CoreUpstreamHost, *CoreUpstream)) error {
+	ids := make([]string, 0, len(nodes))
+	nodeids := make(map[string][]*CoreUpstreamHost)
+	for i := range nodes {
+		fk := nodes[i].UpstreamID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(coreupstream.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "upstream_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
 func (_q *CoreUpstreamHostQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
 	if len(_q.modifiers) > 0 {
@@ -388,6 +464,9 @@ func (_q *CoreUpstreamHostQuery) querySpec() *sqlgraph.QuerySpec {
 				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
 			}
 		}
+		if _q.withHostFromUpstream != nil {
+			_spec.Node.AddColumnOnce(coreupstreamhost.FieldUpstreamID)
+		}
 	}
 	if ps := _q.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
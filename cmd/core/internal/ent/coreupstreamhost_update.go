@@ -11,6 +11,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/coreupstream"
 	"github.com/lyonmu/quebec/cmd/core/internal/ent/coreupstreamhost"
 	"github.com/lyonmu/quebec/cmd/core/internal/ent/predicate"
 	"github.com/lyonmu/quebec/pkg/constant"
@@ -56,6 +57,26 @@ func (_u *CoreUpstreamHostUpdate) ClearDeletedAt() *CoreUpstreamHostUpdate {
 	return _u
 }
 
+// SetUpstreamID sets the "upstream_id" field.
+func (_u *CoreUpstreamHostUpdate) SetUpstreamID(v string) *CoreUpstreamHostUpdate {
+	_u.mutation.SetUpstreamID(v)
+	return _u
+}
+
+// SetNillableUpstreamID sets the "upstream_id" field if the given value is not nil.
+func (_u *CoreUpstreamHostUpdate) SetNillableUpstreamID(v *string) *CoreUpstreamHostUpdate {
+	if v != nil {
+		_u.SetUpstreamID(*v)
+	}
+	return _u
+}
+
+// ClearUpstreamID clears the value of the "upstream_id" field.
+func (_u *CoreUpstreamHostUpdate) ClearUpstreamID() *CoreUpstreamHostUpdate {
+	_u.mutation.ClearUpstreamID()
+	return _u
+}
+
 // SetAddress sets the "address" field.
 func (_u *CoreUpstreamHostUpdate) SetAddress(v string) *CoreUpstreamHostUpdate {
 	_u.mutation.SetAddress(v)
@@ -157,11 +178,36 @@ func (_u *CoreUpstreamHostUpdate) ClearEnabled() *CoreUpstreamHostUpdate {
 	return _u
 }
 
+// SetHostFromUpstreamID sets the "host_from_upstream" edge to the CoreUpstream entity by ID.
+func (_u *CoreUpstreamHostUpdate) SetHostFromUpstreamID(id string) *CoreUpstreamHostUpdate {
+	_u.mutation.SetHostFromUpstreamID(id)
+	return _u
+}
+
+// SetNillableHostFromUpstreamID sets the "host_from_upstream" edge to the CoreUpstream entity by ID if the given value is not nil.
+func (_u *CoreUpstreamHostUpdate) SetNillableHostFromUpstreamID(id *string) *CoreUpstreamHostUpdate {
+	if id != nil {
+		_u = _u.SetHostFromUpstreamID(*id)
+	}
+	return _u
+}
+
+// SetHostFromUpstream sets the "host_from_upstream" edge to the CoreUpstream entity.
+func (_u *CoreUpstreamHostUpdate) SetHostFromUpstream(v *CoreUpstream) *CoreUpstreamHostUpdate {
+	return _u.SetHostFromUpstreamID(v.ID)
+}
+
 // Mutation returns the CoreUpstreamHostMutation object of the builder.
 func (_u *CoreUpstreamHostUpdate) Mutation() *CoreUpstreamHostMutation {
 	return _u.mutation
 }
 
+// ClearHostFromUpstream clears the "host_from_upstream" edge to the CoreUpstream entity.
+func (_u *CoreUpstreamHostUpdate) ClearHostFromUpstream() *CoreUpstreamHostUpdate {
+	_u.mutation.ClearHostFromUpstream()
+	return _u
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *CoreUpstreamHostUpdate) Save(ctx context.Context) (int, error) {
 	if err := _u.defaults(); err != nil {
@@ -261,6 +307,35 @@ func (_u *CoreUpstreamHostUpdate) sqlSave(ctx context.Context) (_node int, err e
 	if _u.mutation.EnabledCleared() {
 		_spec.ClearField(coreupstreamhost.FieldEnabled, field.TypeInt8)
 	}
+	if _u.mutation.HostFromUpstreamCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   coreupstreamhost.HostFromUpstreamTable,
+			Columns: []string{coreupstreamhost.HostFromUpstreamColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(coreupstream.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.HostFromUpstreamIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   coreupstreamhost.HostFromUpstreamTable,
+			Columns: []string{coreupstreamhost.HostFromUpstreamColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(coreupstream.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
@@ -309,6 +384,26 @@ func (_u *CoreUpstreamHostUpdateOne) ClearDeletedAt() *CoreUpstreamHostUpdateOne
 	return _u
 }
 
+// SetUpstreamID sets the "upstream_id" field.
+func (_u *CoreUpstreamHostUpdateOne) SetUpstreamID(v string) *CoreUpstreamHostUpdateOne {
+	_u.mutation.SetUpstreamID(v)
+	return _u
+}
+
+// SetNillableUpstreamID sets the "upstream_id" field if the given value is not nil.
+func (_u *CoreUpstreamHostUpdateOne) SetNillableUpstreamID(v *string) *CoreUpstreamHostUpdateOne {
+	if v != nil {
+		_u.SetUpstreamID(*v)
+	}
+	return _u
+}
+
+// ClearUpstreamID clears the value of the "upstream_id" field.
+func (_u *CoreUpstreamHostUpdateOne) ClearUpstreamID() *CoreUpstreamHostUpdateOne {
+	_u.mutation.ClearUpstreamID()
+	return _u
+}
+
 // SetAddress sets the "address" field.
 func (_u *CoreUpstreamHostUpdateOne) SetAddress(v string) *CoreUpstreamHostUpdateOne {
 	_u.mutation.SetAddress(v)
@@ -410,11 +505,36 @@ func (_u *CoreUpstreamHostUpdateOne) ClearEnabled() *CoreUpstreamHostUpdateOne {
 	return _u
 }
 
+// SetHostFromUpstreamID sets the "host_from_upstream" edge to the CoreUpstream entity by ID.
+func (_u *CoreUpstreamHostUpdateOne) SetHostFromUpstreamID(id string) *CoreUpstreamHostUpdateOne {
+	_u.mutation.SetHostFromUpstreamID(id)
+	return _u
+}
+
+// SetNillableHostFromUpstreamID sets the "host_from_upstream" edge to the CoreUpstream entity by ID if the given value is not nil.
+func (_u *CoreUpstreamHostUpdateOne) SetNillableHostFromUpstreamID(id *string) *CoreUpstreamHostUpdateOne {
+	if id != nil {
+		_u = _u.SetHostFromUpstreamID(*id)
+	}
+	return _u
+}
+
+// SetHostFromUpstream sets the "host_from_upstream" edge to the CoreUpstream entity.
+func (_u *CoreUpstreamHostUpdateOne) SetHostFromUpstream(v *CoreUpstream) *CoreUpstreamHostUpdateOne {
+	return _u.SetHostFromUpstreamID(v.ID)
+}
+
 // Mutation returns the CoreUpstreamHostMutation object of the builder.
 func (_u *CoreUpstreamHostUpdateOne) Mutation() *CoreUpstreamHostMutation {
 	return _u.mutation
 }
 
+// ClearHostFromUpstream clears the "host_from_upstream" edge to the CoreUpstream entity.
+func (_u *CoreUpstreamHostUpdateOne) ClearHostFromUpstream() *CoreUpstreamHostUpdateOne {
+	_u.mutation.ClearHostFromUpstream()
+	return _u
+}
+
 // Where appends a list predicates to the CoreUpstreamHostUpdate builder.
 func (_u *CoreUpstreamHostUpdateOne) Where(ps ...predicate.CoreUpstreamHost) *CoreUpstreamHostUpdateOne {
 	_u.mutation.Where(ps...)
@@ -544,6 +664,35 @@ func (_u *CoreUpstreamHostUpdateOne) sqlSave(ctx context.Context) (_node *CoreUp
 	if _u.mutation.EnabledCleared() {
 		_spec.ClearField(coreupstreamhost.FieldEnabled, field.TypeInt8)
 	}
+	if _u.mutation.HostFromUpstreamCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   coreupstreamhost.HostFromUpstreamTable,
+			Columns: []string{coreupstreamhost.HostFromUpstreamColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(coreupstream.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.HostFromUpstreamIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   coreupstreamhost.HostFromUpstreamTable,
+			Columns: []string{coreupstreamhost.HostFromUpstreamColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(coreupstream.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.AddModifiers(_u.modifiers...)
 	_node = &CoreUpstreamHost{config: _u.config}
 	_spec.Assign = _node.assignValues
@@ -14,14 +14,21 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/lyonmu/quebec/cmd/core/internal/ent/corecert"
 	"github.com/lyonmu/quebec/cmd/core/internal/ent/coredatarelationship"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/coregateway"
 	"github.com/lyonmu/quebec/cmd/core/internal/ent/coregatewaycluster"
 	"github.com/lyonmu/quebec/cmd/core/internal/ent/coregatewayhttproute"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/coregatewayhttproutetarget"
 	"github.com/lyonmu/quebec/cmd/core/internal/ent/coregatewayl4listener"
 	"github.com/lyonmu/quebec/cmd/core/internal/ent/coregatewayl7listener"
 	"github.com/lyonmu/quebec/cmd/core/internal/ent/coregatewaynode"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/coregatewayratelimitrule"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/coregatewayvirtualhost"
 	"github.com/lyonmu/quebec/cmd/core/internal/ent/coremenu"
 	"github.com/lyonmu/quebec/cmd/core/internal/ent/coreonlineuser"
 	"github.com/lyonmu/quebec/cmd/core/internal/ent/coreoperationlog"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/coreproxyrevision"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/coreproxyrollout"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/coreproxyrolloutpolicy"
 	"github.com/lyonmu/quebec/cmd/core/internal/ent/corerole"
 	"github.com/lyonmu/quebec/cmd/core/internal/ent/coreupstream"
 	"github.com/lyonmu/quebec/cmd/core/internal/ent/coreupstreamhost"
@@ -86,20 +93,27 @@ var (
 func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			corecert.Table:              corecert.ValidColumn,
-			coredatarelationship.Table:  coredatarelationship.ValidColumn,
-			coregatewaycluster.Table:    coregatewaycluster.ValidColumn,
-			coregatewayhttproute.Table:  coregatewayhttproute.ValidColumn,
-			coregatewayl4listener.Table: coregatewayl4listener.ValidColumn,
-			coregatewayl7listener.Table: coregatewayl7listener.ValidColumn,
-			coregatewaynode.Table:       coregatewaynode.ValidColumn,
-			coremenu.Table:              coremenu.ValidColumn,
-			coreonlineuser.Table:        coreonlineuser.ValidColumn,
-			coreoperationlog.Table:      coreoperationlog.ValidColumn,
-			corerole.Table:              corerole.ValidColumn,
-			coreupstream.Table:          coreupstream.ValidColumn,
-			coreupstreamhost.Table:      coreupstreamhost.ValidColumn,
-			coreuser.Table:              coreuser.ValidColumn,
+			corecert.Table:                   corecert.ValidColumn,
+			coredatarelationship.Table:       coredatarelationship.ValidColumn,
+			coregateway.Table:                coregateway.ValidColumn,
+			coregatewaycluster.Table:         coregatewaycluster.ValidColumn,
+			coregatewayhttproute.Table:       coregatewayhttproute.ValidColumn,
+			coregatewayhttproutetarget.Table: coregatewayhttproutetarget.ValidColumn,
+			coregatewayl4listener.Table:      coregatewayl4listener.ValidColumn,
+			coregatewayl7listener.Table:      coregatewayl7listener.ValidColumn,
+			coregatewaynode.Table:            coregatewaynode.ValidColumn,
+			coregatewayratelimitrule.Table:   coregatewayratelimitrule.ValidColumn,
+			coregatewayvirtualhost.Table:     coregatewayvirtualhost.ValidColumn,
+			coremenu.Table:                   coremenu.ValidColumn,
+			coreonlineuser.Table:             coreonlineuser.ValidColumn,
+			coreoperationlog.Table:           coreoperationlog.ValidColumn,
+			coreproxyrevision.Table:          coreproxyrevision.ValidColumn,
+			coreproxyrollout.Table:           coreproxyrollout.ValidColumn,
+			coreproxyrolloutpolicy.Table:     coreproxyrolloutpolicy.ValidColumn,
+			corerole.Table:                   corerole.ValidColumn,
+			coreupstream.Table:               coreupstream.ValidColumn,
+			coreupstreamhost.Table:           coreupstreamhost.ValidColumn,
+			coreuser.Table:                   coreuser.ValidColumn,
 		})
 	})
 	return columnCheck(t, c)
@@ -33,6 +33,18 @@ func (f CoreDataRelationshipFunc) Mutate(ctx context.Context, m ent.Mutation) (e
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.CoreDataRelationshipMutation", m)
 }
 
+// The CoreGatewayFunc type is an adapter to allow the use of ordinary
+// function as CoreGateway mutator.
+type CoreGatewayFunc func(context.Context, *ent.CoreGatewayMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f CoreGatewayFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.CoreGatewayMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.CoreGatewayMutation", m)
+}
+
 // The CoreGatewayClusterFunc type is an adapter to allow the use of ordinary
 // function as CoreGatewayCluster mutator.
 type CoreGatewayClusterFunc func(context.Context, *ent.CoreGatewayClusterMutation) (ent.Value, error)
@@ -57,6 +69,18 @@ func (f CoreGatewayHttpRouteFunc) Mutate(ctx context.Context, m ent.Mutation) (e
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.CoreGatewayHttpRouteMutation", m)
 }
 
+// The CoreGatewayHttpRouteTargetFunc type is an adapter to allow the use of ordinary
+// function as CoreGatewayHttpRouteTarget mutator.
+type CoreGatewayHttpRouteTargetFunc func(context.Context, *ent.CoreGatewayHttpRouteTargetMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f CoreGatewayHttpRouteTargetFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.CoreGatewayHttpRouteTargetMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.CoreGatewayHttpRouteTargetMutation", m)
+}
+
 // The CoreGatewayL4ListenerFunc type is an adapter to allow the use of ordinary
 // function as CoreGatewayL4Listener mutator.
 type CoreGatewayL4ListenerFunc func(context.Context, *ent.CoreGatewayL4ListenerMutation) (ent.Value, error)
@@ -93,6 +117,30 @@ func (f CoreGatewayNodeFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Va
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.CoreGatewayNodeMutation", m)
 }
 
+// The CoreGatewayRateLimitRuleFunc type is an adapter to allow the use of ordinary
+// function as CoreGatewayRateLimitRule mutator.
+type CoreGatewayRateLimitRuleFunc func(context.Context, *ent.CoreGatewayRateLimitRuleMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f CoreGatewayRateLimitRuleFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.CoreGatewayRateLimitRuleMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.CoreGatewayRateLimitRuleMutation", m)
+}
+
+// The CoreGatewayVirtualHostFunc type is an adapter to allow the use of ordinary
+// function as CoreGatewayVirtualHost mutator.
+type CoreGatewayVirtualHostFunc func(context.Context, *ent.CoreGatewayVirtualHostMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f CoreGatewayVirtualHostFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.CoreGatewayVirtualHostMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.CoreGatewayVirtualHostMutation", m)
+}
+
 // The CoreMenuFunc type is an adapter to allow the use of ordinary
 // function as CoreMenu mutator.
 type CoreMenuFunc func(context.Context, *ent.CoreMenuMutation) (ent.Value, error)
@@ -129,6 +177,42 @@ func (f CoreOperationLogFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.V
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.CoreOperationLogMutation", m)
 }
 
+// The CoreProxyRevisionFunc type is an adapter to allow the use of ordinary
+// function as CoreProxyRevision mutator.
+type CoreProxyRevisionFunc func(context.Context, *ent.CoreProxyRevisionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f CoreProxyRevisionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.CoreProxyRevisionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.CoreProxyRevisionMutation", m)
+}
+
+// The CoreProxyRolloutFunc type is an adapter to allow the use of ordinary
+// function as CoreProxyRollout mutator.
+type CoreProxyRolloutFunc func(context.Context, *ent.CoreProxyRolloutMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f CoreProxyRolloutFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.CoreProxyRolloutMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.CoreProxyRolloutMutation", m)
+}
+
+// The CoreProxyRolloutPolicyFunc type is an adapter to allow the use of ordinary
+// function as CoreProxyRolloutPolicy mutator.
+type CoreProxyRolloutPolicyFunc func(context.Context, *ent.CoreProxyRolloutPolicyMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f CoreProxyRolloutPolicyFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.CoreProxyRolloutPolicyMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.CoreProxyRolloutPolicyMutation", m)
+}
+
 // The CoreRoleFunc type is an adapter to allow the use of ordinary
 // function as CoreRole mutator.
 type CoreRoleFunc func(context.Context, *ent.CoreRoleMutation) (ent.Value, error)
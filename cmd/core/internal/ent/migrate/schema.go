@@ -97,6 +97,59 @@ var (
 			},
 		},
 	}
+	// QuebecCoreGatewayColumns holds the columns for the "quebec_core_gateway" table.
+	QuebecCoreGatewayColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true, Size: 64, Comment: "主键ID"},
+		{Name: "created_at", Type: field.TypeTime, Comment: "创建时间"},
+		{Name: "updated_at", Type: field.TypeTime, Comment: "更新时间"},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true, Comment: "删除时间"},
+		{Name: "gateway_id", Type: field.TypeInt64, Unique: true, Nullable: true, Comment: "网关ID"},
+		{Name: "applied_revision", Type: field.TypeInt64, Nullable: true, Comment: "网关已应用的配置修订号"},
+		{Name: "rejected_revision", Type: field.TypeInt64, Nullable: true, Comment: "最近被网关拒绝的配置修订号"},
+		{Name: "last_error", Type: field.TypeString, Nullable: true, Comment: "最近一次配置被拒绝的原因，为空表示最新配置已应用"},
+		{Name: "last_sync_time", Type: field.TypeInt64, Nullable: true, Comment: "最近一次配置回执时间"},
+		{Name: "status", Type: field.TypeInt8, Nullable: true, Comment: "是否在线 [1: 在线, 2: 离线]", Default: 1},
+		{Name: "global_rate_limit", Type: field.TypeInt8, Nullable: true, Comment: "是否提供全局限流服务 [1: 是, 2: 否]，未提供时路由的全局限流不生效", Default: 2},
+	}
+	// QuebecCoreGatewayTable holds the schema information for the "quebec_core_gateway" table.
+	QuebecCoreGatewayTable = &schema.Table{
+		Name:       "quebec_core_gateway",
+		Comment:    "网关实例配置同步状态表",
+		Columns:    QuebecCoreGatewayColumns,
+		PrimaryKey: []*schema.Column{QuebecCoreGatewayColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "coregateway_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{QuebecCoreGatewayColumns[1]},
+			},
+			{
+				Name:    "coregateway_updated_at",
+				Unique:  false,
+				Columns: []*schema.Column{QuebecCoreGatewayColumns[2]},
+			},
+			{
+				Name:    "coregateway_deleted_at",
+				Unique:  false,
+				Columns: []*schema.Column{QuebecCoreGatewayColumns[3]},
+			},
+			{
+				Name:    "coregateway_id",
+				Unique:  false,
+				Columns: []*schema.Column{QuebecCoreGatewayColumns[0]},
+			},
+			{
+				Name:    "coregateway_gateway_id",
+				Unique:  false,
+				Columns: []*schema.Column{QuebecCoreGatewayColumns[4]},
+			},
+			{
+				Name:    "coregateway_status",
+				Unique:  false,
+				Columns: []*schema.Column{QuebecCoreGatewayColumns[9]},
+			},
+		},
+	}
 	// QuebecCoreGatewayClusterColumns holds the columns for the "quebec_core_gateway_cluster" table.
 	QuebecCoreGatewayClusterColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString, Unique: true, Size: 64, Comment: "主键ID"},
@@ -107,6 +160,7 @@ var (
 		{Name: "gateway_id", Type: field.TypeInt64, Nullable: true, Comment: "网关ID"},
 		{Name: "cluster_create_time", Type: field.TypeInt64, Nullable: true, Comment: "创建时间"},
 		{Name: "cluster_last_request_time", Type: field.TypeInt64, Nullable: true, Comment: "最新请求时间"},
+		{Name: "maintenance", Type: field.TypeJSON, Nullable: true, Comment: "维护模式，作用于集群内未单独开启维护模式的虚拟主机"},
 	}
 	// QuebecCoreGatewayClusterTable holds the schema information for the "quebec_core_gateway_cluster" table.
 	QuebecCoreGatewayClusterTable = &schema.Table{
@@ -165,15 +219,34 @@ var (
 		{Name: "deleted_at", Type: field.TypeTime, Nullable: true, Comment: "删除时间"},
 		{Name: "name", Type: field.TypeString, Nullable: true, Comment: "路由名称"},
 		{Name: "description", Type: field.TypeString, Nullable: true, Comment: "路由描述"},
+		{Name: "cluster_id", Type: field.TypeString, Nullable: true, Comment: "所属网关集群ID(Envoy node.cluster)，为空表示所有集群"},
 		{Name: "match_type", Type: field.TypeInt8, Nullable: true, Comment: "匹配类型: 1-前缀 2-精确 3-正则", Default: 1},
 		{Name: "match_pattern", Type: field.TypeString, Nullable: true, Comment: "匹配规则，如 /api/v1/*"},
+		{Name: "priority", Type: field.TypeInt, Nullable: true, Comment: "匹配优先级，数值越大越先匹配；相同优先级时按匹配的精确程度排序", Default: 0},
+		{Name: "ignore_case", Type: field.TypeInt8, Nullable: true, Comment: "路径匹配是否忽略大小写 [1-是 2-否]", Default: 2},
+		{Name: "methods", Type: field.TypeJSON, Nullable: true, Comment: "匹配的 HTTP 方法，为空表示不限制"},
+		{Name: "header_matchers", Type: field.TypeJSON, Nullable: true, Comment: "请求头匹配条件，全部满足时路由才匹配"},
+		{Name: "query_matchers", Type: field.TypeJSON, Nullable: true, Comment: "查询参数匹配条件，全部满足时路由才匹配"},
 		{Name: "timeout_ms", Type: field.TypeInt, Nullable: true, Comment: "路由超时(毫秒，默认15000=15秒)，包括所有重试", Default: 15000},
+		{Name: "retry_policy", Type: field.TypeJSON, Nullable: true, Comment: "重试策略，为空表示不重试"},
+		{Name: "header_mutation", Type: field.TypeJSON, Nullable: true, Comment: "请求头与响应头改写"},
+		{Name: "mirror_upstream_id", Type: field.TypeString, Nullable: true, Comment: "流量镜像的影子上游服务ID，为空表示不镜像"},
+		{Name: "mirror_percent", Type: field.TypeFloat64, Nullable: true, Comment: "镜像的请求比例(%)，支持两位小数"},
+		{Name: "fault_policy", Type: field.TypeJSON, Nullable: true, Comment: "故障注入，到期后自动失效"},
+		{Name: "cors_policy", Type: field.TypeJSON, Nullable: true, Comment: "跨域策略，为空时沿用虚拟主机的跨域策略"},
+		{Name: "local_rate_limit", Type: field.TypeJSON, Nullable: true, Comment: "本地限流，为空时沿用监听器的本地限流"},
+		{Name: "rate_limit_actions", Type: field.TypeJSON, Nullable: true, Comment: "全局限流描述符条目，为空表示不进行全局限流"},
+		{Name: "direct_response", Type: field.TypeJSON, Nullable: true, Comment: "直接响应，设置后不转发到上游服务"},
+		{Name: "hash_policies", Type: field.TypeJSON, Nullable: true, Comment: "一致性哈希的哈希策略，上游服务使用 RING_HASH 或 MAGLEV 时生效"},
+		{Name: "disable_compression", Type: field.TypeInt8, Nullable: true, Comment: "是否关闭响应压缩 [1-是 2-否]", Default: 2},
 		{Name: "enable_path_rewrite", Type: field.TypeInt8, Nullable: true, Comment: "是否启用路径重写 [1-启用 2-禁用]", Default: 2},
 		{Name: "path_rewrite", Type: field.TypeString, Nullable: true, Comment: "路径重写规则，如 /api/v1/* /v1/*"},
 		{Name: "enable_redirect", Type: field.TypeInt8, Nullable: true, Comment: "是否启用重定向 [1-启用 2-禁用]", Default: 2},
 		{Name: "redirect_url", Type: field.TypeString, Nullable: true, Comment: "重定向URL"},
 		{Name: "redirect_code", Type: field.TypeInt, Nullable: true, Comment: "重定向状态码", Default: 301},
 		{Name: "status", Type: field.TypeInt8, Nullable: true, Comment: "状态  [1-启用 2-禁用]", Default: 1},
+		{Name: "virtual_host_id", Type: field.TypeString, Nullable: true, Size: 64, Comment: "所属虚拟主机ID，为空表示所有监听器的默认虚拟主机(*)"},
+		{Name: "upstream_id", Type: field.TypeString, Nullable: true, Size: 64, Comment: "目标上游服务ID，配置加权分流时为权重最大的目标"},
 	}
 	// QuebecCoreGatewayHTTPRouteTable holds the schema information for the "quebec_core_gateway_http_route" table.
 	QuebecCoreGatewayHTTPRouteTable = &schema.Table{
@@ -181,6 +254,20 @@ var (
 		Comment:    "L7 HTTP路由规则表",
 		Columns:    QuebecCoreGatewayHTTPRouteColumns,
 		PrimaryKey: []*schema.Column{QuebecCoreGatewayHTTPRouteColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "quebec_core_gateway_http_route_quebec_core_gateway_virtual_host_vhost_to_route",
+				Columns:    []*schema.Column{QuebecCoreGatewayHTTPRouteColumns[32]},
+				RefColumns: []*schema.Column{QuebecCoreGatewayVirtualHostColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "quebec_core_gateway_http_route_quebec_core_upstream_upstream_to_route",
+				Columns:    []*schema.Column{QuebecCoreGatewayHTTPRouteColumns[33]},
+				RefColumns: []*schema.Column{QuebecCoreUpstreamColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
 		Indexes: []*schema.Index{
 			{
 				Name:    "coregatewayhttproute_created_at",
@@ -203,29 +290,112 @@ var (
 				Columns: []*schema.Column{QuebecCoreGatewayHTTPRouteColumns[0]},
 			},
 			{
-				Name:    "coregatewayhttproute_match_type",
+				Name:    "coregatewayhttproute_upstream_id",
+				Unique:  false,
+				Columns: []*schema.Column{QuebecCoreGatewayHTTPRouteColumns[33]},
+			},
+			{
+				Name:    "coregatewayhttproute_cluster_id",
 				Unique:  false,
 				Columns: []*schema.Column{QuebecCoreGatewayHTTPRouteColumns[6]},
 			},
+			{
+				Name:    "coregatewayhttproute_virtual_host_id",
+				Unique:  false,
+				Columns: []*schema.Column{QuebecCoreGatewayHTTPRouteColumns[32]},
+			},
+			{
+				Name:    "coregatewayhttproute_match_type",
+				Unique:  false,
+				Columns: []*schema.Column{QuebecCoreGatewayHTTPRouteColumns[7]},
+			},
+			{
+				Name:    "coregatewayhttproute_priority",
+				Unique:  false,
+				Columns: []*schema.Column{QuebecCoreGatewayHTTPRouteColumns[9]},
+			},
 			{
 				Name:    "coregatewayhttproute_timeout_ms",
 				Unique:  false,
-				Columns: []*schema.Column{QuebecCoreGatewayHTTPRouteColumns[8]},
+				Columns: []*schema.Column{QuebecCoreGatewayHTTPRouteColumns[14]},
 			},
 			{
 				Name:    "coregatewayhttproute_enable_path_rewrite",
 				Unique:  false,
-				Columns: []*schema.Column{QuebecCoreGatewayHTTPRouteColumns[9]},
+				Columns: []*schema.Column{QuebecCoreGatewayHTTPRouteColumns[26]},
 			},
 			{
 				Name:    "coregatewayhttproute_enable_redirect",
 				Unique:  false,
-				Columns: []*schema.Column{QuebecCoreGatewayHTTPRouteColumns[11]},
+				Columns: []*schema.Column{QuebecCoreGatewayHTTPRouteColumns[28]},
 			},
 			{
 				Name:    "coregatewayhttproute_status",
 				Unique:  false,
-				Columns: []*schema.Column{QuebecCoreGatewayHTTPRouteColumns[14]},
+				Columns: []*schema.Column{QuebecCoreGatewayHTTPRouteColumns[31]},
+			},
+		},
+	}
+	// QuebecCoreGatewayHTTPRouteTargetColumns holds the columns for the "quebec_core_gateway_http_route_target" table.
+	QuebecCoreGatewayHTTPRouteTargetColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true, Size: 64, Comment: "主键ID"},
+		{Name: "created_at", Type: field.TypeTime, Comment: "创建时间"},
+		{Name: "updated_at", Type: field.TypeTime, Comment: "更新时间"},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true, Comment: "删除时间"},
+		{Name: "weight", Type: field.TypeInt, Nullable: true, Comment: "流量权重(%)，同一路由的权重之和为100", Default: 0},
+		{Name: "route_id", Type: field.TypeString, Nullable: true, Size: 64, Comment: "HTTP路由ID"},
+		{Name: "upstream_id", Type: field.TypeString, Nullable: true, Size: 64, Comment: "目标上游服务ID"},
+	}
+	// QuebecCoreGatewayHTTPRouteTargetTable holds the schema information for the "quebec_core_gateway_http_route_target" table.
+	QuebecCoreGatewayHTTPRouteTargetTable = &schema.Table{
+		Name:       "quebec_core_gateway_http_route_target",
+		Comment:    "HTTP路由加权分流目标表",
+		Columns:    QuebecCoreGatewayHTTPRouteTargetColumns,
+		PrimaryKey: []*schema.Column{QuebecCoreGatewayHTTPRouteTargetColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "quebec_core_gateway_http_route_target_quebec_core_gateway_http_route_route_to_target",
+				Columns:    []*schema.Column{QuebecCoreGatewayHTTPRouteTargetColumns[5]},
+				RefColumns: []*schema.Column{QuebecCoreGatewayHTTPRouteColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "quebec_core_gateway_http_route_target_quebec_core_upstream_upstream_to_target",
+				Columns:    []*schema.Column{QuebecCoreGatewayHTTPRouteTargetColumns[6]},
+				RefColumns: []*schema.Column{QuebecCoreUpstreamColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "coregatewayhttproutetarget_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{QuebecCoreGatewayHTTPRouteTargetColumns[1]},
+			},
+			{
+				Name:    "coregatewayhttproutetarget_updated_at",
+				Unique:  false,
+				Columns: []*schema.Column{QuebecCoreGatewayHTTPRouteTargetColumns[2]},
+			},
+			{
+				Name:    "coregatewayhttproutetarget_deleted_at",
+				Unique:  false,
+				Columns: []*schema.Column{QuebecCoreGatewayHTTPRouteTargetColumns[3]},
+			},
+			{
+				Name:    "coregatewayhttproutetarget_id",
+				Unique:  false,
+				Columns: []*schema.Column{QuebecCoreGatewayHTTPRouteTargetColumns[0]},
+			},
+			{
+				Name:    "coregatewayhttproutetarget_route_id",
+				Unique:  false,
+				Columns: []*schema.Column{QuebecCoreGatewayHTTPRouteTargetColumns[5]},
+			},
+			{
+				Name:    "coregatewayhttproutetarget_upstream_id",
+				Unique:  false,
+				Columns: []*schema.Column{QuebecCoreGatewayHTTPRouteTargetColumns[6]},
 			},
 		},
 	}
@@ -299,9 +469,12 @@ var (
 		{Name: "deleted_at", Type: field.TypeTime, Nullable: true, Comment: "删除时间"},
 		{Name: "name", Type: field.TypeString, Nullable: true, Comment: "监听器名称"},
 		{Name: "description", Type: field.TypeString, Nullable: true, Comment: "监听器描述"},
+		{Name: "cluster_id", Type: field.TypeString, Nullable: true, Comment: "所属网关集群ID(Envoy node.cluster)，为空表示所有集群"},
 		{Name: "port", Type: field.TypeUint16, Nullable: true, Comment: "监听端口"},
 		{Name: "host", Type: field.TypeString, Nullable: true, Comment: "监听地址", Default: "0.0.0.0"},
 		{Name: "enable_tls", Type: field.TypeInt8, Nullable: true, Comment: "是否启用TLS [1: 启用, 2: 禁用]", Default: 2},
+		{Name: "local_rate_limit", Type: field.TypeJSON, Nullable: true, Comment: "本地限流，作用于监听器上未单独配置限流的路由"},
+		{Name: "compression", Type: field.TypeJSON, Nullable: true, Comment: "响应压缩，为空表示不压缩"},
 		{Name: "status", Type: field.TypeInt8, Nullable: true, Comment: "是否启用 [1: 启用, 2: 禁用]", Default: 1},
 	}
 	// QuebecCoreGatewayL7ListenerTable holds the schema information for the "quebec_core_gateway_l7_listener" table.
@@ -331,25 +504,30 @@ var (
 				Unique:  false,
 				Columns: []*schema.Column{QuebecCoreGatewayL7ListenerColumns[0]},
 			},
+			{
+				Name:    "coregatewayl7listener_cluster_id",
+				Unique:  false,
+				Columns: []*schema.Column{QuebecCoreGatewayL7ListenerColumns[6]},
+			},
 			{
 				Name:    "coregatewayl7listener_host",
 				Unique:  false,
-				Columns: []*schema.Column{QuebecCoreGatewayL7ListenerColumns[7]},
+				Columns: []*schema.Column{QuebecCoreGatewayL7ListenerColumns[8]},
 			},
 			{
 				Name:    "coregatewayl7listener_port",
 				Unique:  false,
-				Columns: []*schema.Column{QuebecCoreGatewayL7ListenerColumns[6]},
+				Columns: []*schema.Column{QuebecCoreGatewayL7ListenerColumns[7]},
 			},
 			{
 				Name:    "coregatewayl7listener_enable_tls",
 				Unique:  false,
-				Columns: []*schema.Column{QuebecCoreGatewayL7ListenerColumns[8]},
+				Columns: []*schema.Column{QuebecCoreGatewayL7ListenerColumns[9]},
 			},
 			{
 				Name:    "coregatewayl7listener_status",
 				Unique:  false,
-				Columns: []*schema.Column{QuebecCoreGatewayL7ListenerColumns[9]},
+				Columns: []*schema.Column{QuebecCoreGatewayL7ListenerColumns[12]},
 			},
 		},
 	}
@@ -363,6 +541,11 @@ var (
 		{Name: "gateway_id", Type: field.TypeInt64, Nullable: true, Comment: "网关ID"},
 		{Name: "node_register_time", Type: field.TypeInt64, Nullable: true, Comment: "注册时间"},
 		{Name: "node_last_request_time", Type: field.TypeInt64, Nullable: true, Comment: "最新请求时间"},
+		{Name: "applied_revision", Type: field.TypeInt64, Nullable: true, Comment: "最近 ACK 的配置修订号"},
+		{Name: "last_error", Type: field.TypeString, Nullable: true, Comment: "最近一次 NACK 错误信息，为空表示配置均已接受"},
+		{Name: "xds_status", Type: field.TypeJSON, Nullable: true, Comment: "各类型 xDS 资源的回执状态，按 type_url 索引"},
+		{Name: "request_total", Type: field.TypeInt64, Nullable: true, Comment: "累计请求数，来自访问日志"},
+		{Name: "error_total", Type: field.TypeInt64, Nullable: true, Comment: "累计 5xx 响应数，来自访问日志"},
 		{Name: "cluster_id", Type: field.TypeString, Nullable: true, Size: 64, Comment: "集群ID"},
 	}
 	// QuebecCoreGatewayNodeTable holds the schema information for the "quebec_core_gateway_node" table.
@@ -374,7 +557,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "quebec_core_gateway_node_quebec_core_gateway_cluster_cluster_to_node",
-				Columns:    []*schema.Column{QuebecCoreGatewayNodeColumns[8]},
+				Columns:    []*schema.Column{QuebecCoreGatewayNodeColumns[13]},
 				RefColumns: []*schema.Column{QuebecCoreGatewayClusterColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
@@ -403,7 +586,7 @@ var (
 			{
 				Name:    "coregatewaynode_cluster_id",
 				Unique:  false,
-				Columns: []*schema.Column{QuebecCoreGatewayNodeColumns[8]},
+				Columns: []*schema.Column{QuebecCoreGatewayNodeColumns[13]},
 			},
 			{
 				Name:    "coregatewaynode_node_id",
@@ -427,6 +610,115 @@ var (
 			},
 		},
 	}
+	// QuebecCoreGatewayRateLimitRuleColumns holds the columns for the "quebec_core_gateway_rate_limit_rule" table.
+	QuebecCoreGatewayRateLimitRuleColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true, Size: 64, Comment: "主键ID"},
+		{Name: "created_at", Type: field.TypeTime, Comment: "创建时间"},
+		{Name: "updated_at", Type: field.TypeTime, Comment: "更新时间"},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true, Comment: "删除时间"},
+		{Name: "name", Type: field.TypeString, Nullable: true, Comment: "限流规则名称"},
+		{Name: "description", Type: field.TypeString, Nullable: true, Comment: "限流规则描述"},
+		{Name: "descriptors", Type: field.TypeJSON, Nullable: true, Comment: "匹配的描述符条目，需与路由的全局限流描述符条目顺序一致"},
+		{Name: "unit", Type: field.TypeInt8, Nullable: true, Comment: "计数周期 [1: 秒, 2: 分钟, 3: 小时, 4: 天]", Default: 2},
+		{Name: "requests_per_unit", Type: field.TypeUint32, Nullable: true, Comment: "每个计数周期允许的请求数"},
+		{Name: "status", Type: field.TypeInt8, Nullable: true, Comment: "是否启用 [1: 启用, 2: 禁用]", Default: 1},
+	}
+	// QuebecCoreGatewayRateLimitRuleTable holds the schema information for the "quebec_core_gateway_rate_limit_rule" table.
+	QuebecCoreGatewayRateLimitRuleTable = &schema.Table{
+		Name:       "quebec_core_gateway_rate_limit_rule",
+		Comment:    "全局限流规则表",
+		Columns:    QuebecCoreGatewayRateLimitRuleColumns,
+		PrimaryKey: []*schema.Column{QuebecCoreGatewayRateLimitRuleColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "coregatewayratelimitrule_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{QuebecCoreGatewayRateLimitRuleColumns[1]},
+			},
+			{
+				Name:    "coregatewayratelimitrule_updated_at",
+				Unique:  false,
+				Columns: []*schema.Column{QuebecCoreGatewayRateLimitRuleColumns[2]},
+			},
+			{
+				Name:    "coregatewayratelimitrule_deleted_at",
+				Unique:  false,
+				Columns: []*schema.Column{QuebecCoreGatewayRateLimitRuleColumns[3]},
+			},
+			{
+				Name:    "coregatewayratelimitrule_id",
+				Unique:  false,
+				Columns: []*schema.Column{QuebecCoreGatewayRateLimitRuleColumns[0]},
+			},
+			{
+				Name:    "coregatewayratelimitrule_status",
+				Unique:  false,
+				Columns: []*schema.Column{QuebecCoreGatewayRateLimitRuleColumns[9]},
+			},
+		},
+	}
+	// QuebecCoreGatewayVirtualHostColumns holds the columns for the "quebec_core_gateway_virtual_host" table.
+	QuebecCoreGatewayVirtualHostColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true, Size: 64, Comment: "主键ID"},
+		{Name: "created_at", Type: field.TypeTime, Comment: "创建时间"},
+		{Name: "updated_at", Type: field.TypeTime, Comment: "更新时间"},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true, Comment: "删除时间"},
+		{Name: "name", Type: field.TypeString, Nullable: true, Comment: "虚拟主机名称"},
+		{Name: "description", Type: field.TypeString, Nullable: true, Comment: "虚拟主机描述"},
+		{Name: "domains", Type: field.TypeJSON, Nullable: true, Comment: "匹配的域名，支持 *.example.com、example.* 与 *，同一监听器内不能重复"},
+		{Name: "header_mutation", Type: field.TypeJSON, Nullable: true, Comment: "请求头与响应头改写，作用于虚拟主机下所有路由"},
+		{Name: "cors_policy", Type: field.TypeJSON, Nullable: true, Comment: "跨域策略，作用于虚拟主机下未单独配置的路由"},
+		{Name: "maintenance", Type: field.TypeJSON, Nullable: true, Comment: "维护模式，优先于网关集群的维护模式"},
+		{Name: "status", Type: field.TypeInt8, Nullable: true, Comment: "是否启用 [1: 启用, 2: 禁用]", Default: 1},
+		{Name: "listener_id", Type: field.TypeString, Nullable: true, Size: 64, Comment: "所属L7监听器ID"},
+	}
+	// QuebecCoreGatewayVirtualHostTable holds the schema information for the "quebec_core_gateway_virtual_host" table.
+	QuebecCoreGatewayVirtualHostTable = &schema.Table{
+		Name:       "quebec_core_gateway_virtual_host",
+		Comment:    "L7 虚拟主机表",
+		Columns:    QuebecCoreGatewayVirtualHostColumns,
+		PrimaryKey: []*schema.Column{QuebecCoreGatewayVirtualHostColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "quebec_core_gateway_virtual_host_quebec_core_gateway_l7_listener_listener_to_vhost",
+				Columns:    []*schema.Column{QuebecCoreGatewayVirtualHostColumns[11]},
+				RefColumns: []*schema.Column{QuebecCoreGatewayL7ListenerColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "coregatewayvirtualhost_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{QuebecCoreGatewayVirtualHostColumns[1]},
+			},
+			{
+				Name:    "coregatewayvirtualhost_updated_at",
+				Unique:  false,
+				Columns: []*schema.Column{QuebecCoreGatewayVirtualHostColumns[2]},
+			},
+			{
+				Name:    "coregatewayvirtualhost_deleted_at",
+				Unique:  false,
+				Columns: []*schema.Column{QuebecCoreGatewayVirtualHostColumns[3]},
+			},
+			{
+				Name:    "coregatewayvirtualhost_id",
+				Unique:  false,
+				Columns: []*schema.Column{QuebecCoreGatewayVirtualHostColumns[0]},
+			},
+			{
+				Name:    "coregatewayvirtualhost_listener_id",
+				Unique:  false,
+				Columns: []*schema.Column{QuebecCoreGatewayVirtualHostColumns[11]},
+			},
+			{
+				Name:    "coregatewayvirtualhost_status",
+				Unique:  false,
+				Columns: []*schema.Column{QuebecCoreGatewayVirtualHostColumns[10]},
+			},
+		},
+	}
 	// QuebecCoreMenuColumns holds the columns for the "quebec_core_menu" table.
 	QuebecCoreMenuColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString, Unique: true, Size: 64, Comment: "主键ID"},
@@ -657,6 +949,177 @@ var (
 			},
 		},
 	}
+	// QuebecCoreProxyRevisionColumns holds the columns for the "quebec_core_proxy_revision" table.
+	QuebecCoreProxyRevisionColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true, Size: 64, Comment: "主键ID"},
+		{Name: "created_at", Type: field.TypeTime, Comment: "创建时间"},
+		{Name: "updated_at", Type: field.TypeTime, Comment: "更新时间"},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true, Comment: "删除时间"},
+		{Name: "revision", Type: field.TypeInt64, Unique: true, Nullable: true, Comment: "配置修订号"},
+		{Name: "config", Type: field.TypeString, Nullable: true, Comment: "完整代理配置(protojson)", SchemaType: map[string]string{"mysql": "longtext", "postgres": "text", "sqlite3": "text"}},
+		{Name: "action", Type: field.TypeInt8, Nullable: true, Comment: "修订来源: 1-发布 2-回滚", Default: 1},
+		{Name: "rollback_from", Type: field.TypeInt64, Nullable: true, Comment: "回滚的目标修订号，仅回滚时有效"},
+		{Name: "author_id", Type: field.TypeString, Nullable: true, Comment: "发布人ID，为空表示系统检测到的变更"},
+		{Name: "author_name", Type: field.TypeString, Nullable: true, Comment: "发布人名称"},
+		{Name: "summary", Type: field.TypeString, Nullable: true, Comment: "变更摘要"},
+	}
+	// QuebecCoreProxyRevisionTable holds the schema information for the "quebec_core_proxy_revision" table.
+	QuebecCoreProxyRevisionTable = &schema.Table{
+		Name:       "quebec_core_proxy_revision",
+		Comment:    "代理配置修订记录表",
+		Columns:    QuebecCoreProxyRevisionColumns,
+		PrimaryKey: []*schema.Column{QuebecCoreProxyRevisionColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "coreproxyrevision_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{QuebecCoreProxyRevisionColumns[1]},
+			},
+			{
+				Name:    "coreproxyrevision_updated_at",
+				Unique:  false,
+				Columns: []*schema.Column{QuebecCoreProxyRevisionColumns[2]},
+			},
+			{
+				Name:    "coreproxyrevision_deleted_at",
+				Unique:  false,
+				Columns: []*schema.Column{QuebecCoreProxyRevisionColumns[3]},
+			},
+			{
+				Name:    "coreproxyrevision_id",
+				Unique:  false,
+				Columns: []*schema.Column{QuebecCoreProxyRevisionColumns[0]},
+			},
+			{
+				Name:    "coreproxyrevision_revision",
+				Unique:  false,
+				Columns: []*schema.Column{QuebecCoreProxyRevisionColumns[4]},
+			},
+			{
+				Name:    "coreproxyrevision_author_id",
+				Unique:  false,
+				Columns: []*schema.Column{QuebecCoreProxyRevisionColumns[8]},
+			},
+		},
+	}
+	// QuebecCoreProxyRolloutColumns holds the columns for the "quebec_core_proxy_rollout" table.
+	QuebecCoreProxyRolloutColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true, Size: 64, Comment: "主键ID"},
+		{Name: "created_at", Type: field.TypeTime, Comment: "创建时间"},
+		{Name: "updated_at", Type: field.TypeTime, Comment: "更新时间"},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true, Comment: "删除时间"},
+		{Name: "cluster_id", Type: field.TypeString, Nullable: true, Comment: "网关集群ID (Envoy node.cluster)"},
+		{Name: "revision", Type: field.TypeInt64, Nullable: true, Comment: "灰度中的配置修订号"},
+		{Name: "stable_revision", Type: field.TypeInt64, Nullable: true, Comment: "非灰度节点使用的稳定修订号"},
+		{Name: "canary_node_ids", Type: field.TypeJSON, Nullable: true, Comment: "灰度节点 (Envoy node.id)"},
+		{Name: "baseline", Type: field.TypeJSON, Nullable: true, Comment: "灰度开始时各灰度节点的累计流量，用于计算灰度期间的错误率"},
+		{Name: "soak_seconds", Type: field.TypeInt, Nullable: true, Comment: "观察时长(秒)"},
+		{Name: "max_error_rate", Type: field.TypeFloat64, Nullable: true, Comment: "允许的最大错误率(%)"},
+		{Name: "min_requests", Type: field.TypeInt64, Nullable: true, Comment: "评估错误率所需的最少请求数"},
+		{Name: "requests", Type: field.TypeInt64, Nullable: true, Comment: "灰度期间灰度节点的请求数"},
+		{Name: "errors", Type: field.TypeInt64, Nullable: true, Comment: "灰度期间灰度节点的 5xx 响应数"},
+		{Name: "status", Type: field.TypeInt8, Nullable: true, Comment: "状态: 1-灰度中 2-已全量 3-已中止 4-已被取代", Default: 1},
+		{Name: "reason", Type: field.TypeString, Nullable: true, Comment: "结束原因"},
+		{Name: "started_at", Type: field.TypeInt64, Nullable: true, Comment: "开始时间"},
+		{Name: "finished_at", Type: field.TypeInt64, Nullable: true, Comment: "结束时间"},
+	}
+	// QuebecCoreProxyRolloutTable holds the schema information for the "quebec_core_proxy_rollout" table.
+	QuebecCoreProxyRolloutTable = &schema.Table{
+		Name:       "quebec_core_proxy_rollout",
+		Comment:    "灰度发布记录表",
+		Columns:    QuebecCoreProxyRolloutColumns,
+		PrimaryKey: []*schema.Column{QuebecCoreProxyRolloutColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "coreproxyrollout_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{QuebecCoreProxyRolloutColumns[1]},
+			},
+			{
+				Name:    "coreproxyrollout_updated_at",
+				Unique:  false,
+				Columns: []*schema.Column{QuebecCoreProxyRolloutColumns[2]},
+			},
+			{
+				Name:    "coreproxyrollout_deleted_at",
+				Unique:  false,
+				Columns: []*schema.Column{QuebecCoreProxyRolloutColumns[3]},
+			},
+			{
+				Name:    "coreproxyrollout_id",
+				Unique:  false,
+				Columns: []*schema.Column{QuebecCoreProxyRolloutColumns[0]},
+			},
+			{
+				Name:    "coreproxyrollout_cluster_id",
+				Unique:  false,
+				Columns: []*schema.Column{QuebecCoreProxyRolloutColumns[4]},
+			},
+			{
+				Name:    "coreproxyrollout_revision",
+				Unique:  false,
+				Columns: []*schema.Column{QuebecCoreProxyRolloutColumns[5]},
+			},
+			{
+				Name:    "coreproxyrollout_status",
+				Unique:  false,
+				Columns: []*schema.Column{QuebecCoreProxyRolloutColumns[14]},
+			},
+		},
+	}
+	// QuebecCoreProxyRolloutPolicyColumns holds the columns for the "quebec_core_proxy_rollout_policy" table.
+	QuebecCoreProxyRolloutPolicyColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true, Size: 64, Comment: "主键ID"},
+		{Name: "created_at", Type: field.TypeTime, Comment: "创建时间"},
+		{Name: "updated_at", Type: field.TypeTime, Comment: "更新时间"},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true, Comment: "删除时间"},
+		{Name: "cluster_id", Type: field.TypeString, Unique: true, Nullable: true, Comment: "网关集群ID (Envoy node.cluster)"},
+		{Name: "percentage", Type: field.TypeInt, Nullable: true, Comment: "灰度节点比例(%)，指定灰度节点时忽略", Default: 10},
+		{Name: "node_ids", Type: field.TypeJSON, Nullable: true, Comment: "指定的灰度节点 (Envoy node.id)"},
+		{Name: "soak_seconds", Type: field.TypeInt, Nullable: true, Comment: "观察时长(秒)，期间无 NACK 且错误率达标则自动全量", Default: 300},
+		{Name: "max_error_rate", Type: field.TypeFloat64, Nullable: true, Comment: "灰度节点允许的最大错误率(%)，超过则自动中止", Default: 1},
+		{Name: "min_requests", Type: field.TypeInt64, Nullable: true, Comment: "评估错误率所需的最少请求数", Default: 100},
+		{Name: "status", Type: field.TypeInt8, Nullable: true, Comment: "是否启用 [1: 启用, 2: 禁用]", Default: 1},
+	}
+	// QuebecCoreProxyRolloutPolicyTable holds the schema information for the "quebec_core_proxy_rollout_policy" table.
+	QuebecCoreProxyRolloutPolicyTable = &schema.Table{
+		Name:       "quebec_core_proxy_rollout_policy",
+		Comment:    "灰度发布策略表",
+		Columns:    QuebecCoreProxyRolloutPolicyColumns,
+		PrimaryKey: []*schema.Column{QuebecCoreProxyRolloutPolicyColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "coreproxyrolloutpolicy_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{QuebecCoreProxyRolloutPolicyColumns[1]},
+			},
+			{
+				Name:    "coreproxyrolloutpolicy_updated_at",
+				Unique:  false,
+				Columns: []*schema.Column{QuebecCoreProxyRolloutPolicyColumns[2]},
+			},
+			{
+				Name:    "coreproxyrolloutpolicy_deleted_at",
+				Unique:  false,
+				Columns: []*schema.Column{QuebecCoreProxyRolloutPolicyColumns[3]},
+			},
+			{
+				Name:    "coreproxyrolloutpolicy_id",
+				Unique:  false,
+				Columns: []*schema.Column{QuebecCoreProxyRolloutPolicyColumns[0]},
+			},
+			{
+				Name:    "coreproxyrolloutpolicy_cluster_id",
+				Unique:  false,
+				Columns: []*schema.Column{QuebecCoreProxyRolloutPolicyColumns[4]},
+			},
+			{
+				Name:    "coreproxyrolloutpolicy_status",
+				Unique:  false,
+				Columns: []*schema.Column{QuebecCoreProxyRolloutPolicyColumns[10]},
+			},
+		},
+	}
 	// QuebecCoreRoleColumns holds the columns for the "quebec_core_role" table.
 	QuebecCoreRoleColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString, Unique: true, Size: 64, Comment: "主键ID"},
@@ -716,6 +1179,9 @@ var (
 		{Name: "name", Type: field.TypeString, Nullable: true, Comment: "上游服务名称"},
 		{Name: "description", Type: field.TypeString, Nullable: true, Comment: "上游服务描述"},
 		{Name: "lb_policy", Type: field.TypeInt8, Nullable: true, Comment: "负载均衡策略: 1-ROUND_ROBIN 2-LEAST_REQUEST 3-RANDOM 4-RING_HASH 5-MAGLEV", Default: 5},
+		{Name: "ring_min_size", Type: field.TypeUint64, Nullable: true, Comment: "环哈希的最小环大小，0 表示使用 Envoy 默认值", Default: 0},
+		{Name: "ring_max_size", Type: field.TypeUint64, Nullable: true, Comment: "环哈希的最大环大小，0 表示使用 Envoy 默认值", Default: 0},
+		{Name: "maglev_table_size", Type: field.TypeUint64, Nullable: true, Comment: "Maglev 查找表大小，必须为质数，0 表示使用 Envoy 默认值", Default: 0},
 		{Name: "connect_timeout_ms", Type: field.TypeInt, Nullable: true, Comment: "连接超时(毫秒)", Default: 5000},
 		{Name: "max_connections", Type: field.TypeInt, Nullable: true, Comment: "最大连接数", Default: 1024},
 		{Name: "max_pending_requests", Type: field.TypeInt, Nullable: true, Comment: "最大等待请求数", Default: 1024},
@@ -758,32 +1224,32 @@ var (
 			{
 				Name:    "coreupstream_connect_timeout_ms",
 				Unique:  false,
-				Columns: []*schema.Column{QuebecCoreUpstreamColumns[7]},
+				Columns: []*schema.Column{QuebecCoreUpstreamColumns[10]},
 			},
 			{
 				Name:    "coreupstream_max_connections",
 				Unique:  false,
-				Columns: []*schema.Column{QuebecCoreUpstreamColumns[8]},
+				Columns: []*schema.Column{QuebecCoreUpstreamColumns[11]},
 			},
 			{
 				Name:    "coreupstream_max_pending_requests",
 				Unique:  false,
-				Columns: []*schema.Column{QuebecCoreUpstreamColumns[9]},
+				Columns: []*schema.Column{QuebecCoreUpstreamColumns[12]},
 			},
 			{
 				Name:    "coreupstream_max_requests",
 				Unique:  false,
-				Columns: []*schema.Column{QuebecCoreUpstreamColumns[10]},
+				Columns: []*schema.Column{QuebecCoreUpstreamColumns[13]},
 			},
 			{
 				Name:    "coreupstream_max_retries",
 				Unique:  false,
-				Columns: []*schema.Column{QuebecCoreUpstreamColumns[11]},
+				Columns: []*schema.Column{QuebecCoreUpstreamColumns[14]},
 			},
 			{
 				Name:    "coreupstream_status",
 				Unique:  false,
-				Columns: []*schema.Column{QuebecCoreUpstreamColumns[12]},
+				Columns: []*schema.Column{QuebecCoreUpstreamColumns[15]},
 			},
 		},
 	}
@@ -797,6 +1263,7 @@ var (
 		{Name: "weight", Type: field.TypeInt, Nullable: true, Comment: "权重(相对权重)", Default: 1},
 		{Name: "port", Type: field.TypeInt, Nullable: true, Comment: "后端端口"},
 		{Name: "enabled", Type: field.TypeInt8, Nullable: true, Comment: "是否可用 [1: 是, 2: 否]", Default: 1},
+		{Name: "upstream_id", Type: field.TypeString, Nullable: true, Size: 64, Comment: "上游服务ID"},
 	}
 	// QuebecCoreUpstreamHostTable holds the schema information for the "quebec_core_upstream_host" table.
 	QuebecCoreUpstreamHostTable = &schema.Table{
@@ -804,6 +1271,14 @@ var (
 		Comment:    "上游服务后端地址表",
 		Columns:    QuebecCoreUpstreamHostColumns,
 		PrimaryKey: []*schema.Column{QuebecCoreUpstreamHostColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "quebec_core_upstream_host_quebec_core_upstream_upstream_to_host",
+				Columns:    []*schema.Column{QuebecCoreUpstreamHostColumns[8]},
+				RefColumns: []*schema.Column{QuebecCoreUpstreamColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
 		Indexes: []*schema.Index{
 			{
 				Name:    "coreupstreamhost_created_at",
@@ -825,6 +1300,11 @@ var (
 				Unique:  false,
 				Columns: []*schema.Column{QuebecCoreUpstreamHostColumns[0]},
 			},
+			{
+				Name:    "coreupstreamhost_upstream_id",
+				Unique:  false,
+				Columns: []*schema.Column{QuebecCoreUpstreamHostColumns[8]},
+			},
 			{
 				Name:    "coreupstreamhost_address",
 				Unique:  false,
@@ -984,14 +1464,21 @@ var (
 	Tables = []*schema.Table{
 		QuebecCoreCertTable,
 		QuebecCoreDataRelationshipTable,
+		QuebecCoreGatewayTable,
 		QuebecCoreGatewayClusterTable,
 		QuebecCoreGatewayHTTPRouteTable,
+		QuebecCoreGatewayHTTPRouteTargetTable,
 		QuebecCoreGatewayL4ListenerTable,
 		QuebecCoreGatewayL7ListenerTable,
 		QuebecCoreGatewayNodeTable,
+		QuebecCoreGatewayRateLimitRuleTable,
+		QuebecCoreGatewayVirtualHostTable,
 		QuebecCoreMenuTable,
 		QuebecCoreOnLineUserTable,
 		QuebecOperationLogTable,
+		QuebecCoreProxyRevisionTable,
+		QuebecCoreProxyRolloutTable,
+		QuebecCoreProxyRolloutPolicyTable,
 		QuebecCoreRoleTable,
 		QuebecCoreUpstreamTable,
 		QuebecCoreUpstreamHostTable,
@@ -1012,16 +1499,30 @@ func init() {
 		Charset:   "utf8mb4",
 		Collation: "utf8mb4_general_ci",
 	}
+	QuebecCoreGatewayTable.Annotation = &entsql.Annotation{
+		Table:     "quebec_core_gateway",
+		Charset:   "utf8mb4",
+		Collation: "utf8mb4_general_ci",
+	}
 	QuebecCoreGatewayClusterTable.Annotation = &entsql.Annotation{
 		Table:     "quebec_core_gateway_cluster",
 		Charset:   "utf8mb4",
 		Collation: "utf8mb4_general_ci",
 	}
+	QuebecCoreGatewayHTTPRouteTable.ForeignKeys[0].RefTable = QuebecCoreGatewayVirtualHostTable
+	QuebecCoreGatewayHTTPRouteTable.ForeignKeys[1].RefTable = QuebecCoreUpstreamTable
 	QuebecCoreGatewayHTTPRouteTable.Annotation = &entsql.Annotation{
 		Table:     "quebec_core_gateway_http_route",
 		Charset:   "utf8mb4",
 		Collation: "utf8mb4_general_ci",
 	}
+	QuebecCoreGatewayHTTPRouteTargetTable.ForeignKeys[0].RefTable = QuebecCoreGatewayHTTPRouteTable
+	QuebecCoreGatewayHTTPRouteTargetTable.ForeignKeys[1].RefTable = QuebecCoreUpstreamTable
+	QuebecCoreGatewayHTTPRouteTargetTable.Annotation = &entsql.Annotation{
+		Table:     "quebec_core_gateway_http_route_target",
+		Charset:   "utf8mb4",
+		Collation: "utf8mb4_general_ci",
+	}
 	QuebecCoreGatewayL4ListenerTable.Annotation = &entsql.Annotation{
 		Table:     "quebec_core_gateway_l4_listener",
 		Charset:   "utf8mb4",
@@ -1038,6 +1539,17 @@ func init() {
 		Charset:   "utf8mb4",
 		Collation: "utf8mb4_general_ci",
 	}
+	QuebecCoreGatewayRateLimitRuleTable.Annotation = &entsql.Annotation{
+		Table:     "quebec_core_gateway_rate_limit_rule",
+		Charset:   "utf8mb4",
+		Collation: "utf8mb4_general_ci",
+	}
+	QuebecCoreGatewayVirtualHostTable.ForeignKeys[0].RefTable = QuebecCoreGatewayL7ListenerTable
+	QuebecCoreGatewayVirtualHostTable.Annotation = &entsql.Annotation{
+		Table:     "quebec_core_gateway_virtual_host",
+		Charset:   "utf8mb4",
+		Collation: "utf8mb4_general_ci",
+	}
 	QuebecCoreMenuTable.ForeignKeys[0].RefTable = QuebecCoreMenuTable
 	QuebecCoreMenuTable.Annotation = &entsql.Annotation{
 		Table:     "quebec_core_menu",
@@ -1056,6 +1568,21 @@ func init() {
 		Charset:   "utf8mb4",
 		Collation: "utf8mb4_general_ci",
 	}
+	QuebecCoreProxyRevisionTable.Annotation = &entsql.Annotation{
+		Table:     "quebec_core_proxy_revision",
+		Charset:   "utf8mb4",
+		Collation: "utf8mb4_general_ci",
+	}
+	QuebecCoreProxyRolloutTable.Annotation = &entsql.Annotation{
+		Table:     "quebec_core_proxy_rollout",
+		Charset:   "utf8mb4",
+		Collation: "utf8mb4_general_ci",
+	}
+	QuebecCoreProxyRolloutPolicyTable.Annotation = &entsql.Annotation{
+		Table:     "quebec_core_proxy_rollout_policy",
+		Charset:   "utf8mb4",
+		Collation: "utf8mb4_general_ci",
+	}
 	QuebecCoreRoleTable.Annotation = &entsql.Annotation{
 		Table:     "quebec_core_role",
 		Charset:   "utf8mb4",
@@ -1066,6 +1593,7 @@ func init() {
 		Charset:   "utf8mb4",
 		Collation: "utf8mb4_general_ci",
 	}
+	QuebecCoreUpstreamHostTable.ForeignKeys[0].RefTable = QuebecCoreUpstreamTable
 	QuebecCoreUpstreamHostTable.Annotation = &entsql.Annotation{
 		Table:     "quebec_core_upstream_host",
 		Charset:   "utf8mb4",
@@ -12,12 +12,18 @@ type CoreCert func(*sql.Selector)
 // CoreDataRelationship is the predicate function for coredatarelationship builders.
 type CoreDataRelationship func(*sql.Selector)
 
+// CoreGateway is the predicate function for coregateway builders.
+type CoreGateway func(*sql.Selector)
+
 // CoreGatewayCluster is the predicate function for coregatewaycluster builders.
 type CoreGatewayCluster func(*sql.Selector)
 
 // CoreGatewayHttpRoute is the predicate function for coregatewayhttproute builders.
 type CoreGatewayHttpRoute func(*sql.Selector)
 
+// CoreGatewayHttpRouteTarget is the predicate function for coregatewayhttproutetarget builders.
+type CoreGatewayHttpRouteTarget func(*sql.Selector)
+
 // CoreGatewayL4Listener is the predicate function for coregatewayl4listener builders.
 type CoreGatewayL4Listener func(*sql.Selector)
 
@@ -27,6 +33,12 @@ type CoreGatewayL7Listener func(*sql.Selector)
 // CoreGatewayNode is the predicate function for coregatewaynode builders.
 type CoreGatewayNode func(*sql.Selector)
 
+// CoreGatewayRateLimitRule is the predicate function for coregatewayratelimitrule builders.
+type CoreGatewayRateLimitRule func(*sql.Selector)
+
+// CoreGatewayVirtualHost is the predicate function for coregatewayvirtualhost builders.
+type CoreGatewayVirtualHost func(*sql.Selector)
+
 // CoreMenu is the predicate function for coremenu builders.
 type CoreMenu func(*sql.Selector)
 
@@ -36,6 +48,15 @@ type CoreOnLineUser func(*sql.Selector)
 // CoreOperationLog is the predicate function for coreoperationlog builders.
 type CoreOperationLog func(*sql.Selector)
 
+// CoreProxyRevision is the predicate function for coreproxyrevision builders.
+type CoreProxyRevision func(*sql.Selector)
+
+// CoreProxyRollout is the predicate function for coreproxyrollout builders.
+type CoreProxyRollout func(*sql.Selector)
+
+// CoreProxyRolloutPolicy is the predicate function for coreproxyrolloutpolicy builders.
+type CoreProxyRolloutPolicy func(*sql.Selector)
+
 // CoreRole is the predicate function for corerole builders.
 type CoreRole func(*sql.Selector)
 
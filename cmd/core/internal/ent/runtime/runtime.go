@@ -8,14 +8,21 @@ import (
 	"github.com/lyonmu/quebec/cmd/core/internal/common"
 	"github.com/lyonmu/quebec/cmd/core/internal/ent/corecert"
 	"github.com/lyonmu/quebec/cmd/core/internal/ent/coredatarelationship"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/coregateway"
 	"github.com/lyonmu/quebec/cmd/core/internal/ent/coregatewaycluster"
 	"github.com/lyonmu/quebec/cmd/core/internal/ent/coregatewayhttproute"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/coregatewayhttproutetarget"
 	"github.com/lyonmu/quebec/cmd/core/internal/ent/coregatewayl4listener"
 	"github.com/lyonmu/quebec/cmd/core/internal/ent/coregatewayl7listener"
 	"github.com/lyonmu/quebec/cmd/core/internal/ent/coregatewaynode"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/coregatewayratelimitrule"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/coregatewayvirtualhost"
 	"github.com/lyonmu/quebec/cmd/core/internal/ent/coremenu"
 	"github.com/lyonmu/quebec/cmd/core/internal/ent/coreonlineuser"
 	"github.com/lyonmu/quebec/cmd/core/internal/ent/coreoperationlog"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/coreproxyrevision"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/coreproxyrollout"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/coreproxyrolloutpolicy"
 	"github.com/lyonmu/quebec/cmd/core/internal/ent/corerole"
 	"github.com/lyonmu/quebec/cmd/core/internal/ent/coreupstream"
 	"github.com/lyonmu/quebec/cmd/core/internal/ent/coreupstreamhost"
@@ -120,6 +127,58 @@ func init() {
 			return nil
 		}
 	}()
+	coregatewayMixin := schema.CoreGateway{}.Mixin()
+	coregatewayMixinHooks1 := coregatewayMixin[1].Hooks()
+	coregateway.Hooks[0] = coregatewayMixinHooks1[0]
+	coregateway.Hooks[1] = coregatewayMixinHooks1[1]
+	coregatewayMixinFields0 := coregatewayMixin[0].Fields()
+	_ = coregatewayMixinFields0
+	coregatewayMixinFields1 := coregatewayMixin[1].Fields()
+	_ = coregatewayMixinFields1
+	coregatewayFields := schema.CoreGateway{}.Fields()
+	_ = coregatewayFields
+	// coregatewayDescCreatedAt is the schema descriptor for created_at field.
+	coregatewayDescCreatedAt := coregatewayMixinFields1[0].Descriptor()
+	// coregateway.DefaultCreatedAt holds the default value on creation for the created_at field.
+	coregateway.DefaultCreatedAt = coregatewayDescCreatedAt.Default.(func() time.Time)
+	// coregatewayDescUpdatedAt is the schema descriptor for updated_at field.
+	coregatewayDescUpdatedAt := coregatewayMixinFields1[1].Descriptor()
+	// coregateway.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	coregateway.DefaultUpdatedAt = coregatewayDescUpdatedAt.Default.(func() time.Time)
+	// coregateway.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	coregateway.UpdateDefaultUpdatedAt = coregatewayDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// coregatewayDescLastSyncTime is the schema descriptor for last_sync_time field.
+	coregatewayDescLastSyncTime := coregatewayFields[4].Descriptor()
+	// coregateway.DefaultLastSyncTime holds the default value on creation for the last_sync_time field.
+	coregateway.DefaultLastSyncTime = coregatewayDescLastSyncTime.Default.(func() int64)
+	// coregatewayDescStatus is the schema descriptor for status field.
+	coregatewayDescStatus := coregatewayFields[5].Descriptor()
+	// coregateway.DefaultStatus holds the default value on creation for the status field.
+	coregateway.DefaultStatus = constant.YesOrNo(coregatewayDescStatus.Default.(int8))
+	// coregatewayDescGlobalRateLimit is the schema descriptor for global_rate_limit field.
+	coregatewayDescGlobalRateLimit := coregatewayFields[6].Descriptor()
+	// coregateway.DefaultGlobalRateLimit holds the default value on creation for the global_rate_limit field.
+	coregateway.DefaultGlobalRateLimit = constant.YesOrNo(coregatewayDescGlobalRateLimit.Default.(int8))
+	// coregatewayDescID is the schema descriptor for id field.
+	coregatewayDescID := coregatewayMixinFields0[0].Descriptor()
+	// coregateway.DefaultID holds the default value on creation for the id field.
+	coregateway.DefaultID = coregatewayDescID.Default.(func() string)
+	// coregateway.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	coregateway.IDValidator = func() func(string) error {
+		validators := coregatewayDescID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(id string) error {
+			for _, fn := range fns {
+				if err := fn(id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
 	coregatewayclusterMixin := schema.CoreGatewayCluster{}.Mixin()
 	coregatewayclusterMixinHooks1 := coregatewayclusterMixin[1].Hooks()
 	coregatewaycluster.Hooks[0] = coregatewayclusterMixinHooks1[0]
@@ -189,27 +248,39 @@ func init() {
 	// coregatewayhttproute.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	coregatewayhttproute.UpdateDefaultUpdatedAt = coregatewayhttprouteDescUpdatedAt.UpdateDefault.(func() time.Time)
 	// coregatewayhttprouteDescMatchType is the schema descriptor for match_type field.
-	coregatewayhttprouteDescMatchType := coregatewayhttprouteFields[2].Descriptor()
+	coregatewayhttprouteDescMatchType := coregatewayhttprouteFields[5].Descriptor()
 	// coregatewayhttproute.DefaultMatchType holds the default value on creation for the match_type field.
 	coregatewayhttproute.DefaultMatchType = constant.ProxyHttpRouteMatchType(coregatewayhttprouteDescMatchType.Default.(int8))
+	// coregatewayhttprouteDescPriority is the schema descriptor for priority field.
+	coregatewayhttprouteDescPriority := coregatewayhttprouteFields[7].Descriptor()
+	// coregatewayhttproute.DefaultPriority holds the default value on creation for the priority field.
+	coregatewayhttproute.DefaultPriority = coregatewayhttprouteDescPriority.Default.(int)
+	// coregatewayhttprouteDescIgnoreCase is the schema descriptor for ignore_case field.
+	coregatewayhttprouteDescIgnoreCase := coregatewayhttprouteFields[8].Descriptor()
+	// coregatewayhttproute.DefaultIgnoreCase holds the default value on creation for the ignore_case field.
+	coregatewayhttproute.DefaultIgnoreCase = constant.YesOrNo(coregatewayhttprouteDescIgnoreCase.Default.(int8))
 	// coregatewayhttprouteDescTimeoutMs is the schema descriptor for timeout_ms field.
-	coregatewayhttprouteDescTimeoutMs := coregatewayhttprouteFields[4].Descriptor()
+	coregatewayhttprouteDescTimeoutMs := coregatewayhttprouteFields[12].Descriptor()
 	// coregatewayhttproute.DefaultTimeoutMs holds the default value on creation for the timeout_ms field.
 	coregatewayhttproute.DefaultTimeoutMs = coregatewayhttprouteDescTimeoutMs.Default.(int)
+	// coregatewayhttprouteDescDisableCompression is the schema descriptor for disable_compression field.
+	coregatewayhttprouteDescDisableCompression := coregatewayhttprouteFields[23].Descriptor()
+	// coregatewayhttproute.DefaultDisableCompression holds the default value on creation for the disable_compression field.
+	coregatewayhttproute.DefaultDisableCompression = constant.YesOrNo(coregatewayhttprouteDescDisableCompression.Default.(int8))
 	// coregatewayhttprouteDescEnablePathRewrite is the schema descriptor for enable_path_rewrite field.
-	coregatewayhttprouteDescEnablePathRewrite := coregatewayhttprouteFields[5].Descriptor()
+	coregatewayhttprouteDescEnablePathRewrite := coregatewayhttprouteFields[24].Descriptor()
 	// coregatewayhttproute.DefaultEnablePathRewrite holds the default value on creation for the enable_path_rewrite field.
 	coregatewayhttproute.DefaultEnablePathRewrite = constant.YesOrNo(coregatewayhttprouteDescEnablePathRewrite.Default.(int8))
 	// coregatewayhttprouteDescEnableRedirect is the schema descriptor for enable_redirect field.
-	coregatewayhttprouteDescEnableRedirect := coregatewayhttprouteFields[7].Descriptor()
+	coregatewayhttprouteDescEnableRedirect := coregatewayhttprouteFields[26].Descriptor()
 	// coregatewayhttproute.DefaultEnableRedirect holds the default value on creation for the enable_redirect field.
 	coregatewayhttproute.DefaultEnableRedirect = constant.YesOrNo(coregatewayhttprouteDescEnableRedirect.Default.(int8))
 	// coregatewayhttprouteDescRedirectCode is the schema descriptor for redirect_code field.
-	coregatewayhttprouteDescRedirectCode := coregatewayhttprouteFields[9].Descriptor()
+	coregatewayhttprouteDescRedirectCode := coregatewayhttprouteFields[28].Descriptor()
 	// coregatewayhttproute.DefaultRedirectCode holds the default value on creation for the redirect_code field.
 	coregatewayhttproute.DefaultRedirectCode = coregatewayhttprouteDescRedirectCode.Default.(int)
 	// coregatewayhttprouteDescStatus is the schema descriptor for status field.
-	coregatewayhttprouteDescStatus := coregatewayhttprouteFields[10].Descriptor()
+	coregatewayhttprouteDescStatus := coregatewayhttprouteFields[29].Descriptor()
 	// coregatewayhttproute.DefaultStatus holds the default value on creation for the status field.
 	coregatewayhttproute.DefaultStatus = constant.YesOrNo(coregatewayhttprouteDescStatus.Default.(int8))
 	// coregatewayhttprouteDescID is the schema descriptor for id field.
@@ -232,6 +303,50 @@ func init() {
 			return nil
 		}
 	}()
+	coregatewayhttproutetargetMixin := schema.CoreGatewayHttpRouteTarget{}.Mixin()
+	coregatewayhttproutetargetMixinHooks1 := coregatewayhttproutetargetMixin[1].Hooks()
+	coregatewayhttproutetarget.Hooks[0] = coregatewayhttproutetargetMixinHooks1[0]
+	coregatewayhttproutetarget.Hooks[1] = coregatewayhttproutetargetMixinHooks1[1]
+	coregatewayhttproutetargetMixinFields0 := coregatewayhttproutetargetMixin[0].Fields()
+	_ = coregatewayhttproutetargetMixinFields0
+	coregatewayhttproutetargetMixinFields1 := coregatewayhttproutetargetMixin[1].Fields()
+	_ = coregatewayhttproutetargetMixinFields1
+	coregatewayhttproutetargetFields := schema.CoreGatewayHttpRouteTarget{}.Fields()
+	_ = coregatewayhttproutetargetFields
+	// coregatewayhttproutetargetDescCreatedAt is the schema descriptor for created_at field.
+	coregatewayhttproutetargetDescCreatedAt := coregatewayhttproutetargetMixinFields1[0].Descriptor()
+	// coregatewayhttproutetarget.DefaultCreatedAt holds the default value on creation for the created_at field.
+	coregatewayhttproutetarget.DefaultCreatedAt = coregatewayhttproutetargetDescCreatedAt.Default.(func() time.Time)
+	// coregatewayhttproutetargetDescUpdatedAt is the schema descriptor for updated_at field.
+	coregatewayhttproutetargetDescUpdatedAt := coregatewayhttproutetargetMixinFields1[1].Descriptor()
+	// coregatewayhttproutetarget.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	coregatewayhttproutetarget.DefaultUpdatedAt = coregatewayhttproutetargetDescUpdatedAt.Default.(func() time.Time)
+	// coregatewayhttproutetarget.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	coregatewayhttproutetarget.UpdateDefaultUpdatedAt = coregatewayhttproutetargetDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// coregatewayhttproutetargetDescWeight is the schema descriptor for weight field.
+	coregatewayhttproutetargetDescWeight := coregatewayhttproutetargetFields[2].Descriptor()
+	// coregatewayhttproutetarget.DefaultWeight holds the default value on creation for the weight field.
+	coregatewayhttproutetarget.DefaultWeight = coregatewayhttproutetargetDescWeight.Default.(int)
+	// coregatewayhttproutetargetDescID is the schema descriptor for id field.
+	coregatewayhttproutetargetDescID := coregatewayhttproutetargetMixinFields0[0].Descriptor()
+	// coregatewayhttproutetarget.DefaultID holds the default value on creation for the id field.
+	coregatewayhttproutetarget.DefaultID = coregatewayhttproutetargetDescID.Default.(func() string)
+	// coregatewayhttproutetarget.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	coregatewayhttproutetarget.IDValidator = func() func(string) error {
+		validators := coregatewayhttproutetargetDescID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(id string) error {
+			for _, fn := range fns {
+				if err := fn(id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
 	coregatewayl4listenerMixin := schema.CoreGatewayL4Listener{}.Mixin()
 	coregatewayl4listenerMixinHooks1 := coregatewayl4listenerMixin[1].Hooks()
 	coregatewayl4listener.Hooks[0] = coregatewayl4listenerMixinHooks1[0]
@@ -305,15 +420,15 @@ func init() {
 	// coregatewayl7listener.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	coregatewayl7listener.UpdateDefaultUpdatedAt = coregatewayl7listenerDescUpdatedAt.UpdateDefault.(func() time.Time)
 	// coregatewayl7listenerDescHost is the schema descriptor for host field.
-	coregatewayl7listenerDescHost := coregatewayl7listenerFields[3].Descriptor()
+	coregatewayl7listenerDescHost := coregatewayl7listenerFields[4].Descriptor()
 	// coregatewayl7listener.DefaultHost holds the default value on creation for the host field.
 	coregatewayl7listener.DefaultHost = coregatewayl7listenerDescHost.Default.(string)
 	// coregatewayl7listenerDescEnableTLS is the schema descriptor for enable_tls field.
-	coregatewayl7listenerDescEnableTLS := coregatewayl7listenerFields[4].Descriptor()
+	coregatewayl7listenerDescEnableTLS := coregatewayl7listenerFields[5].Descriptor()
 	// coregatewayl7listener.DefaultEnableTLS holds the default value on creation for the enable_tls field.
 	coregatewayl7listener.DefaultEnableTLS = constant.YesOrNo(coregatewayl7listenerDescEnableTLS.Default.(int8))
 	// coregatewayl7listenerDescStatus is the schema descriptor for status field.
-	coregatewayl7listenerDescStatus := coregatewayl7listenerFields[5].Descriptor()
+	coregatewayl7listenerDescStatus := coregatewayl7listenerFields[8].Descriptor()
 	// coregatewayl7listener.DefaultStatus holds the default value on creation for the status field.
 	coregatewayl7listener.DefaultStatus = constant.YesOrNo(coregatewayl7listenerDescStatus.Default.(int8))
 	// coregatewayl7listenerDescID is the schema descriptor for id field.
@@ -384,6 +499,98 @@ func init() {
 			return nil
 		}
 	}()
+	coregatewayratelimitruleMixin := schema.CoreGatewayRateLimitRule{}.Mixin()
+	coregatewayratelimitruleMixinHooks1 := coregatewayratelimitruleMixin[1].Hooks()
+	coregatewayratelimitrule.Hooks[0] = coregatewayratelimitruleMixinHooks1[0]
+	coregatewayratelimitrule.Hooks[1] = coregatewayratelimitruleMixinHooks1[1]
+	coregatewayratelimitruleMixinFields0 := coregatewayratelimitruleMixin[0].Fields()
+	_ = coregatewayratelimitruleMixinFields0
+	coregatewayratelimitruleMixinFields1 := coregatewayratelimitruleMixin[1].Fields()
+	_ = coregatewayratelimitruleMixinFields1
+	coregatewayratelimitruleFields := schema.CoreGatewayRateLimitRule{}.Fields()
+	_ = coregatewayratelimitruleFields
+	// coregatewayratelimitruleDescCreatedAt is the schema descriptor for created_at field.
+	coregatewayratelimitruleDescCreatedAt := coregatewayratelimitruleMixinFields1[0].Descriptor()
+	// coregatewayratelimitrule.DefaultCreatedAt holds the default value on creation for the created_at field.
+	coregatewayratelimitrule.DefaultCreatedAt = coregatewayratelimitruleDescCreatedAt.Default.(func() time.Time)
+	// coregatewayratelimitruleDescUpdatedAt is the schema descriptor for updated_at field.
+	coregatewayratelimitruleDescUpdatedAt := coregatewayratelimitruleMixinFields1[1].Descriptor()
+	// coregatewayratelimitrule.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	coregatewayratelimitrule.DefaultUpdatedAt = coregatewayratelimitruleDescUpdatedAt.Default.(func() time.Time)
+	// coregatewayratelimitrule.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	coregatewayratelimitrule.UpdateDefaultUpdatedAt = coregatewayratelimitruleDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// coregatewayratelimitruleDescUnit is the schema descriptor for unit field.
+	coregatewayratelimitruleDescUnit := coregatewayratelimitruleFields[3].Descriptor()
+	// coregatewayratelimitrule.DefaultUnit holds the default value on creation for the unit field.
+	coregatewayratelimitrule.DefaultUnit = constant.ProxyRateLimitUnit(coregatewayratelimitruleDescUnit.Default.(int8))
+	// coregatewayratelimitruleDescStatus is the schema descriptor for status field.
+	coregatewayratelimitruleDescStatus := coregatewayratelimitruleFields[5].Descriptor()
+	// coregatewayratelimitrule.DefaultStatus holds the default value on creation for the status field.
+	coregatewayratelimitrule.DefaultStatus = constant.YesOrNo(coregatewayratelimitruleDescStatus.Default.(int8))
+	// coregatewayratelimitruleDescID is the schema descriptor for id field.
+	coregatewayratelimitruleDescID := coregatewayratelimitruleMixinFields0[0].Descriptor()
+	// coregatewayratelimitrule.DefaultID holds the default value on creation for the id field.
+	coregatewayratelimitrule.DefaultID = coregatewayratelimitruleDescID.Default.(func() string)
+	// coregatewayratelimitrule.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	coregatewayratelimitrule.IDValidator = func() func(string) error {
+		validators := coregatewayratelimitruleDescID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(id string) error {
+			for _, fn := range fns {
+				if err := fn(id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	coregatewayvirtualhostMixin := schema.CoreGatewayVirtualHost{}.Mixin()
+	coregatewayvirtualhostMixinHooks1 := coregatewayvirtualhostMixin[1].Hooks()
+	coregatewayvirtualhost.Hooks[0] = coregatewayvirtualhostMixinHooks1[0]
+	coregatewayvirtualhost.Hooks[1] = coregatewayvirtualhostMixinHooks1[1]
+	coregatewayvirtualhostMixinFields0 := coregatewayvirtualhostMixin[0].Fields()
+	_ = coregatewayvirtualhostMixinFields0
+	coregatewayvirtualhostMixinFields1 := coregatewayvirtualhostMixin[1].Fields()
+	_ = coregatewayvirtualhostMixinFields1
+	coregatewayvirtualhostFields := schema.CoreGatewayVirtualHost{}.Fields()
+	_ = coregatewayvirtualhostFields
+	// coregatewayvirtualhostDescCreatedAt is the schema descriptor for created_at field.
+	coregatewayvirtualhostDescCreatedAt := coregatewayvirtualhostMixinFields1[0].Descriptor()
+	// coregatewayvirtualhost.DefaultCreatedAt holds the default value on creation for the created_at field.
+	coregatewayvirtualhost.DefaultCreatedAt = coregatewayvirtualhostDescCreatedAt.Default.(func() time.Time)
+	// coregatewayvirtualhostDescUpdatedAt is the schema descriptor for updated_at field.
+	coregatewayvirtualhostDescUpdatedAt := coregatewayvirtualhostMixinFields1[1].Descriptor()
+	// coregatewayvirtualhost.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	coregatewayvirtualhost.DefaultUpdatedAt = coregatewayvirtualhostDescUpdatedAt.Default.(func() time.Time)
+	// coregatewayvirtualhost.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	coregatewayvirtualhost.UpdateDefaultUpdatedAt = coregatewayvirtualhostDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// coregatewayvirtualhostDescStatus is the schema descriptor for status field.
+	coregatewayvirtualhostDescStatus := coregatewayvirtualhostFields[7].Descriptor()
+	// coregatewayvirtualhost.DefaultStatus holds the default value on creation for the status field.
+	coregatewayvirtualhost.DefaultStatus = constant.YesOrNo(coregatewayvirtualhostDescStatus.Default.(int8))
+	// coregatewayvirtualhostDescID is the schema descriptor for id field.
+	coregatewayvirtualhostDescID := coregatewayvirtualhostMixinFields0[0].Descriptor()
+	// coregatewayvirtualhost.DefaultID holds the default value on creation for the id field.
+	coregatewayvirtualhost.DefaultID = coregatewayvirtualhostDescID.Default.(func() string)
+	// coregatewayvirtualhost.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	coregatewayvirtualhost.IDValidator = func() func(string) error {
+		validators := coregatewayvirtualhostDescID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(id string) error {
+			for _, fn := range fns {
+				if err := fn(id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
 	coremenuMixin := schema.CoreMenu{}.Mixin()
 	coremenuMixinHooks1 := coremenuMixin[1].Hooks()
 	coremenu.Hooks[0] = coremenuMixinHooks1[0]
@@ -524,6 +731,154 @@ func init() {
 			return nil
 		}
 	}()
+	coreproxyrevisionMixin := schema.CoreProxyRevision{}.Mixin()
+	coreproxyrevisionMixinHooks1 := coreproxyrevisionMixin[1].Hooks()
+	coreproxyrevision.Hooks[0] = coreproxyrevisionMixinHooks1[0]
+	coreproxyrevision.Hooks[1] = coreproxyrevisionMixinHooks1[1]
+	coreproxyrevisionMixinFields0 := coreproxyrevisionMixin[0].Fields()
+	_ = coreproxyrevisionMixinFields0
+	coreproxyrevisionMixinFields1 := coreproxyrevisionMixin[1].Fields()
+	_ = coreproxyrevisionMixinFields1
+	coreproxyrevisionFields := schema.CoreProxyRevision{}.Fields()
+	_ = coreproxyrevisionFields
+	// coreproxyrevisionDescCreatedAt is the schema descriptor for created_at field.
+	coreproxyrevisionDescCreatedAt := coreproxyrevisionMixinFields1[0].Descriptor()
+	// coreproxyrevision.DefaultCreatedAt holds the default value on creation for the created_at field.
+	coreproxyrevision.DefaultCreatedAt = coreproxyrevisionDescCreatedAt.Default.(func() time.Time)
+	// coreproxyrevisionDescUpdatedAt is the schema descriptor for updated_at field.
+	coreproxyrevisionDescUpdatedAt := coreproxyrevisionMixinFields1[1].Descriptor()
+	// coreproxyrevision.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	coreproxyrevision.DefaultUpdatedAt = coreproxyrevisionDescUpdatedAt.Default.(func() time.Time)
+	// coreproxyrevision.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	coreproxyrevision.UpdateDefaultUpdatedAt = coreproxyrevisionDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// coreproxyrevisionDescAction is the schema descriptor for action field.
+	coreproxyrevisionDescAction := coreproxyrevisionFields[2].Descriptor()
+	// coreproxyrevision.DefaultAction holds the default value on creation for the action field.
+	coreproxyrevision.DefaultAction = constant.ProxyRevisionAction(coreproxyrevisionDescAction.Default.(int8))
+	// coreproxyrevisionDescID is the schema descriptor for id field.
+	coreproxyrevisionDescID := coreproxyrevisionMixinFields0[0].Descriptor()
+	// coreproxyrevision.DefaultID holds the default value on creation for the id field.
+	coreproxyrevision.DefaultID = coreproxyrevisionDescID.Default.(func() string)
+	// coreproxyrevision.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	coreproxyrevision.IDValidator = func() func(string) error {
+		validators := coreproxyrevisionDescID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(id string) error {
+			for _, fn := range fns {
+				if err := fn(id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	coreproxyrolloutMixin := schema.CoreProxyRollout{}.Mixin()
+	coreproxyrolloutMixinHooks1 := coreproxyrolloutMixin[1].Hooks()
+	coreproxyrollout.Hooks[0] = coreproxyrolloutMixinHooks1[0]
+	coreproxyrollout.Hooks[1] = coreproxyrolloutMixinHooks1[1]
+	coreproxyrolloutMixinFields0 := coreproxyrolloutMixin[0].Fields()
+	_ = coreproxyrolloutMixinFields0
+	coreproxyrolloutMixinFields1 := coreproxyrolloutMixin[1].Fields()
+	_ = coreproxyrolloutMixinFields1
+	coreproxyrolloutFields := schema.CoreProxyRollout{}.Fields()
+	_ = coreproxyrolloutFields
+	// coreproxyrolloutDescCreatedAt is the schema descriptor for created_at field.
+	coreproxyrolloutDescCreatedAt := coreproxyrolloutMixinFields1[0].Descriptor()
+	// coreproxyrollout.DefaultCreatedAt holds the default value on creation for the created_at field.
+	coreproxyrollout.DefaultCreatedAt = coreproxyrolloutDescCreatedAt.Default.(func() time.Time)
+	// coreproxyrolloutDescUpdatedAt is the schema descriptor for updated_at field.
+	coreproxyrolloutDescUpdatedAt := coreproxyrolloutMixinFields1[1].Descriptor()
+	// coreproxyrollout.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	coreproxyrollout.DefaultUpdatedAt = coreproxyrolloutDescUpdatedAt.Default.(func() time.Time)
+	// coreproxyrollout.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	coreproxyrollout.UpdateDefaultUpdatedAt = coreproxyrolloutDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// coreproxyrolloutDescStatus is the schema descriptor for status field.
+	coreproxyrolloutDescStatus := coreproxyrolloutFields[10].Descriptor()
+	// coreproxyrollout.DefaultStatus holds the default value on creation for the status field.
+	coreproxyrollout.DefaultStatus = constant.ProxyRolloutStatus(coreproxyrolloutDescStatus.Default.(int8))
+	// coreproxyrolloutDescID is the schema descriptor for id field.
+	coreproxyrolloutDescID := coreproxyrolloutMixinFields0[0].Descriptor()
+	// coreproxyrollout.DefaultID holds the default value on creation for the id field.
+	coreproxyrollout.DefaultID = coreproxyrolloutDescID.Default.(func() string)
+	// coreproxyrollout.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	coreproxyrollout.IDValidator = func() func(string) error {
+		validators := coreproxyrolloutDescID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(id string) error {
+			for _, fn := range fns {
+				if err := fn(id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	coreproxyrolloutpolicyMixin := schema.CoreProxyRolloutPolicy{}.Mixin()
+	coreproxyrolloutpolicyMixinHooks1 := coreproxyrolloutpolicyMixin[1].Hooks()
+	coreproxyrolloutpolicy.Hooks[0] = coreproxyrolloutpolicyMixinHooks1[0]
+	coreproxyrolloutpolicy.Hooks[1] = coreproxyrolloutpolicyMixinHooks1[1]
+	coreproxyrolloutpolicyMixinFields0 := coreproxyrolloutpolicyMixin[0].Fields()
+	_ = coreproxyrolloutpolicyMixinFields0
+	coreproxyrolloutpolicyMixinFields1 := coreproxyrolloutpolicyMixin[1].Fields()
+	_ = coreproxyrolloutpolicyMixinFields1
+	coreproxyrolloutpolicyFields := schema.CoreProxyRolloutPolicy{}.Fields()
+	_ = coreproxyrolloutpolicyFields
+	// coreproxyrolloutpolicyDescCreatedAt is the schema descriptor for created_at field.
+	coreproxyrolloutpolicyDescCreatedAt := coreproxyrolloutpolicyMixinFields1[0].Descriptor()
+	// coreproxyrolloutpolicy.DefaultCreatedAt holds the default value on creation for the created_at field.
+	coreproxyrolloutpolicy.DefaultCreatedAt = coreproxyrolloutpolicyDescCreatedAt.Default.(func() time.Time)
+	// coreproxyrolloutpolicyDescUpdatedAt is the schema descriptor for updated_at field.
+	coreproxyrolloutpolicyDescUpdatedAt := coreproxyrolloutpolicyMixinFields1[1].Descriptor()
+	// coreproxyrolloutpolicy.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	coreproxyrolloutpolicy.DefaultUpdatedAt = coreproxyrolloutpolicyDescUpdatedAt.Default.(func() time.Time)
+	// coreproxyrolloutpolicy.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	coreproxyrolloutpolicy.UpdateDefaultUpdatedAt = coreproxyrolloutpolicyDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// coreproxyrolloutpolicyDescPercentage is the schema descriptor for percentage field.
+	coreproxyrolloutpolicyDescPercentage := coreproxyrolloutpolicyFields[1].Descriptor()
+	// coreproxyrolloutpolicy.DefaultPercentage holds the default value on creation for the percentage field.
+	coreproxyrolloutpolicy.DefaultPercentage = coreproxyrolloutpolicyDescPercentage.Default.(int)
+	// coreproxyrolloutpolicyDescSoakSeconds is the schema descriptor for soak_seconds field.
+	coreproxyrolloutpolicyDescSoakSeconds := coreproxyrolloutpolicyFields[3].Descriptor()
+	// coreproxyrolloutpolicy.DefaultSoakSeconds holds the default value on creation for the soak_seconds field.
+	coreproxyrolloutpolicy.DefaultSoakSeconds = coreproxyrolloutpolicyDescSoakSeconds.Default.(int)
+	// coreproxyrolloutpolicyDescMaxErrorRate is the schema descriptor for max_error_rate field.
+	coreproxyrolloutpolicyDescMaxErrorRate := coreproxyrolloutpolicyFields[4].Descriptor()
+	// coreproxyrolloutpolicy.DefaultMaxErrorRate holds the default value on creation for the max_error_rate field.
+	coreproxyrolloutpolicy.DefaultMaxErrorRate = coreproxyrolloutpolicyDescMaxErrorRate.Default.(float64)
+	// coreproxyrolloutpolicyDescMinRequests is the schema descriptor for min_requests field.
+	coreproxyrolloutpolicyDescMinRequests := coreproxyrolloutpolicyFields[5].Descriptor()
+	// coreproxyrolloutpolicy.DefaultMinRequests holds the default value on creation for the min_requests field.
+	coreproxyrolloutpolicy.DefaultMinRequests = coreproxyrolloutpolicyDescMinRequests.Default.(int64)
+	// coreproxyrolloutpolicyDescStatus is the schema descriptor for status field.
+	coreproxyrolloutpolicyDescStatus := coreproxyrolloutpolicyFields[6].Descriptor()
+	// coreproxyrolloutpolicy.DefaultStatus holds the default value on creation for the status field.
+	coreproxyrolloutpolicy.DefaultStatus = constant.YesOrNo(coreproxyrolloutpolicyDescStatus.Default.(int8))
+	// coreproxyrolloutpolicyDescID is the schema descriptor for id field.
+	coreproxyrolloutpolicyDescID := coreproxyrolloutpolicyMixinFields0[0].Descriptor()
+	// coreproxyrolloutpolicy.DefaultID holds the default value on creation for the id field.
+	coreproxyrolloutpolicy.DefaultID = coreproxyrolloutpolicyDescID.Default.(func() string)
+	// coreproxyrolloutpolicy.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	coreproxyrolloutpolicy.IDValidator = func() func(string) error {
+		validators := coreproxyrolloutpolicyDescID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(id string) error {
+			for _, fn := range fns {
+				if err := fn(id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
 	coreroleMixin := schema.CoreRole{}.Mixin()
 	coreroleMixinHooks1 := coreroleMixin[1].Hooks()
 	corerole.Hooks[0] = coreroleMixinHooks1[0]
@@ -596,28 +951,40 @@ func init() {
 	coreupstreamDescLbPolicy := coreupstreamFields[2].Descriptor()
 	// coreupstream.DefaultLbPolicy holds the default value on creation for the lb_policy field.
 	coreupstream.DefaultLbPolicy = constant.ProxyLbPolicy(coreupstreamDescLbPolicy.Default.(int8))
+	// coreupstreamDescRingMinSize is the schema descriptor for ring_min_size field.
+	coreupstreamDescRingMinSize := coreupstreamFields[3].Descriptor()
+	// coreupstream.DefaultRingMinSize holds the default value on creation for the ring_min_size field.
+	coreupstream.DefaultRingMinSize = coreupstreamDescRingMinSize.Default.(uint64)
+	// coreupstreamDescRingMaxSize is the schema descriptor for ring_max_size field.
+	coreupstreamDescRingMaxSize := coreupstreamFields[4].Descriptor()
+	// coreupstream.DefaultRingMaxSize holds the default value on creation for the ring_max_size field.
+	coreupstream.DefaultRingMaxSize = coreupstreamDescRingMaxSize.Default.(uint64)
+	// coreupstreamDescMaglevTableSize is the schema descriptor for maglev_table_size field.
+	coreupstreamDescMaglevTableSize := coreupstreamFields[5].Descriptor()
+	// coreupstream.DefaultMaglevTableSize holds the default value on creation for the maglev_table_size field.
+	coreupstream.DefaultMaglevTableSize = coreupstreamDescMaglevTableSize.Default.(uint64)
 	// coreupstreamDescConnectTimeoutMs is the schema descriptor for connect_timeout_ms field.
-	coreupstreamDescConnectTimeoutMs := coreupstreamFields[3].Descriptor()
+	coreupstreamDescConnectTimeoutMs := coreupstreamFields[6].Descriptor()
 	// coreupstream.DefaultConnectTimeoutMs holds the default value on creation for the connect_timeout_ms field.
 	coreupstream.DefaultConnectTimeoutMs = coreupstreamDescConnectTimeoutMs.Default.(int)
 	// coreupstreamDescMaxConnections is the schema descriptor for max_connections field.
-	coreupstreamDescMaxConnections := coreupstreamFields[4].Descriptor()
+	coreupstreamDescMaxConnections := coreupstreamFields[7].Descriptor()
 	// coreupstream.DefaultMaxConnections holds the default value on creation for the max_connections field.
 	coreupstream.DefaultMaxConnections = coreupstreamDescMaxConnections.Default.(int)
 	// coreupstreamDescMaxPendingRequests is the schema descriptor for max_pending_requests field.
-	coreupstreamDescMaxPendingRequests := coreupstreamFields[5].Descriptor()
+	coreupstreamDescMaxPendingRequests := coreupstreamFields[8].Descriptor()
 	// coreupstream.DefaultMaxPendingRequests holds the default value on creation for the max_pending_requests field.
 	coreupstream.DefaultMaxPendingRequests = coreupstreamDescMaxPendingRequests.Default.(int)
 	// coreupstreamDescMaxRequests is the schema descriptor for max_requests field.
-	coreupstreamDescMaxRequests := coreupstreamFields[6].Descriptor()
+	coreupstreamDescMaxRequests := coreupstreamFields[9].Descriptor()
 	// coreupstream.DefaultMaxRequests holds the default value on creation for the max_requests field.
 	coreupstream.DefaultMaxRequests = coreupstreamDescMaxRequests.Default.(int)
 	// coreupstreamDescMaxRetries is the schema descriptor for max_retries field.
-	coreupstreamDescMaxRetries := coreupstreamFields[7].Descriptor()
+	coreupstreamDescMaxRetries := coreupstreamFields[10].Descriptor()
 	// coreupstream.DefaultMaxRetries holds the default value on creation for the max_retries field.
 	coreupstream.DefaultMaxRetries = coreupstreamDescMaxRetries.Default.(int)
 	// coreupstreamDescStatus is the schema descriptor for status field.
-	coreupstreamDescStatus := coreupstreamFields[8].Descriptor()
+	coreupstreamDescStatus := coreupstreamFields[11].Descriptor()
 	// coreupstream.DefaultStatus holds the default value on creation for the status field.
 	coreupstream.DefaultStatus = constant.YesOrNo(coreupstreamDescStatus.Default.(int8))
 	// coreupstreamDescID is the schema descriptor for id field.
@@ -661,11 +1028,11 @@ func init() {
 	// coreupstreamhost.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	coreupstreamhost.UpdateDefaultUpdatedAt = coreupstreamhostDescUpdatedAt.UpdateDefault.(func() time.Time)
 	// coreupstreamhostDescWeight is the schema descriptor for weight field.
-	coreupstreamhostDescWeight := coreupstreamhostFields[1].Descriptor()
+	coreupstreamhostDescWeight := coreupstreamhostFields[2].Descriptor()
 	// coreupstreamhost.DefaultWeight holds the default value on creation for the weight field.
 	coreupstreamhost.DefaultWeight = coreupstreamhostDescWeight.Default.(int)
 	// coreupstreamhostDescEnabled is the schema descriptor for enabled field.
-	coreupstreamhostDescEnabled := coreupstreamhostFields[3].Descriptor()
+	coreupstreamhostDescEnabled := coreupstreamhostFields[4].Descriptor()
 	// coreupstreamhost.DefaultEnabled holds the default value on creation for the enabled field.
 	coreupstreamhost.DefaultEnabled = constant.YesOrNo(coreupstreamhostDescEnabled.Default.(int8))
 	// coreupstreamhostDescID is the schema descriptor for id field.
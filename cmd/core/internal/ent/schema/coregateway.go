@@ -0,0 +1,70 @@
+package schema
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/lyonmu/quebec/cmd/core/internal/global"
+	"github.com/lyonmu/quebec/pkg/constant"
+	"github.com/lyonmu/quebec/pkg/tools"
+)
+
+// CoreGateway holds the schema definition for the CoreGateway entity.
+type CoreGateway struct {
+	ent.Schema
+}
+
+// Fields of the CoreGateway.
+func (CoreGateway) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("gateway_id").Optional().Comment("网关ID").Unique(),
+		field.Int64("applied_revision").Optional().Comment("网关已应用的配置修订号"),
+		field.Int64("rejected_revision").Optional().Comment("最近被网关拒绝的配置修订号"),
+		field.String("last_error").Optional().Comment("最近一次配置被拒绝的原因，为空表示最新配置已应用"),
+		field.Int64("last_sync_time").Optional().Comment("最近一次配置回执时间").DefaultFunc(func() int64 { return time.Now().Unix() }),
+		field.Int8("status").Optional().GoType(constant.YesOrNo(1)).Optional().Comment("是否在线 [1: 在线, 2: 离线]").Default(int8(constant.Yes)),
+		field.Int8("global_rate_limit").Optional().GoType(constant.YesOrNo(1)).Comment("是否提供全局限流服务 [1: 是, 2: 否]，未提供时路由的全局限流不生效").Default(int8(constant.No)),
+	}
+}
+
+// Edges of the CoreGateway.
+func (CoreGateway) Edges() []ent.Edge {
+	return []ent.Edge{}
+}
+
+func (CoreGateway) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		tools.NewIDMixin(func() string {
+			return fmt.Sprintf("%d", global.Id.GenID())
+		}),
+		tools.TimeMixin{},
+	}
+}
+
+func (CoreGateway) Indexes() []ent.Index {
+	// 时间戳字段的索引由 TimeMixin 提供
+	return []ent.Index{
+		index.Fields("gateway_id"),
+		index.Fields("status"),
+	}
+}
+
+func (CoreGateway) Annotations() []schema.Annotation {
+	withCommentsEnabled := true
+	return []schema.Annotation{
+		schema.Comment("网关实例配置同步状态表"),
+		entsql.Annotation{
+			Table:        fmt.Sprintf("%s_core_gateway", constant.ProjectName),
+			Charset:      "utf8mb4",
+			Collation:    "utf8mb4_general_ci",
+			WithComments: &withCommentsEnabled,
+		},
+		edge.Annotation{StructTag: `json:"-" gorm:"-"`},
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
+	"github.com/lyonmu/quebec/cmd/core/internal/common"
 	"github.com/lyonmu/quebec/cmd/core/internal/global"
 	"github.com/lyonmu/quebec/pkg/constant"
 	"github.com/lyonmu/quebec/pkg/tools"
@@ -27,6 +28,7 @@ func (CoreGatewayCluster) Fields() []ent.Field {
 		field.Int64("gateway_id").Optional().Comment("网关ID"),
 		field.Int64("cluster_create_time").Optional().Comment("创建时间").DefaultFunc(func() int64 { return time.Now().Unix() }),
 		field.Int64("cluster_last_request_time").Optional().Comment("最新请求时间").DefaultFunc(func() int64 { return time.Now().Unix() }),
+		field.JSON("maintenance", &common.Maintenance{}).Optional().Comment("维护模式，作用于集群内未单独开启维护模式的虚拟主机"),
 	}
 }
 
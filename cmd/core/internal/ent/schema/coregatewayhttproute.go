@@ -10,6 +10,7 @@ import (
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
+	"github.com/lyonmu/quebec/cmd/core/internal/common"
 	"github.com/lyonmu/quebec/cmd/core/internal/global"
 	"github.com/lyonmu/quebec/pkg/constant"
 	"github.com/lyonmu/quebec/pkg/tools"
@@ -25,9 +26,28 @@ func (CoreGatewayHttpRoute) Fields() []ent.Field {
 	return []ent.Field{
 		field.String("name").Optional().Comment("路由名称"),
 		field.String("description").Optional().Comment("路由描述"),
+		field.String("upstream_id").Optional().Comment("目标上游服务ID，配置加权分流时为权重最大的目标"),
+		field.String("cluster_id").Optional().Comment("所属网关集群ID(Envoy node.cluster)，为空表示所有集群"),
+		field.String("virtual_host_id").Optional().Comment("所属虚拟主机ID，为空表示所有监听器的默认虚拟主机(*)"),
 		field.Int8("match_type").GoType(constant.ProxyHttpRouteMatchType(1)).Optional().Comment("匹配类型: 1-前缀 2-精确 3-正则").Default(int8(constant.HttpRouteMatchTypePrefix)),
 		field.String("match_pattern").Optional().Comment("匹配规则，如 /api/v1/*"),
+		field.Int("priority").Optional().Comment("匹配优先级，数值越大越先匹配；相同优先级时按匹配的精确程度排序").Default(0),
+		field.Int8("ignore_case").Optional().GoType(constant.YesOrNo(1)).Optional().Comment("路径匹配是否忽略大小写 [1-是 2-否]").Default(int8(constant.No)),
+		field.JSON("methods", []string{}).Optional().Comment("匹配的 HTTP 方法，为空表示不限制"),
+		field.JSON("header_matchers", []common.HttpMatcher{}).Optional().Comment("请求头匹配条件，全部满足时路由才匹配"),
+		field.JSON("query_matchers", []common.HttpMatcher{}).Optional().Comment("查询参数匹配条件，全部满足时路由才匹配"),
 		field.Int("timeout_ms").Optional().Comment("路由超时(毫秒，默认15000=15秒)，包括所有重试").Default(constant.DefaultRouteTimeoutMs),
+		field.JSON("retry_policy", &common.RetryPolicy{}).Optional().Comment("重试策略，为空表示不重试"),
+		field.JSON("header_mutation", &common.HeaderMutation{}).Optional().Comment("请求头与响应头改写"),
+		field.String("mirror_upstream_id").Optional().Comment("流量镜像的影子上游服务ID，为空表示不镜像"),
+		field.Float("mirror_percent").Optional().Comment("镜像的请求比例(%)，支持两位小数"),
+		field.JSON("fault_policy", &common.FaultPolicy{}).Optional().Comment("故障注入，到期后自动失效"),
+		field.JSON("cors_policy", &common.CorsPolicy{}).Optional().Comment("跨域策略，为空时沿用虚拟主机的跨域策略"),
+		field.JSON("local_rate_limit", &common.LocalRateLimit{}).Optional().Comment("本地限流，为空时沿用监听器的本地限流"),
+		field.JSON("rate_limit_actions", []common.RateLimitAction{}).Optional().Comment("全局限流描述符条目，为空表示不进行全局限流"),
+		field.JSON("direct_response", &common.DirectResponse{}).Optional().Comment("直接响应，设置后不转发到上游服务"),
+		field.JSON("hash_policies", []common.HashPolicy{}).Optional().Comment("一致性哈希的哈希策略，上游服务使用 RING_HASH 或 MAGLEV 时生效"),
+		field.Int8("disable_compression").Optional().GoType(constant.YesOrNo(1)).Optional().Comment("是否关闭响应压缩 [1-是 2-否]").Default(int8(constant.No)),
 		field.Int8("enable_path_rewrite").Optional().GoType(constant.YesOrNo(1)).Optional().Comment("是否启用路径重写 [1-启用 2-禁用]").Default(int8(constant.No)),
 		field.String("path_rewrite").Optional().Comment("路径重写规则，如 /api/v1/* /v1/*"),
 		field.Int8("enable_redirect").Optional().GoType(constant.YesOrNo(1)).Optional().Comment("是否启用重定向 [1-启用 2-禁用]").Default(int8(constant.No)),
@@ -39,7 +59,11 @@ func (CoreGatewayHttpRoute) Fields() []ent.Field {
 
 // Edges of the CoreGatewayHttpRoute.
 func (CoreGatewayHttpRoute) Edges() []ent.Edge {
-	return []ent.Edge{}
+	return []ent.Edge{
+		edge.From("route_from_upstream", CoreUpstream.Type).Ref("upstream_to_route").Field("upstream_id").Unique(),
+		edge.To("route_to_target", CoreGatewayHttpRouteTarget.Type),
+		edge.From("route_from_vhost", CoreGatewayVirtualHost.Type).Ref("vhost_to_route").Field("virtual_host_id").Unique(),
+	}
 }
 
 func (CoreGatewayHttpRoute) Mixin() []ent.Mixin {
@@ -53,7 +77,11 @@ func (CoreGatewayHttpRoute) Mixin() []ent.Mixin {
 
 func (CoreGatewayHttpRoute) Indexes() []ent.Index {
 	return []ent.Index{
+		index.Fields("upstream_id"),
+		index.Fields("cluster_id"),
+		index.Fields("virtual_host_id"),
 		index.Fields("match_type"),
+		index.Fields("priority"),
 		index.Fields("timeout_ms"),
 		index.Fields("enable_path_rewrite"),
 		index.Fields("enable_redirect"),
@@ -0,0 +1,67 @@
+package schema
+
+import (
+	"fmt"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/lyonmu/quebec/cmd/core/internal/global"
+	"github.com/lyonmu/quebec/pkg/constant"
+	"github.com/lyonmu/quebec/pkg/tools"
+)
+
+// CoreGatewayHttpRouteTarget holds the schema definition for the HTTP Route Target entity.
+type CoreGatewayHttpRouteTarget struct {
+	ent.Schema
+}
+
+// Fields of the CoreGatewayHttpRouteTarget.
+func (CoreGatewayHttpRouteTarget) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("route_id").Optional().Comment("HTTP路由ID"),
+		field.String("upstream_id").Optional().Comment("目标上游服务ID"),
+		field.Int("weight").Optional().Comment("流量权重(%)，同一路由的权重之和为100").Default(0),
+	}
+}
+
+// Edges of the CoreGatewayHttpRouteTarget.
+func (CoreGatewayHttpRouteTarget) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("target_from_route", CoreGatewayHttpRoute.Type).Ref("route_to_target").Field("route_id").Unique(),
+		edge.From("target_from_upstream", CoreUpstream.Type).Ref("upstream_to_target").Field("upstream_id").Unique(),
+	}
+}
+
+func (CoreGatewayHttpRouteTarget) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		tools.NewIDMixin(func() string {
+			return fmt.Sprintf("%d", global.Id.GenID())
+		}),
+		tools.TimeMixin{},
+	}
+}
+
+func (CoreGatewayHttpRouteTarget) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("route_id"),
+		index.Fields("upstream_id"),
+	}
+}
+
+func (CoreGatewayHttpRouteTarget) Annotations() []schema.Annotation {
+	withCommentsEnabled := true
+	return []schema.Annotation{
+		schema.Comment("HTTP路由加权分流目标表"),
+		entsql.Annotation{
+			Table:        fmt.Sprintf("%s_core_gateway_http_route_target", constant.ProjectName),
+			Charset:      "utf8mb4",
+			Collation:    "utf8mb4_general_ci",
+			WithComments: &withCommentsEnabled,
+		},
+		edge.Annotation{StructTag: `json:"-" gorm:"-"`},
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
+	"github.com/lyonmu/quebec/cmd/core/internal/common"
 	"github.com/lyonmu/quebec/cmd/core/internal/global"
 	"github.com/lyonmu/quebec/pkg/constant"
 	"github.com/lyonmu/quebec/pkg/tools"
@@ -24,16 +25,21 @@ func (CoreGatewayL7Listener) Fields() []ent.Field {
 	return []ent.Field{
 		field.String("name").Optional().Comment("监听器名称"),
 		field.String("description").Optional().Comment("监听器描述"),
+		field.String("cluster_id").Optional().Comment("所属网关集群ID(Envoy node.cluster)，为空表示所有集群"),
 		field.Uint16("port").Optional().Comment("监听端口"),
 		field.String("host").Optional().Comment("监听地址").Default("0.0.0.0"),
 		field.Int8("enable_tls").Optional().GoType(constant.YesOrNo(1)).Optional().Comment("是否启用TLS [1: 启用, 2: 禁用]").Default(int8(constant.No)),
+		field.JSON("local_rate_limit", &common.LocalRateLimit{}).Optional().Comment("本地限流，作用于监听器上未单独配置限流的路由"),
+		field.JSON("compression", &common.Compression{}).Optional().Comment("响应压缩，为空表示不压缩"),
 		field.Int8("status").Optional().GoType(constant.YesOrNo(1)).Optional().Comment("是否启用 [1: 启用, 2: 禁用]").Default(int8(constant.Yes)),
 	}
 }
 
 // Edges of the CoreGatewayL7Listener.
 func (CoreGatewayL7Listener) Edges() []ent.Edge {
-	return []ent.Edge{}
+	return []ent.Edge{
+		edge.To("listener_to_vhost", CoreGatewayVirtualHost.Type),
+	}
 }
 
 func (CoreGatewayL7Listener) Mixin() []ent.Mixin {
@@ -47,6 +53,7 @@ func (CoreGatewayL7Listener) Mixin() []ent.Mixin {
 
 func (CoreGatewayL7Listener) Indexes() []ent.Index {
 	return []ent.Index{
+		index.Fields("cluster_id"),
 		index.Fields("host"),
 		index.Fields("port"),
 		index.Fields("enable_tls"),
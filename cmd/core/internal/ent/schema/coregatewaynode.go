@@ -10,6 +10,7 @@ import (
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
+	"github.com/lyonmu/quebec/cmd/core/internal/common"
 	"github.com/lyonmu/quebec/cmd/core/internal/global"
 	"github.com/lyonmu/quebec/pkg/constant"
 	"github.com/lyonmu/quebec/pkg/tools"
@@ -28,6 +29,11 @@ func (CoreGatewayNode) Fields() []ent.Field {
 		field.Int64("gateway_id").Optional().Comment("网关ID"),
 		field.Int64("node_register_time").Optional().Comment("注册时间").DefaultFunc(func() int64 { return time.Now().Unix() }),
 		field.Int64("node_last_request_time").Optional().Comment("最新请求时间").DefaultFunc(func() int64 { return time.Now().Unix() }),
+		field.Int64("applied_revision").Optional().Comment("最近 ACK 的配置修订号"),
+		field.String("last_error").Optional().Comment("最近一次 NACK 错误信息，为空表示配置均已接受"),
+		field.JSON("xds_status", map[string]common.XdsStatus{}).Optional().Comment("各类型 xDS 资源的回执状态，按 type_url 索引"),
+		field.Int64("request_total").Optional().Comment("累计请求数，来自访问日志"),
+		field.Int64("error_total").Optional().Comment("累计 5xx 响应数，来自访问日志"),
 	}
 }
 
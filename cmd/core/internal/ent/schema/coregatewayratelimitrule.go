@@ -0,0 +1,65 @@
+package schema
+
+import (
+	"fmt"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/lyonmu/quebec/cmd/core/internal/common"
+	"github.com/lyonmu/quebec/cmd/core/internal/global"
+	"github.com/lyonmu/quebec/pkg/constant"
+	"github.com/lyonmu/quebec/pkg/tools"
+)
+
+// CoreGatewayRateLimitRule holds the schema definition for the Global Rate Limit Rule entity.
+type CoreGatewayRateLimitRule struct {
+	ent.Schema
+}
+
+// Fields of the CoreGatewayRateLimitRule.
+func (CoreGatewayRateLimitRule) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").Optional().Comment("限流规则名称"),
+		field.String("description").Optional().Comment("限流规则描述"),
+		field.JSON("descriptors", []common.RateLimitDescriptor{}).Optional().Comment("匹配的描述符条目，需与路由的全局限流描述符条目顺序一致"),
+		field.Int8("unit").Optional().GoType(constant.ProxyRateLimitUnit(1)).Comment("计数周期 [1: 秒, 2: 分钟, 3: 小时, 4: 天]").Default(int8(constant.RateLimitUnitMinute)),
+		field.Uint32("requests_per_unit").Optional().Comment("每个计数周期允许的请求数"),
+		field.Int8("status").Optional().GoType(constant.YesOrNo(1)).Optional().Comment("是否启用 [1: 启用, 2: 禁用]").Default(int8(constant.Yes)),
+	}
+}
+
+// Edges of the CoreGatewayRateLimitRule.
+func (CoreGatewayRateLimitRule) Edges() []ent.Edge {
+	return []ent.Edge{}
+}
+
+func (CoreGatewayRateLimitRule) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		tools.NewIDMixin(func() string {
+			return fmt.Sprintf("%d", global.Id.GenID())
+		}),
+		tools.TimeMixin{},
+	}
+}
+
+func (CoreGatewayRateLimitRule) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("status"),
+	}
+}
+
+func (CoreGatewayRateLimitRule) Annotations() []schema.Annotation {
+	withCommentsEnabled := true
+	return []schema.Annotation{
+		schema.Comment("全局限流规则表"),
+		entsql.Annotation{
+			Table:        fmt.Sprintf("%s_core_gateway_rate_limit_rule", constant.ProjectName),
+			Charset:      "utf8mb4",
+			Collation:    "utf8mb4_general_ci",
+			WithComments: &withCommentsEnabled,
+		},
+	}
+}
@@ -0,0 +1,73 @@
+package schema
+
+import (
+	"fmt"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/lyonmu/quebec/cmd/core/internal/common"
+	"github.com/lyonmu/quebec/cmd/core/internal/global"
+	"github.com/lyonmu/quebec/pkg/constant"
+	"github.com/lyonmu/quebec/pkg/tools"
+)
+
+// CoreGatewayVirtualHost holds the schema definition for the Virtual Host entity.
+type CoreGatewayVirtualHost struct {
+	ent.Schema
+}
+
+// Fields of the CoreGatewayVirtualHost.
+func (CoreGatewayVirtualHost) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").Optional().Comment("虚拟主机名称"),
+		field.String("description").Optional().Comment("虚拟主机描述"),
+		field.String("listener_id").Optional().Comment("所属L7监听器ID"),
+		field.JSON("domains", []string{}).Optional().Comment("匹配的域名，支持 *.example.com、example.* 与 *，同一监听器内不能重复"),
+		field.JSON("header_mutation", &common.HeaderMutation{}).Optional().Comment("请求头与响应头改写，作用于虚拟主机下所有路由"),
+		field.JSON("cors_policy", &common.CorsPolicy{}).Optional().Comment("跨域策略，作用于虚拟主机下未单独配置的路由"),
+		field.JSON("maintenance", &common.Maintenance{}).Optional().Comment("维护模式，优先于网关集群的维护模式"),
+		field.Int8("status").Optional().GoType(constant.YesOrNo(1)).Optional().Comment("是否启用 [1: 启用, 2: 禁用]").Default(int8(constant.Yes)),
+	}
+}
+
+// Edges of the CoreGatewayVirtualHost.
+func (CoreGatewayVirtualHost) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("vhost_from_listener", CoreGatewayL7Listener.Type).Ref("listener_to_vhost").Field("listener_id").Unique(),
+		edge.To("vhost_to_route", CoreGatewayHttpRoute.Type),
+	}
+}
+
+func (CoreGatewayVirtualHost) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		tools.NewIDMixin(func() string {
+			return fmt.Sprintf("%d", global.Id.GenID())
+		}),
+		tools.TimeMixin{},
+	}
+}
+
+func (CoreGatewayVirtualHost) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("listener_id"),
+		index.Fields("status"),
+	}
+}
+
+func (CoreGatewayVirtualHost) Annotations() []schema.Annotation {
+	withCommentsEnabled := true
+	return []schema.Annotation{
+		schema.Comment("L7 虚拟主机表"),
+		entsql.Annotation{
+			Table:        fmt.Sprintf("%s_core_gateway_virtual_host", constant.ProjectName),
+			Charset:      "utf8mb4",
+			Collation:    "utf8mb4_general_ci",
+			WithComments: &withCommentsEnabled,
+		},
+		edge.Annotation{StructTag: `json:"-" gorm:"-"`},
+	}
+}
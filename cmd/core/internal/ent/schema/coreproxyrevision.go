@@ -0,0 +1,72 @@
+package schema
+
+import (
+	"fmt"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/lyonmu/quebec/cmd/core/internal/global"
+	"github.com/lyonmu/quebec/pkg/constant"
+	"github.com/lyonmu/quebec/pkg/tools"
+)
+
+// CoreProxyRevision holds the schema definition for the CoreProxyRevision entity.
+// 每次发布的代理配置都会记录为一条不可变的修订
+type CoreProxyRevision struct {
+	ent.Schema
+}
+
+// Fields of the CoreProxyRevision.
+func (CoreProxyRevision) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("revision").Optional().Comment("配置修订号").Unique().Immutable(),
+		field.String("config").SchemaType(map[string]string{dialect.MySQL: "longtext", dialect.SQLite: "text", dialect.Postgres: "text"}).
+			Optional().Immutable().Comment("完整代理配置(protojson)"),
+		field.Int8("action").GoType(constant.ProxyRevisionAction(1)).Optional().Immutable().Comment("修订来源: 1-发布 2-回滚").Default(int8(constant.RevisionActionPublish)),
+		field.Int64("rollback_from").Optional().Immutable().Comment("回滚的目标修订号，仅回滚时有效"),
+		field.String("author_id").Optional().Immutable().Comment("发布人ID，为空表示系统检测到的变更"),
+		field.String("author_name").Optional().Immutable().Comment("发布人名称"),
+		field.String("summary").Optional().Immutable().Comment("变更摘要"),
+	}
+}
+
+// Edges of the CoreProxyRevision.
+func (CoreProxyRevision) Edges() []ent.Edge {
+	return []ent.Edge{}
+}
+
+func (CoreProxyRevision) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		tools.NewIDMixin(func() string {
+			return fmt.Sprintf("%d", global.Id.GenID())
+		}),
+		tools.TimeMixin{},
+	}
+}
+
+func (CoreProxyRevision) Indexes() []ent.Index {
+	// 时间戳字段的索引由 TimeMixin 提供
+	return []ent.Index{
+		index.Fields("revision"),
+		index.Fields("author_id"),
+	}
+}
+
+func (CoreProxyRevision) Annotations() []schema.Annotation {
+	withCommentsEnabled := true
+	return []schema.Annotation{
+		schema.Comment("代理配置修订记录表"),
+		entsql.Annotation{
+			Table:        fmt.Sprintf("%s_core_proxy_revision", constant.ProjectName),
+			Charset:      "utf8mb4",
+			Collation:    "utf8mb4_general_ci",
+			WithComments: &withCommentsEnabled,
+		},
+		edge.Annotation{StructTag: `json:"-" gorm:"-"`},
+	}
+}
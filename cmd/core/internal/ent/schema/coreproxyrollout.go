@@ -0,0 +1,79 @@
+package schema
+
+import (
+	"fmt"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/lyonmu/quebec/cmd/core/internal/common"
+	"github.com/lyonmu/quebec/cmd/core/internal/global"
+	"github.com/lyonmu/quebec/pkg/constant"
+	"github.com/lyonmu/quebec/pkg/tools"
+)
+
+// CoreProxyRollout holds the schema definition for the CoreProxyRollout entity.
+// 一次修订在网关集群内的灰度发布记录
+type CoreProxyRollout struct {
+	ent.Schema
+}
+
+// Fields of the CoreProxyRollout.
+func (CoreProxyRollout) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("cluster_id").Optional().Comment("网关集群ID (Envoy node.cluster)"),
+		field.Int64("revision").Optional().Comment("灰度中的配置修订号"),
+		field.Int64("stable_revision").Optional().Comment("非灰度节点使用的稳定修订号"),
+		field.JSON("canary_node_ids", []string{}).Optional().Comment("灰度节点 (Envoy node.id)"),
+		field.JSON("baseline", map[string]common.TrafficCounter{}).Optional().Comment("灰度开始时各灰度节点的累计流量，用于计算灰度期间的错误率"),
+		field.Int("soak_seconds").Optional().Comment("观察时长(秒)"),
+		field.Float("max_error_rate").Optional().Comment("允许的最大错误率(%)"),
+		field.Int64("min_requests").Optional().Comment("评估错误率所需的最少请求数"),
+		field.Int64("requests").Optional().Comment("灰度期间灰度节点的请求数"),
+		field.Int64("errors").Optional().Comment("灰度期间灰度节点的 5xx 响应数"),
+		field.Int8("status").GoType(constant.ProxyRolloutStatus(1)).Optional().Comment("状态: 1-灰度中 2-已全量 3-已中止 4-已被取代").Default(int8(constant.RolloutStatusInProgress)),
+		field.String("reason").Optional().Comment("结束原因"),
+		field.Int64("started_at").Optional().Comment("开始时间"),
+		field.Int64("finished_at").Optional().Comment("结束时间"),
+	}
+}
+
+// Edges of the CoreProxyRollout.
+func (CoreProxyRollout) Edges() []ent.Edge {
+	return []ent.Edge{}
+}
+
+func (CoreProxyRollout) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		tools.NewIDMixin(func() string {
+			return fmt.Sprintf("%d", global.Id.GenID())
+		}),
+		tools.TimeMixin{},
+	}
+}
+
+func (CoreProxyRollout) Indexes() []ent.Index {
+	// 时间戳字段的索引由 TimeMixin 提供
+	return []ent.Index{
+		index.Fields("cluster_id"),
+		index.Fields("revision"),
+		index.Fields("status"),
+	}
+}
+
+func (CoreProxyRollout) Annotations() []schema.Annotation {
+	withCommentsEnabled := true
+	return []schema.Annotation{
+		schema.Comment("灰度发布记录表"),
+		entsql.Annotation{
+			Table:        fmt.Sprintf("%s_core_proxy_rollout", constant.ProjectName),
+			Charset:      "utf8mb4",
+			Collation:    "utf8mb4_general_ci",
+			WithComments: &withCommentsEnabled,
+		},
+		edge.Annotation{StructTag: `json:"-" gorm:"-"`},
+	}
+}
@@ -0,0 +1,70 @@
+package schema
+
+import (
+	"fmt"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/lyonmu/quebec/cmd/core/internal/global"
+	"github.com/lyonmu/quebec/pkg/constant"
+	"github.com/lyonmu/quebec/pkg/tools"
+)
+
+// CoreProxyRolloutPolicy holds the schema definition for the CoreProxyRolloutPolicy entity.
+// 网关集群的灰度发布策略，启用后新发布的修订先下发到集群中的部分节点
+type CoreProxyRolloutPolicy struct {
+	ent.Schema
+}
+
+// Fields of the CoreProxyRolloutPolicy.
+func (CoreProxyRolloutPolicy) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("cluster_id").Optional().Comment("网关集群ID (Envoy node.cluster)").Unique(),
+		field.Int("percentage").Optional().Comment("灰度节点比例(%)，指定灰度节点时忽略").Default(10),
+		field.JSON("node_ids", []string{}).Optional().Comment("指定的灰度节点 (Envoy node.id)"),
+		field.Int("soak_seconds").Optional().Comment("观察时长(秒)，期间无 NACK 且错误率达标则自动全量").Default(constant.DefaultRolloutSoakSeconds),
+		field.Float("max_error_rate").Optional().Comment("灰度节点允许的最大错误率(%)，超过则自动中止").Default(constant.DefaultRolloutMaxErrorRate),
+		field.Int64("min_requests").Optional().Comment("评估错误率所需的最少请求数").Default(constant.DefaultRolloutMinRequests),
+		field.Int8("status").GoType(constant.YesOrNo(1)).Optional().Comment("是否启用 [1: 启用, 2: 禁用]").Default(int8(constant.Yes)),
+	}
+}
+
+// Edges of the CoreProxyRolloutPolicy.
+func (CoreProxyRolloutPolicy) Edges() []ent.Edge {
+	return []ent.Edge{}
+}
+
+func (CoreProxyRolloutPolicy) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		tools.NewIDMixin(func() string {
+			return fmt.Sprintf("%d", global.Id.GenID())
+		}),
+		tools.TimeMixin{},
+	}
+}
+
+func (CoreProxyRolloutPolicy) Indexes() []ent.Index {
+	// 时间戳字段的索引由 TimeMixin 提供
+	return []ent.Index{
+		index.Fields("cluster_id"),
+		index.Fields("status"),
+	}
+}
+
+func (CoreProxyRolloutPolicy) Annotations() []schema.Annotation {
+	withCommentsEnabled := true
+	return []schema.Annotation{
+		schema.Comment("灰度发布策略表"),
+		entsql.Annotation{
+			Table:        fmt.Sprintf("%s_core_proxy_rollout_policy", constant.ProjectName),
+			Charset:      "utf8mb4",
+			Collation:    "utf8mb4_general_ci",
+			WithComments: &withCommentsEnabled,
+		},
+		edge.Annotation{StructTag: `json:"-" gorm:"-"`},
+	}
+}
@@ -25,6 +25,9 @@ func (CoreUpstream) Fields() []ent.Field {
 		field.String("name").Optional().Comment("上游服务名称"),
 		field.String("description").Optional().Comment("上游服务描述"),
 		field.Int8("lb_policy").GoType(constant.ProxyLbPolicy(1)).Optional().Comment("负载均衡策略: 1-ROUND_ROBIN 2-LEAST_REQUEST 3-RANDOM 4-RING_HASH 5-MAGLEV").Default(int8(constant.LbPolicyMaglev)),
+		field.Uint64("ring_min_size").Optional().Comment("环哈希的最小环大小，0 表示使用 Envoy 默认值").Default(0),
+		field.Uint64("ring_max_size").Optional().Comment("环哈希的最大环大小，0 表示使用 Envoy 默认值").Default(0),
+		field.Uint64("maglev_table_size").Optional().Comment("Maglev 查找表大小，必须为质数，0 表示使用 Envoy 默认值").Default(0),
 		field.Int("connect_timeout_ms").Optional().Comment("连接超时(毫秒)").Default(constant.DefaultConnectTimeoutMs),
 		field.Int("max_connections").Optional().Comment("最大连接数").Default(constant.DefaultMaxConnections),
 		field.Int("max_pending_requests").Optional().Comment("最大等待请求数").Default(constant.DefaultMaxPendingRequests),
@@ -36,7 +39,11 @@ func (CoreUpstream) Fields() []ent.Field {
 
 // Edges of the CoreUpstream.
 func (CoreUpstream) Edges() []ent.Edge {
-	return []ent.Edge{}
+	return []ent.Edge{
+		edge.To("upstream_to_host", CoreUpstreamHost.Type),
+		edge.To("upstream_to_route", CoreGatewayHttpRoute.Type),
+		edge.To("upstream_to_target", CoreGatewayHttpRouteTarget.Type),
+	}
 }
 
 func (CoreUpstream) Mixin() []ent.Mixin {
@@ -22,6 +22,7 @@ type CoreUpstreamHost struct {
 // Fields of the CoreUpstreamHost.
 func (CoreUpstreamHost) Fields() []ent.Field {
 	return []ent.Field{
+		field.String("upstream_id").Optional().Comment("上游服务ID"),
 		field.String("address").Optional().Comment("后端地址IP"),
 		field.Int("weight").Optional().Comment("权重(相对权重)").Default(1),
 		field.Int("port").Optional().Comment("后端端口"),
@@ -31,7 +32,9 @@ func (CoreUpstreamHost) Fields() []ent.Field {
 
 // Edges of the CoreUpstreamHost.
 func (CoreUpstreamHost) Edges() []ent.Edge {
-	return []ent.Edge{}
+	return []ent.Edge{
+		edge.From("host_from_upstream", CoreUpstream.Type).Ref("upstream_to_host").Field("upstream_id").Unique(),
+	}
 }
 
 func (CoreUpstreamHost) Mixin() []ent.Mixin {
@@ -45,6 +48,7 @@ func (CoreUpstreamHost) Mixin() []ent.Mixin {
 
 func (CoreUpstreamHost) Indexes() []ent.Index {
 	return []ent.Index{
+		index.Fields("upstream_id"),
 		index.Fields("address"),
 		index.Fields("port"),
 		index.Fields("weight"),
@@ -18,22 +18,36 @@ type Tx struct {
 	CoreCert *CoreCertClient
 	// CoreDataRelationship is the client for interacting with the CoreDataRelationship builders.
 	CoreDataRelationship *CoreDataRelationshipClient
+	// CoreGateway is the client for interacting with the CoreGateway builders.
+	CoreGateway *CoreGatewayClient
 	// CoreGatewayCluster is the client for interacting with the CoreGatewayCluster builders.
 	CoreGatewayCluster *CoreGatewayClusterClient
 	// CoreGatewayHttpRoute is the client for interacting with the CoreGatewayHttpRoute builders.
 	CoreGatewayHttpRoute *CoreGatewayHttpRouteClient
+	// CoreGatewayHttpRouteTarget is the client for interacting with the CoreGatewayHttpRouteTarget builders.
+	CoreGatewayHttpRouteTarget *CoreGatewayHttpRouteTargetClient
 	// CoreGatewayL4Listener is the client for interacting with the CoreGatewayL4Listener builders.
 	CoreGatewayL4Listener *CoreGatewayL4ListenerClient
 	// CoreGatewayL7Listener is the client for interacting with the CoreGatewayL7Listener builders.
 	CoreGatewayL7Listener *CoreGatewayL7ListenerClient
 	// CoreGatewayNode is the client for interacting with the CoreGatewayNode builders.
 	CoreGatewayNode *CoreGatewayNodeClient
+	// CoreGatewayRateLimitRule is the client for interacting with the CoreGatewayRateLimitRule builders.
+	CoreGatewayRateLimitRule *CoreGatewayRateLimitRuleClient
+	// CoreGatewayVirtualHost is the client for interacting with the CoreGatewayVirtualHost builders.
+	CoreGatewayVirtualHost *CoreGatewayVirtualHostClient
 	// CoreMenu is the client for interacting with the CoreMenu builders.
 	CoreMenu *CoreMenuClient
 	// CoreOnLineUser is the client for interacting with the CoreOnLineUser builders.
 	CoreOnLineUser *CoreOnLineUserClient
 	// CoreOperationLog is the client for interacting with the CoreOperationLog builders.
 	CoreOperationLog *CoreOperationLogClient
+	// CoreProxyRevision is the client for interacting with the CoreProxyRevision builders.
+	CoreProxyRevision *CoreProxyRevisionClient
+	// CoreProxyRollout is the client for interacting with the CoreProxyRollout builders.
+	CoreProxyRollout *CoreProxyRolloutClient
+	// CoreProxyRolloutPolicy is the client for interacting with the CoreProxyRolloutPolicy builders.
+	CoreProxyRolloutPolicy *CoreProxyRolloutPolicyClient
 	// CoreRole is the client for interacting with the CoreRole builders.
 	CoreRole *CoreRoleClient
 	// CoreUpstream is the client for interacting with the CoreUpstream builders.
@@ -175,14 +189,21 @@ func (tx *Tx) Client() *Client {
 func (tx *Tx) init() {
 	tx.CoreCert = NewCoreCertClient(tx.config)
 	tx.CoreDataRelationship = NewCoreDataRelationshipClient(tx.config)
+	tx.CoreGateway = NewCoreGatewayClient(tx.config)
 	tx.CoreGatewayCluster = NewCoreGatewayClusterClient(tx.config)
 	tx.CoreGatewayHttpRoute = NewCoreGatewayHttpRouteClient(tx.config)
+	tx.CoreGatewayHttpRouteTarget = NewCoreGatewayHttpRouteTargetClient(tx.config)
 	tx.CoreGatewayL4Listener = NewCoreGatewayL4ListenerClient(tx.config)
 	tx.CoreGatewayL7Listener = NewCoreGatewayL7ListenerClient(tx.config)
 	tx.CoreGatewayNode = NewCoreGatewayNodeClient(tx.config)
+	tx.CoreGatewayRateLimitRule = NewCoreGatewayRateLimitRuleClient(tx.config)
+	tx.CoreGatewayVirtualHost = NewCoreGatewayVirtualHostClient(tx.config)
 	tx.CoreMenu = NewCoreMenuClient(tx.config)
 	tx.CoreOnLineUser = NewCoreOnLineUserClient(tx.config)
 	tx.CoreOperationLog = NewCoreOperationLogClient(tx.config)
+	tx.CoreProxyRevision = NewCoreProxyRevisionClient(tx.config)
+	tx.CoreProxyRollout = NewCoreProxyRolloutClient(tx.config)
+	tx.CoreProxyRolloutPolicy = NewCoreProxyRolloutPolicyClient(tx.config)
 	tx.CoreRole = NewCoreRoleClient(tx.config)
 	tx.CoreUpstream = NewCoreUpstreamClient(tx.config)
 	tx.CoreUpstreamHost = NewCoreUpstreamHostClient(tx.config)
@@ -3,6 +3,7 @@ package http
 import (
 	"github.com/gin-gonic/gin"
 	"github.com/lyonmu/quebec/cmd/core/internal/global"
+	"github.com/lyonmu/quebec/cmd/core/internal/utils"
 	"github.com/lyonmu/quebec/pkg/code"
 	"github.com/lyonmu/quebec/pkg/constant"
 )
@@ -36,6 +37,8 @@ func JwtAuth() gin.HandlerFunc {
 			c.Abort()
 			return
 		}
+		// 记录操作人，供配置修订等下游逻辑追溯
+		c.Request = c.Request.WithContext(utils.WithOperator(c.Request.Context(), claims.UserId))
 		c.Next()
 	}
 }
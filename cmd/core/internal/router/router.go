@@ -40,6 +40,9 @@ func InitRouter(e *gin.Engine) {
 	// Init system router
 	v1route.InitSystemRouter(group, v1api)
 
+	// Init proxy router
+	v1route.InitProxyRouter(group, v1api)
+
 	if err := web.Register(e, "/"); err != nil {
 		global.Logger.Sugar().Warnf("register embedded web failed: %v", err)
 	}
@@ -0,0 +1,148 @@
+package router
+
+import (
+	"github.com/gin-gonic/gin"
+	v1 "github.com/lyonmu/quebec/cmd/core/internal/api/http/v1"
+	"github.com/lyonmu/quebec/cmd/core/internal/api/http/v1/system"
+	"github.com/lyonmu/quebec/cmd/core/internal/common"
+	"github.com/lyonmu/quebec/cmd/core/internal/middleware/http"
+	middlewarehttp "github.com/lyonmu/quebec/cmd/core/internal/middleware/http"
+)
+
+type ProxyRouter struct{}
+
+func (r *ProxyRouter) InitProxyRouter(group *gin.RouterGroup, apiGroup v1.V1ApiGroup) {
+	// 创建操作日志中间件
+	operationLogMiddleware := middlewarehttp.NewOperationLogMiddleware(system.GetSystemSvc())
+
+	proxyRouterWithAuth := group.Group("v1/proxy", http.JwtAuth())
+	{
+		// === 网关集群 ===
+		proxyRouterWithAuth.GET("cluster/list", apiGroup.ProxyGatewayClusterList)
+		proxyRouterWithAuth.GET("cluster/label", apiGroup.ProxyGatewayClusterLabel)
+
+		// === 网关实例 ===
+		proxyRouterWithAuth.GET("gateway/list", apiGroup.ProxyGatewayList)
+
+		// === 网关节点 ===
+		proxyRouterWithAuth.GET("node/list", apiGroup.ProxyGatewayNodeList)
+
+		// === 配置修订 ===
+		proxyRouterWithAuth.GET("revision/page", apiGroup.ProxyRevisionPage)
+		proxyRouterWithAuth.GET("revision/diff", apiGroup.ProxyRevisionDiff)
+		proxyRouterWithAuth.GET("revision/:revision", apiGroup.ProxyRevisionDetail)
+		// 回滚到历史修订（需要记录操作日志）
+		proxyRouterWithAuth.POST("revision/rollback/:revision", operationLogMiddleware.Handle(common.OperationProxyRollback), apiGroup.ProxyRevisionRollback)
+
+		// === 配置预览 ===
+		proxyRouterWithAuth.GET("render", apiGroup.ProxyRender)
+
+		// === Envoy 接入 ===
+		proxyRouterWithAuth.POST("bootstrap", apiGroup.ProxyBootstrap)
+
+		// === 灰度发布 ===
+		proxyRouterWithAuth.GET("rollout/policy/list", apiGroup.ProxyRolloutPolicyList)
+		proxyRouterWithAuth.PUT("rollout/policy", operationLogMiddleware.Handle(common.OperationRolloutPolicySave), apiGroup.ProxyRolloutPolicySave)
+		proxyRouterWithAuth.DELETE("rollout/policy/:id", operationLogMiddleware.Handle(common.OperationRolloutPolicyDelete), apiGroup.ProxyRolloutPolicyDelete)
+		proxyRouterWithAuth.GET("rollout/page", apiGroup.ProxyRolloutPage)
+		proxyRouterWithAuth.GET("rollout/:id", apiGroup.ProxyRolloutDetail)
+		proxyRouterWithAuth.POST("rollout/:id/promote", operationLogMiddleware.Handle(common.OperationRolloutPromote), apiGroup.ProxyRolloutPromote)
+		proxyRouterWithAuth.POST("rollout/:id/abort", operationLogMiddleware.Handle(common.OperationRolloutAbort), apiGroup.ProxyRolloutAbort)
+
+		// === 集群绑定 ===
+		// 监听器绑定网关集群（需要记录操作日志）
+		proxyRouterWithAuth.PUT("listener/:id/cluster", operationLogMiddleware.Handle(common.OperationListenerBindCluster), apiGroup.ProxyListenerBindCluster)
+		// 路由绑定网关集群（需要记录操作日志）
+		proxyRouterWithAuth.PUT("route/:id/cluster", operationLogMiddleware.Handle(common.OperationRouteBindCluster), apiGroup.ProxyHttpRouteBindCluster)
+
+		// === 路由加权分流 ===
+		proxyRouterWithAuth.GET("route/:id/targets", apiGroup.ProxyHttpRouteTargets)
+		// 设置路由加权分流（需要记录操作日志）
+		proxyRouterWithAuth.PUT("route/:id/targets", operationLogMiddleware.Handle(common.OperationRouteSetTargets), apiGroup.ProxyHttpRouteSetTargets)
+
+		// === 路由匹配条件 ===
+		proxyRouterWithAuth.GET("route/:id/match", apiGroup.ProxyHttpRouteMatch)
+		// 设置路由匹配条件（需要记录操作日志）
+		proxyRouterWithAuth.PUT("route/:id/match", operationLogMiddleware.Handle(common.OperationRouteSetMatch), apiGroup.ProxyHttpRouteSetMatch)
+
+		// === 路由路径匹配、重写与重定向 ===
+		proxyRouterWithAuth.GET("route/:id/path", apiGroup.ProxyHttpRoutePath)
+		// 设置路由路径（需要记录操作日志）
+		proxyRouterWithAuth.PUT("route/:id/path", operationLogMiddleware.Handle(common.OperationRouteSetPath), apiGroup.ProxyHttpRouteSetPath)
+
+		// === 路由匹配优先级 ===
+		proxyRouterWithAuth.GET("route/:id/priority", apiGroup.ProxyHttpRoutePriority)
+		// 设置路由匹配优先级（需要记录操作日志）
+		proxyRouterWithAuth.PUT("route/:id/priority", operationLogMiddleware.Handle(common.OperationRouteSetPriority), apiGroup.ProxyHttpRouteSetPriority)
+
+		// === 路由重试策略 ===
+		proxyRouterWithAuth.GET("route/:id/retry", apiGroup.ProxyHttpRouteRetry)
+		// 设置路由重试策略（需要记录操作日志）
+		proxyRouterWithAuth.PUT("route/:id/retry", operationLogMiddleware.Handle(common.OperationRouteSetRetry), apiGroup.ProxyHttpRouteSetRetry)
+
+		// === 虚拟主机 ===
+		proxyRouterWithAuth.GET("virtual-host/list", apiGroup.ProxyVirtualHostList)
+		proxyRouterWithAuth.POST("virtual-host", operationLogMiddleware.Handle(common.OperationVirtualHostCreate), apiGroup.ProxyVirtualHostAdd)
+		proxyRouterWithAuth.PUT("virtual-host/:id", operationLogMiddleware.Handle(common.OperationVirtualHostUpdate), apiGroup.ProxyVirtualHostUpdate)
+		proxyRouterWithAuth.DELETE("virtual-host/:id", operationLogMiddleware.Handle(common.OperationVirtualHostDelete), apiGroup.ProxyVirtualHostDelete)
+		// 路由绑定虚拟主机（需要记录操作日志）
+		proxyRouterWithAuth.PUT("route/:id/virtual-host", operationLogMiddleware.Handle(common.OperationRouteBindVhost), apiGroup.ProxyHttpRouteBindVirtualHost)
+
+		// === 请求头与响应头改写 ===
+		proxyRouterWithAuth.GET("route/:id/headers", apiGroup.ProxyHttpRouteHeaders)
+		proxyRouterWithAuth.PUT("route/:id/headers", operationLogMiddleware.Handle(common.OperationRouteSetHeaders), apiGroup.ProxyHttpRouteSetHeaders)
+		proxyRouterWithAuth.GET("virtual-host/:id/headers", apiGroup.ProxyVirtualHostHeaders)
+		proxyRouterWithAuth.PUT("virtual-host/:id/headers", operationLogMiddleware.Handle(common.OperationVhostSetHeaders), apiGroup.ProxyVirtualHostSetHeaders)
+
+		// === 路由流量镜像 ===
+		proxyRouterWithAuth.GET("route/:id/mirror", apiGroup.ProxyHttpRouteMirror)
+		// 设置路由流量镜像（需要记录操作日志）
+		proxyRouterWithAuth.PUT("route/:id/mirror", operationLogMiddleware.Handle(common.OperationRouteSetMirror), apiGroup.ProxyHttpRouteSetMirror)
+
+		// === 路由故障注入 ===
+		proxyRouterWithAuth.GET("route/:id/fault", apiGroup.ProxyHttpRouteFault)
+		// 设置与清除路由故障注入（需要记录操作日志）
+		proxyRouterWithAuth.PUT("route/:id/fault", operationLogMiddleware.Handle(common.OperationRouteSetFault), apiGroup.ProxyHttpRouteSetFault)
+		proxyRouterWithAuth.DELETE("route/:id/fault", operationLogMiddleware.Handle(common.OperationRouteClearFault), apiGroup.ProxyHttpRouteClearFault)
+
+		// === 跨域策略 ===
+		proxyRouterWithAuth.GET("route/:id/cors", apiGroup.ProxyHttpRouteCors)
+		proxyRouterWithAuth.PUT("route/:id/cors", operationLogMiddleware.Handle(common.OperationRouteSetCors), apiGroup.ProxyHttpRouteSetCors)
+		proxyRouterWithAuth.GET("virtual-host/:id/cors", apiGroup.ProxyVirtualHostCors)
+		proxyRouterWithAuth.PUT("virtual-host/:id/cors", operationLogMiddleware.Handle(common.OperationVhostSetCors), apiGroup.ProxyVirtualHostSetCors)
+
+		// === 本地限流 ===
+		proxyRouterWithAuth.GET("route/:id/rate-limit", apiGroup.ProxyHttpRouteRateLimit)
+		proxyRouterWithAuth.PUT("route/:id/rate-limit", operationLogMiddleware.Handle(common.OperationRouteSetRateLimit), apiGroup.ProxyHttpRouteSetRateLimit)
+		proxyRouterWithAuth.GET("listener/:id/rate-limit", apiGroup.ProxyListenerRateLimit)
+		proxyRouterWithAuth.PUT("listener/:id/rate-limit", operationLogMiddleware.Handle(common.OperationListenerRateLimit), apiGroup.ProxyListenerSetRateLimit)
+
+		// === 全局限流 ===
+		proxyRouterWithAuth.GET("rate-limit-rule/list", apiGroup.ProxyRateLimitRuleList)
+		proxyRouterWithAuth.POST("rate-limit-rule", operationLogMiddleware.Handle(common.OperationRateLimitRuleCreate), apiGroup.ProxyRateLimitRuleAdd)
+		proxyRouterWithAuth.PUT("rate-limit-rule/:id", operationLogMiddleware.Handle(common.OperationRateLimitRuleUpdate), apiGroup.ProxyRateLimitRuleUpdate)
+		proxyRouterWithAuth.DELETE("rate-limit-rule/:id", operationLogMiddleware.Handle(common.OperationRateLimitRuleDelete), apiGroup.ProxyRateLimitRuleDelete)
+		proxyRouterWithAuth.GET("route/:id/rate-limit-actions", apiGroup.ProxyHttpRouteRateLimitActions)
+		proxyRouterWithAuth.PUT("route/:id/rate-limit-actions", operationLogMiddleware.Handle(common.OperationRouteSetRateActions), apiGroup.ProxyHttpRouteSetRateLimitActions)
+
+		// === 直接响应与维护模式 ===
+		proxyRouterWithAuth.GET("route/:id/direct-response", apiGroup.ProxyHttpRouteDirectResponse)
+		proxyRouterWithAuth.PUT("route/:id/direct-response", operationLogMiddleware.Handle(common.OperationRouteSetDirectResp), apiGroup.ProxyHttpRouteSetDirectResponse)
+		proxyRouterWithAuth.GET("virtual-host/:id/maintenance", apiGroup.ProxyVirtualHostMaintenance)
+		proxyRouterWithAuth.PUT("virtual-host/:id/maintenance", operationLogMiddleware.Handle(common.OperationVhostMaintenance), apiGroup.ProxyVirtualHostSetMaintenance)
+		proxyRouterWithAuth.GET("cluster/:id/maintenance", apiGroup.ProxyGatewayClusterMaintenance)
+		proxyRouterWithAuth.PUT("cluster/:id/maintenance", operationLogMiddleware.Handle(common.OperationClusterMaintenance), apiGroup.ProxyGatewayClusterSetMaintenance)
+
+		// === 一致性哈希 ===
+		proxyRouterWithAuth.GET("route/:id/hash-policy", apiGroup.ProxyHttpRouteHashPolicies)
+		proxyRouterWithAuth.PUT("route/:id/hash-policy", operationLogMiddleware.Handle(common.OperationRouteSetHashPolicy), apiGroup.ProxyHttpRouteSetHashPolicies)
+		proxyRouterWithAuth.GET("upstream/:id/lb", apiGroup.ProxyUpstreamLb)
+		proxyRouterWithAuth.PUT("upstream/:id/lb", operationLogMiddleware.Handle(common.OperationUpstreamSetLb), apiGroup.ProxyUpstreamSetLb)
+
+		// === 响应压缩 ===
+		proxyRouterWithAuth.GET("listener/:id/compression", apiGroup.ProxyListenerCompression)
+		proxyRouterWithAuth.PUT("listener/:id/compression", operationLogMiddleware.Handle(common.OperationListenerCompression), apiGroup.ProxyListenerSetCompression)
+		proxyRouterWithAuth.GET("route/:id/compression", apiGroup.ProxyHttpRouteCompression)
+		proxyRouterWithAuth.PUT("route/:id/compression", operationLogMiddleware.Handle(common.OperationRouteCompression), apiGroup.ProxyHttpRouteSetCompression)
+	}
+}
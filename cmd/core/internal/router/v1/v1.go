@@ -2,4 +2,5 @@ package router
 
 type V1Router struct {
 	SystemRouter
+	ProxyRouter
 }
@@ -103,6 +103,28 @@ func (s *NodeSvc) processEvent(event *v1.EnvoyStatusEvent) {
 		global.Logger.Sugar().Infof("[DISCONNECT] Gateway:%d -> Node:%s", event.GatewayId, event.NodeId)
 		s.registry.Remove(event.GatewayId, event.NodeId)
 
+	case v1.EnvoyStatusEvent_XDS_STATUS:
+		st := event.XdsStatus
+		if st == nil {
+			return
+		}
+		if st.ErrorDetail != "" {
+			global.Logger.Sugar().Warnf("[NACK] Gateway:%d -> Node:%s %s version %s: %s", event.GatewayId, event.NodeId, st.TypeUrl, st.VersionInfo, st.ErrorDetail)
+		} else {
+			global.Logger.Sugar().Infof("[ACK] Gateway:%d -> Node:%s %s version %s (revision %d)", event.GatewayId, event.NodeId, st.TypeUrl, st.VersionInfo, st.Revision)
+		}
+		if err := s.registry.UpdateXdsStatus(event.GatewayId, event.NodeId, st); err != nil {
+			global.Logger.Sugar().Errorf("update node xds status failed: %v", err)
+		}
+
+	case v1.EnvoyStatusEvent_TRAFFIC:
+		if event.Traffic == nil {
+			return
+		}
+		if err := s.registry.AddTraffic(event.GatewayId, event.NodeId, event.Traffic); err != nil {
+			global.Logger.Sugar().Errorf("update node traffic failed: %v", err)
+		}
+
 	case v1.EnvoyStatusEvent_HEARTBEAT:
 		// 可以在这里更新 Gateway 的最后活跃时间
 		global.Logger.Sugar().Infof("[HEARTBEAT] from %d", event.GatewayId)
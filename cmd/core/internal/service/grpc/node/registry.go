@@ -7,10 +7,12 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 
+	"github.com/lyonmu/quebec/cmd/core/internal/common"
 	"github.com/lyonmu/quebec/cmd/core/internal/ent"
 	"github.com/lyonmu/quebec/cmd/core/internal/ent/coregatewaycluster"
 	"github.com/lyonmu/quebec/cmd/core/internal/ent/coregatewaynode"
 	"github.com/lyonmu/quebec/cmd/core/internal/global"
+	v1 "github.com/lyonmu/quebec/idl/node/v1"
 )
 
 // EnvoyNode 表示单个 Envoy 实例的信息
@@ -26,7 +28,7 @@ type EnvoyNode struct {
 
 // Registry 是一个线程安全的存储库
 type Registry struct {
-	// 使用互斥锁保证并发下的顺序化写入
+	// 使用互斥锁保证并发下的顺序化写入，所有写操作都持有写锁
 	mu sync.RWMutex
 }
 
@@ -155,6 +157,103 @@ func (r *Registry) UpdateLastRequestTime(gatewayID int64, clusterID, nodeID stri
 	return nil
 }
 
+// UpdateXdsStatus 记录节点对某类 xDS 资源的 ACK/NACK 回执
+func (r *Registry) UpdateXdsStatus(gatewayID int64, nodeID string, st *v1.XdsStatus) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	n, err := global.EntClient.CoreGatewayNode.Query().
+		Where(
+			coregatewaynode.NodeID(nodeID),
+			coregatewaynode.GatewayID(gatewayID),
+			coregatewaynode.DeletedAtIsNil(),
+		).
+		Only(ctx)
+	if err != nil {
+		global.Logger.Sugar().Errorf("query core_gateway_node failed: %s", err)
+		return err
+	}
+
+	statuses, appliedRevision := mergeXdsStatus(n.XdsStatus, n.AppliedRevision, st)
+
+	if _, err := global.EntClient.CoreGatewayNode.UpdateOneID(n.ID).
+		SetXdsStatus(statuses).
+		SetAppliedRevision(appliedRevision).
+		SetLastError(lastXdsError(statuses)).
+		Save(ctx); err != nil {
+		global.Logger.Sugar().Errorf("update core_gateway_node xds_status failed: %s", err)
+		return err
+	}
+
+	return nil
+}
+
+// AddTraffic 累加节点的请求数与 5xx 响应数
+func (r *Registry) AddTraffic(gatewayID int64, nodeID string, st *v1.TrafficStats) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	if _, err := global.EntClient.CoreGatewayNode.Update().
+		Where(
+			coregatewaynode.NodeID(nodeID),
+			coregatewaynode.GatewayID(gatewayID),
+			coregatewaynode.DeletedAtIsNil(),
+		).
+		AddRequestTotal(st.Requests).
+		AddErrorTotal(st.Errors).
+		Save(ctx); err != nil {
+		global.Logger.Sugar().Errorf("update core_gateway_node traffic failed: %s", err)
+		return err
+	}
+
+	return nil
+}
+
+// mergeXdsStatus 将一次 ACK/NACK 回执合并到节点的 xDS 状态，返回新的状态与已应用修订号。
+// ACK 清除该类型之前的 NACK 错误，NACK 保留最近一次 ACK 的版本
+func mergeXdsStatus(statuses map[string]common.XdsStatus, appliedRevision int64, st *v1.XdsStatus) (map[string]common.XdsStatus, int64) {
+	if statuses == nil {
+		statuses = make(map[string]common.XdsStatus)
+	}
+	cur := statuses[st.TypeUrl]
+	if st.ErrorDetail == "" {
+		cur.VersionInfo = st.VersionInfo
+		cur.Revision = st.Revision
+		cur.AckTime = st.Timestamp
+		cur.RejectedVersion = ""
+		cur.RejectedRevision = 0
+		cur.ErrorDetail = ""
+		// 灰度中止后节点会回到较低的稳定修订，已应用修订号以最近一次 ACK 为准
+		appliedRevision = st.Revision
+	} else {
+		cur.RejectedVersion = st.VersionInfo
+		cur.RejectedRevision = st.Revision
+		cur.ErrorDetail = st.ErrorDetail
+		cur.NackTime = st.Timestamp
+	}
+	statuses[st.TypeUrl] = cur
+	return statuses, appliedRevision
+}
+
+// lastXdsError 返回各类型中最近一次尚未恢复的 NACK 错误
+func lastXdsError(statuses map[string]common.XdsStatus) string {
+	var (
+		lastErr  string
+		lastTime int64
+	)
+	for typeURL, st := range statuses {
+		if st.ErrorDetail != "" && st.NackTime >= lastTime {
+			lastErr = typeURL + ": " + st.ErrorDetail
+			lastTime = st.NackTime
+		}
+	}
+	return lastErr
+}
+
 func (r *Registry) upsertCluster(ctx context.Context, clusterID string, gatewayID int64, lastRequest int64) error {
 	if clusterID == "" {
 		return nil
@@ -196,6 +295,10 @@ func (r *Registry) upsertNode(ctx context.Context, gatewayID int64, node *EnvoyN
 			u.SetClusterID(node.ClusterID)
 			u.SetGatewayID(gatewayID)
 			u.SetNodeLastRequestTime(lastRequest)
+			// 重新连接的节点重新拉取配置，上一次连接的回执与错误不再代表当前状态
+			u.ClearXdsStatus()
+			u.ClearLastError()
+			u.ClearAppliedRevision()
 			u.ClearDeletedAt()
 			u.SetUpdatedAt(time.Now())
 		}).
@@ -0,0 +1,95 @@
+package node
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/lyonmu/quebec/cmd/core/internal/common"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/coregatewaynode"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/enttest"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/migrate"
+	_ "github.com/lyonmu/quebec/cmd/core/internal/ent/runtime"
+	"github.com/lyonmu/quebec/cmd/core/internal/global"
+	v1 "github.com/lyonmu/quebec/idl/node/v1"
+	"github.com/lyonmu/quebec/pkg/tools"
+	_ "github.com/mattn/go-sqlite3"
+	"go.uber.org/zap"
+)
+
+func TestMergeXdsStatus(t *testing.T) {
+	const cds = "type.googleapis.com/envoy.config.cluster.v3.Cluster"
+
+	statuses, applied := mergeXdsStatus(nil, 0, &v1.XdsStatus{TypeUrl: cds, VersionInfo: "v1", Revision: 1, Timestamp: 10})
+	if applied != 1 || statuses[cds].VersionInfo != "v1" {
+		t.Fatalf("expected v1 applied at revision 1, got %+v (%d)", statuses[cds], applied)
+	}
+
+	// NACK 保留已应用的版本与修订号，只记录被拒绝的版本
+	statuses, applied = mergeXdsStatus(statuses, applied, &v1.XdsStatus{TypeUrl: cds, VersionInfo: "v2", Revision: 2, Timestamp: 20, ErrorDetail: "bad cluster"})
+	if applied != 1 || statuses[cds].VersionInfo != "v1" || statuses[cds].RejectedRevision != 2 {
+		t.Fatalf("expected nack to keep v1, got %+v (%d)", statuses[cds], applied)
+	}
+	if lastXdsError(statuses) == "" {
+		t.Fatal("expected last error after nack")
+	}
+
+	// NACK 之后的 ACK 清除错误
+	statuses, applied = mergeXdsStatus(statuses, applied, &v1.XdsStatus{TypeUrl: cds, VersionInfo: "v3", Revision: 3, Timestamp: 30})
+	if applied != 3 || statuses[cds].ErrorDetail != "" || statuses[cds].RejectedVersion != "" || statuses[cds].RejectedRevision != 0 {
+		t.Fatalf("expected ack to clear the nack, got %+v (%d)", statuses[cds], applied)
+	}
+	if err := lastXdsError(statuses); err != "" {
+		t.Fatalf("expected no last error after ack, got %s", err)
+	}
+}
+
+func TestLastXdsError(t *testing.T) {
+	statuses := map[string]common.XdsStatus{
+		"cds": {ErrorDetail: "old", NackTime: 10},
+		"lds": {ErrorDetail: "new", NackTime: 20},
+		"rds": {VersionInfo: "v1", AckTime: 30},
+	}
+	if err := lastXdsError(statuses); !strings.HasPrefix(err, "lds: new") {
+		t.Fatalf("expected newest nack from lds, got %q", err)
+	}
+}
+
+func TestReconnectAfterNack(t *testing.T) {
+	global.Logger = zap.NewNop()
+	id, err := tools.NewSonySnowFlake(func() (int, error) { return 1, nil })
+	if err != nil {
+		t.Fatalf("create id generator failed: %v", err)
+	}
+	global.Id = id
+	// 与线上迁移保持一致，不创建外键约束
+	client := enttest.Open(t, "sqlite3", "file:registry?mode=memory&cache=shared&_fk=1",
+		enttest.WithMigrateOptions(migrate.WithForeignKeys(false)))
+	defer client.Close()
+	global.EntClient = client
+
+	r := NewRegistry()
+	node := &EnvoyNode{NodeID: "n1", ClusterID: "edge", GatewayID: 7}
+	if err := r.AddOrUpdate(7, node); err != nil {
+		t.Fatalf("add node failed: %v", err)
+	}
+	const cds = "type.googleapis.com/envoy.config.cluster.v3.Cluster"
+	if err := r.UpdateXdsStatus(7, "n1", &v1.XdsStatus{TypeUrl: cds, VersionInfo: "v1", Revision: 1, Timestamp: 10}); err != nil {
+		t.Fatalf("ack failed: %v", err)
+	}
+	if err := r.UpdateXdsStatus(7, "n1", &v1.XdsStatus{TypeUrl: cds, VersionInfo: "v2", Revision: 2, Timestamp: 20, ErrorDetail: "bad cluster"}); err != nil {
+		t.Fatalf("nack failed: %v", err)
+	}
+
+	// 重新连接后不再报告上一次连接的 NACK
+	if err := r.AddOrUpdate(7, node); err != nil {
+		t.Fatalf("reconnect node failed: %v", err)
+	}
+	n, err := client.CoreGatewayNode.Query().Where(coregatewaynode.NodeID("n1")).Only(context.Background())
+	if err != nil {
+		t.Fatalf("query node failed: %v", err)
+	}
+	if n.LastError != "" || len(n.XdsStatus) != 0 || n.AppliedRevision != 0 {
+		t.Fatalf("expected xds state reset on reconnect, got error %q, status %v, revision %d", n.LastError, n.XdsStatus, n.AppliedRevision)
+	}
+}
@@ -0,0 +1,504 @@
+package router
+
+import (
+	"context"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/lyonmu/quebec/cmd/core/internal/common"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/corecert"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/coregatewaycluster"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/coregatewayhttproute"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/coregatewayhttproutetarget"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/coregatewayl7listener"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/coregatewayratelimitrule"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/coregatewayvirtualhost"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/coreupstream"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/coreupstreamhost"
+	"github.com/lyonmu/quebec/cmd/core/internal/global"
+	v1 "github.com/lyonmu/quebec/idl/router/v1"
+	"github.com/lyonmu/quebec/pkg/constant"
+	"github.com/lyonmu/quebec/pkg/envoy"
+)
+
+// LoadProxyConfig 从数据库读取所有启用的上游服务、HTTP 路由、L7 监听器、虚拟主机、全局限流规则与证书
+func LoadProxyConfig(ctx context.Context) (*v1.ProxyConfig, error) {
+	cfg, _, err := LoadProxyConfigWithSkipped(ctx)
+	return cfg, err
+}
+
+// SkippedRoute 因目标上游或所属虚拟主机不存在、已禁用而不会下发的路由
+type SkippedRoute struct {
+	Route         *ent.CoreGatewayHttpRoute
+	UpstreamID    string // 不可用的上游服务ID
+	VirtualHostID string // 不可用的虚拟主机ID
+}
+
+// LoadProxyConfigWithSkipped 同 LoadProxyConfig，并返回因目标上游或所属虚拟主机不存在、已禁用而不会下发的路由
+func LoadProxyConfigWithSkipped(ctx context.Context) (*v1.ProxyConfig, []SkippedRoute, error) {
+	upstreams, err := global.EntClient.CoreUpstream.Query().
+		Where(coreupstream.DeletedAtIsNil(), coreupstream.Status(constant.Yes)).
+		WithUpstreamToHost(func(q *ent.CoreUpstreamHostQuery) {
+			q.Where(coreupstreamhost.DeletedAtIsNil(), coreupstreamhost.Enabled(constant.Yes)).
+				Order(coreupstreamhost.ByCreatedAt(sql.OrderAsc()))
+		}).
+		Order(coreupstream.ByCreatedAt(sql.OrderAsc())).
+		All(ctx)
+	if err != nil {
+		global.Logger.Sugar().Errorf("select core_upstream failed: %s", err)
+		return nil, nil, err
+	}
+
+	routes, err := global.EntClient.CoreGatewayHttpRoute.Query().
+		Where(coregatewayhttproute.DeletedAtIsNil(), coregatewayhttproute.Status(constant.Yes)).
+		WithRouteToTarget(func(q *ent.CoreGatewayHttpRouteTargetQuery) {
+			q.Where(coregatewayhttproutetarget.DeletedAtIsNil()).
+				Order(coregatewayhttproutetarget.ByCreatedAt(sql.OrderAsc()))
+		}).
+		Order(coregatewayhttproute.ByCreatedAt(sql.OrderAsc())).
+		All(ctx)
+	if err != nil {
+		global.Logger.Sugar().Errorf("select core_gateway_http_route failed: %s", err)
+		return nil, nil, err
+	}
+
+	listeners, err := global.EntClient.CoreGatewayL7Listener.Query().
+		Where(coregatewayl7listener.DeletedAtIsNil(), coregatewayl7listener.Status(constant.Yes)).
+		Order(coregatewayl7listener.ByCreatedAt(sql.OrderAsc())).
+		All(ctx)
+	if err != nil {
+		global.Logger.Sugar().Errorf("select core_gateway_l7_listener failed: %s", err)
+		return nil, nil, err
+	}
+
+	vhosts, err := global.EntClient.CoreGatewayVirtualHost.Query().
+		Where(coregatewayvirtualhost.DeletedAtIsNil(), coregatewayvirtualhost.Status(constant.Yes)).
+		Order(coregatewayvirtualhost.ByCreatedAt(sql.OrderAsc())).
+		All(ctx)
+	if err != nil {
+		global.Logger.Sugar().Errorf("select core_gateway_virtual_host failed: %s", err)
+		return nil, nil, err
+	}
+
+	rules, err := global.EntClient.CoreGatewayRateLimitRule.Query().
+		Where(coregatewayratelimitrule.DeletedAtIsNil(), coregatewayratelimitrule.Status(constant.Yes)).
+		Order(coregatewayratelimitrule.ByCreatedAt(sql.OrderAsc())).
+		All(ctx)
+	if err != nil {
+		global.Logger.Sugar().Errorf("select core_gateway_rate_limit_rule failed: %s", err)
+		return nil, nil, err
+	}
+
+	// 集群记录随 Gateway 断开被软删除，但维护模式与 Gateway 是否在线无关，不过滤已删除的记录
+	clusters, err := global.EntClient.CoreGatewayCluster.Query().
+		Where(coregatewaycluster.MaintenanceNotNil()).
+		Order(coregatewaycluster.ByClusterCreateTime(sql.OrderAsc())).
+		All(ctx)
+	if err != nil {
+		global.Logger.Sugar().Errorf("select core_gateway_cluster failed: %s", err)
+		return nil, nil, err
+	}
+
+	certs, err := global.EntClient.CoreCert.Query().
+		Where(corecert.DeletedAtIsNil(), corecert.Status(constant.Yes)).
+		Order(corecert.ByCreatedAt(sql.OrderAsc())).
+		All(ctx)
+	if err != nil {
+		global.Logger.Sugar().Errorf("select core_cert failed: %s", err)
+		return nil, nil, err
+	}
+
+	cfg := &v1.ProxyConfig{}
+	var skipped []SkippedRoute
+	enabled := make(map[string]struct{}, len(upstreams))
+	for _, u := range upstreams {
+		enabled[u.ID] = struct{}{}
+		cfg.Upstreams = append(cfg.Upstreams, toUpstream(u))
+	}
+
+	scopes := make(map[string]struct{}, len(vhosts))
+	for _, vh := range vhosts {
+		scopes[vh.ID] = struct{}{}
+		v := toVirtualHost(vh)
+		// 不合法的维护模式不下发，避免整个虚拟主机被 Gateway 拒绝
+		if err := envoy.ValidateMaintenance(v.GetMaintenance()); err != nil {
+			global.Logger.Sugar().Warnf("drop maintenance of virtual host %s(%s): %v", vh.Name, vh.ID, err)
+			v.Maintenance = nil
+		}
+		cfg.VirtualHosts = append(cfg.VirtualHosts, v)
+	}
+
+	now := time.Now().Unix()
+nextRoute:
+	for _, r := range routes {
+		// 所属虚拟主机不存在或已禁用的路由不下发，避免落入其他域名
+		if len(r.VirtualHostID) > 0 {
+			if _, ok := scopes[r.VirtualHostID]; !ok {
+				global.Logger.Sugar().Warnf("skip http route %s(%s): virtual host %q not available", r.Name, r.ID, r.VirtualHostID)
+				skipped = append(skipped, SkippedRoute{Route: r, VirtualHostID: r.VirtualHostID})
+				continue
+			}
+		}
+
+		// 任一目标上游不存在或已禁用的路由不下发，避免流量按错误的比例分配
+		route := ToHttpRoute(r)
+		for _, id := range envoy.RouteUpstreamIDs(route) {
+			if _, ok := enabled[id]; !ok {
+				global.Logger.Sugar().Warnf("skip http route %s(%s): upstream %q not available", r.Name, r.ID, id)
+				skipped = append(skipped, SkippedRoute{Route: r, UpstreamID: id})
+				continue nextRoute
+			}
+		}
+
+		// 已到期的故障注入不再下发
+		if p := route.GetFaultPolicy(); p != nil && p.GetExpiresAt() <= now {
+			route.FaultPolicy = nil
+		}
+
+		// 影子上游不可用或与转发目标重复时只取消镜像，不影响路由本身的转发
+		if id := route.GetMirrorUpstreamId(); id != "" {
+			_, ok := enabled[id]
+			if err := envoy.ValidateRouteMirror(route); !ok || err != nil {
+				global.Logger.Sugar().Warnf("drop mirror of http route %s(%s): upstream %q not available or invalid: %v", r.Name, r.ID, id, err)
+				route.MirrorUpstreamId, route.MirrorPercent = "", 0
+			}
+		}
+		cfg.HttpRoutes = append(cfg.HttpRoutes, route)
+	}
+
+	for _, l := range listeners {
+		cfg.L7Listeners = append(cfg.L7Listeners, toL7Listener(l))
+	}
+
+	// 不合法的全局限流规则不下发，Gateway 的限流服务按其余规则计数
+	for _, r := range rules {
+		rule := toRateLimitRule(r)
+		if err := envoy.ValidateRateLimitRule(rule); err != nil {
+			global.Logger.Sugar().Warnf("skip rate limit rule %s(%s): %v", r.Name, r.ID, err)
+			continue
+		}
+		cfg.RateLimitRules = append(cfg.RateLimitRules, rule)
+	}
+
+	for _, c := range clusters {
+		m := ToMaintenance(c.Maintenance)
+		if m == nil {
+			continue
+		}
+		if err := envoy.ValidateMaintenance(m); err != nil {
+			global.Logger.Sugar().Warnf("skip maintenance of gateway cluster %s: %v", c.ClusterID, err)
+			continue
+		}
+		cfg.ClusterMaintenances = append(cfg.ClusterMaintenances, &v1.ClusterMaintenance{Id: c.ID, ClusterId: c.ClusterID, Maintenance: m})
+	}
+
+	for _, c := range certs {
+		cfg.Certs = append(cfg.Certs, toCert(c))
+	}
+
+	return cfg, skipped, nil
+}
+
+func toUpstream(e *ent.CoreUpstream) *v1.Upstream {
+	u := &v1.Upstream{
+		Id:                 e.ID,
+		Name:               e.Name,
+		LbPolicy:           int32(e.LbPolicy),
+		ConnectTimeoutMs:   int32(e.ConnectTimeoutMs),
+		MaxConnections:     int32(e.MaxConnections),
+		MaxPendingRequests: int32(e.MaxPendingRequests),
+		MaxRequests:        int32(e.MaxRequests),
+		MaxRetries:         int32(e.MaxRetries),
+		RingMinSize:        e.RingMinSize,
+		RingMaxSize:        e.RingMaxSize,
+		MaglevTableSize:    e.MaglevTableSize,
+	}
+	for _, h := range e.Edges.UpstreamToHost {
+		u.Hosts = append(u.Hosts, &v1.UpstreamHost{
+			Id:      h.ID,
+			Address: h.Address,
+			Port:    uint32(h.Port),
+			Weight:  uint32(h.Weight),
+		})
+	}
+	return u
+}
+
+// ToHttpRoute 将路由转换为下发格式，加权分流目标取自预加载的 RouteToTarget
+func ToHttpRoute(e *ent.CoreGatewayHttpRoute) *v1.HttpRoute {
+	r := &v1.HttpRoute{
+		Id:                 e.ID,
+		Name:               e.Name,
+		UpstreamId:         e.UpstreamID,
+		MatchType:          int32(e.MatchType),
+		MatchPattern:       e.MatchPattern,
+		Priority:           int32(e.Priority),
+		TimeoutMs:          int32(e.TimeoutMs),
+		EnablePathRewrite:  e.EnablePathRewrite == constant.Yes,
+		PathRewrite:        e.PathRewrite,
+		EnableRedirect:     e.EnableRedirect == constant.Yes,
+		RedirectUrl:        e.RedirectURL,
+		RedirectCode:       int32(e.RedirectCode),
+		ClusterId:          e.ClusterID,
+		IgnoreCase:         e.IgnoreCase == constant.Yes,
+		Methods:            e.Methods,
+		Headers:            ToHttpMatchers(e.HeaderMatchers),
+		QueryParameters:    ToHttpMatchers(e.QueryMatchers),
+		RetryPolicy:        ToRetryPolicy(e.RetryPolicy),
+		VirtualHostId:      e.VirtualHostID,
+		HeaderMutation:     ToHeaderMutation(e.HeaderMutation),
+		MirrorUpstreamId:   e.MirrorUpstreamID,
+		MirrorPercent:      e.MirrorPercent,
+		FaultPolicy:        ToFaultPolicy(e.FaultPolicy),
+		CorsPolicy:         ToCorsPolicy(e.CorsPolicy),
+		LocalRateLimit:     ToLocalRateLimit(e.LocalRateLimit),
+		RateLimitActions:   ToRateLimitActions(e.RateLimitActions),
+		DirectResponse:     ToDirectResponse(e.DirectResponse),
+		HashPolicies:       ToHashPolicies(e.HashPolicies),
+		DisableCompression: e.DisableCompression == constant.Yes,
+	}
+	for _, t := range e.Edges.RouteToTarget {
+		r.Targets = append(r.Targets, &v1.RouteTarget{
+			Id:         t.ID,
+			UpstreamId: t.UpstreamID,
+			Weight:     uint32(t.Weight),
+		})
+	}
+	return r
+}
+
+// ToHttpMatchers 将路由的请求头或查询参数匹配条件转换为下发格式
+func ToHttpMatchers(ms []common.HttpMatcher) []*v1.HttpMatcher {
+	out := make([]*v1.HttpMatcher, 0, len(ms))
+	for _, m := range ms {
+		out = append(out, &v1.HttpMatcher{
+			Name:       m.Name,
+			Type:       int32(m.Type),
+			Value:      m.Value,
+			Invert:     m.Invert,
+			IgnoreCase: m.IgnoreCase,
+		})
+	}
+	return out
+}
+
+// ToRetryPolicy 将路由的重试策略转换为下发格式，未配置时返回 nil
+func ToRetryPolicy(p *common.RetryPolicy) *v1.RetryPolicy {
+	if p == nil || len(p.RetryOn) == 0 {
+		return nil
+	}
+	out := &v1.RetryPolicy{
+		NumRetries:      uint32(p.NumRetries),
+		PerTryTimeoutMs: uint32(p.PerTryTimeoutMs),
+		BackoffBaseMs:   uint32(p.BackoffBaseMs),
+		BackoffMaxMs:    uint32(p.BackoffMaxMs),
+	}
+	for _, on := range p.RetryOn {
+		out.RetryOn = append(out.RetryOn, string(on))
+	}
+	for _, c := range p.RetriableStatusCodes {
+		out.RetriableStatusCodes = append(out.RetriableStatusCodes, uint32(c))
+	}
+	return out
+}
+
+// ToHeaderMutation 将请求头与响应头改写规则转换为下发格式，未配置时返回 nil
+func ToHeaderMutation(m *common.HeaderMutation) *v1.HeaderMutation {
+	if m == nil {
+		return nil
+	}
+	out := &v1.HeaderMutation{
+		RequestHeadersToRemove:  m.RequestHeadersToRemove,
+		ResponseHeadersToRemove: m.ResponseHeadersToRemove,
+	}
+	for _, h := range m.RequestHeadersToAdd {
+		out.RequestHeadersToAdd = append(out.RequestHeadersToAdd, &v1.HeaderValue{Name: h.Name, Value: h.Value, Action: int32(h.Action)})
+	}
+	for _, h := range m.ResponseHeadersToAdd {
+		out.ResponseHeadersToAdd = append(out.ResponseHeadersToAdd, &v1.HeaderValue{Name: h.Name, Value: h.Value, Action: int32(h.Action)})
+	}
+	return out
+}
+
+// ToFaultPolicy 将路由的故障注入转换为下发格式，未配置时返回 nil
+func ToFaultPolicy(p *common.FaultPolicy) *v1.FaultPolicy {
+	if p == nil {
+		return nil
+	}
+	return &v1.FaultPolicy{
+		DelayMs:         uint32(p.DelayMs),
+		DelayPercent:    p.DelayPercent,
+		AbortHttpStatus: uint32(p.AbortHttpStatus),
+		AbortGrpcStatus: uint32(p.AbortGrpcStatus),
+		AbortPercent:    p.AbortPercent,
+		HeaderName:      p.HeaderName,
+		HeaderValue:     p.HeaderValue,
+		ExpiresAt:       p.ExpiresAt,
+	}
+}
+
+// ToCorsPolicy 将跨域策略转换为下发格式，未配置允许来源时返回 nil
+func ToCorsPolicy(p *common.CorsPolicy) *v1.CorsPolicy {
+	if p == nil || len(p.AllowOrigins) == 0 {
+		return nil
+	}
+	out := &v1.CorsPolicy{
+		AllowMethods:     p.AllowMethods,
+		AllowHeaders:     p.AllowHeaders,
+		ExposeHeaders:    p.ExposeHeaders,
+		MaxAgeSeconds:    uint32(p.MaxAgeSeconds),
+		AllowCredentials: p.AllowCredentials,
+	}
+	for _, o := range p.AllowOrigins {
+		out.AllowOrigins = append(out.AllowOrigins, &v1.CorsOrigin{Type: int32(o.Type), Value: o.Value})
+	}
+	return out
+}
+
+// ToLocalRateLimit 将本地限流转换为下发格式，令牌桶容量为 0 时返回 nil
+func ToLocalRateLimit(l *common.LocalRateLimit) *v1.LocalRateLimit {
+	if l == nil || l.MaxTokens <= 0 {
+		return nil
+	}
+	return &v1.LocalRateLimit{
+		MaxTokens:      uint32(l.MaxTokens),
+		TokensPerFill:  uint32(l.TokensPerFill),
+		FillIntervalMs: uint32(l.FillIntervalMs),
+		StatusCode:     uint32(l.StatusCode),
+		HeaderName:     l.HeaderName,
+		HeaderValue:    l.HeaderValue,
+	}
+}
+
+func toL7Listener(e *ent.CoreGatewayL7Listener) *v1.L7Listener {
+	return &v1.L7Listener{
+		Id:             e.ID,
+		Name:           e.Name,
+		Host:           e.Host,
+		Port:           uint32(e.Port),
+		EnableTls:      e.EnableTLS == constant.Yes,
+		ClusterId:      e.ClusterID,
+		LocalRateLimit: ToLocalRateLimit(e.LocalRateLimit),
+		Compression:    ToCompression(e.Compression),
+	}
+}
+
+// ToCompression 将监听器的响应压缩转换为下发格式，未选择压缩算法时返回 nil
+func ToCompression(c *common.Compression) *v1.Compression {
+	if c == nil || len(c.Algorithms) == 0 {
+		return nil
+	}
+	out := &v1.Compression{
+		ContentTypes:     c.ContentTypes,
+		MinContentLength: uint32(c.MinContentLength),
+		Level:            uint32(c.Level),
+	}
+	for _, a := range c.Algorithms {
+		out.Algorithms = append(out.Algorithms, int32(a))
+	}
+	return out
+}
+
+// ToDirectResponse 将路由的直接响应转换为下发格式，未设置状态码时返回 nil
+func ToDirectResponse(d *common.DirectResponse) *v1.DirectResponse {
+	if d == nil || d.StatusCode == 0 {
+		return nil
+	}
+	return &v1.DirectResponse{
+		Status:      uint32(d.StatusCode),
+		Body:        d.Body,
+		ContentType: d.ContentType,
+	}
+}
+
+// ToMaintenance 将维护模式转换为下发格式，未开启时返回 nil
+func ToMaintenance(m *common.Maintenance) *v1.Maintenance {
+	if m == nil || !m.Enabled {
+		return nil
+	}
+	return &v1.Maintenance{
+		Response:   ToDirectResponse(&m.Response),
+		AllowPaths: m.AllowPaths,
+		AllowIps:   m.AllowIPs,
+	}
+}
+
+func toVirtualHost(e *ent.CoreGatewayVirtualHost) *v1.VirtualHost {
+	return &v1.VirtualHost{
+		Id:             e.ID,
+		Name:           e.Name,
+		ListenerId:     e.ListenerID,
+		Domains:        e.Domains,
+		HeaderMutation: ToHeaderMutation(e.HeaderMutation),
+		CorsPolicy:     ToCorsPolicy(e.CorsPolicy),
+		Maintenance:    ToMaintenance(e.Maintenance),
+	}
+}
+
+// ToRateLimitActions 将全局限流描述符条目转换为下发格式
+func ToRateLimitActions(actions []common.RateLimitAction) []*v1.RateLimitAction {
+	if len(actions) == 0 {
+		return nil
+	}
+	out := make([]*v1.RateLimitAction, 0, len(actions))
+	for _, a := range actions {
+		out = append(out, &v1.RateLimitAction{
+			Type:            int32(a.Type),
+			DescriptorKey:   a.DescriptorKey,
+			DescriptorValue: a.DescriptorValue,
+			Name:            a.Name,
+		})
+	}
+	return out
+}
+
+// ToHashPolicies 将路由的哈希策略转换为下发格式
+func ToHashPolicies(policies []common.HashPolicy) []*v1.HashPolicy {
+	if len(policies) == 0 {
+		return nil
+	}
+	out := make([]*v1.HashPolicy, 0, len(policies))
+	for _, p := range policies {
+		out = append(out, &v1.HashPolicy{
+			Type:             int32(p.Type),
+			Name:             p.Name,
+			CookieTtlSeconds: uint32(p.CookieTtlSeconds),
+			CookiePath:       p.CookiePath,
+			Terminal:         p.Terminal,
+		})
+	}
+	return out
+}
+
+func toRateLimitRule(e *ent.CoreGatewayRateLimitRule) *v1.RateLimitRule {
+	return &v1.RateLimitRule{
+		Id:              e.ID,
+		Name:            e.Name,
+		Descriptors:     ToRateLimitDescriptors(e.Descriptors),
+		Unit:            int32(e.Unit),
+		RequestsPerUnit: e.RequestsPerUnit,
+	}
+}
+
+// ToRateLimitDescriptors 将全局限流规则的描述符条目转换为下发格式
+func ToRateLimitDescriptors(descriptors []common.RateLimitDescriptor) []*v1.RateLimitDescriptor {
+	out := make([]*v1.RateLimitDescriptor, 0, len(descriptors))
+	for _, d := range descriptors {
+		out = append(out, &v1.RateLimitDescriptor{Key: d.Key, Value: d.Value})
+	}
+	return out
+}
+
+func toCert(e *ent.CoreCert) *v1.Cert {
+	return &v1.Cert{
+		Id:              e.ID,
+		Name:            e.Name,
+		SerialNumber:    e.SerialNumber,
+		SubjectCn:       e.SubjectCn,
+		NotAfter:        e.NotAfter,
+		SecretType:      int32(e.SecretType),
+		CertificateHash: e.CertificateHash,
+		PrivateKeyHash:  e.PrivateKeyHash,
+	}
+}
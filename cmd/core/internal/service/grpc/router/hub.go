@@ -0,0 +1,322 @@
+package router
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/lyonmu/quebec/cmd/core/internal/ent"
+	"github.com/lyonmu/quebec/cmd/core/internal/global"
+	"github.com/lyonmu/quebec/cmd/core/internal/utils"
+	v1 "github.com/lyonmu/quebec/idl/router/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// hubDebounce 合并短时间内的多次变更，同时等待事务提交
+	hubDebounce = 500 * time.Millisecond
+	// hubResyncInterval 定期全量比对数据库，兜底直接修改数据库等未经过 hook 的变更
+	hubResyncInterval = 30 * time.Second
+	// subscriberBuffer 单个订阅者可积压的增量数量，超出后改为推送完整配置
+	subscriberBuffer = 64
+)
+
+// subscriber 表示一个通过 ConfigSync 订阅配置的 Gateway 连接
+type subscriber struct {
+	updates chan *v1.ConfigSyncResponse
+	resync  chan struct{}
+}
+
+// ConfigHub 维护当前生效的代理配置及其修订号，并向所有订阅的 Gateway 推送变更。
+// 每次发布的配置都记录为不可变修订 (CoreProxyRevision)
+type ConfigHub struct {
+	mu          sync.RWMutex
+	revision    int64
+	config      *v1.ProxyConfig
+	subscribers map[*subscriber]struct{}
+	notifyCh    chan struct{}
+
+	// rollouts 生效中的灰度发布 (集群 -> 灰度状态)，随每条响应下发
+	rollouts   map[string]*v1.Rollout
+	rolloutSeq int64
+	rolloutCh  chan struct{}
+
+	// pending 下一次发布的来源信息
+	pendingMu sync.Mutex
+	pending   revisionMeta
+
+	// faultTimer 在最近一个故障注入到期时触发刷新，仅在 reload 中访问
+	faultTimer *time.Timer
+}
+
+func NewConfigHub() *ConfigHub {
+	return &ConfigHub{
+		config:      &v1.ProxyConfig{},
+		subscribers: make(map[*subscriber]struct{}),
+		notifyCh:    make(chan struct{}, 1),
+		rollouts:    make(map[string]*v1.Rollout),
+		rolloutCh:   make(chan struct{}, 1),
+	}
+}
+
+// Start 恢复最近一次发布的修订与生效中的灰度发布，加载当前配置并启动后台刷新协程
+func (h *ConfigHub) Start() {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	revision, cfg, err := loadLatestRevision(ctx)
+	cancel()
+	if err != nil {
+		global.Logger.Sugar().Errorf("load latest proxy config revision failed: %v", err)
+	} else {
+		h.revision, h.config = revision, cfg
+		global.Logger.Sugar().Infof("proxy config revision %d restored", revision)
+	}
+
+	h.syncRollouts(context.Background())
+	h.reload(context.Background())
+	go h.runLoop()
+	go h.runRolloutLoop()
+}
+
+func (h *ConfigHub) runLoop() {
+	ticker := time.NewTicker(hubResyncInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-h.notifyCh:
+			time.Sleep(hubDebounce)
+		case <-ticker.C:
+		}
+		h.reload(context.Background())
+	}
+}
+
+// Notify 通知配置可能发生变化，非阻塞
+func (h *ConfigHub) Notify() {
+	select {
+	case h.notifyCh <- struct{}{}:
+	default:
+	}
+}
+
+// Hook 返回在代理实体变更成功后触发刷新的 ent hook，同时记录变更的操作人与回滚来源。
+// 通过 ent.NewTxContext 携带事务的变更在事务提交后才触发刷新
+func (h *ConfigHub) Hook() ent.Hook {
+	return func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			v, err := next.Mutate(ctx, m)
+			if err != nil {
+				return v, err
+			}
+
+			tx := ent.TxFromContext(ctx)
+			if tx == nil {
+				h.record(ctx)
+				return v, nil
+			}
+			tx.OnCommit(func(next ent.Committer) ent.Committer {
+				return ent.CommitFunc(func(c context.Context, tx *ent.Tx) error {
+					if err := next.Commit(c, tx); err != nil {
+						return err
+					}
+					h.record(ctx)
+					return nil
+				})
+			})
+			return v, nil
+		})
+	}
+}
+
+// record 记录变更来源并触发刷新
+func (h *ConfigHub) record(ctx context.Context) {
+	h.pendingMu.Lock()
+	if userID := utils.OperatorFromContext(ctx); userID != "" {
+		h.pending.authorID = userID
+	}
+	if revision, ok := utils.RollbackFromContext(ctx); ok {
+		h.pending.rollbackFrom = revision
+	}
+	h.pendingMu.Unlock()
+	h.Notify()
+}
+
+// Full 返回当前修订号的完整配置
+func (h *ConfigHub) Full() *v1.ConfigSyncResponse {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	return &v1.ConfigSyncResponse{
+		Type:       v1.ConfigSyncResponse_FULL,
+		Revision:   h.revision,
+		Config:     h.config,
+		Rollouts:   h.rolloutList(),
+		RolloutSeq: h.rolloutSeq,
+	}
+}
+
+// Subscribe 注册订阅者，返回的 cancel 用于连接断开时注销
+func (h *ConfigHub) Subscribe() (*subscriber, func()) {
+	sub := &subscriber{
+		updates: make(chan *v1.ConfigSyncResponse, subscriberBuffer),
+		resync:  make(chan struct{}, 1),
+	}
+
+	h.mu.Lock()
+	h.subscribers[sub] = struct{}{}
+	h.mu.Unlock()
+
+	return sub, func() {
+		h.mu.Lock()
+		delete(h.subscribers, sub)
+		h.mu.Unlock()
+	}
+}
+
+// Resync 要求订阅者下一次发送完整配置
+func (s *subscriber) Resync() {
+	select {
+	case s.resync <- struct{}{}:
+	default:
+	}
+}
+
+// reload 从数据库重新加载配置，与当前配置比对后生成增量并广播
+func (h *ConfigHub) reload(ctx context.Context) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	cfg, err := LoadProxyConfig(ctx)
+	if err != nil {
+		global.Logger.Sugar().Errorf("reload proxy config failed: %v", err)
+		return
+	}
+	h.scheduleFaultExpiry(cfg)
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.pendingMu.Lock()
+	meta := h.pending
+	h.pending = revisionMeta{}
+	h.pendingMu.Unlock()
+
+	changed, removed := diffProxyConfig(h.config, cfg)
+	if changed == nil && removed == nil {
+		return
+	}
+
+	// 先持久化修订，失败时保持当前修订，等待下一次刷新重试
+	if err := saveRevision(ctx, h.revision+1, cfg, meta, revisionSummary(changed, removed)); err != nil {
+		global.Logger.Sugar().Errorf("save proxy config revision %d failed: %v", h.revision+1, err)
+		h.pendingMu.Lock()
+		if h.pending.authorID == "" {
+			h.pending.authorID = meta.authorID
+		}
+		if h.pending.rollbackFrom == 0 {
+			h.pending.rollbackFrom = meta.rollbackFrom
+		}
+		h.pendingMu.Unlock()
+		return
+	}
+
+	// 灰度状态随增量一并下发，保证灰度集群的非灰度节点不会先收到新修订
+	h.startRollouts(ctx, h.revision, h.revision+1)
+
+	update := &v1.ConfigSyncResponse{
+		Type:         v1.ConfigSyncResponse_INCREMENTAL,
+		Revision:     h.revision + 1,
+		BaseRevision: h.revision,
+		Config:       changed,
+		Removed:      removed,
+		Rollouts:     h.rolloutList(),
+		RolloutSeq:   h.rolloutSeq,
+	}
+	h.revision = update.Revision
+	h.config = cfg
+
+	global.Logger.Sugar().Infof("proxy config changed, revision: %d, subscribers: %d", h.revision, len(h.subscribers))
+	h.broadcast(update)
+}
+
+// scheduleFaultExpiry 在最近一个故障注入到期时触发刷新，使到期的故障及时从配置中移除
+func (h *ConfigHub) scheduleFaultExpiry(cfg *v1.ProxyConfig) {
+	var next int64
+	for _, r := range cfg.GetHttpRoutes() {
+		if at := r.GetFaultPolicy().GetExpiresAt(); at > 0 && (next == 0 || at < next) {
+			next = at
+		}
+	}
+
+	if h.faultTimer != nil {
+		h.faultTimer.Stop()
+		h.faultTimer = nil
+	}
+	if next > 0 {
+		h.faultTimer = time.AfterFunc(time.Until(time.Unix(next, 0)), h.Notify)
+	}
+}
+
+// broadcast 向所有订阅者推送，调用方需持有 h.mu
+func (h *ConfigHub) broadcast(update *v1.ConfigSyncResponse) {
+	for sub := range h.subscribers {
+		select {
+		case sub.updates <- update:
+		default:
+			// 积压过多，放弃增量，改为推送完整配置
+			sub.Resync()
+		}
+	}
+}
+
+// diffProxyConfig 比较新旧配置，返回新增/变更的资源与被删除的资源 ID，无变化时均返回 nil
+func diffProxyConfig(prev, next *v1.ProxyConfig) (*v1.ProxyConfig, *v1.RemovedResources) {
+	changed := &v1.ProxyConfig{}
+	removed := &v1.RemovedResources{}
+
+	changed.Upstreams, removed.UpstreamIds = diffResources(prev.GetUpstreams(), next.GetUpstreams())
+	changed.HttpRoutes, removed.HttpRouteIds = diffResources(prev.GetHttpRoutes(), next.GetHttpRoutes())
+	changed.L7Listeners, removed.L7ListenerIds = diffResources(prev.GetL7Listeners(), next.GetL7Listeners())
+	changed.Certs, removed.CertIds = diffResources(prev.GetCerts(), next.GetCerts())
+	changed.VirtualHosts, removed.VirtualHostIds = diffResources(prev.GetVirtualHosts(), next.GetVirtualHosts())
+	changed.RateLimitRules, removed.RateLimitRuleIds = diffResources(prev.GetRateLimitRules(), next.GetRateLimitRules())
+	changed.ClusterMaintenances, removed.ClusterMaintenanceIds = diffResources(prev.GetClusterMaintenances(), next.GetClusterMaintenances())
+
+	hasChanged := len(changed.Upstreams)+len(changed.HttpRoutes)+len(changed.L7Listeners)+len(changed.Certs)+
+		len(changed.VirtualHosts)+len(changed.RateLimitRules)+len(changed.ClusterMaintenances) > 0
+	hasRemoved := len(removed.UpstreamIds)+len(removed.HttpRouteIds)+len(removed.L7ListenerIds)+len(removed.CertIds)+
+		len(removed.VirtualHostIds)+len(removed.RateLimitRuleIds)+len(removed.ClusterMaintenanceIds) > 0
+
+	if !hasChanged && !hasRemoved {
+		return nil, nil
+	}
+	return changed, removed
+}
+
+type identified interface {
+	proto.Message
+	GetId() string
+}
+
+func diffResources[T identified](prev, next []T) ([]T, []string) {
+	old := make(map[string]T, len(prev))
+	for _, r := range prev {
+		old[r.GetId()] = r
+	}
+
+	var changed []T
+	for _, r := range next {
+		if o, ok := old[r.GetId()]; !ok || !proto.Equal(o, r) {
+			changed = append(changed, r)
+		}
+		delete(old, r.GetId())
+	}
+
+	var removed []string
+	for id := range old {
+		removed = append(removed, id)
+	}
+	sort.Strings(removed)
+	return changed, removed
+}
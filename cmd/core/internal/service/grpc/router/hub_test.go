@@ -0,0 +1,169 @@
+package router
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/lyonmu/quebec/cmd/core/internal/common"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/coregatewaycluster"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/coreproxyrevision"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/enttest"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/migrate"
+	_ "github.com/lyonmu/quebec/cmd/core/internal/ent/runtime"
+	"github.com/lyonmu/quebec/cmd/core/internal/global"
+	"github.com/lyonmu/quebec/cmd/core/internal/service/grpc/node"
+	"github.com/lyonmu/quebec/cmd/core/internal/utils"
+	nodev1 "github.com/lyonmu/quebec/idl/node/v1"
+	v1 "github.com/lyonmu/quebec/idl/router/v1"
+	"github.com/lyonmu/quebec/pkg/tools"
+	_ "github.com/mattn/go-sqlite3"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+)
+
+func TestClusterMaintenancePush(t *testing.T) {
+	global.Logger = zap.NewNop()
+	id, err := tools.NewSonySnowFlake(func() (int, error) { return 1, nil })
+	if err != nil {
+		t.Fatalf("create id generator failed: %v", err)
+	}
+	global.Id = id
+	client := enttest.Open(t, "sqlite3", "file:hub?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+	global.EntClient = client
+
+	ctx := context.Background()
+	hub := NewConfigHub()
+	useHooks(client, hub)
+	hub.reload(ctx)
+	sub, cancel := hub.Subscribe()
+	defer cancel()
+
+	cluster, err := client.CoreGatewayCluster.Create().SetClusterID("edge").Save(ctx)
+	if err != nil {
+		t.Fatalf("create cluster failed: %v", err)
+	}
+
+	// 节点上报请求时间时的集群更新不触发刷新
+	if err := client.CoreGatewayCluster.UpdateOne(cluster).SetClusterLastRequestTime(time.Now().Unix()).Exec(ctx); err != nil {
+		t.Fatalf("update cluster last request time failed: %v", err)
+	}
+	if len(hub.notifyCh) != 0 {
+		t.Fatal("expected no reload for cluster last request time")
+	}
+
+	// 开启维护模式立即触发刷新，并记录操作人
+	m := &common.Maintenance{Enabled: true, Response: common.DirectResponse{StatusCode: 503, Body: "maintenance"}}
+	if err := client.CoreGatewayCluster.UpdateOne(cluster).SetMaintenance(m).Exec(utils.WithOperator(ctx, "u1")); err != nil {
+		t.Fatalf("update cluster maintenance failed: %v", err)
+	}
+	if len(hub.notifyCh) != 1 {
+		t.Fatal("expected reload for cluster maintenance")
+	}
+	hub.reload(ctx)
+
+	var resp *v1.ConfigSyncResponse
+	select {
+	case resp = <-sub.updates:
+	default:
+		t.Fatal("expected a pushed update after cluster maintenance changed")
+	}
+	if resp.GetType() != v1.ConfigSyncResponse_INCREMENTAL || len(resp.GetConfig().GetClusterMaintenances()) != 1 ||
+		resp.GetConfig().GetClusterMaintenances()[0].GetClusterId() != "edge" {
+		t.Fatalf("expected incremental update with edge maintenance, got %v", resp)
+	}
+	rev, err := client.CoreProxyRevision.Query().Where(coreproxyrevision.Revision(resp.GetRevision())).Only(ctx)
+	if err != nil {
+		t.Fatalf("query revision %d failed: %v", resp.GetRevision(), err)
+	}
+	if rev.AuthorID != "u1" {
+		t.Fatalf("expected revision authored by u1, got %q", rev.AuthorID)
+	}
+
+	// 关闭维护模式同样立即下发
+	if err := client.CoreGatewayCluster.UpdateOne(cluster).ClearMaintenance().Exec(ctx); err != nil {
+		t.Fatalf("clear cluster maintenance failed: %v", err)
+	}
+	hub.reload(ctx)
+	select {
+	case resp = <-sub.updates:
+	default:
+		t.Fatal("expected a pushed update after cluster maintenance cleared")
+	}
+	if resp.GetType() != v1.ConfigSyncResponse_INCREMENTAL || len(resp.GetRemoved().GetClusterMaintenanceIds()) != 1 {
+		t.Fatalf("expected incremental removal of edge maintenance, got %v", resp)
+	}
+}
+
+// gatewayStream 模拟 Gateway 的节点状态上报流，依次返回 events 后断开
+type gatewayStream struct {
+	grpc.ServerStream
+	events []*nodev1.EnvoyStatusEvent
+}
+
+func (s *gatewayStream) Recv() (*nodev1.EnvoyStatusEvent, error) {
+	if len(s.events) == 0 {
+		return nil, io.EOF
+	}
+	e := s.events[0]
+	s.events = s.events[1:]
+	return e, nil
+}
+
+func (s *gatewayStream) Send(*nodev1.BaseResponse) error {
+	return nil
+}
+
+func TestClusterMaintenanceSurvivesGatewayDisconnect(t *testing.T) {
+	global.Logger = zap.NewNop()
+	id, err := tools.NewSonySnowFlake(func() (int, error) { return 1, nil })
+	if err != nil {
+		t.Fatalf("create id generator failed: %v", err)
+	}
+	global.Id = id
+	// 与线上迁移保持一致，不创建外键约束
+	client := enttest.Open(t, "sqlite3", "file:hub-disconnect?mode=memory&cache=shared&_fk=1",
+		enttest.WithMigrateOptions(migrate.WithForeignKeys(false)))
+	defer client.Close()
+	global.EntClient = client
+
+	ctx := context.Background()
+	m := &common.Maintenance{Enabled: true, Response: common.DirectResponse{StatusCode: 503, Body: "maintenance"}}
+	if _, err := client.CoreGatewayCluster.Create().SetClusterID("edge").SetMaintenance(m).Save(ctx); err != nil {
+		t.Fatalf("create cluster failed: %v", err)
+	}
+
+	hub := NewConfigHub()
+	useHooks(client, hub)
+	hub.reload(ctx)
+	revision := hub.Full().Revision
+	if len(hub.Full().GetConfig().GetClusterMaintenances()) != 1 {
+		t.Fatal("expected edge maintenance in the initial config")
+	}
+	sub, cancel := hub.Subscribe()
+	defer cancel()
+
+	// Gateway 接入集群节点后断开，集群记录被软删除
+	stream := &gatewayStream{events: []*nodev1.EnvoyStatusEvent{
+		{Event: nodev1.EnvoyStatusEvent_CONNECT, GatewayId: 7, ClusterId: "edge", NodeId: "n1"},
+	}}
+	if err := node.NewNodeSvc().SyncEnvoyStatus(stream); err != nil {
+		t.Fatalf("sync envoy status failed: %v", err)
+	}
+	if client.CoreGatewayCluster.Query().Where(coregatewaycluster.ClusterID("edge"), coregatewaycluster.DeletedAtIsNil()).ExistX(ctx) {
+		t.Fatal("expected cluster soft deleted after gateway disconnect")
+	}
+
+	// 定时刷新时维护模式保持不变，不产生新的修订
+	hub.reload(ctx)
+	select {
+	case resp := <-sub.updates:
+		t.Fatalf("expected no update after gateway disconnect, got %v", resp)
+	default:
+	}
+	if full := hub.Full(); full.Revision != revision || len(full.GetConfig().GetClusterMaintenances()) != 1 {
+		t.Fatalf("expected revision %d with edge maintenance, got revision %d with %v", revision, full.Revision, full.GetConfig().GetClusterMaintenances())
+	}
+}
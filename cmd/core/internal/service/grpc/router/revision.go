@@ -0,0 +1,112 @@
+package router
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/coreproxyrevision"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/coreuser"
+	"github.com/lyonmu/quebec/cmd/core/internal/global"
+	v1 "github.com/lyonmu/quebec/idl/router/v1"
+	"github.com/lyonmu/quebec/pkg/constant"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// revisionMeta 两次发布之间累积的变更来源信息，由 ent hook 从请求上下文中收集
+type revisionMeta struct {
+	authorID     string
+	rollbackFrom int64
+}
+
+// LoadRevision 读取指定修订号的完整配置
+func LoadRevision(ctx context.Context, revision int64) (*ent.CoreProxyRevision, *v1.ProxyConfig, error) {
+	row, err := global.EntClient.CoreProxyRevision.Query().
+		Where(coreproxyrevision.Revision(revision), coreproxyrevision.DeletedAtIsNil()).
+		Only(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	cfg, err := unmarshalRevisionConfig(row.Config)
+	if err != nil {
+		return nil, nil, fmt.Errorf("decode revision %d: %w", revision, err)
+	}
+	return row, cfg, nil
+}
+
+// loadLatestRevision 读取最近一次发布的修订，不存在时返回修订号 0 与空配置
+func loadLatestRevision(ctx context.Context) (int64, *v1.ProxyConfig, error) {
+	row, err := global.EntClient.CoreProxyRevision.Query().
+		Where(coreproxyrevision.DeletedAtIsNil()).
+		Order(coreproxyrevision.ByRevision(sql.OrderDesc())).
+		First(ctx)
+	if ent.IsNotFound(err) {
+		return 0, &v1.ProxyConfig{}, nil
+	}
+	if err != nil {
+		return 0, nil, err
+	}
+	cfg, err := unmarshalRevisionConfig(row.Config)
+	if err != nil {
+		return 0, nil, fmt.Errorf("decode revision %d: %w", row.Revision, err)
+	}
+	return row.Revision, cfg, nil
+}
+
+// saveRevision 将发布的配置记录为不可变修订
+func saveRevision(ctx context.Context, revision int64, cfg *v1.ProxyConfig, meta revisionMeta, summary string) error {
+	data, err := protojson.Marshal(cfg)
+	if err != nil {
+		return err
+	}
+
+	create := global.EntClient.CoreProxyRevision.Create().
+		SetRevision(revision).
+		SetConfig(string(data)).
+		SetSummary(summary)
+
+	if meta.rollbackFrom > 0 {
+		create = create.SetAction(constant.RevisionActionRollback).SetRollbackFrom(meta.rollbackFrom)
+	}
+	if meta.authorID != "" {
+		create = create.SetAuthorID(meta.authorID)
+		if u, err := global.EntClient.CoreUser.Query().Where(coreuser.ID(meta.authorID)).Only(ctx); err == nil {
+			create = create.SetAuthorName(u.Username)
+		}
+	}
+
+	return create.Exec(ctx)
+}
+
+func unmarshalRevisionConfig(data string) (*v1.ProxyConfig, error) {
+	cfg := &v1.ProxyConfig{}
+	if err := protojson.Unmarshal([]byte(data), cfg); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
+// revisionSummary 生成增量变更摘要，如 "upstreams: 1 changed; http_routes: 2 removed"
+func revisionSummary(changed *v1.ProxyConfig, removed *v1.RemovedResources) string {
+	var parts []string
+	add := func(kind string, c, r int) {
+		switch {
+		case c > 0 && r > 0:
+			parts = append(parts, fmt.Sprintf("%s: %d changed, %d removed", kind, c, r))
+		case c > 0:
+			parts = append(parts, fmt.Sprintf("%s: %d changed", kind, c))
+		case r > 0:
+			parts = append(parts, fmt.Sprintf("%s: %d removed", kind, r))
+		}
+	}
+	add("upstreams", len(changed.GetUpstreams()), len(removed.GetUpstreamIds()))
+	add("http_routes", len(changed.GetHttpRoutes()), len(removed.GetHttpRouteIds()))
+	add("l7_listeners", len(changed.GetL7Listeners()), len(removed.GetL7ListenerIds()))
+	add("certs", len(changed.GetCerts()), len(removed.GetCertIds()))
+	add("virtual_hosts", len(changed.GetVirtualHosts()), len(removed.GetVirtualHostIds()))
+	add("rate_limit_rules", len(changed.GetRateLimitRules()), len(removed.GetRateLimitRuleIds()))
+	add("cluster_maintenances", len(changed.GetClusterMaintenances()), len(removed.GetClusterMaintenanceIds()))
+	return strings.Join(parts, "; ")
+}
@@ -0,0 +1,348 @@
+package router
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/lyonmu/quebec/cmd/core/internal/common"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/coregatewaynode"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/coreproxyrollout"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/coreproxyrolloutpolicy"
+	"github.com/lyonmu/quebec/cmd/core/internal/global"
+	v1 "github.com/lyonmu/quebec/idl/router/v1"
+	"github.com/lyonmu/quebec/pkg/constant"
+)
+
+// rolloutEvaluateInterval 评估灰度节点回执与错误率的周期
+const rolloutEvaluateInterval = 10 * time.Second
+
+// RolloutHook 返回在灰度发布记录变更后刷新灰度状态的 ent hook
+func (h *ConfigHub) RolloutHook() ent.Hook {
+	return func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			v, err := next.Mutate(ctx, m)
+			if err == nil {
+				select {
+				case h.rolloutCh <- struct{}{}:
+				default:
+				}
+			}
+			return v, err
+		})
+	}
+}
+
+func (h *ConfigHub) runRolloutLoop() {
+	ticker := time.NewTicker(rolloutEvaluateInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-h.rolloutCh:
+		case <-ticker.C:
+			evaluateRollouts(context.Background())
+		}
+		h.syncRollouts(context.Background())
+	}
+}
+
+// syncRollouts 从数据库加载生效中的灰度发布，状态变化时向所有订阅者推送
+func (h *ConfigHub) syncRollouts(ctx context.Context) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	rollouts, err := loadRollouts(ctx)
+	if err != nil {
+		global.Logger.Sugar().Errorf("load proxy rollouts failed: %v", err)
+		return
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if rolloutsEqual(h.rollouts, rollouts) {
+		return
+	}
+	h.rollouts = rollouts
+	h.rolloutSeq++
+
+	global.Logger.Sugar().Infof("proxy rollouts changed, %d in effect, subscribers: %d", len(rollouts), len(h.subscribers))
+	h.broadcast(&v1.ConfigSyncResponse{
+		Type:       v1.ConfigSyncResponse_ROLLOUT,
+		Revision:   h.revision,
+		Rollouts:   h.rolloutList(),
+		RolloutSeq: h.rolloutSeq,
+	})
+}
+
+// rolloutList 返回按集群排序的灰度发布列表，调用方需持有 h.mu
+func (h *ConfigHub) rolloutList() []*v1.Rollout {
+	list := make([]*v1.Rollout, 0, len(h.rollouts))
+	for _, r := range h.rollouts {
+		list = append(list, r)
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].ClusterId < list[j].ClusterId })
+	return list
+}
+
+// startRollouts 新修订发布时，为启用灰度策略的集群创建灰度发布，并取代之前生效的灰度发布。
+// 集群已有未全量的灰度时沿用其稳定修订，否则以上一个修订作为稳定修订。调用方需持有 h.mu
+func (h *ConfigHub) startRollouts(ctx context.Context, stable, revision int64) {
+	policies, err := global.EntClient.CoreProxyRolloutPolicy.Query().
+		Where(coreproxyrolloutpolicy.DeletedAtIsNil(), coreproxyrolloutpolicy.Status(constant.Yes)).
+		All(ctx)
+	if err != nil {
+		global.Logger.Sugar().Errorf("select core_proxy_rollout_policy failed: %v", err)
+		return
+	}
+
+	active, err := global.EntClient.CoreProxyRollout.Query().
+		Where(coreproxyrollout.DeletedAtIsNil(), coreproxyrollout.StatusIn(constant.RolloutStatusInProgress, constant.RolloutStatusAborted)).
+		All(ctx)
+	if err != nil {
+		global.Logger.Sugar().Errorf("select core_proxy_rollout failed: %v", err)
+		return
+	}
+
+	now := time.Now().Unix()
+	stables := make(map[string]int64, len(active))
+	for _, r := range active {
+		stables[r.ClusterID] = r.StableRevision
+		if err := global.EntClient.CoreProxyRollout.UpdateOneID(r.ID).
+			SetStatus(constant.RolloutStatusSuperseded).
+			SetReason(fmt.Sprintf("superseded by revision %d", revision)).
+			SetFinishedAt(now).
+			Exec(ctx); err != nil {
+			global.Logger.Sugar().Errorf("supersede rollout %s failed: %v", r.ID, err)
+		}
+	}
+
+	for _, p := range policies {
+		stableRevision, ok := stables[p.ClusterID]
+		if !ok {
+			stableRevision = stable
+		}
+		// 首个修订没有可回退的稳定修订
+		if stableRevision == 0 {
+			continue
+		}
+
+		canary, baseline, err := selectCanaryNodes(ctx, p)
+		if err != nil {
+			global.Logger.Sugar().Errorf("select canary nodes for cluster %s failed: %v", p.ClusterID, err)
+			continue
+		}
+
+		if err := global.EntClient.CoreProxyRollout.Create().
+			SetClusterID(p.ClusterID).
+			SetRevision(revision).
+			SetStableRevision(stableRevision).
+			SetCanaryNodeIds(canary).
+			SetBaseline(baseline).
+			SetSoakSeconds(p.SoakSeconds).
+			SetMaxErrorRate(p.MaxErrorRate).
+			SetMinRequests(p.MinRequests).
+			SetStatus(constant.RolloutStatusInProgress).
+			SetStartedAt(now).
+			Exec(ctx); err != nil {
+			global.Logger.Sugar().Errorf("create rollout for cluster %s failed: %v", p.ClusterID, err)
+			continue
+		}
+		global.Logger.Sugar().Infof("rollout of revision %d started in cluster %s, stable revision %d, canary nodes %v", revision, p.ClusterID, stableRevision, canary)
+	}
+
+	rollouts, err := loadRollouts(ctx)
+	if err != nil {
+		global.Logger.Sugar().Errorf("load proxy rollouts failed: %v", err)
+		return
+	}
+	h.rollouts = rollouts
+	h.rolloutSeq++
+}
+
+// selectCanaryNodes 按策略选取灰度节点：优先使用指定节点，否则按比例从在线节点中选取，并记录其当前累计流量
+func selectCanaryNodes(ctx context.Context, p *ent.CoreProxyRolloutPolicy) ([]string, map[string]common.TrafficCounter, error) {
+	nodes, err := global.EntClient.CoreGatewayNode.Query().
+		Where(coregatewaynode.ClusterID(p.ClusterID), coregatewaynode.DeletedAtIsNil()).
+		All(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	canary, baseline := pickCanaryNodes(p, nodes)
+	return canary, baseline, nil
+}
+
+// pickCanaryNodes 从在线节点中选取灰度节点：按比例选取时向上取整，并按节点 ID 排序后取前 n 个，保证选取结果稳定
+func pickCanaryNodes(p *ent.CoreProxyRolloutPolicy, nodes []*ent.CoreGatewayNode) ([]string, map[string]common.TrafficCounter) {
+	nodes = slices.Clone(nodes)
+	slices.SortFunc(nodes, func(a, b *ent.CoreGatewayNode) int { return strings.Compare(a.NodeID, b.NodeID) })
+
+	canary := make([]string, 0)
+	if len(p.NodeIds) > 0 {
+		canary = append(canary, p.NodeIds...)
+	} else if p.Percentage > 0 {
+		n := int(math.Ceil(float64(len(nodes)) * float64(p.Percentage) / 100))
+		for _, node := range nodes[:min(n, len(nodes))] {
+			canary = append(canary, node.NodeID)
+		}
+	}
+
+	baseline := make(map[string]common.TrafficCounter, len(canary))
+	for _, node := range nodes {
+		if slices.Contains(canary, node.NodeID) {
+			baseline[node.NodeID] = common.TrafficCounter{Requests: node.RequestTotal, Errors: node.ErrorTotal}
+		}
+	}
+	return canary, baseline
+}
+
+// evaluateRollouts 评估灰度中的发布：灰度节点 NACK 或错误率超限时中止，观察期结束后全量
+func evaluateRollouts(ctx context.Context) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	rows, err := global.EntClient.CoreProxyRollout.Query().
+		Where(coreproxyrollout.DeletedAtIsNil(), coreproxyrollout.Status(constant.RolloutStatusInProgress)).
+		All(ctx)
+	if err != nil {
+		global.Logger.Sugar().Errorf("select core_proxy_rollout failed: %v", err)
+		return
+	}
+
+	now := time.Now().Unix()
+	for _, r := range rows {
+		nodes, err := global.EntClient.CoreGatewayNode.Query().
+			Where(coregatewaynode.NodeIDIn(r.CanaryNodeIds...)).
+			All(ctx)
+		if err != nil {
+			global.Logger.Sugar().Errorf("select canary nodes of rollout %s failed: %v", r.ID, err)
+			continue
+		}
+
+		result := EvaluateRollout(r, nodes, now)
+		update := global.EntClient.CoreProxyRollout.Update().
+			Where(coreproxyrollout.ID(r.ID), coreproxyrollout.Status(constant.RolloutStatusInProgress)).
+			SetRequests(result.Requests).
+			SetErrors(result.Errors)
+		if result.Status != constant.RolloutStatusInProgress {
+			update = update.SetStatus(result.Status).SetReason(result.Reason).SetFinishedAt(now)
+			global.Logger.Sugar().Infof("rollout of revision %d in cluster %s finished with status %d: %s", r.Revision, r.ClusterID, result.Status, result.Reason)
+		}
+		if err := update.Exec(ctx); err != nil {
+			global.Logger.Sugar().Errorf("update rollout %s failed: %v", r.ID, err)
+		}
+	}
+}
+
+// RolloutResult 一次灰度评估的结果
+type RolloutResult struct {
+	Status   constant.ProxyRolloutStatus
+	Reason   string
+	Requests int64
+	Errors   int64
+}
+
+// EvaluateRollout 根据灰度节点的回执与流量评估灰度发布
+func EvaluateRollout(r *ent.CoreProxyRollout, nodes []*ent.CoreGatewayNode, now int64) RolloutResult {
+	result := RolloutResult{Status: constant.RolloutStatusInProgress}
+
+	for _, n := range nodes {
+		base := r.Baseline[n.NodeID]
+		result.Requests += max(n.RequestTotal-base.Requests, 0)
+		result.Errors += max(n.ErrorTotal-base.Errors, 0)
+
+		for typeURL, st := range n.XdsStatus {
+			if st.RejectedRevision == r.Revision && st.NackTime >= r.StartedAt {
+				result.Status = constant.RolloutStatusAborted
+				result.Reason = fmt.Sprintf("node %s rejected revision %d (%s): %s", n.NodeID, r.Revision, typeURL, st.ErrorDetail)
+			}
+		}
+	}
+	if result.Status != constant.RolloutStatusInProgress {
+		return result
+	}
+
+	if result.Requests > 0 && result.Requests >= r.MinRequests {
+		rate := float64(result.Errors) * 100 / float64(result.Requests)
+		if rate > r.MaxErrorRate {
+			result.Status = constant.RolloutStatusAborted
+			result.Reason = fmt.Sprintf("error rate %.2f%% exceeds %.2f%%", rate, r.MaxErrorRate)
+			return result
+		}
+	}
+
+	if now-r.StartedAt >= int64(r.SoakSeconds) {
+		result.Status = constant.RolloutStatusPromoted
+		result.Reason = fmt.Sprintf("soaked %ds without NACK, %d errors in %d requests", r.SoakSeconds, result.Errors, result.Requests)
+	}
+	return result
+}
+
+// loadRollouts 加载生效中的灰度发布：灰度中的集群按灰度节点区分修订，已中止的集群全部使用稳定修订
+func loadRollouts(ctx context.Context) (map[string]*v1.Rollout, error) {
+	rows, err := global.EntClient.CoreProxyRollout.Query().
+		Where(coreproxyrollout.DeletedAtIsNil(), coreproxyrollout.StatusIn(constant.RolloutStatusInProgress, constant.RolloutStatusAborted)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	configs := make(map[int64]*v1.ProxyConfig)
+	rollouts := make(map[string]*v1.Rollout, len(rows))
+	for _, row := range rows {
+		cfg, ok := configs[row.StableRevision]
+		if !ok {
+			if _, cfg, err = LoadRevision(ctx, row.StableRevision); err != nil {
+				return nil, fmt.Errorf("load stable revision %d of cluster %s: %w", row.StableRevision, row.ClusterID, err)
+			}
+			configs[row.StableRevision] = cfg
+		}
+
+		r := &v1.Rollout{
+			ClusterId:      row.ClusterID,
+			Revision:       row.Revision,
+			StableRevision: row.StableRevision,
+			StableConfig:   cfg,
+		}
+		if row.Status == constant.RolloutStatusInProgress {
+			r.CanaryNodeIds = row.CanaryNodeIds
+		}
+		rollouts[row.ClusterID] = r
+	}
+	return rollouts, nil
+}
+
+// StableRollout 返回使节点继续使用稳定修订的灰度发布：集群灰度中且节点不是灰度节点，或集群灰度已中止。
+// 节点使用最新修订时返回 nil
+func StableRollout(ctx context.Context, clusterID, nodeID string) (*v1.Rollout, error) {
+	rollouts, err := loadRollouts(ctx)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := rollouts[clusterID]
+	if !ok || clusterID == "" || slices.Contains(r.GetCanaryNodeIds(), nodeID) {
+		return nil, nil
+	}
+	return r, nil
+}
+
+func rolloutsEqual(a, b map[string]*v1.Rollout) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for cluster, r := range a {
+		o, ok := b[cluster]
+		if !ok || o.Revision != r.Revision || o.StableRevision != r.StableRevision || !slices.Equal(o.CanaryNodeIds, r.CanaryNodeIds) {
+			return false
+		}
+	}
+	return true
+}
+
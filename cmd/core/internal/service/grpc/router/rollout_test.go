@@ -0,0 +1,97 @@
+package router
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/lyonmu/quebec/cmd/core/internal/common"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent"
+	"github.com/lyonmu/quebec/pkg/constant"
+)
+
+func TestEvaluateRollout(t *testing.T) {
+	const cds = "type.googleapis.com/envoy.config.cluster.v3.Cluster"
+
+	rollout := &ent.CoreProxyRollout{
+		Revision:     5,
+		StartedAt:    1000,
+		SoakSeconds:  300,
+		MaxErrorRate: 5,
+		MinRequests:  100,
+		Baseline:     map[string]common.TrafficCounter{"n1": {Requests: 100, Errors: 1}},
+	}
+	// traffic 返回在基线之上新增 requests 个请求与 errors 个错误的灰度节点
+	traffic := func(requests, errors int64) *ent.CoreGatewayNode {
+		return &ent.CoreGatewayNode{NodeID: "n1", RequestTotal: 100 + requests, ErrorTotal: 1 + errors}
+	}
+	nack := func(revision int64) *ent.CoreGatewayNode {
+		n := traffic(0, 0)
+		n.XdsStatus = map[string]common.XdsStatus{cds: {RejectedRevision: revision, ErrorDetail: "bad cluster", NackTime: 1010}}
+		return n
+	}
+
+	tests := []struct {
+		name     string
+		node     *ent.CoreGatewayNode
+		now      int64
+		status   constant.ProxyRolloutStatus
+		requests int64
+		errors   int64
+	}{
+		{name: "nack of rollout revision aborts", node: nack(5), now: 1010, status: constant.RolloutStatusAborted},
+		{name: "nack of other revision is ignored", node: nack(4), now: 1010, status: constant.RolloutStatusInProgress},
+		{name: "no abort before min requests", node: traffic(50, 40), now: 1100, status: constant.RolloutStatusInProgress, requests: 50, errors: 40},
+		{name: "error rate above limit aborts", node: traffic(200, 20), now: 1100, status: constant.RolloutStatusAborted, requests: 200, errors: 20},
+		{name: "error rate at limit keeps going", node: traffic(200, 10), now: 1100, status: constant.RolloutStatusInProgress, requests: 200, errors: 10},
+		{name: "no action before soak ends", node: traffic(200, 2), now: 1299, status: constant.RolloutStatusInProgress, requests: 200, errors: 2},
+		{name: "promote after soak", node: traffic(200, 2), now: 1300, status: constant.RolloutStatusPromoted, requests: 200, errors: 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := EvaluateRollout(rollout, []*ent.CoreGatewayNode{tt.node}, tt.now)
+			if result.Status != tt.status {
+				t.Fatalf("expected status %d, got %d (%s)", tt.status, result.Status, result.Reason)
+			}
+			if result.Requests != tt.requests || result.Errors != tt.errors {
+				t.Fatalf("expected %d errors in %d requests, got %d in %d", tt.errors, tt.requests, result.Errors, result.Requests)
+			}
+		})
+	}
+}
+
+func TestPickCanaryNodes(t *testing.T) {
+	nodes := []*ent.CoreGatewayNode{
+		{NodeID: "n3", RequestTotal: 30},
+		{NodeID: "n1", RequestTotal: 10},
+		{NodeID: "n2", RequestTotal: 20},
+	}
+
+	tests := []struct {
+		name   string
+		policy *ent.CoreProxyRolloutPolicy
+		canary []string
+	}{
+		{name: "explicit node ids", policy: &ent.CoreProxyRolloutPolicy{NodeIds: []string{"n3", "offline"}, Percentage: 100}, canary: []string{"n3", "offline"}},
+		{name: "percentage rounds up", policy: &ent.CoreProxyRolloutPolicy{Percentage: 10}, canary: []string{"n1"}},
+		{name: "percentage ordered by node id", policy: &ent.CoreProxyRolloutPolicy{Percentage: 50}, canary: []string{"n1", "n2"}},
+		{name: "percentage capped at all nodes", policy: &ent.CoreProxyRolloutPolicy{Percentage: 100}, canary: []string{"n1", "n2", "n3"}},
+		{name: "no canary", policy: &ent.CoreProxyRolloutPolicy{}, canary: []string{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			canary, baseline := pickCanaryNodes(tt.policy, nodes)
+			if !slices.Equal(canary, tt.canary) {
+				t.Fatalf("expected canary %v, got %v", tt.canary, canary)
+			}
+			// 只记录在线灰度节点的基线流量
+			for _, id := range canary {
+				if _, ok := baseline[id]; ok == (id == "offline") {
+					t.Fatalf("unexpected baseline for %s: %v", id, baseline)
+				}
+			}
+		})
+	}
+	if nodes[0].NodeID != "n3" {
+		t.Fatal("expected input nodes to keep their order")
+	}
+}
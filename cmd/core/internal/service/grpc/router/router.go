@@ -1,18 +1,151 @@
 package router
 
 import (
+	"io"
+	"sync/atomic"
+
+	"github.com/lyonmu/quebec/cmd/core/internal/ent"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/coregatewaycluster"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/hook"
 	"github.com/lyonmu/quebec/cmd/core/internal/global"
+	v1 "github.com/lyonmu/quebec/idl/router/v1"
+	"github.com/lyonmu/quebec/pkg/envoy"
 	"google.golang.org/grpc"
 )
 
-type RouterSvc struct{}
+type RouterSvc struct {
+	v1.UnimplementedRouterConfigServer
+	hub *ConfigHub
+}
 
 func NewRouterSvc() *RouterSvc {
-	return &RouterSvc{}
+	hub := NewConfigHub()
+	useHooks(global.EntClient, hub)
+	hub.Start()
+
+	return &RouterSvc{hub: hub}
 }
 
-func (r *RouterSvc) Register(server *grpc.Server) error {
+// useHooks 注册代理相关实体的 ent hook
+func useHooks(client *ent.Client, hub *ConfigHub) {
+	// 代理相关实体变更后触发配置刷新
+	client.CoreUpstream.Use(hub.Hook())
+	client.CoreUpstreamHost.Use(hub.Hook())
+	client.CoreGatewayHttpRoute.Use(hub.Hook())
+	client.CoreGatewayHttpRouteTarget.Use(hub.Hook())
+	client.CoreGatewayL7Listener.Use(hub.Hook())
+	client.CoreGatewayVirtualHost.Use(hub.Hook())
+	client.CoreGatewayRateLimitRule.Use(hub.Hook())
+	client.CoreCert.Use(hub.Hook())
+
+	// 网关集群只在维护模式变更时触发刷新，节点上报请求时间时的集群更新不触发
+	client.CoreGatewayCluster.Use(hook.If(hub.Hook(), hook.Or(
+		hook.HasFields(coregatewaycluster.FieldMaintenance),
+		hook.HasClearedFields(coregatewaycluster.FieldMaintenance),
+	)))
 
+	// 灰度发布状态变更后推送给 Gateway
+	client.CoreProxyRollout.Use(hub.RolloutHook())
+
+	// 修订记录不可变
+	client.CoreProxyRevision.Use(hook.Reject(ent.OpUpdate | ent.OpUpdateOne | ent.OpDelete | ent.OpDeleteOne))
+}
+
+func (r *RouterSvc) Register(server *grpc.Server) error {
+	v1.RegisterRouterConfigServer(server, r)
 	global.Logger.Sugar().Info("router grpc service registered")
 	return nil
 }
+
+// ConfigSync 处理双向流：先推送完整配置，之后推送增量变更并接收 Gateway 的回执
+func (r *RouterSvc) ConfigSync(stream v1.RouterConfig_ConfigSyncServer) error {
+	// 首包用于标识 Gateway
+	req, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	gatewayID := req.GatewayId
+	global.Logger.Sugar().Infof("Gateway %d subscribed proxy config, current revision: %d, global rate limit: %t", gatewayID, req.Revision, req.GlobalRateLimit)
+
+	sub, cancel := r.hub.Subscribe()
+	defer cancel()
+
+	markGatewayOnline(gatewayID, req.Revision, req.GlobalRateLimit)
+	defer markGatewayOffline(gatewayID)
+
+	// 最近一次推送的完整配置修订号
+	var fullRevision atomic.Int64
+
+	// 接收回执，增量配置被拒绝时要求重新推送完整配置；
+	// 完整配置被拒绝说明配置本身不合法，Gateway 会继续使用上一个可用配置，等待下一次修订
+	recvErr := make(chan error, 1)
+	go func() {
+		for {
+			ack, err := stream.Recv()
+			if err != nil {
+				recvErr <- err
+				return
+			}
+			recordGatewayAck(gatewayID, ack.Revision, ack.ErrorDetail)
+			if ack.ErrorDetail != "" {
+				global.Logger.Sugar().Warnf("Gateway %d rejected revision %d: %s", gatewayID, ack.Revision, ack.ErrorDetail)
+				if ack.Revision != fullRevision.Load() {
+					sub.Resync()
+				}
+				continue
+			}
+			global.Logger.Sugar().Infof("Gateway %d applied revision %d", gatewayID, ack.Revision)
+		}
+	}()
+
+	full := r.hub.Full()
+	fullRevision.Store(full.Revision)
+	warnGlobalRateLimit(gatewayID, req.GlobalRateLimit, full)
+	if err := stream.Send(full); err != nil {
+		global.Logger.Sugar().Errorf("send full proxy config to Gateway %d failed: %v", gatewayID, err)
+		return err
+	}
+	sent, sentSeq := full.Revision, full.RolloutSeq
+
+	for {
+		var resp *v1.ConfigSyncResponse
+		select {
+		case resp = <-sub.updates:
+			// 完整配置已覆盖的增量与灰度状态直接跳过
+			if resp.Type == v1.ConfigSyncResponse_ROLLOUT {
+				if resp.RolloutSeq <= sentSeq {
+					continue
+				}
+			} else if resp.Revision <= sent {
+				continue
+			}
+		case <-sub.resync:
+			resp = r.hub.Full()
+			fullRevision.Store(resp.Revision)
+		case err := <-recvErr:
+			if err == io.EOF {
+				global.Logger.Sugar().Infof("Gateway %d config sync closed (EOF)", gatewayID)
+				return nil
+			}
+			global.Logger.Sugar().Infof("Config sync stream error from Gateway %d: %v", gatewayID, err)
+			return err
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		}
+
+		warnGlobalRateLimit(gatewayID, req.GlobalRateLimit, resp)
+		if err := stream.Send(resp); err != nil {
+			global.Logger.Sugar().Errorf("send proxy config revision %d to Gateway %d failed: %v", resp.Revision, gatewayID, err)
+			return err
+		}
+		sent, sentSeq = resp.Revision, resp.RolloutSeq
+	}
+}
+
+// warnGlobalRateLimit 推送的配置包含全局限流路由而 Gateway 未提供限流服务时告警，这些路由的全局限流不会生效
+func warnGlobalRateLimit(gatewayID int64, globalRateLimit bool, resp *v1.ConfigSyncResponse) {
+	if globalRateLimit || !envoy.UsesGlobalRateLimit(resp.GetConfig()) {
+		return
+	}
+	global.Logger.Sugar().Warnf("revision %d pushed to Gateway %d has routes with global rate limit, but global rate limit is disabled on the gateway, these limits are not enforced", resp.GetRevision(), gatewayID)
+}
@@ -0,0 +1,74 @@
+package router
+
+import (
+	"context"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/coregateway"
+	"github.com/lyonmu/quebec/cmd/core/internal/global"
+	"github.com/lyonmu/quebec/pkg/constant"
+)
+
+// markGatewayOnline 记录 Gateway 建立配置同步连接及其是否提供全局限流服务
+func markGatewayOnline(gatewayID, revision int64, globalRateLimit bool) {
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	rls := constant.No
+	if globalRateLimit {
+		rls = constant.Yes
+	}
+	err := global.EntClient.CoreGateway.Create().
+		SetGatewayID(gatewayID).
+		SetAppliedRevision(revision).
+		SetStatus(constant.Yes).
+		SetGlobalRateLimit(rls).
+		OnConflict(
+			sql.ConflictColumns(coregateway.FieldGatewayID),
+		).
+		Update(func(u *ent.CoreGatewayUpsert) {
+			u.SetStatus(constant.Yes)
+			u.SetGlobalRateLimit(rls)
+			u.SetLastSyncTime(time.Now().Unix())
+			u.ClearDeletedAt()
+			u.SetUpdatedAt(time.Now())
+		}).
+		Exec(ctx)
+	if err != nil {
+		global.Logger.Sugar().Errorf("upsert core_gateway failed: %s", err)
+	}
+}
+
+// markGatewayOffline 记录 Gateway 断开配置同步连接
+func markGatewayOffline(gatewayID int64) {
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	if _, err := global.EntClient.CoreGateway.Update().
+		Where(coregateway.GatewayID(gatewayID), coregateway.DeletedAtIsNil()).
+		SetStatus(constant.No).
+		Save(ctx); err != nil {
+		global.Logger.Sugar().Errorf("update core_gateway status failed: %s", err)
+	}
+}
+
+// recordGatewayAck 记录 Gateway 对配置修订的回执，errorDetail 非空表示配置被拒绝
+func recordGatewayAck(gatewayID, revision int64, errorDetail string) {
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	update := global.EntClient.CoreGateway.Update().
+		Where(coregateway.GatewayID(gatewayID), coregateway.DeletedAtIsNil()).
+		SetLastSyncTime(time.Now().Unix())
+	if errorDetail == "" {
+		update = update.SetAppliedRevision(revision).SetLastError("")
+	} else {
+		update = update.SetRejectedRevision(revision).SetLastError(errorDetail)
+	}
+
+	if _, err := update.Save(ctx); err != nil {
+		global.Logger.Sugar().Errorf("update core_gateway sync status failed: %s", err)
+	}
+}
@@ -0,0 +1,69 @@
+package proxy
+
+import (
+	"context"
+
+	"github.com/lyonmu/quebec/cmd/core/internal/dto/request"
+	"github.com/lyonmu/quebec/cmd/core/internal/dto/response"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/coregatewaycluster"
+	"github.com/lyonmu/quebec/cmd/core/internal/global"
+	"github.com/lyonmu/quebec/pkg/code"
+	"github.com/lyonmu/quebec/pkg/envoy"
+)
+
+// Bootstrap 生成接入指定网关集群的 Envoy bootstrap
+func (s *ProxySvc) Bootstrap(ctx context.Context, req *request.ProxyBootstrapReq) (*response.ProxyBootstrapResp, error) {
+
+	// 集群下线时记录会被软删除，但集群ID仍然有效，这里不过滤已删除的记录
+	exist, err := global.EntClient.CoreGatewayCluster.Query().
+		Where(coregatewaycluster.ClusterID(req.ClusterID)).
+		Exist(ctx)
+	if err != nil {
+		global.Logger.Sugar().Errorf("查询网关集群 %s 失败: %v", req.ClusterID, err)
+		return nil, &code.ProxyBootstrapFailed
+	}
+	if !exist {
+		return nil, &code.GatewayClusterNotExists
+	}
+
+	xdsPort := req.XdsPort
+	if xdsPort == 0 {
+		xdsPort = envoy.DefaultXdsPort
+	}
+
+	opts := envoy.BootstrapOptions{
+		NodeID:    req.NodeID,
+		Cluster:   req.ClusterID,
+		XdsHost:   req.XdsHost,
+		XdsPort:   xdsPort,
+		AdminPort: req.AdminPort,
+		Delta:     req.Delta,
+		XdsToken:  req.XdsToken,
+	}
+	if req.TLS != nil {
+		opts.TLS = &envoy.BootstrapTLS{
+			CACert:     req.TLS.CACert,
+			ClientCert: req.TLS.ClientCert,
+			ClientKey:  req.TLS.ClientKey,
+			SNI:        req.TLS.SNI,
+		}
+	}
+
+	format := req.Format
+	if len(format) == 0 {
+		format = envoy.BootstrapFormatYAML
+	}
+
+	b, err := envoy.Bootstrap(opts)
+	if err != nil {
+		global.Logger.Sugar().Errorf("生成节点 %s 的 Envoy bootstrap 失败: %v", req.NodeID, err)
+		return nil, &code.ProxyBootstrapFailed
+	}
+	data, err := envoy.MarshalBootstrap(b, format)
+	if err != nil {
+		global.Logger.Sugar().Errorf("序列化节点 %s 的 Envoy bootstrap 失败: %v", req.NodeID, err)
+		return nil, &code.ProxyBootstrapFailed
+	}
+
+	return &response.ProxyBootstrapResp{Format: format, Content: string(data)}, nil
+}
@@ -0,0 +1,106 @@
+package proxy
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/lyonmu/quebec/cmd/core/internal/dto/request"
+	"github.com/lyonmu/quebec/cmd/core/internal/dto/response"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/coregatewaycluster"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/coregatewayhttproute"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/coregatewayl7listener"
+	"github.com/lyonmu/quebec/cmd/core/internal/global"
+	v1 "github.com/lyonmu/quebec/idl/router/v1"
+	"github.com/lyonmu/quebec/pkg/code"
+)
+
+func (s *ProxySvc) ListGatewayCluster(ctx context.Context) ([]*response.ProxyGatewayClusterResp, error) {
+
+	var (
+		resp = make([]*response.ProxyGatewayClusterResp, 0)
+	)
+
+	// 设置了维护模式的集群即使 Gateway 已全部断开也需要展示，以便关闭维护模式
+	rows, err := global.EntClient.CoreGatewayCluster.Query().
+		Where(coregatewaycluster.Or(coregatewaycluster.DeletedAtIsNil(), coregatewaycluster.MaintenanceNotNil())).
+		Order(coregatewaycluster.ByClusterCreateTime(sql.OrderAsc())).
+		All(ctx)
+	if err != nil {
+		global.Logger.Sugar().Errorf("获取网关集群列表失败: %v", err)
+		return nil, &code.GatewayClusterQueryFailed
+	}
+
+	for _, row := range rows {
+		item := response.ProxyGatewayClusterResp{}
+		item.LoadDb(row)
+		resp = append(resp, &item)
+	}
+
+	return resp, nil
+}
+
+func (s *ProxySvc) GatewayClusterLabel(ctx context.Context) ([]*response.Options, error) {
+
+	var (
+		resp = make([]*response.Options, 0)
+	)
+
+	rows, err := global.EntClient.CoreGatewayCluster.Query().
+		Where(coregatewaycluster.DeletedAtIsNil()).
+		Select(coregatewaycluster.FieldClusterID).
+		Order(coregatewaycluster.ByClusterCreateTime(sql.OrderAsc())).
+		All(ctx)
+	if err != nil {
+		global.Logger.Sugar().Errorf("获取网关集群标签失败: %v", err)
+		return nil, &code.GatewayClusterQueryFailed
+	}
+
+	for _, row := range rows {
+		resp = append(resp, &response.Options{Label: row.ClusterID, Value: row.ClusterID})
+	}
+
+	return resp, nil
+}
+
+// ListenerBindCluster 将监听器绑定到指定网关集群，集群ID为空时对所有集群生效
+func (s *ProxySvc) ListenerBindCluster(id string, req *request.ProxyBindClusterReq, ctx context.Context) error {
+
+	err := global.EntClient.CoreGatewayL7Listener.UpdateOneID(id).
+		Where(coregatewayl7listener.DeletedAtIsNil()).
+		SetClusterID(req.ClusterID).
+		Exec(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return &code.ListenerNotExists
+		}
+		global.Logger.Sugar().Errorf("监听器 %s 绑定网关集群失败: %v", id, err)
+		return &code.ListenerBindFailed
+	}
+
+	return nil
+}
+
+// HttpRouteBindCluster 将路由绑定到指定网关集群，集群ID为空时对所有集群生效
+func (s *ProxySvc) HttpRouteBindCluster(id string, req *request.ProxyBindClusterReq, ctx context.Context) error {
+
+	if err := checkRouteConflicts(ctx, id, func(r *v1.HttpRoute) {
+		r.ClusterId = req.ClusterID
+	}); err != nil {
+		return err
+	}
+
+	err := global.EntClient.CoreGatewayHttpRoute.UpdateOneID(id).
+		Where(coregatewayhttproute.DeletedAtIsNil()).
+		SetClusterID(req.ClusterID).
+		Exec(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return &code.HttpRouteNotExists
+		}
+		global.Logger.Sugar().Errorf("路由 %s 绑定网关集群失败: %v", id, err)
+		return &code.HttpRouteBindFailed
+	}
+
+	return nil
+}
@@ -0,0 +1,115 @@
+package proxy
+
+import (
+	"context"
+
+	"github.com/lyonmu/quebec/cmd/core/internal/common"
+	"github.com/lyonmu/quebec/cmd/core/internal/dto/request"
+	"github.com/lyonmu/quebec/cmd/core/internal/dto/response"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/coregatewayhttproute"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/coregatewayl7listener"
+	"github.com/lyonmu/quebec/cmd/core/internal/global"
+	"github.com/lyonmu/quebec/cmd/core/internal/service/grpc/router"
+	"github.com/lyonmu/quebec/pkg/code"
+	"github.com/lyonmu/quebec/pkg/constant"
+	"github.com/lyonmu/quebec/pkg/envoy"
+)
+
+// ListenerCompression 获取监听器的响应压缩
+func (s *ProxySvc) ListenerCompression(ctx context.Context, id string) (*response.ProxyCompressionResp, error) {
+
+	row, err := global.EntClient.CoreGatewayL7Listener.Query().
+		Where(coregatewayl7listener.ID(id), coregatewayl7listener.DeletedAtIsNil()).
+		Select(coregatewayl7listener.FieldCompression).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, &code.ListenerNotExists
+		}
+		global.Logger.Sugar().Errorf("获取监听器 %s 响应压缩失败: %v", id, err)
+		return nil, &code.CompressionQueryFailed
+	}
+
+	resp := &response.ProxyCompressionResp{}
+	if c := row.Compression; c != nil && len(c.Algorithms) > 0 {
+		resp.Enabled = true
+		resp.Compression = *c
+	}
+	if resp.Algorithms == nil {
+		resp.Algorithms = []constant.ProxyCompressionAlgorithm{}
+	}
+	if resp.ContentTypes == nil {
+		resp.ContentTypes = []string{}
+	}
+	return resp, nil
+}
+
+// ListenerSetCompression 保存监听器的响应压缩，作用于监听器上未关闭压缩的路由，压缩算法为空时清除
+func (s *ProxySvc) ListenerSetCompression(ctx context.Context, id string, req *request.ProxyCompressionReq) error {
+
+	c := &common.Compression{
+		Algorithms:       req.Algorithms,
+		ContentTypes:     req.ContentTypes,
+		MinContentLength: req.MinContentLength,
+		Level:            req.Level,
+	}
+	if err := envoy.ValidateCompression(router.ToCompression(c)); err != nil {
+		global.Logger.Sugar().Warnf("监听器 %s 响应压缩不合法: %v", id, err)
+		return &code.CompressionInvalid
+	}
+
+	update := global.EntClient.CoreGatewayL7Listener.UpdateOneID(id).
+		Where(coregatewayl7listener.DeletedAtIsNil())
+	if len(c.Algorithms) == 0 {
+		update.ClearCompression()
+	} else {
+		update.SetCompression(c)
+	}
+
+	if err := update.Exec(ctx); err != nil {
+		if ent.IsNotFound(err) {
+			return &code.ListenerNotExists
+		}
+		global.Logger.Sugar().Errorf("保存监听器 %s 响应压缩失败: %v", id, err)
+		return &code.CompressionSaveFailed
+	}
+
+	return nil
+}
+
+// HttpRouteCompression 获取路由是否关闭了响应压缩
+func (s *ProxySvc) HttpRouteCompression(ctx context.Context, id string) (*response.ProxyRouteCompressionResp, error) {
+
+	row, err := global.EntClient.CoreGatewayHttpRoute.Query().
+		Where(coregatewayhttproute.ID(id), coregatewayhttproute.DeletedAtIsNil()).
+		Select(coregatewayhttproute.FieldDisableCompression).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, &code.HttpRouteNotExists
+		}
+		global.Logger.Sugar().Errorf("获取路由 %s 响应压缩失败: %v", id, err)
+		return nil, &code.CompressionQueryFailed
+	}
+
+	return &response.ProxyRouteCompressionResp{DisableCompression: row.DisableCompression}, nil
+}
+
+// HttpRouteSetCompression 保存路由是否关闭响应压缩，如已压缩的文件下载或流式响应
+func (s *ProxySvc) HttpRouteSetCompression(ctx context.Context, id string, req *request.ProxyRouteCompressionReq) error {
+
+	err := global.EntClient.CoreGatewayHttpRoute.UpdateOneID(id).
+		Where(coregatewayhttproute.DeletedAtIsNil()).
+		SetDisableCompression(req.DisableCompression).
+		Exec(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return &code.HttpRouteNotExists
+		}
+		global.Logger.Sugar().Errorf("保存路由 %s 响应压缩失败: %v", id, err)
+		return &code.CompressionSaveFailed
+	}
+
+	return nil
+}
@@ -0,0 +1,35 @@
+package proxy
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/lyonmu/quebec/cmd/core/internal/dto/response"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/coregateway"
+	"github.com/lyonmu/quebec/cmd/core/internal/global"
+	"github.com/lyonmu/quebec/pkg/code"
+)
+
+func (s *ProxySvc) ListGateway(ctx context.Context) ([]*response.ProxyGatewayResp, error) {
+
+	var (
+		resp = make([]*response.ProxyGatewayResp, 0)
+	)
+
+	rows, err := global.EntClient.CoreGateway.Query().
+		Where(coregateway.DeletedAtIsNil()).
+		Order(coregateway.ByGatewayID(sql.OrderAsc())).
+		All(ctx)
+	if err != nil {
+		global.Logger.Sugar().Errorf("获取网关列表失败: %v", err)
+		return nil, &code.GatewayQueryFailed
+	}
+
+	for _, row := range rows {
+		item := response.ProxyGatewayResp{}
+		item.LoadDb(row)
+		resp = append(resp, &item)
+	}
+
+	return resp, nil
+}
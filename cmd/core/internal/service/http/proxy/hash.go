@@ -0,0 +1,131 @@
+package proxy
+
+import (
+	"context"
+
+	"github.com/lyonmu/quebec/cmd/core/internal/common"
+	"github.com/lyonmu/quebec/cmd/core/internal/dto/request"
+	"github.com/lyonmu/quebec/cmd/core/internal/dto/response"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/coregatewayhttproute"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/coreupstream"
+	"github.com/lyonmu/quebec/cmd/core/internal/global"
+	"github.com/lyonmu/quebec/cmd/core/internal/service/grpc/router"
+	v1 "github.com/lyonmu/quebec/idl/router/v1"
+	"github.com/lyonmu/quebec/pkg/code"
+	"github.com/lyonmu/quebec/pkg/envoy"
+)
+
+// HttpRouteHashPolicies 获取路由的一致性哈希策略
+func (s *ProxySvc) HttpRouteHashPolicies(ctx context.Context, id string) (*response.ProxyHashPoliciesResp, error) {
+
+	row, err := global.EntClient.CoreGatewayHttpRoute.Query().
+		Where(coregatewayhttproute.ID(id), coregatewayhttproute.DeletedAtIsNil()).
+		Select(coregatewayhttproute.FieldHashPolicies).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, &code.HttpRouteNotExists
+		}
+		global.Logger.Sugar().Errorf("获取路由 %s 哈希策略失败: %v", id, err)
+		return nil, &code.HashPolicyQueryFailed
+	}
+
+	resp := &response.ProxyHashPoliciesResp{Enabled: len(row.HashPolicies) > 0, Policies: row.HashPolicies}
+	if resp.Policies == nil {
+		resp.Policies = []common.HashPolicy{}
+	}
+	return resp, nil
+}
+
+// HttpRouteSetHashPolicies 保存路由的一致性哈希策略，策略为空时清除。
+// 哈希策略只在上游服务使用 RING_HASH 或 MAGLEV 时生效，其他负载均衡策略忽略哈希值
+func (s *ProxySvc) HttpRouteSetHashPolicies(ctx context.Context, id string, req *request.ProxyHashPoliciesReq) error {
+
+	policies := make([]common.HashPolicy, 0, len(req.Policies))
+	for _, p := range req.Policies {
+		policies = append(policies, common.HashPolicy{
+			Type:             p.Type,
+			Name:             p.Name,
+			CookieTtlSeconds: p.CookieTtlSeconds,
+			CookiePath:       p.CookiePath,
+			Terminal:         p.Terminal,
+		})
+	}
+	if err := envoy.ValidateHashPolicies(router.ToHashPolicies(policies)); err != nil {
+		global.Logger.Sugar().Warnf("路由 %s 哈希策略不合法: %v", id, err)
+		return &code.HashPolicyInvalid
+	}
+
+	update := global.EntClient.CoreGatewayHttpRoute.UpdateOneID(id).
+		Where(coregatewayhttproute.DeletedAtIsNil())
+	if len(policies) == 0 {
+		update.ClearHashPolicies()
+	} else {
+		update.SetHashPolicies(policies)
+	}
+
+	if err := update.Exec(ctx); err != nil {
+		if ent.IsNotFound(err) {
+			return &code.HttpRouteNotExists
+		}
+		global.Logger.Sugar().Errorf("保存路由 %s 哈希策略失败: %v", id, err)
+		return &code.HashPolicySaveFailed
+	}
+
+	return nil
+}
+
+// UpstreamLb 获取上游服务的负载均衡策略与一致性哈希的表大小
+func (s *ProxySvc) UpstreamLb(ctx context.Context, id string) (*response.ProxyUpstreamLbResp, error) {
+
+	row, err := global.EntClient.CoreUpstream.Query().
+		Where(coreupstream.ID(id), coreupstream.DeletedAtIsNil()).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, &code.UpstreamNotExists
+		}
+		global.Logger.Sugar().Errorf("获取上游服务 %s 负载均衡设置失败: %v", id, err)
+		return nil, &code.UpstreamLbQueryFailed
+	}
+
+	return &response.ProxyUpstreamLbResp{
+		LbPolicy:        row.LbPolicy,
+		RingMinSize:     row.RingMinSize,
+		RingMaxSize:     row.RingMaxSize,
+		MaglevTableSize: row.MaglevTableSize,
+	}, nil
+}
+
+// UpstreamSetLb 保存上游服务的负载均衡策略，环大小只在 RING_HASH 时生效，查找表大小只在 MAGLEV 时生效
+func (s *ProxySvc) UpstreamSetLb(ctx context.Context, id string, req *request.ProxyUpstreamLbReq) error {
+
+	// 与 Gateway 下发前使用同一校验，避免保存后整个上游服务被跳过
+	if err := envoy.ValidateUpstreamHash(&v1.Upstream{
+		LbPolicy:        int32(req.LbPolicy),
+		RingMinSize:     req.RingMinSize,
+		RingMaxSize:     req.RingMaxSize,
+		MaglevTableSize: req.MaglevTableSize,
+	}); err != nil {
+		global.Logger.Sugar().Warnf("上游服务 %s 负载均衡设置不合法: %v", id, err)
+		return &code.UpstreamLbInvalid
+	}
+
+	err := global.EntClient.CoreUpstream.UpdateOneID(id).
+		Where(coreupstream.DeletedAtIsNil()).
+		SetLbPolicy(req.LbPolicy).
+		SetRingMinSize(req.RingMinSize).
+		SetRingMaxSize(req.RingMaxSize).
+		SetMaglevTableSize(req.MaglevTableSize).
+		Exec(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return &code.UpstreamNotExists
+		}
+		global.Logger.Sugar().Errorf("保存上游服务 %s 负载均衡设置失败: %v", id, err)
+		return &code.UpstreamLbSaveFailed
+	}
+
+	return nil
+}
@@ -0,0 +1,202 @@
+package proxy
+
+import (
+	"context"
+
+	"github.com/lyonmu/quebec/cmd/core/internal/common"
+	"github.com/lyonmu/quebec/cmd/core/internal/dto/request"
+	"github.com/lyonmu/quebec/cmd/core/internal/dto/response"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/coregatewaycluster"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/coregatewayhttproute"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/coregatewayvirtualhost"
+	"github.com/lyonmu/quebec/cmd/core/internal/global"
+	"github.com/lyonmu/quebec/cmd/core/internal/service/grpc/router"
+	"github.com/lyonmu/quebec/pkg/code"
+	"github.com/lyonmu/quebec/pkg/constant"
+	"github.com/lyonmu/quebec/pkg/envoy"
+)
+
+// HttpRouteDirectResponse 获取路由的直接响应
+func (s *ProxySvc) HttpRouteDirectResponse(ctx context.Context, id string) (*response.ProxyDirectResponseResp, error) {
+
+	row, err := global.EntClient.CoreGatewayHttpRoute.Query().
+		Where(coregatewayhttproute.ID(id), coregatewayhttproute.DeletedAtIsNil()).
+		Select(coregatewayhttproute.FieldDirectResponse).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, &code.HttpRouteNotExists
+		}
+		global.Logger.Sugar().Errorf("获取路由 %s 直接响应失败: %v", id, err)
+		return nil, &code.DirectResponseQueryFailed
+	}
+
+	resp := &response.ProxyDirectResponseResp{}
+	if d := row.DirectResponse; d != nil && d.StatusCode > 0 {
+		resp.Enabled = true
+		resp.DirectResponse = *d
+	}
+	return resp, nil
+}
+
+// HttpRouteSetDirectResponse 保存路由的直接响应，设置后 Envoy 直接返回该响应而不转发到上游服务；状态码为 0 时清除
+func (s *ProxySvc) HttpRouteSetDirectResponse(ctx context.Context, id string, req *request.ProxyDirectResponseReq) error {
+
+	row, err := global.EntClient.CoreGatewayHttpRoute.Query().
+		Where(coregatewayhttproute.ID(id), coregatewayhttproute.DeletedAtIsNil()).
+		Select(coregatewayhttproute.FieldEnableRedirect).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return &code.HttpRouteNotExists
+		}
+		global.Logger.Sugar().Errorf("获取路由 %s 失败: %v", id, err)
+		return &code.DirectResponseSaveFailed
+	}
+
+	update := global.EntClient.CoreGatewayHttpRoute.UpdateOneID(id).
+		Where(coregatewayhttproute.DeletedAtIsNil())
+	if req.StatusCode == 0 {
+		update.ClearDirectResponse()
+	} else {
+		d := &common.DirectResponse{StatusCode: req.StatusCode, Body: req.Body, ContentType: req.ContentType}
+		if err := envoy.ValidateDirectResponse(router.ToDirectResponse(d)); err != nil {
+			global.Logger.Sugar().Warnf("路由 %s 直接响应不合法: %v", id, err)
+			return &code.DirectResponseInvalid
+		}
+		if row.EnableRedirect == constant.Yes {
+			return &code.DirectResponseRedirect
+		}
+		update.SetDirectResponse(d)
+	}
+
+	if err := update.Exec(ctx); err != nil {
+		if ent.IsNotFound(err) {
+			return &code.HttpRouteNotExists
+		}
+		global.Logger.Sugar().Errorf("保存路由 %s 直接响应失败: %v", id, err)
+		return &code.DirectResponseSaveFailed
+	}
+
+	return nil
+}
+
+// VirtualHostMaintenance 获取虚拟主机的维护模式
+func (s *ProxySvc) VirtualHostMaintenance(ctx context.Context, id string) (*response.ProxyMaintenanceResp, error) {
+
+	row, err := global.EntClient.CoreGatewayVirtualHost.Query().
+		Where(coregatewayvirtualhost.ID(id), coregatewayvirtualhost.DeletedAtIsNil()).
+		Select(coregatewayvirtualhost.FieldMaintenance).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, &code.VirtualHostNotExists
+		}
+		global.Logger.Sugar().Errorf("获取虚拟主机 %s 维护模式失败: %v", id, err)
+		return nil, &code.MaintenanceQueryFailed
+	}
+
+	return toMaintenanceResp(row.Maintenance), nil
+}
+
+// VirtualHostSetMaintenance 保存虚拟主机的维护模式，开启后优先于网关集群的维护模式
+func (s *ProxySvc) VirtualHostSetMaintenance(ctx context.Context, id string, req *request.ProxyMaintenanceReq) error {
+
+	m, err := fromMaintenanceReq(req)
+	if err != nil {
+		global.Logger.Sugar().Warnf("虚拟主机 %s 维护模式不合法: %v", id, err)
+		return &code.MaintenanceInvalid
+	}
+
+	err = global.EntClient.CoreGatewayVirtualHost.UpdateOneID(id).
+		Where(coregatewayvirtualhost.DeletedAtIsNil()).
+		SetMaintenance(m).
+		Exec(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return &code.VirtualHostNotExists
+		}
+		global.Logger.Sugar().Errorf("保存虚拟主机 %s 维护模式失败: %v", id, err)
+		return &code.MaintenanceSaveFailed
+	}
+
+	return nil
+}
+
+// GatewayClusterMaintenance 获取网关集群的维护模式，集群内 Gateway 全部断开时同样可以查询
+func (s *ProxySvc) GatewayClusterMaintenance(ctx context.Context, id string) (*response.ProxyMaintenanceResp, error) {
+
+	row, err := global.EntClient.CoreGatewayCluster.Query().
+		Where(coregatewaycluster.ID(id)).
+		Select(coregatewaycluster.FieldMaintenance).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, &code.GatewayClusterNotExists
+		}
+		global.Logger.Sugar().Errorf("获取网关集群 %s 维护模式失败: %v", id, err)
+		return nil, &code.MaintenanceQueryFailed
+	}
+
+	return toMaintenanceResp(row.Maintenance), nil
+}
+
+// GatewayClusterSetMaintenance 保存网关集群的维护模式，作用于集群内未单独开启维护模式的虚拟主机。
+// 集群记录随 Gateway 断开被软删除，维护模式不受影响，因此不过滤已删除的记录
+func (s *ProxySvc) GatewayClusterSetMaintenance(ctx context.Context, id string, req *request.ProxyMaintenanceReq) error {
+
+	m, err := fromMaintenanceReq(req)
+	if err != nil {
+		global.Logger.Sugar().Warnf("网关集群 %s 维护模式不合法: %v", id, err)
+		return &code.MaintenanceInvalid
+	}
+
+	err = global.EntClient.CoreGatewayCluster.UpdateOneID(id).
+		SetMaintenance(m).
+		Exec(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return &code.GatewayClusterNotExists
+		}
+		global.Logger.Sugar().Errorf("保存网关集群 %s 维护模式失败: %v", id, err)
+		return &code.MaintenanceSaveFailed
+	}
+
+	return nil
+}
+
+// fromMaintenanceReq 转换维护模式请求并补全默认值，关闭维护模式时同样校验，保证再次开启时可以下发
+func fromMaintenanceReq(req *request.ProxyMaintenanceReq) (*common.Maintenance, error) {
+	m := &common.Maintenance{
+		Enabled: req.Enabled,
+		Response: common.DirectResponse{
+			StatusCode:  req.StatusCode,
+			Body:        req.Body,
+			ContentType: req.ContentType,
+		},
+		AllowPaths: req.AllowPaths,
+		AllowIPs:   req.AllowIPs,
+	}
+	if m.Response.StatusCode == 0 {
+		m.Response.StatusCode = constant.DefaultMaintenanceStatus
+	}
+
+	enabled := *m
+	enabled.Enabled = true
+	return m, envoy.ValidateMaintenance(router.ToMaintenance(&enabled))
+}
+
+func toMaintenanceResp(m *common.Maintenance) *response.ProxyMaintenanceResp {
+	resp := &response.ProxyMaintenanceResp{}
+	if m != nil {
+		resp.Maintenance = *m
+	}
+	if resp.AllowPaths == nil {
+		resp.AllowPaths = []string{}
+	}
+	if resp.AllowIPs == nil {
+		resp.AllowIPs = []string{}
+	}
+	return resp
+}
@@ -0,0 +1,39 @@
+package proxy
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/lyonmu/quebec/cmd/core/internal/dto/request"
+	"github.com/lyonmu/quebec/cmd/core/internal/dto/response"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/coregatewaynode"
+	"github.com/lyonmu/quebec/cmd/core/internal/global"
+	"github.com/lyonmu/quebec/pkg/code"
+)
+
+func (s *ProxySvc) ListGatewayNode(req *request.ProxyGatewayNodeListReq, ctx context.Context) ([]*response.ProxyGatewayNodeResp, error) {
+
+	var (
+		resp = make([]*response.ProxyGatewayNodeResp, 0)
+	)
+
+	query := global.EntClient.CoreGatewayNode.Query().Where(coregatewaynode.DeletedAtIsNil())
+
+	if len(req.ClusterID) > 0 {
+		query = query.Where(coregatewaynode.ClusterIDEQ(req.ClusterID))
+	}
+
+	rows, err := query.Order(coregatewaynode.ByNodeRegisterTime(sql.OrderAsc())).All(ctx)
+	if err != nil {
+		global.Logger.Sugar().Errorf("获取网关节点列表失败: %v", err)
+		return nil, &code.GatewayNodeQueryFailed
+	}
+
+	for _, row := range rows {
+		item := response.ProxyGatewayNodeResp{}
+		item.LoadDb(row)
+		resp = append(resp, &item)
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,157 @@
+package proxy
+
+import (
+	"context"
+
+	"github.com/lyonmu/quebec/cmd/core/internal/dto/request"
+	"github.com/lyonmu/quebec/cmd/core/internal/dto/response"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/coregatewayhttproute"
+	"github.com/lyonmu/quebec/cmd/core/internal/global"
+	"github.com/lyonmu/quebec/cmd/core/internal/service/grpc/router"
+	v1 "github.com/lyonmu/quebec/idl/router/v1"
+	"github.com/lyonmu/quebec/pkg/code"
+	"github.com/lyonmu/quebec/pkg/constant"
+	"github.com/lyonmu/quebec/pkg/envoy"
+)
+
+// HttpRoutePriority 获取路由的匹配优先级，以及当前与其相互覆盖的路由
+func (s *ProxySvc) HttpRoutePriority(ctx context.Context, id string) (*response.ProxyRoutePriorityResp, error) {
+
+	row, err := global.EntClient.CoreGatewayHttpRoute.Query().
+		Where(coregatewayhttproute.ID(id), coregatewayhttproute.DeletedAtIsNil()).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, &code.HttpRouteNotExists
+		}
+		global.Logger.Sugar().Errorf("获取路由 %s 匹配优先级失败: %v", id, err)
+		return nil, &code.HttpRoutePriorityQueryFailed
+	}
+
+	resp := &response.ProxyRoutePriorityResp{Priority: row.Priority, Conflicts: []response.ProxyRouteConflictResp{}}
+	if row.Status != constant.Yes {
+		return resp, nil
+	}
+	conflicts, err := routeConflicts(ctx, router.ToHttpRoute(row))
+	if err != nil {
+		global.Logger.Sugar().Errorf("获取路由 %s 同一虚拟主机下的路由失败: %v", id, err)
+		return nil, &code.HttpRoutePriorityQueryFailed
+	}
+	resp.Conflicts = append(resp.Conflicts, conflicts...)
+
+	return resp, nil
+}
+
+// HttpRouteSetPriority 保存路由的匹配优先级，调整后与同一虚拟主机下的其他路由相互覆盖时拒绝保存
+func (s *ProxySvc) HttpRouteSetPriority(ctx context.Context, id string, req *request.ProxyRoutePriorityReq) error {
+
+	if err := checkRouteConflicts(ctx, id, func(r *v1.HttpRoute) {
+		r.Priority = int32(req.Priority)
+	}); err != nil {
+		return err
+	}
+
+	err := global.EntClient.CoreGatewayHttpRoute.UpdateOneID(id).
+		Where(coregatewayhttproute.DeletedAtIsNil()).
+		SetPriority(req.Priority).
+		Exec(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return &code.HttpRouteNotExists
+		}
+		global.Logger.Sugar().Errorf("保存路由 %s 匹配优先级失败: %v", id, err)
+		return &code.HttpRoutePrioritySaveFailed
+	}
+
+	return nil
+}
+
+// checkRouteConflicts 在路由上应用待保存的修改后，检查其与同一虚拟主机下的其他启用路由是否相互覆盖，
+// 存在冲突时返回 HttpRouteConflict 并在 Data 中列出冲突的路由。已禁用的路由不会下发，不做检查
+func checkRouteConflicts(ctx context.Context, id string, modify func(r *v1.HttpRoute)) error {
+
+	row, err := global.EntClient.CoreGatewayHttpRoute.Query().
+		Where(coregatewayhttproute.ID(id), coregatewayhttproute.DeletedAtIsNil()).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return &code.HttpRouteNotExists
+		}
+		global.Logger.Sugar().Errorf("获取路由 %s 失败: %v", id, err)
+		return &code.HttpRoutePriorityQueryFailed
+	}
+	if row.Status != constant.Yes {
+		return nil
+	}
+
+	r := router.ToHttpRoute(row)
+	modify(r)
+	conflicts, err := routeConflicts(ctx, r)
+	if err != nil {
+		global.Logger.Sugar().Errorf("获取路由 %s 同一虚拟主机下的路由失败: %v", id, err)
+		return &code.HttpRoutePriorityQueryFailed
+	}
+	if len(conflicts) == 0 {
+		return nil
+	}
+
+	global.Logger.Sugar().Warnf("路由 %s 与 %d 条路由相互覆盖", id, len(conflicts))
+	resp := code.HttpRouteConflict
+	resp.Data = conflicts
+	return &resp
+}
+
+// routeConflicts 查找与路由 r 下发到同一虚拟主机的启用路由：虚拟主机相同，且网关集群相同或任一方对所有集群生效
+func routeConflicts(ctx context.Context, r *v1.HttpRoute) ([]response.ProxyRouteConflictResp, error) {
+
+	query := global.EntClient.CoreGatewayHttpRoute.Query().
+		Where(
+			coregatewayhttproute.IDNEQ(r.GetId()),
+			coregatewayhttproute.DeletedAtIsNil(),
+			coregatewayhttproute.Status(constant.Yes),
+		)
+	if r.GetVirtualHostId() == "" {
+		query.Where(coregatewayhttproute.Or(coregatewayhttproute.VirtualHostIDIsNil(), coregatewayhttproute.VirtualHostIDEQ("")))
+	} else {
+		query.Where(coregatewayhttproute.VirtualHostID(r.GetVirtualHostId()))
+	}
+	if r.GetClusterId() != "" {
+		query.Where(coregatewayhttproute.Or(
+			coregatewayhttproute.ClusterIDIsNil(),
+			coregatewayhttproute.ClusterIDEQ(""),
+			coregatewayhttproute.ClusterID(r.GetClusterId()),
+		))
+	}
+
+	rows, err := query.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	others := make([]*v1.HttpRoute, 0, len(rows))
+	for _, row := range rows {
+		others = append(others, router.ToHttpRoute(row))
+	}
+
+	shadowedBy, shadows := envoy.RouteConflicts(r, others)
+	conflicts := make([]response.ProxyRouteConflictResp, 0, len(shadowedBy)+len(shadows))
+	for _, o := range shadowedBy {
+		conflicts = append(conflicts, toRouteConflictResp(o, false))
+	}
+	for _, o := range shadows {
+		conflicts = append(conflicts, toRouteConflictResp(o, true))
+	}
+	return conflicts, nil
+}
+
+func toRouteConflictResp(r *v1.HttpRoute, shadowed bool) response.ProxyRouteConflictResp {
+	return response.ProxyRouteConflictResp{
+		ID:           r.GetId(),
+		Name:         r.GetName(),
+		Priority:     int(r.GetPriority()),
+		MatchType:    constant.ProxyHttpRouteMatchType(r.GetMatchType()),
+		MatchPattern: r.GetMatchPattern(),
+		Shadowed:     shadowed,
+	}
+}
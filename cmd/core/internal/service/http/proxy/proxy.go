@@ -0,0 +1,3 @@
+package proxy
+
+type ProxySvc struct{}
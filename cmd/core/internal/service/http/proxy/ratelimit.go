@@ -0,0 +1,339 @@
+package proxy
+
+import (
+	"context"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+
+	"github.com/lyonmu/quebec/cmd/core/internal/common"
+	"github.com/lyonmu/quebec/cmd/core/internal/dto/request"
+	"github.com/lyonmu/quebec/cmd/core/internal/dto/response"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/coregatewayhttproute"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/coregatewayl7listener"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/coregatewayratelimitrule"
+	"github.com/lyonmu/quebec/cmd/core/internal/global"
+	"github.com/lyonmu/quebec/cmd/core/internal/service/grpc/router"
+	v1 "github.com/lyonmu/quebec/idl/router/v1"
+	"github.com/lyonmu/quebec/pkg/code"
+	"github.com/lyonmu/quebec/pkg/constant"
+	"github.com/lyonmu/quebec/pkg/envoy"
+)
+
+// HttpRouteRateLimit 获取路由的本地限流
+func (s *ProxySvc) HttpRouteRateLimit(ctx context.Context, id string) (*response.ProxyLocalRateLimitResp, error) {
+
+	row, err := global.EntClient.CoreGatewayHttpRoute.Query().
+		Where(coregatewayhttproute.ID(id), coregatewayhttproute.DeletedAtIsNil()).
+		Select(coregatewayhttproute.FieldLocalRateLimit).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, &code.HttpRouteNotExists
+		}
+		global.Logger.Sugar().Errorf("获取路由 %s 本地限流失败: %v", id, err)
+		return nil, &code.LocalRateLimitQueryFailed
+	}
+
+	return toLocalRateLimitResp(row.LocalRateLimit), nil
+}
+
+// HttpRouteSetRateLimit 保存路由的本地限流，令牌桶容量为 0 时清除并沿用监听器的本地限流
+func (s *ProxySvc) HttpRouteSetRateLimit(ctx context.Context, id string, req *request.ProxyLocalRateLimitReq) error {
+
+	limit := fromLocalRateLimitReq(req)
+	if err := envoy.ValidateLocalRateLimit(router.ToLocalRateLimit(limit)); err != nil {
+		global.Logger.Sugar().Warnf("路由 %s 本地限流不合法: %v", id, err)
+		return &code.LocalRateLimitInvalid
+	}
+
+	update := global.EntClient.CoreGatewayHttpRoute.UpdateOneID(id).
+		Where(coregatewayhttproute.DeletedAtIsNil())
+	if limit == nil {
+		update.ClearLocalRateLimit()
+	} else {
+		update.SetLocalRateLimit(limit)
+	}
+
+	if err := update.Exec(ctx); err != nil {
+		if ent.IsNotFound(err) {
+			return &code.HttpRouteNotExists
+		}
+		global.Logger.Sugar().Errorf("保存路由 %s 本地限流失败: %v", id, err)
+		return &code.LocalRateLimitSaveFailed
+	}
+
+	return nil
+}
+
+// ListenerRateLimit 获取监听器的本地限流
+func (s *ProxySvc) ListenerRateLimit(ctx context.Context, id string) (*response.ProxyLocalRateLimitResp, error) {
+
+	row, err := global.EntClient.CoreGatewayL7Listener.Query().
+		Where(coregatewayl7listener.ID(id), coregatewayl7listener.DeletedAtIsNil()).
+		Select(coregatewayl7listener.FieldLocalRateLimit).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, &code.ListenerNotExists
+		}
+		global.Logger.Sugar().Errorf("获取监听器 %s 本地限流失败: %v", id, err)
+		return nil, &code.LocalRateLimitQueryFailed
+	}
+
+	return toLocalRateLimitResp(row.LocalRateLimit), nil
+}
+
+// ListenerSetRateLimit 保存监听器的本地限流，作用于监听器上所有未单独配置限流的路由，令牌桶容量为 0 时清除
+func (s *ProxySvc) ListenerSetRateLimit(ctx context.Context, id string, req *request.ProxyLocalRateLimitReq) error {
+
+	limit := fromLocalRateLimitReq(req)
+	if err := envoy.ValidateLocalRateLimit(router.ToLocalRateLimit(limit)); err != nil {
+		global.Logger.Sugar().Warnf("监听器 %s 本地限流不合法: %v", id, err)
+		return &code.LocalRateLimitInvalid
+	}
+
+	update := global.EntClient.CoreGatewayL7Listener.UpdateOneID(id).
+		Where(coregatewayl7listener.DeletedAtIsNil())
+	if limit == nil {
+		update.ClearLocalRateLimit()
+	} else {
+		update.SetLocalRateLimit(limit)
+	}
+
+	if err := update.Exec(ctx); err != nil {
+		if ent.IsNotFound(err) {
+			return &code.ListenerNotExists
+		}
+		global.Logger.Sugar().Errorf("保存监听器 %s 本地限流失败: %v", id, err)
+		return &code.LocalRateLimitSaveFailed
+	}
+
+	return nil
+}
+
+// fromLocalRateLimitReq 转换本地限流请求并补全默认值，令牌桶容量为 0 时返回 nil
+func fromLocalRateLimitReq(req *request.ProxyLocalRateLimitReq) *common.LocalRateLimit {
+	if req.MaxTokens == 0 {
+		return nil
+	}
+	out := &common.LocalRateLimit{
+		MaxTokens:      req.MaxTokens,
+		TokensPerFill:  req.TokensPerFill,
+		FillIntervalMs: req.FillIntervalMs,
+		StatusCode:     req.StatusCode,
+		HeaderName:     req.HeaderName,
+		HeaderValue:    req.HeaderValue,
+	}
+	if out.TokensPerFill == 0 {
+		out.TokensPerFill = out.MaxTokens
+	}
+	if out.StatusCode == 0 {
+		out.StatusCode = constant.DefaultRateLimitStatus
+	}
+	return out
+}
+
+func toLocalRateLimitResp(l *common.LocalRateLimit) *response.ProxyLocalRateLimitResp {
+	resp := &response.ProxyLocalRateLimitResp{}
+	if l != nil && l.MaxTokens > 0 {
+		resp.Enabled, resp.LocalRateLimit = true, *l
+	}
+	return resp
+}
+
+// ListRateLimitRule 获取全局限流规则列表
+func (s *ProxySvc) ListRateLimitRule(ctx context.Context) ([]*response.ProxyRateLimitRuleResp, error) {
+
+	rows, err := global.EntClient.CoreGatewayRateLimitRule.Query().
+		Where(coregatewayratelimitrule.DeletedAtIsNil()).
+		Order(coregatewayratelimitrule.ByCreatedAt(sql.OrderAsc())).
+		All(ctx)
+	if err != nil {
+		global.Logger.Sugar().Errorf("获取全局限流规则列表失败: %v", err)
+		return nil, &code.RateLimitRuleQueryFailed
+	}
+
+	resp := make([]*response.ProxyRateLimitRuleResp, 0, len(rows))
+	for _, row := range rows {
+		item := &response.ProxyRateLimitRuleResp{}
+		item.LoadDb(row)
+		resp = append(resp, item)
+	}
+
+	return resp, nil
+}
+
+// RateLimitRuleAdd 创建全局限流规则
+func (s *ProxySvc) RateLimitRuleAdd(ctx context.Context, req *request.ProxyRateLimitRuleAddReq) error {
+
+	if req.Status == 0 {
+		req.Status = constant.Yes
+	}
+
+	descriptors := fromRateLimitDescriptorsReq(req.Descriptors)
+	if err := validateRateLimitRule(descriptors, req.Unit, req.RequestsPerUnit); err != nil {
+		global.Logger.Sugar().Warnf("全局限流规则 %s 不合法: %v", req.Name, err)
+		return &code.RateLimitRuleInvalid
+	}
+
+	err := global.EntClient.CoreGatewayRateLimitRule.Create().
+		SetName(req.Name).
+		SetDescription(req.Description).
+		SetDescriptors(descriptors).
+		SetUnit(req.Unit).
+		SetRequestsPerUnit(req.RequestsPerUnit).
+		SetStatus(req.Status).
+		Exec(ctx)
+	if err != nil {
+		global.Logger.Sugar().Errorf("创建全局限流规则 %s 失败: %v", req.Name, err)
+		return &code.RateLimitRuleSaveFailed
+	}
+
+	return nil
+}
+
+// RateLimitRuleUpdate 更新全局限流规则，未传入的字段保持不变
+func (s *ProxySvc) RateLimitRuleUpdate(ctx context.Context, id string, req *request.ProxyRateLimitRuleUpdateReq) error {
+
+	rule, err := global.EntClient.CoreGatewayRateLimitRule.Query().
+		Where(coregatewayratelimitrule.ID(id), coregatewayratelimitrule.DeletedAtIsNil()).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return &code.RateLimitRuleNotExists
+		}
+		global.Logger.Sugar().Errorf("获取全局限流规则 %s 失败: %v", id, err)
+		return &code.RateLimitRuleQueryFailed
+	}
+
+	descriptors, unit, requests := rule.Descriptors, rule.Unit, rule.RequestsPerUnit
+	if req.Descriptors != nil {
+		descriptors = fromRateLimitDescriptorsReq(req.Descriptors)
+	}
+	if req.Unit != 0 {
+		unit = req.Unit
+	}
+	if req.RequestsPerUnit != 0 {
+		requests = req.RequestsPerUnit
+	}
+	if err := validateRateLimitRule(descriptors, unit, requests); err != nil {
+		global.Logger.Sugar().Warnf("全局限流规则 %s 不合法: %v", id, err)
+		return &code.RateLimitRuleInvalid
+	}
+
+	update := global.EntClient.CoreGatewayRateLimitRule.UpdateOneID(id).
+		SetDescriptors(descriptors).
+		SetUnit(unit).
+		SetRequestsPerUnit(requests)
+	if req.Name != nil {
+		update.SetName(*req.Name)
+	}
+	if req.Description != nil {
+		update.SetDescription(*req.Description)
+	}
+	if req.Status != 0 {
+		update.SetStatus(req.Status)
+	}
+
+	if err := update.Exec(ctx); err != nil {
+		global.Logger.Sugar().Errorf("更新全局限流规则 %s 失败: %v", id, err)
+		return &code.RateLimitRuleSaveFailed
+	}
+
+	return nil
+}
+
+// RateLimitRuleDelete 删除全局限流规则，已有计数在当前窗口结束后自动过期
+func (s *ProxySvc) RateLimitRuleDelete(ctx context.Context, id string) error {
+
+	err := global.EntClient.CoreGatewayRateLimitRule.UpdateOneID(id).
+		Where(coregatewayratelimitrule.DeletedAtIsNil()).
+		SetDeletedAt(time.Now()).
+		Exec(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return &code.RateLimitRuleNotExists
+		}
+		global.Logger.Sugar().Errorf("删除全局限流规则 %s 失败: %v", id, err)
+		return &code.RateLimitRuleDelFailed
+	}
+
+	return nil
+}
+
+// HttpRouteRateLimitActions 获取路由的全局限流描述符条目
+func (s *ProxySvc) HttpRouteRateLimitActions(ctx context.Context, id string) (*response.ProxyRateLimitActionsResp, error) {
+
+	row, err := global.EntClient.CoreGatewayHttpRoute.Query().
+		Where(coregatewayhttproute.ID(id), coregatewayhttproute.DeletedAtIsNil()).
+		Select(coregatewayhttproute.FieldRateLimitActions).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, &code.HttpRouteNotExists
+		}
+		global.Logger.Sugar().Errorf("获取路由 %s 全局限流描述符失败: %v", id, err)
+		return nil, &code.RateLimitActionsQueryFailed
+	}
+
+	resp := &response.ProxyRateLimitActionsResp{Enabled: len(row.RateLimitActions) > 0, Actions: row.RateLimitActions}
+	if resp.Actions == nil {
+		resp.Actions = []common.RateLimitAction{}
+	}
+	return resp, nil
+}
+
+// HttpRouteSetRateLimitActions 保存路由的全局限流描述符条目，为空时清除
+func (s *ProxySvc) HttpRouteSetRateLimitActions(ctx context.Context, id string, req *request.ProxyRateLimitActionsReq) error {
+
+	actions := make([]common.RateLimitAction, 0, len(req.Actions))
+	for _, a := range req.Actions {
+		actions = append(actions, common.RateLimitAction{
+			Type:            a.Type,
+			DescriptorKey:   a.DescriptorKey,
+			DescriptorValue: a.DescriptorValue,
+			Name:            a.Name,
+		})
+	}
+	if err := envoy.ValidateRateLimitActions(router.ToRateLimitActions(actions)); err != nil {
+		global.Logger.Sugar().Warnf("路由 %s 全局限流描述符不合法: %v", id, err)
+		return &code.RateLimitActionsInvalid
+	}
+
+	update := global.EntClient.CoreGatewayHttpRoute.UpdateOneID(id).
+		Where(coregatewayhttproute.DeletedAtIsNil())
+	if len(actions) == 0 {
+		update.ClearRateLimitActions()
+	} else {
+		update.SetRateLimitActions(actions)
+	}
+
+	if err := update.Exec(ctx); err != nil {
+		if ent.IsNotFound(err) {
+			return &code.HttpRouteNotExists
+		}
+		global.Logger.Sugar().Errorf("保存路由 %s 全局限流描述符失败: %v", id, err)
+		return &code.RateLimitActionsSaveFailed
+	}
+
+	return nil
+}
+
+func fromRateLimitDescriptorsReq(req []request.ProxyRateLimitDescriptorReq) []common.RateLimitDescriptor {
+	out := make([]common.RateLimitDescriptor, 0, len(req))
+	for _, d := range req {
+		out = append(out, common.RateLimitDescriptor{Key: d.Key, Value: d.Value})
+	}
+	return out
+}
+
+// validateRateLimitRule 使用 Gateway 限流服务的同一校验
+func validateRateLimitRule(descriptors []common.RateLimitDescriptor, unit constant.ProxyRateLimitUnit, requests uint32) error {
+	return envoy.ValidateRateLimitRule(&v1.RateLimitRule{
+		Descriptors:     router.ToRateLimitDescriptors(descriptors),
+		Unit:            int32(unit),
+		RequestsPerUnit: requests,
+	})
+}
@@ -0,0 +1,143 @@
+package proxy
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/lyonmu/quebec/cmd/core/internal/dto/request"
+	"github.com/lyonmu/quebec/cmd/core/internal/dto/response"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/coregatewaynode"
+	"github.com/lyonmu/quebec/cmd/core/internal/global"
+	"github.com/lyonmu/quebec/cmd/core/internal/service/grpc/router"
+	v1 "github.com/lyonmu/quebec/idl/router/v1"
+	"github.com/lyonmu/quebec/pkg/code"
+	"github.com/lyonmu/quebec/pkg/envoy"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// Render 渲染节点或集群将收到的 Envoy 资源并执行与 Gateway 相同的校验，不会下发给 Envoy
+func (s *ProxySvc) Render(ctx context.Context, req *request.ProxyRenderReq) (*response.ProxyRenderResp, error) {
+
+	var (
+		cfg     *v1.ProxyConfig
+		skipped []router.SkippedRoute
+		resp    = &response.ProxyRenderResp{
+			ClusterID: req.ClusterID,
+			NodeID:    req.NodeID,
+			Revision:  req.Revision,
+			Errors:    make([]response.ProxyRenderError, 0),
+		}
+	)
+
+	// 指定节点时使用节点所属集群，未指定修订且节点处于灰度外时使用稳定修订
+	if len(req.NodeID) > 0 {
+		node, err := global.EntClient.CoreGatewayNode.Query().
+			Where(coregatewaynode.NodeID(req.NodeID), coregatewaynode.DeletedAtIsNil()).
+			First(ctx)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return nil, &code.GatewayNodeNotExists
+			}
+			global.Logger.Sugar().Errorf("获取网关节点 %s 失败: %v", req.NodeID, err)
+			return nil, &code.GatewayNodeQueryFailed
+		}
+		resp.ClusterID = node.ClusterID
+
+		if req.Revision == 0 {
+			rollout, err := router.StableRollout(ctx, node.ClusterID, req.NodeID)
+			if err != nil {
+				global.Logger.Sugar().Errorf("获取集群 %s 灰度发布失败: %v", node.ClusterID, err)
+				return nil, &code.RolloutQueryFailed
+			}
+			if rollout != nil {
+				resp.Revision = rollout.GetStableRevision()
+				cfg = rollout.GetStableConfig()
+			}
+		}
+	}
+
+	if cfg == nil {
+		var err error
+		if resp.Revision > 0 {
+			_, cfg, err = router.LoadRevision(ctx, resp.Revision)
+			if ent.IsNotFound(err) {
+				return nil, &code.RevisionNotExists
+			}
+		} else {
+			cfg, skipped, err = router.LoadProxyConfigWithSkipped(ctx)
+		}
+		if err != nil {
+			global.Logger.Sugar().Errorf("加载代理配置失败: %v", err)
+			return nil, &code.ProxyRenderFailed
+		}
+	}
+
+	// 目标上游或所属虚拟主机不可用的路由不会下发，同样作为错误返回
+	for _, r := range skipped {
+		if len(r.Route.ClusterID) > 0 && r.Route.ClusterID != resp.ClusterID {
+			continue
+		}
+		message := fmt.Sprintf("upstream %q not found or disabled", r.UpstreamID)
+		if len(r.VirtualHostID) > 0 {
+			message = fmt.Sprintf("virtual host %q not found or disabled", r.VirtualHostID)
+		}
+		resp.Errors = append(resp.Errors, response.ProxyRenderError{
+			Entity:  envoy.EntityHttpRoute,
+			ID:      r.Route.ID,
+			Name:    r.Route.Name,
+			Message: message,
+		})
+	}
+
+	res, verrs := envoy.Render(envoy.ScopeProxyConfig(cfg, resp.ClusterID), envoy.Options{AccessLog: req.AccessLog, GlobalRateLimit: req.GlobalRateLimit})
+	for _, ve := range verrs {
+		resp.Errors = append(resp.Errors, renderError(ve))
+	}
+	if _, err := res.Snapshot(strconv.FormatInt(resp.Revision, 10)); err != nil {
+		var ve *envoy.ValidationError
+		if !errors.As(err, &ve) {
+			ve = &envoy.ValidationError{Entity: envoy.EntitySnapshot, Err: err}
+		}
+		resp.Errors = append(resp.Errors, renderError(ve))
+	}
+	resp.Valid = len(resp.Errors) == 0
+
+	var errs [4]error
+	resp.Listeners, errs[0] = marshalResources(res.Listeners)
+	resp.Routes, errs[1] = marshalResources(res.Routes)
+	resp.Clusters, errs[2] = marshalResources(res.Clusters)
+	resp.Endpoints, errs[3] = marshalResources(res.Endpoints)
+	if err := errors.Join(errs[:]...); err != nil {
+		global.Logger.Sugar().Errorf("序列化 Envoy 资源失败: %v", err)
+		return nil, &code.ProxyRenderFailed
+	}
+
+	return resp, nil
+}
+
+func renderError(ve *envoy.ValidationError) response.ProxyRenderError {
+	return response.ProxyRenderError{
+		Entity:  ve.Entity,
+		ID:      ve.ID,
+		Name:    ve.Name,
+		Message: ve.Err.Error(),
+	}
+}
+
+// marshalResources 将 Envoy 资源序列化为 protojson
+func marshalResources[T proto.Message](items []T) ([]json.RawMessage, error) {
+	out := make([]json.RawMessage, 0, len(items))
+	for _, item := range items {
+		data, err := protojson.Marshal(item)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, data)
+	}
+	return out, nil
+}
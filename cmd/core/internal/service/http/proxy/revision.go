@@ -0,0 +1,724 @@
+package proxy
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/lyonmu/quebec/cmd/core/internal/common"
+	"github.com/lyonmu/quebec/cmd/core/internal/dto/request"
+	"github.com/lyonmu/quebec/cmd/core/internal/dto/response"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/corecert"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/coregatewaycluster"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/coregatewayhttproute"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/coregatewayhttproutetarget"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/coregatewayl7listener"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/coregatewayratelimitrule"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/coregatewayvirtualhost"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/coreproxyrevision"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/coreupstream"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/coreupstreamhost"
+	"github.com/lyonmu/quebec/cmd/core/internal/global"
+	"github.com/lyonmu/quebec/cmd/core/internal/service/grpc/router"
+	"github.com/lyonmu/quebec/cmd/core/internal/utils"
+	v1 "github.com/lyonmu/quebec/idl/router/v1"
+	"github.com/lyonmu/quebec/pkg/code"
+	"github.com/lyonmu/quebec/pkg/constant"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+func (s *ProxySvc) RevisionPage(ctx context.Context, req *request.ProxyRevisionPageReq) (*response.ProxyRevisionListResp, error) {
+	var (
+		items    = make([]*response.ProxyRevisionResp, 0)
+		page     = (req.Page - 1) * req.PageSize
+		pageSize = req.PageSize
+		resp     = &response.ProxyRevisionListResp{}
+		query    = global.EntClient.CoreProxyRevision.Query().Where(coreproxyrevision.DeletedAtIsNil())
+	)
+
+	if len(req.AuthorID) > 0 {
+		query = query.Where(coreproxyrevision.AuthorID(req.AuthorID))
+	}
+
+	total, err := query.Count(ctx)
+	if err != nil {
+		global.Logger.Sugar().Errorf("select proxy revision failed: %s", err)
+		return nil, &code.RevisionQueryFailed
+	}
+
+	// 列表不返回完整配置
+	rows, err := query.Offset(page).Limit(pageSize).
+		Select(
+			coreproxyrevision.FieldID, coreproxyrevision.FieldCreatedAt, coreproxyrevision.FieldRevision,
+			coreproxyrevision.FieldAction, coreproxyrevision.FieldRollbackFrom, coreproxyrevision.FieldAuthorID,
+			coreproxyrevision.FieldAuthorName, coreproxyrevision.FieldSummary,
+		).
+		Order(coreproxyrevision.ByRevision(sql.OrderDesc())).
+		All(ctx)
+	if err != nil {
+		global.Logger.Sugar().Errorf("select proxy revision failed: %s", err)
+		return nil, &code.RevisionQueryFailed
+	}
+
+	for _, row := range rows {
+		item := response.ProxyRevisionResp{}
+		item.LoadDb(row)
+		items = append(items, &item)
+	}
+
+	resp.Total = total
+	resp.Items = items
+	resp.Page = req.Page
+	resp.PageSize = req.PageSize
+
+	return resp, nil
+}
+
+func (s *ProxySvc) RevisionDetail(ctx context.Context, revision int64) (*response.ProxyRevisionDetailResp, error) {
+	row, cfg, err := router.LoadRevision(ctx, revision)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, &code.RevisionNotExists
+		}
+		global.Logger.Sugar().Errorf("获取配置修订 %d 失败: %v", revision, err)
+		return nil, &code.RevisionQueryFailed
+	}
+
+	resp := &response.ProxyRevisionDetailResp{Config: cfg}
+	resp.LoadDb(row)
+	return resp, nil
+}
+
+// RevisionDiff 比较两个修订之间各类资源的新增、删除与字段级修改
+func (s *ProxySvc) RevisionDiff(ctx context.Context, req *request.ProxyRevisionDiffReq) (*response.ProxyRevisionDiffResp, error) {
+	configs := make([]*v1.ProxyConfig, 0, 2)
+	for _, revision := range []int64{req.From, req.To} {
+		_, cfg, err := router.LoadRevision(ctx, revision)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return nil, &code.RevisionNotExists
+			}
+			global.Logger.Sugar().Errorf("获取配置修订 %d 失败: %v", revision, err)
+			return nil, &code.RevisionQueryFailed
+		}
+		configs = append(configs, cfg)
+	}
+
+	resp, err := DiffProxyConfig(configs[0], configs[1])
+	if err != nil {
+		global.Logger.Sugar().Errorf("比较配置修订 %d 与 %d 失败: %v", req.From, req.To, err)
+		return nil, &code.RevisionDiffFailed
+	}
+	resp.From = req.From
+	resp.To = req.To
+	return resp, nil
+}
+
+// DiffProxyConfig 按资源 ID 比较两份完整配置
+func DiffProxyConfig(from, to *v1.ProxyConfig) (*response.ProxyRevisionDiffResp, error) {
+	var (
+		resp = &response.ProxyRevisionDiffResp{}
+		err  error
+	)
+	if resp.Upstreams, err = diffResources(from.GetUpstreams(), to.GetUpstreams()); err != nil {
+		return nil, err
+	}
+	if resp.HttpRoutes, err = diffResources(from.GetHttpRoutes(), to.GetHttpRoutes()); err != nil {
+		return nil, err
+	}
+	if resp.L7Listeners, err = diffResources(from.GetL7Listeners(), to.GetL7Listeners()); err != nil {
+		return nil, err
+	}
+	if resp.Certs, err = diffResources(from.GetCerts(), to.GetCerts()); err != nil {
+		return nil, err
+	}
+	if resp.VirtualHosts, err = diffResources(from.GetVirtualHosts(), to.GetVirtualHosts()); err != nil {
+		return nil, err
+	}
+	if resp.RateLimitRules, err = diffResources(from.GetRateLimitRules(), to.GetRateLimitRules()); err != nil {
+		return nil, err
+	}
+	if resp.ClusterMaintenances, err = diffResources(toClusterMaintenances(from), toClusterMaintenances(to)); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// clusterMaintenance 集群维护模式没有名称，差异中以网关集群标识作为名称展示
+type clusterMaintenance struct {
+	*v1.ClusterMaintenance
+}
+
+func (c clusterMaintenance) GetName() string {
+	return c.GetClusterId()
+}
+
+func toClusterMaintenances(cfg *v1.ProxyConfig) []clusterMaintenance {
+	items := make([]clusterMaintenance, 0, len(cfg.GetClusterMaintenances()))
+	for _, m := range cfg.GetClusterMaintenances() {
+		items = append(items, clusterMaintenance{m})
+	}
+	return items
+}
+
+type identified interface {
+	proto.Message
+	GetId() string
+	GetName() string
+}
+
+func diffResources[T identified](from, to []T) (*response.ProxyResourceDiff, error) {
+	diff := &response.ProxyResourceDiff{
+		Added:    make([]response.ProxyResourceRef, 0),
+		Removed:  make([]response.ProxyResourceRef, 0),
+		Modified: make([]response.ProxyResourceChange, 0),
+	}
+
+	old := make(map[string]T, len(from))
+	for _, item := range from {
+		old[item.GetId()] = item
+	}
+
+	seen := make(map[string]struct{}, len(to))
+	for _, item := range to {
+		seen[item.GetId()] = struct{}{}
+		prev, ok := old[item.GetId()]
+		if !ok {
+			diff.Added = append(diff.Added, response.ProxyResourceRef{ID: item.GetId(), Name: item.GetName()})
+			continue
+		}
+		if proto.Equal(prev, item) {
+			continue
+		}
+		fields, err := diffFields(prev, item)
+		if err != nil {
+			return nil, err
+		}
+		diff.Modified = append(diff.Modified, response.ProxyResourceChange{
+			ProxyResourceRef: response.ProxyResourceRef{ID: item.GetId(), Name: item.GetName()},
+			Fields:           fields,
+		})
+	}
+
+	for _, item := range from {
+		if _, ok := seen[item.GetId()]; !ok {
+			diff.Removed = append(diff.Removed, response.ProxyResourceRef{ID: item.GetId(), Name: item.GetName()})
+		}
+	}
+
+	return diff, nil
+}
+
+// diffFields 以 protojson 字段名比较两个资源的顶层字段
+func diffFields(from, to proto.Message) ([]response.ProxyFieldChange, error) {
+	a, err := toFieldMap(from)
+	if err != nil {
+		return nil, err
+	}
+	b, err := toFieldMap(to)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make(map[string]struct{}, len(a)+len(b))
+	for k := range a {
+		keys[k] = struct{}{}
+	}
+	for k := range b {
+		keys[k] = struct{}{}
+	}
+
+	changes := make([]response.ProxyFieldChange, 0)
+	for k := range keys {
+		if reflect.DeepEqual(a[k], b[k]) {
+			continue
+		}
+		changes = append(changes, response.ProxyFieldChange{Field: k, From: a[k], To: b[k]})
+	}
+	sort.Slice(changes, func(i, j int) bool { return changes[i].Field < changes[j].Field })
+	return changes, nil
+}
+
+func toFieldMap(m proto.Message) (map[string]any, error) {
+	data, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(m)
+	if err != nil {
+		return nil, err
+	}
+	fields := make(map[string]any)
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+	return fields, nil
+}
+
+// RevisionRollback 将代理实体恢复到指定修订的状态，恢复后的配置作为新修订发布
+func (s *ProxySvc) RevisionRollback(ctx context.Context, revision int64) error {
+	_, cfg, err := router.LoadRevision(ctx, revision)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return &code.RevisionNotExists
+		}
+		global.Logger.Sugar().Errorf("获取配置修订 %d 失败: %v", revision, err)
+		return &code.RevisionQueryFailed
+	}
+
+	tx, err := global.EntClient.Tx(ctx)
+	if err != nil {
+		global.Logger.Sugar().Errorf("回滚配置修订 %d 失败: %v", revision, err)
+		return &code.RevisionRollbackFailed
+	}
+	ctx = ent.NewTxContext(utils.WithRollback(ctx, revision), tx)
+
+	if err := restoreProxyConfig(ctx, tx, cfg); err != nil {
+		_ = tx.Rollback()
+		if resp, ok := err.(*code.Response); ok {
+			return resp
+		}
+		global.Logger.Sugar().Errorf("回滚配置修订 %d 失败: %v", revision, err)
+		return &code.RevisionRollbackFailed
+	}
+
+	if err := tx.Commit(); err != nil {
+		global.Logger.Sugar().Errorf("回滚配置修订 %d 失败: %v", revision, err)
+		return &code.RevisionRollbackFailed
+	}
+
+	return nil
+}
+
+// restoreProxyConfig 启用修订中包含的实体并恢复其字段，禁用修订中不存在的实体
+func restoreProxyConfig(ctx context.Context, tx *ent.Tx, cfg *v1.ProxyConfig) error {
+	// 证书不在修订中保存私钥等内容，只能恢复启用状态
+	certIDs := make([]string, 0, len(cfg.GetCerts()))
+	for _, c := range cfg.GetCerts() {
+		row, err := tx.CoreCert.Get(ctx, c.GetId())
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return &code.RevisionCertChanged
+			}
+			return err
+		}
+		if row.CertificateHash != c.GetCertificateHash() || row.PrivateKeyHash != c.GetPrivateKeyHash() {
+			return &code.RevisionCertChanged
+		}
+		certIDs = append(certIDs, c.GetId())
+	}
+	if err := tx.CoreCert.Update().Where(corecert.IDIn(certIDs...)).
+		SetStatus(constant.Yes).ClearDeletedAt().Exec(ctx); err != nil {
+		return err
+	}
+	if err := tx.CoreCert.Update().Where(corecert.IDNotIn(certIDs...), corecert.Status(constant.Yes)).
+		SetStatus(constant.No).Exec(ctx); err != nil {
+		return err
+	}
+
+	upstreamIDs := make([]string, 0, len(cfg.GetUpstreams()))
+	for _, u := range cfg.GetUpstreams() {
+		if err := restoreUpstream(ctx, tx, u); err != nil {
+			return fmt.Errorf("restore upstream %s: %w", u.GetId(), err)
+		}
+		upstreamIDs = append(upstreamIDs, u.GetId())
+	}
+	if err := tx.CoreUpstream.Update().Where(coreupstream.IDNotIn(upstreamIDs...), coreupstream.Status(constant.Yes)).
+		SetStatus(constant.No).Exec(ctx); err != nil {
+		return err
+	}
+
+	routeIDs := make([]string, 0, len(cfg.GetHttpRoutes()))
+	for _, r := range cfg.GetHttpRoutes() {
+		err := tx.CoreGatewayHttpRoute.Create().
+			SetID(r.GetId()).
+			SetName(r.GetName()).
+			SetUpstreamID(r.GetUpstreamId()).
+			SetClusterID(r.GetClusterId()).
+			SetMatchType(constant.ProxyHttpRouteMatchType(r.GetMatchType())).
+			SetMatchPattern(r.GetMatchPattern()).
+			SetPriority(int(r.GetPriority())).
+			SetTimeoutMs(int(r.GetTimeoutMs())).
+			SetEnablePathRewrite(yesOrNo(r.GetEnablePathRewrite())).
+			SetPathRewrite(r.GetPathRewrite()).
+			SetEnableRedirect(yesOrNo(r.GetEnableRedirect())).
+			SetRedirectURL(r.GetRedirectUrl()).
+			SetRedirectCode(int(r.GetRedirectCode())).
+			SetIgnoreCase(yesOrNo(r.GetIgnoreCase())).
+			SetMethods(r.GetMethods()).
+			SetHeaderMatchers(fromHttpMatchers(r.GetHeaders())).
+			SetQueryMatchers(fromHttpMatchers(r.GetQueryParameters())).
+			SetRetryPolicy(fromRetryPolicy(r.GetRetryPolicy())).
+			SetVirtualHostID(r.GetVirtualHostId()).
+			SetHeaderMutation(fromHeaderMutation(r.GetHeaderMutation())).
+			SetMirrorUpstreamID(r.GetMirrorUpstreamId()).
+			SetMirrorPercent(r.GetMirrorPercent()).
+			SetFaultPolicy(fromFaultPolicy(r.GetFaultPolicy())).
+			SetCorsPolicy(fromCorsPolicy(r.GetCorsPolicy())).
+			SetLocalRateLimit(fromLocalRateLimit(r.GetLocalRateLimit())).
+			SetRateLimitActions(fromRateLimitActions(r.GetRateLimitActions())).
+			SetDirectResponse(fromDirectResponse(r.GetDirectResponse())).
+			SetHashPolicies(fromHashPolicies(r.GetHashPolicies())).
+			SetDisableCompression(yesOrNo(r.GetDisableCompression())).
+			SetStatus(constant.Yes).
+			OnConflictColumns(coregatewayhttproute.FieldID).
+			UpdateNewValues().
+			ClearDeletedAt().
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("restore http route %s: %w", r.GetId(), err)
+		}
+		if err := restoreRouteTargets(ctx, tx, r); err != nil {
+			return fmt.Errorf("restore http route %s targets: %w", r.GetId(), err)
+		}
+		routeIDs = append(routeIDs, r.GetId())
+	}
+	if err := tx.CoreGatewayHttpRoute.Update().Where(coregatewayhttproute.IDNotIn(routeIDs...), coregatewayhttproute.Status(constant.Yes)).
+		SetStatus(constant.No).Exec(ctx); err != nil {
+		return err
+	}
+
+	listenerIDs := make([]string, 0, len(cfg.GetL7Listeners()))
+	for _, l := range cfg.GetL7Listeners() {
+		err := tx.CoreGatewayL7Listener.Create().
+			SetID(l.GetId()).
+			SetName(l.GetName()).
+			SetHost(l.GetHost()).
+			SetPort(uint16(l.GetPort())).
+			SetEnableTLS(yesOrNo(l.GetEnableTls())).
+			SetClusterID(l.GetClusterId()).
+			SetLocalRateLimit(fromLocalRateLimit(l.GetLocalRateLimit())).
+			SetCompression(fromCompression(l.GetCompression())).
+			SetStatus(constant.Yes).
+			OnConflictColumns(coregatewayl7listener.FieldID).
+			UpdateNewValues().
+			ClearDeletedAt().
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("restore l7 listener %s: %w", l.GetId(), err)
+		}
+		listenerIDs = append(listenerIDs, l.GetId())
+	}
+	if err := tx.CoreGatewayL7Listener.Update().Where(coregatewayl7listener.IDNotIn(listenerIDs...), coregatewayl7listener.Status(constant.Yes)).
+		SetStatus(constant.No).Exec(ctx); err != nil {
+		return err
+	}
+
+	vhostIDs := make([]string, 0, len(cfg.GetVirtualHosts()))
+	for _, vh := range cfg.GetVirtualHosts() {
+		err := tx.CoreGatewayVirtualHost.Create().
+			SetID(vh.GetId()).
+			SetName(vh.GetName()).
+			SetListenerID(vh.GetListenerId()).
+			SetDomains(vh.GetDomains()).
+			SetHeaderMutation(fromHeaderMutation(vh.GetHeaderMutation())).
+			SetCorsPolicy(fromCorsPolicy(vh.GetCorsPolicy())).
+			SetMaintenance(fromMaintenance(vh.GetMaintenance())).
+			SetStatus(constant.Yes).
+			OnConflictColumns(coregatewayvirtualhost.FieldID).
+			UpdateNewValues().
+			ClearDeletedAt().
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("restore virtual host %s: %w", vh.GetId(), err)
+		}
+		vhostIDs = append(vhostIDs, vh.GetId())
+	}
+	if err := tx.CoreGatewayVirtualHost.Update().Where(coregatewayvirtualhost.IDNotIn(vhostIDs...), coregatewayvirtualhost.Status(constant.Yes)).
+		SetStatus(constant.No).Exec(ctx); err != nil {
+		return err
+	}
+
+	// 网关集群由 Gateway 注册，只恢复其维护模式
+	clusterIDs := make([]string, 0, len(cfg.GetClusterMaintenances()))
+	for _, m := range cfg.GetClusterMaintenances() {
+		if err := tx.CoreGatewayCluster.Update().Where(coregatewaycluster.ID(m.GetId())).
+			SetMaintenance(fromMaintenance(m.GetMaintenance())).Exec(ctx); err != nil {
+			return fmt.Errorf("restore gateway cluster %s maintenance: %w", m.GetClusterId(), err)
+		}
+		clusterIDs = append(clusterIDs, m.GetId())
+	}
+	if err := tx.CoreGatewayCluster.Update().Where(coregatewaycluster.IDNotIn(clusterIDs...), coregatewaycluster.MaintenanceNotNil()).
+		ClearMaintenance().Exec(ctx); err != nil {
+		return err
+	}
+
+	ruleIDs := make([]string, 0, len(cfg.GetRateLimitRules()))
+	for _, r := range cfg.GetRateLimitRules() {
+		err := tx.CoreGatewayRateLimitRule.Create().
+			SetID(r.GetId()).
+			SetName(r.GetName()).
+			SetDescriptors(fromRateLimitDescriptors(r.GetDescriptors())).
+			SetUnit(constant.ProxyRateLimitUnit(r.GetUnit())).
+			SetRequestsPerUnit(r.GetRequestsPerUnit()).
+			SetStatus(constant.Yes).
+			OnConflictColumns(coregatewayratelimitrule.FieldID).
+			UpdateNewValues().
+			ClearDeletedAt().
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("restore rate limit rule %s: %w", r.GetId(), err)
+		}
+		ruleIDs = append(ruleIDs, r.GetId())
+	}
+	return tx.CoreGatewayRateLimitRule.Update().Where(coregatewayratelimitrule.IDNotIn(ruleIDs...), coregatewayratelimitrule.Status(constant.Yes)).
+		SetStatus(constant.No).Exec(ctx)
+}
+
+func restoreUpstream(ctx context.Context, tx *ent.Tx, u *v1.Upstream) error {
+	err := tx.CoreUpstream.Create().
+		SetID(u.GetId()).
+		SetName(u.GetName()).
+		SetLbPolicy(constant.ProxyLbPolicy(u.GetLbPolicy())).
+		SetConnectTimeoutMs(int(u.GetConnectTimeoutMs())).
+		SetMaxConnections(int(u.GetMaxConnections())).
+		SetMaxPendingRequests(int(u.GetMaxPendingRequests())).
+		SetMaxRequests(int(u.GetMaxRequests())).
+		SetMaxRetries(int(u.GetMaxRetries())).
+		SetRingMinSize(u.GetRingMinSize()).
+		SetRingMaxSize(u.GetRingMaxSize()).
+		SetMaglevTableSize(u.GetMaglevTableSize()).
+		SetStatus(constant.Yes).
+		OnConflictColumns(coreupstream.FieldID).
+		UpdateNewValues().
+		ClearDeletedAt().
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	hostIDs := make([]string, 0, len(u.GetHosts()))
+	for _, h := range u.GetHosts() {
+		err := tx.CoreUpstreamHost.Create().
+			SetID(h.GetId()).
+			SetUpstreamID(u.GetId()).
+			SetAddress(h.GetAddress()).
+			SetPort(int(h.GetPort())).
+			SetWeight(int(h.GetWeight())).
+			SetEnabled(constant.Yes).
+			OnConflictColumns(coreupstreamhost.FieldID).
+			UpdateNewValues().
+			ClearDeletedAt().
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+		hostIDs = append(hostIDs, h.GetId())
+	}
+	return tx.CoreUpstreamHost.Update().
+		Where(coreupstreamhost.UpstreamID(u.GetId()), coreupstreamhost.IDNotIn(hostIDs...), coreupstreamhost.Enabled(constant.Yes)).
+		SetEnabled(constant.No).Exec(ctx)
+}
+
+func restoreRouteTargets(ctx context.Context, tx *ent.Tx, r *v1.HttpRoute) error {
+	targetIDs := make([]string, 0, len(r.GetTargets()))
+	for _, t := range r.GetTargets() {
+		err := tx.CoreGatewayHttpRouteTarget.Create().
+			SetID(t.GetId()).
+			SetRouteID(r.GetId()).
+			SetUpstreamID(t.GetUpstreamId()).
+			SetWeight(int(t.GetWeight())).
+			OnConflictColumns(coregatewayhttproutetarget.FieldID).
+			UpdateNewValues().
+			ClearDeletedAt().
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+		targetIDs = append(targetIDs, t.GetId())
+	}
+	_, err := tx.CoreGatewayHttpRouteTarget.Update().
+		Where(coregatewayhttproutetarget.RouteID(r.GetId()), coregatewayhttproutetarget.IDNotIn(targetIDs...), coregatewayhttproutetarget.DeletedAtIsNil()).
+		SetDeletedAt(time.Now()).
+		Save(ctx)
+	return err
+}
+
+func fromHttpMatchers(ms []*v1.HttpMatcher) []common.HttpMatcher {
+	out := make([]common.HttpMatcher, 0, len(ms))
+	for _, m := range ms {
+		out = append(out, common.HttpMatcher{
+			Name:       m.GetName(),
+			Type:       constant.ProxyHttpMatcherType(m.GetType()),
+			Value:      m.GetValue(),
+			Invert:     m.GetInvert(),
+			IgnoreCase: m.GetIgnoreCase(),
+		})
+	}
+	return out
+}
+
+func fromRetryPolicy(p *v1.RetryPolicy) *common.RetryPolicy {
+	if p == nil {
+		return nil
+	}
+	out := &common.RetryPolicy{
+		NumRetries:      int(p.GetNumRetries()),
+		PerTryTimeoutMs: int(p.GetPerTryTimeoutMs()),
+		BackoffBaseMs:   int(p.GetBackoffBaseMs()),
+		BackoffMaxMs:    int(p.GetBackoffMaxMs()),
+	}
+	for _, on := range p.GetRetryOn() {
+		out.RetryOn = append(out.RetryOn, constant.ProxyRetry(on))
+	}
+	for _, c := range p.GetRetriableStatusCodes() {
+		out.RetriableStatusCodes = append(out.RetriableStatusCodes, int(c))
+	}
+	return out
+}
+
+func fromHeaderMutation(m *v1.HeaderMutation) *common.HeaderMutation {
+	if m == nil {
+		return nil
+	}
+	out := &common.HeaderMutation{
+		RequestHeadersToRemove:  m.GetRequestHeadersToRemove(),
+		ResponseHeadersToRemove: m.GetResponseHeadersToRemove(),
+	}
+	for _, h := range m.GetRequestHeadersToAdd() {
+		out.RequestHeadersToAdd = append(out.RequestHeadersToAdd, common.HeaderValue{Name: h.GetName(), Value: h.GetValue(), Action: constant.ProxyHeaderAction(h.GetAction())})
+	}
+	for _, h := range m.GetResponseHeadersToAdd() {
+		out.ResponseHeadersToAdd = append(out.ResponseHeadersToAdd, common.HeaderValue{Name: h.GetName(), Value: h.GetValue(), Action: constant.ProxyHeaderAction(h.GetAction())})
+	}
+	return out
+}
+
+func fromFaultPolicy(p *v1.FaultPolicy) *common.FaultPolicy {
+	if p == nil {
+		return nil
+	}
+	return &common.FaultPolicy{
+		DelayMs:         int(p.GetDelayMs()),
+		DelayPercent:    p.GetDelayPercent(),
+		AbortHttpStatus: int(p.GetAbortHttpStatus()),
+		AbortGrpcStatus: int(p.GetAbortGrpcStatus()),
+		AbortPercent:    p.GetAbortPercent(),
+		HeaderName:      p.GetHeaderName(),
+		HeaderValue:     p.GetHeaderValue(),
+		ExpiresAt:       p.GetExpiresAt(),
+	}
+}
+
+func fromCorsPolicy(p *v1.CorsPolicy) *common.CorsPolicy {
+	if p == nil {
+		return nil
+	}
+	out := &common.CorsPolicy{
+		AllowMethods:     p.GetAllowMethods(),
+		AllowHeaders:     p.GetAllowHeaders(),
+		ExposeHeaders:    p.GetExposeHeaders(),
+		MaxAgeSeconds:    int(p.GetMaxAgeSeconds()),
+		AllowCredentials: p.GetAllowCredentials(),
+	}
+	for _, o := range p.GetAllowOrigins() {
+		out.AllowOrigins = append(out.AllowOrigins, common.CorsOrigin{Type: constant.ProxyCorsOriginType(o.GetType()), Value: o.GetValue()})
+	}
+	return out
+}
+
+func fromLocalRateLimit(l *v1.LocalRateLimit) *common.LocalRateLimit {
+	if l == nil {
+		return nil
+	}
+	return &common.LocalRateLimit{
+		MaxTokens:      int(l.GetMaxTokens()),
+		TokensPerFill:  int(l.GetTokensPerFill()),
+		FillIntervalMs: int(l.GetFillIntervalMs()),
+		StatusCode:     int(l.GetStatusCode()),
+		HeaderName:     l.GetHeaderName(),
+		HeaderValue:    l.GetHeaderValue(),
+	}
+}
+
+func fromCompression(c *v1.Compression) *common.Compression {
+	if c == nil {
+		return nil
+	}
+	out := &common.Compression{
+		ContentTypes:     c.GetContentTypes(),
+		MinContentLength: int(c.GetMinContentLength()),
+		Level:            int(c.GetLevel()),
+	}
+	for _, a := range c.GetAlgorithms() {
+		out.Algorithms = append(out.Algorithms, constant.ProxyCompressionAlgorithm(a))
+	}
+	return out
+}
+
+func fromRateLimitActions(actions []*v1.RateLimitAction) []common.RateLimitAction {
+	if len(actions) == 0 {
+		return nil
+	}
+	out := make([]common.RateLimitAction, 0, len(actions))
+	for _, a := range actions {
+		out = append(out, common.RateLimitAction{
+			Type:            constant.ProxyRateLimitActionType(a.GetType()),
+			DescriptorKey:   a.GetDescriptorKey(),
+			DescriptorValue: a.GetDescriptorValue(),
+			Name:            a.GetName(),
+		})
+	}
+	return out
+}
+
+func fromHashPolicies(policies []*v1.HashPolicy) []common.HashPolicy {
+	if len(policies) == 0 {
+		return nil
+	}
+	out := make([]common.HashPolicy, 0, len(policies))
+	for _, p := range policies {
+		out = append(out, common.HashPolicy{
+			Type:             constant.ProxyHashPolicyType(p.GetType()),
+			Name:             p.GetName(),
+			CookieTtlSeconds: int(p.GetCookieTtlSeconds()),
+			CookiePath:       p.GetCookiePath(),
+			Terminal:         p.GetTerminal(),
+		})
+	}
+	return out
+}
+
+func fromRateLimitDescriptors(descriptors []*v1.RateLimitDescriptor) []common.RateLimitDescriptor {
+	out := make([]common.RateLimitDescriptor, 0, len(descriptors))
+	for _, d := range descriptors {
+		out = append(out, common.RateLimitDescriptor{Key: d.GetKey(), Value: d.GetValue()})
+	}
+	return out
+}
+
+func fromDirectResponse(d *v1.DirectResponse) *common.DirectResponse {
+	if d == nil {
+		return nil
+	}
+	return &common.DirectResponse{
+		StatusCode:  int(d.GetStatus()),
+		Body:        d.GetBody(),
+		ContentType: d.GetContentType(),
+	}
+}
+
+// fromMaintenance 修订中只保存开启的维护模式
+func fromMaintenance(m *v1.Maintenance) *common.Maintenance {
+	if m == nil {
+		return nil
+	}
+	out := &common.Maintenance{
+		Enabled:    true,
+		AllowPaths: m.GetAllowPaths(),
+		AllowIPs:   m.GetAllowIps(),
+	}
+	if d := fromDirectResponse(m.GetResponse()); d != nil {
+		out.Response = *d
+	}
+	return out
+}
+
+func yesOrNo(b bool) constant.YesOrNo {
+	if b {
+		return constant.Yes
+	}
+	return constant.No
+}
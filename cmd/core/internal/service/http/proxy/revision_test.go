@@ -0,0 +1,41 @@
+package proxy
+
+import (
+	"testing"
+
+	v1 "github.com/lyonmu/quebec/idl/router/v1"
+)
+
+func TestDiffProxyConfig(t *testing.T) {
+	from := &v1.ProxyConfig{
+		Upstreams: []*v1.Upstream{
+			{Id: "u1", Name: "api", LbPolicy: 1, ConnectTimeoutMs: 1000},
+			{Id: "u2", Name: "old"},
+		},
+	}
+	to := &v1.ProxyConfig{
+		Upstreams: []*v1.Upstream{
+			{Id: "u1", Name: "api", LbPolicy: 2, ConnectTimeoutMs: 1000},
+			{Id: "u3", Name: "new"},
+		},
+	}
+
+	diff, err := DiffProxyConfig(from, to)
+	if err != nil {
+		t.Fatalf("diff failed: %v", err)
+	}
+
+	u := diff.Upstreams
+	if len(u.Added) != 1 || u.Added[0].ID != "u3" {
+		t.Fatalf("expected u3 added, got %+v", u.Added)
+	}
+	if len(u.Removed) != 1 || u.Removed[0].ID != "u2" {
+		t.Fatalf("expected u2 removed, got %+v", u.Removed)
+	}
+	if len(u.Modified) != 1 || len(u.Modified[0].Fields) != 1 || u.Modified[0].Fields[0].Field != "lb_policy" {
+		t.Fatalf("expected lb_policy modified on u1, got %+v", u.Modified)
+	}
+	if len(diff.HttpRoutes.Added)+len(diff.HttpRoutes.Removed)+len(diff.HttpRoutes.Modified) != 0 {
+		t.Fatalf("expected no http route changes, got %+v", diff.HttpRoutes)
+	}
+}
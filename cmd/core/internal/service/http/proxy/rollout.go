@@ -0,0 +1,223 @@
+package proxy
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/lyonmu/quebec/cmd/core/internal/dto/request"
+	"github.com/lyonmu/quebec/cmd/core/internal/dto/response"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/coregatewaynode"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/coreproxyrollout"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/coreproxyrolloutpolicy"
+	"github.com/lyonmu/quebec/cmd/core/internal/global"
+	"github.com/lyonmu/quebec/cmd/core/internal/utils"
+	"github.com/lyonmu/quebec/pkg/code"
+	"github.com/lyonmu/quebec/pkg/constant"
+)
+
+func (s *ProxySvc) RolloutPolicyList(ctx context.Context) ([]*response.ProxyRolloutPolicyResp, error) {
+
+	var (
+		resp = make([]*response.ProxyRolloutPolicyResp, 0)
+	)
+
+	rows, err := global.EntClient.CoreProxyRolloutPolicy.Query().
+		Where(coreproxyrolloutpolicy.DeletedAtIsNil()).
+		Order(coreproxyrolloutpolicy.ByClusterID(sql.OrderAsc())).
+		All(ctx)
+	if err != nil {
+		global.Logger.Sugar().Errorf("获取灰度策略列表失败: %v", err)
+		return nil, &code.RolloutPolicyQueryFailed
+	}
+
+	for _, row := range rows {
+		item := response.ProxyRolloutPolicyResp{}
+		item.LoadDb(row)
+		resp = append(resp, &item)
+	}
+
+	return resp, nil
+}
+
+// RolloutPolicySave 按集群创建或更新灰度策略，对之后发布的修订生效
+func (s *ProxySvc) RolloutPolicySave(ctx context.Context, req *request.ProxyRolloutPolicyReq) error {
+
+	nodeIDs := req.NodeIDs
+	if nodeIDs == nil {
+		nodeIDs = []string{}
+	}
+
+	err := global.EntClient.CoreProxyRolloutPolicy.Create().
+		SetClusterID(req.ClusterID).
+		SetPercentage(req.Percentage).
+		SetNodeIds(nodeIDs).
+		SetSoakSeconds(req.SoakSeconds).
+		SetMaxErrorRate(req.MaxErrorRate).
+		SetMinRequests(req.MinRequests).
+		SetStatus(req.Status).
+		OnConflictColumns(coreproxyrolloutpolicy.FieldClusterID).
+		UpdateNewValues().
+		ClearDeletedAt().
+		Exec(ctx)
+	if err != nil {
+		global.Logger.Sugar().Errorf("保存集群 %s 灰度策略失败: %v", req.ClusterID, err)
+		return &code.RolloutPolicySaveFailed
+	}
+
+	return nil
+}
+
+func (s *ProxySvc) RolloutPolicyDelete(ctx context.Context, id string) error {
+
+	err := global.EntClient.CoreProxyRolloutPolicy.UpdateOneID(id).
+		Where(coreproxyrolloutpolicy.DeletedAtIsNil()).
+		SetDeletedAt(time.Now()).
+		Exec(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return &code.RolloutPolicyNotExists
+		}
+		global.Logger.Sugar().Errorf("删除灰度策略 %s 失败: %v", id, err)
+		return &code.RolloutPolicyDelFailed
+	}
+
+	return nil
+}
+
+func (s *ProxySvc) RolloutPage(ctx context.Context, req *request.ProxyRolloutPageReq) (*response.ProxyRolloutListResp, error) {
+	var (
+		items    = make([]*response.ProxyRolloutResp, 0)
+		page     = (req.Page - 1) * req.PageSize
+		pageSize = req.PageSize
+		resp     = &response.ProxyRolloutListResp{}
+		query    = global.EntClient.CoreProxyRollout.Query().Where(coreproxyrollout.DeletedAtIsNil())
+	)
+
+	if len(req.ClusterID) > 0 {
+		query = query.Where(coreproxyrollout.ClusterID(req.ClusterID))
+	}
+
+	if req.Status != 0 {
+		query = query.Where(coreproxyrollout.Status(req.Status))
+	}
+
+	total, err := query.Count(ctx)
+	if err != nil {
+		global.Logger.Sugar().Errorf("select proxy rollout failed: %s", err)
+		return nil, &code.RolloutQueryFailed
+	}
+
+	rows, err := query.Offset(page).Limit(pageSize).
+		Order(coreproxyrollout.ByStartedAt(sql.OrderDesc()), coreproxyrollout.ByClusterID(sql.OrderAsc())).
+		All(ctx)
+	if err != nil {
+		global.Logger.Sugar().Errorf("select proxy rollout failed: %s", err)
+		return nil, &code.RolloutQueryFailed
+	}
+
+	for _, row := range rows {
+		item := response.ProxyRolloutResp{}
+		item.LoadDb(row)
+		items = append(items, &item)
+	}
+
+	resp.Total = total
+	resp.Items = items
+	resp.Page = req.Page
+	resp.PageSize = req.PageSize
+
+	return resp, nil
+}
+
+// RolloutDetail 返回灰度发布及各灰度节点的回执与流量
+func (s *ProxySvc) RolloutDetail(ctx context.Context, id string) (*response.ProxyRolloutDetailResp, error) {
+
+	row, err := global.EntClient.CoreProxyRollout.Query().
+		Where(coreproxyrollout.ID(id), coreproxyrollout.DeletedAtIsNil()).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, &code.RolloutNotExists
+		}
+		global.Logger.Sugar().Errorf("获取灰度发布 %s 失败: %v", id, err)
+		return nil, &code.RolloutQueryFailed
+	}
+
+	nodes, err := global.EntClient.CoreGatewayNode.Query().
+		Where(coregatewaynode.NodeIDIn(row.CanaryNodeIds...)).
+		All(ctx)
+	if err != nil {
+		global.Logger.Sugar().Errorf("获取灰度发布 %s 的节点失败: %v", id, err)
+		return nil, &code.RolloutQueryFailed
+	}
+	found := make(map[string]*ent.CoreGatewayNode, len(nodes))
+	for _, n := range nodes {
+		found[n.NodeID] = n
+	}
+
+	resp := &response.ProxyRolloutDetailResp{Nodes: make([]*response.ProxyRolloutNodeResp, 0, len(row.CanaryNodeIds))}
+	resp.LoadDb(row)
+	for _, nodeID := range row.CanaryNodeIds {
+		item := &response.ProxyRolloutNodeResp{NodeID: nodeID}
+		if n, ok := found[nodeID]; ok {
+			base := row.Baseline[nodeID]
+			item.Online = n.DeletedAt == nil
+			item.AppliedRevision = n.AppliedRevision
+			item.LastError = n.LastError
+			item.Requests = max(n.RequestTotal-base.Requests, 0)
+			item.Errors = max(n.ErrorTotal-base.Errors, 0)
+		}
+		resp.Nodes = append(resp.Nodes, item)
+	}
+
+	return resp, nil
+}
+
+// RolloutPromote 手动全量：灰度中或已中止的发布均可全量到集群所有节点
+func (s *ProxySvc) RolloutPromote(ctx context.Context, id string) error {
+	return finishRollout(ctx, id, constant.RolloutStatusPromoted, "manually promoted",
+		constant.RolloutStatusInProgress, constant.RolloutStatusAborted)
+}
+
+// RolloutAbort 手动中止灰度中的发布，集群所有节点回到稳定修订
+func (s *ProxySvc) RolloutAbort(ctx context.Context, id string) error {
+	return finishRollout(ctx, id, constant.RolloutStatusAborted, "manually aborted",
+		constant.RolloutStatusInProgress)
+}
+
+func finishRollout(ctx context.Context, id string, status constant.ProxyRolloutStatus, reason string, from ...constant.ProxyRolloutStatus) error {
+
+	exists, err := global.EntClient.CoreProxyRollout.Query().
+		Where(coreproxyrollout.ID(id), coreproxyrollout.DeletedAtIsNil()).
+		Exist(ctx)
+	if err != nil {
+		global.Logger.Sugar().Errorf("获取灰度发布 %s 失败: %v", id, err)
+		return &code.RolloutQueryFailed
+	}
+	if !exists {
+		return &code.RolloutNotExists
+	}
+
+	if userID := utils.OperatorFromContext(ctx); userID != "" {
+		reason = fmt.Sprintf("%s by user %s", reason, userID)
+	}
+
+	n, err := global.EntClient.CoreProxyRollout.Update().
+		Where(coreproxyrollout.ID(id), coreproxyrollout.StatusIn(from...)).
+		SetStatus(status).
+		SetReason(reason).
+		SetFinishedAt(time.Now().Unix()).
+		Save(ctx)
+	if err != nil {
+		global.Logger.Sugar().Errorf("更新灰度发布 %s 失败: %v", id, err)
+		return &code.RolloutUpdateFailed
+	}
+	if n == 0 {
+		return &code.RolloutStatusNotAllow
+	}
+
+	return nil
+}
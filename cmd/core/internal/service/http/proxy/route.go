@@ -0,0 +1,729 @@
+package proxy
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"slices"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/lyonmu/quebec/cmd/core/internal/common"
+	"github.com/lyonmu/quebec/cmd/core/internal/dto/request"
+	"github.com/lyonmu/quebec/cmd/core/internal/dto/response"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/coregatewayhttproute"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/coregatewayhttproutetarget"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/coreupstream"
+	"github.com/lyonmu/quebec/cmd/core/internal/global"
+	"github.com/lyonmu/quebec/cmd/core/internal/service/grpc/router"
+	v1 "github.com/lyonmu/quebec/idl/router/v1"
+	"github.com/lyonmu/quebec/pkg/code"
+	"github.com/lyonmu/quebec/pkg/constant"
+	"github.com/lyonmu/quebec/pkg/envoy"
+)
+
+// HttpRouteTargets 获取路由的加权分流目标
+func (s *ProxySvc) HttpRouteTargets(ctx context.Context, id string) ([]*response.ProxyRouteTargetResp, error) {
+
+	exist, err := global.EntClient.CoreGatewayHttpRoute.Query().
+		Where(coregatewayhttproute.ID(id), coregatewayhttproute.DeletedAtIsNil()).
+		Exist(ctx)
+	if err != nil {
+		global.Logger.Sugar().Errorf("获取路由 %s 分流目标失败: %v", id, err)
+		return nil, &code.HttpRouteTargetQueryFailed
+	}
+	if !exist {
+		return nil, &code.HttpRouteNotExists
+	}
+
+	rows, err := global.EntClient.CoreGatewayHttpRouteTarget.Query().
+		Where(coregatewayhttproutetarget.RouteID(id), coregatewayhttproutetarget.DeletedAtIsNil()).
+		WithTargetFromUpstream().
+		Order(coregatewayhttproutetarget.ByCreatedAt(sql.OrderAsc())).
+		All(ctx)
+	if err != nil {
+		global.Logger.Sugar().Errorf("获取路由 %s 分流目标失败: %v", id, err)
+		return nil, &code.HttpRouteTargetQueryFailed
+	}
+
+	resp := make([]*response.ProxyRouteTargetResp, 0, len(rows))
+	for _, row := range rows {
+		item := &response.ProxyRouteTargetResp{
+			ID:         row.ID,
+			UpstreamID: row.UpstreamID,
+			Weight:     row.Weight,
+		}
+		if u := row.Edges.TargetFromUpstream; u != nil {
+			item.UpstreamName = u.Name
+		}
+		resp = append(resp, item)
+	}
+
+	return resp, nil
+}
+
+// HttpRouteSetTargets 覆盖路由的加权分流目标，路由的上游服务同步为权重最大的目标
+func (s *ProxySvc) HttpRouteSetTargets(ctx context.Context, id string, req *request.ProxyRouteTargetsReq) error {
+
+	// 1. 校验权重之和与上游服务
+	if len(req.Targets) > 0 {
+		var (
+			total int
+			ids   = make([]string, 0, len(req.Targets))
+			seen  = make(map[string]struct{}, len(req.Targets))
+		)
+		for _, t := range req.Targets {
+			if _, ok := seen[t.UpstreamID]; ok {
+				return &code.HttpRouteTargetInvalid
+			}
+			seen[t.UpstreamID] = struct{}{}
+			ids = append(ids, t.UpstreamID)
+			total += t.Weight
+		}
+		if total != 100 {
+			return &code.HttpRouteTargetInvalid
+		}
+
+		count, err := global.EntClient.CoreUpstream.Query().
+			Where(coreupstream.IDIn(ids...), coreupstream.DeletedAtIsNil(), coreupstream.Status(constant.Yes)).
+			Count(ctx)
+		if err != nil {
+			global.Logger.Sugar().Errorf("保存路由 %s 分流目标失败: %v", id, err)
+			return &code.HttpRouteTargetSaveFailed
+		}
+		if count != len(ids) {
+			return &code.HttpRouteTargetUpstream
+		}
+	}
+
+	exist, err := global.EntClient.CoreGatewayHttpRoute.Query().
+		Where(coregatewayhttproute.ID(id), coregatewayhttproute.DeletedAtIsNil()).
+		Exist(ctx)
+	if err != nil {
+		global.Logger.Sugar().Errorf("保存路由 %s 分流目标失败: %v", id, err)
+		return &code.HttpRouteTargetSaveFailed
+	}
+	if !exist {
+		return &code.HttpRouteNotExists
+	}
+
+	// 2. 在同一事务中替换分流目标，提交后只发布一次修订
+	tx, err := global.EntClient.Tx(ctx)
+	if err != nil {
+		global.Logger.Sugar().Errorf("保存路由 %s 分流目标失败: %v", id, err)
+		return &code.HttpRouteTargetSaveFailed
+	}
+	ctx = ent.NewTxContext(ctx, tx)
+
+	if err := replaceRouteTargets(ctx, tx, id, req.Targets); err != nil {
+		_ = tx.Rollback()
+		global.Logger.Sugar().Errorf("保存路由 %s 分流目标失败: %v", id, err)
+		return &code.HttpRouteTargetSaveFailed
+	}
+
+	if err := tx.Commit(); err != nil {
+		global.Logger.Sugar().Errorf("保存路由 %s 分流目标失败: %v", id, err)
+		return &code.HttpRouteTargetSaveFailed
+	}
+
+	return nil
+}
+
+func replaceRouteTargets(ctx context.Context, tx *ent.Tx, id string, targets []request.ProxyRouteTargetReq) error {
+
+	if _, err := tx.CoreGatewayHttpRouteTarget.Update().
+		Where(coregatewayhttproutetarget.RouteID(id), coregatewayhttproutetarget.DeletedAtIsNil()).
+		SetDeletedAt(time.Now()).
+		Save(ctx); err != nil {
+		return err
+	}
+	if len(targets) == 0 {
+		return nil
+	}
+
+	var (
+		builders = make([]*ent.CoreGatewayHttpRouteTargetCreate, 0, len(targets))
+		primary  = targets[0]
+	)
+	for _, t := range targets {
+		builders = append(builders, tx.CoreGatewayHttpRouteTarget.Create().
+			SetRouteID(id).
+			SetUpstreamID(t.UpstreamID).
+			SetWeight(t.Weight))
+		if t.Weight > primary.Weight {
+			primary = t
+		}
+	}
+	if err := tx.CoreGatewayHttpRouteTarget.CreateBulk(builders...).Exec(ctx); err != nil {
+		return err
+	}
+
+	// 路由的上游服务保持为主目标，取消分流后流量全部回到该上游服务
+	return tx.CoreGatewayHttpRoute.UpdateOneID(id).SetUpstreamID(primary.UpstreamID).Exec(ctx)
+}
+
+// HttpRouteMatch 获取路由的匹配条件
+func (s *ProxySvc) HttpRouteMatch(ctx context.Context, id string) (*response.ProxyRouteMatchResp, error) {
+
+	row, err := global.EntClient.CoreGatewayHttpRoute.Query().
+		Where(coregatewayhttproute.ID(id), coregatewayhttproute.DeletedAtIsNil()).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, &code.HttpRouteNotExists
+		}
+		global.Logger.Sugar().Errorf("获取路由 %s 匹配条件失败: %v", id, err)
+		return nil, &code.HttpRouteMatchQueryFailed
+	}
+
+	resp := &response.ProxyRouteMatchResp{
+		MatchType:       row.MatchType,
+		MatchPattern:    row.MatchPattern,
+		IgnoreCase:      row.IgnoreCase == constant.Yes,
+		Methods:         row.Methods,
+		Headers:         row.HeaderMatchers,
+		QueryParameters: row.QueryMatchers,
+	}
+	if resp.Methods == nil {
+		resp.Methods = []string{}
+	}
+	if resp.Headers == nil {
+		resp.Headers = []common.HttpMatcher{}
+	}
+	if resp.QueryParameters == nil {
+		resp.QueryParameters = []common.HttpMatcher{}
+	}
+
+	return resp, nil
+}
+
+// HttpRouteSetMatch 覆盖路由的 HTTP 方法、请求头与查询参数匹配条件，与路径匹配同时生效
+func (s *ProxySvc) HttpRouteSetMatch(ctx context.Context, id string, req *request.ProxyRouteMatchReq) error {
+
+	var (
+		methods = make([]string, 0, len(req.Methods))
+		headers = fromMatcherReqs(req.Headers)
+		queries = fromMatcherReqs(req.QueryParameters)
+		seen    = make(map[string]struct{}, len(req.Methods))
+	)
+	for _, m := range req.Methods {
+		if _, ok := seen[m]; ok {
+			continue
+		}
+		seen[m] = struct{}{}
+		methods = append(methods, m)
+	}
+
+	// 与 Gateway 下发前使用同一校验，避免保存后整条路由被跳过
+	route := &v1.HttpRoute{
+		Methods:         methods,
+		Headers:         router.ToHttpMatchers(headers),
+		QueryParameters: router.ToHttpMatchers(queries),
+	}
+	if err := envoy.ValidateRouteMatch(route); err != nil {
+		global.Logger.Sugar().Warnf("路由 %s 匹配条件不合法: %v", id, err)
+		return &code.HttpRouteMatchInvalid
+	}
+	if err := checkRouteConflicts(ctx, id, func(r *v1.HttpRoute) {
+		r.IgnoreCase = req.IgnoreCase
+		r.Methods, r.Headers, r.QueryParameters = route.Methods, route.Headers, route.QueryParameters
+	}); err != nil {
+		return err
+	}
+
+	err := global.EntClient.CoreGatewayHttpRoute.UpdateOneID(id).
+		Where(coregatewayhttproute.DeletedAtIsNil()).
+		SetIgnoreCase(yesOrNo(req.IgnoreCase)).
+		SetMethods(methods).
+		SetHeaderMatchers(headers).
+		SetQueryMatchers(queries).
+		Exec(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return &code.HttpRouteNotExists
+		}
+		global.Logger.Sugar().Errorf("保存路由 %s 匹配条件失败: %v", id, err)
+		return &code.HttpRouteMatchSaveFailed
+	}
+
+	return nil
+}
+
+func fromMatcherReqs(reqs []request.ProxyHttpMatcherReq) []common.HttpMatcher {
+	out := make([]common.HttpMatcher, 0, len(reqs))
+	for _, r := range reqs {
+		out = append(out, common.HttpMatcher{
+			Name:       r.Name,
+			Type:       r.Type,
+			Value:      r.Value,
+			Invert:     r.Invert,
+			IgnoreCase: r.IgnoreCase,
+		})
+	}
+	return out
+}
+
+// HttpRoutePath 获取路由的路径匹配、路径重写与重定向设置
+func (s *ProxySvc) HttpRoutePath(ctx context.Context, id string) (*response.ProxyRoutePathResp, error) {
+
+	row, err := global.EntClient.CoreGatewayHttpRoute.Query().
+		Where(coregatewayhttproute.ID(id), coregatewayhttproute.DeletedAtIsNil()).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, &code.HttpRouteNotExists
+		}
+		global.Logger.Sugar().Errorf("获取路由 %s 路径设置失败: %v", id, err)
+		return nil, &code.HttpRoutePathQueryFailed
+	}
+
+	return &response.ProxyRoutePathResp{
+		MatchType:         row.MatchType,
+		MatchPattern:      row.MatchPattern,
+		EnablePathRewrite: row.EnablePathRewrite,
+		PathRewrite:       row.PathRewrite,
+		EnableRedirect:    row.EnableRedirect,
+		RedirectURL:       row.RedirectURL,
+		RedirectCode:      row.RedirectCode,
+	}, nil
+}
+
+// HttpRouteSetPath 保存路由的路径匹配、路径重写与重定向设置，正则按 RE2 语法校验
+func (s *ProxySvc) HttpRouteSetPath(ctx context.Context, id string, req *request.ProxyRoutePathReq) error {
+
+	if req.RedirectCode == 0 {
+		req.RedirectCode = http.StatusMovedPermanently
+	}
+
+	// 与 Gateway 下发前使用同一校验，避免保存后整条路由被跳过
+	route := &v1.HttpRoute{
+		MatchType:         int32(req.MatchType),
+		MatchPattern:      req.MatchPattern,
+		EnablePathRewrite: req.EnablePathRewrite == constant.Yes,
+		PathRewrite:       req.PathRewrite,
+		EnableRedirect:    req.EnableRedirect == constant.Yes,
+		RedirectUrl:       req.RedirectURL,
+		RedirectCode:      int32(req.RedirectCode),
+	}
+	if err := envoy.ValidateRoutePath(route); err != nil {
+		global.Logger.Sugar().Warnf("路由 %s 路径设置不合法: %v", id, err)
+		return &code.HttpRoutePathInvalid
+	}
+	if err := checkRouteConflicts(ctx, id, func(r *v1.HttpRoute) {
+		r.MatchType, r.MatchPattern = route.MatchType, route.MatchPattern
+	}); err != nil {
+		return err
+	}
+	if route.EnableRedirect {
+		row, err := global.EntClient.CoreGatewayHttpRoute.Query().
+			Where(coregatewayhttproute.ID(id), coregatewayhttproute.DeletedAtIsNil()).
+			Select(coregatewayhttproute.FieldDirectResponse).
+			Only(ctx)
+		if err != nil {
+			global.Logger.Sugar().Errorf("获取路由 %s 直接响应失败: %v", id, err)
+			return &code.HttpRoutePathSaveFailed
+		}
+		if router.ToDirectResponse(row.DirectResponse) != nil {
+			return &code.DirectResponseRedirect
+		}
+	}
+
+	err := global.EntClient.CoreGatewayHttpRoute.UpdateOneID(id).
+		Where(coregatewayhttproute.DeletedAtIsNil()).
+		SetMatchType(req.MatchType).
+		SetMatchPattern(req.MatchPattern).
+		SetEnablePathRewrite(req.EnablePathRewrite).
+		SetPathRewrite(req.PathRewrite).
+		SetEnableRedirect(req.EnableRedirect).
+		SetRedirectURL(req.RedirectURL).
+		SetRedirectCode(req.RedirectCode).
+		Exec(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return &code.HttpRouteNotExists
+		}
+		global.Logger.Sugar().Errorf("保存路由 %s 路径设置失败: %v", id, err)
+		return &code.HttpRoutePathSaveFailed
+	}
+
+	return nil
+}
+
+// HttpRouteRetry 获取路由的重试策略，未配置时返回默认值
+func (s *ProxySvc) HttpRouteRetry(ctx context.Context, id string) (*response.ProxyRouteRetryResp, error) {
+
+	row, err := global.EntClient.CoreGatewayHttpRoute.Query().
+		Where(coregatewayhttproute.ID(id), coregatewayhttproute.DeletedAtIsNil()).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, &code.HttpRouteNotExists
+		}
+		global.Logger.Sugar().Errorf("获取路由 %s 重试策略失败: %v", id, err)
+		return nil, &code.HttpRouteRetryQueryFailed
+	}
+
+	if row.RetryPolicy == nil || len(row.RetryPolicy.RetryOn) == 0 {
+		return &response.ProxyRouteRetryResp{
+			RetryPolicy: common.RetryPolicy{
+				RetryOn:         []constant.ProxyRetry{},
+				NumRetries:      constant.DefaultNumRetries,
+				PerTryTimeoutMs: constant.DefaultPerTryTimeoutMs,
+			},
+		}, nil
+	}
+	return &response.ProxyRouteRetryResp{Enabled: true, RetryPolicy: *row.RetryPolicy}, nil
+}
+
+// HttpRouteSetRetry 保存路由的重试策略，重试条件为空时关闭重试
+func (s *ProxySvc) HttpRouteSetRetry(ctx context.Context, id string, req *request.ProxyRouteRetryReq) error {
+
+	update := global.EntClient.CoreGatewayHttpRoute.UpdateOneID(id).
+		Where(coregatewayhttproute.DeletedAtIsNil())
+
+	if len(req.RetryOn) == 0 {
+		update.ClearRetryPolicy()
+	} else {
+		policy := &common.RetryPolicy{
+			RetryOn:              slices.Compact(slices.Sorted(slices.Values(req.RetryOn))),
+			NumRetries:           req.NumRetries,
+			PerTryTimeoutMs:      req.PerTryTimeoutMs,
+			RetriableStatusCodes: req.RetriableStatusCodes,
+			BackoffBaseMs:        req.BackoffBaseMs,
+			BackoffMaxMs:         req.BackoffMaxMs,
+		}
+		if policy.NumRetries == 0 {
+			policy.NumRetries = constant.DefaultNumRetries
+		}
+
+		// 与 Gateway 下发前使用同一校验，避免保存后整条路由被跳过
+		if err := envoy.ValidateRetryPolicy(router.ToRetryPolicy(policy)); err != nil {
+			global.Logger.Sugar().Warnf("路由 %s 重试策略不合法: %v", id, err)
+			return &code.HttpRouteRetryInvalid
+		}
+		update.SetRetryPolicy(policy)
+	}
+
+	if err := update.Exec(ctx); err != nil {
+		if ent.IsNotFound(err) {
+			return &code.HttpRouteNotExists
+		}
+		global.Logger.Sugar().Errorf("保存路由 %s 重试策略失败: %v", id, err)
+		return &code.HttpRouteRetrySaveFailed
+	}
+
+	return nil
+}
+
+// HttpRouteHeaders 获取路由的请求头与响应头改写规则
+func (s *ProxySvc) HttpRouteHeaders(ctx context.Context, id string) (*response.ProxyHeaderMutationResp, error) {
+
+	row, err := global.EntClient.CoreGatewayHttpRoute.Query().
+		Where(coregatewayhttproute.ID(id), coregatewayhttproute.DeletedAtIsNil()).
+		Select(coregatewayhttproute.FieldHeaderMutation).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, &code.HttpRouteNotExists
+		}
+		global.Logger.Sugar().Errorf("获取路由 %s 头改写规则失败: %v", id, err)
+		return nil, &code.HttpRouteHeaderQueryFailed
+	}
+
+	resp := &response.ProxyHeaderMutationResp{}
+	if row.HeaderMutation != nil {
+		resp.HeaderMutation = *row.HeaderMutation
+	}
+	return resp, nil
+}
+
+// HttpRouteSetHeaders 保存路由的请求头与响应头改写规则，规则全部为空时清除
+func (s *ProxySvc) HttpRouteSetHeaders(ctx context.Context, id string, req *request.ProxyHeaderMutationReq) error {
+
+	mutation := fromHeaderMutationReq(req)
+	if err := envoy.ValidateHeaderMutation(router.ToHeaderMutation(mutation)); err != nil {
+		global.Logger.Sugar().Warnf("路由 %s 头改写规则不合法: %v", id, err)
+		return &code.HeaderMutationInvalid
+	}
+
+	update := global.EntClient.CoreGatewayHttpRoute.UpdateOneID(id).
+		Where(coregatewayhttproute.DeletedAtIsNil())
+	if mutation == nil {
+		update.ClearHeaderMutation()
+	} else {
+		update.SetHeaderMutation(mutation)
+	}
+
+	if err := update.Exec(ctx); err != nil {
+		if ent.IsNotFound(err) {
+			return &code.HttpRouteNotExists
+		}
+		global.Logger.Sugar().Errorf("保存路由 %s 头改写规则失败: %v", id, err)
+		return &code.HttpRouteHeaderSaveFailed
+	}
+
+	return nil
+}
+
+// fromHeaderMutationReq 转换头改写请求，规则全部为空时返回 nil
+func fromHeaderMutationReq(req *request.ProxyHeaderMutationReq) *common.HeaderMutation {
+	if len(req.RequestHeadersToAdd)+len(req.RequestHeadersToRemove)+len(req.ResponseHeadersToAdd)+len(req.ResponseHeadersToRemove) == 0 {
+		return nil
+	}
+	out := &common.HeaderMutation{
+		RequestHeadersToRemove:  req.RequestHeadersToRemove,
+		ResponseHeadersToRemove: req.ResponseHeadersToRemove,
+	}
+	for _, h := range req.RequestHeadersToAdd {
+		out.RequestHeadersToAdd = append(out.RequestHeadersToAdd, common.HeaderValue{Name: h.Name, Value: h.Value, Action: h.Action})
+	}
+	for _, h := range req.ResponseHeadersToAdd {
+		out.ResponseHeadersToAdd = append(out.ResponseHeadersToAdd, common.HeaderValue{Name: h.Name, Value: h.Value, Action: h.Action})
+	}
+	return out
+}
+
+// HttpRouteMirror 获取路由的流量镜像设置
+func (s *ProxySvc) HttpRouteMirror(ctx context.Context, id string) (*response.ProxyRouteMirrorResp, error) {
+
+	row, err := global.EntClient.CoreGatewayHttpRoute.Query().
+		Where(coregatewayhttproute.ID(id), coregatewayhttproute.DeletedAtIsNil()).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, &code.HttpRouteNotExists
+		}
+		global.Logger.Sugar().Errorf("获取路由 %s 流量镜像失败: %v", id, err)
+		return nil, &code.HttpRouteMirrorQueryFailed
+	}
+
+	resp := &response.ProxyRouteMirrorResp{RuntimeKey: envoy.MirrorRuntimeKey(id)}
+	if row.MirrorUpstreamID == "" {
+		return resp, nil
+	}
+	resp.UpstreamID, resp.Percent = row.MirrorUpstreamID, row.MirrorPercent
+
+	upstream, err := global.EntClient.CoreUpstream.Query().
+		Where(coreupstream.ID(row.MirrorUpstreamID), coreupstream.DeletedAtIsNil()).
+		Only(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		global.Logger.Sugar().Errorf("获取路由 %s 流量镜像失败: %v", id, err)
+		return nil, &code.HttpRouteMirrorQueryFailed
+	}
+	if upstream != nil {
+		resp.UpstreamName = upstream.Name
+	}
+	return resp, nil
+}
+
+// HttpRouteSetMirror 保存路由的流量镜像，按比例将请求复制到影子上游并丢弃其响应，上游为空时关闭镜像
+func (s *ProxySvc) HttpRouteSetMirror(ctx context.Context, id string, req *request.ProxyRouteMirrorReq) error {
+
+	row, err := global.EntClient.CoreGatewayHttpRoute.Query().
+		Where(coregatewayhttproute.ID(id), coregatewayhttproute.DeletedAtIsNil()).
+		WithRouteToTarget(func(q *ent.CoreGatewayHttpRouteTargetQuery) {
+			q.Where(coregatewayhttproutetarget.DeletedAtIsNil())
+		}).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return &code.HttpRouteNotExists
+		}
+		global.Logger.Sugar().Errorf("保存路由 %s 流量镜像失败: %v", id, err)
+		return &code.HttpRouteMirrorSaveFailed
+	}
+
+	update := row.Update()
+	if req.UpstreamID == "" {
+		update.ClearMirrorUpstreamID().ClearMirrorPercent()
+	} else {
+		percent := math.Round(req.Percent*100) / 100
+		if percent == 0 {
+			percent = 100
+		}
+
+		exist, err := global.EntClient.CoreUpstream.Query().
+			Where(coreupstream.ID(req.UpstreamID), coreupstream.DeletedAtIsNil(), coreupstream.Status(constant.Yes)).
+			Exist(ctx)
+		if err != nil {
+			global.Logger.Sugar().Errorf("保存路由 %s 流量镜像失败: %v", id, err)
+			return &code.HttpRouteMirrorSaveFailed
+		}
+		if !exist {
+			return &code.HttpRouteMirrorUpstream
+		}
+
+		// 与 Gateway 下发前使用同一校验，影子上游不能是路由自身的转发目标
+		route := &v1.HttpRoute{
+			Id:               id,
+			UpstreamId:       row.UpstreamID,
+			EnableRedirect:   row.EnableRedirect == constant.Yes,
+			MirrorUpstreamId: req.UpstreamID,
+			MirrorPercent:    percent,
+		}
+		for _, t := range row.Edges.RouteToTarget {
+			route.Targets = append(route.Targets, &v1.RouteTarget{UpstreamId: t.UpstreamID, Weight: uint32(t.Weight)})
+		}
+		if err := envoy.ValidateRouteMirror(route); err != nil {
+			global.Logger.Sugar().Warnf("路由 %s 流量镜像不合法: %v", id, err)
+			return &code.HttpRouteMirrorInvalid
+		}
+		update.SetMirrorUpstreamID(req.UpstreamID).SetMirrorPercent(percent)
+	}
+
+	if err := update.Exec(ctx); err != nil {
+		global.Logger.Sugar().Errorf("保存路由 %s 流量镜像失败: %v", id, err)
+		return &code.HttpRouteMirrorSaveFailed
+	}
+
+	return nil
+}
+
+// HttpRouteFault 获取路由的故障注入，已到期的故障返回未生效
+func (s *ProxySvc) HttpRouteFault(ctx context.Context, id string) (*response.ProxyRouteFaultResp, error) {
+
+	row, err := global.EntClient.CoreGatewayHttpRoute.Query().
+		Where(coregatewayhttproute.ID(id), coregatewayhttproute.DeletedAtIsNil()).
+		Select(coregatewayhttproute.FieldFaultPolicy).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, &code.HttpRouteNotExists
+		}
+		global.Logger.Sugar().Errorf("获取路由 %s 故障注入失败: %v", id, err)
+		return nil, &code.HttpRouteFaultQueryFailed
+	}
+
+	resp := &response.ProxyRouteFaultResp{}
+	if row.FaultPolicy != nil {
+		resp.FaultPolicy = *row.FaultPolicy
+		resp.Enabled = row.FaultPolicy.ExpiresAt > time.Now().Unix()
+	}
+	return resp, nil
+}
+
+// HttpRouteSetFault 保存路由的故障注入，到期后自动从下发的配置中移除
+func (s *ProxySvc) HttpRouteSetFault(ctx context.Context, id string, req *request.ProxyRouteFaultReq) error {
+
+	policy := &common.FaultPolicy{
+		DelayMs:         req.DelayMs,
+		DelayPercent:    math.Round(req.DelayPercent*100) / 100,
+		AbortHttpStatus: req.AbortHttpStatus,
+		AbortGrpcStatus: req.AbortGrpcStatus,
+		AbortPercent:    math.Round(req.AbortPercent*100) / 100,
+		HeaderName:      req.HeaderName,
+		HeaderValue:     req.HeaderValue,
+		ExpiresAt:       time.Now().Add(time.Duration(req.DurationMinutes) * time.Minute).Unix(),
+	}
+
+	// 与 Gateway 下发前使用同一校验，避免保存后整条路由被跳过
+	if err := envoy.ValidateFaultPolicy(router.ToFaultPolicy(policy)); err != nil {
+		global.Logger.Sugar().Warnf("路由 %s 故障注入不合法: %v", id, err)
+		return &code.HttpRouteFaultInvalid
+	}
+
+	err := global.EntClient.CoreGatewayHttpRoute.UpdateOneID(id).
+		Where(coregatewayhttproute.DeletedAtIsNil()).
+		SetFaultPolicy(policy).
+		Exec(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return &code.HttpRouteNotExists
+		}
+		global.Logger.Sugar().Errorf("保存路由 %s 故障注入失败: %v", id, err)
+		return &code.HttpRouteFaultSaveFailed
+	}
+
+	return nil
+}
+
+// HttpRouteClearFault 立即清除路由的故障注入
+func (s *ProxySvc) HttpRouteClearFault(ctx context.Context, id string) error {
+
+	err := global.EntClient.CoreGatewayHttpRoute.UpdateOneID(id).
+		Where(coregatewayhttproute.DeletedAtIsNil()).
+		ClearFaultPolicy().
+		Exec(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return &code.HttpRouteNotExists
+		}
+		global.Logger.Sugar().Errorf("清除路由 %s 故障注入失败: %v", id, err)
+		return &code.HttpRouteFaultSaveFailed
+	}
+
+	return nil
+}
+
+// HttpRouteCors 获取路由的跨域策略
+func (s *ProxySvc) HttpRouteCors(ctx context.Context, id string) (*response.ProxyCorsPolicyResp, error) {
+
+	row, err := global.EntClient.CoreGatewayHttpRoute.Query().
+		Where(coregatewayhttproute.ID(id), coregatewayhttproute.DeletedAtIsNil()).
+		Select(coregatewayhttproute.FieldCorsPolicy).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, &code.HttpRouteNotExists
+		}
+		global.Logger.Sugar().Errorf("获取路由 %s 跨域策略失败: %v", id, err)
+		return nil, &code.HttpRouteCorsQueryFailed
+	}
+
+	return toCorsPolicyResp(row.CorsPolicy), nil
+}
+
+// HttpRouteSetCors 保存路由的跨域策略，允许来源为空时清除并沿用虚拟主机的跨域策略
+func (s *ProxySvc) HttpRouteSetCors(ctx context.Context, id string, req *request.ProxyCorsPolicyReq) error {
+
+	policy := fromCorsPolicyReq(req)
+	if err := envoy.ValidateCorsPolicy(router.ToCorsPolicy(policy)); err != nil {
+		global.Logger.Sugar().Warnf("路由 %s 跨域策略不合法: %v", id, err)
+		return &code.CorsPolicyInvalid
+	}
+
+	update := global.EntClient.CoreGatewayHttpRoute.UpdateOneID(id).
+		Where(coregatewayhttproute.DeletedAtIsNil())
+	if policy == nil {
+		update.ClearCorsPolicy()
+	} else {
+		update.SetCorsPolicy(policy)
+	}
+
+	if err := update.Exec(ctx); err != nil {
+		if ent.IsNotFound(err) {
+			return &code.HttpRouteNotExists
+		}
+		global.Logger.Sugar().Errorf("保存路由 %s 跨域策略失败: %v", id, err)
+		return &code.HttpRouteCorsSaveFailed
+	}
+
+	return nil
+}
+
+// fromCorsPolicyReq 转换跨域策略请求，允许来源为空时返回 nil
+func fromCorsPolicyReq(req *request.ProxyCorsPolicyReq) *common.CorsPolicy {
+	if len(req.AllowOrigins) == 0 {
+		return nil
+	}
+	out := &common.CorsPolicy{
+		AllowMethods:     req.AllowMethods,
+		AllowHeaders:     req.AllowHeaders,
+		ExposeHeaders:    req.ExposeHeaders,
+		MaxAgeSeconds:    req.MaxAgeSeconds,
+		AllowCredentials: req.AllowCredentials,
+	}
+	for _, o := range req.AllowOrigins {
+		out.AllowOrigins = append(out.AllowOrigins, common.CorsOrigin{Type: o.Type, Value: o.Value})
+	}
+	return out
+}
+
+func toCorsPolicyResp(p *common.CorsPolicy) *response.ProxyCorsPolicyResp {
+	resp := &response.ProxyCorsPolicyResp{}
+	if p != nil && len(p.AllowOrigins) > 0 {
+		resp.Enabled, resp.CorsPolicy = true, *p
+	}
+	return resp
+}
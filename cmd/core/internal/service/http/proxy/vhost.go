@@ -0,0 +1,338 @@
+package proxy
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/lyonmu/quebec/cmd/core/internal/dto/request"
+	"github.com/lyonmu/quebec/cmd/core/internal/dto/response"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/coregatewayhttproute"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/coregatewayl7listener"
+	"github.com/lyonmu/quebec/cmd/core/internal/ent/coregatewayvirtualhost"
+	"github.com/lyonmu/quebec/cmd/core/internal/global"
+	"github.com/lyonmu/quebec/cmd/core/internal/service/grpc/router"
+	v1 "github.com/lyonmu/quebec/idl/router/v1"
+	"github.com/lyonmu/quebec/pkg/code"
+	"github.com/lyonmu/quebec/pkg/constant"
+	"github.com/lyonmu/quebec/pkg/envoy"
+)
+
+// ListVirtualHost 获取虚拟主机列表，可按监听器过滤
+func (s *ProxySvc) ListVirtualHost(ctx context.Context, req *request.ProxyVirtualHostListReq) ([]*response.ProxyVirtualHostResp, error) {
+
+	query := global.EntClient.CoreGatewayVirtualHost.Query().
+		Where(coregatewayvirtualhost.DeletedAtIsNil())
+	if req.ListenerID != "" {
+		query.Where(coregatewayvirtualhost.ListenerID(req.ListenerID))
+	}
+
+	rows, err := query.
+		WithVhostFromListener().
+		WithVhostToRoute(func(q *ent.CoreGatewayHttpRouteQuery) {
+			q.Where(coregatewayhttproute.DeletedAtIsNil())
+		}).
+		Order(ent.Asc(coregatewayvirtualhost.FieldListenerID), ent.Asc(coregatewayvirtualhost.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		global.Logger.Sugar().Errorf("获取虚拟主机列表失败: %v", err)
+		return nil, &code.VirtualHostQueryFailed
+	}
+
+	resp := make([]*response.ProxyVirtualHostResp, 0, len(rows))
+	for _, row := range rows {
+		item := &response.ProxyVirtualHostResp{RouteCount: len(row.Edges.VhostToRoute)}
+		item.LoadDb(row)
+		resp = append(resp, item)
+	}
+
+	return resp, nil
+}
+
+// VirtualHostAdd 创建虚拟主机，域名不能与同一监听器下其他启用的虚拟主机重复
+func (s *ProxySvc) VirtualHostAdd(ctx context.Context, req *request.ProxyVirtualHostAddReq) error {
+
+	if req.Status == 0 {
+		req.Status = constant.Yes
+	}
+
+	exist, err := global.EntClient.CoreGatewayL7Listener.Query().
+		Where(coregatewayl7listener.ID(req.ListenerID), coregatewayl7listener.DeletedAtIsNil()).
+		Exist(ctx)
+	if err != nil {
+		global.Logger.Sugar().Errorf("查询监听器 %s 失败: %v", req.ListenerID, err)
+		return &code.VirtualHostQueryFailed
+	}
+	if !exist {
+		return &code.ListenerNotExists
+	}
+
+	if err := checkVirtualHostDomains(ctx, "", req.ListenerID, req.Domains, req.Status); err != nil {
+		return err
+	}
+
+	err = global.EntClient.CoreGatewayVirtualHost.Create().
+		SetName(req.Name).
+		SetDescription(req.Description).
+		SetListenerID(req.ListenerID).
+		SetDomains(req.Domains).
+		SetStatus(req.Status).
+		Exec(ctx)
+	if err != nil {
+		global.Logger.Sugar().Errorf("创建虚拟主机 %s 失败: %v", req.Name, err)
+		return &code.VirtualHostSaveFailed
+	}
+
+	return nil
+}
+
+// VirtualHostUpdate 更新虚拟主机，修改域名或重新启用时重新检查域名冲突
+func (s *ProxySvc) VirtualHostUpdate(ctx context.Context, id string, req *request.ProxyVirtualHostUpdateReq) error {
+
+	vh, err := global.EntClient.CoreGatewayVirtualHost.Query().
+		Where(coregatewayvirtualhost.ID(id), coregatewayvirtualhost.DeletedAtIsNil()).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return &code.VirtualHostNotExists
+		}
+		global.Logger.Sugar().Errorf("获取虚拟主机 %s 失败: %v", id, err)
+		return &code.VirtualHostQueryFailed
+	}
+
+	domains, status := vh.Domains, vh.Status
+	if req.Domains != nil {
+		domains = req.Domains
+	}
+	if req.Status != 0 {
+		status = req.Status
+	}
+	if err := checkVirtualHostDomains(ctx, id, vh.ListenerID, domains, status); err != nil {
+		return err
+	}
+
+	update := global.EntClient.CoreGatewayVirtualHost.UpdateOneID(id).
+		SetDomains(domains).
+		SetStatus(status)
+	if req.Name != nil {
+		update.SetName(*req.Name)
+	}
+	if req.Description != nil {
+		update.SetDescription(*req.Description)
+	}
+
+	if err := update.Exec(ctx); err != nil {
+		global.Logger.Sugar().Errorf("更新虚拟主机 %s 失败: %v", id, err)
+		return &code.VirtualHostSaveFailed
+	}
+
+	return nil
+}
+
+// VirtualHostDelete 删除虚拟主机，仍有路由绑定时不允许删除
+func (s *ProxySvc) VirtualHostDelete(ctx context.Context, id string) error {
+
+	inUse, err := global.EntClient.CoreGatewayHttpRoute.Query().
+		Where(coregatewayhttproute.VirtualHostID(id), coregatewayhttproute.DeletedAtIsNil()).
+		Exist(ctx)
+	if err != nil {
+		global.Logger.Sugar().Errorf("查询虚拟主机 %s 的路由失败: %v", id, err)
+		return &code.VirtualHostDelFailed
+	}
+	if inUse {
+		return &code.VirtualHostInUse
+	}
+
+	err = global.EntClient.CoreGatewayVirtualHost.UpdateOneID(id).
+		Where(coregatewayvirtualhost.DeletedAtIsNil()).
+		SetDeletedAt(time.Now()).
+		Exec(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return &code.VirtualHostNotExists
+		}
+		global.Logger.Sugar().Errorf("删除虚拟主机 %s 失败: %v", id, err)
+		return &code.VirtualHostDelFailed
+	}
+
+	return nil
+}
+
+// HttpRouteBindVirtualHost 将路由绑定到指定虚拟主机，虚拟主机ID为空时挂到所有监听器的默认虚拟主机(*)
+func (s *ProxySvc) HttpRouteBindVirtualHost(ctx context.Context, id string, req *request.ProxyRouteBindVhostReq) error {
+
+	if req.VirtualHostID != "" {
+		exist, err := global.EntClient.CoreGatewayVirtualHost.Query().
+			Where(coregatewayvirtualhost.ID(req.VirtualHostID), coregatewayvirtualhost.DeletedAtIsNil()).
+			Exist(ctx)
+		if err != nil {
+			global.Logger.Sugar().Errorf("查询虚拟主机 %s 失败: %v", req.VirtualHostID, err)
+			return &code.VirtualHostQueryFailed
+		}
+		if !exist {
+			return &code.VirtualHostNotExists
+		}
+	}
+
+	if err := checkRouteConflicts(ctx, id, func(r *v1.HttpRoute) {
+		r.VirtualHostId = req.VirtualHostID
+	}); err != nil {
+		return err
+	}
+
+	update := global.EntClient.CoreGatewayHttpRoute.UpdateOneID(id).
+		Where(coregatewayhttproute.DeletedAtIsNil())
+	if req.VirtualHostID == "" {
+		update.ClearVirtualHostID()
+	} else {
+		update.SetVirtualHostID(req.VirtualHostID)
+	}
+
+	if err := update.Exec(ctx); err != nil {
+		if ent.IsNotFound(err) {
+			return &code.HttpRouteNotExists
+		}
+		global.Logger.Sugar().Errorf("路由 %s 绑定虚拟主机失败: %v", id, err)
+		return &code.HttpRouteBindVhostFailed
+	}
+
+	return nil
+}
+
+// VirtualHostHeaders 获取虚拟主机的请求头与响应头改写规则
+func (s *ProxySvc) VirtualHostHeaders(ctx context.Context, id string) (*response.ProxyHeaderMutationResp, error) {
+
+	row, err := global.EntClient.CoreGatewayVirtualHost.Query().
+		Where(coregatewayvirtualhost.ID(id), coregatewayvirtualhost.DeletedAtIsNil()).
+		Select(coregatewayvirtualhost.FieldHeaderMutation).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, &code.VirtualHostNotExists
+		}
+		global.Logger.Sugar().Errorf("获取虚拟主机 %s 头改写规则失败: %v", id, err)
+		return nil, &code.VirtualHostQueryFailed
+	}
+
+	resp := &response.ProxyHeaderMutationResp{}
+	if row.HeaderMutation != nil {
+		resp.HeaderMutation = *row.HeaderMutation
+	}
+	return resp, nil
+}
+
+// VirtualHostSetHeaders 保存虚拟主机的请求头与响应头改写规则，作用于虚拟主机下所有路由，路由上的同名规则优先
+func (s *ProxySvc) VirtualHostSetHeaders(ctx context.Context, id string, req *request.ProxyHeaderMutationReq) error {
+
+	mutation := fromHeaderMutationReq(req)
+	if err := envoy.ValidateHeaderMutation(router.ToHeaderMutation(mutation)); err != nil {
+		global.Logger.Sugar().Warnf("虚拟主机 %s 头改写规则不合法: %v", id, err)
+		return &code.HeaderMutationInvalid
+	}
+
+	update := global.EntClient.CoreGatewayVirtualHost.UpdateOneID(id).
+		Where(coregatewayvirtualhost.DeletedAtIsNil())
+	if mutation == nil {
+		update.ClearHeaderMutation()
+	} else {
+		update.SetHeaderMutation(mutation)
+	}
+
+	if err := update.Exec(ctx); err != nil {
+		if ent.IsNotFound(err) {
+			return &code.VirtualHostNotExists
+		}
+		global.Logger.Sugar().Errorf("保存虚拟主机 %s 头改写规则失败: %v", id, err)
+		return &code.VirtualHostSaveFailed
+	}
+
+	return nil
+}
+
+// VirtualHostCors 获取虚拟主机的跨域策略
+func (s *ProxySvc) VirtualHostCors(ctx context.Context, id string) (*response.ProxyCorsPolicyResp, error) {
+
+	row, err := global.EntClient.CoreGatewayVirtualHost.Query().
+		Where(coregatewayvirtualhost.ID(id), coregatewayvirtualhost.DeletedAtIsNil()).
+		Select(coregatewayvirtualhost.FieldCorsPolicy).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, &code.VirtualHostNotExists
+		}
+		global.Logger.Sugar().Errorf("获取虚拟主机 %s 跨域策略失败: %v", id, err)
+		return nil, &code.VirtualHostQueryFailed
+	}
+
+	return toCorsPolicyResp(row.CorsPolicy), nil
+}
+
+// VirtualHostSetCors 保存虚拟主机的跨域策略，作用于虚拟主机下未单独配置跨域策略的路由，允许来源为空时清除
+func (s *ProxySvc) VirtualHostSetCors(ctx context.Context, id string, req *request.ProxyCorsPolicyReq) error {
+
+	policy := fromCorsPolicyReq(req)
+	if err := envoy.ValidateCorsPolicy(router.ToCorsPolicy(policy)); err != nil {
+		global.Logger.Sugar().Warnf("虚拟主机 %s 跨域策略不合法: %v", id, err)
+		return &code.CorsPolicyInvalid
+	}
+
+	update := global.EntClient.CoreGatewayVirtualHost.UpdateOneID(id).
+		Where(coregatewayvirtualhost.DeletedAtIsNil())
+	if policy == nil {
+		update.ClearCorsPolicy()
+	} else {
+		update.SetCorsPolicy(policy)
+	}
+
+	if err := update.Exec(ctx); err != nil {
+		if ent.IsNotFound(err) {
+			return &code.VirtualHostNotExists
+		}
+		global.Logger.Sugar().Errorf("保存虚拟主机 %s 跨域策略失败: %v", id, err)
+		return &code.VirtualHostSaveFailed
+	}
+
+	return nil
+}
+
+// checkVirtualHostDomains 校验域名格式，启用的虚拟主机还需检查与同一监听器下其他启用的虚拟主机是否冲突。
+// 与 Gateway 下发前使用同一校验，避免冲突导致整个快照被拒绝
+func checkVirtualHostDomains(ctx context.Context, id, listenerID string, domains []string, status constant.YesOrNo) error {
+
+	if err := envoy.ValidateDomains(domains); err != nil {
+		global.Logger.Sugar().Warnf("虚拟主机 %s 域名不合法: %v", id, err)
+		return &code.VirtualHostDomainInvalid
+	}
+	if status != constant.Yes {
+		return nil
+	}
+
+	query := global.EntClient.CoreGatewayVirtualHost.Query().
+		Where(
+			coregatewayvirtualhost.ListenerID(listenerID),
+			coregatewayvirtualhost.StatusEQ(constant.Yes),
+			coregatewayvirtualhost.DeletedAtIsNil(),
+		)
+	if id != "" {
+		query.Where(coregatewayvirtualhost.IDNEQ(id))
+	}
+	others, err := query.All(ctx)
+	if err != nil {
+		global.Logger.Sugar().Errorf("查询监听器 %s 的虚拟主机失败: %v", listenerID, err)
+		return &code.VirtualHostQueryFailed
+	}
+
+	used := make(map[string]string)
+	for _, o := range others {
+		for _, d := range o.Domains {
+			used[strings.ToLower(d)] = o.ID
+		}
+	}
+	if conflicts := envoy.DomainConflicts(used, domains); len(conflicts) > 0 {
+		global.Logger.Sugar().Warnf("虚拟主机 %s 域名 %s 已被虚拟主机 %s 使用", id, conflicts[0], used[strings.ToLower(conflicts[0])])
+		return &code.VirtualHostDomainConflict
+	}
+
+	return nil
+}
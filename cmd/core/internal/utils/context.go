@@ -0,0 +1,29 @@
+package utils
+
+import "context"
+
+type operatorKey struct{}
+
+type rollbackKey struct{}
+
+// WithOperator 在上下文中记录当前操作用户ID，供 ent hook 等下游逻辑追溯操作人
+func WithOperator(ctx context.Context, userID string) context.Context {
+	return context.WithValue(ctx, operatorKey{}, userID)
+}
+
+// OperatorFromContext 返回上下文中的操作用户ID，不存在时返回空字符串
+func OperatorFromContext(ctx context.Context) string {
+	userID, _ := ctx.Value(operatorKey{}).(string)
+	return userID
+}
+
+// WithRollback 标记上下文中的变更为回滚到指定修订号
+func WithRollback(ctx context.Context, revision int64) context.Context {
+	return context.WithValue(ctx, rollbackKey{}, revision)
+}
+
+// RollbackFromContext 返回上下文中回滚的目标修订号
+func RollbackFromContext(ctx context.Context) (int64, bool) {
+	revision, ok := ctx.Value(rollbackKey{}).(int64)
+	return revision, ok
+}
@@ -6,13 +6,22 @@ import (
 
 	"github.com/alecthomas/kong"
 	"github.com/lyonmu/quebec/cmd/gateway/internal/bootstrap"
+	"github.com/lyonmu/quebec/cmd/gateway/internal/command"
 	"github.com/lyonmu/quebec/cmd/gateway/internal/global"
 	"github.com/lyonmu/quebec/pkg/constant"
 	"github.com/prometheus/common/version"
 )
 
+// cli 网关命令行，默认启动网关服务，全局参数即网关配置
+type cli struct {
+	Serve          struct{}                  `cmd:"" default:"1" help:"启动网关服务"`
+	EnvoyBootstrap command.EnvoyBootstrapCmd `cmd:"" name:"envoy-bootstrap" help:"生成接入本网关的 Envoy bootstrap 配置"`
+}
+
 func main() {
-	kong.Parse(&global.Cfg,
+	var c cli
+	ctx := kong.Parse(&c,
+		kong.Embed(&global.Cfg),
 		kong.Name(string(constant.ModuleNameGateway)),
 		kong.Description(string(constant.ModuleNameGateway)),
 		kong.UsageOnError(),
@@ -26,5 +35,10 @@ func main() {
 		os.Exit(0)
 	}
 
+	if ctx.Command() == "envoy-bootstrap" {
+		ctx.FatalIfErrorf(ctx.Run())
+		return
+	}
+
 	bootstrap.Start()
 }
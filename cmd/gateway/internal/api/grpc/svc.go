@@ -7,6 +7,8 @@ import (
 	"github.com/lyonmu/quebec/cmd/gateway/internal/service/grpc/als"
 	"github.com/lyonmu/quebec/cmd/gateway/internal/service/grpc/auth"
 	"github.com/lyonmu/quebec/cmd/gateway/internal/service/grpc/ads"
+	"github.com/lyonmu/quebec/cmd/gateway/internal/service/grpc/node"
+	"github.com/lyonmu/quebec/cmd/gateway/internal/service/grpc/ratelimit"
 )
 
 type Svc interface {
@@ -17,15 +19,26 @@ var registry = make([]Svc, 0)
 
 func NewGrpcSvc(server *grpc.Server) error {
 
-	als := als.NewAlsSvc()
+	// 到 Core 的节点状态上报长连接，xDS 回调与访问日志流量统计共用
+	syncer := node.NewCoreSyncer(global.GrpcClient, int64(global.Cfg.Gateway.Node))
+	syncer.Start()
+	global.Logger.Sugar().Infof("CoreSyncer started for GatewayID: %d", global.Cfg.Gateway.Node)
+
+	als := als.NewAlsSvc(syncer)
 	registry = append(registry, als)
 
 	auth := auth.NewAuthSvc()
 	registry = append(registry, auth)
 
-	proxy := ads.NewAdsSvc()
+	proxy := ads.NewAdsSvc(syncer)
 	registry = append(registry, proxy)
 
+	// 全局限流计数保存在 Redis 中，所有 Gateway 实例共享；未启用时 Envoy 调用限流服务失败并放行请求
+	if global.RedisCli != nil {
+		rls := ratelimit.NewRateLimitSvc(proxy, global.RedisCli)
+		registry = append(registry, rls)
+	}
+
 	for _, svc := range registry {
 		if err := svc.Register(server); err != nil {
 			global.Logger.Sugar().Errorf("register grpc service failed: %v", err)
@@ -38,6 +38,13 @@ func Start() {
 		global.Id = idGenerator
 		global.Metrics = metrics.NewPrometheusRegistry()
 
+		// 全局限流计数保存在 Redis 中，未启用全局限流的 Gateway 不连接 Redis
+		if global.Cfg.Gateway.GlobalRateLimit {
+			global.RedisCli = global.Cfg.Redis.Client(global.Cfg.Log.Module)
+		} else {
+			global.Logger.Sugar().Warn("未启用全局限流 (--gateway.global_rate_limit)，接入该 Gateway 的 Envoy 不会执行路由配置的全局限流")
+		}
+
 		// 初始化 ALS Kafka Producer
 		// Key 使用 String 编码，Value 使用 Binary 编码
 		if len(global.Cfg.Gateway.AlsTopic) > 0 {
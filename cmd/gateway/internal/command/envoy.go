@@ -0,0 +1,54 @@
+package command
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/lyonmu/quebec/cmd/gateway/internal/global"
+	"github.com/lyonmu/quebec/pkg/envoy"
+)
+
+// EnvoyBootstrapCmd 生成接入本网关的 Envoy bootstrap，xDS 端口与令牌取自网关自身的 --gateway.port 与 --gateway.xds_token
+type EnvoyBootstrapCmd struct {
+	NodeID    string `name:"node-id" required:"" help:"Envoy 节点ID (node.id)"`
+	Cluster   string `name:"cluster" required:"" help:"网关集群ID (node.cluster)"`
+	XdsHost   string `name:"xds-host" default:"127.0.0.1" help:"Envoy 访问本网关 xDS 服务的地址，支持域名"`
+	AdminPort uint32 `name:"admin-port" default:"19901" help:"Envoy 管理接口端口"`
+	Delta     bool   `name:"delta" default:"false" help:"是否使用 Delta xDS"`
+	TLSCA     string `name:"tls-ca" help:"校验网关证书的 CA 证书路径，设置任一 TLS 参数即启用 TLS"`
+	TLSCert   string `name:"tls-cert" help:"双向认证的客户端证书路径"`
+	TLSKey    string `name:"tls-key" help:"双向认证的客户端私钥路径"`
+	TLSSNI    string `name:"tls-sni" help:"TLS SNI"`
+	Format    string `name:"format" enum:"yaml,json" default:"yaml" help:"输出格式 [可选yaml,json]"`
+	Output    string `short:"o" name:"output" help:"输出文件路径，为空输出到标准输出"`
+}
+
+func (c *EnvoyBootstrapCmd) Run() error {
+	opts := envoy.BootstrapOptions{
+		NodeID:    c.NodeID,
+		Cluster:   c.Cluster,
+		XdsHost:   c.XdsHost,
+		XdsPort:   uint32(global.Cfg.Gateway.Port),
+		AdminPort: c.AdminPort,
+		Delta:     c.Delta,
+		XdsToken:  global.Cfg.Gateway.XdsToken,
+	}
+	if c.TLSCA != "" || c.TLSCert != "" || c.TLSKey != "" || c.TLSSNI != "" {
+		opts.TLS = &envoy.BootstrapTLS{CACert: c.TLSCA, ClientCert: c.TLSCert, ClientKey: c.TLSKey, SNI: c.TLSSNI}
+	}
+
+	b, err := envoy.Bootstrap(opts)
+	if err != nil {
+		return fmt.Errorf("generate envoy bootstrap: %w", err)
+	}
+	data, err := envoy.MarshalBootstrap(b, c.Format)
+	if err != nil {
+		return fmt.Errorf("marshal envoy bootstrap: %w", err)
+	}
+
+	if c.Output == "" {
+		_, err = os.Stdout.Write(data)
+		return err
+	}
+	return os.WriteFile(c.Output, data, 0o644)
+}
@@ -10,14 +10,17 @@ type Config struct {
 	Log     log.LogConfig      `embed:"" prefix:"log." mapstructure:"log" json:"log" yaml:"log"`
 	Gateway GatewayConfig      `embed:"" prefix:"gateway." mapstructure:"gateway" json:"gateway" yaml:"gateway"`
 	Kafka   config.KafkaConfig `embed:"" prefix:"kafka." mapstructure:"kafka" json:"kafka" yaml:"kafka"`
+	Redis   config.RedisConfig `embed:"" prefix:"redis." mapstructure:"redis" json:"redis" yaml:"redis"`
 }
 
 type GatewayConfig struct {
-	Port     uint16 `name:"port" env:"PORT" default:"59025" help:"端口" mapstructure:"port" yaml:"port" json:"port"`
-	Node     int    `name:"node" env:"NODE" default:"1" help:"节点编号" mapstructure:"node" yaml:"node" json:"node"`
-	Prefix   string `name:"prefix" env:"PREFIX" default:"/gateway/api" help:"路由前缀" mapstructure:"prefix" yaml:"prefix" json:"prefix"`
-	Admin    string `name:"admin" env:"ADMIN" default:"127.0.0.1:59024" help:"Admin服务地址" mapstructure:"admin" yaml:"admin" json:"admin"`
-	AlsTopic string `name:"als_topic" env:"KAFKA_ALS_TOPIC" default:"envoy_als_events" help:"envoy access log 主题名称" mapstructure:"als_topic" yaml:"als_topic" json:"als_topic"`
+	Port            uint16 `name:"port" env:"PORT" default:"59025" help:"端口" mapstructure:"port" yaml:"port" json:"port"`
+	Node            int    `name:"node" env:"NODE" default:"1" help:"节点编号" mapstructure:"node" yaml:"node" json:"node"`
+	Prefix          string `name:"prefix" env:"PREFIX" default:"/gateway/api" help:"路由前缀" mapstructure:"prefix" yaml:"prefix" json:"prefix"`
+	Admin           string `name:"admin" env:"ADMIN" default:"127.0.0.1:59024" help:"Admin服务地址" mapstructure:"admin" yaml:"admin" json:"admin"`
+	AlsTopic        string `name:"als_topic" env:"KAFKA_ALS_TOPIC" default:"envoy_als_events" help:"envoy access log 主题名称" mapstructure:"als_topic" yaml:"als_topic" json:"als_topic"`
+	XdsToken        string `name:"xds_token" env:"XDS_TOKEN" default:"" help:"Envoy 连接 xDS 服务需携带的令牌，为空不校验" mapstructure:"xds_token" yaml:"xds_token" json:"xds_token"`
+	GlobalRateLimit bool   `name:"global_rate_limit" env:"GLOBAL_RATE_LIMIT" default:"false" help:"启用全局限流服务，计数保存在 Redis 中" mapstructure:"global_rate_limit" yaml:"global_rate_limit" json:"global_rate_limit"`
 }
 
 func (c *Config) MachineID() (int, error) {
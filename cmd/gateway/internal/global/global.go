@@ -4,6 +4,7 @@ import (
 	"github.com/lyonmu/quebec/cmd/gateway/internal/config"
 	"github.com/lyonmu/quebec/pkg/tools"
 	"github.com/prometheus/client_golang/prometheus"
+	"github.com/redis/go-redis/v9"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
 )
@@ -15,4 +16,5 @@ var (
 	GrpcClient       *grpc.ClientConn
 	Metrics          *prometheus.Registry
 	AlsKafkaProducer any
+	RedisCli         redis.UniversalClient // 全局限流计数
 )
@@ -8,20 +8,23 @@ import (
 	endpointservice "github.com/envoyproxy/go-control-plane/envoy/service/endpoint/v3"
 	listenerservice "github.com/envoyproxy/go-control-plane/envoy/service/listener/v3"
 	routeservice "github.com/envoyproxy/go-control-plane/envoy/service/route/v3"
-	xdsv3cache "github.com/envoyproxy/go-control-plane/pkg/cache/v3"
-	xdsv3log "github.com/envoyproxy/go-control-plane/pkg/log"
 	xdsv3server "github.com/envoyproxy/go-control-plane/pkg/server/v3"
 	"github.com/lyonmu/quebec/cmd/gateway/internal/global"
 	"github.com/lyonmu/quebec/cmd/gateway/internal/service/grpc/ads/callback"
+	"github.com/lyonmu/quebec/cmd/gateway/internal/service/grpc/ads/xds"
+	"github.com/lyonmu/quebec/cmd/gateway/internal/service/grpc/node"
+	"github.com/lyonmu/quebec/cmd/gateway/internal/service/grpc/router"
+	v1 "github.com/lyonmu/quebec/idl/router/v1"
 	"google.golang.org/grpc"
 )
 
 var (
-	xdsCache = xdsv3cache.NewSnapshotCache(false, xdsv3cache.IDHash{}, &xdsv3log.DefaultLogger{})
+	xdsCache = xds.NewResourceCache()
 )
 
 type AdsSvc struct {
-	xdsserver xdsv3server.Server
+	xdsserver   xdsv3server.Server
+	snapshotter *xds.Snapshotter
 }
 
 // https://www.envoyproxy.io/docs/envoy/latest/api-v3/config/bootstrap/v3/bootstrap.proto#config-bootstrap-v3-bootstrap-dynamicresources
@@ -36,10 +39,26 @@ func (s *AdsSvc) Register(gs *grpc.Server) error {
 	return nil
 }
 
-func NewAdsSvc() *AdsSvc {
+func NewAdsSvc(syncer *node.CoreSyncer) *AdsSvc {
+
+	if err := xds.RegisterMetrics(global.Metrics); err != nil {
+		global.Logger.Sugar().Warnf("register xds metrics failed: %v", err)
+	}
+
+	// snapshotter 持有 Core 下发的配置，在节点连接与配置变更时写入快照缓存
+	snapshotter := xds.NewSnapshotter(xdsCache)
+
+	// 与 Core 建立配置同步长连接，这是配置变更到达 Envoy 的唯一通道
+	configSyncer := router.NewConfigSyncer(global.GrpcClient, int64(global.Cfg.Gateway.Node), snapshotter)
+	configSyncer.Start()
 
 	// create default callback instance to record envoy xDS logs
-	callbacks := callback.NewGatewayCallbacks(global.GrpcClient, int64(global.Cfg.Gateway.Node))
+	callbacks := callback.NewGatewayCallbacks(syncer, snapshotter)
+
+	return &AdsSvc{xdsserver: xdsv3server.NewServer(context.Background(), xdsCache, callbacks), snapshotter: snapshotter}
+}
 
-	return &AdsSvc{xdsv3server.NewServer(context.Background(), xdsCache, callbacks)}
+// RateLimitRules 返回 Core 下发的全局限流规则
+func (s *AdsSvc) RateLimitRules() []*v1.RateLimitRule {
+	return s.snapshotter.RateLimitRules()
 }
@@ -0,0 +1,90 @@
+package callback
+
+import (
+	"sync"
+	"time"
+
+	"github.com/lyonmu/quebec/cmd/gateway/internal/global"
+	v1pb "github.com/lyonmu/quebec/idl/node/v1"
+	"google.golang.org/genproto/googleapis/rpc/status"
+)
+
+// sentResponse 已下发给节点、等待回执的 xDS 响应
+type sentResponse struct {
+	nonce    string
+	version  string
+	revision int64
+}
+
+// trackResponse 记录下发给节点的响应，后续按 nonce 匹配 Envoy 的回执
+func (c *XDSCallbacks) trackResponse(sessions *sync.Map, streamID int64, typeURL, nonce, version string) {
+	info := loadSession(sessions, streamID)
+	if info == nil {
+		return
+	}
+
+	info.mu.Lock()
+	defer info.mu.Unlock()
+	info.pending[typeURL] = sentResponse{
+		nonce:    nonce,
+		version:  version,
+		revision: c.Snapshotter.NodeRevision(info.NodeID),
+	}
+}
+
+// handleAck 处理 Envoy 的回执：ACK 的版本发生变化或出现 NACK 时上报 Core
+func (c *XDSCallbacks) handleAck(sessions *sync.Map, streamID int64, typeURL, nonce string, errorDetail *status.Status) {
+	info := loadSession(sessions, streamID)
+	if info == nil {
+		return
+	}
+
+	info.mu.Lock()
+	sent, ok := info.pending[typeURL]
+	// 只处理最近一次响应的回执，过期 nonce 的回执已被后续响应覆盖
+	if !ok || sent.nonce != nonce {
+		info.mu.Unlock()
+		return
+	}
+	delete(info.pending, typeURL)
+
+	xdsStatus := &v1pb.XdsStatus{
+		TypeUrl:     typeURL,
+		VersionInfo: sent.version,
+		Revision:    sent.revision,
+		Timestamp:   time.Now().Unix(),
+	}
+	if errorDetail != nil {
+		xdsStatus.ErrorDetail = errorDetail.GetMessage()
+	} else {
+		if info.acked[typeURL] == sent.version {
+			info.mu.Unlock()
+			return
+		}
+		info.acked[typeURL] = sent.version
+	}
+	info.mu.Unlock()
+
+	if xdsStatus.ErrorDetail != "" {
+		global.Logger.Sugar().Warnf("node %s rejected %s version %s: %s", info.NodeID, typeURL, sent.version, xdsStatus.ErrorDetail)
+	} else {
+		global.Logger.Sugar().Infof("node %s accepted %s version %s", info.NodeID, typeURL, sent.version)
+	}
+
+	c.Syncer.PushEvent(&v1pb.EnvoyStatusEvent{
+		Event:     v1pb.EnvoyStatusEvent_XDS_STATUS,
+		NodeId:    info.NodeID,
+		ClusterId: info.Cluster,
+		StreamId:  info.StreamID,
+		XdsStatus: xdsStatus,
+	})
+}
+
+func loadSession(sessions *sync.Map, streamID int64) *NodeInfo {
+	value, ok := sessions.Load(streamID)
+	if !ok {
+		return nil
+	}
+	info, _ := value.(*NodeInfo)
+	return info
+}
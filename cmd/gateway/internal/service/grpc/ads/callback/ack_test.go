@@ -0,0 +1,79 @@
+package callback
+
+import (
+	"testing"
+
+	"github.com/lyonmu/quebec/cmd/gateway/internal/global"
+	"github.com/lyonmu/quebec/cmd/gateway/internal/service/grpc/ads/xds"
+	v1pb "github.com/lyonmu/quebec/idl/node/v1"
+	v1 "github.com/lyonmu/quebec/idl/router/v1"
+	"go.uber.org/zap"
+	"google.golang.org/genproto/googleapis/rpc/status"
+)
+
+type eventRecorder struct {
+	events []*v1pb.EnvoyStatusEvent
+}
+
+func (r *eventRecorder) PushEvent(event *v1pb.EnvoyStatusEvent) {
+	r.events = append(r.events, event)
+}
+
+func TestHandleAck(t *testing.T) {
+	global.Logger = zap.NewNop()
+
+	const typeURL = "type.googleapis.com/envoy.config.cluster.v3.Cluster"
+	recorder := &eventRecorder{}
+	snapshotter := xds.NewSnapshotter(xds.NewResourceCache())
+	if err := snapshotter.Apply(&v1.ConfigSyncResponse{Type: v1.ConfigSyncResponse_FULL, Revision: 3, Config: &v1.ProxyConfig{}}); err != nil {
+		t.Fatalf("apply full config failed: %v", err)
+	}
+	c := &XDSCallbacks{Syncer: recorder, Snapshotter: snapshotter}
+	c.sessions.Store(int64(1), &NodeInfo{
+		NodeID:   "n1",
+		Cluster:  "edge",
+		StreamID: 1,
+		pending:  make(map[string]sentResponse),
+		acked:    make(map[string]string),
+	})
+
+	// ACK 按 nonce 找到下发的版本与修订号
+	c.trackResponse(&c.sessions, 1, typeURL, "1", "v1")
+	c.handleAck(&c.sessions, 1, typeURL, "1", nil)
+	if len(recorder.events) != 1 {
+		t.Fatalf("expected 1 event after ack, got %d", len(recorder.events))
+	}
+	if st := recorder.events[0].GetXdsStatus(); st.GetVersionInfo() != "v1" || st.GetRevision() != 3 || st.GetErrorDetail() != "" {
+		t.Fatalf("unexpected ack status: %v", st)
+	}
+
+	// 同一版本的重复 ACK 不再上报
+	c.trackResponse(&c.sessions, 1, typeURL, "2", "v1")
+	c.handleAck(&c.sessions, 1, typeURL, "2", nil)
+	if len(recorder.events) != 1 {
+		t.Fatalf("expected repeated ack to be ignored, got %d events", len(recorder.events))
+	}
+
+	// 过期 nonce 的回执被忽略，最近一次响应仍等待回执
+	c.trackResponse(&c.sessions, 1, typeURL, "3", "v2")
+	c.trackResponse(&c.sessions, 1, typeURL, "4", "v3")
+	c.handleAck(&c.sessions, 1, typeURL, "3", &status.Status{Message: "stale"})
+	if len(recorder.events) != 1 {
+		t.Fatalf("expected stale nonce to be ignored, got %d events", len(recorder.events))
+	}
+
+	// NACK 上报被拒绝的版本与错误信息
+	c.handleAck(&c.sessions, 1, typeURL, "4", &status.Status{Message: "bad cluster"})
+	if len(recorder.events) != 2 {
+		t.Fatalf("expected 2 events after nack, got %d", len(recorder.events))
+	}
+	if st := recorder.events[1].GetXdsStatus(); st.GetVersionInfo() != "v3" || st.GetErrorDetail() != "bad cluster" {
+		t.Fatalf("unexpected nack status: %v", st)
+	}
+
+	// 已处理的 nonce 不会重复上报
+	c.handleAck(&c.sessions, 1, typeURL, "4", nil)
+	if len(recorder.events) != 2 {
+		t.Fatalf("expected handled nonce to be ignored, got %d events", len(recorder.events))
+	}
+}
@@ -0,0 +1,28 @@
+package callback
+
+import (
+	"context"
+	"crypto/subtle"
+
+	"github.com/lyonmu/quebec/cmd/gateway/internal/global"
+	"github.com/lyonmu/quebec/pkg/envoy"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// authorize 校验 Envoy 在 xDS 流上携带的令牌 (bootstrap 中 ads_config 的 initial_metadata)，未配置令牌时不校验
+func authorize(ctx context.Context) error {
+	token := global.Cfg.Gateway.XdsToken
+	if token == "" {
+		return nil
+	}
+	md, _ := metadata.FromIncomingContext(ctx)
+	for _, v := range md.Get(envoy.XdsTokenHeader) {
+		if subtle.ConstantTimeCompare([]byte(v), []byte(token)) == 1 {
+			return nil
+		}
+	}
+	global.Logger.Sugar().Warn("reject xds stream: missing or invalid xds token")
+	return status.Error(codes.Unauthenticated, "invalid xds token")
+}
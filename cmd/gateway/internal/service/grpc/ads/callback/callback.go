@@ -8,48 +8,53 @@ import (
 	discoverygrpc "github.com/envoyproxy/go-control-plane/envoy/service/discovery/v3"
 	"github.com/envoyproxy/go-control-plane/pkg/server/v3"
 	"github.com/lyonmu/quebec/cmd/gateway/internal/global"
+	"github.com/lyonmu/quebec/cmd/gateway/internal/service/grpc/ads/xds"
 	"github.com/lyonmu/quebec/cmd/gateway/internal/service/grpc/node"
-	"google.golang.org/grpc"
+	v1pb "github.com/lyonmu/quebec/idl/node/v1"
 )
 
 type NodeInfo struct {
 	NodeID   string
 	Cluster  string
 	StreamID int64
-}
 
-type XDSCallbacks struct {
-	Syncer   *node.CoreSyncer
-	sessions sync.Map
+	// xDS 回执跟踪，按资源类型记录
+	mu      sync.Mutex
+	pending map[string]sentResponse // 最近一次下发、等待回执的响应
+	acked   map[string]string       // 最近一次被 ACK 的版本
 }
 
-// NewGatewayCallbacks 初始化 CoreSyncer 并构建 xDS 回调
-// conn: 到 Core 服务的 gRPC 连接
-// gatewayID: 当前 Gateway 的唯一标识
-func NewGatewayCallbacks(conn *grpc.ClientConn, gatewayID int64) server.Callbacks {
-	// 1. 初始化 CoreSyncer
-	// 注意：这里我们假设 NewCoreSyncer 已经在同一个包或引用的包中定义
-	syncer := node.NewCoreSyncer(conn, gatewayID)
-
-	// 2. 启动后台同步协程
-	// 这一步封装在这里，外部调用者就不需要关心内部有个 syncer 需要启动了
-	syncer.Start()
+// EventPusher 向 Core 上报节点事件，由 CoreSyncer 实现
+type EventPusher interface {
+	PushEvent(event *v1pb.EnvoyStatusEvent)
+}
 
-	global.Logger.Sugar().Infof("CoreSyncer started for GatewayID: %d", gatewayID)
+type XDSCallbacks struct {
+	Syncer      EventPusher
+	Snapshotter *xds.Snapshotter
+	sessions    sync.Map
+	// Delta 流与 SotW 流的 streamID 各自计数，需分开登记
+	deltaSessions sync.Map
+}
 
-	// 3. 返回组装好的 Callbacks
+// NewGatewayCallbacks 构建 xDS 回调
+// syncer: 已启动的 CoreSyncer，用于向 Core 上报节点连接与回执事件
+// snapshotter: 负责在节点连接/断开时设置、清理 xDS 快照
+func NewGatewayCallbacks(syncer *node.CoreSyncer, snapshotter *xds.Snapshotter) server.Callbacks {
 	// sync.Map 的零值即直接可用，无需显式初始化
 	return &XDSCallbacks{
-		Syncer: syncer,
+		Syncer:      syncer,
+		Snapshotter: snapshotter,
 	}
 }
 
 func (c *XDSCallbacks) OnStreamOpen(ctx context.Context, streamID int64, typeURL string) error {
 	global.Logger.Sugar().Infof("on stream opened, streamID: %d, typeURL: %s", streamID, typeURL)
-	return nil
+	return authorize(ctx)
 }
 
 func (c *XDSCallbacks) OnStreamResponse(ctx context.Context, streamID int64, request *discoverygrpc.DiscoveryRequest, response *discoverygrpc.DiscoveryResponse) {
+	c.trackResponse(&c.sessions, streamID, response.GetTypeUrl(), response.GetNonce(), response.GetVersionInfo())
 	global.Logger.Sugar().Infof("on stream response, streamID: %d, nodeId: %s, nodeCluster: %s, node.Metadata: %+v",
 		streamID, request.Node.Id, request.Node.Cluster, request.Node.Metadata)
 }
@@ -68,10 +73,11 @@ func (c *XDSCallbacks) OnFetchResponse(request *discoverygrpc.DiscoveryRequest,
 // Delta xDS 相关方法
 func (c *XDSCallbacks) OnDeltaStreamOpen(ctx context.Context, streamID int64, typeURL string) error {
 	global.Logger.Sugar().Infof("on delta stream opened, streamID: %d, typeURL: %s", streamID, typeURL)
-	return nil
+	return authorize(ctx)
 }
 
 func (c *XDSCallbacks) OnDeltaStreamClosed(streamID int64, node *core.Node) {
+	c.closeSession(&c.deltaSessions, streamID)
 	global.Logger.Sugar().Infof("on delta stream closed, streamID: %d, nodeId: %s, nodeCluster: %s, node.Metadata: %+v",
 		streamID, node.Id, node.Cluster, node.Metadata)
 }
@@ -79,10 +85,17 @@ func (c *XDSCallbacks) OnDeltaStreamClosed(streamID int64, node *core.Node) {
 func (c *XDSCallbacks) OnStreamDeltaRequest(streamID int64, request *discoverygrpc.DeltaDiscoveryRequest) error {
 	global.Logger.Sugar().Infof("on stream delta request, streamID: %d, nodeId: %s, nodeCluster: %s, node.Metadata: %+v",
 		streamID, request.Node.Id, request.Node.Cluster, request.Node.Metadata)
+	if err := c.openSession(&c.deltaSessions, streamID, request.GetNode()); err != nil {
+		return err
+	}
+	if request.GetResponseNonce() != "" {
+		c.handleAck(&c.deltaSessions, streamID, request.GetTypeUrl(), request.GetResponseNonce(), request.GetErrorDetail())
+	}
 	return nil
 }
 
 func (c *XDSCallbacks) OnStreamDeltaResponse(streamID int64, request *discoverygrpc.DeltaDiscoveryRequest, response *discoverygrpc.DeltaDiscoveryResponse) {
+	c.trackResponse(&c.deltaSessions, streamID, response.GetTypeUrl(), response.GetNonce(), response.GetSystemVersionInfo())
 	global.Logger.Sugar().Infof("on stream delta response_nonce, streamID: %d, nodeId: %s, nodeCluster: %s, node.Metadata: %+v",
 		streamID, request.Node.Id, request.Node.Cluster, request.Node.Metadata)
 }
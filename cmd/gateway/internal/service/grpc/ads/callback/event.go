@@ -1,6 +1,8 @@
 package callback
 
 import (
+	"sync"
+
 	core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	discoverygrpc "github.com/envoyproxy/go-control-plane/envoy/service/discovery/v3"
 	"github.com/lyonmu/quebec/cmd/gateway/internal/global"
@@ -8,20 +10,7 @@ import (
 )
 
 func (c *XDSCallbacks) OnStreamClosed(streamID int64, node *core.Node) {
-	// 原子地删除并获取值
-	if value, loaded := c.sessions.LoadAndDelete(streamID); loaded {
-		// 类型断言：sync.Map 存的是 interface{}，取出来需要转回 *NodeInfo
-		info, ok := value.(*NodeInfo)
-		if ok {
-			// 发送断开事件给 Core
-			c.Syncer.PushEvent(&v1pb.EnvoyStatusEvent{
-				Event:     v1pb.EnvoyStatusEvent_DISCONNECT,
-				NodeId:    info.NodeID,
-				ClusterId: info.Cluster,
-				StreamId:  info.StreamID,
-			})
-		}
-	}
+	c.closeSession(&c.sessions, streamID)
 	global.Logger.Sugar().Infof("on stream closed, streamID: %d, nodeId: %s, nodeCluster: %s", streamID, node.Id, node.Cluster)
 }
 
@@ -29,8 +18,21 @@ func (c *XDSCallbacks) OnStreamRequest(id int64, request *discoverygrpc.Discover
 	node := request.GetNode()
 	global.Logger.Sugar().Infof("on stream request, streamID: %d, nodeId: %s, nodeCluster: %s", id, node.Id, node.Cluster)
 
+	if err := c.openSession(&c.sessions, id, node); err != nil {
+		return err
+	}
+
+	// 携带 response_nonce 的请求是对上一次响应的 ACK/NACK
+	if request.GetResponseNonce() != "" {
+		c.handleAck(&c.sessions, id, request.GetTypeUrl(), request.GetResponseNonce(), request.GetErrorDetail())
+	}
+	return nil
+}
+
+// openSession 在流的首个请求时登记会话、准备节点分组的 xDS 资源并通知 Core，SotW 与 Delta 流共用
+func (c *XDSCallbacks) openSession(sessions *sync.Map, id int64, node *core.Node) error {
 	// 1. 快速路径：如果 session 已经存在，说明不是首包，直接返回，不做任何分配
-	if _, ok := c.sessions.Load(id); ok {
+	if _, ok := sessions.Load(id); ok {
 		return nil
 	}
 
@@ -39,14 +41,22 @@ func (c *XDSCallbacks) OnStreamRequest(id int64, request *discoverygrpc.Discover
 		NodeID:   node.Id,
 		Cluster:  node.Cluster,
 		StreamID: id,
+		pending:  make(map[string]sentResponse),
+		acked:    make(map[string]string),
 	}
 
 	// 3. 原子操作：LoadOrStore
 	// 如果 loaded 为 true，说明在并发情况下，别的协程已经存进去了，我们什么都不用做
 	// 如果 loaded 为 false，说明是我们存进去的，需要触发 CONNECT 事件
-	_, loaded := c.sessions.LoadOrStore(id, newNodeInfo)
+	_, loaded := sessions.LoadOrStore(id, newNodeInfo)
 
 	if !loaded {
+		// 节点首次请求时生成并设置快照，失败则关闭流由 Envoy 重连重试
+		if err := c.Snapshotter.SetNodeSnapshot(node); err != nil {
+			sessions.Delete(id)
+			return err
+		}
+
 		// 发送连接事件给 Core
 		c.Syncer.PushEvent(&v1pb.EnvoyStatusEvent{
 			Event:     v1pb.EnvoyStatusEvent_CONNECT,
@@ -59,3 +69,24 @@ func (c *XDSCallbacks) OnStreamRequest(id int64, request *discoverygrpc.Discover
 
 	return nil
 }
+
+// closeSession 清理流对应的会话，释放节点分组的快照引用并通知 Core
+func (c *XDSCallbacks) closeSession(sessions *sync.Map, streamID int64) {
+	// 原子地删除并获取值
+	if value, loaded := sessions.LoadAndDelete(streamID); loaded {
+		// 类型断言：sync.Map 存的是 interface{}，取出来需要转回 *NodeInfo
+		info, ok := value.(*NodeInfo)
+		if ok {
+			// 节点流关闭后释放其快照引用，分组内无节点时清理
+			c.Snapshotter.ClearNodeSnapshot(&core.Node{Id: info.NodeID, Cluster: info.Cluster})
+
+			// 发送断开事件给 Core
+			c.Syncer.PushEvent(&v1pb.EnvoyStatusEvent{
+				Event:     v1pb.EnvoyStatusEvent_DISCONNECT,
+				NodeId:    info.NodeID,
+				ClusterId: info.Cluster,
+				StreamId:  info.StreamID,
+			})
+		}
+	}
+}
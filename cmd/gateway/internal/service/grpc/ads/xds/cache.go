@@ -0,0 +1,235 @@
+package xds
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/envoyproxy/go-control-plane/pkg/cache/types"
+	"github.com/envoyproxy/go-control-plane/pkg/cache/v3"
+	"github.com/envoyproxy/go-control-plane/pkg/resource/v3"
+	"github.com/lyonmu/quebec/cmd/gateway/internal/global"
+)
+
+// resourceTypes 资源更新顺序：先下发被引用的 CDS/EDS，再下发引用方 LDS/RDS；删除时逆序进行
+var resourceTypes = []resource.Type{
+	resource.ClusterType,
+	resource.EndpointType,
+	resource.ListenerType,
+	resource.RouteType,
+}
+
+// ResourceCache 资源级 xDS 缓存，同时支持 SotW 与 Delta xDS。
+// 每个节点分组 (见 ClusterHash) 持有一组按类型拆分的 LinearCache，
+// 资源以内容哈希作为版本，只有内容变化的资源才会推送给 Envoy。
+// 节点可通过 Assign 指定分组 (如灰度分组)，其已打开的 watch 会迁移到新分组
+type ResourceCache struct {
+	mu      sync.RWMutex
+	groups  map[string]*resourceGroup
+	assign  map[string]string                  // node.id -> 指定的分组
+	watches map[string]map[*nodeWatch]struct{} // node.id -> 已打开的 watch
+}
+
+// nodeWatch 节点在某个分组上打开的 watch，迁移分组时按原请求重新登记
+type nodeWatch struct {
+	key    string
+	cancel func()
+	create func(g *resourceGroup) (func(), error)
+}
+
+// resourceGroup 一个节点分组的资源缓存
+type resourceGroup struct {
+	mux      *cache.MuxCache
+	linear   map[resource.Type]*cache.LinearCache
+	versions map[resource.Type]map[string]string // 资源名 -> 内容哈希
+}
+
+var _ cache.Cache = &ResourceCache{}
+
+func NewResourceCache() *ResourceCache {
+	return &ResourceCache{
+		groups:  make(map[string]*resourceGroup),
+		assign:  make(map[string]string),
+		watches: make(map[string]map[*nodeWatch]struct{}),
+	}
+}
+
+func newResourceGroup(key string) *resourceGroup {
+	// 版本前缀区分不同分组与进程生命周期，避免 Gateway 重启后版本号复用
+	prefix := fmt.Sprintf("%s-%s-", key, strconv.FormatInt(time.Now().UnixNano(), 36))
+
+	g := &resourceGroup{
+		linear:   make(map[resource.Type]*cache.LinearCache, len(resourceTypes)),
+		versions: make(map[resource.Type]map[string]string, len(resourceTypes)),
+	}
+	caches := make(map[string]cache.Cache, len(resourceTypes))
+	for _, typ := range resourceTypes {
+		linear := cache.NewLinearCache(typ, cache.WithVersionPrefix(prefix), cache.WithLogger(global.Logger.Sugar()))
+		g.linear[typ] = linear
+		g.versions[typ] = make(map[string]string)
+		caches[typ] = linear
+	}
+	g.mux = &cache.MuxCache{
+		Classify:      func(r *cache.Request) string { return r.GetTypeUrl() },
+		ClassifyDelta: func(r *cache.DeltaRequest) string { return r.GetTypeUrl() },
+		Caches:        caches,
+	}
+	return g
+}
+
+// Update 使用快照内容更新分组资源，仅写入内容哈希发生变化的资源，返回变更的资源数
+func (c *ResourceCache) Update(key string, snap *cache.Snapshot) (int, error) {
+	c.mu.Lock()
+	g, ok := c.groups[key]
+	if !ok {
+		g = newResourceGroup(key)
+		c.groups[key] = g
+	}
+	c.mu.Unlock()
+
+	if err := snap.ConstructVersionMap(); err != nil {
+		return 0, err
+	}
+
+	deletes := make(map[resource.Type][]string, len(resourceTypes))
+	changed := 0
+	for _, typ := range resourceTypes {
+		toUpdate, toDelete := diffResources(g.versions[typ], snap.GetResources(typ), snap.GetVersionMap(typ))
+		if len(toUpdate) > 0 {
+			if err := g.linear[typ].UpdateResources(toUpdate, nil); err != nil {
+				return changed, err
+			}
+		}
+		g.versions[typ] = snap.GetVersionMap(typ)
+		deletes[typ] = toDelete
+		changed += len(toUpdate) + len(toDelete)
+	}
+
+	// 删除逆序进行，先移除引用方再移除被引用的资源
+	for i := len(resourceTypes) - 1; i >= 0; i-- {
+		typ := resourceTypes[i]
+		if len(deletes[typ]) == 0 {
+			continue
+		}
+		if err := g.linear[typ].UpdateResources(nil, deletes[typ]); err != nil {
+			return changed, err
+		}
+	}
+
+	return changed, nil
+}
+
+// Clear 移除分组的资源缓存
+func (c *ResourceCache) Clear(key string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.groups, key)
+}
+
+// Assign 将节点指定到分组，并把节点已打开的 watch 迁移到该分组，
+// 迁移后 LinearCache 按新分组的资源重新响应
+func (c *ResourceCache) Assign(nodeID, key string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	g, ok := c.groups[key]
+	if !ok {
+		return fmt.Errorf("no resources for node group %s", key)
+	}
+	c.assign[nodeID] = key
+
+	for w := range c.watches[nodeID] {
+		if w.key == key {
+			continue
+		}
+		if w.cancel != nil {
+			w.cancel()
+		}
+		cancel, err := w.create(g)
+		if err != nil {
+			return fmt.Errorf("move watch of node %s to group %s: %w", nodeID, key, err)
+		}
+		w.key, w.cancel = key, cancel
+	}
+	return nil
+}
+
+// Unassign 移除节点的分组指定，节点重新按 ClusterHash 分组
+func (c *ResourceCache) Unassign(nodeID string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.assign, nodeID)
+}
+
+// watch 在节点当前所属分组上登记 watch，返回的 cancel 同时注销迁移跟踪
+func (c *ResourceCache) watch(nodeID string, hashKey string, create func(g *resourceGroup) (func(), error)) (func(), error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	key := hashKey
+	if assigned, ok := c.assign[nodeID]; ok {
+		key = assigned
+	}
+	g, ok := c.groups[key]
+	if !ok {
+		return nil, fmt.Errorf("no resources for node group %s", key)
+	}
+	cancel, err := create(g)
+	if err != nil {
+		return nil, err
+	}
+
+	w := &nodeWatch{key: key, cancel: cancel, create: create}
+	if c.watches[nodeID] == nil {
+		c.watches[nodeID] = make(map[*nodeWatch]struct{})
+	}
+	c.watches[nodeID][w] = struct{}{}
+
+	return func() {
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		delete(c.watches[nodeID], w)
+		if len(c.watches[nodeID]) == 0 {
+			delete(c.watches, nodeID)
+		}
+		if w.cancel != nil {
+			w.cancel()
+		}
+	}, nil
+}
+
+func (c *ResourceCache) CreateWatch(request *cache.Request, sub cache.Subscription, value chan cache.Response) (func(), error) {
+	return c.watch(request.GetNode().GetId(), ClusterHash{}.ID(request.GetNode()), func(g *resourceGroup) (func(), error) {
+		return g.mux.CreateWatch(request, sub, value)
+	})
+}
+
+func (c *ResourceCache) CreateDeltaWatch(request *cache.DeltaRequest, sub cache.Subscription, value chan cache.DeltaResponse) (func(), error) {
+	return c.watch(request.GetNode().GetId(), ClusterHash{}.ID(request.GetNode()), func(g *resourceGroup) (func(), error) {
+		return g.mux.CreateDeltaWatch(request, sub, value)
+	})
+}
+
+func (c *ResourceCache) Fetch(context.Context, *cache.Request) (cache.Response, error) {
+	return nil, errors.New("fetch is not supported")
+}
+
+// diffResources 对比已下发资源的内容哈希，返回需要更新与删除的资源
+func diffResources(applied map[string]string, items map[string]types.Resource, versions map[string]string) (map[string]types.Resource, []string) {
+	toUpdate := make(map[string]types.Resource)
+	for name, res := range items {
+		if applied[name] != versions[name] {
+			toUpdate[name] = res
+		}
+	}
+	var toDelete []string
+	for name := range applied {
+		if _, ok := items[name]; !ok {
+			toDelete = append(toDelete, name)
+		}
+	}
+	return toUpdate, toDelete
+}
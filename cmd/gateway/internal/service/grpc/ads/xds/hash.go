@@ -0,0 +1,28 @@
+package xds
+
+import (
+	core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+)
+
+const (
+	// clusterKeyPrefix 按 node.cluster 分组的键前缀
+	clusterKeyPrefix = "cluster/"
+	// nodeKeyPrefix 按 node.id 单独分组的键前缀，与集群分组分属不同键空间，
+	// 避免某个节点的 id 恰好等于另一个集群名时共用同一份快照
+	nodeKeyPrefix = "node/"
+)
+
+// ClusterHash 以 Envoy node.cluster 作为快照分组键，同一集群的节点共享同一份快照；
+// 未声明 cluster 的节点退化为按 node.id 单独分组
+type ClusterHash struct{}
+
+// ID 返回节点所属的快照分组键
+func (ClusterHash) ID(node *core.Node) string {
+	if node == nil {
+		return ""
+	}
+	if node.GetCluster() != "" {
+		return clusterKeyPrefix + node.GetCluster()
+	}
+	return nodeKeyPrefix + node.GetId()
+}
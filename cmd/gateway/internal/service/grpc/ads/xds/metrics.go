@@ -0,0 +1,39 @@
+package xds
+
+import (
+	"github.com/lyonmu/quebec/pkg/constant"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	snapshotBuildFailures = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: string(constant.ProjectName),
+		Subsystem: "gateway",
+		Name:      "snapshot_build_failures_total",
+		Help:      "Number of proxy config revisions rejected while building xDS resources, by offending entity type.",
+	}, []string{"entity"})
+
+	appliedRevision = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: string(constant.ProjectName),
+		Subsystem: "gateway",
+		Name:      "config_applied_revision",
+		Help:      "Proxy config revision currently served to Envoy (last known-good).",
+	})
+
+	configRejected = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: string(constant.ProjectName),
+		Subsystem: "gateway",
+		Name:      "config_rejected",
+		Help:      "1 if the latest proxy config revision from core was rejected, 0 otherwise.",
+	})
+)
+
+// RegisterMetrics 注册快照生成相关指标
+func RegisterMetrics(reg prometheus.Registerer) error {
+	for _, c := range []prometheus.Collector{snapshotBuildFailures, appliedRevision, configRejected} {
+		if err := reg.Register(c); err != nil {
+			return err
+		}
+	}
+	return nil
+}
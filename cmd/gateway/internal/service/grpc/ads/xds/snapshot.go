@@ -0,0 +1,372 @@
+package xds
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	"github.com/envoyproxy/go-control-plane/pkg/cache/v3"
+	"github.com/envoyproxy/go-control-plane/pkg/resource/v3"
+	"github.com/lyonmu/quebec/cmd/gateway/internal/global"
+	v1 "github.com/lyonmu/quebec/idl/router/v1"
+	"github.com/lyonmu/quebec/pkg/envoy"
+)
+
+// canaryGroupSuffix 灰度节点所在分组键的后缀
+const canaryGroupSuffix = "/canary"
+
+// Snapshotter 持有 Core 下发的代理配置，并为连接的 Envoy 节点分组更新 xDS 资源。
+// 集群处于灰度发布时，灰度节点单独分组使用最新修订，其余节点使用稳定修订
+type Snapshotter struct {
+	mu         sync.Mutex
+	cache      *ResourceCache
+	revision   atomic.Int64 // 原子读写，xDS 回调读取修订号时不与配置应用争用锁
+	config     *v1.ProxyConfig
+	rollouts   map[string]*v1.Rollout // 集群 -> 生效中的灰度发布
+	rolloutSeq int64
+	groups     map[string]*nodeGroup
+	members    map[string]*nodeMember // node.id -> 节点所属分组
+
+	// nodeRevisions 节点当前使用的修订号 (node.id -> int64)，供 xDS 回调无锁读取
+	nodeRevisions sync.Map
+	// rateLimitRules 最新修订中合法的全局限流规则，供限流服务无锁读取
+	rateLimitRules atomic.Pointer[[]*v1.RateLimitRule]
+}
+
+// nodeGroup 共享同一份快照的节点分组 (见 ClusterHash)
+type nodeGroup struct {
+	cluster string // 节点声明的 node.cluster，用于筛选下发的资源
+	canary  bool   // 灰度分组，集群灰度期间使用最新修订
+	nodes   int    // 分组内已连接的节点数
+}
+
+// nodeMember 已连接的节点
+type nodeMember struct {
+	cluster string
+	key     string // 所属分组
+	streams int    // 节点打开的 xDS 流数
+}
+
+func NewSnapshotter(resourceCache *ResourceCache) *Snapshotter {
+	return &Snapshotter{
+		cache:    resourceCache,
+		config:   &v1.ProxyConfig{},
+		rollouts: make(map[string]*v1.Rollout),
+		groups:   make(map[string]*nodeGroup),
+		members:  make(map[string]*nodeMember),
+	}
+}
+
+// Revision 返回当前已应用的配置修订号
+func (s *Snapshotter) Revision() int64 {
+	return s.revision.Load()
+}
+
+// NodeRevision 返回节点当前使用的配置修订号，灰度期间非灰度节点为稳定修订号
+func (s *Snapshotter) NodeRevision(nodeID string) int64 {
+	if v, ok := s.nodeRevisions.Load(nodeID); ok {
+		return v.(int64)
+	}
+	return s.revision.Load()
+}
+
+// RateLimitRules 返回最新修订中合法的全局限流规则，灰度期间所有节点共用
+func (s *Snapshotter) RateLimitRules() []*v1.RateLimitRule {
+	if rules := s.rateLimitRules.Load(); rules != nil {
+		return *rules
+	}
+	return nil
+}
+
+// Apply 应用 Core 推送的完整或增量配置及灰度发布状态，并刷新所有已连接节点分组的资源。
+// 配置无法生成合法资源时整体拒绝，继续提供上一个可用的配置
+func (s *Snapshotter) Apply(resp *v1.ConfigSyncResponse) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	config := s.config
+	revision := resp.GetRevision()
+	switch resp.GetType() {
+	case v1.ConfigSyncResponse_FULL:
+		config = resp.GetConfig()
+		if config == nil {
+			config = &v1.ProxyConfig{}
+		}
+	case v1.ConfigSyncResponse_INCREMENTAL:
+		if resp.GetBaseRevision() != s.revision.Load() {
+			return fmt.Errorf("incremental base revision %d does not match applied revision %d", resp.GetBaseRevision(), s.revision.Load())
+		}
+		config = mergeProxyConfig(s.config, resp.GetConfig(), resp.GetRemoved())
+	case v1.ConfigSyncResponse_ROLLOUT:
+		if resp.GetRolloutSeq() < s.rolloutSeq {
+			return nil
+		}
+		revision = s.revision.Load()
+	default:
+		return fmt.Errorf("unknown config sync type: %v", resp.GetType())
+	}
+
+	rollouts := make(map[string]*v1.Rollout, len(resp.GetRollouts()))
+	for _, r := range resp.GetRollouts() {
+		rollouts[r.GetClusterId()] = r
+	}
+
+	groups, assign := s.plan(rollouts)
+	snaps, err := s.render(revision, config, rollouts, groups)
+	if err != nil {
+		snapshotBuildFailures.WithLabelValues(envoy.ErrorEntity(err)).Inc()
+		configRejected.Set(1)
+		global.Logger.Sugar().Errorf("proxy config revision %d rejected, keep serving revision %d: %v", revision, s.revision.Load(), err)
+		return err
+	}
+
+	s.config = config
+	s.revision.Store(revision)
+	s.storeRateLimitRules(config.GetRateLimitRules())
+	s.rollouts = rollouts
+	s.rolloutSeq = resp.GetRolloutSeq()
+	appliedRevision.Set(float64(revision))
+	configRejected.Set(0)
+
+	for key, snap := range snaps {
+		changed, err := s.cache.Update(key, snap)
+		if err != nil {
+			global.Logger.Sugar().Errorf("update resources for group %s failed: %v", key, err)
+			continue
+		}
+		global.Logger.Sugar().Infof("revision %s pushed %d changed resources to group %s", snap.GetVersion(resource.ClusterType), changed, key)
+	}
+
+	// 灰度状态变化后迁移节点，再清理不再使用的分组
+	for id, m := range s.members {
+		key := assign[id]
+		if key != m.key {
+			if err := s.cache.Assign(id, key); err != nil {
+				global.Logger.Sugar().Errorf("move node %s to group %s failed: %v", id, key, err)
+				continue
+			}
+			global.Logger.Sugar().Infof("node %s moved from group %s to %s", id, m.key, key)
+			m.key = key
+		}
+		_, rev := s.groupSource(groups[key], config, revision, rollouts)
+		s.nodeRevisions.Store(id, rev)
+	}
+	for key := range s.groups {
+		if _, ok := groups[key]; !ok {
+			s.cache.Clear(key)
+		}
+	}
+	s.groups = groups
+
+	global.Logger.Sugar().Infof("proxy config revision %d applied to %d node groups, %d rollouts in progress", revision, len(s.groups), len(rollouts))
+	return nil
+}
+
+// plan 根据灰度状态计算每个已连接节点所属的分组
+func (s *Snapshotter) plan(rollouts map[string]*v1.Rollout) (map[string]*nodeGroup, map[string]string) {
+	groups := make(map[string]*nodeGroup, len(s.groups))
+	assign := make(map[string]string, len(s.members))
+	for id, m := range s.members {
+		key, canary := groupKey(id, m.cluster, rollouts)
+		g, ok := groups[key]
+		if !ok {
+			g = &nodeGroup{cluster: m.cluster, canary: canary}
+			groups[key] = g
+		}
+		g.nodes++
+		assign[id] = key
+	}
+	return groups, assign
+}
+
+// render 为所有节点分组生成快照，任一分组失败则整体失败。
+// 未绑定集群的配置对所有分组生效，即使当前没有节点连接也需要校验
+func (s *Snapshotter) render(revision int64, config *v1.ProxyConfig, rollouts map[string]*v1.Rollout, groups map[string]*nodeGroup) (map[string]*cache.Snapshot, error) {
+	if _, err := generateSnapshot(strconv.FormatInt(revision, 10), envoy.ScopeProxyConfig(config, "")); err != nil {
+		return nil, err
+	}
+	if !global.Cfg.Gateway.GlobalRateLimit && envoy.UsesGlobalRateLimit(config) {
+		global.Logger.Sugar().Warnf("revision %d has routes with global rate limit, but global rate limit is disabled on this gateway (--gateway.global_rate_limit), these limits are not enforced", revision)
+	}
+
+	snaps := make(map[string]*cache.Snapshot, len(groups))
+	for key, g := range groups {
+		cfg, rev := s.groupSource(g, config, revision, rollouts)
+		snap, err := generateSnapshot(strconv.FormatInt(rev, 10), envoy.ScopeProxyConfig(cfg, g.cluster))
+		if err != nil {
+			return nil, fmt.Errorf("node group %s: %w", key, err)
+		}
+		snaps[key] = snap
+	}
+	return snaps, nil
+}
+
+// groupSource 返回分组使用的配置与修订号：灰度中的集群，非灰度分组使用稳定修订
+func (s *Snapshotter) groupSource(g *nodeGroup, config *v1.ProxyConfig, revision int64, rollouts map[string]*v1.Rollout) (*v1.ProxyConfig, int64) {
+	if r, ok := rollouts[g.cluster]; ok && g.cluster != "" && !g.canary {
+		return r.GetStableConfig(), r.GetStableRevision()
+	}
+	return config, revision
+}
+
+// groupKey 返回节点所属的分组键：灰度节点使用集群分组键加灰度后缀
+func groupKey(nodeID, cluster string, rollouts map[string]*v1.Rollout) (string, bool) {
+	key := ClusterHash{}.ID(&core.Node{Id: nodeID, Cluster: cluster})
+	if r, ok := rollouts[cluster]; ok && cluster != "" && slices.Contains(r.GetCanaryNodeIds(), nodeID) {
+		return key + canaryGroupSuffix, true
+	}
+	return key, false
+}
+
+// SetNodeSnapshot 使用当前配置为节点所属分组生成快照并写入资源缓存
+func (s *Snapshotter) SetNodeSnapshot(node *core.Node) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if m, ok := s.members[node.GetId()]; ok {
+		// 同一节点的其他 xDS 流复用已有分组
+		m.streams++
+		return nil
+	}
+
+	key, canary := groupKey(node.GetId(), node.GetCluster(), s.rollouts)
+	g, ok := s.groups[key]
+	if !ok {
+		g = &nodeGroup{cluster: node.GetCluster(), canary: canary}
+		cfg, rev := s.groupSource(g, s.config, s.revision.Load(), s.rollouts)
+		snap, err := generateSnapshot(strconv.FormatInt(rev, 10), envoy.ScopeProxyConfig(cfg, node.GetCluster()))
+		if err != nil {
+			snapshotBuildFailures.WithLabelValues(envoy.ErrorEntity(err)).Inc()
+			global.Logger.Sugar().Errorf("generate snapshot for group %s failed: %v", key, err)
+			return err
+		}
+		if _, err := s.cache.Update(key, snap); err != nil {
+			global.Logger.Sugar().Errorf("set resources for group %s failed: %v", key, err)
+			s.cache.Clear(key)
+			return err
+		}
+		s.groups[key] = g
+		global.Logger.Sugar().Infof("snapshot %d set for group %s", rev, key)
+	}
+
+	if err := s.cache.Assign(node.GetId(), key); err != nil {
+		if g.nodes == 0 {
+			delete(s.groups, key)
+			s.cache.Clear(key)
+		}
+		return err
+	}
+	g.nodes++
+	s.members[node.GetId()] = &nodeMember{cluster: node.GetCluster(), key: key, streams: 1}
+	_, rev := s.groupSource(g, s.config, s.revision.Load(), s.rollouts)
+	s.nodeRevisions.Store(node.GetId(), rev)
+
+	global.Logger.Sugar().Infof("node %s joined group %s (%d nodes)", node.GetId(), key, g.nodes)
+	return nil
+}
+
+// ClearNodeSnapshot 节点断开时减少分组引用，分组内无节点时清理快照
+func (s *Snapshotter) ClearNodeSnapshot(node *core.Node) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	m, ok := s.members[node.GetId()]
+	if !ok {
+		return
+	}
+	m.streams--
+	if m.streams > 0 {
+		return
+	}
+	delete(s.members, node.GetId())
+	s.cache.Unassign(node.GetId())
+	s.nodeRevisions.Delete(node.GetId())
+
+	g, ok := s.groups[m.key]
+	if !ok {
+		return
+	}
+	g.nodes--
+	if g.nodes > 0 {
+		return
+	}
+
+	delete(s.groups, m.key)
+	s.cache.Clear(m.key)
+	global.Logger.Sugar().Infof("snapshot cleared for group %s", m.key)
+}
+
+// storeRateLimitRules 保存合法的全局限流规则，不合法的规则被忽略
+func (s *Snapshotter) storeRateLimitRules(rules []*v1.RateLimitRule) {
+	valid := make([]*v1.RateLimitRule, 0, len(rules))
+	for _, r := range rules {
+		if err := envoy.ValidateRateLimitRule(r); err != nil {
+			global.Logger.Sugar().Warnf("ignore rate limit rule %s(%s): %v", r.GetName(), r.GetId(), err)
+			continue
+		}
+		valid = append(valid, r)
+	}
+	s.rateLimitRules.Store(&valid)
+}
+
+// generateSnapshot 按 Gateway 的运行配置渲染快照，debug 日志级别下为监听器开启 gRPC 访问日志，
+// 未启用全局限流时不添加全局限流过滤器
+func generateSnapshot(version string, cfg *v1.ProxyConfig) (*cache.Snapshot, error) {
+	return envoy.GenerateSnapshot(version, cfg, envoy.Options{
+		AccessLog:       strings.ToLower(global.Cfg.Log.Level) == "debug",
+		GlobalRateLimit: global.Cfg.Gateway.GlobalRateLimit,
+	})
+}
+
+// mergeProxyConfig 将增量配置合并到当前配置，返回新的配置
+func mergeProxyConfig(base, changed *v1.ProxyConfig, removed *v1.RemovedResources) *v1.ProxyConfig {
+	return &v1.ProxyConfig{
+		Upstreams:      mergeResources(base.GetUpstreams(), changed.GetUpstreams(), removed.GetUpstreamIds()),
+		HttpRoutes:     mergeResources(base.GetHttpRoutes(), changed.GetHttpRoutes(), removed.GetHttpRouteIds()),
+		L7Listeners:    mergeResources(base.GetL7Listeners(), changed.GetL7Listeners(), removed.GetL7ListenerIds()),
+		Certs:          mergeResources(base.GetCerts(), changed.GetCerts(), removed.GetCertIds()),
+		VirtualHosts:   mergeResources(base.GetVirtualHosts(), changed.GetVirtualHosts(), removed.GetVirtualHostIds()),
+		RateLimitRules: mergeResources(base.GetRateLimitRules(), changed.GetRateLimitRules(), removed.GetRateLimitRuleIds()),
+		ClusterMaintenances: mergeResources(base.GetClusterMaintenances(), changed.GetClusterMaintenances(),
+			removed.GetClusterMaintenanceIds()),
+	}
+}
+
+type identified interface {
+	GetId() string
+}
+
+// mergeResources 按 ID 原位替换变更的资源，追加新增资源并移除被删除的资源
+func mergeResources[T identified](base, changed []T, removed []string) []T {
+	updates := make(map[string]T, len(changed))
+	for _, r := range changed {
+		updates[r.GetId()] = r
+	}
+	drops := make(map[string]struct{}, len(removed))
+	for _, id := range removed {
+		drops[id] = struct{}{}
+	}
+
+	merged := make([]T, 0, len(base)+len(changed))
+	for _, r := range base {
+		if _, ok := drops[r.GetId()]; ok {
+			continue
+		}
+		if u, ok := updates[r.GetId()]; ok {
+			merged = append(merged, u)
+			delete(updates, r.GetId())
+			continue
+		}
+		merged = append(merged, r)
+	}
+	for _, r := range changed {
+		if _, ok := updates[r.GetId()]; ok {
+			merged = append(merged, r)
+		}
+	}
+	return merged
+}
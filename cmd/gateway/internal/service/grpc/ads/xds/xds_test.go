@@ -0,0 +1,145 @@
+package xds
+
+import (
+	"testing"
+
+	core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	"github.com/envoyproxy/go-control-plane/pkg/cache/v3"
+	"github.com/lyonmu/quebec/cmd/gateway/internal/global"
+	v1 "github.com/lyonmu/quebec/idl/router/v1"
+	"go.uber.org/zap"
+)
+
+func TestMergeProxyConfig(t *testing.T) {
+	base := &v1.ProxyConfig{
+		HttpRoutes: []*v1.HttpRoute{
+			{Id: "r1", MatchPattern: "/a"},
+			{Id: "r2", MatchPattern: "/b"},
+			{Id: "r3", MatchPattern: "/c"},
+		},
+		VirtualHosts: []*v1.VirtualHost{{Id: "v1", Domains: []string{"api.example.com"}}},
+	}
+	changed := &v1.ProxyConfig{
+		HttpRoutes: []*v1.HttpRoute{
+			{Id: "r2", MatchPattern: "/b2"},
+			{Id: "r4", MatchPattern: "/d"},
+		},
+	}
+	removed := &v1.RemovedResources{HttpRouteIds: []string{"r1"}}
+
+	merged := mergeProxyConfig(base, changed, removed)
+
+	want := []string{"r2:/b2", "r3:/c", "r4:/d"}
+	if len(merged.HttpRoutes) != len(want) {
+		t.Fatalf("expected %d routes, got %d", len(want), len(merged.HttpRoutes))
+	}
+	for i, r := range merged.HttpRoutes {
+		if got := r.Id + ":" + r.MatchPattern; got != want[i] {
+			t.Fatalf("route %d: expected %s, got %s", i, want[i], got)
+		}
+	}
+	if len(merged.VirtualHosts) != 1 || merged.VirtualHosts[0].Id != "v1" {
+		t.Fatalf("expected unchanged virtual hosts to be kept, got %v", merged.VirtualHosts)
+	}
+}
+
+func TestResourceCacheUpdate(t *testing.T) {
+	global.Logger = zap.NewNop()
+
+	cfg := &v1.ProxyConfig{
+		Upstreams: []*v1.Upstream{
+			{Id: "u1", Hosts: []*v1.UpstreamHost{{Address: "10.0.0.1", Port: 8080, Weight: 1}}},
+			{Id: "u2", Hosts: []*v1.UpstreamHost{{Address: "10.0.0.2", Port: 8080, Weight: 1}}},
+		},
+		L7Listeners: []*v1.L7Listener{{Id: "l1", Port: 10000}},
+	}
+
+	rc := NewResourceCache()
+	if changed, err := rc.Update("edge", mustSnapshot(t, "1", cfg)); err != nil || changed != 6 {
+		t.Fatalf("expected 6 resources on first update, got %d (err: %v)", changed, err)
+	}
+
+	// 仅修改一个上游主机，只应推送该上游的 ClusterLoadAssignment
+	cfg.Upstreams[0].Hosts[0].Weight = 5
+	if changed, err := rc.Update("edge", mustSnapshot(t, "2", cfg)); err != nil || changed != 1 {
+		t.Fatalf("expected 1 changed resource after host edit, got %d (err: %v)", changed, err)
+	}
+
+	cfg.Upstreams = cfg.Upstreams[:1]
+	if changed, err := rc.Update("edge", mustSnapshot(t, "3", cfg)); err != nil || changed != 2 {
+		t.Fatalf("expected cluster and endpoints removed, got %d changes (err: %v)", changed, err)
+	}
+}
+
+func TestSnapshotterRollout(t *testing.T) {
+	global.Logger = zap.NewNop()
+
+	stable := &v1.ProxyConfig{L7Listeners: []*v1.L7Listener{{Id: "l1", Port: 10000}}}
+	s := NewSnapshotter(NewResourceCache())
+	if err := s.Apply(&v1.ConfigSyncResponse{Type: v1.ConfigSyncResponse_FULL, Revision: 1, Config: stable}); err != nil {
+		t.Fatalf("apply full config failed: %v", err)
+	}
+	for _, id := range []string{"a", "b"} {
+		if err := s.SetNodeSnapshot(&core.Node{Id: id, Cluster: "edge"}); err != nil {
+			t.Fatalf("set node %s snapshot failed: %v", id, err)
+		}
+	}
+
+	// 仅灰度节点 a 使用新修订号，b 保持稳定修订号
+	err := s.Apply(&v1.ConfigSyncResponse{
+		Type:         v1.ConfigSyncResponse_INCREMENTAL,
+		Revision:     2,
+		BaseRevision: 1,
+		Config:       &v1.ProxyConfig{L7Listeners: []*v1.L7Listener{{Id: "l2", Port: 10001}}},
+		Rollouts:     []*v1.Rollout{{ClusterId: "edge", Revision: 2, StableRevision: 1, StableConfig: stable, CanaryNodeIds: []string{"a"}}},
+		RolloutSeq:   1,
+	})
+	if err != nil {
+		t.Fatalf("apply incremental config failed: %v", err)
+	}
+	if s.NodeRevision("a") != 2 || s.NodeRevision("b") != 1 {
+		t.Fatalf("expected canary a on 2 and b on 1, got %d and %d", s.NodeRevision("a"), s.NodeRevision("b"))
+	}
+
+	// 灰度结束后所有节点切换到最新修订号
+	if err := s.Apply(&v1.ConfigSyncResponse{Type: v1.ConfigSyncResponse_ROLLOUT, Revision: 2, RolloutSeq: 2}); err != nil {
+		t.Fatalf("apply rollout state failed: %v", err)
+	}
+	if s.NodeRevision("a") != 2 || s.NodeRevision("b") != 2 {
+		t.Fatalf("expected all nodes on 2 after promote, got %d and %d", s.NodeRevision("a"), s.NodeRevision("b"))
+	}
+}
+
+func TestClusterHashKeySpaces(t *testing.T) {
+	global.Logger = zap.NewNop()
+
+	// 未声明 cluster 的节点 id 与集群名相同时，不能落入该集群的分组
+	byCluster := ClusterHash{}.ID(&core.Node{Id: "a", Cluster: "edge"})
+	byNode := ClusterHash{}.ID(&core.Node{Id: "edge"})
+	if byCluster == byNode {
+		t.Fatalf("expected separate keys for cluster and node, both got %s", byCluster)
+	}
+
+	s := NewSnapshotter(NewResourceCache())
+	cfg := &v1.ProxyConfig{L7Listeners: []*v1.L7Listener{{Id: "l1", Port: 10000}}}
+	if err := s.Apply(&v1.ConfigSyncResponse{Type: v1.ConfigSyncResponse_FULL, Revision: 1, Config: cfg}); err != nil {
+		t.Fatalf("apply full config failed: %v", err)
+	}
+	for _, node := range []*core.Node{{Id: "a", Cluster: "edge"}, {Id: "edge"}} {
+		if err := s.SetNodeSnapshot(node); err != nil {
+			t.Fatalf("set node %s snapshot failed: %v", node.GetId(), err)
+		}
+	}
+	if len(s.groups) != 2 || s.members["a"].key == s.members["edge"].key {
+		t.Fatalf("expected nodes in separate groups, got %d groups", len(s.groups))
+	}
+}
+
+func mustSnapshot(t *testing.T, version string, cfg *v1.ProxyConfig) *cache.Snapshot {
+	t.Helper()
+	snap, err := generateSnapshot(version, cfg)
+	if err != nil {
+		t.Fatalf("generate snapshot failed: %v", err)
+	}
+	return snap
+}
@@ -5,17 +5,24 @@ import (
 	"io"
 
 	"github.com/lyonmu/quebec/cmd/gateway/internal/global"
+	"github.com/lyonmu/quebec/cmd/gateway/internal/service/grpc/node"
 	"github.com/lyonmu/quebec/pkg/mq/kafka"
 
+	core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	accesslogv3 "github.com/envoyproxy/go-control-plane/envoy/service/accesslog/v3"
 	"google.golang.org/grpc"
 	"google.golang.org/protobuf/proto"
 )
 
-type AlsSvc struct{}
+type AlsSvc struct {
+	traffic *trafficCounter
+}
 
-func NewAlsSvc() *AlsSvc {
-	return &AlsSvc{}
+// NewAlsSvc 创建访问日志服务，并通过 syncer 向 Core 上报各节点的流量统计
+func NewAlsSvc(syncer *node.CoreSyncer) *AlsSvc {
+	traffic := newTrafficCounter()
+	go traffic.run(syncer)
+	return &AlsSvc{traffic: traffic}
 }
 
 func (a *AlsSvc) Register(server *grpc.Server) error {
@@ -27,6 +34,9 @@ func (a *AlsSvc) Register(server *grpc.Server) error {
 func (a *AlsSvc) StreamAccessLogs(stream accesslogv3.AccessLogService_StreamAccessLogsServer) error {
 	ctx := stream.Context()
 
+	// 流的首条消息携带 Envoy 节点标识
+	var envoyNode *core.Node
+
 	for {
 		in, err := stream.Recv()
 		if err == io.EOF {
@@ -40,6 +50,13 @@ func (a *AlsSvc) StreamAccessLogs(stream accesslogv3.AccessLogService_StreamAcce
 			return fmt.Errorf("als event recv error: %v", err)
 		}
 
+		if id := in.GetIdentifier(); id != nil {
+			envoyNode = id.GetNode()
+		}
+		if logs := in.GetHttpLogs(); logs != nil {
+			a.traffic.record(envoyNode, logs)
+		}
+
 		// 发送 proto 二进制数据到 Kafka
 		if global.AlsKafkaProducer != nil {
 			data, err := proto.Marshal(in)
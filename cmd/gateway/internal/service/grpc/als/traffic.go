@@ -0,0 +1,80 @@
+package als
+
+import (
+	"sync"
+	"time"
+
+	core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	accesslogv3 "github.com/envoyproxy/go-control-plane/envoy/service/accesslog/v3"
+	"github.com/lyonmu/quebec/cmd/gateway/internal/service/grpc/node"
+	v1pb "github.com/lyonmu/quebec/idl/node/v1"
+)
+
+// trafficReportInterval 节点流量统计上报 Core 的周期
+const trafficReportInterval = 10 * time.Second
+
+// trafficCounter 按节点累计上报周期内的请求数与 5xx 响应数，Core 据此评估灰度发布的错误率
+type trafficCounter struct {
+	mu    sync.Mutex
+	nodes map[string]*nodeTraffic
+}
+
+type nodeTraffic struct {
+	cluster  string
+	requests int64
+	errors   int64
+}
+
+func newTrafficCounter() *trafficCounter {
+	return &trafficCounter{nodes: make(map[string]*nodeTraffic)}
+}
+
+// record 统计一批 HTTP 访问日志
+func (t *trafficCounter) record(node *core.Node, logs *accesslogv3.StreamAccessLogsMessage_HTTPAccessLogEntries) {
+	if node == nil || len(logs.GetLogEntry()) == 0 {
+		return
+	}
+
+	var errors int64
+	for _, entry := range logs.GetLogEntry() {
+		if entry.GetResponse().GetResponseCode().GetValue() >= 500 {
+			errors++
+		}
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	n, ok := t.nodes[node.GetId()]
+	if !ok {
+		n = &nodeTraffic{cluster: node.GetCluster()}
+		t.nodes[node.GetId()] = n
+	}
+	n.requests += int64(len(logs.GetLogEntry()))
+	n.errors += errors
+}
+
+// run 周期性地将各节点的增量统计上报 Core
+func (t *trafficCounter) run(syncer *node.CoreSyncer) {
+	ticker := time.NewTicker(trafficReportInterval)
+	defer ticker.Stop()
+
+	for now := range ticker.C {
+		t.mu.Lock()
+		nodes := t.nodes
+		t.nodes = make(map[string]*nodeTraffic, len(nodes))
+		t.mu.Unlock()
+
+		for id, n := range nodes {
+			syncer.PushEvent(&v1pb.EnvoyStatusEvent{
+				Event:     v1pb.EnvoyStatusEvent_TRAFFIC,
+				NodeId:    id,
+				ClusterId: n.cluster,
+				Traffic: &v1pb.TrafficStats{
+					Requests:  n.requests,
+					Errors:    n.errors,
+					Timestamp: now.Unix(),
+				},
+			})
+		}
+	}
+}
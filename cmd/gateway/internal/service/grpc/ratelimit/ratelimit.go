@@ -0,0 +1,161 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	ratelimitcommon "github.com/envoyproxy/go-control-plane/envoy/extensions/common/ratelimit/v3"
+	rlsv3 "github.com/envoyproxy/go-control-plane/envoy/service/ratelimit/v3"
+	"github.com/lyonmu/quebec/cmd/gateway/internal/global"
+	v1 "github.com/lyonmu/quebec/idl/router/v1"
+	"github.com/lyonmu/quebec/pkg/constant"
+	"github.com/lyonmu/quebec/pkg/envoy"
+	"github.com/redis/go-redis/v9"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// counterKeyPrefix Redis 中全局限流计数的键前缀
+const counterKeyPrefix = "quebec:gateway:rate_limit"
+
+// unitDurations 计数周期对应的窗口长度
+var unitDurations = map[constant.ProxyRateLimitUnit]time.Duration{
+	constant.RateLimitUnitSecond: time.Second,
+	constant.RateLimitUnitMinute: time.Minute,
+	constant.RateLimitUnitHour:   time.Hour,
+	constant.RateLimitUnitDay:    24 * time.Hour,
+}
+
+// RuleSource 提供 Core 下发的全局限流规则
+type RuleSource interface {
+	RateLimitRules() []*v1.RateLimitRule
+}
+
+// RateLimitSvc 实现 Envoy 全局限流服务，按固定窗口在 Redis 中计数，所有 Gateway 实例共享计数
+type RateLimitSvc struct {
+	rules  RuleSource
+	client redis.UniversalClient
+	now    func() time.Time
+}
+
+func NewRateLimitSvc(rules RuleSource, client redis.UniversalClient) *RateLimitSvc {
+	return &RateLimitSvc{rules: rules, client: client, now: time.Now}
+}
+
+func (s *RateLimitSvc) Register(server *grpc.Server) error {
+	rlsv3.RegisterRateLimitServiceServer(server, s)
+	global.Logger.Sugar().Info("rate limit service registered")
+	return nil
+}
+
+// ShouldRateLimit 实现 rlsv3.RateLimitServiceServer 接口，任一描述符超出限制时整体返回 OVER_LIMIT。
+// Redis 不可用时返回错误，由 Envoy 按 failure_mode_deny 放行请求
+func (s *RateLimitSvc) ShouldRateLimit(ctx context.Context, req *rlsv3.RateLimitRequest) (*rlsv3.RateLimitResponse, error) {
+	resp := &rlsv3.RateLimitResponse{OverallCode: rlsv3.RateLimitResponse_OK}
+	if req.GetDomain() != envoy.RateLimitDomain {
+		return resp, nil
+	}
+
+	hits := uint64(req.GetHitsAddend())
+	if hits == 0 {
+		hits = 1
+	}
+	rules := s.rules.RateLimitRules()
+	now := s.now()
+	for _, d := range req.GetDescriptors() {
+		st := &rlsv3.RateLimitResponse_DescriptorStatus{Code: rlsv3.RateLimitResponse_OK}
+		resp.Statuses = append(resp.Statuses, st)
+
+		rule := matchRule(rules, d.GetEntries())
+		if rule == nil {
+			continue
+		}
+		addend := hits
+		if d.GetHitsAddend() != nil {
+			addend = d.GetHitsAddend().GetValue()
+		}
+
+		window := unitDurations[constant.ProxyRateLimitUnit(rule.GetUnit())]
+		start := now.Truncate(window)
+		used, err := s.incr(ctx, counterKey(rule, d.GetEntries(), start), addend, window)
+		if err != nil {
+			global.Logger.Sugar().Errorf("count rate limit rule %s(%s) failed: %v", rule.GetName(), rule.GetId(), err)
+			return nil, status.Errorf(codes.Unavailable, "rate limit counter unavailable: %v", err)
+		}
+
+		limit := uint64(rule.GetRequestsPerUnit())
+		st.CurrentLimit = &rlsv3.RateLimitResponse_RateLimit{
+			Name:            rule.GetName(),
+			RequestsPerUnit: rule.GetRequestsPerUnit(),
+			Unit:            rlsv3.RateLimitResponse_RateLimit_Unit(rule.GetUnit()),
+		}
+		st.DurationUntilReset = durationpb.New(start.Add(window).Sub(now))
+		if used > limit {
+			st.Code = rlsv3.RateLimitResponse_OVER_LIMIT
+			resp.OverallCode = rlsv3.RateLimitResponse_OVER_LIMIT
+			continue
+		}
+		st.LimitRemaining = uint32(limit - used)
+	}
+	return resp, nil
+}
+
+// incr 增加窗口计数并返回增加后的值。过期时间只在首次计数时设置，计数键在窗口结束后过期
+func (s *RateLimitSvc) incr(ctx context.Context, key string, hits uint64, window time.Duration) (uint64, error) {
+	var incr *redis.IntCmd
+	_, err := s.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		incr = pipe.IncrBy(ctx, key, int64(hits))
+		pipe.ExpireNX(ctx, key, window)
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return uint64(incr.Val()), nil
+}
+
+// matchRule 返回与描述符匹配的规则：条目数量与键的顺序一致，规则中的值为空时匹配任意值。
+// 多条规则匹配时取指定值最多的规则，数量相同时取先创建的规则
+func matchRule(rules []*v1.RateLimitRule, entries []*ratelimitcommon.RateLimitDescriptor_Entry) *v1.RateLimitRule {
+	var (
+		best      *v1.RateLimitRule
+		bestFixed = -1
+	)
+next:
+	for _, r := range rules {
+		if len(r.GetDescriptors()) != len(entries) {
+			continue
+		}
+		fixed := 0
+		for i, d := range r.GetDescriptors() {
+			if d.GetKey() != entries[i].GetKey() {
+				continue next
+			}
+			if d.GetValue() != "" {
+				if d.GetValue() != entries[i].GetValue() {
+					continue next
+				}
+				fixed++
+			}
+		}
+		if fixed > bestFixed {
+			best, bestFixed = r, fixed
+		}
+	}
+	return best
+}
+
+// counterKey 返回规则在窗口内的计数键，规则中值为空的条目按请求的值分别计数
+func counterKey(r *v1.RateLimitRule, entries []*ratelimitcommon.RateLimitDescriptor_Entry, start time.Time) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s:%s", counterKeyPrefix, r.GetId())
+	for _, e := range entries {
+		fmt.Fprintf(&b, ":%s=%s", e.GetKey(), e.GetValue())
+	}
+	fmt.Fprintf(&b, ":%d", start.Unix())
+	return b.String()
+}
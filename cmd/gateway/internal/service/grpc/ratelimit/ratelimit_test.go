@@ -0,0 +1,118 @@
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	ratelimitcommon "github.com/envoyproxy/go-control-plane/envoy/extensions/common/ratelimit/v3"
+	rlsv3 "github.com/envoyproxy/go-control-plane/envoy/service/ratelimit/v3"
+	"github.com/lyonmu/quebec/cmd/gateway/internal/global"
+	v1 "github.com/lyonmu/quebec/idl/router/v1"
+	"github.com/lyonmu/quebec/pkg/constant"
+	"github.com/lyonmu/quebec/pkg/envoy"
+	"github.com/redis/go-redis/v9"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type staticRules []*v1.RateLimitRule
+
+func (r staticRules) RateLimitRules() []*v1.RateLimitRule {
+	return r
+}
+
+func TestMatchRule(t *testing.T) {
+	rules := []*v1.RateLimitRule{
+		{Id: "any-ip", Descriptors: []*v1.RateLimitDescriptor{{Key: "generic_key", Value: "api"}, {Key: "remote_address"}}},
+		{Id: "one-ip", Descriptors: []*v1.RateLimitDescriptor{{Key: "generic_key", Value: "api"}, {Key: "remote_address", Value: "10.0.0.1"}}},
+		{Id: "api", Descriptors: []*v1.RateLimitDescriptor{{Key: "generic_key", Value: "api"}}},
+	}
+	entries := func(ip string) []*ratelimitcommon.RateLimitDescriptor_Entry {
+		return []*ratelimitcommon.RateLimitDescriptor_Entry{{Key: "generic_key", Value: "api"}, {Key: "remote_address", Value: ip}}
+	}
+
+	if r := matchRule(rules, entries("10.0.0.1")); r == nil || r.Id != "one-ip" {
+		t.Fatalf("expected the most specific rule one-ip, got %v", r)
+	}
+	if r := matchRule(rules, entries("10.0.0.2")); r == nil || r.Id != "any-ip" {
+		t.Fatalf("expected wildcard rule any-ip, got %v", r)
+	}
+	if r := matchRule(rules, []*ratelimitcommon.RateLimitDescriptor_Entry{{Key: "generic_key", Value: "web"}}); r != nil {
+		t.Fatalf("expected no rule for a different value, got %s", r.Id)
+	}
+
+	start := time.Unix(1700000000, 0)
+	want := "quebec:gateway:rate_limit:any-ip:generic_key=api:remote_address=10.0.0.2:1700000000"
+	if got := counterKey(rules[0], entries("10.0.0.2"), start); got != want {
+		t.Fatalf("expected counter key %s, got %s", want, got)
+	}
+}
+
+func TestShouldRateLimit(t *testing.T) {
+	global.Logger = zap.NewNop()
+
+	mr := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: mr.Addr(), MaxRetries: -1})
+	defer client.Close()
+
+	rule := &v1.RateLimitRule{
+		Id:              "api",
+		Name:            "api",
+		Descriptors:     []*v1.RateLimitDescriptor{{Key: "generic_key", Value: "api"}},
+		Unit:            int32(constant.RateLimitUnitMinute),
+		RequestsPerUnit: 2,
+	}
+	now := time.Unix(1700000040, 0)
+	svc := NewRateLimitSvc(staticRules{rule}, client)
+	svc.now = func() time.Time { return now }
+
+	entries := []*ratelimitcommon.RateLimitDescriptor_Entry{{Key: "generic_key", Value: "api"}}
+	req := &rlsv3.RateLimitRequest{
+		Domain:      envoy.RateLimitDomain,
+		Descriptors: []*ratelimitcommon.RateLimitDescriptor{{Entries: entries}},
+	}
+	check := func(want rlsv3.RateLimitResponse_Code) *rlsv3.RateLimitResponse {
+		t.Helper()
+		resp, err := svc.ShouldRateLimit(context.Background(), req)
+		if err != nil {
+			t.Fatalf("should rate limit failed: %v", err)
+		}
+		if resp.GetOverallCode() != want {
+			t.Fatalf("expected %s, got %s", want, resp.GetOverallCode())
+		}
+		return resp
+	}
+
+	// 首次计数时设置过期时间，后续计数不再延长
+	key := counterKey(rule, entries, now.Truncate(time.Minute))
+	if resp := check(rlsv3.RateLimitResponse_OK); resp.GetStatuses()[0].GetLimitRemaining() != 1 {
+		t.Fatalf("expected 1 remaining, got %d", resp.GetStatuses()[0].GetLimitRemaining())
+	}
+	if ttl := mr.TTL(key); ttl != time.Minute {
+		t.Fatalf("expected ttl %s after the first hit, got %s", time.Minute, ttl)
+	}
+	mr.FastForward(10 * time.Second)
+	check(rlsv3.RateLimitResponse_OK)
+	if ttl := mr.TTL(key); ttl != 50*time.Second {
+		t.Fatalf("expected ttl to keep counting down, got %s", ttl)
+	}
+
+	// 同一窗口内超出限制，下一个窗口重新计数
+	check(rlsv3.RateLimitResponse_OVER_LIMIT)
+	now = now.Add(time.Minute)
+	check(rlsv3.RateLimitResponse_OK)
+
+	// 其他域的请求不计数
+	if resp, err := svc.ShouldRateLimit(context.Background(), &rlsv3.RateLimitRequest{Domain: "other", Descriptors: req.Descriptors}); err != nil || resp.GetOverallCode() != rlsv3.RateLimitResponse_OK {
+		t.Fatalf("expected other domain to pass, got %v, %v", resp, err)
+	}
+
+	// Redis 不可用时返回 Unavailable，由 Envoy 决定是否放行
+	mr.Close()
+	if _, err := svc.ShouldRateLimit(context.Background(), req); status.Code(err) != codes.Unavailable {
+		t.Fatalf("expected unavailable, got %v", err)
+	}
+}
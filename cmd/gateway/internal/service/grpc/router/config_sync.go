@@ -0,0 +1,106 @@
+package router
+
+import (
+	"context"
+	"time"
+
+	"github.com/lyonmu/quebec/cmd/gateway/internal/global"
+	v1 "github.com/lyonmu/quebec/idl/router/v1"
+
+	"google.golang.org/grpc"
+)
+
+// ConfigApplier 应用 Core 推送的代理配置
+type ConfigApplier interface {
+	// Revision 返回当前已应用的修订号
+	Revision() int64
+	// Apply 应用完整或增量配置，返回错误时将向 Core 回执 NACK
+	Apply(*v1.ConfigSyncResponse) error
+}
+
+// ConfigSyncer 负责与 Core 保持配置同步长连接，接收配置并回执已应用的修订号
+type ConfigSyncer struct {
+	client    v1.RouterConfigClient
+	applier   ConfigApplier
+	gatewayID int64
+	ctx       context.Context
+	cancel    context.CancelFunc
+}
+
+func NewConfigSyncer(conn *grpc.ClientConn, gatewayID int64, applier ConfigApplier) *ConfigSyncer {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &ConfigSyncer{
+		client:    v1.NewRouterConfigClient(conn),
+		applier:   applier,
+		gatewayID: gatewayID,
+		ctx:       ctx,
+		cancel:    cancel,
+	}
+}
+
+// Start 启动后台同步循环
+func (s *ConfigSyncer) Start() {
+	go s.runLoop()
+}
+
+// Stop 停止同步
+func (s *ConfigSyncer) Stop() {
+	s.cancel()
+}
+
+func (s *ConfigSyncer) runLoop() {
+	for {
+		select {
+		case <-s.ctx.Done():
+			return
+		default:
+		}
+
+		if err := s.sync(); err != nil {
+			global.Logger.Sugar().Errorf("Config sync with core failed: %v, retrying in 5s...", err)
+			select {
+			case <-time.After(5 * time.Second):
+			case <-s.ctx.Done():
+				return
+			}
+		}
+	}
+}
+
+// sync 建立一次同步流，直到流断开
+func (s *ConfigSyncer) sync() error {
+	global.Logger.Sugar().Info("Connecting to core for config sync ...")
+	stream, err := s.client.ConfigSync(s.ctx)
+	if err != nil {
+		return err
+	}
+
+	// 首包：标识 Gateway 并携带当前修订号及是否提供全局限流服务
+	if err := stream.Send(&v1.ConfigSyncRequest{
+		GatewayId:       s.gatewayID,
+		Revision:        s.applier.Revision(),
+		GlobalRateLimit: global.Cfg.Gateway.GlobalRateLimit,
+	}); err != nil {
+		return err
+	}
+
+	for {
+		resp, err := stream.Recv()
+		if err != nil {
+			return err
+		}
+
+		ack := &v1.ConfigSyncRequest{
+			GatewayId: s.gatewayID,
+			Revision:  resp.GetRevision(),
+		}
+		if err := s.applier.Apply(resp); err != nil {
+			global.Logger.Sugar().Errorf("apply proxy config revision %d failed: %v", resp.GetRevision(), err)
+			ack.ErrorDetail = err.Error()
+		}
+
+		if err := stream.Send(ack); err != nil {
+			return err
+		}
+	}
+}
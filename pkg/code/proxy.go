@@ -0,0 +1,140 @@
+package code
+
+var (
+	// 网关集群相关
+	GatewayClusterQueryFailed = Response{Code: 52001, Message: "网关集群查询失败"}
+
+	// 监听器相关
+	ListenerNotExists  = Response{Code: 52002, Message: "监听器不存在"}
+	ListenerBindFailed = Response{Code: 52003, Message: "监听器绑定集群失败"}
+
+	// 路由相关
+	HttpRouteNotExists  = Response{Code: 52004, Message: "路由不存在"}
+	HttpRouteBindFailed = Response{Code: 52005, Message: "路由绑定集群失败"}
+
+	// 网关节点相关
+	GatewayNodeQueryFailed = Response{Code: 52006, Message: "网关节点查询失败"}
+
+	// 网关实例相关
+	GatewayQueryFailed = Response{Code: 52007, Message: "网关查询失败"}
+
+	// 配置修订相关
+	RevisionQueryFailed    = Response{Code: 52008, Message: "配置修订查询失败"}
+	RevisionNotExists      = Response{Code: 52009, Message: "配置修订不存在"}
+	RevisionDiffFailed     = Response{Code: 52010, Message: "配置修订比较失败"}
+	RevisionRollbackFailed = Response{Code: 52011, Message: "配置修订回滚失败"}
+	RevisionCertChanged    = Response{Code: 52012, Message: "修订引用的证书已删除或内容已变更，无法回滚"}
+
+	// 灰度发布相关
+	RolloutPolicyQueryFailed = Response{Code: 52013, Message: "灰度策略查询失败"}
+	RolloutPolicySaveFailed  = Response{Code: 52014, Message: "灰度策略保存失败"}
+	RolloutPolicyNotExists   = Response{Code: 52015, Message: "灰度策略不存在"}
+	RolloutPolicyDelFailed   = Response{Code: 52016, Message: "灰度策略删除失败"}
+	RolloutQueryFailed       = Response{Code: 52017, Message: "灰度发布查询失败"}
+	RolloutNotExists         = Response{Code: 52018, Message: "灰度发布不存在"}
+	RolloutUpdateFailed      = Response{Code: 52019, Message: "灰度发布更新失败"}
+	RolloutStatusNotAllow    = Response{Code: 52020, Message: "灰度发布当前状态不允许该操作"}
+
+	// 配置预览相关
+	GatewayNodeNotExists = Response{Code: 52021, Message: "网关节点不存在"}
+	ProxyRenderFailed    = Response{Code: 52022, Message: "代理配置渲染失败"}
+
+	// Envoy 接入相关
+	ProxyBootstrapFailed = Response{Code: 52023, Message: "Envoy bootstrap 生成失败"}
+
+	// 路由加权分流相关
+	HttpRouteTargetQueryFailed = Response{Code: 52024, Message: "路由分流目标查询失败"}
+	HttpRouteTargetSaveFailed  = Response{Code: 52025, Message: "路由分流目标保存失败"}
+	HttpRouteTargetInvalid     = Response{Code: 52026, Message: "分流目标的权重之和必须为100，且上游服务不能重复"}
+	HttpRouteTargetUpstream    = Response{Code: 52027, Message: "分流目标的上游服务不存在或已禁用"}
+
+	// 路由匹配条件相关
+	HttpRouteMatchQueryFailed = Response{Code: 52028, Message: "路由匹配条件查询失败"}
+	HttpRouteMatchSaveFailed  = Response{Code: 52029, Message: "路由匹配条件保存失败"}
+	HttpRouteMatchInvalid     = Response{Code: 52030, Message: "路由匹配条件不合法"}
+
+	// 路由路径匹配、重写与重定向相关
+	HttpRoutePathQueryFailed = Response{Code: 52031, Message: "路由路径设置查询失败"}
+	HttpRoutePathSaveFailed  = Response{Code: 52032, Message: "路由路径设置保存失败"}
+	HttpRoutePathInvalid     = Response{Code: 52033, Message: "路由路径匹配、重写或重定向设置不合法"}
+
+	// 路由重试策略相关
+	HttpRouteRetryQueryFailed = Response{Code: 52034, Message: "路由重试策略查询失败"}
+	HttpRouteRetrySaveFailed  = Response{Code: 52035, Message: "路由重试策略保存失败"}
+	HttpRouteRetryInvalid     = Response{Code: 52036, Message: "路由重试策略不合法"}
+
+	// 虚拟主机相关
+	VirtualHostQueryFailed    = Response{Code: 52037, Message: "虚拟主机查询失败"}
+	VirtualHostNotExists      = Response{Code: 52038, Message: "虚拟主机不存在"}
+	VirtualHostSaveFailed     = Response{Code: 52039, Message: "虚拟主机保存失败"}
+	VirtualHostDelFailed      = Response{Code: 52040, Message: "虚拟主机删除失败"}
+	VirtualHostDomainInvalid  = Response{Code: 52041, Message: "虚拟主机域名不合法"}
+	VirtualHostDomainConflict = Response{Code: 52042, Message: "虚拟主机域名与同一监听器的其他虚拟主机冲突"}
+	VirtualHostInUse          = Response{Code: 52043, Message: "虚拟主机下仍有路由，无法删除"}
+	HttpRouteBindVhostFailed  = Response{Code: 52044, Message: "路由绑定虚拟主机失败"}
+
+	// 请求头/响应头改写相关
+	HttpRouteHeaderQueryFailed = Response{Code: 52045, Message: "路由头改写规则查询失败"}
+	HttpRouteHeaderSaveFailed  = Response{Code: 52046, Message: "路由头改写规则保存失败"}
+	HeaderMutationInvalid      = Response{Code: 52047, Message: "请求头/响应头改写规则不合法"}
+
+	// 流量镜像相关
+	HttpRouteMirrorQueryFailed = Response{Code: 52048, Message: "路由流量镜像查询失败"}
+	HttpRouteMirrorSaveFailed  = Response{Code: 52049, Message: "路由流量镜像保存失败"}
+	HttpRouteMirrorInvalid     = Response{Code: 52050, Message: "影子上游不能是路由的转发目标，镜像比例需在 0~100 之间"}
+	HttpRouteMirrorUpstream    = Response{Code: 52051, Message: "影子上游服务不存在或已禁用"}
+
+	// 故障注入相关
+	HttpRouteFaultQueryFailed = Response{Code: 52052, Message: "路由故障注入查询失败"}
+	HttpRouteFaultSaveFailed  = Response{Code: 52053, Message: "路由故障注入保存失败"}
+	HttpRouteFaultInvalid     = Response{Code: 52054, Message: "路由故障注入不合法"}
+
+	// 跨域策略相关
+	HttpRouteCorsQueryFailed = Response{Code: 52055, Message: "路由跨域策略查询失败"}
+	HttpRouteCorsSaveFailed  = Response{Code: 52056, Message: "路由跨域策略保存失败"}
+	CorsPolicyInvalid        = Response{Code: 52057, Message: "跨域策略不合法"}
+
+	// 本地限流相关
+	LocalRateLimitQueryFailed = Response{Code: 52058, Message: "本地限流查询失败"}
+	LocalRateLimitSaveFailed  = Response{Code: 52059, Message: "本地限流保存失败"}
+	LocalRateLimitInvalid     = Response{Code: 52060, Message: "本地限流不合法，补充间隔不小于 50 毫秒，每次补充的令牌数不能超过令牌桶容量"}
+
+	// 全局限流相关
+	RateLimitRuleQueryFailed    = Response{Code: 52061, Message: "全局限流规则查询失败"}
+	RateLimitRuleNotExists      = Response{Code: 52062, Message: "全局限流规则不存在"}
+	RateLimitRuleSaveFailed     = Response{Code: 52063, Message: "全局限流规则保存失败"}
+	RateLimitRuleDelFailed      = Response{Code: 52064, Message: "全局限流规则删除失败"}
+	RateLimitRuleInvalid        = Response{Code: 52065, Message: "全局限流规则不合法"}
+	RateLimitActionsQueryFailed = Response{Code: 52066, Message: "路由全局限流描述符查询失败"}
+	RateLimitActionsSaveFailed  = Response{Code: 52067, Message: "路由全局限流描述符保存失败"}
+	RateLimitActionsInvalid     = Response{Code: 52068, Message: "路由全局限流描述符不合法"}
+
+	// 路由匹配顺序相关
+	HttpRouteConflict            = Response{Code: 52069, Message: "路由与同一虚拟主机下的其他路由相互覆盖，被覆盖的路由永远不会命中"}
+	HttpRoutePriorityQueryFailed = Response{Code: 52070, Message: "路由匹配优先级查询失败"}
+	HttpRoutePrioritySaveFailed  = Response{Code: 52071, Message: "路由匹配优先级保存失败"}
+
+	// 直接响应与维护模式相关
+	DirectResponseQueryFailed = Response{Code: 52072, Message: "路由直接响应查询失败"}
+	DirectResponseSaveFailed  = Response{Code: 52073, Message: "路由直接响应保存失败"}
+	DirectResponseInvalid     = Response{Code: 52074, Message: "直接响应不合法，状态码需在 200-599 之间，响应体不超过 4KB"}
+	DirectResponseRedirect    = Response{Code: 52075, Message: "路由的重定向与直接响应不能同时开启"}
+	MaintenanceQueryFailed    = Response{Code: 52076, Message: "维护模式查询失败"}
+	MaintenanceSaveFailed     = Response{Code: 52077, Message: "维护模式保存失败"}
+	MaintenanceInvalid        = Response{Code: 52078, Message: "维护模式不合法，放行路径需以 / 开头，放行地址需为 IP 或 CIDR"}
+	GatewayClusterNotExists   = Response{Code: 52079, Message: "网关集群不存在"}
+
+	// 一致性哈希相关
+	HashPolicyQueryFailed = Response{Code: 52080, Message: "路由哈希策略查询失败"}
+	HashPolicySaveFailed  = Response{Code: 52081, Message: "路由哈希策略保存失败"}
+	HashPolicyInvalid     = Response{Code: 52082, Message: "路由哈希策略不合法，请求头、Cookie 与查询参数需要名称，只有 Cookie 可以设置有效期与路径"}
+	UpstreamNotExists     = Response{Code: 52083, Message: "上游服务不存在"}
+	UpstreamLbQueryFailed = Response{Code: 52084, Message: "上游服务负载均衡设置查询失败"}
+	UpstreamLbSaveFailed  = Response{Code: 52085, Message: "上游服务负载均衡设置保存失败"}
+	UpstreamLbInvalid     = Response{Code: 52086, Message: "上游服务负载均衡设置不合法，环大小不超过 8388608 且最小值不大于最大值，Maglev 查找表大小需为不超过 5000011 的质数"}
+
+	// 响应压缩相关
+	CompressionQueryFailed = Response{Code: 52087, Message: "响应压缩查询失败"}
+	CompressionSaveFailed  = Response{Code: 52088, Message: "响应压缩保存失败"}
+	CompressionInvalid     = Response{Code: 52089, Message: "响应压缩不合法，压缩算法不能重复，压缩级别不超过 9，响应类型需为 type/subtype 格式"}
+)
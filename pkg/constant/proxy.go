@@ -21,6 +21,25 @@ const (
 	LbPolicyMaglev       ProxyLbPolicy = 5 // Maglev (一致性哈希)
 )
 
+// 一致性哈希的哈希策略类型
+type ProxyHashPolicyType int8
+
+const (
+	HashPolicyHeader         ProxyHashPolicyType = 1 // 请求头的值
+	HashPolicyCookie         ProxyHashPolicyType = 2 // Cookie 的值，可由 Envoy 生成
+	HashPolicySourceIP       ProxyHashPolicyType = 3 // 客户端地址
+	HashPolicyQueryParameter ProxyHashPolicyType = 4 // 查询参数的值
+)
+
+// 响应压缩算法
+type ProxyCompressionAlgorithm int8
+
+const (
+	CompressionGzip   ProxyCompressionAlgorithm = 1 // gzip
+	CompressionBrotli ProxyCompressionAlgorithm = 2 // brotli
+	CompressionZstd   ProxyCompressionAlgorithm = 3 // zstd
+)
+
 // HTTP路由匹配类型
 type ProxyHttpRouteMatchType int8
 
@@ -30,6 +49,54 @@ const (
 	HttpRouteMatchTypeRegex  ProxyHttpRouteMatchType = 3 // 正则匹配
 )
 
+// HTTP路由请求头/查询参数匹配方式
+type ProxyHttpMatcherType int8
+
+const (
+	HttpMatcherTypeExact   ProxyHttpMatcherType = 1 // 精确匹配
+	HttpMatcherTypeRegex   ProxyHttpMatcherType = 2 // 正则匹配
+	HttpMatcherTypePresent ProxyHttpMatcherType = 3 // 存在即匹配
+	HttpMatcherTypeAbsent  ProxyHttpMatcherType = 4 // 不存在时匹配 (仅请求头)
+)
+
+// 请求头/响应头添加方式
+type ProxyHeaderAction int8
+
+const (
+	HeaderActionAppend      ProxyHeaderAction = 1 // 已存在时追加，否则添加
+	HeaderActionOverwrite   ProxyHeaderAction = 2 // 已存在时覆盖，否则添加
+	HeaderActionAddIfAbsent ProxyHeaderAction = 3 // 仅在不存在时添加
+)
+
+// CORS 允许来源的匹配方式
+type ProxyCorsOriginType int8
+
+const (
+	CorsOriginExact  ProxyCorsOriginType = 1 // 精确匹配，* 表示允许所有来源
+	CorsOriginPrefix ProxyCorsOriginType = 2 // 前缀匹配
+	CorsOriginRegex  ProxyCorsOriginType = 3 // 正则匹配
+)
+
+// 全局限流描述符的生成方式，对应 Envoy route.RateLimit.Action
+type ProxyRateLimitActionType int8
+
+const (
+	RateLimitActionGenericKey     ProxyRateLimitActionType = 1 // 固定键值
+	RateLimitActionRequestHeader  ProxyRateLimitActionType = 2 // 请求头的值
+	RateLimitActionRemoteAddress  ProxyRateLimitActionType = 3 // 客户端地址
+	RateLimitActionQueryParameter ProxyRateLimitActionType = 4 // 查询参数的值
+)
+
+// 全局限流的计数周期，取值与 Envoy RateLimitResponse.RateLimit.Unit 一致
+type ProxyRateLimitUnit int8
+
+const (
+	RateLimitUnitSecond ProxyRateLimitUnit = 1 // 每秒
+	RateLimitUnitMinute ProxyRateLimitUnit = 2 // 每分钟
+	RateLimitUnitHour   ProxyRateLimitUnit = 3 // 每小时
+	RateLimitUnitDay    ProxyRateLimitUnit = 4 // 每天
+)
+
 // 证书类型
 type CertType int8
 
@@ -38,6 +105,31 @@ const (
 	RootCert   CertType = 2 // 根证书（用于验证客户端证书链）
 )
 
+// 配置修订来源
+type ProxyRevisionAction int8
+
+const (
+	RevisionActionPublish  ProxyRevisionAction = 1 // 实体变更发布
+	RevisionActionRollback ProxyRevisionAction = 2 // 回滚到历史修订
+)
+
+// 灰度发布状态
+type ProxyRolloutStatus int8
+
+const (
+	RolloutStatusInProgress ProxyRolloutStatus = 1 // 灰度中，灰度节点使用新修订
+	RolloutStatusPromoted   ProxyRolloutStatus = 2 // 已全量，集群全部节点使用新修订
+	RolloutStatusAborted    ProxyRolloutStatus = 3 // 已中止，集群全部节点使用稳定修订
+	RolloutStatusSuperseded ProxyRolloutStatus = 4 // 已被更新的修订取代
+)
+
+// 灰度发布默认值
+const (
+	DefaultRolloutSoakSeconds  = 300 // 观察时长(秒)
+	DefaultRolloutMaxErrorRate = 1.0 // 最大错误率(%)
+	DefaultRolloutMinRequests  = 100 // 评估错误率所需的最少请求数
+)
+
 // 熔断配置默认值
 const (
 	DefaultMaxConnections     = 1024  // 最大连接数
@@ -48,6 +140,8 @@ const (
 	DefaultRouteTimeoutMs     = 15000 // 路由超时(毫秒)
 	DefaultNumRetries         = 3     // 默认重试次数
 	DefaultPerTryTimeoutMs    = 0     // 单次重试超时(毫秒，0表示使用全局超时)
+	DefaultRateLimitStatus    = 429   // 被限流时返回的状态码
+	DefaultMaintenanceStatus  = 503   // 维护模式默认返回的状态码
 )
 
 // 重试触发条件
@@ -0,0 +1,148 @@
+package envoy
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+
+	route "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	matcher "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
+	v1 "github.com/lyonmu/quebec/idl/router/v1"
+	"github.com/lyonmu/quebec/pkg/constant"
+)
+
+// redirectCodes 重定向状态码与 Envoy RedirectResponseCode 的映射
+var redirectCodes = map[int32]route.RedirectAction_RedirectResponseCode{
+	http.StatusMovedPermanently:  route.RedirectAction_MOVED_PERMANENTLY,
+	http.StatusFound:             route.RedirectAction_FOUND,
+	http.StatusSeeOther:          route.RedirectAction_SEE_OTHER,
+	http.StatusTemporaryRedirect: route.RedirectAction_TEMPORARY_REDIRECT,
+	http.StatusPermanentRedirect: route.RedirectAction_PERMANENT_REDIRECT,
+}
+
+// makePathMatch 按匹配类型生成路径匹配：前缀匹配时去掉末尾的通配符 *
+func makePathMatch(r *v1.HttpRoute) *route.RouteMatch {
+	switch constant.ProxyHttpRouteMatchType(r.GetMatchType()) {
+	case constant.HttpRouteMatchTypeExact:
+		return &route.RouteMatch{PathSpecifier: &route.RouteMatch_Path{Path: r.GetMatchPattern()}}
+	case constant.HttpRouteMatchTypeRegex:
+		return &route.RouteMatch{PathSpecifier: &route.RouteMatch_SafeRegex{SafeRegex: &matcher.RegexMatcher{Regex: r.GetMatchPattern()}}}
+	default:
+		return &route.RouteMatch{PathSpecifier: &route.RouteMatch_Prefix{Prefix: strings.TrimSuffix(r.GetMatchPattern(), "*")}}
+	}
+}
+
+// parsePathRewrite 解析路径重写规则，返回前缀重写或正则重写其中之一：
+//   - 单个值：前缀与精确匹配时替换匹配到的路径，正则匹配时作为替换串，可用 \1 引用路由正则的分组
+//   - "<匹配> <替换>"：以正则改写路径；匹配以 * 结尾且不含正则元字符时按前缀通配处理，如 /api/v1/* /v1/*
+func parsePathRewrite(r *v1.HttpRoute) (string, *matcher.RegexMatchAndSubstitute, error) {
+	fields := strings.Fields(r.GetPathRewrite())
+	switch len(fields) {
+	case 1:
+		if constant.ProxyHttpRouteMatchType(r.GetMatchType()) == constant.HttpRouteMatchTypeRegex {
+			return "", &matcher.RegexMatchAndSubstitute{
+				Pattern:      &matcher.RegexMatcher{Regex: r.GetMatchPattern()},
+				Substitution: fields[0],
+			}, nil
+		}
+		return strings.TrimSuffix(fields[0], "*"), nil, nil
+	case 2:
+		pattern, substitution := fields[0], fields[1]
+		if base := strings.TrimSuffix(pattern, "*"); base != pattern && regexp.QuoteMeta(base) == base {
+			pattern = "^" + base + "(.*)$"
+			substitution = strings.TrimSuffix(substitution, "*") + `\1`
+		}
+		if _, err := regexp.Compile(pattern); err != nil {
+			return "", nil, fmt.Errorf("invalid path rewrite regex: %w", err)
+		}
+		return "", &matcher.RegexMatchAndSubstitute{
+			Pattern:      &matcher.RegexMatcher{Regex: pattern},
+			Substitution: substitution,
+		}, nil
+	case 0:
+		return "", nil, errors.New("path rewrite is required")
+	default:
+		return "", nil, fmt.Errorf("invalid path rewrite %q", r.GetPathRewrite())
+	}
+}
+
+// parseRedirect 解析重定向地址：完整 URL 替换协议、主机与路径，以 / 开头时只替换路径
+func parseRedirect(r *v1.HttpRoute) (*route.RedirectAction, error) {
+	code := r.GetRedirectCode()
+	if code == 0 {
+		code = http.StatusMovedPermanently
+	}
+	responseCode, ok := redirectCodes[code]
+	if !ok {
+		return nil, fmt.Errorf("unsupported redirect code %d", code)
+	}
+	if r.GetRedirectUrl() == "" {
+		return nil, errors.New("redirect url is required")
+	}
+	u, err := url.Parse(r.GetRedirectUrl())
+	if err != nil {
+		return nil, fmt.Errorf("invalid redirect url: %w", err)
+	}
+
+	redirect := &route.RedirectAction{ResponseCode: responseCode}
+	if u.Scheme != "" {
+		if u.Scheme != "http" && u.Scheme != "https" {
+			return nil, fmt.Errorf("unsupported redirect scheme %q", u.Scheme)
+		}
+		if u.Hostname() == "" {
+			return nil, errors.New("redirect host is required")
+		}
+		redirect.SchemeRewriteSpecifier = &route.RedirectAction_SchemeRedirect{SchemeRedirect: u.Scheme}
+		redirect.HostRedirect = u.Hostname()
+		if port := u.Port(); port != "" {
+			p, err := strconv.ParseUint(port, 10, 32)
+			if err != nil {
+				return nil, fmt.Errorf("invalid redirect port %q", port)
+			}
+			redirect.PortRedirect = uint32(p)
+		}
+	} else if !strings.HasPrefix(u.Path, "/") {
+		return nil, errors.New("redirect url must be an absolute url or start with /")
+	}
+
+	// 未指定路径时保留原请求路径
+	if path := u.EscapedPath(); path != "" || u.RawQuery != "" {
+		if u.RawQuery != "" {
+			path += "?" + u.RawQuery
+		}
+		redirect.PathRewriteSpecifier = &route.RedirectAction_PathRedirect{PathRedirect: path}
+	}
+	return redirect, nil
+}
+
+// ValidateRoutePath 校验路径匹配、路径重写与重定向，正则需能按 RE2 语法编译。
+// Core 保存路由路径设置时使用同一校验
+func ValidateRoutePath(r *v1.HttpRoute) error {
+	pattern := r.GetMatchPattern()
+	switch constant.ProxyHttpRouteMatchType(r.GetMatchType()) {
+	case 0, constant.HttpRouteMatchTypePrefix, constant.HttpRouteMatchTypeExact: // 未设置时按前缀匹配
+		if !strings.HasPrefix(pattern, "/") {
+			return fmt.Errorf("match pattern %q must start with /", pattern)
+		}
+	case constant.HttpRouteMatchTypeRegex:
+		if _, err := regexp.Compile(pattern); err != nil {
+			return fmt.Errorf("invalid match regex: %w", err)
+		}
+	default:
+		return fmt.Errorf("unsupported match type %d", r.GetMatchType())
+	}
+
+	if r.GetEnableRedirect() {
+		_, err := parseRedirect(r)
+		return err
+	}
+	if r.GetEnablePathRewrite() {
+		_, _, err := parsePathRewrite(r)
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,234 @@
+package envoy
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	bootstrap "github.com/envoyproxy/go-control-plane/envoy/config/bootstrap/v3"
+	cluster "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	endpoint "github.com/envoyproxy/go-control-plane/envoy/config/endpoint/v3"
+	tlsv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
+	upstreamhttp "github.com/envoyproxy/go-control-plane/envoy/extensions/upstreams/http/v3"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"gopkg.in/yaml.v3"
+)
+
+// XdsTokenHeader Envoy 连接 Gateway xDS 服务时携带令牌的 gRPC metadata 键
+const XdsTokenHeader = "x-quebec-xds-token"
+
+// DefaultAdminPort Envoy 管理接口默认端口
+const DefaultAdminPort = 19901
+
+// DefaultXdsPort Gateway xDS 服务默认端口，与 Gateway 的 --gateway.port 默认值一致
+const DefaultXdsPort = 59025
+
+// bootstrap 输出格式
+const (
+	BootstrapFormatYAML = "yaml"
+	BootstrapFormatJSON = "json"
+)
+
+// BootstrapOptions 生成 Envoy bootstrap 的参数
+type BootstrapOptions struct {
+	NodeID    string // Envoy 节点ID (node.id)
+	Cluster   string // 网关集群ID (node.cluster)
+	XdsHost   string // Gateway xDS 服务地址，支持域名
+	XdsPort   uint32 // Gateway xDS 服务端口，即 Gateway 的 --gateway.port
+	AdminPort uint32 // 管理接口端口，为 0 时使用 DefaultAdminPort
+	Delta     bool   // 是否使用 Delta xDS
+	XdsToken  string // xDS 连接令牌，通过 XdsTokenHeader 携带，为空不携带
+	TLS       *BootstrapTLS
+}
+
+// BootstrapTLS xDS 连接的 TLS 配置，文件路径为 Envoy 所在主机上的路径
+type BootstrapTLS struct {
+	CACert     string // 校验 Gateway 证书的 CA 证书
+	ClientCert string // 客户端证书，双向认证时与 ClientKey 同时设置
+	ClientKey  string // 客户端私钥
+	SNI        string // TLS SNI，为空时不设置
+}
+
+// Bootstrap 生成指向 Gateway xDS 服务的 Envoy bootstrap，监听器与集群均通过 ADS 获取。
+// ADS 集群名为 GatewayClusterName，下发的 gRPC 访问日志同样使用该集群
+func Bootstrap(opts BootstrapOptions) (*bootstrap.Bootstrap, error) {
+	if opts.NodeID == "" || opts.Cluster == "" {
+		return nil, errors.New("node id and cluster are required")
+	}
+	if opts.XdsHost == "" || opts.XdsPort == 0 {
+		return nil, errors.New("xds host and port are required")
+	}
+	adminPort := opts.AdminPort
+	if adminPort == 0 {
+		adminPort = DefaultAdminPort
+	}
+
+	apiType := core.ApiConfigSource_GRPC
+	if opts.Delta {
+		apiType = core.ApiConfigSource_DELTA_GRPC
+	}
+	grpcService := &core.GrpcService{
+		TargetSpecifier: &core.GrpcService_EnvoyGrpc_{
+			EnvoyGrpc: &core.GrpcService_EnvoyGrpc{ClusterName: GatewayClusterName},
+		},
+	}
+	if opts.XdsToken != "" {
+		grpcService.InitialMetadata = []*core.HeaderValue{{Key: XdsTokenHeader, Value: opts.XdsToken}}
+	}
+	ads := &core.ConfigSource{
+		ResourceApiVersion:    core.ApiVersion_V3,
+		ConfigSourceSpecifier: &core.ConfigSource_Ads{Ads: &core.AggregatedConfigSource{}},
+	}
+
+	xdsCluster, err := makeXdsCluster(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	b := &bootstrap.Bootstrap{
+		Node: &core.Node{Id: opts.NodeID, Cluster: opts.Cluster},
+		Admin: &bootstrap.Admin{
+			Address: socketAddress("0.0.0.0", adminPort),
+		},
+		DynamicResources: &bootstrap.Bootstrap_DynamicResources{
+			AdsConfig: &core.ApiConfigSource{
+				ApiType:             apiType,
+				TransportApiVersion: core.ApiVersion_V3,
+				GrpcServices:        []*core.GrpcService{grpcService},
+			},
+			LdsConfig: ads,
+			CdsConfig: ads,
+		},
+		StaticResources: &bootstrap.Bootstrap_StaticResources{
+			Clusters: []*cluster.Cluster{xdsCluster},
+		},
+	}
+	if err := b.Validate(); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// makeXdsCluster 生成指向 Gateway 的静态集群，gRPC 需要 HTTP/2
+func makeXdsCluster(opts BootstrapOptions) (*cluster.Cluster, error) {
+	protocol, err := anypb.New(&upstreamhttp.HttpProtocolOptions{
+		UpstreamProtocolOptions: &upstreamhttp.HttpProtocolOptions_ExplicitHttpConfig_{
+			ExplicitHttpConfig: &upstreamhttp.HttpProtocolOptions_ExplicitHttpConfig{
+				ProtocolConfig: &upstreamhttp.HttpProtocolOptions_ExplicitHttpConfig_Http2ProtocolOptions{
+					Http2ProtocolOptions: &core.Http2ProtocolOptions{},
+				},
+			},
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("marshal HttpProtocolOptions: %w", err)
+	}
+
+	c := &cluster.Cluster{
+		Name:                 GatewayClusterName,
+		ConnectTimeout:       durationpb.New(5 * time.Second),
+		ClusterDiscoveryType: &cluster.Cluster_Type{Type: cluster.Cluster_STRICT_DNS}, // 支持以域名访问 Gateway
+		LbPolicy:             cluster.Cluster_ROUND_ROBIN,
+		TypedExtensionProtocolOptions: map[string]*anypb.Any{
+			"envoy.extensions.upstreams.http.v3.HttpProtocolOptions": protocol,
+		},
+		LoadAssignment: &endpoint.ClusterLoadAssignment{
+			ClusterName: GatewayClusterName,
+			Endpoints: []*endpoint.LocalityLbEndpoints{{
+				LbEndpoints: []*endpoint.LbEndpoint{{
+					HostIdentifier: &endpoint.LbEndpoint_Endpoint{
+						Endpoint: &endpoint.Endpoint{Address: socketAddress(opts.XdsHost, opts.XdsPort)},
+					},
+				}},
+			}},
+		},
+	}
+
+	if opts.TLS != nil {
+		socket, err := makeTransportSocket(opts.TLS)
+		if err != nil {
+			return nil, err
+		}
+		c.TransportSocket = socket
+	}
+	return c, nil
+}
+
+func makeTransportSocket(t *BootstrapTLS) (*core.TransportSocket, error) {
+	if (t.ClientCert == "") != (t.ClientKey == "") {
+		return nil, errors.New("tls client cert and key must be set together")
+	}
+
+	common := &tlsv3.CommonTlsContext{AlpnProtocols: []string{"h2"}}
+	if t.CACert != "" {
+		common.ValidationContextType = &tlsv3.CommonTlsContext_ValidationContext{
+			ValidationContext: &tlsv3.CertificateValidationContext{TrustedCa: fileSource(t.CACert)},
+		}
+	}
+	if t.ClientCert != "" {
+		common.TlsCertificates = []*tlsv3.TlsCertificate{{
+			CertificateChain: fileSource(t.ClientCert),
+			PrivateKey:       fileSource(t.ClientKey),
+		}}
+	}
+
+	tlsContext, err := anypb.New(&tlsv3.UpstreamTlsContext{CommonTlsContext: common, Sni: t.SNI})
+	if err != nil {
+		return nil, fmt.Errorf("marshal UpstreamTlsContext: %w", err)
+	}
+	return &core.TransportSocket{
+		Name:       "envoy.transport_sockets.tls",
+		ConfigType: &core.TransportSocket_TypedConfig{TypedConfig: tlsContext},
+	}, nil
+}
+
+func socketAddress(host string, port uint32) *core.Address {
+	return &core.Address{
+		Address: &core.Address_SocketAddress{
+			SocketAddress: &core.SocketAddress{
+				Protocol:      core.SocketAddress_TCP,
+				Address:       host,
+				PortSpecifier: &core.SocketAddress_PortValue{PortValue: port},
+			},
+		},
+	}
+}
+
+func fileSource(path string) *core.DataSource {
+	return &core.DataSource{Specifier: &core.DataSource_Filename{Filename: path}}
+}
+
+// MarshalBootstrap 按格式序列化 bootstrap，yaml 可直接作为 envoy -c 的配置文件
+func MarshalBootstrap(b *bootstrap.Bootstrap, format string) ([]byte, error) {
+	data, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(b)
+	if err != nil {
+		return nil, err
+	}
+	switch format {
+	case BootstrapFormatJSON:
+		var buf bytes.Buffer
+		if err := json.Indent(&buf, data, "", "  "); err != nil {
+			return nil, err
+		}
+		return buf.Bytes(), nil
+	case BootstrapFormatYAML, "":
+		var doc any
+		if err := json.Unmarshal(data, &doc); err != nil {
+			return nil, err
+		}
+		var buf bytes.Buffer
+		enc := yaml.NewEncoder(&buf)
+		enc.SetIndent(2)
+		if err := enc.Encode(doc); err != nil {
+			return nil, err
+		}
+		return buf.Bytes(), nil
+	default:
+		return nil, fmt.Errorf("unsupported bootstrap format: %s", format)
+	}
+}
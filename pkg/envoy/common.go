@@ -0,0 +1,40 @@
+package envoy
+
+import (
+	cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	"github.com/lyonmu/quebec/pkg/constant"
+)
+
+// 负载均衡策略映射，键与 Core 中 CoreUpstream.lb_policy 保持一致
+// 参考: https://www.envoyproxy.io/docs/envoy/latest/intro/arch_overview/upstream/load_balancing/load_balancers#arch-overview-load-balancing-types
+var LBPolicyMap = map[constant.ProxyLbPolicy]cluster_v3.Cluster_LbPolicy{
+	constant.LbPolicyRoundRobin:   cluster_v3.Cluster_ROUND_ROBIN,   // 加权轮询
+	constant.LbPolicyLeastRequest: cluster_v3.Cluster_LEAST_REQUEST, // 加权最小请求数
+	constant.LbPolicyRandom:       cluster_v3.Cluster_RANDOM,        // 随机
+	constant.LbPolicyRingHash:     cluster_v3.Cluster_RING_HASH,     // 环形一致性哈希
+	constant.LbPolicyMaglev:       cluster_v3.Cluster_MAGLEV,        // Maglev一致性哈希
+}
+
+// Envoy 资源名称，Gateway 下发与 Core 预览渲染共用
+const (
+	GatewayClusterName  = "quebec_gateway_cluster" // Envoy bootstrap 中指向 Gateway 的集群
+	RoutePrefix         = "quebec_gateway_route"
+	ListenerPrefix      = "quebec_gateway_listener"
+	ListenerFilterName  = "quebec_gateway_listener_filter"
+	HttpStatPrefixName  = "quebec_gateway_http"
+	HttpFilterName      = "quebec_gateway_http_filter"
+	VirtualHostPrefix   = "quebec_gateway_virtual_host" // 同时作为监听器默认虚拟主机(*)的名称
+	AccessLogName       = "quebec_gateway_access_log"
+	UpstreamPrefix      = "quebec_upstream"
+	MirrorRuntimePrefix = "quebec.route_mirror"      // 流量镜像比例的 Envoy runtime key 前缀
+	FaultFilterName     = "envoy.filters.http.fault" // 故障注入过滤器，路由通过 typed_per_filter_config 启用
+	CorsFilterName      = "envoy.filters.http.cors"  // 跨域过滤器，路由与虚拟主机通过 typed_per_filter_config 启用
+	// 本地限流过滤器，监听器配置作用于整个监听器，路由通过 typed_per_filter_config 单独限流
+	LocalRateLimitFilterName = "envoy.filters.http.local_ratelimit"
+	LocalRateLimitStatPrefix = "quebec_gateway_local_rate_limit"
+	// 全局限流过滤器，路由通过 rate_limits 生成描述符并调用 Gateway 的限流服务
+	RateLimitFilterName = "envoy.filters.http.ratelimit"
+	RateLimitDomain     = "quebec_gateway"
+	// 响应压缩过滤器，每种算法一个实例，名称为 CompressorFilterName.<算法>，路由通过 typed_per_filter_config 关闭压缩
+	CompressorFilterName = "envoy.filters.http.compressor"
+)
@@ -0,0 +1,137 @@
+package envoy
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	brotli "github.com/envoyproxy/go-control-plane/envoy/extensions/compression/brotli/compressor/v3"
+	gzip "github.com/envoyproxy/go-control-plane/envoy/extensions/compression/gzip/compressor/v3"
+	zstd "github.com/envoyproxy/go-control-plane/envoy/extensions/compression/zstd/compressor/v3"
+	compressor "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/compressor/v3"
+	hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	v1 "github.com/lyonmu/quebec/idl/router/v1"
+	"github.com/lyonmu/quebec/pkg/constant"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// maxCompressionLevel 压缩级别上限，gzip 最高为 9
+const maxCompressionLevel = 9
+
+// compressionAlgorithms 支持的压缩算法名称，同时作为压缩过滤器名称的后缀
+var compressionAlgorithms = map[constant.ProxyCompressionAlgorithm]string{
+	constant.CompressionGzip:   "gzip",
+	constant.CompressionBrotli: "brotli",
+	constant.CompressionZstd:   "zstd",
+}
+
+// CompressorFilterNames 返回所有算法的压缩过滤器名称，路由关闭压缩时对每个过滤器生效
+func CompressorFilterNames() []string {
+	return []string{
+		compressorFilterName(constant.CompressionGzip),
+		compressorFilterName(constant.CompressionBrotli),
+		compressorFilterName(constant.CompressionZstd),
+	}
+}
+
+func compressorFilterName(a constant.ProxyCompressionAlgorithm) string {
+	return CompressorFilterName + "." + compressionAlgorithms[a]
+}
+
+// makeCompressorFilters 按监听器的响应压缩生成压缩过滤器，未配置时返回 nil。
+// 多个压缩过滤器由 Envoy 按 Accept-Encoding 的权重选择其中一个；最后一个过滤器移除 Accept-Encoding，
+// 上游服务不再压缩响应，前面的过滤器仍能读取到该请求头
+func makeCompressorFilters(c *v1.Compression) []*hcm.HttpFilter {
+	if c == nil || len(c.GetAlgorithms()) == 0 {
+		return nil
+	}
+
+	filters := make([]*hcm.HttpFilter, 0, len(c.GetAlgorithms()))
+	for i, a := range c.GetAlgorithms() {
+		algorithm := constant.ProxyCompressionAlgorithm(a)
+		library := makeCompressorLibrary(algorithm, c.GetLevel())
+		if library == nil {
+			continue
+		}
+		libraryConfig, _ := anypb.New(library)
+		common := &compressor.Compressor_CommonDirectionConfig{ContentType: c.GetContentTypes()}
+		if c.GetMinContentLength() > 0 {
+			common.MinContentLength = wrapperspb.UInt32(c.GetMinContentLength())
+		}
+		config, _ := anypb.New(&compressor.Compressor{
+			CompressorLibrary: &core.TypedExtensionConfig{
+				Name:        "envoy.compression." + compressionAlgorithms[algorithm] + ".compressor",
+				TypedConfig: libraryConfig,
+			},
+			ResponseDirectionConfig: &compressor.Compressor_ResponseDirectionConfig{
+				CommonConfig:               common,
+				RemoveAcceptEncodingHeader: i == len(c.GetAlgorithms())-1,
+			},
+		})
+		filters = append(filters, &hcm.HttpFilter{
+			Name:       compressorFilterName(algorithm),
+			ConfigType: &hcm.HttpFilter_TypedConfig{TypedConfig: config},
+		})
+	}
+	return filters
+}
+
+// makeCompressorLibrary 生成压缩算法配置，级别为 0 时使用各算法的默认级别
+func makeCompressorLibrary(a constant.ProxyCompressionAlgorithm, level uint32) proto.Message {
+	switch a {
+	case constant.CompressionGzip:
+		return &gzip.Gzip{CompressionLevel: gzip.Gzip_CompressionLevel(level)}
+	case constant.CompressionBrotli:
+		b := &brotli.Brotli{}
+		if level > 0 {
+			b.Quality = wrapperspb.UInt32(level)
+		}
+		return b
+	case constant.CompressionZstd:
+		z := &zstd.Zstd{}
+		if level > 0 {
+			z.CompressionLevel = wrapperspb.UInt32(level)
+		}
+		return z
+	}
+	return nil
+}
+
+// makeCompressionDisabled 生成路由关闭响应压缩的 typed_per_filter_config
+func makeCompressionDisabled() *compressor.CompressorPerRoute {
+	return &compressor.CompressorPerRoute{Override: &compressor.CompressorPerRoute_Disabled{Disabled: true}}
+}
+
+// ValidateCompression 校验响应压缩：至少一种算法且不能重复，压缩级别不超过 9，
+// 响应类型需为 type/subtype 格式。Core 保存响应压缩时使用同一校验
+func ValidateCompression(c *v1.Compression) error {
+	if c == nil {
+		return nil
+	}
+	if len(c.GetAlgorithms()) == 0 {
+		return errors.New("at least one compression algorithm is required")
+	}
+	seen := make(map[int32]struct{}, len(c.GetAlgorithms()))
+	for _, a := range c.GetAlgorithms() {
+		if _, ok := compressionAlgorithms[constant.ProxyCompressionAlgorithm(a)]; !ok {
+			return fmt.Errorf("unsupported compression algorithm %d", a)
+		}
+		if _, ok := seen[a]; ok {
+			return fmt.Errorf("duplicate compression algorithm %d", a)
+		}
+		seen[a] = struct{}{}
+	}
+	if c.GetLevel() > maxCompressionLevel {
+		return fmt.Errorf("compression level %d exceeds %d", c.GetLevel(), maxCompressionLevel)
+	}
+	for _, t := range c.GetContentTypes() {
+		typ, sub, ok := strings.Cut(t, "/")
+		if !ok || typ == "" || sub == "" || strings.ContainsAny(t, " ,;\r\n") {
+			return fmt.Errorf("invalid content type %q", t)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,82 @@
+package envoy
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	cors "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/cors/v3"
+	matcher "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
+	v1 "github.com/lyonmu/quebec/idl/router/v1"
+	"github.com/lyonmu/quebec/pkg/constant"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// makeCorsPolicy 生成跨域策略，未配置允许来源时返回 nil
+func makeCorsPolicy(p *v1.CorsPolicy) *cors.CorsPolicy {
+	if len(p.GetAllowOrigins()) == 0 {
+		return nil
+	}
+
+	policy := &cors.CorsPolicy{
+		AllowMethods:     strings.Join(p.GetAllowMethods(), ","),
+		AllowHeaders:     strings.Join(p.GetAllowHeaders(), ","),
+		ExposeHeaders:    strings.Join(p.GetExposeHeaders(), ","),
+		AllowCredentials: wrapperspb.Bool(p.GetAllowCredentials()),
+	}
+	if p.GetMaxAgeSeconds() > 0 {
+		policy.MaxAge = strconv.FormatUint(uint64(p.GetMaxAgeSeconds()), 10)
+	}
+	for _, o := range p.GetAllowOrigins() {
+		m := &matcher.StringMatcher{}
+		switch constant.ProxyCorsOriginType(o.GetType()) {
+		case constant.CorsOriginPrefix:
+			m.MatchPattern = &matcher.StringMatcher_Prefix{Prefix: o.GetValue()}
+		case constant.CorsOriginRegex:
+			m.MatchPattern = &matcher.StringMatcher_SafeRegex{SafeRegex: &matcher.RegexMatcher{Regex: o.GetValue()}}
+		default:
+			m.MatchPattern = &matcher.StringMatcher_Exact{Exact: o.GetValue()}
+		}
+		policy.AllowOriginStringMatch = append(policy.AllowOriginStringMatch, m)
+	}
+	return policy
+}
+
+// ValidateCorsPolicy 校验跨域策略：至少允许一个来源，正则需能按 RE2 语法编译，方法与头名称需合法。
+// Core 保存跨域策略时使用同一校验
+func ValidateCorsPolicy(p *v1.CorsPolicy) error {
+	if p == nil {
+		return nil
+	}
+	if len(p.GetAllowOrigins()) == 0 {
+		return errors.New("cors policy requires at least one allowed origin")
+	}
+	for _, o := range p.GetAllowOrigins() {
+		if o.GetValue() == "" {
+			return errors.New("allowed origin is required")
+		}
+		switch constant.ProxyCorsOriginType(o.GetType()) {
+		case constant.CorsOriginExact, constant.CorsOriginPrefix:
+		case constant.CorsOriginRegex:
+			if _, err := regexp.Compile(o.GetValue()); err != nil {
+				return fmt.Errorf("invalid allowed origin regex: %w", err)
+			}
+		default:
+			return fmt.Errorf("unsupported origin match type %d", o.GetType())
+		}
+	}
+	for _, method := range p.GetAllowMethods() {
+		if _, ok := httpMethods[method]; !ok {
+			return fmt.Errorf("unsupported http method %q", method)
+		}
+	}
+	for _, name := range slices.Concat(p.GetAllowHeaders(), p.GetExposeHeaders()) {
+		if !headerNameRegex.MatchString(name) {
+			return fmt.Errorf("invalid header name %q", name)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,188 @@
+package envoy
+
+import (
+	"errors"
+	"fmt"
+	"net/netip"
+	"strings"
+
+	xdscore "github.com/cncf/xds/go/xds/core/v3"
+	xdsmatcher "github.com/cncf/xds/go/xds/type/matcher/v3"
+	core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	route "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	network "github.com/envoyproxy/go-control-plane/envoy/extensions/matching/common_inputs/network/v3"
+	ipmatcher "github.com/envoyproxy/go-control-plane/envoy/extensions/matching/input_matchers/ip/v3"
+	matcher "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
+	v1 "github.com/lyonmu/quebec/idl/router/v1"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+const (
+	// defaultContentType 直接响应未指定 Content-Type 时使用
+	defaultContentType = "text/plain; charset=utf-8"
+	// maxDirectResponseBody Envoy 默认允许的直接响应体大小上限 (max_direct_response_body_size_bytes)
+	maxDirectResponseBody = 4096
+	// MaintenanceRouteName 维护模式下返回维护响应的路由
+	MaintenanceRouteName = "quebec_gateway_maintenance"
+	// MaintenanceStatPrefix 维护模式客户端地址白名单的统计前缀
+	MaintenanceStatPrefix = "quebec_gateway_maintenance_allow_ip"
+)
+
+// makeDirectResponse 生成直接响应动作与其 Content-Type 响应头
+func makeDirectResponse(d *v1.DirectResponse) (*route.DirectResponseAction, *core.HeaderValueOption) {
+	action := &route.DirectResponseAction{Status: d.GetStatus()}
+	if d.GetBody() != "" {
+		action.Body = &core.DataSource{Specifier: &core.DataSource_InlineString{InlineString: d.GetBody()}}
+	}
+	contentType := d.GetContentType()
+	if contentType == "" {
+		contentType = defaultContentType
+	}
+	return action, &core.HeaderValueOption{
+		Header:       &core.HeaderValue{Key: "Content-Type", Value: contentType},
+		AppendAction: core.HeaderValueOption_OVERWRITE_IF_EXISTS_OR_ADD,
+	}
+}
+
+// ValidateDirectResponse 校验直接响应：状态码在 200-599 之间，响应体不超过 4KB，Content-Type 不能包含换行。
+// Core 保存直接响应与维护模式时使用同一校验
+func ValidateDirectResponse(d *v1.DirectResponse) error {
+	if d == nil {
+		return nil
+	}
+	if d.GetStatus() < 200 || d.GetStatus() > 599 {
+		return fmt.Errorf("status %d out of range [200, 599]", d.GetStatus())
+	}
+	if len(d.GetBody()) > maxDirectResponseBody {
+		return fmt.Errorf("body exceeds %d bytes", maxDirectResponseBody)
+	}
+	if strings.ContainsAny(d.GetContentType(), "\r\n") {
+		return errors.New("content type must not contain line breaks")
+	}
+	return nil
+}
+
+// ValidateMaintenance 校验维护模式：维护响应必填，放行路径以 / 开头，放行地址为 IP 或 CIDR
+func ValidateMaintenance(m *v1.Maintenance) error {
+	if m == nil {
+		return nil
+	}
+	if m.GetResponse() == nil {
+		return errors.New("maintenance response is required")
+	}
+	if err := ValidateDirectResponse(m.GetResponse()); err != nil {
+		return err
+	}
+	for _, p := range m.GetAllowPaths() {
+		if !strings.HasPrefix(p, "/") {
+			return fmt.Errorf("allow path %q must start with /", p)
+		}
+	}
+	for _, ip := range m.GetAllowIps() {
+		if _, err := parsePrefix(ip); err != nil {
+			return fmt.Errorf("invalid allow ip %q", ip)
+		}
+	}
+	return nil
+}
+
+// parsePrefix 解析 IP 或 CIDR，单个 IP 视为全长前缀
+func parsePrefix(s string) (netip.Prefix, error) {
+	if strings.Contains(s, "/") {
+		return netip.ParsePrefix(s)
+	}
+	addr, err := netip.ParseAddr(s)
+	if err != nil {
+		return netip.Prefix{}, err
+	}
+	return netip.PrefixFrom(addr, addr.BitLen()), nil
+}
+
+// applyMaintenance 为虚拟主机开启维护模式：不在白名单中的请求返回维护响应，
+// 白名单中的路径前缀与客户端地址仍按原路由转发。原路由不做修改，关闭维护模式即恢复原配置
+func applyMaintenance(v *route.VirtualHost, m *v1.Maintenance) {
+	action, contentType := makeDirectResponse(m.GetResponse())
+	maintenance := &route.Route{
+		Name:                 MaintenanceRouteName,
+		Match:                &route.RouteMatch{PathSpecifier: &route.RouteMatch_Prefix{Prefix: "/"}},
+		Action:               &route.Route_DirectResponse{DirectResponse: action},
+		ResponseHeadersToAdd: []*core.HeaderValueOption{contentType},
+	}
+
+	predicates := make([]*xdsmatcher.Matcher_MatcherList_Predicate, 0, len(m.GetAllowPaths())+1)
+	for _, p := range m.GetAllowPaths() {
+		predicates = append(predicates, singlePredicate("path", &matcher.HttpRequestHeaderMatchInput{HeaderName: ":path"},
+			&xdsmatcher.Matcher_MatcherList_Predicate_SinglePredicate{
+				Matcher: &xdsmatcher.Matcher_MatcherList_Predicate_SinglePredicate_ValueMatch{
+					ValueMatch: &xdsmatcher.StringMatcher{MatchPattern: &xdsmatcher.StringMatcher_Prefix{Prefix: p}},
+				},
+			},
+		))
+	}
+	if len(m.GetAllowIps()) > 0 {
+		ranges := make([]*core.CidrRange, 0, len(m.GetAllowIps()))
+		for _, ip := range m.GetAllowIps() {
+			prefix, _ := parsePrefix(ip)
+			prefix = prefix.Masked()
+			ranges = append(ranges, &core.CidrRange{
+				AddressPrefix: prefix.Addr().String(),
+				PrefixLen:     wrapperspb.UInt32(uint32(prefix.Bits())),
+			})
+		}
+		ipConfig, _ := anypb.New(&ipmatcher.Ip{CidrRanges: ranges, StatPrefix: MaintenanceStatPrefix})
+		predicates = append(predicates, singlePredicate("source_ip", &network.SourceIPInput{},
+			&xdsmatcher.Matcher_MatcherList_Predicate_SinglePredicate{
+				Matcher: &xdsmatcher.Matcher_MatcherList_Predicate_SinglePredicate_CustomMatch{
+					CustomMatch: &xdscore.TypedExtensionConfig{Name: "envoy.matching.matchers.ip", TypedConfig: ipConfig},
+				},
+			},
+		))
+	}
+
+	if len(predicates) == 0 {
+		v.Routes = []*route.Route{maintenance}
+		return
+	}
+
+	// 白名单命中时按原路由匹配，否则返回维护响应；设置 matcher 后 routes 必须为空
+	predicate := predicates[0]
+	if len(predicates) > 1 {
+		predicate = &xdsmatcher.Matcher_MatcherList_Predicate{
+			MatchType: &xdsmatcher.Matcher_MatcherList_Predicate_OrMatcher{
+				OrMatcher: &xdsmatcher.Matcher_MatcherList_Predicate_PredicateList{Predicate: predicates},
+			},
+		}
+	}
+	v.Matcher = &xdsmatcher.Matcher{
+		MatcherType: &xdsmatcher.Matcher_MatcherList_{
+			MatcherList: &xdsmatcher.Matcher_MatcherList{
+				Matchers: []*xdsmatcher.Matcher_MatcherList_FieldMatcher{{
+					Predicate: predicate,
+					OnMatch:   routeListAction("allow", v.GetRoutes()),
+				}},
+			},
+		},
+		OnNoMatch: routeListAction("maintenance", []*route.Route{maintenance}),
+	}
+	v.Routes = nil
+}
+
+// singlePredicate 以 input 提取请求中的值交给 p 匹配
+func singlePredicate(name string, input proto.Message, p *xdsmatcher.Matcher_MatcherList_Predicate_SinglePredicate) *xdsmatcher.Matcher_MatcherList_Predicate {
+	config, _ := anypb.New(input)
+	p.Input = &xdscore.TypedExtensionConfig{Name: name, TypedConfig: config}
+	return &xdsmatcher.Matcher_MatcherList_Predicate{
+		MatchType: &xdsmatcher.Matcher_MatcherList_Predicate_SinglePredicate_{SinglePredicate: p},
+	}
+}
+
+func routeListAction(name string, routes []*route.Route) *xdsmatcher.Matcher_OnMatch {
+	config, _ := anypb.New(&route.RouteList{Routes: routes})
+	return &xdsmatcher.Matcher_OnMatch{
+		OnMatch: &xdsmatcher.Matcher_OnMatch_Action{
+			Action: &xdscore.TypedExtensionConfig{Name: name, TypedConfig: config},
+		},
+	}
+}
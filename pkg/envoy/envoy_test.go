@@ -0,0 +1,787 @@
+package envoy
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	route "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	compressor "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/compressor/v3"
+	cors "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/cors/v3"
+	fault "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/fault/v3"
+	localratelimit "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/local_ratelimit/v3"
+	hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	"github.com/envoyproxy/go-control-plane/pkg/resource/v3"
+	v1 "github.com/lyonmu/quebec/idl/router/v1"
+	"github.com/lyonmu/quebec/pkg/constant"
+)
+
+func TestGenerateSnapshot(t *testing.T) {
+	cfg := &v1.ProxyConfig{
+		Upstreams: []*v1.Upstream{
+			{Id: "u1", Name: "user-service", LbPolicy: 1, Hosts: []*v1.UpstreamHost{{Address: "10.0.0.1", Port: 8080, Weight: 1}}},
+			{Id: "u2", Name: "order-service", LbPolicy: 5},
+		},
+		HttpRoutes: []*v1.HttpRoute{
+			{Id: "r1", UpstreamId: "u1", MatchType: 1, MatchPattern: "/api/users/*", TimeoutMs: 3000},
+		},
+		L7Listeners: []*v1.L7Listener{
+			{Id: "l1", Port: 10000},
+			{Id: "l2", Host: "127.0.0.1", Port: 10001},
+		},
+	}
+
+	snap, err := GenerateSnapshot("1", cfg, Options{})
+	if err != nil {
+		t.Fatalf("generate snapshot failed: %v", err)
+	}
+
+	if n := len(snap.GetResources(resource.ClusterType)); n != 2 {
+		t.Fatalf("expected 2 clusters, got %d", n)
+	}
+	if n := len(snap.GetResources(resource.ListenerType)); n != 2 {
+		t.Fatalf("expected 2 listeners, got %d", n)
+	}
+	if _, ok := snap.GetResources(resource.RouteType)[RouteConfigName("l1")]; !ok {
+		t.Fatalf("route configuration for listener l1 not found")
+	}
+}
+
+func TestScopeProxyConfig(t *testing.T) {
+	cfg := &v1.ProxyConfig{
+		HttpRoutes: []*v1.HttpRoute{
+			{Id: "r1"},
+			{Id: "r2", ClusterId: "edge"},
+			{Id: "r3", ClusterId: "internal"},
+		},
+		L7Listeners: []*v1.L7Listener{
+			{Id: "l1", ClusterId: "edge"},
+			{Id: "l2", ClusterId: "internal"},
+		},
+	}
+
+	scoped := ScopeProxyConfig(cfg, "edge")
+	if n := len(scoped.HttpRoutes); n != 2 {
+		t.Fatalf("expected 2 routes for cluster edge, got %d", n)
+	}
+	if n := len(scoped.L7Listeners); n != 1 || scoped.L7Listeners[0].Id != "l1" {
+		t.Fatalf("expected listener l1 only for cluster edge, got %v", scoped.L7Listeners)
+	}
+}
+
+func TestGenerateSnapshotValidation(t *testing.T) {
+	cfg := &v1.ProxyConfig{
+		Upstreams:   []*v1.Upstream{{Id: "u1", Name: "user-service"}},
+		HttpRoutes:  []*v1.HttpRoute{{Id: "r1", Name: "orders", UpstreamId: "u9", MatchPattern: "/orders"}},
+		L7Listeners: []*v1.L7Listener{{Id: "l1", Port: 10000}},
+	}
+
+	_, err := GenerateSnapshot("1", cfg, Options{})
+	var ve *ValidationError
+	if !errors.As(err, &ve) || ve.Entity != EntityHttpRoute || ve.ID != "r1" {
+		t.Fatalf("expected validation error for http route r1, got %v", err)
+	}
+
+	cfg.HttpRoutes = nil
+	cfg.L7Listeners = append(cfg.L7Listeners, &v1.L7Listener{Id: "l2", Host: "0.0.0.0", Port: 10000})
+	_, err = GenerateSnapshot("1", cfg, Options{})
+	if !errors.As(err, &ve) || ve.Entity != EntityL7Listener || ve.ID != "l2" {
+		t.Fatalf("expected validation error for listener l2, got %v", err)
+	}
+}
+
+func TestRenderCollectsErrors(t *testing.T) {
+	cfg := &v1.ProxyConfig{
+		Upstreams: []*v1.Upstream{{Id: "u1", Name: "user-service"}},
+		HttpRoutes: []*v1.HttpRoute{
+			{Id: "r1", UpstreamId: "u1", MatchPattern: "/users"},
+			{Id: "r2", UpstreamId: "u8", MatchPattern: "/orders"},
+			{Id: "r3", UpstreamId: "u9", MatchPattern: "/payments"},
+		},
+		L7Listeners: []*v1.L7Listener{{Id: "l1", Port: 10000}},
+	}
+
+	res, errs := Render(cfg, Options{})
+	if len(errs) != 2 || errs[0].ID != "r2" || errs[1].ID != "r3" {
+		t.Fatalf("expected errors for routes r2 and r3, got %v", errs)
+	}
+	// 校验失败的路由不影响其余资源的渲染
+	if len(res.Listeners) != 1 || len(res.Routes[0].VirtualHosts[0].Routes) != 1 {
+		t.Fatalf("expected listener l1 with route r1 only, got %d listeners", len(res.Listeners))
+	}
+	if _, err := res.Snapshot("1"); err != nil {
+		t.Fatalf("snapshot of valid resources failed: %v", err)
+	}
+}
+
+func TestBootstrap(t *testing.T) {
+	b, err := Bootstrap(BootstrapOptions{
+		NodeID:   "envoy-1",
+		Cluster:  "edge",
+		XdsHost:  "gateway.internal",
+		XdsPort:  59025,
+		XdsToken: "secret",
+		TLS:      &BootstrapTLS{CACert: "/etc/envoy/ca.pem", SNI: "gateway.internal"},
+	})
+	if err != nil {
+		t.Fatalf("generate bootstrap failed: %v", err)
+	}
+	if b.GetAdmin().GetAddress().GetSocketAddress().GetPortValue() != DefaultAdminPort {
+		t.Fatalf("expected default admin port %d", DefaultAdminPort)
+	}
+	ads := b.GetDynamicResources().GetAdsConfig().GetGrpcServices()[0]
+	if ads.GetEnvoyGrpc().GetClusterName() != GatewayClusterName || ads.GetInitialMetadata()[0].GetValue() != "secret" {
+		t.Fatalf("unexpected ads grpc service: %v", ads)
+	}
+	if b.GetStaticResources().GetClusters()[0].GetTransportSocket() == nil {
+		t.Fatalf("expected tls transport socket on xds cluster")
+	}
+
+	data, err := MarshalBootstrap(b, BootstrapFormatYAML)
+	if err != nil || !strings.Contains(string(data), "port_value: 59025") {
+		t.Fatalf("unexpected yaml bootstrap (err: %v):\n%s", err, data)
+	}
+
+	if _, err := Bootstrap(BootstrapOptions{NodeID: "envoy-1", Cluster: "edge", XdsHost: "127.0.0.1", XdsPort: 59025, TLS: &BootstrapTLS{ClientCert: "/etc/envoy/cert.pem"}}); err == nil {
+		t.Fatalf("expected error for client cert without key")
+	}
+}
+
+func TestRenderWeightedTargets(t *testing.T) {
+	cfg := &v1.ProxyConfig{
+		Upstreams: []*v1.Upstream{{Id: "blue"}, {Id: "green"}},
+		HttpRoutes: []*v1.HttpRoute{{
+			Id:           "r1",
+			UpstreamId:   "blue",
+			MatchPattern: "/orders",
+			Targets:      []*v1.RouteTarget{{UpstreamId: "blue", Weight: 90}, {UpstreamId: "green", Weight: 10}},
+		}},
+		L7Listeners: []*v1.L7Listener{{Id: "l1", Port: 10000}},
+	}
+
+	res, errs := Render(cfg, Options{})
+	if len(errs) > 0 {
+		t.Fatalf("render weighted route failed: %v", errs)
+	}
+	weighted := res.Routes[0].VirtualHosts[0].Routes[0].GetRoute().GetWeightedClusters()
+	if len(weighted.GetClusters()) != 2 || weighted.GetClusters()[1].GetName() != ClusterName("green") || weighted.GetClusters()[1].GetWeight().GetValue() != 10 {
+		t.Fatalf("unexpected weighted clusters: %v", weighted)
+	}
+
+	// 分流目标必须是已下发的上游服务
+	cfg.HttpRoutes[0].Targets[1].UpstreamId = "gray"
+	if _, errs := Render(cfg, Options{}); len(errs) != 1 || errs[0].ID != "r1" {
+		t.Fatalf("expected validation error for route r1, got %v", errs)
+	}
+}
+
+func TestRenderRouteMatchers(t *testing.T) {
+	cfg := &v1.ProxyConfig{
+		Upstreams: []*v1.Upstream{{Id: "u1"}},
+		HttpRoutes: []*v1.HttpRoute{{
+			Id:           "r1",
+			UpstreamId:   "u1",
+			MatchPattern: "/orders",
+			IgnoreCase:   true,
+			Methods:      []string{"GET", "POST"},
+			Headers: []*v1.HttpMatcher{
+				{Name: "x-tenant", Type: int32(constant.HttpMatcherTypeExact), Value: "acme", IgnoreCase: true},
+				{Name: "x-debug", Type: int32(constant.HttpMatcherTypeAbsent)},
+			},
+			QueryParameters: []*v1.HttpMatcher{{Name: "version", Type: int32(constant.HttpMatcherTypeRegex), Value: `^v[12]$`}},
+		}},
+		L7Listeners: []*v1.L7Listener{{Id: "l1", Port: 10000}},
+	}
+
+	res, errs := Render(cfg, Options{})
+	if len(errs) > 0 {
+		t.Fatalf("render route matchers failed: %v", errs)
+	}
+	match := res.Routes[0].VirtualHosts[0].Routes[0].GetMatch()
+	if match.GetCaseSensitive().GetValue() || len(match.GetHeaders()) != 3 || len(match.GetQueryParameters()) != 1 {
+		t.Fatalf("unexpected route match: %v", match)
+	}
+	if got := match.GetHeaders()[0].GetStringMatch().GetSafeRegex().GetRegex(); got != "^(GET|POST)$" {
+		t.Fatalf("unexpected method regex: %s", got)
+	}
+	if h := match.GetHeaders()[2]; h.GetPresentMatch() || h.GetHeaderMatchSpecifier() == nil {
+		t.Fatalf("absent header should render present_match false: %v", h)
+	}
+
+	// 查询参数不支持不存在匹配，非法正则同样拒绝
+	cfg.HttpRoutes[0].QueryParameters[0].Type = int32(constant.HttpMatcherTypeAbsent)
+	if _, errs := Render(cfg, Options{}); len(errs) != 1 || errs[0].ID != "r1" {
+		t.Fatalf("expected validation error for route r1, got %v", errs)
+	}
+	cfg.HttpRoutes[0].QueryParameters[0] = &v1.HttpMatcher{Name: "version", Type: int32(constant.HttpMatcherTypeRegex), Value: "(v1"}
+	if _, errs := Render(cfg, Options{}); len(errs) != 1 || errs[0].ID != "r1" {
+		t.Fatalf("expected validation error for route r1, got %v", errs)
+	}
+}
+
+func TestRenderRoutePath(t *testing.T) {
+	cases := []struct {
+		name  string
+		route *v1.HttpRoute
+		check func(*route.Route) bool
+	}{
+		{
+			name:  "exact",
+			route: &v1.HttpRoute{MatchType: int32(constant.HttpRouteMatchTypeExact), MatchPattern: "/healthz"},
+			check: func(r *route.Route) bool { return r.GetMatch().GetPath() == "/healthz" },
+		},
+		{
+			name:  "regex rewrite with route groups",
+			route: &v1.HttpRoute{MatchType: int32(constant.HttpRouteMatchTypeRegex), MatchPattern: `^/v(\d+)/users$`, EnablePathRewrite: true, PathRewrite: `/users?version=\1`},
+			check: func(r *route.Route) bool {
+				rw := r.GetRoute().GetRegexRewrite()
+				return r.GetMatch().GetSafeRegex().GetRegex() == `^/v(\d+)/users$` && rw.GetPattern().GetRegex() == `^/v(\d+)/users$`
+			},
+		},
+		{
+			name:  "prefix glob rewrite",
+			route: &v1.HttpRoute{MatchPattern: "/api/v1/*", EnablePathRewrite: true, PathRewrite: "/api/v1/* /v1/*"},
+			check: func(r *route.Route) bool {
+				rw := r.GetRoute().GetRegexRewrite()
+				return rw.GetPattern().GetRegex() == "^/api/v1/(.*)$" && rw.GetSubstitution() == `/v1/\1`
+			},
+		},
+		{
+			name:  "prefix rewrite",
+			route: &v1.HttpRoute{MatchPattern: "/api/*", EnablePathRewrite: true, PathRewrite: "/"},
+			check: func(r *route.Route) bool { return r.GetRoute().GetPrefixRewrite() == "/" },
+		},
+		{
+			name:  "redirect",
+			route: &v1.HttpRoute{MatchPattern: "/old", EnableRedirect: true, RedirectUrl: "https://example.com:8443/new?a=1", RedirectCode: 308},
+			check: func(r *route.Route) bool {
+				rd := r.GetRedirect()
+				return rd.GetSchemeRedirect() == "https" && rd.GetHostRedirect() == "example.com" && rd.GetPortRedirect() == 8443 &&
+					rd.GetPathRedirect() == "/new?a=1" && rd.GetResponseCode() == route.RedirectAction_PERMANENT_REDIRECT
+			},
+		},
+	}
+
+	for _, c := range cases {
+		c.route.Id, c.route.UpstreamId = "r1", "u1"
+		cfg := &v1.ProxyConfig{
+			Upstreams:   []*v1.Upstream{{Id: "u1"}},
+			HttpRoutes:  []*v1.HttpRoute{c.route},
+			L7Listeners: []*v1.L7Listener{{Id: "l1", Port: 10000}},
+		}
+		res, errs := Render(cfg, Options{})
+		if len(errs) > 0 {
+			t.Fatalf("%s: render failed: %v", c.name, errs)
+		}
+		if r := res.Routes[0].VirtualHosts[0].Routes[0]; !c.check(r) {
+			t.Fatalf("%s: unexpected route: %v", c.name, r)
+		}
+	}
+
+	// 非法正则与不支持的重定向状态码在下发前拒绝
+	for _, r := range []*v1.HttpRoute{
+		{MatchType: int32(constant.HttpRouteMatchTypeRegex), MatchPattern: "^/(a"},
+		{MatchPattern: "/old", EnableRedirect: true, RedirectUrl: "/new", RedirectCode: 200},
+		{MatchPattern: "/old", EnableRedirect: true, RedirectUrl: "new"},
+	} {
+		if err := ValidateRoutePath(r); err == nil {
+			t.Fatalf("expected validation error for %v", r)
+		}
+	}
+}
+
+func TestRenderRetryPolicy(t *testing.T) {
+	policy := &v1.RetryPolicy{
+		RetryOn:              []string{string(constant.RetryOn5xx), string(constant.RetryOnRetriableCodes)},
+		NumRetries:           2,
+		PerTryTimeoutMs:      500,
+		RetriableStatusCodes: []uint32{409},
+		BackoffBaseMs:        50,
+		BackoffMaxMs:         200,
+	}
+	cfg := &v1.ProxyConfig{
+		Upstreams:   []*v1.Upstream{{Id: "u1"}},
+		HttpRoutes:  []*v1.HttpRoute{{Id: "r1", UpstreamId: "u1", MatchPattern: "/orders", RetryPolicy: policy}},
+		L7Listeners: []*v1.L7Listener{{Id: "l1", Port: 10000}},
+	}
+
+	res, errs := Render(cfg, Options{})
+	if len(errs) > 0 {
+		t.Fatalf("render retry policy failed: %v", errs)
+	}
+	rp := res.Routes[0].VirtualHosts[0].Routes[0].GetRoute().GetRetryPolicy()
+	if rp.GetRetryOn() != "5xx,retriable-status-codes" || rp.GetNumRetries().GetValue() != 2 ||
+		rp.GetPerTryTimeout().AsDuration().Milliseconds() != 500 || rp.GetRetryBackOff().GetMaxInterval().AsDuration().Milliseconds() != 200 {
+		t.Fatalf("unexpected retry policy: %v", rp)
+	}
+
+	// 可重试状态码必须配合 retriable-status-codes 条件
+	policy.RetryOn = []string{string(constant.RetryOn5xx)}
+	if _, errs := Render(cfg, Options{}); len(errs) != 1 || errs[0].ID != "r1" {
+		t.Fatalf("expected validation error for route r1, got %v", errs)
+	}
+}
+
+func TestRenderVirtualHosts(t *testing.T) {
+	cfg := &v1.ProxyConfig{
+		Upstreams: []*v1.Upstream{{Id: "u1"}},
+		VirtualHosts: []*v1.VirtualHost{
+			{Id: "api", ListenerId: "l1", Domains: []string{"api.example.com", "*.api.example.com"}},
+			{Id: "web", ListenerId: "l1", Domains: []string{"www.example.com"}},
+		},
+		HttpRoutes: []*v1.HttpRoute{
+			{Id: "r1", UpstreamId: "u1", MatchPattern: "/", VirtualHostId: "api"},
+			{Id: "r2", UpstreamId: "u1", MatchPattern: "/", VirtualHostId: "web"},
+			{Id: "r3", UpstreamId: "u1", MatchPattern: "/healthz"},
+		},
+		L7Listeners: []*v1.L7Listener{{Id: "l1", Port: 10000}, {Id: "l2", Port: 10001}},
+	}
+
+	res, errs := Render(cfg, Options{})
+	if len(errs) > 0 {
+		t.Fatalf("render virtual hosts failed: %v", errs)
+	}
+	for _, rc := range res.Routes {
+		var routes int
+		for _, vh := range rc.GetVirtualHosts() {
+			routes += len(vh.GetRoutes())
+		}
+		switch rc.GetName() {
+		case RouteConfigName("l1"):
+			// api、web 与默认虚拟主机，未指定虚拟主机的 r3 进入默认虚拟主机
+			if len(rc.GetVirtualHosts()) != 3 || routes != 3 || rc.GetVirtualHosts()[2].GetRoutes()[0].GetName() != "r3" {
+				t.Fatalf("unexpected route config for l1: %v", rc)
+			}
+		case RouteConfigName("l2"):
+			if len(rc.GetVirtualHosts()) != 1 || routes != 1 {
+				t.Fatalf("unexpected route config for l2: %v", rc)
+			}
+		}
+	}
+
+	// 同一监听器内域名冲突的虚拟主机被拒绝，其路由随之报错
+	cfg.VirtualHosts[1].Domains = []string{"API.example.com"}
+	_, errs = Render(cfg, Options{})
+	if len(errs) != 2 || errs[0].Entity != EntityVirtualHost || errs[0].ID != "web" || errs[1].ID != "r2" {
+		t.Fatalf("expected conflict errors for web and r2, got %v", errs)
+	}
+
+	if err := ValidateDomains([]string{"a.*.com"}); err == nil {
+		t.Fatalf("expected invalid wildcard domain")
+	}
+}
+
+func TestRenderHeaderMutation(t *testing.T) {
+	mutation := &v1.HeaderMutation{
+		RequestHeadersToAdd: []*v1.HeaderValue{
+			{Name: "X-Request-Source", Value: "%DOWNSTREAM_REMOTE_ADDRESS%", Action: int32(constant.HeaderActionOverwrite)},
+			{Name: "X-Start", Value: "%START_TIME(%s)% 100%%", Action: int32(constant.HeaderActionAddIfAbsent)},
+		},
+		RequestHeadersToRemove:  []string{"x-internal-token"},
+		ResponseHeadersToRemove: []string{"server"},
+	}
+	cfg := &v1.ProxyConfig{
+		Upstreams: []*v1.Upstream{{Id: "u1"}},
+		VirtualHosts: []*v1.VirtualHost{{Id: "api", ListenerId: "l1", Domains: []string{"api.example.com"}, HeaderMutation: &v1.HeaderMutation{
+			ResponseHeadersToAdd: []*v1.HeaderValue{{Name: "Strict-Transport-Security", Value: "max-age=31536000", Action: int32(constant.HeaderActionOverwrite)}},
+		}}},
+		HttpRoutes:  []*v1.HttpRoute{{Id: "r1", UpstreamId: "u1", MatchPattern: "/", VirtualHostId: "api", HeaderMutation: mutation}},
+		L7Listeners: []*v1.L7Listener{{Id: "l1", Port: 10000}},
+	}
+
+	res, errs := Render(cfg, Options{})
+	if len(errs) > 0 {
+		t.Fatalf("render header mutation failed: %v", errs)
+	}
+	vh := res.Routes[0].GetVirtualHosts()[0]
+	if len(vh.GetResponseHeadersToAdd()) != 1 || vh.GetResponseHeadersToAdd()[0].GetHeader().GetKey() != "Strict-Transport-Security" {
+		t.Fatalf("unexpected virtual host headers: %v", vh)
+	}
+	r := vh.GetRoutes()[0]
+	if len(r.GetRequestHeadersToAdd()) != 2 || r.GetRequestHeadersToAdd()[0].GetAppendAction() != core.HeaderValueOption_OVERWRITE_IF_EXISTS_OR_ADD ||
+		r.GetRequestHeadersToRemove()[0] != "x-internal-token" || r.GetResponseHeadersToRemove()[0] != "server" {
+		t.Fatalf("unexpected route headers: %v", r)
+	}
+
+	// 伪头与未闭合的格式化变量都会被拒绝
+	for _, h := range []*v1.HeaderValue{{Name: ":path", Value: "/", Action: 1}, {Name: "X-Bad", Value: "%DOWNSTREAM_REMOTE_ADDRESS", Action: 1}} {
+		mutation.RequestHeadersToAdd = []*v1.HeaderValue{h}
+		if _, errs := Render(cfg, Options{}); len(errs) != 1 || errs[0].ID != "r1" {
+			t.Fatalf("expected validation error for header %q, got %v", h.GetName(), errs)
+		}
+	}
+}
+
+func TestRenderRouteMirror(t *testing.T) {
+	cfg := &v1.ProxyConfig{
+		Upstreams:   []*v1.Upstream{{Id: "u1"}, {Id: "u2"}},
+		HttpRoutes:  []*v1.HttpRoute{{Id: "r1", UpstreamId: "u1", MatchPattern: "/orders", MirrorUpstreamId: "u2", MirrorPercent: 12.5}},
+		L7Listeners: []*v1.L7Listener{{Id: "l1", Port: 10000}},
+	}
+
+	res, errs := Render(cfg, Options{})
+	if len(errs) > 0 {
+		t.Fatalf("render route mirror failed: %v", errs)
+	}
+	mirrors := res.Routes[0].VirtualHosts[0].Routes[0].GetRoute().GetRequestMirrorPolicies()
+	if len(mirrors) != 1 || mirrors[0].GetCluster() != ClusterName("u2") ||
+		mirrors[0].GetRuntimeFraction().GetDefaultValue().GetNumerator() != 1250 || mirrors[0].GetRuntimeFraction().GetRuntimeKey() != MirrorRuntimeKey("r1") {
+		t.Fatalf("unexpected mirror policies: %v", mirrors)
+	}
+
+	// 影子上游不能是路由自身的转发目标
+	cfg.HttpRoutes[0].MirrorUpstreamId = "u1"
+	if _, errs := Render(cfg, Options{}); len(errs) != 1 || errs[0].ID != "r1" {
+		t.Fatalf("expected validation error for route r1, got %v", errs)
+	}
+}
+
+func TestRenderFaultPolicy(t *testing.T) {
+	policy := &v1.FaultPolicy{DelayMs: 200, DelayPercent: 50, AbortHttpStatus: 503, AbortPercent: 10, HeaderName: "x-chaos"}
+	cfg := &v1.ProxyConfig{
+		Upstreams:   []*v1.Upstream{{Id: "u1"}},
+		HttpRoutes:  []*v1.HttpRoute{{Id: "r1", UpstreamId: "u1", MatchPattern: "/orders", FaultPolicy: policy}},
+		L7Listeners: []*v1.L7Listener{{Id: "l1", Port: 10000}},
+	}
+
+	res, errs := Render(cfg, Options{})
+	if len(errs) > 0 {
+		t.Fatalf("render fault policy failed: %v", errs)
+	}
+	config, ok := res.Routes[0].VirtualHosts[0].Routes[0].GetTypedPerFilterConfig()[FaultFilterName]
+	if !ok {
+		t.Fatalf("fault filter config not found on route")
+	}
+	f := &fault.HTTPFault{}
+	if err := config.UnmarshalTo(f); err != nil {
+		t.Fatalf("unmarshal fault config: %v", err)
+	}
+	if f.GetDelay().GetFixedDelay().AsDuration().Milliseconds() != 200 || f.GetAbort().GetHttpStatus() != 503 ||
+		f.GetAbort().GetPercentage().GetNumerator() != 1000 || f.GetHeaders()[0].GetName() != "x-chaos" {
+		t.Fatalf("unexpected fault config: %v", f)
+	}
+
+	// HTTP 与 gRPC 中断状态码只能二选一
+	policy.AbortGrpcStatus = 14
+	if _, errs := Render(cfg, Options{}); len(errs) != 1 || errs[0].ID != "r1" {
+		t.Fatalf("expected validation error for route r1, got %v", errs)
+	}
+}
+
+func TestRenderCorsPolicy(t *testing.T) {
+	policy := &v1.CorsPolicy{
+		AllowOrigins:     []*v1.CorsOrigin{{Type: int32(constant.CorsOriginExact), Value: "https://app.example.com"}, {Type: int32(constant.CorsOriginRegex), Value: `https://.*\.example\.com`}},
+		AllowMethods:     []string{"GET", "POST"},
+		AllowHeaders:     []string{"Authorization", "Content-Type"},
+		MaxAgeSeconds:    600,
+		AllowCredentials: true,
+	}
+	cfg := &v1.ProxyConfig{
+		Upstreams: []*v1.Upstream{{Id: "u1"}},
+		VirtualHosts: []*v1.VirtualHost{{Id: "api", ListenerId: "l1", Domains: []string{"api.example.com"}, CorsPolicy: &v1.CorsPolicy{
+			AllowOrigins: []*v1.CorsOrigin{{Type: int32(constant.CorsOriginExact), Value: "*"}},
+		}}},
+		HttpRoutes:  []*v1.HttpRoute{{Id: "r1", UpstreamId: "u1", MatchPattern: "/", VirtualHostId: "api", CorsPolicy: policy}},
+		L7Listeners: []*v1.L7Listener{{Id: "l1", Port: 10000}},
+	}
+
+	res, errs := Render(cfg, Options{})
+	if len(errs) > 0 {
+		t.Fatalf("render cors policy failed: %v", errs)
+	}
+	vh := res.Routes[0].GetVirtualHosts()[0]
+	if _, ok := vh.GetTypedPerFilterConfig()[CorsFilterName]; !ok {
+		t.Fatalf("cors policy not found on virtual host")
+	}
+	c := &cors.CorsPolicy{}
+	if err := vh.GetRoutes()[0].GetTypedPerFilterConfig()[CorsFilterName].UnmarshalTo(c); err != nil {
+		t.Fatalf("unmarshal cors policy: %v", err)
+	}
+	if len(c.GetAllowOriginStringMatch()) != 2 || c.GetAllowOriginStringMatch()[1].GetSafeRegex() == nil ||
+		c.GetAllowMethods() != "GET,POST" || c.GetMaxAge() != "600" || !c.GetAllowCredentials().GetValue() {
+		t.Fatalf("unexpected cors policy: %v", c)
+	}
+
+	// 跨域过滤器位于 router 之前
+	hcmConfig := &hcm.HttpConnectionManager{}
+	if err := res.Listeners[0].GetFilterChains()[0].GetFilters()[0].GetTypedConfig().UnmarshalTo(hcmConfig); err != nil {
+		t.Fatalf("unmarshal http connection manager: %v", err)
+	}
+	filters := hcmConfig.GetHttpFilters()
+	if filters[0].GetName() != CorsFilterName || filters[len(filters)-1].GetName() != HttpFilterName {
+		t.Fatalf("unexpected http filters: %v", filters)
+	}
+
+	policy.AllowOrigins[1].Value = "https://(.*"
+	if _, errs := Render(cfg, Options{}); len(errs) != 1 || errs[0].ID != "r1" {
+		t.Fatalf("expected validation error for route r1, got %v", errs)
+	}
+}
+
+func TestRenderLocalRateLimit(t *testing.T) {
+	limit := &v1.LocalRateLimit{MaxTokens: 100, TokensPerFill: 10, FillIntervalMs: 1000, HeaderName: "x-rate-limited", HeaderValue: "true"}
+	cfg := &v1.ProxyConfig{
+		Upstreams:   []*v1.Upstream{{Id: "u1"}},
+		HttpRoutes:  []*v1.HttpRoute{{Id: "r1", UpstreamId: "u1", MatchPattern: "/", LocalRateLimit: limit}},
+		L7Listeners: []*v1.L7Listener{{Id: "l1", Port: 10000, LocalRateLimit: &v1.LocalRateLimit{MaxTokens: 1000, FillIntervalMs: 100, StatusCode: 503}}},
+	}
+
+	res, errs := Render(cfg, Options{})
+	if len(errs) > 0 {
+		t.Fatalf("render local rate limit failed: %v", errs)
+	}
+	rl := &localratelimit.LocalRateLimit{}
+	if err := res.Routes[0].GetVirtualHosts()[0].GetRoutes()[0].GetTypedPerFilterConfig()[LocalRateLimitFilterName].UnmarshalTo(rl); err != nil {
+		t.Fatalf("unmarshal route local rate limit: %v", err)
+	}
+	if rl.GetTokenBucket().GetMaxTokens() != 100 || rl.GetTokenBucket().GetTokensPerFill().GetValue() != 10 ||
+		rl.GetTokenBucket().GetFillInterval().AsDuration() != time.Second || rl.GetStatus().GetCode() != 429 ||
+		rl.GetFilterEnforced().GetDefaultValue().GetNumerator() != 100 || rl.GetResponseHeadersToAdd()[0].GetHeader().GetKey() != "x-rate-limited" {
+		t.Fatalf("unexpected route local rate limit: %v", rl)
+	}
+
+	// 监听器级限流位于 HCM 的本地限流过滤器中
+	hcmConfig := &hcm.HttpConnectionManager{}
+	if err := res.Listeners[0].GetFilterChains()[0].GetFilters()[0].GetTypedConfig().UnmarshalTo(hcmConfig); err != nil {
+		t.Fatalf("unmarshal http connection manager: %v", err)
+	}
+	listenerLimit := &localratelimit.LocalRateLimit{}
+	for _, f := range hcmConfig.GetHttpFilters() {
+		if f.GetName() == LocalRateLimitFilterName {
+			if err := f.GetTypedConfig().UnmarshalTo(listenerLimit); err != nil {
+				t.Fatalf("unmarshal listener local rate limit: %v", err)
+			}
+		}
+	}
+	if listenerLimit.GetTokenBucket().GetMaxTokens() != 1000 || listenerLimit.GetStatus().GetCode() != 503 {
+		t.Fatalf("unexpected listener local rate limit: %v", listenerLimit)
+	}
+
+	limit.FillIntervalMs = 10
+	cfg.L7Listeners[0].LocalRateLimit.StatusCode = 200
+	if _, errs := Render(cfg, Options{}); len(errs) != 2 || errs[0].ID != "r1" || errs[1].ID != "l1" {
+		t.Fatalf("expected validation errors for route r1 and listener l1, got %v", errs)
+	}
+}
+
+func TestRenderGlobalRateLimitFilter(t *testing.T) {
+	cfg := &v1.ProxyConfig{
+		Upstreams:   []*v1.Upstream{{Id: "u1"}},
+		HttpRoutes:  []*v1.HttpRoute{{Id: "r1", UpstreamId: "u1", MatchPattern: "/"}},
+		L7Listeners: []*v1.L7Listener{{Id: "l1", Port: 10000}},
+	}
+	hasFilter := func(opts Options) bool {
+		t.Helper()
+		res, errs := Render(cfg, opts)
+		if len(errs) > 0 {
+			t.Fatalf("render global rate limit failed: %v", errs)
+		}
+		hcmConfig := &hcm.HttpConnectionManager{}
+		if err := res.Listeners[0].GetFilterChains()[0].GetFilters()[0].GetTypedConfig().UnmarshalTo(hcmConfig); err != nil {
+			t.Fatalf("unmarshal http connection manager: %v", err)
+		}
+		for _, f := range hcmConfig.GetHttpFilters() {
+			if f.GetName() == RateLimitFilterName {
+				return true
+			}
+		}
+		return false
+	}
+
+	// 没有路由配置全局限流时不调用 Gateway 的限流服务
+	if hasFilter(Options{GlobalRateLimit: true}) {
+		t.Fatal("expected no global rate limit filter without rate limit actions")
+	}
+	cfg.HttpRoutes[0].RateLimitActions = []*v1.RateLimitAction{{Type: int32(constant.RateLimitActionRemoteAddress)}}
+	if !UsesGlobalRateLimit(cfg) {
+		t.Fatal("expected config with rate limit actions to use global rate limit")
+	}
+	if !hasFilter(Options{GlobalRateLimit: true}) {
+		t.Fatal("expected global rate limit filter for routes with rate limit actions")
+	}
+	// Gateway 未提供限流服务时不添加过滤器
+	if hasFilter(Options{}) {
+		t.Fatal("expected no global rate limit filter when the gateway has no rate limit service")
+	}
+}
+
+func TestRouteOrderAndConflicts(t *testing.T) {
+	prefix, exact := int32(constant.HttpRouteMatchTypePrefix), int32(constant.HttpRouteMatchTypeExact)
+	cfg := &v1.ProxyConfig{
+		Upstreams: []*v1.Upstream{{Id: "u1"}},
+		HttpRoutes: []*v1.HttpRoute{
+			{Id: "api", UpstreamId: "u1", MatchType: prefix, MatchPattern: "/api/*"},
+			{Id: "users", UpstreamId: "u1", MatchType: prefix, MatchPattern: "/api/v1/users"},
+			{Id: "health", UpstreamId: "u1", MatchType: exact, MatchPattern: "/api/health"},
+			{Id: "post", UpstreamId: "u1", MatchType: prefix, MatchPattern: "/api/*", Methods: []string{"POST"}},
+		},
+		L7Listeners: []*v1.L7Listener{{Id: "l1", Port: 10000}},
+	}
+
+	res, errs := Render(cfg, Options{})
+	if len(errs) > 0 {
+		t.Fatalf("render routes failed: %v", errs)
+	}
+	var got []string
+	for _, r := range res.Routes[0].GetVirtualHosts()[0].GetRoutes() {
+		got = append(got, r.GetName())
+	}
+	if want := "health,users,post,api"; strings.Join(got, ",") != want {
+		t.Fatalf("expected route order %s, got %v", want, got)
+	}
+
+	// 优先级更高的 /api 前缀排到最前，使其他路由都无法命中
+	api := cfg.HttpRoutes[0]
+	if by, shadows := RouteConflicts(api, cfg.HttpRoutes); len(by)+len(shadows) != 0 {
+		t.Fatalf("expected no conflicts, got %v %v", by, shadows)
+	}
+	api.Priority = 10
+	if by, shadows := RouteConflicts(api, cfg.HttpRoutes); len(by) != 0 || len(shadows) != 3 {
+		t.Fatalf("expected api to shadow 3 routes, got %v %v", by, shadows)
+	}
+	if by, _ := RouteConflicts(cfg.HttpRoutes[1], cfg.HttpRoutes); len(by) != 1 || by[0].GetId() != "api" {
+		t.Fatalf("expected users to be shadowed by api, got %v", by)
+	}
+}
+
+func TestRenderDirectResponseAndMaintenance(t *testing.T) {
+	cfg := &v1.ProxyConfig{
+		Upstreams: []*v1.Upstream{{Id: "u1"}},
+		VirtualHosts: []*v1.VirtualHost{{Id: "api", ListenerId: "l1", Domains: []string{"api.example.com"}, Maintenance: &v1.Maintenance{
+			Response:   &v1.DirectResponse{Status: 503, Body: "maintenance"},
+			AllowPaths: []string{"/healthz"},
+			AllowIps:   []string{"10.0.0.0/8"},
+		}}},
+		HttpRoutes: []*v1.HttpRoute{
+			{Id: "robots", MatchType: int32(constant.HttpRouteMatchTypeExact), MatchPattern: "/robots.txt", DirectResponse: &v1.DirectResponse{Status: 200, Body: "User-agent: *"}},
+			{Id: "r1", UpstreamId: "u1", MatchPattern: "/", VirtualHostId: "api"},
+		},
+		L7Listeners:         []*v1.L7Listener{{Id: "l1", Port: 10000}},
+		ClusterMaintenances: []*v1.ClusterMaintenance{{Id: "c1", ClusterId: "blue", Maintenance: &v1.Maintenance{Response: &v1.DirectResponse{Status: 503}}}},
+	}
+
+	// 直接响应的路由不需要上游服务；未开启维护模式的集群保持原路由
+	res, errs := Render(ScopeProxyConfig(cfg, "green"), Options{})
+	if len(errs) > 0 {
+		t.Fatalf("render direct response failed: %v", errs)
+	}
+	vhosts := res.Routes[0].GetVirtualHosts()
+	api, fallback := vhosts[0], vhosts[1]
+	if d := fallback.GetRoutes()[0].GetDirectResponse(); d.GetStatus() != 200 || d.GetBody().GetInlineString() != "User-agent: *" {
+		t.Fatalf("unexpected direct response: %v", fallback.GetRoutes()[0])
+	}
+	if api.GetMatcher() == nil || len(api.GetRoutes()) != 0 {
+		t.Fatalf("expected maintenance matcher on api virtual host, got %v", api)
+	}
+	predicates := api.GetMatcher().GetMatcherList().GetMatchers()[0].GetPredicate().GetOrMatcher().GetPredicate()
+	if len(predicates) != 2 {
+		t.Fatalf("expected path and ip allow predicates, got %v", predicates)
+	}
+	list := &route.RouteList{}
+	if err := api.GetMatcher().GetOnNoMatch().GetAction().GetTypedConfig().UnmarshalTo(list); err != nil || list.GetRoutes()[0].GetDirectResponse().GetStatus() != 503 {
+		t.Fatalf("expected maintenance response on no match, got %v %v", list, err)
+	}
+
+	// 集群维护模式作用于默认虚拟主机，关闭后恢复原路由
+	res, _ = Render(ScopeProxyConfig(cfg, "blue"), Options{})
+	if r := res.Routes[0].GetVirtualHosts()[1].GetRoutes(); len(r) != 1 || r[0].GetName() != MaintenanceRouteName {
+		t.Fatalf("expected cluster maintenance on fallback virtual host, got %v", r)
+	}
+	cfg.ClusterMaintenances = nil
+	res, _ = Render(ScopeProxyConfig(cfg, "blue"), Options{})
+	if r := res.Routes[0].GetVirtualHosts()[1].GetRoutes(); len(r) != 1 || r[0].GetName() != "robots" {
+		t.Fatalf("expected original routes after maintenance is off, got %v", r)
+	}
+
+	cfg.HttpRoutes[0].DirectResponse.Status = 99
+	if _, errs := Render(cfg, Options{}); len(errs) != 1 || errs[0].ID != "robots" {
+		t.Fatalf("expected invalid direct response status, got %v", errs)
+	}
+}
+
+func TestRenderHashPolicies(t *testing.T) {
+	cfg := &v1.ProxyConfig{
+		Upstreams: []*v1.Upstream{
+			{Id: "u1", LbPolicy: int32(constant.LbPolicyRingHash), RingMinSize: 2048, RingMaxSize: 4096},
+			{Id: "u2", LbPolicy: int32(constant.LbPolicyMaglev), MaglevTableSize: 65536},
+		},
+		HttpRoutes: []*v1.HttpRoute{{Id: "r1", UpstreamId: "u1", MatchPattern: "/", HashPolicies: []*v1.HashPolicy{
+			{Type: int32(constant.HashPolicyCookie), Name: "session", CookieTtlSeconds: 3600, CookiePath: "/"},
+			{Type: int32(constant.HashPolicySourceIP), Terminal: true},
+		}}},
+		L7Listeners: []*v1.L7Listener{{Id: "l1", Port: 10000}},
+	}
+
+	// Maglev 查找表大小必须为质数，不合法的上游服务被跳过
+	res, errs := Render(cfg, Options{})
+	if len(errs) != 1 || errs[0].ID != "u2" {
+		t.Fatalf("expected maglev table size error for u2, got %v", errs)
+	}
+	if c := res.Clusters[0].GetRingHashLbConfig(); c.GetMinimumRingSize().GetValue() != 2048 || c.GetMaximumRingSize().GetValue() != 4096 {
+		t.Fatalf("unexpected ring hash config: %v", res.Clusters[0])
+	}
+	policies := res.Routes[0].GetVirtualHosts()[0].GetRoutes()[0].GetRoute().GetHashPolicy()
+	if len(policies) != 2 || policies[0].GetCookie().GetTtl().AsDuration() != time.Hour || !policies[1].GetConnectionProperties().GetSourceIp() || !policies[1].GetTerminal() {
+		t.Fatalf("unexpected hash policies: %v", policies)
+	}
+
+	if err := ValidateHashPolicies([]*v1.HashPolicy{{Type: int32(constant.HashPolicyHeader), CookieTtlSeconds: 60, Name: "x-user"}}); err == nil {
+		t.Fatal("expected cookie ttl on a header policy to be rejected")
+	}
+}
+
+func TestRenderCompression(t *testing.T) {
+	cfg := &v1.ProxyConfig{
+		Upstreams: []*v1.Upstream{{Id: "u1"}},
+		HttpRoutes: []*v1.HttpRoute{
+			{Id: "r1", UpstreamId: "u1", MatchPattern: "/"},
+			{Id: "download", UpstreamId: "u1", MatchPattern: "/download", DisableCompression: true},
+		},
+		L7Listeners: []*v1.L7Listener{{Id: "l1", Port: 10000, Compression: &v1.Compression{
+			Algorithms:   []int32{int32(constant.CompressionBrotli), int32(constant.CompressionGzip)},
+			ContentTypes: []string{"application/json"},
+			Level:        6,
+		}}},
+	}
+
+	res, errs := Render(cfg, Options{})
+	if len(errs) > 0 {
+		t.Fatalf("render compression failed: %v", errs)
+	}
+
+	// 每种算法一个压缩过滤器，位于 router 之前，只有最后一个移除 Accept-Encoding
+	hcmConfig := &hcm.HttpConnectionManager{}
+	if err := res.Listeners[0].GetFilterChains()[0].GetFilters()[0].GetTypedConfig().UnmarshalTo(hcmConfig); err != nil {
+		t.Fatalf("unmarshal http connection manager: %v", err)
+	}
+	filters := hcmConfig.GetHttpFilters()
+	brotli, gzip := filters[len(filters)-3], filters[len(filters)-2]
+	if brotli.GetName() != CompressorFilterName+".brotli" || gzip.GetName() != CompressorFilterName+".gzip" {
+		t.Fatalf("unexpected compressor filters: %v", filters)
+	}
+	first, last := &compressor.Compressor{}, &compressor.Compressor{}
+	if err := brotli.GetTypedConfig().UnmarshalTo(first); err != nil {
+		t.Fatalf("unmarshal compressor: %v", err)
+	}
+	if err := gzip.GetTypedConfig().UnmarshalTo(last); err != nil {
+		t.Fatalf("unmarshal compressor: %v", err)
+	}
+	if first.GetResponseDirectionConfig().GetRemoveAcceptEncodingHeader() || !last.GetResponseDirectionConfig().GetRemoveAcceptEncodingHeader() {
+		t.Fatalf("expected only the last compressor to remove accept-encoding")
+	}
+
+	// 关闭压缩的路由对每个压缩过滤器都设置 disabled
+	for _, r := range res.Routes[0].GetVirtualHosts()[0].GetRoutes() {
+		_, disabled := r.GetTypedPerFilterConfig()[CompressorFilterName+".gzip"]
+		if disabled != (r.GetName() == "download") {
+			t.Fatalf("unexpected compression override on route %s", r.GetName())
+		}
+	}
+
+	cfg.L7Listeners[0].Compression.Algorithms = append(cfg.L7Listeners[0].Compression.Algorithms, int32(constant.CompressionGzip))
+	if _, errs := Render(cfg, Options{}); len(errs) != 1 || errs[0].ID != "l1" {
+		t.Fatalf("expected duplicate algorithm error for listener l1, got %v", errs)
+	}
+}
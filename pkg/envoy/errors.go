@@ -0,0 +1,52 @@
+package envoy
+
+import (
+	"errors"
+	"fmt"
+)
+
+// 校验失败的配置实体类型
+const (
+	EntityUpstream    = "upstream"
+	EntityHttpRoute   = "http_route"
+	EntityL7Listener  = "l7_listener"
+	EntityVirtualHost = "virtual_host"
+	EntityCluster     = "gateway_cluster"
+	EntitySnapshot    = "snapshot"
+)
+
+// ValidationError 由配置生成 xDS 资源失败时返回，标明出错的配置实体
+type ValidationError struct {
+	Entity string // 实体类型，见 Entity* 常量
+	ID     string // 实体ID
+	Name   string // 实体名称
+	Err    error
+}
+
+func (e *ValidationError) Error() string {
+	if e.ID == "" {
+		return fmt.Sprintf("invalid %s: %v", e.Entity, e.Err)
+	}
+	return fmt.Sprintf("invalid %s %s(%s): %v", e.Entity, e.Name, e.ID, e.Err)
+}
+
+func (e *ValidationError) Unwrap() error {
+	return e.Err
+}
+
+// ErrorEntity 返回错误对应的实体类型，用于指标标签
+func ErrorEntity(err error) string {
+	var ve *ValidationError
+	if errors.As(err, &ve) {
+		return ve.Entity
+	}
+	return EntitySnapshot
+}
+
+// validateResource 执行 Envoy 资源自带的 proto 校验规则
+func validateResource(res any) error {
+	if v, ok := res.(interface{ Validate() error }); ok {
+		return v.Validate()
+	}
+	return nil
+}
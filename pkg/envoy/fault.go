@@ -0,0 +1,99 @@
+package envoy
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	route "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	commonfault "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/common/fault/v3"
+	fault "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/fault/v3"
+	matcher "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
+	v1 "github.com/lyonmu/quebec/idl/router/v1"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// makeFault 生成路由级故障注入配置，未配置时返回 nil。
+// 监听器上的故障注入过滤器本身不注入任何故障，只有配置了 typed_per_filter_config 的路由才会生效
+func makeFault(p *v1.FaultPolicy) *fault.HTTPFault {
+	if p == nil {
+		return nil
+	}
+
+	f := &fault.HTTPFault{}
+	if p.GetDelayMs() > 0 {
+		f.Delay = &commonfault.FaultDelay{
+			FaultDelaySecifier: &commonfault.FaultDelay_FixedDelay{
+				FixedDelay: durationpb.New(time.Duration(p.GetDelayMs()) * time.Millisecond),
+			},
+			Percentage: fractionalPercent(p.GetDelayPercent()),
+		}
+	}
+	switch {
+	case p.GetAbortHttpStatus() > 0:
+		f.Abort = &fault.FaultAbort{
+			ErrorType:  &fault.FaultAbort_HttpStatus{HttpStatus: p.GetAbortHttpStatus()},
+			Percentage: fractionalPercent(p.GetAbortPercent()),
+		}
+	case p.GetAbortGrpcStatus() > 0:
+		f.Abort = &fault.FaultAbort{
+			ErrorType:  &fault.FaultAbort_GrpcStatus{GrpcStatus: p.GetAbortGrpcStatus()},
+			Percentage: fractionalPercent(p.GetAbortPercent()),
+		}
+	}
+	if name := p.GetHeaderName(); name != "" {
+		h := &route.HeaderMatcher{Name: name, HeaderMatchSpecifier: &route.HeaderMatcher_PresentMatch{PresentMatch: true}}
+		if p.GetHeaderValue() != "" {
+			h.HeaderMatchSpecifier = &route.HeaderMatcher_StringMatch{
+				StringMatch: &matcher.StringMatcher{MatchPattern: &matcher.StringMatcher_Exact{Exact: p.GetHeaderValue()}},
+			}
+		}
+		f.Headers = []*route.HeaderMatcher{h}
+	}
+	return f
+}
+
+// ValidateFaultPolicy 校验故障注入：至少配置延迟或中断之一，比例需在 (0, 100] 之间，
+// HTTP 状态码需在 [200, 600) 之间，HTTP 与 gRPC 状态码只能二选一。Core 保存故障注入时使用同一校验
+func ValidateFaultPolicy(p *v1.FaultPolicy) error {
+	if p == nil {
+		return nil
+	}
+	abort := p.GetAbortHttpStatus() > 0 || p.GetAbortGrpcStatus() > 0
+	if p.GetDelayMs() == 0 && !abort {
+		return errors.New("fault requires a delay or an abort")
+	}
+	if p.GetDelayMs() > 0 {
+		if err := validatePercent("delay", p.GetDelayPercent()); err != nil {
+			return err
+		}
+	}
+	if abort {
+		if p.GetAbortHttpStatus() > 0 && p.GetAbortGrpcStatus() > 0 {
+			return errors.New("abort http status and grpc status are mutually exclusive")
+		}
+		if s := p.GetAbortHttpStatus(); s > 0 && (s < 200 || s >= 600) {
+			return fmt.Errorf("invalid abort http status %d", s)
+		}
+		if s := p.GetAbortGrpcStatus(); s > 16 {
+			return fmt.Errorf("invalid abort grpc status %d", s)
+		}
+		if err := validatePercent("abort", p.GetAbortPercent()); err != nil {
+			return err
+		}
+	}
+	if p.GetHeaderName() == "" && p.GetHeaderValue() != "" {
+		return errors.New("fault header value requires a header name")
+	}
+	if name := p.GetHeaderName(); name != "" && !headerNameRegex.MatchString(name) {
+		return fmt.Errorf("invalid fault header name %q", name)
+	}
+	return nil
+}
+
+func validatePercent(kind string, p float64) error {
+	if p <= 0 || p > 100 {
+		return fmt.Errorf("%s percent %v must be in (0, 100]", kind, p)
+	}
+	return nil
+}
@@ -0,0 +1,136 @@
+package envoy
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"strings"
+	"time"
+
+	cluster "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	route "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	v1 "github.com/lyonmu/quebec/idl/router/v1"
+	"github.com/lyonmu/quebec/pkg/constant"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+const (
+	// MaxRingSize Envoy 环哈希允许的最大环大小
+	MaxRingSize = 8388608
+	// MaxMaglevTableSize Envoy Maglev 允许的最大查找表大小
+	MaxMaglevTableSize = 5000011
+)
+
+// makeHashPolicies 生成路由的一致性哈希策略，未配置时返回 nil
+func makeHashPolicies(policies []*v1.HashPolicy) []*route.RouteAction_HashPolicy {
+	if len(policies) == 0 {
+		return nil
+	}
+	out := make([]*route.RouteAction_HashPolicy, 0, len(policies))
+	for _, p := range policies {
+		hp := &route.RouteAction_HashPolicy{Terminal: p.GetTerminal()}
+		switch constant.ProxyHashPolicyType(p.GetType()) {
+		case constant.HashPolicyHeader:
+			hp.PolicySpecifier = &route.RouteAction_HashPolicy_Header_{
+				Header: &route.RouteAction_HashPolicy_Header{HeaderName: p.GetName()},
+			}
+		case constant.HashPolicyCookie:
+			cookie := &route.RouteAction_HashPolicy_Cookie{Name: p.GetName(), Path: p.GetCookiePath()}
+			// 设置有效期后，请求未携带该 Cookie 时 Envoy 生成 Cookie 并在响应中返回，后续请求保持在同一后端
+			if p.GetCookieTtlSeconds() > 0 {
+				cookie.Ttl = durationpb.New(time.Duration(p.GetCookieTtlSeconds()) * time.Second)
+			}
+			hp.PolicySpecifier = &route.RouteAction_HashPolicy_Cookie_{Cookie: cookie}
+		case constant.HashPolicySourceIP:
+			hp.PolicySpecifier = &route.RouteAction_HashPolicy_ConnectionProperties_{
+				ConnectionProperties: &route.RouteAction_HashPolicy_ConnectionProperties{SourceIp: true},
+			}
+		case constant.HashPolicyQueryParameter:
+			hp.PolicySpecifier = &route.RouteAction_HashPolicy_QueryParameter_{
+				QueryParameter: &route.RouteAction_HashPolicy_QueryParameter{Name: p.GetName()},
+			}
+		default:
+			continue
+		}
+		out = append(out, hp)
+	}
+	return out
+}
+
+// ValidateHashPolicies 校验哈希策略：请求头、Cookie 与查询参数需要名称，Cookie 名称需合法，
+// 只有 Cookie 策略可以设置有效期与路径。Core 保存哈希策略时使用同一校验
+func ValidateHashPolicies(policies []*v1.HashPolicy) error {
+	for i, p := range policies {
+		typ := constant.ProxyHashPolicyType(p.GetType())
+		switch typ {
+		case constant.HashPolicyHeader, constant.HashPolicyQueryParameter:
+			if p.GetName() == "" {
+				return fmt.Errorf("hash policy %d: name is required", i)
+			}
+		case constant.HashPolicyCookie:
+			if p.GetName() == "" {
+				return fmt.Errorf("hash policy %d: cookie name is required", i)
+			}
+			if (&http.Cookie{Name: p.GetName(), Value: "v"}).Valid() != nil {
+				return fmt.Errorf("hash policy %d: invalid cookie name %q", i, p.GetName())
+			}
+			if path := p.GetCookiePath(); path != "" && (!strings.HasPrefix(path, "/") || strings.ContainsAny(path, ";\r\n")) {
+				return fmt.Errorf("hash policy %d: invalid cookie path %q", i, path)
+			}
+		case constant.HashPolicySourceIP:
+		default:
+			return fmt.Errorf("hash policy %d: unsupported type %d", i, p.GetType())
+		}
+		if typ != constant.HashPolicyCookie && (p.GetCookieTtlSeconds() > 0 || p.GetCookiePath() != "") {
+			return fmt.Errorf("hash policy %d: cookie ttl and path only apply to cookie policies", i)
+		}
+	}
+	return nil
+}
+
+// applyHashLbConfig 按负载均衡策略设置环哈希的环大小或 Maglev 的查找表大小，未设置时使用 Envoy 默认值
+func applyHashLbConfig(c *cluster.Cluster, u *v1.Upstream) {
+	switch c.GetLbPolicy() {
+	case cluster.Cluster_RING_HASH:
+		if u.GetRingMinSize() == 0 && u.GetRingMaxSize() == 0 {
+			return
+		}
+		config := &cluster.Cluster_RingHashLbConfig{}
+		if u.GetRingMinSize() > 0 {
+			config.MinimumRingSize = wrapperspb.UInt64(u.GetRingMinSize())
+		}
+		if u.GetRingMaxSize() > 0 {
+			config.MaximumRingSize = wrapperspb.UInt64(u.GetRingMaxSize())
+		}
+		c.LbConfig = &cluster.Cluster_RingHashLbConfig_{RingHashLbConfig: config}
+	case cluster.Cluster_MAGLEV:
+		if u.GetMaglevTableSize() == 0 {
+			return
+		}
+		c.LbConfig = &cluster.Cluster_MaglevLbConfig_{
+			MaglevLbConfig: &cluster.Cluster_MaglevLbConfig{TableSize: wrapperspb.UInt64(u.GetMaglevTableSize())},
+		}
+	}
+}
+
+// ValidateUpstreamHash 校验一致性哈希的表大小：环大小不超过 8M 且最小值不大于最大值，
+// Maglev 查找表大小必须为不超过 5000011 的质数。Core 保存上游服务负载均衡设置时使用同一校验
+func ValidateUpstreamHash(u *v1.Upstream) error {
+	if u.GetRingMinSize() > MaxRingSize || u.GetRingMaxSize() > MaxRingSize {
+		return fmt.Errorf("ring size must not exceed %d", MaxRingSize)
+	}
+	if u.GetRingMinSize() > 0 && u.GetRingMaxSize() > 0 && u.GetRingMinSize() > u.GetRingMaxSize() {
+		return errors.New("ring min size must not exceed ring max size")
+	}
+	if n := u.GetMaglevTableSize(); n > 0 {
+		if n > MaxMaglevTableSize {
+			return fmt.Errorf("maglev table size must not exceed %d", MaxMaglevTableSize)
+		}
+		if !new(big.Int).SetUint64(n).ProbablyPrime(0) {
+			return fmt.Errorf("maglev table size %d is not a prime", n)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,98 @@
+package envoy
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	v1 "github.com/lyonmu/quebec/idl/router/v1"
+	"github.com/lyonmu/quebec/pkg/constant"
+)
+
+// headerActions 请求头/响应头添加方式与 Envoy AppendAction 的映射
+var headerActions = map[constant.ProxyHeaderAction]core.HeaderValueOption_HeaderAppendAction{
+	constant.HeaderActionAppend:      core.HeaderValueOption_APPEND_IF_EXISTS_OR_ADD,
+	constant.HeaderActionOverwrite:   core.HeaderValueOption_OVERWRITE_IF_EXISTS_OR_ADD,
+	constant.HeaderActionAddIfAbsent: core.HeaderValueOption_ADD_IF_ABSENT,
+}
+
+var (
+	// headerNameRegex RFC 7230 中 token 允许的字符
+	headerNameRegex = regexp.MustCompile("^[!#$%&'*+.^_`|~0-9A-Za-z-]+$")
+	// formatCommandRegex 去掉开头 % 后的 Envoy 格式化变量，如 DOWNSTREAM_REMOTE_ADDRESS%、REQ(x-request-id):64%、START_TIME(%s)%
+	formatCommandRegex = regexp.MustCompile(`^[A-Z][A-Z0-9_]*(\([^()]*\))?(:[0-9]+)?%`)
+)
+
+// makeHeaderOptions 生成添加的请求头或响应头
+func makeHeaderOptions(values []*v1.HeaderValue) []*core.HeaderValueOption {
+	if len(values) == 0 {
+		return nil
+	}
+	options := make([]*core.HeaderValueOption, 0, len(values))
+	for _, v := range values {
+		options = append(options, &core.HeaderValueOption{
+			Header:       &core.HeaderValue{Key: v.GetName(), Value: v.GetValue()},
+			AppendAction: headerActions[constant.ProxyHeaderAction(v.GetAction())],
+		})
+	}
+	return options
+}
+
+// ValidateHeaderMutation 校验请求头与响应头改写：头名称需为合法 token，不能改写伪头(:path 等)与 host，
+// 值中的 % 需成对出现组成格式化变量，%% 表示字面量 %。Core 保存改写规则时使用同一校验
+func ValidateHeaderMutation(m *v1.HeaderMutation) error {
+	for _, v := range slices.Concat(m.GetRequestHeadersToAdd(), m.GetResponseHeadersToAdd()) {
+		if err := validateHeaderName(v.GetName()); err != nil {
+			return err
+		}
+		if _, ok := headerActions[constant.ProxyHeaderAction(v.GetAction())]; !ok {
+			return fmt.Errorf("unsupported action %d for header %q", v.GetAction(), v.GetName())
+		}
+		if err := validateHeaderFormat(v.GetValue()); err != nil {
+			return fmt.Errorf("invalid value for header %q: %w", v.GetName(), err)
+		}
+	}
+	for _, name := range slices.Concat(m.GetRequestHeadersToRemove(), m.GetResponseHeadersToRemove()) {
+		if err := validateHeaderName(name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateHeaderName Envoy 不允许改写伪头与 host
+func validateHeaderName(name string) error {
+	if strings.HasPrefix(name, ":") || strings.EqualFold(name, "host") {
+		return fmt.Errorf("header %q cannot be modified", name)
+	}
+	if !headerNameRegex.MatchString(name) {
+		return fmt.Errorf("invalid header name %q", name)
+	}
+	return nil
+}
+
+// validateHeaderFormat 校验值中的格式化变量
+func validateHeaderFormat(value string) error {
+	if strings.ContainsAny(value, "\r\n") {
+		return errors.New("value must not contain line breaks")
+	}
+	for rest := value; ; {
+		i := strings.IndexByte(rest, '%')
+		if i < 0 {
+			return nil
+		}
+		rest = rest[i+1:]
+		if strings.HasPrefix(rest, "%") {
+			rest = rest[1:]
+			continue
+		}
+		command := formatCommandRegex.FindString(rest)
+		if command == "" {
+			return fmt.Errorf("invalid format variable at %q, use %%%% for a literal %%", "%"+rest)
+		}
+		rest = rest[len(command):]
+	}
+}
@@ -0,0 +1,138 @@
+package envoy
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+
+	route "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	matcher "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
+	v1 "github.com/lyonmu/quebec/idl/router/v1"
+	"github.com/lyonmu/quebec/pkg/constant"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// MethodHeader Envoy 以伪请求头 :method 匹配 HTTP 方法
+const MethodHeader = ":method"
+
+// httpMethods 路由允许匹配的 HTTP 方法
+var httpMethods = map[string]struct{}{
+	http.MethodGet:     {},
+	http.MethodHead:    {},
+	http.MethodPost:    {},
+	http.MethodPut:     {},
+	http.MethodPatch:   {},
+	http.MethodDelete:  {},
+	http.MethodConnect: {},
+	http.MethodOptions: {},
+	http.MethodTrace:   {},
+}
+
+// makeRouteMatch 生成路由匹配条件：路径、HTTP 方法、请求头与查询参数需要同时满足
+func makeRouteMatch(r *v1.HttpRoute) *route.RouteMatch {
+	m := makePathMatch(r)
+	if r.GetIgnoreCase() {
+		m.CaseSensitive = wrapperspb.Bool(false)
+	}
+
+	if methods := r.GetMethods(); len(methods) > 0 {
+		h := &route.HeaderMatcher{Name: MethodHeader}
+		if len(methods) == 1 {
+			h.HeaderMatchSpecifier = &route.HeaderMatcher_StringMatch{
+				StringMatch: &matcher.StringMatcher{MatchPattern: &matcher.StringMatcher_Exact{Exact: methods[0]}},
+			}
+		} else {
+			h.HeaderMatchSpecifier = &route.HeaderMatcher_StringMatch{
+				StringMatch: &matcher.StringMatcher{MatchPattern: &matcher.StringMatcher_SafeRegex{
+					SafeRegex: &matcher.RegexMatcher{Regex: fmt.Sprintf("^(%s)$", strings.Join(methods, "|"))},
+				}},
+			}
+		}
+		m.Headers = append(m.Headers, h)
+	}
+
+	for _, hm := range r.GetHeaders() {
+		h := &route.HeaderMatcher{Name: hm.GetName(), InvertMatch: hm.GetInvert()}
+		switch constant.ProxyHttpMatcherType(hm.GetType()) {
+		case constant.HttpMatcherTypePresent:
+			h.HeaderMatchSpecifier = &route.HeaderMatcher_PresentMatch{PresentMatch: true}
+		case constant.HttpMatcherTypeAbsent:
+			// present_match 为 false 时请求头不存在才匹配
+			h.HeaderMatchSpecifier = &route.HeaderMatcher_PresentMatch{PresentMatch: false}
+		default:
+			h.HeaderMatchSpecifier = &route.HeaderMatcher_StringMatch{StringMatch: makeStringMatcher(hm)}
+		}
+		m.Headers = append(m.Headers, h)
+	}
+
+	for _, qm := range r.GetQueryParameters() {
+		q := &route.QueryParameterMatcher{Name: qm.GetName()}
+		if constant.ProxyHttpMatcherType(qm.GetType()) == constant.HttpMatcherTypePresent {
+			q.QueryParameterMatchSpecifier = &route.QueryParameterMatcher_PresentMatch{PresentMatch: true}
+		} else {
+			q.QueryParameterMatchSpecifier = &route.QueryParameterMatcher_StringMatch{StringMatch: makeStringMatcher(qm)}
+		}
+		m.QueryParameters = append(m.QueryParameters, q)
+	}
+
+	return m
+}
+
+func makeStringMatcher(m *v1.HttpMatcher) *matcher.StringMatcher {
+	if constant.ProxyHttpMatcherType(m.GetType()) == constant.HttpMatcherTypeRegex {
+		return &matcher.StringMatcher{MatchPattern: &matcher.StringMatcher_SafeRegex{
+			SafeRegex: &matcher.RegexMatcher{Regex: m.GetValue()},
+		}}
+	}
+	return &matcher.StringMatcher{
+		MatchPattern: &matcher.StringMatcher_Exact{Exact: m.GetValue()},
+		IgnoreCase:   m.GetIgnoreCase(),
+	}
+}
+
+// ValidateRouteMatch 校验 HTTP 方法与请求头、查询参数匹配条件，正则需能按 RE2 语法编译。
+// Core 保存匹配条件时使用同一校验
+func ValidateRouteMatch(r *v1.HttpRoute) error {
+	for _, method := range r.GetMethods() {
+		if _, ok := httpMethods[method]; !ok {
+			return fmt.Errorf("unsupported http method %q", method)
+		}
+	}
+	for _, h := range r.GetHeaders() {
+		if err := validateMatcher(h, true); err != nil {
+			return fmt.Errorf("header %q: %w", h.GetName(), err)
+		}
+	}
+	for _, q := range r.GetQueryParameters() {
+		if err := validateMatcher(q, false); err != nil {
+			return fmt.Errorf("query parameter %q: %w", q.GetName(), err)
+		}
+	}
+	return nil
+}
+
+func validateMatcher(m *v1.HttpMatcher, header bool) error {
+	if m.GetName() == "" {
+		return errors.New("name is required")
+	}
+	if !header && m.GetInvert() {
+		return errors.New("invert is only supported for headers")
+	}
+	switch constant.ProxyHttpMatcherType(m.GetType()) {
+	case constant.HttpMatcherTypeExact:
+	case constant.HttpMatcherTypeRegex:
+		if _, err := regexp.Compile(m.GetValue()); err != nil {
+			return fmt.Errorf("invalid regex: %w", err)
+		}
+	case constant.HttpMatcherTypePresent:
+	case constant.HttpMatcherTypeAbsent:
+		if !header {
+			return errors.New("absent match is only supported for headers")
+		}
+	default:
+		return fmt.Errorf("unsupported match type %d", m.GetType())
+	}
+	return nil
+}
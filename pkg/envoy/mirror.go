@@ -0,0 +1,52 @@
+package envoy
+
+import (
+	"fmt"
+	"math"
+	"slices"
+
+	core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	route "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	typev3 "github.com/envoyproxy/go-control-plane/envoy/type/v3"
+	v1 "github.com/lyonmu/quebec/idl/router/v1"
+)
+
+// MirrorRuntimeKey 流量镜像比例的 runtime key，可通过 Envoy runtime 临时调整镜像比例而无需重新下发
+func MirrorRuntimeKey(routeID string) string {
+	return fmt.Sprintf("%s.%s", MirrorRuntimePrefix, routeID)
+}
+
+// makeMirrorPolicies 生成流量镜像策略，Envoy 以 fire-and-forget 方式发送镜像请求并丢弃其响应，不影响原请求
+func makeMirrorPolicies(r *v1.HttpRoute) []*route.RouteAction_RequestMirrorPolicy {
+	if r.GetMirrorUpstreamId() == "" {
+		return nil
+	}
+	return []*route.RouteAction_RequestMirrorPolicy{{
+		Cluster: ClusterName(r.GetMirrorUpstreamId()),
+		RuntimeFraction: &core.RuntimeFractionalPercent{
+			DefaultValue: fractionalPercent(r.GetMirrorPercent()),
+			RuntimeKey:   MirrorRuntimeKey(r.GetId()),
+		},
+	}}
+}
+
+// fractionalPercent 将百分比按万分比转换为 Envoy FractionalPercent，保留两位小数
+func fractionalPercent(percent float64) *typev3.FractionalPercent {
+	return &typev3.FractionalPercent{
+		Numerator:   uint32(math.Round(percent * 100)),
+		Denominator: typev3.FractionalPercent_TEN_THOUSAND,
+	}
+}
+
+// ValidateRouteMirror 校验流量镜像：影子上游不能是路由自身的转发目标，镜像比例需在 (0, 100] 之间。
+// Core 保存流量镜像时使用同一校验
+func ValidateRouteMirror(r *v1.HttpRoute) error {
+	id := r.GetMirrorUpstreamId()
+	if id == "" {
+		return nil
+	}
+	if slices.Contains(RouteUpstreamIDs(r), id) {
+		return fmt.Errorf("mirror upstream %q is also a target of the route", id)
+	}
+	return validatePercent("mirror", r.GetMirrorPercent())
+}
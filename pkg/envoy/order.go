@@ -0,0 +1,141 @@
+package envoy
+
+import (
+	"cmp"
+	"regexp"
+	"slices"
+	"strings"
+
+	v1 "github.com/lyonmu/quebec/idl/router/v1"
+	"github.com/lyonmu/quebec/pkg/constant"
+	"google.golang.org/protobuf/proto"
+)
+
+// SortRoutes 返回按 Envoy 匹配顺序排列的路由副本：优先级高的在前；优先级相同时精确匹配在前、正则其次、前缀最后，
+// 匹配规则越长、附加条件(HTTP 方法、请求头、查询参数)越多越靠前，最后按路由ID排序，保证各 Gateway 下发的顺序一致
+func SortRoutes(routes []*v1.HttpRoute) []*v1.HttpRoute {
+	sorted := slices.Clone(routes)
+	slices.SortStableFunc(sorted, compareRoutes)
+	return sorted
+}
+
+func compareRoutes(a, b *v1.HttpRoute) int {
+	return cmp.Or(
+		cmp.Compare(b.GetPriority(), a.GetPriority()),
+		cmp.Compare(matchRank(a), matchRank(b)),
+		cmp.Compare(len(pathPattern(b)), len(pathPattern(a))),
+		cmp.Compare(conditionCount(b), conditionCount(a)),
+		strings.Compare(a.GetId(), b.GetId()),
+	)
+}
+
+func matchRank(r *v1.HttpRoute) int {
+	switch constant.ProxyHttpRouteMatchType(r.GetMatchType()) {
+	case constant.HttpRouteMatchTypeExact:
+		return 0
+	case constant.HttpRouteMatchTypeRegex:
+		return 1
+	default:
+		return 2
+	}
+}
+
+// pathPattern 返回下发给 Envoy 的路径匹配规则，前缀匹配去掉末尾的 *
+func pathPattern(r *v1.HttpRoute) string {
+	if matchRank(r) == 2 {
+		return strings.TrimSuffix(r.GetMatchPattern(), "*")
+	}
+	return r.GetMatchPattern()
+}
+
+func conditionCount(r *v1.HttpRoute) int {
+	n := len(r.GetHeaders()) + len(r.GetQueryParameters())
+	if len(r.GetMethods()) > 0 {
+		n++
+	}
+	return n
+}
+
+// RouteConflicts 返回与路由 r 相互覆盖的路由：shadowedBy 排在 r 之前且覆盖 r，使 r 永远不会命中；
+// shadows 排在 r 之后且被 r 覆盖，同样永远不会命中。others 应为与 r 位于同一虚拟主机、同时下发的路由
+func RouteConflicts(r *v1.HttpRoute, others []*v1.HttpRoute) (shadowedBy, shadows []*v1.HttpRoute) {
+	for _, o := range others {
+		if o.GetId() == r.GetId() {
+			continue
+		}
+		if compareRoutes(o, r) < 0 {
+			if RouteShadows(o, r) {
+				shadowedBy = append(shadowedBy, o)
+			}
+		} else if RouteShadows(r, o) {
+			shadows = append(shadows, o)
+		}
+	}
+	return shadowedBy, shadows
+}
+
+// RouteShadows 判断命中路由 b 的请求是否一定命中路由 a，a 排在 b 之前时 b 永远不会命中。
+// 无法判断两个不同正则的包含关系，此时视为不覆盖
+func RouteShadows(a, b *v1.HttpRoute) bool {
+	return pathShadows(a, b) &&
+		methodsShadow(a.GetMethods(), b.GetMethods()) &&
+		matchersShadow(a.GetHeaders(), b.GetHeaders()) &&
+		matchersShadow(a.GetQueryParameters(), b.GetQueryParameters())
+}
+
+func pathShadows(a, b *v1.HttpRoute) bool {
+	ap, bp := pathPattern(a), pathPattern(b)
+	switch constant.ProxyHttpRouteMatchType(a.GetMatchType()) {
+	case constant.HttpRouteMatchTypeRegex:
+		switch constant.ProxyHttpRouteMatchType(b.GetMatchType()) {
+		case constant.HttpRouteMatchTypeRegex:
+			return ap == bp
+		case constant.HttpRouteMatchTypeExact:
+			// Envoy 的正则需要匹配完整路径
+			re, err := regexp.Compile("^(?:" + ap + ")$")
+			return err == nil && !b.GetIgnoreCase() && re.MatchString(bp)
+		}
+		return false
+	}
+
+	// 前缀为 / 时匹配所有路径
+	if matchRank(a) == 2 && (ap == "" || ap == "/") {
+		return true
+	}
+	if matchRank(b) == 1 || (b.GetIgnoreCase() && !a.GetIgnoreCase()) {
+		return false
+	}
+	if a.GetIgnoreCase() {
+		ap, bp = strings.ToLower(ap), strings.ToLower(bp)
+	}
+	if matchRank(a) == 0 {
+		return matchRank(b) == 0 && ap == bp
+	}
+	return strings.HasPrefix(bp, ap)
+}
+
+// methodsShadow a 不限制方法，或 b 限制的方法都在 a 中
+func methodsShadow(a, b []string) bool {
+	if len(a) == 0 {
+		return true
+	}
+	if len(b) == 0 {
+		return false
+	}
+	for _, m := range b {
+		if !slices.Contains(a, m) {
+			return false
+		}
+	}
+	return true
+}
+
+// matchersShadow a 的每个匹配条件都原样出现在 b 中
+func matchersShadow(a, b []*v1.HttpMatcher) bool {
+	for _, m := range a {
+		if !slices.ContainsFunc(b, func(o *v1.HttpMatcher) bool { return proto.Equal(m, o) }) {
+			return false
+		}
+	}
+	return true
+}
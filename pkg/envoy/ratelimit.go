@@ -0,0 +1,210 @@
+package envoy
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	ratelimitconf "github.com/envoyproxy/go-control-plane/envoy/config/ratelimit/v3"
+	route "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	localratelimit "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/local_ratelimit/v3"
+	ratelimit "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/ratelimit/v3"
+	typev3 "github.com/envoyproxy/go-control-plane/envoy/type/v3"
+	v1 "github.com/lyonmu/quebec/idl/router/v1"
+	"github.com/lyonmu/quebec/pkg/constant"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+const (
+	// minFillInterval Envoy 要求令牌桶补充间隔不小于 50ms
+	minFillInterval = 50 * time.Millisecond
+	// rateLimitTimeout 调用 Gateway 限流服务的超时时间，超时或出错时放行请求
+	rateLimitTimeout = 100 * time.Millisecond
+)
+
+// Envoy 为固定键值与客户端地址生成的默认描述符键
+const (
+	GenericKeyDescriptor    = "generic_key"
+	RemoteAddressDescriptor = "remote_address"
+)
+
+// makeLocalRateLimit 生成令牌桶本地限流配置，未配置时返回 nil。
+// 监听器上的配置作用于整个监听器，路由上的配置会替换监听器的配置并使用路由独立的令牌桶
+func makeLocalRateLimit(l *v1.LocalRateLimit) *localratelimit.LocalRateLimit {
+	if l == nil {
+		return nil
+	}
+
+	status := l.GetStatusCode()
+	if status == 0 {
+		status = constant.DefaultRateLimitStatus
+	}
+	tokensPerFill := l.GetTokensPerFill()
+	if tokensPerFill == 0 {
+		tokensPerFill = 1
+	}
+	rl := &localratelimit.LocalRateLimit{
+		StatPrefix: LocalRateLimitStatPrefix,
+		Status:     &typev3.HttpStatus{Code: typev3.StatusCode(status)},
+		TokenBucket: &typev3.TokenBucket{
+			MaxTokens:     l.GetMaxTokens(),
+			TokensPerFill: wrapperspb.UInt32(tokensPerFill),
+			FillInterval:  durationpb.New(time.Duration(l.GetFillIntervalMs()) * time.Millisecond),
+		},
+		// Envoy 默认对 0% 的请求启用并执行限流，需显式设置为全部请求
+		FilterEnabled:  fullRuntimePercent(LocalRateLimitStatPrefix + "_enabled"),
+		FilterEnforced: fullRuntimePercent(LocalRateLimitStatPrefix + "_enforced"),
+	}
+	if name := l.GetHeaderName(); name != "" {
+		rl.ResponseHeadersToAdd = []*core.HeaderValueOption{{
+			Header:       &core.HeaderValue{Key: name, Value: l.GetHeaderValue()},
+			AppendAction: core.HeaderValueOption_OVERWRITE_IF_EXISTS_OR_ADD,
+		}}
+	}
+	return rl
+}
+
+func fullRuntimePercent(key string) *core.RuntimeFractionalPercent {
+	return &core.RuntimeFractionalPercent{
+		DefaultValue: &typev3.FractionalPercent{Numerator: 100, Denominator: typev3.FractionalPercent_HUNDRED},
+		RuntimeKey:   key,
+	}
+}
+
+// ValidateLocalRateLimit 校验本地限流：令牌桶容量与每次补充的令牌数需大于 0，补充间隔不小于 50ms，
+// 状态码需在 [400, 600) 之间，响应头名称需为合法 token。Core 保存本地限流时使用同一校验
+func ValidateLocalRateLimit(l *v1.LocalRateLimit) error {
+	if l == nil {
+		return nil
+	}
+	if l.GetMaxTokens() == 0 {
+		return errors.New("max tokens must be greater than 0")
+	}
+	if l.GetTokensPerFill() > l.GetMaxTokens() {
+		return fmt.Errorf("tokens per fill %d exceeds max tokens %d", l.GetTokensPerFill(), l.GetMaxTokens())
+	}
+	if d := time.Duration(l.GetFillIntervalMs()) * time.Millisecond; d < minFillInterval {
+		return fmt.Errorf("fill interval %v must be at least %v", d, minFillInterval)
+	}
+	if s := l.GetStatusCode(); s != 0 && (s < 400 || s >= 600) {
+		return fmt.Errorf("invalid rate limit status %d", s)
+	}
+	if l.GetHeaderName() == "" && l.GetHeaderValue() != "" {
+		return errors.New("rate limit header value requires a header name")
+	}
+	if name := l.GetHeaderName(); name != "" {
+		if err := validateHeaderName(name); err != nil {
+			return err
+		}
+		if err := validateHeaderFormat(l.GetHeaderValue()); err != nil {
+			return fmt.Errorf("invalid value for header %q: %w", name, err)
+		}
+	}
+	return nil
+}
+
+// makeRateLimitFilter 生成全局限流过滤器，描述符发送到 Gateway 的限流服务，
+// 限流服务不可用时放行请求，只有配置了全局限流描述符条目的路由才会调用限流服务
+func makeRateLimitFilter() *ratelimit.RateLimit {
+	return &ratelimit.RateLimit{
+		Domain:                  RateLimitDomain,
+		Timeout:                 durationpb.New(rateLimitTimeout),
+		FailureModeDeny:         false,
+		EnableXRatelimitHeaders: ratelimit.RateLimit_DRAFT_VERSION_03,
+		RateLimitService: &ratelimitconf.RateLimitServiceConfig{
+			GrpcService: &core.GrpcService{
+				TargetSpecifier: &core.GrpcService_EnvoyGrpc_{
+					EnvoyGrpc: &core.GrpcService_EnvoyGrpc{
+						ClusterName: GatewayClusterName,
+					},
+				},
+			},
+			TransportApiVersion: core.ApiVersion_V3,
+		},
+	}
+}
+
+// makeRateLimits 按描述符条目顺序生成路由的全局限流描述符，未配置时返回 nil。
+// 请求头或查询参数不存在时 Envoy 不生成描述符，该请求不受全局限流约束
+func makeRateLimits(actions []*v1.RateLimitAction) []*route.RateLimit {
+	if len(actions) == 0 {
+		return nil
+	}
+	rl := &route.RateLimit{Actions: make([]*route.RateLimit_Action, 0, len(actions))}
+	for _, a := range actions {
+		action := &route.RateLimit_Action{}
+		switch constant.ProxyRateLimitActionType(a.GetType()) {
+		case constant.RateLimitActionGenericKey:
+			action.ActionSpecifier = &route.RateLimit_Action_GenericKey_{
+				GenericKey: &route.RateLimit_Action_GenericKey{DescriptorKey: a.GetDescriptorKey(), DescriptorValue: a.GetDescriptorValue()},
+			}
+		case constant.RateLimitActionRequestHeader:
+			action.ActionSpecifier = &route.RateLimit_Action_RequestHeaders_{
+				RequestHeaders: &route.RateLimit_Action_RequestHeaders{HeaderName: a.GetName(), DescriptorKey: a.GetDescriptorKey()},
+			}
+		case constant.RateLimitActionRemoteAddress:
+			action.ActionSpecifier = &route.RateLimit_Action_RemoteAddress_{
+				RemoteAddress: &route.RateLimit_Action_RemoteAddress{},
+			}
+		case constant.RateLimitActionQueryParameter:
+			action.ActionSpecifier = &route.RateLimit_Action_QueryParameters_{
+				QueryParameters: &route.RateLimit_Action_QueryParameters{QueryParameterName: a.GetName(), DescriptorKey: a.GetDescriptorKey()},
+			}
+		}
+		rl.Actions = append(rl.Actions, action)
+	}
+	return []*route.RateLimit{rl}
+}
+
+// ValidateRateLimitActions 校验路由的全局限流描述符条目：固定键值需指定值，请求头与查询参数需指定名称与描述符键，
+// 客户端地址不能自定义描述符键。Core 保存描述符条目时使用同一校验
+func ValidateRateLimitActions(actions []*v1.RateLimitAction) error {
+	for i, a := range actions {
+		switch constant.ProxyRateLimitActionType(a.GetType()) {
+		case constant.RateLimitActionGenericKey:
+			if a.GetDescriptorValue() == "" {
+				return fmt.Errorf("action %d: generic key requires a descriptor value", i)
+			}
+		case constant.RateLimitActionRequestHeader:
+			if err := validateHeaderName(a.GetName()); err != nil {
+				return fmt.Errorf("action %d: %w", i, err)
+			}
+			if a.GetDescriptorKey() == "" {
+				return fmt.Errorf("action %d: request header requires a descriptor key", i)
+			}
+		case constant.RateLimitActionRemoteAddress:
+			if a.GetDescriptorKey() != "" && a.GetDescriptorKey() != RemoteAddressDescriptor {
+				return fmt.Errorf("action %d: remote address descriptor key is always %s", i, RemoteAddressDescriptor)
+			}
+		case constant.RateLimitActionQueryParameter:
+			if a.GetName() == "" || a.GetDescriptorKey() == "" {
+				return fmt.Errorf("action %d: query parameter requires a name and a descriptor key", i)
+			}
+		default:
+			return fmt.Errorf("action %d: unsupported type %d", i, a.GetType())
+		}
+	}
+	return nil
+}
+
+// ValidateRateLimitRule 校验全局限流规则：至少包含一个描述符条目且键不能为空，计数周期为秒、分钟、小时或天，
+// 每个周期允许的请求数需大于 0。Core 保存规则时使用同一校验
+func ValidateRateLimitRule(r *v1.RateLimitRule) error {
+	if len(r.GetDescriptors()) == 0 {
+		return errors.New("at least one descriptor is required")
+	}
+	for i, d := range r.GetDescriptors() {
+		if d.GetKey() == "" {
+			return fmt.Errorf("descriptor %d: key is required", i)
+		}
+	}
+	if u := constant.ProxyRateLimitUnit(r.GetUnit()); u < constant.RateLimitUnitSecond || u > constant.RateLimitUnitDay {
+		return fmt.Errorf("unsupported unit %d", r.GetUnit())
+	}
+	if r.GetRequestsPerUnit() == 0 {
+		return errors.New("requests per unit must be greater than 0")
+	}
+	return nil
+}
@@ -0,0 +1,523 @@
+package envoy
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	accesslogv3 "github.com/envoyproxy/go-control-plane/envoy/config/accesslog/v3"
+	cluster "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	endpoint "github.com/envoyproxy/go-control-plane/envoy/config/endpoint/v3"
+	listener "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	route "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	accessloggrpcv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/access_loggers/grpc/v3"
+	cors "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/cors/v3"
+	extauthz "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/ext_authz/v3"
+	fault "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/fault/v3"
+	localratelimit "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/local_ratelimit/v3"
+	router "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/router/v3"
+	hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+
+	v1 "github.com/lyonmu/quebec/idl/router/v1"
+	"github.com/lyonmu/quebec/pkg/constant"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// ClusterName 返回上游服务对应的 Envoy Cluster 名称
+func ClusterName(upstreamID string) string {
+	return fmt.Sprintf("%s_%s", UpstreamPrefix, upstreamID)
+}
+
+// ListenerName 返回 L7 监听器对应的 Envoy Listener 名称
+func ListenerName(listenerID string) string {
+	return fmt.Sprintf("%s_%s", ListenerPrefix, listenerID)
+}
+
+// VirtualHostName 返回虚拟主机对应的 Envoy VirtualHost 名称
+func VirtualHostName(vhostID string) string {
+	return fmt.Sprintf("%s_%s", VirtualHostPrefix, vhostID)
+}
+
+// RouteConfigName 返回 L7 监听器引用的 RouteConfiguration 名称
+func RouteConfigName(listenerID string) string {
+	return fmt.Sprintf("%s_%s", RoutePrefix, listenerID)
+}
+
+func MakeEndpoint(u *v1.Upstream) *endpoint.ClusterLoadAssignment {
+	lbEndpoints := make([]*endpoint.LbEndpoint, 0, len(u.Hosts))
+	for _, h := range u.Hosts {
+		lbEndpoint := &endpoint.LbEndpoint{
+			HostIdentifier: &endpoint.LbEndpoint_Endpoint{
+				Endpoint: &endpoint.Endpoint{
+					Address: &core.Address{
+						Address: &core.Address_SocketAddress{
+							SocketAddress: &core.SocketAddress{
+								Protocol: core.SocketAddress_TCP,
+								Address:  h.Address,
+								PortSpecifier: &core.SocketAddress_PortValue{
+									PortValue: h.Port,
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+		// 权重必须大于 0，未设置时使用 Envoy 默认值
+		if h.Weight > 0 {
+			lbEndpoint.LoadBalancingWeight = wrapperspb.UInt32(h.Weight)
+		}
+		lbEndpoints = append(lbEndpoints, lbEndpoint)
+	}
+
+	return &endpoint.ClusterLoadAssignment{
+		ClusterName: ClusterName(u.Id),
+		Endpoints: []*endpoint.LocalityLbEndpoints{
+			{LbEndpoints: lbEndpoints},
+		},
+	}
+}
+
+func MakeCluster(u *v1.Upstream) *cluster.Cluster {
+	lbPolicy, ok := LBPolicyMap[constant.ProxyLbPolicy(u.LbPolicy)]
+	if !ok {
+		lbPolicy = LBPolicyMap[constant.LbPolicyMaglev]
+	}
+
+	connectTimeout := time.Duration(u.ConnectTimeoutMs) * time.Millisecond
+	if connectTimeout <= 0 {
+		connectTimeout = constant.DefaultConnectTimeoutMs * time.Millisecond
+	}
+
+	c := &cluster.Cluster{
+		Name:                 ClusterName(u.Id),
+		ConnectTimeout:       durationpb.New(connectTimeout),
+		ClusterDiscoveryType: &cluster.Cluster_Type{Type: cluster.Cluster_EDS},
+		LbPolicy:             lbPolicy,
+		EdsClusterConfig: &cluster.Cluster_EdsClusterConfig{
+			EdsConfig: &core.ConfigSource{
+				ResourceApiVersion: core.ApiVersion_V3,
+				ConfigSourceSpecifier: &core.ConfigSource_Ads{
+					Ads: &core.AggregatedConfigSource{},
+				},
+			},
+			ServiceName: ClusterName(u.Id),
+		},
+		CircuitBreakers: &cluster.CircuitBreakers{
+			Thresholds: []*cluster.CircuitBreakers_Thresholds{
+				{
+					Priority:           core.RoutingPriority_DEFAULT,
+					MaxConnections:     positiveUInt32(u.MaxConnections),
+					MaxPendingRequests: positiveUInt32(u.MaxPendingRequests),
+					MaxRequests:        positiveUInt32(u.MaxRequests),
+					MaxRetries:         positiveUInt32(u.MaxRetries),
+				},
+			},
+		},
+		DnsLookupFamily: cluster.Cluster_AUTO,
+		// 添加 DNS 解析相关配置
+		RespectDnsTtl:  true,
+		DnsRefreshRate: durationpb.New(30 * time.Second),
+	}
+	applyHashLbConfig(c, u)
+	return c
+}
+
+// positiveUInt32 仅在值大于 0 时返回包装值，否则交由 Envoy 使用默认值
+func positiveUInt32(v int32) *wrapperspb.UInt32Value {
+	if v <= 0 {
+		return nil
+	}
+	return wrapperspb.UInt32(uint32(v))
+}
+
+// RouteUpstreamIDs 返回路由转发的所有上游服务ID：配置加权分流时为各分流目标，否则为 upstream_id。
+// 重定向路由不转发请求，返回空
+func RouteUpstreamIDs(r *v1.HttpRoute) []string {
+	if !routeForwards(r) {
+		return nil
+	}
+	if len(r.GetTargets()) == 0 {
+		return []string{r.GetUpstreamId()}
+	}
+	ids := make([]string, 0, len(r.GetTargets()))
+	for _, t := range r.GetTargets() {
+		ids = append(ids, t.GetUpstreamId())
+	}
+	return ids
+}
+
+// routeForwards 路由是否转发到上游服务，重定向与直接响应的路由由 Envoy 直接返回
+func routeForwards(r *v1.HttpRoute) bool {
+	return !r.GetEnableRedirect() && r.GetDirectResponse() == nil
+}
+
+func MakeRoute(r *v1.HttpRoute) *route.Route {
+
+	routeConfig := &route.Route{
+		Name:  r.Id,
+		Match: makeRouteMatch(r),
+		Action: &route.Route_Route{
+			Route: &route.RouteAction{
+				ClusterSpecifier: &route.RouteAction_Cluster{
+					Cluster: ClusterName(r.UpstreamId),
+				},
+			},
+		},
+	}
+
+	if m := r.GetHeaderMutation(); m != nil {
+		routeConfig.RequestHeadersToAdd = makeHeaderOptions(m.GetRequestHeadersToAdd())
+		routeConfig.RequestHeadersToRemove = m.GetRequestHeadersToRemove()
+		routeConfig.ResponseHeadersToAdd = makeHeaderOptions(m.GetResponseHeadersToAdd())
+		routeConfig.ResponseHeadersToRemove = m.GetResponseHeadersToRemove()
+	}
+
+	routeConfig.TypedPerFilterConfig = makeRouteFilterConfigs(r)
+
+	// 重定向路由直接返回 3xx，不转发到上游服务
+	if r.GetEnableRedirect() {
+		redirect, _ := parseRedirect(r)
+		routeConfig.Action = &route.Route_Redirect{Redirect: redirect}
+		return routeConfig
+	}
+
+	// 直接响应的路由由 Envoy 返回固定内容，不转发到上游服务
+	if d := r.GetDirectResponse(); d != nil {
+		action, contentType := makeDirectResponse(d)
+		routeConfig.Action = &route.Route_DirectResponse{DirectResponse: action}
+		routeConfig.ResponseHeadersToAdd = append(routeConfig.ResponseHeadersToAdd, contentType)
+		return routeConfig
+	}
+
+	// 配置加权分流时按权重转发到多个上游服务
+	if len(r.GetTargets()) > 0 {
+		clusters := make([]*route.WeightedCluster_ClusterWeight, 0, len(r.GetTargets()))
+		for _, t := range r.GetTargets() {
+			clusters = append(clusters, &route.WeightedCluster_ClusterWeight{
+				Name:   ClusterName(t.GetUpstreamId()),
+				Weight: wrapperspb.UInt32(t.GetWeight()),
+			})
+		}
+		routeConfig.GetRoute().ClusterSpecifier = &route.RouteAction_WeightedClusters{
+			WeightedClusters: &route.WeightedCluster{Clusters: clusters},
+		}
+	}
+
+	if r.TimeoutMs > 0 {
+		routeConfig.GetRoute().Timeout = durationpb.New(time.Duration(r.TimeoutMs) * time.Millisecond)
+	}
+
+	routeConfig.GetRoute().RetryPolicy = makeRetryPolicy(r.GetRetryPolicy())
+	routeConfig.GetRoute().RequestMirrorPolicies = makeMirrorPolicies(r)
+	routeConfig.GetRoute().RateLimits = makeRateLimits(r.GetRateLimitActions())
+	routeConfig.GetRoute().HashPolicy = makeHashPolicies(r.GetHashPolicies())
+
+	if r.GetEnablePathRewrite() {
+		prefix, regex, _ := parsePathRewrite(r)
+		if regex != nil {
+			routeConfig.GetRoute().RegexRewrite = regex
+		} else {
+			routeConfig.GetRoute().PrefixRewrite = prefix
+		}
+	}
+
+	// 如果开启权限认证，则添加 ext_authz 配置
+	// if global.Cfg.AuthConfig.Enabled {
+	// 如果需要权限校验，添加 ext_authz 配置
+	// if !s.NoAuth {
+	// 	routeConfig.TypedPerFilterConfig = map[string]*anypb.Any{
+	// 		"envoy.filters.http.ext_authz": createExtAuthzGrpcConfig(),
+	// 	}
+	// }
+	// }
+
+	return routeConfig
+}
+
+// makeRouteFilterConfigs 生成路由级的过滤器配置 (typed_per_filter_config)，未配置时返回 nil
+func makeRouteFilterConfigs(r *v1.HttpRoute) map[string]*anypb.Any {
+	configs := make(map[string]*anypb.Any)
+	if f := makeFault(r.GetFaultPolicy()); f != nil {
+		configs[FaultFilterName], _ = anypb.New(f)
+	}
+	if c := makeCorsPolicy(r.GetCorsPolicy()); c != nil {
+		configs[CorsFilterName], _ = anypb.New(c)
+	}
+	if rl := makeLocalRateLimit(r.GetLocalRateLimit()); rl != nil {
+		configs[LocalRateLimitFilterName], _ = anypb.New(rl)
+	}
+	if r.GetDisableCompression() {
+		disabled, _ := anypb.New(makeCompressionDisabled())
+		for _, name := range CompressorFilterNames() {
+			configs[name] = disabled
+		}
+	}
+	if len(configs) == 0 {
+		return nil
+	}
+	return configs
+}
+
+// createExtAuthzGrpcConfig 创建 gRPC ext_authz 配置
+func createExtAuthzGrpcConfig() *anypb.Any {
+	extAuthzConfig := &extauthz.ExtAuthz{
+		Services: &extauthz.ExtAuthz_GrpcService{
+			GrpcService: &core.GrpcService{
+				TargetSpecifier: &core.GrpcService_EnvoyGrpc_{
+					EnvoyGrpc: &core.GrpcService_EnvoyGrpc{
+						ClusterName: GatewayClusterName,
+					},
+				},
+				Timeout: durationpb.New(60 * time.Second),
+			},
+		},
+		FailureModeAllow: false, // 认证失败时拒绝请求
+		WithRequestBody: &extauthz.BufferSettings{
+			MaxRequestBytes:     4096,
+			AllowPartialMessage: true,
+		},
+		ClearRouteCache: false,
+		// 设置 gRPC 服务的方法
+		TransportApiVersion: core.ApiVersion_V3,
+	}
+
+	config, _ := anypb.New(extAuthzConfig)
+	return config
+}
+
+// MakeRouteConfig 生成监听器的 RouteConfiguration：虚拟主机按域名承载各自的路由，
+// 未指定虚拟主机的路由放入默认虚拟主机 "*"，监听器已有虚拟主机声明 "*" 时并入该虚拟主机。
+// 不属于 vhosts 的虚拟主机的路由被忽略，各虚拟主机内的路由按 SortRoutes 排序。
+// maintenance 为网关集群的维护模式，作用于未单独开启维护模式的虚拟主机
+func MakeRouteConfig(name string, vhosts []*v1.VirtualHost, routes []*v1.HttpRoute, maintenance *v1.Maintenance) *route.RouteConfiguration {
+
+	var (
+		rc       = &route.RouteConfiguration{Name: name, MostSpecificHeaderMutationsWins: true} // 路由上的头改写优先于虚拟主机
+		byID     = make(map[string]*route.VirtualHost, len(vhosts))
+		fallback *route.VirtualHost
+	)
+	for _, vh := range vhosts {
+		v := &route.VirtualHost{
+			Name:                    VirtualHostName(vh.GetId()),
+			Domains:                 vh.GetDomains(),
+			RequestHeadersToAdd:     makeHeaderOptions(vh.GetHeaderMutation().GetRequestHeadersToAdd()),
+			RequestHeadersToRemove:  vh.GetHeaderMutation().GetRequestHeadersToRemove(),
+			ResponseHeadersToAdd:    makeHeaderOptions(vh.GetHeaderMutation().GetResponseHeadersToAdd()),
+			ResponseHeadersToRemove: vh.GetHeaderMutation().GetResponseHeadersToRemove(),
+		}
+		if c := makeCorsPolicy(vh.GetCorsPolicy()); c != nil {
+			config, _ := anypb.New(c)
+			v.TypedPerFilterConfig = map[string]*anypb.Any{CorsFilterName: config}
+		}
+		if slices.Contains(vh.GetDomains(), "*") {
+			fallback = v
+		}
+		byID[vh.GetId()] = v
+		rc.VirtualHosts = append(rc.VirtualHosts, v)
+	}
+	implicit := fallback == nil
+	if implicit {
+		fallback = &route.VirtualHost{Name: VirtualHostPrefix, Domains: []string{"*"}} // 只允许一个 "*"
+		rc.VirtualHosts = append(rc.VirtualHosts, fallback)
+	}
+
+	// Envoy 按顺序匹配路由，先排序再分组，同一虚拟主机内的路由保持 SortRoutes 的顺序
+	for _, r := range SortRoutes(routes) {
+		if r.GetVirtualHostId() == "" {
+			fallback.Routes = append(fallback.Routes, MakeRoute(r))
+			continue
+		}
+		if v, ok := byID[r.GetVirtualHostId()]; ok {
+			v.Routes = append(v.Routes, MakeRoute(r))
+		}
+	}
+
+	// 维护模式在路由分组完成后替换虚拟主机的匹配逻辑，虚拟主机自身的维护模式优先于网关集群
+	for _, vh := range vhosts {
+		m := vh.GetMaintenance()
+		if m == nil {
+			m = maintenance
+		}
+		if m != nil {
+			applyMaintenance(byID[vh.GetId()], m)
+		}
+	}
+	if implicit && maintenance != nil {
+		applyMaintenance(fallback, maintenance)
+	}
+
+	return rc
+}
+
+// Options 控制渲染中与 Gateway 运行环境相关的部分
+type Options struct {
+	// AccessLog 为监听器添加指向 Gateway 的 gRPC 访问日志，Gateway 以 debug 日志级别运行时开启
+	AccessLog bool
+	// GlobalRateLimit Gateway 已启用全局限流服务 (--gateway.global_rate_limit)，未启用时不添加全局限流过滤器，路由的全局限流不生效
+	GlobalRateLimit bool
+}
+
+// Listener
+// globalRateLimit 为 true 时添加全局限流过滤器，只有路由配置了全局限流描述符条目时才需要调用 Gateway 的限流服务
+func MakeListener(l *v1.L7Listener, opts Options, globalRateLimit bool) (*listener.Listener, error) {
+	if err := ValidateLocalRateLimit(l.GetLocalRateLimit()); err != nil {
+		return nil, err
+	}
+	if err := ValidateCompression(l.GetCompression()); err != nil {
+		return nil, err
+	}
+	routerConfig, _ := anypb.New(&router.Router{})
+
+	// 创建 HTTP 过滤器列表
+	httpFilters := []*hcm.HttpFilter{}
+
+	// 如果启用认证，先添加 ext_authz 过滤器
+	// if global.Cfg.Gateway.EnableAuth {
+	// 	httpFilters = append(httpFilters, &hcm.HttpFilter{
+	// 		Name: "envoy.filters.http.ext_authz",
+	// 		ConfigType: &hcm.HttpFilter_TypedConfig{
+	// 			TypedConfig: createExtAuthzGrpcConfig(),
+	// 		},
+	// 	})
+	// }
+
+	// 跨域过滤器需在故障注入之前处理预检请求，默认不生效，由路由与虚拟主机的 typed_per_filter_config 按需启用
+	corsConfig, _ := anypb.New(&cors.Cors{})
+	httpFilters = append(httpFilters, &hcm.HttpFilter{
+		Name: CorsFilterName,
+		ConfigType: &hcm.HttpFilter_TypedConfig{
+			TypedConfig: corsConfig,
+		},
+	})
+
+	// 本地限流过滤器在故障注入之前执行，被限流的请求不再注入故障；监听器未配置限流时仅由路由按需启用
+	rateLimit := makeLocalRateLimit(l.GetLocalRateLimit())
+	if rateLimit == nil {
+		rateLimit = &localratelimit.LocalRateLimit{StatPrefix: LocalRateLimitStatPrefix}
+	}
+	rateLimitConfig, _ := anypb.New(rateLimit)
+	httpFilters = append(httpFilters, &hcm.HttpFilter{
+		Name: LocalRateLimitFilterName,
+		ConfigType: &hcm.HttpFilter_TypedConfig{
+			TypedConfig: rateLimitConfig,
+		},
+	})
+
+	// 全局限流过滤器在本地限流之后执行，已被本地限流拒绝的请求不再调用 Gateway 的限流服务
+	if globalRateLimit {
+		globalRateLimitConfig, _ := anypb.New(makeRateLimitFilter())
+		httpFilters = append(httpFilters, &hcm.HttpFilter{
+			Name: RateLimitFilterName,
+			ConfigType: &hcm.HttpFilter_TypedConfig{
+				TypedConfig: globalRateLimitConfig,
+			},
+		})
+	}
+
+	// 故障注入过滤器默认不生效，由路由的 typed_per_filter_config 按需启用
+	faultConfig, _ := anypb.New(&fault.HTTPFault{})
+	httpFilters = append(httpFilters, &hcm.HttpFilter{
+		Name: FaultFilterName,
+		ConfigType: &hcm.HttpFilter_TypedConfig{
+			TypedConfig: faultConfig,
+		},
+	})
+
+	// 响应压缩过滤器紧邻 router，监听器未配置时不添加，路由可通过 typed_per_filter_config 关闭压缩
+	httpFilters = append(httpFilters, makeCompressorFilters(l.GetCompression())...)
+
+	// router 过滤器必须是最后一个
+	httpFilters = append(httpFilters, &hcm.HttpFilter{
+		Name: HttpFilterName, // router 过滤器
+		ConfigType: &hcm.HttpFilter_TypedConfig{
+			TypedConfig: routerConfig,
+		},
+	})
+
+	manager := &hcm.HttpConnectionManager{
+		CodecType:  hcm.HttpConnectionManager_AUTO,
+		StatPrefix: HttpStatPrefixName,
+		RouteSpecifier: &hcm.HttpConnectionManager_Rds{
+			Rds: &hcm.Rds{
+				RouteConfigName: RouteConfigName(l.Id), // 对应 snapshot 的 key
+				ConfigSource: &core.ConfigSource{
+					ResourceApiVersion: core.ApiVersion_V3,
+					ConfigSourceSpecifier: &core.ConfigSource_Ads{
+						Ads: &core.AggregatedConfigSource{},
+					},
+				},
+			},
+		},
+		HttpFilters: httpFilters,
+		// HTTP 请求优化配置
+		RequestTimeout:      durationpb.New(60 * time.Second),    // 减少请求超时时间
+		StreamIdleTimeout:   durationpb.New(60 * time.Second),    // 减少流空闲超时
+		MaxRequestHeadersKb: &wrapperspb.UInt32Value{Value: 256}, // 限制请求头大小
+	}
+
+	// 按需添加 gRPC Access Log
+	if opts.AccessLog {
+		accessLogConfig, err := anypb.New(&accessloggrpcv3.HttpGrpcAccessLogConfig{
+			CommonConfig: &accessloggrpcv3.CommonGrpcAccessLogConfig{
+				LogName:             AccessLogName,
+				TransportApiVersion: core.ApiVersion_V3,
+				GrpcService: &core.GrpcService{
+					TargetSpecifier: &core.GrpcService_EnvoyGrpc_{
+						EnvoyGrpc: &core.GrpcService_EnvoyGrpc{
+							ClusterName: GatewayClusterName,
+						},
+					},
+					Timeout: durationpb.New(60 * time.Second),
+				},
+			},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("marshal HttpGrpcAccessLogConfig: %w", err)
+		}
+		manager.AccessLog = []*accesslogv3.AccessLog{
+			{
+				Name:       AccessLogName,
+				ConfigType: &accesslogv3.AccessLog_TypedConfig{TypedConfig: accessLogConfig},
+			},
+		}
+	}
+
+	pbst, err := anypb.New(manager)
+	if err != nil {
+		return nil, fmt.Errorf("marshal HttpConnectionManager: %w", err)
+	}
+
+	host := l.Host
+	if host == "" {
+		host = "0.0.0.0"
+	}
+
+	return &listener.Listener{
+		Name: ListenerName(l.Id),
+		Address: &core.Address{
+			Address: &core.Address_SocketAddress{
+				SocketAddress: &core.SocketAddress{
+					Protocol: core.SocketAddress_TCP,
+					Address:  host,
+					PortSpecifier: &core.SocketAddress_PortValue{
+						PortValue: l.Port,
+					},
+				},
+			},
+		},
+		FilterChains: []*listener.FilterChain{
+			{
+				Filters: []*listener.Filter{
+					{
+						Name:       ListenerFilterName,
+						ConfigType: &listener.Filter_TypedConfig{TypedConfig: pbst},
+					},
+				},
+			},
+		},
+	}, nil
+}
@@ -0,0 +1,81 @@
+package envoy
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	route "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	v1 "github.com/lyonmu/quebec/idl/router/v1"
+	"github.com/lyonmu/quebec/pkg/constant"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// retryConditions 路由允许配置的 Envoy x-envoy-retry-on 条件
+var retryConditions = map[constant.ProxyRetry]struct{}{
+	constant.RetryOn5xx:            {},
+	constant.RetryOnGatewayError:   {},
+	constant.RetryOnConnectFailure: {},
+	constant.RetryOnRetriable4xx:   {},
+	constant.RetryOnRefusedStream:  {},
+	constant.RetryOnReset:          {},
+	constant.RetryOnRetriableCodes: {},
+}
+
+// makeRetryPolicy 生成路由重试策略，未配置重试条件时返回 nil
+func makeRetryPolicy(p *v1.RetryPolicy) *route.RetryPolicy {
+	if len(p.GetRetryOn()) == 0 {
+		return nil
+	}
+
+	policy := &route.RetryPolicy{
+		RetryOn:              strings.Join(p.GetRetryOn(), ","),
+		NumRetries:           wrapperspb.UInt32(p.GetNumRetries()),
+		RetriableStatusCodes: p.GetRetriableStatusCodes(),
+	}
+	if p.GetPerTryTimeoutMs() > 0 {
+		policy.PerTryTimeout = durationpb.New(time.Duration(p.GetPerTryTimeoutMs()) * time.Millisecond)
+	}
+	if p.GetBackoffBaseMs() > 0 {
+		policy.RetryBackOff = &route.RetryPolicy_RetryBackOff{
+			BaseInterval: durationpb.New(time.Duration(p.GetBackoffBaseMs()) * time.Millisecond),
+		}
+		if p.GetBackoffMaxMs() > 0 {
+			policy.RetryBackOff.MaxInterval = durationpb.New(time.Duration(p.GetBackoffMaxMs()) * time.Millisecond)
+		}
+	}
+	return policy
+}
+
+// ValidateRetryPolicy 校验重试条件与退避间隔，配置可重试状态码时必须包含 retriable-status-codes 条件。
+// Core 保存重试策略时使用同一校验
+func ValidateRetryPolicy(p *v1.RetryPolicy) error {
+	if len(p.GetRetryOn()) == 0 {
+		return nil
+	}
+	for _, on := range p.GetRetryOn() {
+		if _, ok := retryConditions[constant.ProxyRetry(on)]; !ok {
+			return fmt.Errorf("unsupported retry condition %q", on)
+		}
+	}
+	if len(p.GetRetriableStatusCodes()) > 0 && !slices.Contains(p.GetRetryOn(), string(constant.RetryOnRetriableCodes)) {
+		return fmt.Errorf("retriable status codes require the %q condition", constant.RetryOnRetriableCodes)
+	}
+	for _, c := range p.GetRetriableStatusCodes() {
+		if c < 100 || c > 599 {
+			return fmt.Errorf("invalid retriable status code %d", c)
+		}
+	}
+	if p.GetBackoffMaxMs() > 0 {
+		if p.GetBackoffBaseMs() == 0 {
+			return errors.New("backoff max interval requires a base interval")
+		}
+		if p.GetBackoffMaxMs() < p.GetBackoffBaseMs() {
+			return errors.New("backoff max interval must not be less than the base interval")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,283 @@
+package envoy
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"slices"
+	"strconv"
+
+	cluster "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	endpoint "github.com/envoyproxy/go-control-plane/envoy/config/endpoint/v3"
+	listener "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	route "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	"github.com/envoyproxy/go-control-plane/pkg/cache/types"
+	"github.com/envoyproxy/go-control-plane/pkg/cache/v3"
+	"github.com/envoyproxy/go-control-plane/pkg/resource/v3"
+	v1 "github.com/lyonmu/quebec/idl/router/v1"
+)
+
+// Resources 由代理配置渲染得到的 Envoy 资源
+type Resources struct {
+	Clusters  []*cluster.Cluster
+	Endpoints []*endpoint.ClusterLoadAssignment
+	Routes    []*route.RouteConfiguration
+	Listeners []*listener.Listener
+}
+
+// Render 将代理配置渲染为 Envoy 资源并执行 proto 校验，返回所有校验失败的实体。
+// 校验失败的上游服务、路由与监听器不会出现在渲染结果中
+func Render(cfg *v1.ProxyConfig, opts Options) (*Resources, []*ValidationError) {
+	var (
+		res  = &Resources{}
+		errs []*ValidationError
+	)
+
+	// 1. 生成集群配置 CDS 与端点配置 EDS
+	upstreams := make(map[string]struct{}, len(cfg.GetUpstreams()))
+	for _, u := range cfg.GetUpstreams() {
+		if err := ValidateUpstreamHash(u); err != nil {
+			errs = append(errs, &ValidationError{Entity: EntityUpstream, ID: u.GetId(), Name: u.GetName(), Err: err})
+			continue
+		}
+		c := MakeCluster(u)
+		if err := validateResource(c); err != nil {
+			errs = append(errs, &ValidationError{Entity: EntityUpstream, ID: u.GetId(), Name: u.GetName(), Err: err})
+			continue
+		}
+		e := MakeEndpoint(u)
+		if err := validateResource(e); err != nil {
+			errs = append(errs, &ValidationError{Entity: EntityUpstream, ID: u.GetId(), Name: u.GetName(), Err: err})
+			continue
+		}
+		res.Clusters = append(res.Clusters, c)
+		res.Endpoints = append(res.Endpoints, e)
+		upstreams[u.GetId()] = struct{}{}
+	}
+
+	// 2. 校验虚拟主机，同一监听器内的域名不能重复
+	vhosts, vhostErrs := validateVirtualHosts(cfg.GetVirtualHosts())
+	errs = append(errs, vhostErrs...)
+	scopes := make(map[string]struct{}, len(cfg.GetVirtualHosts()))
+	for _, list := range vhosts {
+		for _, vh := range list {
+			scopes[vh.GetId()] = struct{}{}
+		}
+	}
+
+	// 3. 校验路由，路由必须指向已下发的上游服务与虚拟主机
+	routes := make([]*v1.HttpRoute, 0, len(cfg.GetHttpRoutes()))
+	for _, r := range cfg.GetHttpRoutes() {
+		if err := validateRoute(r, upstreams, scopes); err != nil {
+			errs = append(errs, &ValidationError{Entity: EntityHttpRoute, ID: r.GetId(), Name: r.GetName(), Err: err})
+			continue
+		}
+		if err := validateResource(MakeRoute(r)); err != nil {
+			errs = append(errs, &ValidationError{Entity: EntityHttpRoute, ID: r.GetId(), Name: r.GetName(), Err: err})
+			continue
+		}
+		routes = append(routes, r)
+	}
+
+	// 4. 校验网关集群的维护模式，cfg 应已由 ScopeProxyConfig 筛选出当前集群
+	var maintenance *v1.Maintenance
+	for _, m := range cfg.GetClusterMaintenances() {
+		if err := ValidateMaintenance(m.GetMaintenance()); err != nil {
+			errs = append(errs, &ValidationError{Entity: EntityCluster, ID: m.GetId(), Name: m.GetClusterId(), Err: err})
+			continue
+		}
+		maintenance = m.GetMaintenance()
+	}
+
+	// 5. 生成路由配置 RDS 与监听器配置 LDS，每个监听器引用各自的 RouteConfiguration。
+	// 只有 Gateway 提供限流服务且有路由配置全局限流时才添加全局限流过滤器
+	globalRateLimit := opts.GlobalRateLimit && slices.ContainsFunc(routes, hasRateLimitActions)
+	addresses := make(map[string]string, len(cfg.GetL7Listeners()))
+	for _, l := range cfg.GetL7Listeners() {
+		ln, err := MakeListener(l, opts, globalRateLimit)
+		if err == nil {
+			err = validateResource(ln)
+		}
+		if err != nil {
+			errs = append(errs, &ValidationError{Entity: EntityL7Listener, ID: l.GetId(), Name: l.GetName(), Err: err})
+			continue
+		}
+
+		// 同一分组内监听地址不能重复，否则 Envoy 会拒绝整个 LDS 更新
+		addr := net.JoinHostPort(ln.GetAddress().GetSocketAddress().GetAddress(), strconv.FormatUint(uint64(l.GetPort()), 10))
+		if other, ok := addresses[addr]; ok {
+			errs = append(errs, &ValidationError{Entity: EntityL7Listener, ID: l.GetId(), Name: l.GetName(), Err: fmt.Errorf("address %s already used by listener %s", addr, other)})
+			continue
+		}
+		addresses[addr] = l.GetId()
+
+		rc := MakeRouteConfig(RouteConfigName(l.Id), vhosts[l.GetId()], routes, maintenance)
+		if err := validateResource(rc); err != nil {
+			errs = append(errs, &ValidationError{Entity: EntityL7Listener, ID: l.GetId(), Name: l.GetName(), Err: err})
+			continue
+		}
+		res.Routes = append(res.Routes, rc)
+		res.Listeners = append(res.Listeners, ln)
+	}
+
+	return res, errs
+}
+
+// validateRoute 校验路由中 proto 校验无法覆盖的设置：上游服务与虚拟主机引用、匹配条件、路径、直接响应与重试策略
+func validateRoute(r *v1.HttpRoute, upstreams, vhosts map[string]struct{}) error {
+	if err := validateRouteTargets(r, upstreams); err != nil {
+		return err
+	}
+	if id := r.GetVirtualHostId(); id != "" {
+		if _, ok := vhosts[id]; !ok {
+			return fmt.Errorf("virtual host %q not found", id)
+		}
+	}
+	if err := ValidateRouteMatch(r); err != nil {
+		return err
+	}
+	if err := ValidateRoutePath(r); err != nil {
+		return err
+	}
+	if err := ValidateHeaderMutation(r.GetHeaderMutation()); err != nil {
+		return err
+	}
+	if err := ValidateRouteMirror(r); err != nil {
+		return err
+	}
+	if err := ValidateFaultPolicy(r.GetFaultPolicy()); err != nil {
+		return err
+	}
+	if err := ValidateCorsPolicy(r.GetCorsPolicy()); err != nil {
+		return err
+	}
+	if err := ValidateLocalRateLimit(r.GetLocalRateLimit()); err != nil {
+		return err
+	}
+	if err := ValidateHashPolicies(r.GetHashPolicies()); err != nil {
+		return err
+	}
+	if err := ValidateRateLimitActions(r.GetRateLimitActions()); err != nil {
+		return err
+	}
+	if err := ValidateDirectResponse(r.GetDirectResponse()); err != nil {
+		return err
+	}
+	if r.GetDirectResponse() != nil && r.GetEnableRedirect() {
+		return errors.New("direct response and redirect cannot be enabled together")
+	}
+	if id := r.GetMirrorUpstreamId(); id != "" && routeForwards(r) {
+		if _, ok := upstreams[id]; !ok {
+			return fmt.Errorf("mirror upstream %q not found", id)
+		}
+	}
+	return ValidateRetryPolicy(r.GetRetryPolicy())
+}
+
+// validateRouteTargets 校验路由转发的上游服务均已下发，加权分流的权重之和必须大于 0
+func validateRouteTargets(r *v1.HttpRoute, upstreams map[string]struct{}) error {
+	for _, id := range RouteUpstreamIDs(r) {
+		if _, ok := upstreams[id]; !ok {
+			return fmt.Errorf("upstream %q not found", id)
+		}
+	}
+	if len(r.GetTargets()) == 0 {
+		return nil
+	}
+	var total uint32
+	seen := make(map[string]struct{}, len(r.GetTargets()))
+	for _, t := range r.GetTargets() {
+		if _, ok := seen[t.GetUpstreamId()]; ok {
+			return fmt.Errorf("upstream %q targeted more than once", t.GetUpstreamId())
+		}
+		seen[t.GetUpstreamId()] = struct{}{}
+		total += t.GetWeight()
+	}
+	if total == 0 {
+		return errors.New("total weight of targets must be greater than 0")
+	}
+	return nil
+}
+
+// Snapshot 以指定版本创建 xDS 快照，并校验资源之间的引用一致性
+func (r *Resources) Snapshot(version string) (*cache.Snapshot, error) {
+	resources := map[resource.Type][]types.Resource{
+		resource.ClusterType:  toResources(r.Clusters),
+		resource.EndpointType: toResources(r.Endpoints),
+		resource.RouteType:    toResources(r.Routes),
+		resource.ListenerType: toResources(r.Listeners),
+	}
+
+	snap, err := cache.NewSnapshot(version, resources)
+	if err != nil {
+		return nil, &ValidationError{Entity: EntitySnapshot, Err: err}
+	}
+
+	// 验证 snapshot
+	if err := snap.Consistent(); err != nil {
+		return nil, &ValidationError{Entity: EntitySnapshot, Err: err}
+	}
+
+	return snap, nil
+}
+
+func toResources[T types.Resource](items []T) []types.Resource {
+	out := make([]types.Resource, 0, len(items))
+	for _, item := range items {
+		out = append(out, item)
+	}
+	return out
+}
+
+// UsesGlobalRateLimit 判断代理配置中是否有路由配置了全局限流描述符条目，这些路由需要 Gateway 提供限流服务
+func UsesGlobalRateLimit(cfg *v1.ProxyConfig) bool {
+	return slices.ContainsFunc(cfg.GetHttpRoutes(), hasRateLimitActions)
+}
+
+func hasRateLimitActions(r *v1.HttpRoute) bool {
+	return len(r.GetRateLimitActions()) > 0
+}
+
+// GenerateSnapshot 由代理配置生成 xDS 快照，任一实体无法生成合法资源时返回 *ValidationError
+func GenerateSnapshot(version string, cfg *v1.ProxyConfig, opts Options) (*cache.Snapshot, error) {
+	res, errs := Render(cfg, opts)
+	if len(errs) > 0 {
+		return nil, errs[0]
+	}
+	return res.Snapshot(version)
+}
+
+// ScopeProxyConfig 筛选出下发给指定集群的配置：未绑定集群的监听器与路由对所有集群生效，
+// 虚拟主机随所属监听器下发，属于未下发虚拟主机的路由同样不下发，网关集群的维护模式只下发给该集群
+func ScopeProxyConfig(cfg *v1.ProxyConfig, cluster string) *v1.ProxyConfig {
+	scoped := &v1.ProxyConfig{Upstreams: cfg.GetUpstreams()}
+	listeners := make(map[string]struct{}, len(cfg.GetL7Listeners()))
+	for _, l := range cfg.GetL7Listeners() {
+		if l.GetClusterId() == "" || l.GetClusterId() == cluster {
+			scoped.L7Listeners = append(scoped.L7Listeners, l)
+			listeners[l.GetId()] = struct{}{}
+		}
+	}
+	vhosts := make(map[string]struct{}, len(cfg.GetVirtualHosts()))
+	for _, vh := range cfg.GetVirtualHosts() {
+		if _, ok := listeners[vh.GetListenerId()]; ok {
+			scoped.VirtualHosts = append(scoped.VirtualHosts, vh)
+			vhosts[vh.GetId()] = struct{}{}
+		}
+	}
+	for _, r := range cfg.GetHttpRoutes() {
+		if r.GetClusterId() != "" && r.GetClusterId() != cluster {
+			continue
+		}
+		if _, ok := vhosts[r.GetVirtualHostId()]; r.GetVirtualHostId() != "" && !ok {
+			continue
+		}
+		scoped.HttpRoutes = append(scoped.HttpRoutes, r)
+	}
+	for _, m := range cfg.GetClusterMaintenances() {
+		if m.GetClusterId() == cluster {
+			scoped.ClusterMaintenances = append(scoped.ClusterMaintenances, m)
+		}
+	}
+	return scoped
+}
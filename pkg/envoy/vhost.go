@@ -0,0 +1,88 @@
+package envoy
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	v1 "github.com/lyonmu/quebec/idl/router/v1"
+)
+
+// ValidateDomains 校验虚拟主机的域名：通配符 * 只能单独出现或位于域名首尾，同一虚拟主机内不能重复。
+// Core 保存虚拟主机时使用同一校验
+func ValidateDomains(domains []string) error {
+	if len(domains) == 0 {
+		return errors.New("at least one domain is required")
+	}
+	seen := make(map[string]struct{}, len(domains))
+	for _, d := range domains {
+		if d == "" || strings.ContainsAny(d, " \t/") {
+			return fmt.Errorf("invalid domain %q", d)
+		}
+		if d != "*" {
+			if n := strings.Count(d, "*"); n > 1 || (n == 1 && !strings.HasPrefix(d, "*") && !strings.HasSuffix(d, "*")) {
+				return fmt.Errorf("invalid wildcard domain %q", d)
+			}
+		}
+		key := strings.ToLower(d)
+		if _, ok := seen[key]; ok {
+			return fmt.Errorf("duplicate domain %q", d)
+		}
+		seen[key] = struct{}{}
+	}
+	return nil
+}
+
+// DomainConflicts 返回 domains 中已被 used 占用的域名，域名不区分大小写
+func DomainConflicts(used map[string]string, domains []string) []string {
+	var conflicts []string
+	for _, d := range domains {
+		if _, ok := used[strings.ToLower(d)]; ok {
+			conflicts = append(conflicts, d)
+		}
+	}
+	return conflicts
+}
+
+// validateVirtualHosts 校验虚拟主机域名、头改写规则与跨域策略，同一监听器内的域名不能重复，否则 Envoy 会拒绝整个 RouteConfiguration。
+// 返回按监听器分组的合法虚拟主机
+func validateVirtualHosts(vhosts []*v1.VirtualHost) (map[string][]*v1.VirtualHost, []*ValidationError) {
+	var (
+		byListener = make(map[string][]*v1.VirtualHost)
+		used       = make(map[string]map[string]string) // listener -> domain -> vhost
+		errs       []*ValidationError
+	)
+	for _, vh := range vhosts {
+		if err := ValidateDomains(vh.GetDomains()); err != nil {
+			errs = append(errs, &ValidationError{Entity: EntityVirtualHost, ID: vh.GetId(), Name: vh.GetName(), Err: err})
+			continue
+		}
+		if err := ValidateHeaderMutation(vh.GetHeaderMutation()); err != nil {
+			errs = append(errs, &ValidationError{Entity: EntityVirtualHost, ID: vh.GetId(), Name: vh.GetName(), Err: err})
+			continue
+		}
+		if err := ValidateCorsPolicy(vh.GetCorsPolicy()); err != nil {
+			errs = append(errs, &ValidationError{Entity: EntityVirtualHost, ID: vh.GetId(), Name: vh.GetName(), Err: err})
+			continue
+		}
+		if err := ValidateMaintenance(vh.GetMaintenance()); err != nil {
+			errs = append(errs, &ValidationError{Entity: EntityVirtualHost, ID: vh.GetId(), Name: vh.GetName(), Err: err})
+			continue
+		}
+		domains, ok := used[vh.GetListenerId()]
+		if !ok {
+			domains = make(map[string]string)
+			used[vh.GetListenerId()] = domains
+		}
+		if conflicts := DomainConflicts(domains, vh.GetDomains()); len(conflicts) > 0 {
+			err := fmt.Errorf("domain %s already used by virtual host %s", conflicts[0], domains[strings.ToLower(conflicts[0])])
+			errs = append(errs, &ValidationError{Entity: EntityVirtualHost, ID: vh.GetId(), Name: vh.GetName(), Err: err})
+			continue
+		}
+		for _, d := range vh.GetDomains() {
+			domains[strings.ToLower(d)] = vh.GetId()
+		}
+		byListener[vh.GetListenerId()] = append(byListener[vh.GetListenerId()], vh)
+	}
+	return byListener, errs
+}